@@ -0,0 +1,130 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/pkg/health"
+)
+
+// Leader election flags
+var (
+	leaderElect = flag.Bool(
+		"leader-elect", false,
+		`Enable lease based leader election so that only one of the replicas
+		reconciles at a time.`,
+	)
+	leaderElectionLeaseName = flag.String(
+		"leader-election-lease-name", "openebs-upgrade",
+		"Name of the lease used for leader election",
+	)
+	leaderElectionNamespace = flag.String(
+		"leader-election-namespace", "",
+		`Namespace of the lease used for leader election. Defaults to the
+		namespace given by the POD_NAMESPACE env.`,
+	)
+	leaderElectionLeaseDuration = flag.Duration(
+		"leader-election-lease-duration", 15*time.Second,
+		`Duration for which the standby replicas wait before taking over
+		once the leader stops renewing the lease.`,
+	)
+	leaderElectionRenewDeadline = flag.Duration(
+		"leader-election-renew-deadline", 10*time.Second,
+		"Duration for which the leader retries renewing the lease before giving up",
+	)
+	leaderElectionRetryPeriod = flag.Duration(
+		"leader-election-retry-period", 2*time.Second,
+		"Duration between the attempts to acquire or renew the lease",
+	)
+)
+
+// runWithLeaderElection invokes the given function only once this replica
+// becomes the leader. The lease is released once the function returns so
+// that a standby replica can take over without waiting for the lease to
+// expire.
+//
+// NOTE: This replica exits if it loses the lease while it is leading since
+// the controllers can't be stopped without stopping the process.
+func runWithLeaderElection(run func()) error {
+	namespace := *leaderElectionNamespace
+	if namespace == "" {
+		namespace = os.Getenv("POD_NAMESPACE")
+	}
+	if namespace == "" {
+		return errors.Errorf(
+			"Can't run leader election: Neither leader-election-namespace flag nor POD_NAMESPACE env is set")
+	}
+	identity := os.Getenv("POD_NAME")
+	if identity == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return errors.Wrapf(err, "Can't run leader election: Failed to get hostname")
+		}
+		identity = hostname
+	}
+	lock, err := resourcelock.New(
+		resourcelock.LeasesResourceLock,
+		namespace,
+		*leaderElectionLeaseName,
+		k8s.Clientset.CoreV1(),
+		k8s.Clientset.CoordinationV1(),
+		resourcelock.ResourceLockConfig{
+			Identity:      identity,
+			EventRecorder: k8s.EventRecorder,
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Can't create lease lock %s %s", namespace, *leaderElectionLeaseName)
+	}
+
+	// standby replicas are reported as ready so that these don't block the
+	// rollout of the operator
+	health.SetStandby(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	glog.Infof("Waiting to acquire lease %s %s as %s", namespace, *leaderElectionLeaseName, identity)
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   *leaderElectionLeaseDuration,
+		RenewDeadline:   *leaderElectionRenewDeadline,
+		RetryPeriod:     *leaderElectionRetryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				glog.Infof("Acquired lease %s %s, starting controllers", namespace, *leaderElectionLeaseName)
+				health.SetStandby(false)
+				run()
+				// release the lease since the controllers have stopped
+				cancel()
+			},
+			OnStoppedLeading: func() {
+				if ctx.Err() != nil {
+					glog.Infof("Released lease %s %s", namespace, *leaderElectionLeaseName)
+					return
+				}
+				glog.Fatalf("Lost lease %s %s, exiting", namespace, *leaderElectionLeaseName)
+			},
+		},
+	})
+	return nil
+}
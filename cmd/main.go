@@ -16,8 +16,14 @@ package main
 import (
 	"flag"
 	"mayadata.io/openebs-upgrade/controller/adoptopenebs"
+	"net/http"
 	"os"
+	"path/filepath"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"openebs.io/metac/controller/generic"
 	"openebs.io/metac/start"
@@ -25,6 +31,9 @@ import (
 	"github.com/golang/glog"
 	"mayadata.io/openebs-upgrade/controller/openebs"
 	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/pkg/catalog"
+	"mayadata.io/openebs-upgrade/pkg/health"
+	"mayadata.io/openebs-upgrade/pkg/templates"
 )
 
 // Command line flags
@@ -34,8 +43,48 @@ var (
 		`Absolute path to the kubeconfig file.
 		Required only when running outside the cluster.`,
 	)
+	metricsAddr = flag.String(
+		"metrics-addr", ":8080",
+		"The address to bind the metrics http endpoint",
+	)
+	healthAddr = flag.String(
+		"health-addr", ":8081",
+		"The address to bind the liveness and readiness http endpoints",
+	)
+	templatesDir = flag.String(
+		"templates-dir", "",
+		`Directory having the operator, the ISCSI setup and the release YAMLs
+		which override the ones bundled into the binary. The bundled YAMLs
+		are used for the ones not found in this directory.`,
+	)
+	releaseCatalogDir = flag.String(
+		"release-catalog-dir", "",
+		`Directory having the descriptors of the OpenEBS releases that can be
+		installed. Defaults to the releases directory of the templates.`,
+	)
+	releaseCatalogConfigMap = flag.String(
+		"release-catalog-configmap", "",
+		`Optional namespace/name of a ConfigMap having additional release
+		descriptors and operator YAMLs. These take precedence over the
+		releases of the release catalog directory.`,
+	)
+	releaseCatalogCacheDir = flag.String(
+		"release-catalog-cache-dir", filepath.Join(os.TempDir(), "openebs-upgrade", "templates"),
+		`Directory where the remote operator YAMLs of the release catalog are
+		cached once their digests are verified.`,
+	)
+	livenessWindow = flag.Duration(
+		"liveness-window", 0,
+		`Duration within which the reconcile loop must tick for the operator
+		to be reported live. It must be larger than the cache flush interval
+		and defaults to twice the cache flush interval.`,
+	)
 )
 
+// defaultCacheFlushInterval is the default of the cache-flush-interval flag
+// of metac, it is used if the flag can't be looked up.
+const defaultCacheFlushInterval = 30 * time.Minute
+
 // main function is the entry point of this binary.
 //
 // This registers various controller (i.e. kubernetes reconciler)
@@ -46,6 +95,18 @@ func main() {
 	flag.Set("logtostderr", "true")
 	flag.Parse()
 
+	// serve the liveness and readiness endpoints before anything else so
+	// that the operator is reported as not ready till it is set up.
+	healthMux := http.NewServeMux()
+	healthMux.HandleFunc("/healthz", health.HealthzHandler(getLivenessWindow()))
+	healthMux.HandleFunc("/readyz", health.ReadyzHandler)
+	go func() {
+		glog.Errorf(
+			"Error serving health endpoints: %v",
+			http.ListenAndServe(*healthAddr, healthMux),
+		)
+	}()
+
 	// Create the kubernetes client config.
 	config, err := k8s.BuildConfig(*kubeconfig)
 	if err != nil {
@@ -61,9 +122,92 @@ func main() {
 	}
 	// set the global ClientSet variable so that it can be used globally.
 	k8s.Clientset = clientset
+	// set the global DynamicClient variable so that the custom resources
+	// such as cStor pools, volumes, etc. can be accessed globally.
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		glog.Error(err.Error())
+		os.Exit(1)
+	}
+	k8s.DynamicClient = dynamicClient
+	// set the global EventRecorder variable so that the reconcile outcomes
+	// are recorded as events against OpenEBS and AdoptOpenEBS.
+	k8s.EventRecorder = k8s.NewEventRecorder("openebs-upgrade")
+	health.SetReadiness(health.ReadinessCheckKubernetesClient, nil)
+
+	// the templates bundled into the binary are used if no templates
+	// directory is given.
+	templates.SetDir(*templatesDir)
+
+	// the operator is not ready till the release catalog is fixed since
+	// none of the OpenEBS versions can be installed otherwise.
+	err = catalog.Init(catalog.Config{
+		Dir:       *releaseCatalogDir,
+		ConfigMap: *releaseCatalogConfigMap,
+		CacheDir:  *releaseCatalogCacheDir,
+	})
+	if err != nil {
+		glog.Errorf("Invalid release catalog: %+v", err)
+	}
+	health.SetReadiness(health.ReadinessCheckReleaseCatalog, err)
+
+	// the operator is not ready till the templates are fixed since none of
+	// the OpenEBS components can be formed otherwise.
+	if err == nil {
+		err = openebs.ValidateTemplates()
+		if err != nil {
+			glog.Errorf("Invalid templates: %+v", err)
+		}
+	}
+	health.SetReadiness(health.ReadinessCheckTemplates, err)
+
+	// serve the metrics of openebs-upgrade, the metrics of metac are
+	// served separately at its debug address.
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	go func() {
+		glog.Errorf(
+			"Error serving metrics endpoint: %v",
+			http.ListenAndServe(*metricsAddr, mux),
+		)
+	}()
 
 	generic.AddToInlineRegistry("sync/openebs", openebs.Sync)
+	generic.AddToInlineRegistry("finalize/openebs", openebs.Finalize)
 	generic.AddToInlineRegistry("sync/adoptopenebs", adoptopenebs.Sync)
 
-	start.Start()
+	if !*leaderElect {
+		start.Start()
+		return
+	}
+	err = runWithLeaderElection(start.Start)
+	if err != nil {
+		glog.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+// getLivenessWindow returns the duration within which the reconcile loop must
+// tick. It is derived from the cache flush interval of metac if not set or if
+// it is not larger than the cache flush interval since metac resyncs the
+// watches only once the caches are flushed.
+func getLivenessWindow() time.Duration {
+	cacheFlushInterval := defaultCacheFlushInterval
+	if f := flag.Lookup("cache-flush-interval"); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			if interval, ok := getter.Get().(time.Duration); ok {
+				cacheFlushInterval = interval
+			}
+		}
+	}
+	if *livenessWindow > cacheFlushInterval {
+		return *livenessWindow
+	}
+	window := 2 * cacheFlushInterval
+	if *livenessWindow > 0 {
+		glog.Warningf("Using liveness window %s instead of %s: "+
+			"It must be larger than the cache flush interval %s",
+			window, *livenessWindow, cacheFlushInterval)
+	}
+	return window
 }
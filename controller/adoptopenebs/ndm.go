@@ -4,7 +4,7 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"mayadata.io/openebs-upgrade/controller/openebs"
+	"mayadata.io/openebs-upgrade/pkg/catalog"
 	"mayadata.io/openebs-upgrade/types"
 	"mayadata.io/openebs-upgrade/unstruct"
 	"mayadata.io/openebs-upgrade/util"
@@ -12,6 +12,17 @@ import (
 	"strings"
 )
 
+// getNDMVersion returns the NDM version shipped along with the OpenEBS
+// version being adopted, an empty version is returned if the OpenEBS
+// version is not in the release catalog.
+func (p *Planner) getNDMVersion() string {
+	release, err := catalog.GetRelease(p.OpenEBSVersion)
+	if err != nil {
+		return ""
+	}
+	return release.Components.NDM
+}
+
 // formNDMOperatorConfig forms the desired OpenEBS CR config for NDM operator.
 func (p *Planner) formNDMOperatorConfig(ndmOperator *unstructured.Unstructured) error {
 	ndmOperatorConfig := &unstructured.Unstructured{
@@ -48,7 +59,7 @@ func (p *Planner) formNDMOperatorConfig(ndmOperator *unstructured.Unstructured)
 			if err != nil {
 				return err
 			}
-			if imageTag != openebs.SupportedNDMVersionForOpenEBSVersion[p.OpenEBSVersion] {
+			if imageTag != p.getNDMVersion() {
 				ndmOperatorDetails[types.KeyImageTag] = imageTag
 			}
 			if containerName != "node-disk-operator" {
@@ -134,7 +145,7 @@ func (p *Planner) formNDMDaemonConfig(ndm *unstructured.Unstructured) error {
 			if err != nil {
 				return err
 			}
-			if imageTag != openebs.SupportedNDMVersionForOpenEBSVersion[p.OpenEBSVersion] {
+			if imageTag != p.getNDMVersion() {
 				ndmDaemonDetails[types.KeyImageTag] = imageTag
 			}
 			// update the container name if not the desired one i.e., node-disk-manager.
@@ -15,12 +15,18 @@ package adoptopenebs
 
 import (
 	"encoding/json"
+	"fmt"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/pkg/health"
+	"mayadata.io/openebs-upgrade/pkg/metrics"
 	"mayadata.io/openebs-upgrade/pkg/utils/metac"
 	"mayadata.io/openebs-upgrade/types"
 	"strconv"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"openebs.io/metac/controller/generic"
@@ -29,6 +35,9 @@ import (
 type reconcileErrHandler struct {
 	adoptopenebs *unstructured.Unstructured
 	hookResponse *generic.SyncHookResponse
+	// isHandled is true if an error has been handled, this is used to
+	// count the failed reconciliations.
+	isHandled bool
 }
 
 type reconcileSuccessHandler struct {
@@ -51,6 +60,8 @@ func (h *reconcileErrHandler) handle(err error) {
 	h.hookResponse.Status = map[string]interface{}{}
 	h.hookResponse.Status["phase"] = types.AdoptOpenEBSStatusPhaseFailed
 	h.hookResponse.Status["reason"] = err.Error()
+	h.isHandled = true
+	k8s.RecordEvent(h.adoptopenebs, corev1.EventTypeWarning, types.EventReasonReconcileFailed, err.Error())
 	// this will stop further reconciliation at metac since there was
 	// an error
 	h.hookResponse.SkipReconcile = true
@@ -108,6 +119,12 @@ func Sync(request *generic.SyncHookRequest, response *generic.SyncHookResponse)
 		adoptopenebs: request.Watch,
 		hookResponse: response,
 	}
+	health.Tick()
+	// record the outcome and the duration of this reconciliation
+	start := time.Now()
+	defer func() {
+		metrics.ObserveReconcile(metrics.ControllerSyncAdoptOpenEBS, start, errHandler.isHandled)
+	}()
 
 	var observedAdoptOpenEBS *unstructured.Unstructured
 	var observedOpenEBS *unstructured.Unstructured
@@ -185,6 +202,11 @@ func Sync(request *generic.SyncHookRequest, response *generic.SyncHookResponse)
 	}
 	if resp.DesiredOpenEBS != nil {
 		response.Attachments = append(response.Attachments, resp.DesiredOpenEBS)
+		if observedOpenEBS == nil {
+			k8s.RecordEvent(request.Watch, corev1.EventTypeNormal, types.EventReasonOpenEBSAdopted,
+				fmt.Sprintf("Adopting the installed OpenEBS as OpenEBS %s/%s",
+					resp.DesiredOpenEBS.GetNamespace(), resp.DesiredOpenEBS.GetName()))
+		}
 	}
 	if resp.DesiredAdoptOpenEBSComponents != nil {
 		for _, desiredAdoptOpenEBSComponent := range resp.DesiredAdoptOpenEBSComponents {
@@ -192,6 +214,12 @@ func Sync(request *generic.SyncHookRequest, response *generic.SyncHookResponse)
 		}
 	}
 
+	for _, component := range response.ExplicitDeletes {
+		k8s.RecordEvent(request.Watch, corev1.EventTypeNormal, types.EventReasonComponentDeleted,
+			fmt.Sprintf("Deleting %s/%s/%s since it is not adopted",
+				component.GetKind(), component.GetNamespace(), component.GetName()))
+	}
+
 	glog.V(2).Infof(
 		"AdoptOpenEBS %s %s reconciled successfully: %s",
 		request.Watch.GetNamespace(), request.Watch.GetName(),
@@ -47,7 +47,8 @@ func (p *Planner) setAdmissionServerDefaultsIfNotSet() error {
 		p.ObservedOpenEBS.Spec.AdmissionServer.ImageTag = p.ObservedOpenEBS.Spec.Version +
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
-	p.ObservedOpenEBS.Spec.AdmissionServer.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.AdmissionServer.Image = getImagePrefix(p.ObservedOpenEBS.Spec.AdmissionServer.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"admission-server:" + p.ObservedOpenEBS.Spec.AdmissionServer.ImageTag
 
 	if p.ObservedOpenEBS.Spec.AdmissionServer.Replicas == nil {
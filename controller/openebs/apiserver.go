@@ -145,6 +145,9 @@ func (p *Planner) updateMayaAPIServer(deploy *unstructured.Unstructured) error {
 			if err != nil {
 				return err
 			}
+			// pass the imagePullSecrets to the jiva and cstor pods launched by it
+			envs = setImagePullSecretsEnv(envs,
+				p.getImagePullSecrets(p.ObservedOpenEBS.Spec.APIServer.ImagePullSecrets))
 			// ignore updating the Envs which could cause immutability error
 			envs, err = p.ignoreUpdatingImmutableEnvs(p.ObservedOpenEBS.Spec.APIServer.ENV, envs)
 			if err != nil {
@@ -195,7 +198,8 @@ func (p *Planner) setAPIServerDefaultsIfNotSet() error {
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
 	// form the container image as per the image prefix and image tag.
-	p.ObservedOpenEBS.Spec.APIServer.Image = p.ObservedOpenEBS.Spec.ImagePrefix + "m-apiserver:" +
+	p.ObservedOpenEBS.Spec.APIServer.Image = getImagePrefix(p.ObservedOpenEBS.Spec.APIServer.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) + "m-apiserver:" +
 		p.ObservedOpenEBS.Spec.APIServer.ImageTag
 
 	if p.ObservedOpenEBS.Spec.APIServer.CstorSparsePool == nil {
@@ -14,14 +14,16 @@ limitations under the License.
 package openebs
 
 import (
-	"io/ioutil"
 	"mayadata.io/openebs-upgrade/k8s"
-	"regexp"
+	"mayadata.io/openebs-upgrade/pkg/catalog"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/ghodss/yaml"
+	"github.com/golang/glog"
 	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"mayadata.io/openebs-upgrade/types"
@@ -34,8 +36,9 @@ var OpenEBSVersionAbove240 bool
 
 // setDefaultImagePullPolicyIfNotSet sets the default imagePullPolicy
 // to "IfNotPresent" for all the components.
-// TODO: See if this is required component wise and not at the global
-// level.
+//
+// NOTE: The imagePullPolicy of a particular container, if set, takes
+// precedence over this.
 func (p *Planner) setDefaultImagePullPolicyIfNotSet() error {
 	if p.ObservedOpenEBS.Spec.ImagePullPolicy == "" {
 		p.ObservedOpenEBS.Spec.ImagePullPolicy = "IfNotPresent"
@@ -43,6 +46,15 @@ func (p *Planner) setDefaultImagePullPolicyIfNotSet() error {
 	return nil
 }
 
+// getImagePullPolicy returns the imagePullPolicy of the given container if
+// set otherwise the global imagePullPolicy.
+func (p *Planner) getImagePullPolicy(container types.Container) string {
+	if container.ImagePullPolicy != "" {
+		return container.ImagePullPolicy
+	}
+	return p.ObservedOpenEBS.Spec.ImagePullPolicy
+}
+
 // setDefaultStoragePathIfNotSet sets the default storage path for
 // OpenEBS to "/var/openebs" if not already set.
 func (p *Planner) setDefaultStoragePathIfNotSet() error {
@@ -74,6 +86,20 @@ func (p *Planner) setDefaultImagePrefixIfNotSet() error {
 	return nil
 }
 
+// getImagePrefix returns the imagePrefix of the given container if set
+// otherwise the given default imagePrefix which is usually the global one.
+// A forward slash is added to the imagePrefix of the container if missing
+// same as it is done for the global one.
+func getImagePrefix(container types.Container, defaultImagePrefix string) string {
+	if container.ImagePrefix == "" {
+		return defaultImagePrefix
+	}
+	if !strings.HasSuffix(container.ImagePrefix, "/") {
+		return container.ImagePrefix + "/"
+	}
+	return container.ImagePrefix
+}
+
 // setImageTagSuffixIfPresent sets a custom image tag suffix that can be specified
 // for pulling the release candidate images for containers such as 1.10.0-RC1, etc.
 //
@@ -121,68 +147,13 @@ type BasicComponentDetails struct {
 
 // getManifests returns a mapping of component's "name_kind" to YAML of
 // the respective components based on a particular version.
-// Note: This method makes use of the various operator YAMLs to form this
-// mapping.
+// Note: This method makes use of the operator YAML of the release being
+// installed as per the release catalog to form this mapping.
 func (p *Planner) getManifests() error {
 	componentsYAMLMap := make(map[string]*unstructured.Unstructured)
-	var yamlFile string
-
-	switch p.ObservedOpenEBS.Spec.Version {
-	case types.OpenEBSVersion150:
-		yamlFile = "/templates/openebs-operator-1.5.0.yaml"
-	case types.OpenEBSVersion160:
-		yamlFile = "/templates/openebs-operator-1.6.0.yaml"
-	case types.OpenEBSVersion170:
-		yamlFile = "/templates/openebs-operator-1.7.0.yaml"
-	case types.OpenEBSVersion180:
-		yamlFile = "/templates/openebs-operator-1.8.0.yaml"
-	case types.OpenEBSVersion190:
-		yamlFile = "/templates/openebs-operator-1.9.0.yaml"
-	case types.OpenEBSVersion1100:
-		yamlFile = "/templates/openebs-operator-1.10.0.yaml"
-	case types.OpenEBSVersion1100EE:
-		yamlFile = "/templates/openebs-operator-1.10.0-ee.yaml"
-	case types.OpenEBSVersion1110:
-		yamlFile = "/templates/openebs-operator-1.11.0.yaml"
-	case types.OpenEBSVersion1110EE:
-		yamlFile = "/templates/openebs-operator-1.11.0-ee.yaml"
-	case types.OpenEBSVersion1120:
-		yamlFile = "/templates/openebs-operator-1.12.0.yaml"
-	case types.OpenEBSVersion1120EE:
-		yamlFile = "/templates/openebs-operator-1.12.0-ee.yaml"
-	case types.OpenEBSVersion200:
-		yamlFile = "/templates/openebs-operator-2.0.0.yaml"
-	case types.OpenEBSVersion200EE:
-		yamlFile = "/templates/openebs-operator-2.0.0-ee.yaml"
-	case types.OpenEBSVersion210:
-		yamlFile = "/templates/openebs-operator-2.1.0.yaml"
-	case types.OpenEBSVersion210EE:
-		yamlFile = "/templates/openebs-operator-2.1.0-ee.yaml"
-	case types.OpenEBSVersion220:
-		yamlFile = "/templates/openebs-operator-2.2.0.yaml"
-	case types.OpenEBSVersion220EE:
-		yamlFile = "/templates/openebs-operator-2.2.0-ee.yaml"
-	case types.OpenEBSVersion240:
-		yamlFile = "/templates/openebs-operator-2.4.0.yaml"
-	case types.OpenEBSVersion250:
-		yamlFile = "/templates/openebs-operator-2.5.0.yaml"
-	case types.OpenEBSVersion260:
-		yamlFile = "/templates/openebs-operator-2.6.0.yaml"
-	case types.OpenEBSVersion270:
-		yamlFile = "/templates/openebs-operator-2.7.0.yaml"
-	case types.OpenEBSVersion280:
-		yamlFile = "/templates/openebs-operator-2.8.0.yaml"
-	case types.OpenEBSVersion290:
-		yamlFile = "/templates/openebs-operator-2.9.0.yaml"
-
-	default:
-		return errors.Errorf(
-			"Unsupported OpenEBS version provided, version: %+v", p.ObservedOpenEBS.Spec.Version)
-	}
-	openEBSOperatorYaml, err := ioutil.ReadFile(yamlFile)
+	openEBSOperatorYaml, err := catalog.ReadOperatorYAML(p.Release)
 	if err != nil {
-		return errors.Errorf(
-			"Error reading YAML file for version %s: %+v", p.ObservedOpenEBS.Spec.Version, err)
+		return err
 	}
 
 	// form the mapping from component's "name_kind" as key to YAML
@@ -221,85 +192,166 @@ func (p *Planner) getManifests() error {
 }
 
 // removeDisabledManifests removes the manifests which are disabled so that
-// these components does not get installed.
-// TODO: Delete the components if the components are disabled after installation.
+// these components does not get installed. The components which were already
+// installed and got disabled later are removed from the cluster as well.
 func (p *Planner) removeDisabledManifests() error {
-	if *p.ObservedOpenEBS.Spec.APIServer.Enabled == false {
-		delete(p.ComponentManifests, types.MayaAPIServerManifestKey)
-		delete(p.ComponentManifests, types.MayaAPIServerServiceManifestKey)
+	spec := p.ObservedOpenEBS.Spec
+	if *spec.APIServer.Enabled == false {
+		p.removeDisabledManifest(types.MayaAPIServerManifestKey, spec.APIServer.Name)
+		p.removeDisabledManifest(types.MayaAPIServerServiceManifestKey, spec.APIServer.Service.Name)
 	}
-	if *p.ObservedOpenEBS.Spec.AdmissionServer.Enabled == false {
-		delete(p.ComponentManifests, types.AdmissionServerManifestKey)
+	if *spec.AdmissionServer.Enabled == false {
+		p.removeDisabledManifest(types.AdmissionServerManifestKey, spec.AdmissionServer.Name)
 	}
-	if *p.ObservedOpenEBS.Spec.Provisioner.Enabled == false {
-		delete(p.ComponentManifests, types.ProvisionerManifestKey)
+	if *spec.Provisioner.Enabled == false {
+		p.removeDisabledManifest(types.ProvisionerManifestKey, spec.Provisioner.Name)
 	}
-	if *p.ObservedOpenEBS.Spec.SnapshotOperator.Enabled == false {
-		delete(p.ComponentManifests, types.SnapshotOperatorManifestKey)
+	if *spec.SnapshotOperator.Enabled == false {
+		p.removeDisabledManifest(types.SnapshotOperatorManifestKey, spec.SnapshotOperator.Name)
 	}
-	if *p.ObservedOpenEBS.Spec.NDMDaemon.Enabled == false {
-		delete(p.ComponentManifests, types.NDMConfigManifestKey)
-		delete(p.ComponentManifests, types.NDMManifestKey)
+	if *spec.NDMDaemon.Enabled == false {
+		p.removeDisabledManifest(types.NDMConfigManifestKey, spec.NDMConfigMap.Name)
+		p.removeDisabledManifest(types.NDMManifestKey, spec.NDMDaemon.Name)
 	}
-	if *p.ObservedOpenEBS.Spec.NDMOperator.Enabled == false {
-		delete(p.ComponentManifests, types.NDMOperatorManifestKey)
+	if *spec.NDMOperator.Enabled == false {
+		p.removeDisabledManifest(types.NDMOperatorManifestKey, spec.NDMOperator.Name)
 	}
-	if *p.ObservedOpenEBS.Spec.LocalProvisioner.Enabled == false {
-		delete(p.ComponentManifests, types.LocalProvisionerManifestKey)
+	if *spec.LocalProvisioner.Enabled == false {
+		p.removeDisabledManifest(types.LocalProvisionerManifestKey, spec.LocalProvisioner.Name)
 	}
 
-	if *p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.Enabled == false &&
-		*p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.Enabled == false {
-		delete(p.ComponentManifests, types.CSINodeInfoCRDManifestKey)
-		delete(p.ComponentManifests, types.CSIVolumeCRDManifestKey)
-		delete(p.ComponentManifests, types.VolumeSnapshotClassCRDManifestKey)
-		delete(p.ComponentManifests, types.VolumeSnapshotContentCRDManifestKey)
-		delete(p.ComponentManifests, types.VolumeSnapshotCRDManifestKey)
-		delete(p.ComponentManifests, types.CStorCSISnapshottterBindingManifestKey)
-		delete(p.ComponentManifests, types.CStorCSISnapshottterRoleManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIControllerSAManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIProvisionerRoleManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIProvisionerBindingManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIControllerManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIAttacherRoleManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIAttacherBindingManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIClusterRegistrarRoleManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIClusterRegistrarBindingManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIRegistrarRoleManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIRegistrarBindingManifestKey)
-		delete(p.ComponentManifests, types.CStorCSINodeSAManifestKey)
-		delete(p.ComponentManifests, types.CStorCSINodeManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIDriverManifestKey)
-		delete(p.ComponentManifests, types.CStorVolumeAttachmentCRDManifestKey)
+	if *spec.CstorConfig.CSI.CSIController.Enabled == false &&
+		*spec.CstorConfig.CSI.CSINode.Enabled == false {
+		p.removeDisabledManifest(types.CSINodeInfoCRDManifestKey)
+		p.removeDisabledManifest(types.CSIVolumeCRDManifestKey)
+		p.removeDisabledManifest(types.VolumeSnapshotClassCRDManifestKey)
+		p.removeDisabledManifest(types.VolumeSnapshotContentCRDManifestKey)
+		p.removeDisabledManifest(types.VolumeSnapshotCRDManifestKey)
+		p.removeDisabledManifest(types.CStorCSIDriverManifestKey)
+		p.removeDisabledManifest(types.CStorVolumeAttachmentCRDManifestKey)
 	}
 
-	if *p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.Enabled == false {
-		delete(p.ComponentManifests, types.CStorCSIControllerManifestKey)
+	// the service account, cluster roles and cluster role bindings of the CSI
+	// controller and the CSI node are removed along with the respective
+	// component since these are not shared.
+	if *spec.CstorConfig.CSI.CSIController.Enabled == false {
+		p.removeDisabledManifest(types.CStorCSIControllerManifestKey, spec.CstorConfig.CSI.CSIController.Name)
+		p.removeDisabledManifest(types.CStorCSISnapshottterBindingManifestKey)
+		p.removeDisabledManifest(types.CStorCSISnapshottterRoleManifestKey)
+		p.removeDisabledManifest(types.CStorCSIControllerSAManifestKey)
+		p.removeDisabledManifest(types.CStorCSIProvisionerRoleManifestKey)
+		p.removeDisabledManifest(types.CStorCSIProvisionerBindingManifestKey)
+		p.removeDisabledManifest(types.CStorCSIAttacherRoleManifestKey)
+		p.removeDisabledManifest(types.CStorCSIAttacherBindingManifestKey)
+		p.removeDisabledManifest(types.CStorCSIClusterRegistrarRoleManifestKey)
+		p.removeDisabledManifest(types.CStorCSIClusterRegistrarBindingManifestKey)
 	}
 
-	if *p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.Enabled == false {
-		delete(p.ComponentManifests, types.CStorCSINodeManifestKey)
-		delete(p.ComponentManifests, types.CStorCSIISCSIADMManifestKey)
+	if *spec.CstorConfig.CSI.CSINode.Enabled == false {
+		p.removeDisabledManifest(types.CStorCSINodeManifestKey, spec.CstorConfig.CSI.CSINode.Name)
+		p.removeDisabledManifest(types.CStorCSIISCSIADMManifestKey, spec.CstorConfig.CSI.ISCSIADMConfigmap.Name)
+		p.removeDisabledManifest(types.CStorCSIRegistrarRoleManifestKey)
+		p.removeDisabledManifest(types.CStorCSIRegistrarBindingManifestKey)
+		p.removeDisabledManifest(types.CStorCSINodeSAManifestKey)
 	}
 
-	if *p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Enabled == false {
-		delete(p.ComponentManifests, types.CSPCOperatorManifestKey)
-		delete(p.ComponentManifests, types.CSPCCRDV1alpha1ManifestKey)
-		delete(p.ComponentManifests, types.CSPCCRDV1ManifestKey)
-		delete(p.ComponentManifests, types.CSPICRDV1ManifestKey)
-	}
-	if *p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Enabled == false {
-		delete(p.ComponentManifests, types.CVCOperatorManifestKey)
-	}
-	if *p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Enabled == false &&
-		*p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Enabled == false {
-		delete(p.ComponentManifests, types.CStorAdmissionServerManifestKey)
+	if *spec.CstorConfig.CSPCOperator.Enabled == false {
+		p.removeDisabledManifest(types.CSPCOperatorManifestKey, spec.CstorConfig.CSPCOperator.Name)
+		p.removeDisabledManifest(types.CSPCCRDV1alpha1ManifestKey)
+		p.removeDisabledManifest(types.CSPCCRDV1ManifestKey)
+		p.removeDisabledManifest(types.CSPICRDV1ManifestKey)
+	}
+	if *spec.CstorConfig.CVCOperator.Enabled == false {
+		p.removeDisabledManifest(types.CVCOperatorManifestKey, spec.CstorConfig.CVCOperator.Name)
+		p.removeDisabledManifest(types.CVCOperatorServiceManifestKey, spec.CstorConfig.CVCOperator.Service.Name)
+	}
+	if *spec.CstorConfig.CSPCOperator.Enabled == false &&
+		*spec.CstorConfig.CVCOperator.Enabled == false {
+		p.removeDisabledManifest(types.CStorAdmissionServerManifestKey, spec.CstorConfig.AdmissionServer.Name)
+		// the service account, cluster role and cluster role binding are
+		// shared by the cStor operators.
+		p.removeDisabledManifest(types.OpenEBSCstorOperatorSANameKey + "_" + types.KindServiceAccount)
+		p.removeDisabledManifest(types.OpenEBSCstorOperatorRoleNameKey + "_" + types.KindClusterRole)
+		p.removeDisabledManifest(types.OpenEBSCstorOperatorBindingNameKey + "_" + types.KindClusterRoleBinding)
 	}
 
 	p.removeMayastorManifests()
+	p.removeDisabledComponents()
 	return nil
 }
 
+// removeDisabledManifest removes the manifest with the given key from the
+// component manifests and marks it as disabled.
+//
+// Some of the components can be installed with a custom name, these names
+// can be provided as well so that the installed components can be found.
+func (p *Planner) removeDisabledManifest(key string, names ...string) {
+	if p.DisabledComponentKeys == nil {
+		p.DisabledComponentKeys = make(map[string]bool)
+	}
+	delete(p.ComponentManifests, key)
+	p.DisabledComponentKeys[key] = true
+
+	kind := key[strings.LastIndex(key, "_")+1:]
+	for _, name := range names {
+		if len(name) == 0 {
+			continue
+		}
+		p.DisabledComponentKeys[name+"_"+kind] = true
+	}
+}
+
+// removeDisabledComponents deletes the components which were installed earlier
+// but are disabled now. Only the components that are managed by openebs-upgrade
+// are deleted.
+//
+// NOTE: CustomResourceDefinitions and Namespaces of the disabled components
+// are retained since deleting them will delete the custom resources or the
+// other resources present in them as well.
+func (p *Planner) removeDisabledComponents() {
+	removedComponents := make(map[string]bool)
+	for _, component := range p.ObservedOpenEBSComponents {
+		key := component.GetName() + "_" + component.GetKind()
+		if !p.DisabledComponentKeys[key] {
+			continue
+		}
+		if component.GetKind() == types.KindCustomResourceDefinition ||
+			component.GetKind() == types.KindNamespace {
+			continue
+		}
+		// a component with the same name and kind might still be desired, for
+		// example if a custom name is given for some other component.
+		if _, exist := p.ComponentManifests[key]; exist {
+			continue
+		}
+		if component.GetLabels()[types.OpenEBSUpgradeDAOManagedLabelKey] !=
+			types.OpenEBSUpgradeDAOManagedLabelValue {
+			continue
+		}
+		glog.V(2).Infof(
+			"Will delete disabled component %s %s %s",
+			component.GetKind(), component.GetNamespace(), component.GetName(),
+		)
+		p.ExplicitDeletes = append(p.ExplicitDeletes, component)
+		p.RemovedComponents = append(p.RemovedComponents, types.ComponentReference{
+			Kind:      component.GetKind(),
+			Namespace: component.GetNamespace(),
+			Name:      component.GetName(),
+		})
+		removedComponents[key] = true
+	}
+	// keep reporting the components which were removed in the earlier reconciliations
+	// as long as these are disabled.
+	for _, removedComponent := range p.ObservedOpenEBS.Status.RemovedComponents {
+		key := removedComponent.Name + "_" + removedComponent.Kind
+		if !p.DisabledComponentKeys[key] || removedComponents[key] {
+			continue
+		}
+		p.RemovedComponents = append(p.RemovedComponents, removedComponent)
+		removedComponents[key] = true
+	}
+}
+
 // getDesiredManifests updates all the component's manifest as per the provided
 // or the default values.
 func (p *Planner) getDesiredManifests() error {
@@ -388,128 +440,96 @@ func (p *Planner) getDesiredManifests() error {
 // getDesiredDeployment updates the deployment manifest as per the given configuration.
 func (p *Planner) getDesiredDeployment(deploy *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	var (
-		replicas *int32
-		err      error
+		component *types.Component
+		err       error
 	)
-	nodeSelector := make(map[string]string)
-	tolerations := make([]interface{}, 0)
-	affinity := make(map[string]interface{})
-	resources := make(map[string]interface{})
-	matchLabels := make(map[string]string, 0)
-	podTemplateLabels := make(map[string]string, 0)
+	// containerConfigs are the configurations of the containers of the
+	// component keyed by the container names.
+	var containerConfigs map[string]types.Container
 	// update the namespace
 	deploy.SetNamespace(p.ObservedOpenEBS.Namespace)
 
 	switch deploy.GetName() {
 	case types.MayaAPIServerNameKey:
-		replicas = p.ObservedOpenEBS.Spec.APIServer.Replicas
-		resources = p.ObservedOpenEBS.Spec.APIServer.Resources
-		nodeSelector = p.ObservedOpenEBS.Spec.APIServer.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.APIServer.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.APIServer.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.APIServer.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.APIServer.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.APIServer.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.APIServerContainerKey: p.ObservedOpenEBS.Spec.APIServer.Container,
+		})
 		err = p.updateMayaAPIServer(deploy)
 
 	case types.ProvisionerNameKey:
-		replicas = p.ObservedOpenEBS.Spec.Provisioner.Replicas
-		resources = p.ObservedOpenEBS.Spec.Provisioner.Resources
-		nodeSelector = p.ObservedOpenEBS.Spec.Provisioner.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.Provisioner.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.Provisioner.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.Provisioner.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.Provisioner.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.Provisioner.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.OpenEBSProvisionerContainerKey: p.ObservedOpenEBS.Spec.Provisioner.Container,
+		})
 		err = p.updateOpenEBSProvisioner(deploy)
 
 	case types.SnapshotOperatorNameKey:
-		replicas = p.ObservedOpenEBS.Spec.SnapshotOperator.Replicas
-		resources = p.ObservedOpenEBS.Spec.SnapshotOperator.Resources
-		nodeSelector = p.ObservedOpenEBS.Spec.SnapshotOperator.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.SnapshotOperator.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.SnapshotOperator.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.SnapshotOperator.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.SnapshotOperator.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.SnapshotOperator.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.SnapshotControllerContainerKey:  p.ObservedOpenEBS.Spec.SnapshotOperator.Controller,
+			types.SnapshotProvisionerContainerKey: p.ObservedOpenEBS.Spec.SnapshotOperator.Provisioner,
+		})
 		err = p.updateSnapshotOperator(deploy)
 
 	case types.NDMOperatorNameKey:
-		replicas = p.ObservedOpenEBS.Spec.NDMOperator.Replicas
-		resources = p.ObservedOpenEBS.Spec.NDMOperator.Resources
-		nodeSelector = p.ObservedOpenEBS.Spec.NDMOperator.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.NDMOperator.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.NDMOperator.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.NDMOperator.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.NDMOperator.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.NDMOperator.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.NodeDiskOperatorContainerKey: p.ObservedOpenEBS.Spec.NDMOperator.Container,
+		})
 		err = p.updateNDMOperator(deploy)
 
 	case types.LocalProvisionerNameKey:
-		replicas = p.ObservedOpenEBS.Spec.LocalProvisioner.Replicas
-		resources = p.ObservedOpenEBS.Spec.LocalProvisioner.Resources
-		nodeSelector = p.ObservedOpenEBS.Spec.LocalProvisioner.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.LocalProvisioner.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.LocalProvisioner.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.LocalProvisioner.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.LocalProvisioner.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.LocalProvisioner.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.LocalPVProvisionerContainerKey: p.ObservedOpenEBS.Spec.LocalProvisioner.Container,
+		})
 		err = p.updateLocalProvisioner(deploy)
 
 	case types.AdmissionServerNameKey:
-		replicas = p.ObservedOpenEBS.Spec.AdmissionServer.Replicas
-		resources = p.ObservedOpenEBS.Spec.AdmissionServer.Resources
-		nodeSelector = p.ObservedOpenEBS.Spec.AdmissionServer.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.AdmissionServer.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.AdmissionServer.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.AdmissionServer.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.AdmissionServer.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.AdmissionServer.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.AdmissionServerContainerKey: p.ObservedOpenEBS.Spec.AdmissionServer.Container,
+		})
 		err = p.updateAdmissionServer(deploy)
 
 	case types.CSPCOperatorNameKey:
-		replicas = p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Replicas
-		resources = p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Resources
-		nodeSelector = p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.CSPCOperatorContainerKey: p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Container,
+		})
 		err = p.updateCSPCOperator(deploy)
 
 	case types.CVCOperatorNameKey:
-		replicas = p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Replicas
-		resources = p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Resources
-		nodeSelector = p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.CVCOperatorContainerKey: p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Container,
+		})
 		err = p.updateCVCOperator(deploy)
 
 	case types.CStorAdmissionServerNameKey:
-		replicas = p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.Replicas
-		resources = p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.Resources
-		nodeSelector = p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.AdmissionServerContainerKey: p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.Container,
+		})
 		err = p.updateCStorAdmissionServer(deploy)
 
 	case types.MoacDeploymentNameKey:
-		replicas = p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Replicas
-		resources = p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Resources
-		nodeSelector = p.ObservedOpenEBS.Spec.MayastorConfig.Moac.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.MayastorConfig.Moac.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.MayastorConfig.Moac.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.MoacContainerKey: p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Container,
+		})
 		err = p.updateMoac(deploy)
 
 	case types.NATSDeploymentNameKey:
-		replicas = p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Replicas
-		resources = p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Resources
-		nodeSelector = p.ObservedOpenEBS.Spec.MayastorConfig.NATS.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.MayastorConfig.NATS.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.MayastorConfig.NATS.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.NATSContainerKey: p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Container,
+		})
 		err = p.updateNATS(deploy)
+
+	default:
+		return deploy, errors.Errorf("Unsupported deployment %s", deploy.GetName())
 	}
 	if err != nil {
 		return deploy, err
@@ -518,21 +538,26 @@ func (p *Planner) getDesiredDeployment(deploy *unstructured.Unstructured) (*unst
 	// default value itself is 1.
 	// TODO: Validate the replica count value and throw error or take
 	// some action based on that.
-	if *replicas > 1 {
-		err = unstructured.SetNestedField(deploy.Object, int64(*replicas), "spec", "replicas")
+	if *component.Replicas > 1 {
+		err = unstructured.SetNestedField(deploy.Object, int64(*component.Replicas), "spec", "replicas")
 		if err != nil {
 			return deploy, err
 		}
 	}
+	// update the strategy if set
+	err = setDesiredDeploymentStrategy(deploy, component.Strategy)
+	if err != nil {
+		return deploy, err
+	}
 	// check if matchLabels and podTemplateLabels are present for this component or not,
 	// if yes use the matchLabels defined in the OpenEBS CR.
-	if !(matchLabels == nil || len(matchLabels) == 0) &&
-		!(podTemplateLabels == nil || len(podTemplateLabels) == 0) {
-		err = unstructured.SetNestedStringMap(deploy.Object, matchLabels, "spec", "selector", "matchLabels")
+	if !(component.MatchLabels == nil || len(component.MatchLabels) == 0) &&
+		!(component.PodTemplateLabels == nil || len(component.PodTemplateLabels) == 0) {
+		err = unstructured.SetNestedStringMap(deploy.Object, component.MatchLabels, "spec", "selector", "matchLabels")
 		if err != nil {
 			return deploy, err
 		}
-		err = unstructured.SetNestedStringMap(deploy.Object, podTemplateLabels, "spec", "template", "metadata", "labels")
+		err = unstructured.SetNestedStringMap(deploy.Object, component.PodTemplateLabels, "spec", "template", "metadata", "labels")
 		if err != nil {
 			return deploy, err
 		}
@@ -543,20 +568,23 @@ func (p *Planner) getDesiredDeployment(deploy *unstructured.Unstructured) (*unst
 		return deploy, err
 	}
 	updateContainer := func(obj *unstructured.Unstructured) error {
-		err = unstructured.SetNestedField(obj.Object,
-			p.ObservedOpenEBS.Spec.ImagePullPolicy, "spec", "imagePullPolicy")
+		containerName, _, err := unstructured.NestedString(obj.Object, "spec", "name")
 		if err != nil {
 			return err
 		}
-		if resources != nil {
-			err = unstructured.SetNestedField(obj.Object, resources, "spec", "resources")
-		} else if p.ObservedOpenEBS.Spec.Resources != nil {
-			err = unstructured.SetNestedField(obj.Object, p.ObservedOpenEBS.Spec.Resources, "spec", "resources")
+		// the imagePullPolicy of the container if set otherwise the global one
+		err = unstructured.SetNestedField(obj.Object,
+			p.getImagePullPolicy(containerConfigs[containerName]), "spec", "imagePullPolicy")
+		if err != nil {
+			return err
 		}
+		err = p.setDesiredContainerSecurityContext(deploy, obj, getContainerSecurityContext(
+			containerConfigs[containerName], component.ContainerSecurityContext))
 		if err != nil {
 			return err
 		}
-		return nil
+		return setDesiredContainerResources(obj,
+			p.getContainerResources(containerName, component.ContainerResources, component.Resources))
 	}
 	err = unstruct.SliceIterator(containers).ForEachUpdate(updateContainer)
 	if err != nil {
@@ -567,45 +595,251 @@ func (p *Planner) getDesiredDeployment(deploy *unstructured.Unstructured) (*unst
 	if err != nil {
 		return deploy, err
 	}
+	// set the pod security context once the security contexts of the
+	// containers are set
+	err = p.setDesiredPodSecurityContext(deploy, component.PodSecurityContext)
+	if err != nil {
+		return deploy, err
+	}
 	// update the nodeSelector value
-	if nodeSelector != nil {
-		err = unstructured.SetNestedStringMap(deploy.Object, nodeSelector, "spec",
+	if component.NodeSelector != nil {
+		err = unstructured.SetNestedStringMap(deploy.Object, component.NodeSelector, "spec",
 			"template", "spec", "nodeSelector")
 		if err != nil {
 			return deploy, err
 		}
 	}
 	// update the tolerations if any
-	if len(tolerations) > 0 {
-		err = unstructured.SetNestedSlice(deploy.Object, tolerations, "spec",
+	if len(component.Tolerations) > 0 {
+		err = unstructured.SetNestedSlice(deploy.Object, component.Tolerations, "spec",
 			"template", "spec", "tolerations")
 		if err != nil {
 			return deploy, err
 		}
 	}
 	// update affinity if set
-	if affinity != nil {
-		err = unstructured.SetNestedField(deploy.Object, affinity, "spec",
+	if component.Affinity != nil {
+		err = unstructured.SetNestedField(deploy.Object, component.Affinity, "spec",
 			"template", "spec", "affinity")
 		if err != nil {
 			return deploy, err
 		}
 	}
+	// update the topologySpreadConstraints if any
+	if len(component.TopologySpreadConstraints) > 0 {
+		err = unstructured.SetNestedSlice(deploy.Object, component.TopologySpreadConstraints, "spec",
+			"template", "spec", "topologySpreadConstraints")
+		if err != nil {
+			return deploy, err
+		}
+	}
 	// update pod version label
 	err = p.updatePodTemplateVersionLabel(deploy)
 	if err != nil {
 		return deploy, err
 	}
-	// create annotations that refers to the instance which
-	// triggered creation of this deployment
-	deploy.SetAnnotations(
-		map[string]string{
-			types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.GetUID()),
-		},
-	)
+	// merge the pod annotations of the component with the existing ones
+	err = setDesiredPodAnnotations(deploy, component.PodAnnotations)
+	if err != nil {
+		return deploy, err
+	}
+	// set the imagePullSecrets of the component otherwise the global ones
+	err = setDesiredImagePullSecrets(deploy, p.getImagePullSecrets(component.ImagePullSecrets),
+		"spec", "template", "spec", "imagePullSecrets")
+	if err != nil {
+		return deploy, err
+	}
+	// merge the annotations of the component and the annotation that refers
+	// to the instance which triggered creation of this deployment with the
+	// existing ones
+	p.setDesiredAnnotations(deploy, component.Annotations)
+	// the PodDisruptionBudget is created once the selector of the deployment
+	// is set
+	err = p.addDesiredPodDisruptionBudget(deploy, *component.Replicas, component.PodDisruptionBudget)
+	if err != nil {
+		return deploy, err
+	}
 	return deploy, nil
 }
 
+// setDesiredAnnotations merges the given annotations of the component and the
+// annotation that refers to the OpenEBS instance which triggered creation of
+// the component with the existing annotations of the given manifest so that
+// the annotations of the templates are retained.
+//
+// The annotations of the observed component which are set by others such as
+// deployment.kubernetes.io/revision or the ones set by injectors are retained
+// as well. The keys of the annotations desired by openebs-upgrade are recorded
+// on the component so that such an annotation gets removed once it is no
+// longer desired instead of being retained as an annotation set by others.
+func (p *Planner) setDesiredAnnotations(obj *unstructured.Unstructured, annotations map[string]string) {
+	desiredAnnotations := obj.GetAnnotations()
+	if desiredAnnotations == nil {
+		desiredAnnotations = make(map[string]string, 0)
+	}
+	for key, value := range annotations {
+		desiredAnnotations[key] = value
+	}
+	desiredKeys := make([]string, 0, len(desiredAnnotations))
+	for key := range desiredAnnotations {
+		desiredKeys = append(desiredKeys, key)
+	}
+	sort.Strings(desiredKeys)
+
+	if observed := p.getObservedComponent(obj); observed != nil {
+		observedAnnotations := observed.GetAnnotations()
+		previouslyDesiredKeys := make(map[string]bool)
+		for _, key := range strings.Split(observedAnnotations[types.AnnKeyDesiredAnnotations], ",") {
+			previouslyDesiredKeys[key] = true
+		}
+		for key, value := range observedAnnotations {
+			if isOperatorOwnedAnnotation(key) || previouslyDesiredKeys[key] {
+				continue
+			}
+			if _, exist := desiredAnnotations[key]; !exist {
+				desiredAnnotations[key] = value
+			}
+		}
+	}
+	if len(desiredKeys) > 0 {
+		desiredAnnotations[types.AnnKeyDesiredAnnotations] = strings.Join(desiredKeys, ",")
+	}
+	desiredAnnotations[types.AnnKeyOpenEBSUID] = string(p.ObservedOpenEBS.GetUID())
+	obj.SetAnnotations(desiredAnnotations)
+}
+
+// getObservedComponent returns the observed component with the same kind,
+// namespace and name as the given component if any.
+func (p *Planner) getObservedComponent(component *unstructured.Unstructured) *unstructured.Unstructured {
+	key := getComponentKey(component)
+	for _, observed := range p.ObservedOpenEBSComponents {
+		if getComponentKey(observed) == key {
+			return observed
+		}
+	}
+	return nil
+}
+
+// isOperatorOwnedAnnotation returns true if the given annotation key is
+// managed by openebs-upgrade, metac or kubectl, such annotations of the
+// observed components are not retained since these are set again if needed.
+func isOperatorOwnedAnnotation(key string) bool {
+	return strings.HasPrefix(key, types.AnnotationPrefix+"/") ||
+		strings.HasPrefix(key, metacAnnotationPrefix) ||
+		key == v1.LastAppliedConfigAnnotation
+}
+
+// setDesiredPodAnnotations merges the given pod annotations of the component
+// with the existing annotations of the pod template of the given workload.
+func setDesiredPodAnnotations(obj *unstructured.Unstructured, podAnnotations map[string]string) error {
+	if len(podAnnotations) == 0 {
+		return nil
+	}
+	desiredPodAnnotations, _, err := unstructured.NestedStringMap(obj.Object,
+		"spec", "template", "metadata", "annotations")
+	if err != nil {
+		return err
+	}
+	if desiredPodAnnotations == nil {
+		desiredPodAnnotations = make(map[string]string, 0)
+	}
+	for key, value := range podAnnotations {
+		desiredPodAnnotations[key] = value
+	}
+	return unstructured.SetNestedStringMap(obj.Object, desiredPodAnnotations,
+		"spec", "template", "metadata", "annotations")
+}
+
+// getContainerResources returns the resources of the given container of a
+// component i.e., the resources set for this container in the component if
+// any otherwise the resources of the component and then the global ones.
+func (p *Planner) getContainerResources(containerName string,
+	containerResources map[string]map[string]interface{},
+	componentResources map[string]interface{}) map[string]interface{} {
+	if resources, exist := containerResources[containerName]; exist {
+		return resources
+	}
+	if componentResources != nil {
+		return componentResources
+	}
+	return p.ObservedOpenEBS.Spec.Resources
+}
+
+// setDesiredContainerResources sets the given resources in the given
+// container if any.
+func setDesiredContainerResources(container *unstructured.Unstructured, resources map[string]interface{}) error {
+	if resources == nil {
+		return nil
+	}
+	return unstructured.SetNestedField(container.Object, resources, "spec", "resources")
+}
+
+// getContainersByName returns the given configurations of the containers of
+// a component, keyed by the default container names, keyed by the names with
+// which the containers are deployed i.e., containerName if set.
+func getContainersByName(containers map[string]types.Container) map[string]types.Container {
+	containersByName := make(map[string]types.Container, len(containers))
+	for defaultName, container := range containers {
+		if container.ContainerName != "" {
+			containersByName[container.ContainerName] = container
+		} else {
+			containersByName[defaultName] = container
+		}
+	}
+	return containersByName
+}
+
+// getImagePullSecrets returns the given imagePullSecrets of a component if
+// set otherwise the global imagePullSecrets.
+func (p *Planner) getImagePullSecrets(componentSecrets []types.ImagePullSecret) []types.ImagePullSecret {
+	if len(componentSecrets) > 0 {
+		return componentSecrets
+	}
+	return p.ObservedOpenEBS.Spec.ImagePullSecrets
+}
+
+// setDesiredImagePullSecrets sets the given imagePullSecrets at the given
+// path of the given manifest such as the pod spec of a workload or a
+// ServiceAccount.
+func setDesiredImagePullSecrets(obj *unstructured.Unstructured,
+	imagePullSecrets []types.ImagePullSecret, fields ...string) error {
+	if len(imagePullSecrets) == 0 {
+		return nil
+	}
+	desiredImagePullSecrets := make([]interface{}, 0)
+	for _, secret := range imagePullSecrets {
+		desiredImagePullSecrets = append(desiredImagePullSecrets,
+			map[string]interface{}{"name": secret.Name})
+	}
+	return unstructured.SetNestedSlice(obj.Object, desiredImagePullSecrets, fields...)
+}
+
+// setImagePullSecretsEnv sets the OPENEBS_IO_IMAGE_PULL_SECRETS env, which is
+// used by the OpenEBS control plane components to pass the imagePullSecrets
+// to the data plane pods launched by them, in the given envs. The env is
+// added if not present since it is commented out in the operator YAMLs.
+func setImagePullSecretsEnv(envs []interface{}, imagePullSecrets []types.ImagePullSecret) []interface{} {
+	if len(imagePullSecrets) == 0 {
+		return envs
+	}
+	var names []string
+	for _, secret := range imagePullSecrets {
+		names = append(names, secret.Name)
+	}
+	value := strings.Join(names, ",")
+	for _, env := range envs {
+		envMap, ok := env.(map[string]interface{})
+		if ok && envMap["name"] == types.ImagePullSecretsEnvKey {
+			envMap["value"] = value
+			return envs
+		}
+	}
+	return append(envs, map[string]interface{}{
+		"name":  types.ImagePullSecretsEnvKey,
+		"value": value,
+	})
+}
+
 // getDesiredConfigmap updates the configmap manifest as per the given configuration.
 func (p *Planner) getDesiredConfigmap(configmap *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	var err error
@@ -620,13 +854,9 @@ func (p *Planner) getDesiredConfigmap(configmap *unstructured.Unstructured) (*un
 	if err != nil {
 		return configmap, err
 	}
-	// create annotations that refers to the instance which
-	// triggered creation of this ConfigMap
-	configmap.SetAnnotations(
-		map[string]string{
-			types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.GetUID()),
-		},
-	)
+	// add the annotation that refers to the instance which triggered
+	// creation of this ConfigMap to the existing ones
+	p.setDesiredAnnotations(configmap, nil)
 	return configmap, nil
 }
 
@@ -647,13 +877,9 @@ func (p *Planner) getDesiredService(svc *unstructured.Unstructured) (*unstructur
 	if err != nil {
 		return svc, err
 	}
-	// create annotations that refers to the instance which
-	// triggered creation of this Service
-	svc.SetAnnotations(
-		map[string]string{
-			types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.GetUID()),
-		},
-	)
+	// add the annotation that refers to the instance which triggered
+	// creation of this Service to the existing ones
+	p.setDesiredAnnotations(svc, nil)
 	return svc, nil
 }
 
@@ -662,51 +888,79 @@ func (p *Planner) getDesiredDaemonSet(daemon *unstructured.Unstructured) (*unstr
 	var (
 		err error
 	)
+	// component is the configuration of the component of this daemonset,
+	// its nodeSelector, tolerations and affinity are set only for NDM and
+	// the ISCSI client setup.
+	component := &types.Component{}
 	nodeSelector := make(map[string]string)
 	tolerations := make([]interface{}, 0)
 	affinity := make(map[string]interface{})
-	matchLabels := make(map[string]string, 0)
-	podTemplateLabels := make(map[string]string, 0)
+	// containerConfigs are the configurations of the containers of the
+	// component keyed by the container names.
+	containerConfigs := make(map[string]types.Container)
 
 	daemon.SetNamespace(p.ObservedOpenEBS.Namespace)
 	switch daemon.GetName() {
 	case types.NDMNameKey:
-		nodeSelector = p.ObservedOpenEBS.Spec.NDMDaemon.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.NDMDaemon.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.NDMDaemon.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.NDMDaemon.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.NDMDaemon.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.NDMDaemon.Component
+		nodeSelector = component.NodeSelector
+		tolerations = component.Tolerations
+		affinity = component.Affinity
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.NDMDaemonContainerKey: p.ObservedOpenEBS.Spec.NDMDaemon.Container,
+		})
 		err = p.updateNDM(daemon)
 	case types.CStorCSINodeNameKey:
-		matchLabels = p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.CStorCSINodeCSIPluginContainerKey: p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.Container,
+		})
 		err = p.updateOpenEBSCStorCSINode(daemon)
 	case types.MayastorDaemonsetNameKey:
-		matchLabels = p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.MayastorContainerKey:     p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Mayastor,
+			types.MayastorGRPCContainerKey: p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.MayastorGRPC,
+		})
 		err = p.updateMayastor(daemon)
 	case types.MayastorCSIDaemonsetNameKey:
-		matchLabels = p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.MayastorCSIContainerKey: p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Container,
+		})
 		err = p.updateMayastorCSI(daemon)
 	case types.OpenEBSNodeSetupDaemonsetNameKey:
-		nodeSelector = p.ObservedOpenEBS.Spec.PreInstallation.ISCSIClient.NodeSelector
-		tolerations = p.ObservedOpenEBS.Spec.PreInstallation.ISCSIClient.Tolerations
-		affinity = p.ObservedOpenEBS.Spec.PreInstallation.ISCSIClient.Affinity
-		matchLabels = p.ObservedOpenEBS.Spec.PreInstallation.ISCSIClient.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.PreInstallation.ISCSIClient.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.PreInstallation.ISCSIClient.Component
+		nodeSelector = component.NodeSelector
+		tolerations = component.Tolerations
+		affinity = component.Affinity
 	}
 	if err != nil {
 		return daemon, err
 	}
+	// update the updateStrategy if set
+	err = setDesiredDaemonSetUpdateStrategy(daemon, component.UpdateStrategy)
+	if err != nil {
+		return daemon, err
+	}
 	// update the daemonset containers with the images and imagePullPolicy
 	containers, err := unstruct.GetNestedSliceOrError(daemon, "spec", "template", "spec", "containers")
 	if err != nil {
 		return daemon, err
 	}
 	updateContainer := func(obj *unstructured.Unstructured) error {
+		containerName, _, err := unstructured.NestedString(obj.Object, "spec", "name")
+		if err != nil {
+			return err
+		}
+		// the imagePullPolicy of the container if set otherwise the global one
 		err = unstructured.SetNestedField(obj.Object,
-			p.ObservedOpenEBS.Spec.ImagePullPolicy, "spec", "imagePullPolicy")
+			p.getImagePullPolicy(containerConfigs[containerName]), "spec", "imagePullPolicy")
+		if err != nil {
+			return err
+		}
+		err = p.setDesiredContainerSecurityContext(daemon, obj, getContainerSecurityContext(
+			containerConfigs[containerName], component.ContainerSecurityContext))
 		if err != nil {
 			return err
 		}
@@ -721,6 +975,12 @@ func (p *Planner) getDesiredDaemonSet(daemon *unstructured.Unstructured) (*unstr
 	if err != nil {
 		return daemon, err
 	}
+	// set the pod security context once the security contexts of the
+	// containers are set
+	err = p.setDesiredPodSecurityContext(daemon, component.PodSecurityContext)
+	if err != nil {
+		return daemon, err
+	}
 	// update the nodeSelector value
 	if nodeSelector != nil {
 		err = unstructured.SetNestedStringMap(daemon.Object, nodeSelector, "spec",
@@ -747,13 +1007,13 @@ func (p *Planner) getDesiredDaemonSet(daemon *unstructured.Unstructured) (*unstr
 	}
 	// check if matchLabels is present for this component or not, if yes use the matchLabels defined
 	// in the OpenEBS CR.
-	if !(matchLabels == nil || len(matchLabels) == 0) &&
-		!(podTemplateLabels == nil || len(podTemplateLabels) == 0) {
-		err = unstructured.SetNestedStringMap(daemon.Object, matchLabels, "spec", "selector", "matchLabels")
+	if !(component.MatchLabels == nil || len(component.MatchLabels) == 0) &&
+		!(component.PodTemplateLabels == nil || len(component.PodTemplateLabels) == 0) {
+		err = unstructured.SetNestedStringMap(daemon.Object, component.MatchLabels, "spec", "selector", "matchLabels")
 		if err != nil {
 			return daemon, err
 		}
-		err = unstructured.SetNestedStringMap(daemon.Object, podTemplateLabels, "spec", "template", "metadata", "labels")
+		err = unstructured.SetNestedStringMap(daemon.Object, component.PodTemplateLabels, "spec", "template", "metadata", "labels")
 		if err != nil {
 			return daemon, err
 		}
@@ -763,13 +1023,21 @@ func (p *Planner) getDesiredDaemonSet(daemon *unstructured.Unstructured) (*unstr
 	if err != nil {
 		return daemon, err
 	}
-	// create annotations that refers to the instance which
-	// triggered creation of this DaemonSet
-	daemon.SetAnnotations(
-		map[string]string{
-			types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.GetUID()),
-		},
-	)
+	// merge the pod annotations of the component with the existing ones
+	err = setDesiredPodAnnotations(daemon, component.PodAnnotations)
+	if err != nil {
+		return daemon, err
+	}
+	// set the imagePullSecrets of the component otherwise the global ones
+	err = setDesiredImagePullSecrets(daemon, p.getImagePullSecrets(component.ImagePullSecrets),
+		"spec", "template", "spec", "imagePullSecrets")
+	if err != nil {
+		return daemon, err
+	}
+	// merge the annotations of the component and the annotation that refers
+	// to the instance which triggered creation of this DaemonSet with the
+	// existing ones
+	p.setDesiredAnnotations(daemon, component.Annotations)
 
 	return daemon, nil
 }
@@ -777,26 +1045,64 @@ func (p *Planner) getDesiredDaemonSet(daemon *unstructured.Unstructured) (*unstr
 // getDesiredStatefulSet updates the statefulset manifest as per the given configuration.
 func (p *Planner) getDesiredStatefulSet(statefulset *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	var err error
-	matchLabels := make(map[string]string, 0)
-	podTemplateLabels := make(map[string]string, 0)
+	component := &types.Component{}
+	// containerConfigs are the configurations of the containers of the
+	// component keyed by the container names.
+	containerConfigs := make(map[string]types.Container)
 	switch statefulset.GetName() {
 	case types.CStorCSIControllerNameKey:
-		matchLabels = p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.MatchLabels
-		podTemplateLabels = p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.PodTemplateLabels
+		component = &p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.Component
+		containerConfigs = getContainersByName(map[string]types.Container{
+			types.CStorCSIControllerCSIPluginContainerKey: p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.Container,
+		})
 		err = p.updateOpenEBSCStorCSIController(statefulset)
 		if err != nil {
 			return statefulset, err
 		}
 	}
+	// update the statefulset containers with the imagePullPolicy
+	containers, err := unstruct.GetNestedSliceOrError(statefulset, "spec", "template", "spec", "containers")
+	if err != nil {
+		return statefulset, err
+	}
+	updateContainer := func(obj *unstructured.Unstructured) error {
+		containerName, _, err := unstructured.NestedString(obj.Object, "spec", "name")
+		if err != nil {
+			return err
+		}
+		// the imagePullPolicy of the container if set otherwise the global one
+		err = unstructured.SetNestedField(obj.Object,
+			p.getImagePullPolicy(containerConfigs[containerName]), "spec", "imagePullPolicy")
+		if err != nil {
+			return err
+		}
+		return p.setDesiredContainerSecurityContext(statefulset, obj, getContainerSecurityContext(
+			containerConfigs[containerName], component.ContainerSecurityContext))
+	}
+	err = unstruct.SliceIterator(containers).ForEachUpdate(updateContainer)
+	if err != nil {
+		return statefulset, err
+	}
+	err = unstructured.SetNestedSlice(statefulset.Object, containers, "spec",
+		"template", "spec", "containers")
+	if err != nil {
+		return statefulset, err
+	}
+	// set the pod security context once the security contexts of the
+	// containers are set
+	err = p.setDesiredPodSecurityContext(statefulset, component.PodSecurityContext)
+	if err != nil {
+		return statefulset, err
+	}
 	// check if matchLabels is present for this component or not, if yes use the matchLabels defined
 	// in the OpenEBS CR.
-	if !(matchLabels == nil || len(matchLabels) == 0) &&
-		!(podTemplateLabels == nil || len(podTemplateLabels) == 0) {
-		err = unstructured.SetNestedStringMap(statefulset.Object, matchLabels, "spec", "selector", "matchLabels")
+	if !(component.MatchLabels == nil || len(component.MatchLabels) == 0) &&
+		!(component.PodTemplateLabels == nil || len(component.PodTemplateLabels) == 0) {
+		err = unstructured.SetNestedStringMap(statefulset.Object, component.MatchLabels, "spec", "selector", "matchLabels")
 		if err != nil {
 			return statefulset, err
 		}
-		err = unstructured.SetNestedStringMap(statefulset.Object, podTemplateLabels, "spec", "template", "metadata", "labels")
+		err = unstructured.SetNestedStringMap(statefulset.Object, component.PodTemplateLabels, "spec", "template", "metadata", "labels")
 		if err != nil {
 			return statefulset, err
 		}
@@ -806,13 +1112,21 @@ func (p *Planner) getDesiredStatefulSet(statefulset *unstructured.Unstructured)
 	if err != nil {
 		return statefulset, err
 	}
-	// create annotations that refers to the instance which
-	// triggered creation of this StatefulSet
-	statefulset.SetAnnotations(
-		map[string]string{
-			types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.GetUID()),
-		},
-	)
+	// merge the pod annotations of the component with the existing ones
+	err = setDesiredPodAnnotations(statefulset, component.PodAnnotations)
+	if err != nil {
+		return statefulset, err
+	}
+	// set the imagePullSecrets of the component otherwise the global ones
+	err = setDesiredImagePullSecrets(statefulset, p.getImagePullSecrets(component.ImagePullSecrets),
+		"spec", "template", "spec", "imagePullSecrets")
+	if err != nil {
+		return statefulset, err
+	}
+	// merge the annotations of the component and the annotation that refers
+	// to the instance which triggered creation of this StatefulSet with the
+	// existing ones
+	p.setDesiredAnnotations(statefulset, component.Annotations)
 
 	return statefulset, nil
 }
@@ -833,13 +1147,9 @@ func (p *Planner) getDesiredCSIDriver(driver *unstructured.Unstructured) (*unstr
 		types.CStorCSIDriverNameKey
 	// set the desired labels
 	driver.SetLabels(desiredLabels)
-	// create annotations that refers to the instance which
-	// triggered creation of this CSIDriver
-	driver.SetAnnotations(
-		map[string]string{
-			types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.GetUID()),
-		},
-	)
+	// add the annotation that refers to the instance which triggered
+	// creation of this CSIDriver to the existing ones
+	p.setDesiredAnnotations(driver, nil)
 	// add volumeLifeCycleModes field based on k8s version.
 	// get the kubernetes version.
 	k8sVersion, err := k8s.GetK8sVersion()
@@ -847,7 +1157,7 @@ func (p *Planner) getDesiredCSIDriver(driver *unstructured.Unstructured) (*unstr
 		return driver, errors.Errorf("Unable to find kubernetes version, error: %v", err)
 	}
 	// compare the kubernetes version with the supported version of K8S for adding volumeLifeCycleModes.
-	comp, err := compareVersion(k8sVersion, types.K8sVersion1170)
+	comp, err := compareVersion(getKubernetesReleaseVersion(k8sVersion), types.K8sVersion1170)
 	if err != nil {
 		return driver, errors.Errorf("Error comparing versions, error: %v", err)
 	}
@@ -867,51 +1177,128 @@ func (p *Planner) getDesiredCSIDriver(driver *unstructured.Unstructured) (*unstr
 	return driver, nil
 }
 
+// enterpriseEditionSuffix is the suffix of the enterprise edition of an
+// OpenEBS release such as 1.10.0-ee, it is ordered the same as the release.
+const enterpriseEditionSuffix = "ee"
+
 // compareVersion compares given version i.e v1 and v2.
 // It returns -1 if v1 is less than v2, 0 if v1 equal to v2, 1 if v1 is greater than v2.
 // It returns -2 in case of any error with error.
+//
+// The versions are ordered as per semantic versioning i.e., a pre-release
+// such as 2.9.0-RC1 is less than 2.9.0 and the build metadata such as +k3s1
+// is ignored. The enterprise edition such as 1.10.0-ee or 1.10.0-ee-RC1 is
+// ordered the same as 1.10.0 or 1.10.0-RC1 respectively.
 func compareVersion(v1, v2 string) (int, error) {
+	v1Release, v1PreRelease, err := parseVersion(v1)
+	if err != nil {
+		return -2, err
+	}
+	v2Release, v2PreRelease, err := parseVersion(v2)
+	if err != nil {
+		return -2, err
+	}
+	for i := 0; i < len(v1Release) || i < len(v2Release); i++ {
+		var v1Part, v2Part int
+		if i < len(v1Release) {
+			v1Part = v1Release[i]
+		}
+		if i < len(v2Release) {
+			v2Part = v2Release[i]
+		}
+		if v1Part < v2Part {
+			return -1, nil
+		} else if v1Part > v2Part {
+			return 1, nil
+		}
+	}
+	// a pre-release is less than the release
+	switch {
+	case len(v1PreRelease) == 0 && len(v2PreRelease) == 0:
+		return 0, nil
+	case len(v1PreRelease) == 0:
+		return 1, nil
+	case len(v2PreRelease) == 0:
+		return -1, nil
+	}
+	for i := 0; i < len(v1PreRelease) && i < len(v2PreRelease); i++ {
+		if res := comparePreReleaseIdentifier(v1PreRelease[i], v2PreRelease[i]); res != 0 {
+			return res, nil
+		}
+	}
+	if len(v1PreRelease) < len(v2PreRelease) {
+		return -1, nil
+	} else if len(v1PreRelease) > len(v2PreRelease) {
+		return 1, nil
+	}
+	return 0, nil
+}
 
-	// removes alphabets from the version.
-	reg := regexp.MustCompile("[^\\d.]")
-	v1 = reg.ReplaceAllString(v1, "")
-	v2 = reg.ReplaceAllString(v2, "")
-
-	v1Array := strings.Split(v1, ".")
-	v2Array := strings.Split(v2, ".")
+// parseVersion returns the numeric parts of the release and the identifiers
+// of the pre-release, if any, of the given version for example, [1 10 0] and
+// [RC1] for v1.10.0-ee-RC1+build.1.
+func parseVersion(version string) ([]int, []string, error) {
+	v := strings.TrimPrefix(version, "v")
+	if i := strings.Index(v, "+"); i != -1 {
+		v = v[:i]
+	}
+	release := v
+	var preRelease []string
+	if i := strings.Index(v, "-"); i != -1 {
+		release = v[:i]
+		preRelease = strings.FieldsFunc(v[i+1:], func(r rune) bool {
+			return r == '-' || r == '.'
+		})
+		if len(preRelease) == 0 {
+			return nil, nil, errors.Errorf("Invalid version %q: empty pre-release", version)
+		}
+		if preRelease[0] == enterpriseEditionSuffix {
+			preRelease = preRelease[1:]
+		}
+	}
+	var releaseParts []int
+	for _, part := range strings.Split(release, ".") {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return nil, nil, errors.Errorf("Invalid version %q: %q is not a number", version, part)
+		}
+		releaseParts = append(releaseParts, number)
+	}
+	return releaseParts, preRelease, nil
+}
 
-	for i := 0; i < len(v1Array) || i < len(v2Array); i++ {
-		if i < len(v1Array) && i < len(v2Array) {
-			v1, err := strconv.Atoi(v1Array[i])
-			v2, err := strconv.Atoi(v2Array[i])
-			if err != nil {
-				return -2, err
-			}
-			if v1 < v2 {
-				return -1, nil
-			} else if v1 > v2 {
-				return 1, nil
-			}
-		} else if i < len(v1Array) {
-			v1, err := strconv.Atoi(v1Array[i])
-			if err != nil {
-				return -2, err
-			}
-			if v1 != 0 {
-				return 1, nil
-			}
-		} else if i < len(v2Array) {
-			v2, err := strconv.Atoi(v2Array[i])
-			if err != nil {
-				return -2, err
-			}
-			if v2 != 0 {
-				return -1, nil
-			}
+// comparePreReleaseIdentifier compares the given pre-release identifiers, the
+// numbers are compared numerically and the identifiers with a numeric suffix
+// such as RC2 and RC10 are compared by their prefix followed by the number.
+func comparePreReleaseIdentifier(id1, id2 string) int {
+	prefix1, number1, hasNumber1 := splitNumericSuffix(id1)
+	prefix2, number2, hasNumber2 := splitNumericSuffix(id2)
+	if hasNumber1 && hasNumber2 && prefix1 == prefix2 {
+		if number1 < number2 {
+			return -1
+		} else if number1 > number2 {
+			return 1
 		}
+		return 0
 	}
+	return strings.Compare(id1, id2)
+}
 
-	return 0, nil
+// splitNumericSuffix splits the given identifier into its prefix and its
+// numeric suffix if any for example, RC and 10 for RC10.
+func splitNumericSuffix(id string) (string, int, bool) {
+	i := len(id)
+	for i > 0 && id[i-1] >= '0' && id[i-1] <= '9' {
+		i--
+	}
+	if i == len(id) {
+		return id, 0, false
+	}
+	number, err := strconv.Atoi(id[i:])
+	if err != nil {
+		return id, 0, false
+	}
+	return id[:i], number, true
 }
 
 // updatePodTemplateVersionLabel updates the version label of pod template present in the deployment,
@@ -0,0 +1,878 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+// getComponentKeys returns the sorted keys i.e., name_kind of the given
+// components.
+func getComponentKeys(components []*unstructured.Unstructured) []string {
+	var keys []string
+	for _, component := range components {
+		keys = append(keys, component.GetName()+"_"+component.GetKind())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestRemoveDisabledManifests(t *testing.T) {
+	enabled := true
+	disabled := false
+	var tests = map[string]struct {
+		disable        func(components *types.Components)
+		observed       []*unstructured.Unstructured
+		removedInPast  []types.ComponentReference
+		expectDeletes  []string
+		expectRemoved  []string
+		expectRetained []string
+	}{
+		"all the components are enabled": {
+			disable: func(components *types.Components) {},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":      "maya-apiserver",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindServiceAccount,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-cstor-csi-controller-sa",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectRetained: []string{types.MayaAPIServerManifestKey, types.CStorCSIControllerSAManifestKey},
+		},
+		"disabled component which is managed is deleted": {
+			disable: func(components *types.Components) {
+				components.APIServer.Enabled = &disabled
+			},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":      "maya-apiserver",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-provisioner",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectDeletes:  []string{types.MayaAPIServerManifestKey},
+			expectRemoved:  []string{types.MayaAPIServerManifestKey},
+			expectRetained: []string{types.ProvisionerManifestKey},
+		},
+		"disabled component which is not managed is not deleted": {
+			disable: func(components *types.Components) {
+				components.APIServer.Enabled = &disabled
+			},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":      "maya-apiserver",
+							"namespace": "openebs",
+						},
+					},
+				},
+			},
+		},
+		"disabled component with a custom name is deleted": {
+			disable: func(components *types.Components) {
+				components.Provisioner.Enabled = &disabled
+				components.Provisioner.Name = "my-provisioner"
+			},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":      "my-provisioner",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectDeletes: []string{"my-provisioner_" + types.KindDeployment},
+			expectRemoved: []string{"my-provisioner_" + types.KindDeployment},
+		},
+		"rbac of the disabled CSI controller is deleted while the CRDs are retained": {
+			disable: func(components *types.Components) {
+				components.CstorConfig.CSI.CSIController.Enabled = &disabled
+			},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindStatefulset,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-cstor-csi-controller",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindServiceAccount,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-cstor-csi-controller-sa",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindClusterRole,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-cstor-csi-provisioner-role",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindClusterRoleBinding,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-cstor-csi-provisioner-binding",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindServiceAccount,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-cstor-csi-node-sa",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindCustomResourceDefinition,
+						"metadata": map[string]interface{}{
+							"name":      "csivolumes.openebs.io",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectDeletes: []string{
+				types.CStorCSIControllerManifestKey,
+				types.CStorCSIControllerSAManifestKey,
+				types.CStorCSIProvisionerRoleManifestKey,
+				types.CStorCSIProvisionerBindingManifestKey,
+			},
+			expectRemoved: []string{
+				types.CStorCSIControllerManifestKey,
+				types.CStorCSIControllerSAManifestKey,
+				types.CStorCSIProvisionerRoleManifestKey,
+				types.CStorCSIProvisionerBindingManifestKey,
+			},
+			expectRetained: []string{types.CStorCSINodeSAManifestKey, types.CSIVolumeCRDManifestKey},
+		},
+		"rbac of the disabled CSI node is deleted": {
+			disable: func(components *types.Components) {
+				components.CstorConfig.CSI.CSINode.Enabled = &disabled
+			},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindServiceAccount,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-cstor-csi-node-sa",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindClusterRole,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-cstor-csi-registrar-role",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindServiceAccount,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-cstor-csi-controller-sa",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectDeletes: []string{
+				types.CStorCSINodeSAManifestKey,
+				types.CStorCSIRegistrarRoleManifestKey,
+			},
+			expectRemoved: []string{
+				types.CStorCSINodeSAManifestKey,
+				types.CStorCSIRegistrarRoleManifestKey,
+			},
+			expectRetained: []string{types.CStorCSIControllerSAManifestKey},
+		},
+		"shared rbac of cStor operators is deleted only if both are disabled": {
+			disable: func(components *types.Components) {
+				components.CstorConfig.CSPCOperator.Enabled = &disabled
+			},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":      "cspc-operator",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindServiceAccount,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-cstor-operator",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindCustomResourceDefinition,
+						"metadata": map[string]interface{}{
+							"name":      "cstorpoolclusters.cstor.openebs.io",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectDeletes:  []string{types.CSPCOperatorManifestKey},
+			expectRemoved:  []string{types.CSPCOperatorManifestKey},
+			expectRetained: []string{types.OpenEBSCstorOperatorSANameKey + "_" + types.KindServiceAccount},
+		},
+		"namespaces and CRDs of the disabled components are retained": {
+			disable: func(components *types.Components) {
+				components.MayastorConfig.Moac.Enabled = &disabled
+				components.MayastorConfig.Mayastor.Enabled = &disabled
+			},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindNamespace,
+						"metadata": map[string]interface{}{
+							"name":      "mayastor",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindCustomResourceDefinition,
+						"metadata": map[string]interface{}{
+							"name":      "mayastorpools.openebs.io",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindServiceAccount,
+						"metadata": map[string]interface{}{
+							"name":      "moac",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectDeletes: []string{types.MoacSAManifestKey},
+			expectRemoved: []string{types.MoacSAManifestKey},
+		},
+		"components removed in the past are reported while disabled": {
+			disable: func(components *types.Components) {
+				components.APIServer.Enabled = &disabled
+			},
+			removedInPast: []types.ComponentReference{
+				{Kind: types.KindDeployment, Namespace: "openebs", Name: types.MayaAPIServerNameKey},
+				{Kind: types.KindDeployment, Namespace: "openebs", Name: types.ProvisionerNameKey},
+			},
+			expectRemoved: []string{types.MayaAPIServerManifestKey},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			components := types.Components{
+				APIServer: &types.APIServer{
+					Component: types.Component{Enabled: &enabled},
+					Service:   &types.APIServerService{},
+				},
+				Provisioner:      &types.Provisioner{Component: types.Component{Enabled: &enabled}},
+				LocalProvisioner: &types.LocalProvisioner{Component: types.Component{Enabled: &enabled}},
+				SnapshotOperator: &types.SnapshotOperator{Component: types.Component{Enabled: &enabled}},
+				AdmissionServer:  &types.AdmissionServer{Component: types.Component{Enabled: &enabled}},
+				NDMDaemon:        &types.NDMDaemon{Component: types.Component{Enabled: &enabled}},
+				NDMOperator:      &types.NDMOperator{Component: types.Component{Enabled: &enabled}},
+				NDMConfigMap:     &types.NDMConfigMap{},
+				CstorConfig: &types.CstorConfig{
+					CSPCOperator: &types.CSPCOperator{Component: types.Component{Enabled: &enabled}},
+					CVCOperator: &types.CVCOperator{
+						Component: types.Component{Enabled: &enabled},
+						Service:   &types.CVCOperatorService{},
+					},
+					CSI: types.CSI{
+						CSIController: types.CSIController{Component: types.Component{Enabled: &enabled}},
+						CSINode:       types.CSINode{Component: types.Component{Enabled: &enabled}},
+					},
+					AdmissionServer: &types.CStorAdmissionServer{Component: types.Component{Enabled: &enabled}},
+				},
+				MayastorConfig: &types.MayastorConfig{
+					Moac:        types.Moac{Component: types.Component{Enabled: &enabled}},
+					Mayastor:    types.Mayastor{Component: types.Component{Enabled: &enabled}},
+					MayastorCSI: types.MayastorCSI{Component: types.Component{Enabled: &enabled}},
+					NATS:        types.NATS{Component: types.Component{Enabled: &enabled}},
+				},
+			}
+			mock.disable(&components)
+			p := &Planner{
+				ObservedOpenEBS: &types.OpenEBS{
+					Spec:   types.OpenEBSSpec{Components: components},
+					Status: types.OpenEBSStatus{RemovedComponents: mock.removedInPast},
+				},
+				ObservedOpenEBSComponents: mock.observed,
+				ComponentManifests:        make(map[string]*unstructured.Unstructured),
+			}
+			err := p.removeDisabledManifests()
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			gotDeletes := getComponentKeys(p.ExplicitDeletes)
+			sort.Strings(mock.expectDeletes)
+			if strings.Join(gotDeletes, ",") != strings.Join(mock.expectDeletes, ",") {
+				t.Fatalf("Expected deletes %v got %v", mock.expectDeletes, gotDeletes)
+			}
+			var gotRemoved []string
+			for _, removed := range p.RemovedComponents {
+				gotRemoved = append(gotRemoved, removed.Name+"_"+removed.Kind)
+			}
+			sort.Strings(gotRemoved)
+			sort.Strings(mock.expectRemoved)
+			if strings.Join(gotRemoved, ",") != strings.Join(mock.expectRemoved, ",") {
+				t.Fatalf("Expected removed components %v got %v", mock.expectRemoved, gotRemoved)
+			}
+			for _, key := range mock.expectRetained {
+				for _, deleted := range gotDeletes {
+					if deleted == key {
+						t.Fatalf("Expected %s to be retained got deleted", key)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCompareVersion(t *testing.T) {
+	var tests = map[string]struct {
+		v1        string
+		v2        string
+		expectRes int
+		isErr     bool
+	}{
+		"equal versions":                     {v1: "2.6.0", v2: "2.6.0", expectRes: 0},
+		"lower patch version":                {v1: "2.6.0", v2: "2.6.1", expectRes: -1},
+		"higher minor version":               {v1: "1.10.0", v2: "1.9.0", expectRes: 1},
+		"missing parts are zero":             {v1: "2.6", v2: "2.6.0", expectRes: 0},
+		"pre-release is less than release":   {v1: "2.9.0-RC1", v2: "2.9.0", expectRes: -1},
+		"pre-release is more than previous":  {v1: "2.9.0-RC1", v2: "2.8.0", expectRes: 1},
+		"pre-releases are ordered by number": {v1: "2.9.0-RC2", v2: "2.9.0-RC10", expectRes: -1},
+		"enterprise edition is the release":  {v1: "1.10.0-ee", v2: "1.10.0", expectRes: 0},
+		"enterprise edition pre-release":     {v1: "1.10.0-ee-RC1", v2: "1.10.0-ee", expectRes: -1},
+		"enterprise edition of pre-release":  {v1: "1.10.0-ee-RC1", v2: "1.10.0-RC1", expectRes: 0},
+		"v prefix and build metadata":        {v1: "v1.17.3+k3s1", v2: "v1.17.0", expectRes: 1},
+		"invalid version":                    {v1: "invalid", v2: "2.6.0", isErr: true},
+		"invalid other version":              {v1: "2.6.0", v2: "2.x.0", isErr: true},
+		"empty pre-release":                  {v1: "2.6.0-", v2: "2.6.0", isErr: true},
+		"empty version":                      {v1: "", v2: "2.6.0", isErr: true},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			res, err := compareVersion(mock.v1, mock.v2)
+			if mock.isErr {
+				if err == nil {
+					t.Fatalf("Expected error got result %d", res)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			if res != mock.expectRes {
+				t.Fatalf("Expected %d got %d", mock.expectRes, res)
+			}
+			// the comparison is antisymmetric
+			if res, _ := compareVersion(mock.v2, mock.v1); res != -mock.expectRes {
+				t.Fatalf("Expected %d for the reverse comparison got %d", -mock.expectRes, res)
+			}
+		})
+	}
+}
+
+func TestSetDesiredAnnotations(t *testing.T) {
+	var tests = map[string]struct {
+		template          map[string]string
+		annotations       map[string]string
+		observed          *unstructured.Unstructured
+		expectAnnotations map[string]string
+	}{
+		"component is not yet created": {
+			template:    map[string]string{"template": "true"},
+			annotations: map[string]string{"given": "true"},
+			expectAnnotations: map[string]string{
+				"template":                     "true",
+				"given":                        "true",
+				types.AnnKeyDesiredAnnotations: "given,template",
+			},
+		},
+		"annotations set by others are retained": {
+			annotations: map[string]string{"given": "true"},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":      types.MayaAPIServerNameKey,
+						"namespace": "openebs",
+						"annotations": map[string]interface{}{
+							"deployment.kubernetes.io/revision": "3",
+							"injector.io/status":                "injected",
+						},
+					},
+				},
+			},
+			expectAnnotations: map[string]string{
+				"given":                             "true",
+				"deployment.kubernetes.io/revision": "3",
+				"injector.io/status":                "injected",
+				types.AnnKeyDesiredAnnotations:      "given",
+			},
+		},
+		"desired annotations take precedence": {
+			template:    map[string]string{"template": "true"},
+			annotations: map[string]string{"given": "true"},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":      types.MayaAPIServerNameKey,
+						"namespace": "openebs",
+						"annotations": map[string]interface{}{
+							"template": "false",
+							"given":    "false",
+						},
+					},
+				},
+			},
+			expectAnnotations: map[string]string{
+				"template":                     "true",
+				"given":                        "true",
+				types.AnnKeyDesiredAnnotations: "given,template",
+			},
+		},
+		"annotations owned by the operators are not retained": {
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":      types.MayaAPIServerNameKey,
+						"namespace": "openebs",
+						"annotations": map[string]interface{}{
+							types.AnnKeyOpenEBSUID:                             "old-uid",
+							attachmentCreateAnnotationKey:                      "watch-uid",
+							"kubectl.kubernetes.io/last-applied-configuration": "{}",
+						},
+					},
+				},
+			},
+			expectAnnotations: map[string]string{},
+		},
+		"annotations which are no longer desired are removed": {
+			annotations: map[string]string{"given": "true"},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":      types.MayaAPIServerNameKey,
+						"namespace": "openebs",
+						"annotations": map[string]interface{}{
+							"given":                             "true",
+							"removed":                           "true",
+							"deployment.kubernetes.io/revision": "3",
+							types.AnnKeyDesiredAnnotations:      "given,removed",
+						},
+					},
+				},
+			},
+			expectAnnotations: map[string]string{
+				"given":                             "true",
+				"deployment.kubernetes.io/revision": "3",
+				types.AnnKeyDesiredAnnotations:      "given",
+			},
+		},
+		"component of another namespace is not considered": {
+			annotations: map[string]string{"given": "true"},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":      types.MayaAPIServerNameKey,
+						"namespace": "default",
+						"annotations": map[string]interface{}{
+							"deployment.kubernetes.io/revision": "3",
+						},
+					},
+				},
+			},
+			expectAnnotations: map[string]string{
+				"given":                        "true",
+				types.AnnKeyDesiredAnnotations: "given",
+			},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			openebs := &types.OpenEBS{}
+			openebs.SetUID("openebs-uid")
+			p := &Planner{ObservedOpenEBS: openebs}
+			if mock.observed != nil {
+				p.ObservedOpenEBSComponents = []*unstructured.Unstructured{mock.observed}
+			}
+			desired := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":      types.MayaAPIServerNameKey,
+						"namespace": "openebs",
+					},
+				},
+			}
+			desired.SetAnnotations(mock.template)
+			p.setDesiredAnnotations(desired, mock.annotations)
+			expectAnnotations := map[string]string{types.AnnKeyOpenEBSUID: "openebs-uid"}
+			for key, value := range mock.expectAnnotations {
+				expectAnnotations[key] = value
+			}
+			if !reflect.DeepEqual(desired.GetAnnotations(), expectAnnotations) {
+				t.Fatalf("Expected annotations %v got %v", expectAnnotations, desired.GetAnnotations())
+			}
+		})
+	}
+}
+
+func TestGetContainerResources(t *testing.T) {
+	globalResources := map[string]interface{}{
+		"limits": map[string]interface{}{"memory": "1Gi"},
+	}
+	var tests = map[string]struct {
+		containerResources map[string]map[string]interface{}
+		componentResources map[string]interface{}
+		expectResources    map[string]interface{}
+	}{
+		"resources of the container take precedence": {
+			containerResources: map[string]map[string]interface{}{
+				"maya-apiserver": {"limits": map[string]interface{}{"memory": "512Mi"}},
+			},
+			componentResources: map[string]interface{}{
+				"limits": map[string]interface{}{"memory": "256Mi"},
+			},
+			expectResources: map[string]interface{}{
+				"limits": map[string]interface{}{"memory": "512Mi"},
+			},
+		},
+		"resources of the component are used if not set for the container": {
+			containerResources: map[string]map[string]interface{}{
+				"sidecar": {"limits": map[string]interface{}{"memory": "512Mi"}},
+			},
+			componentResources: map[string]interface{}{
+				"limits": map[string]interface{}{"memory": "256Mi"},
+			},
+			expectResources: map[string]interface{}{
+				"limits": map[string]interface{}{"memory": "256Mi"},
+			},
+		},
+		"global resources are used if not set for the component": {
+			expectResources: globalResources,
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			p := &Planner{
+				ObservedOpenEBS: &types.OpenEBS{
+					Spec: types.OpenEBSSpec{Resources: globalResources},
+				},
+			}
+			resources := p.getContainerResources("maya-apiserver",
+				mock.containerResources, mock.componentResources)
+			if !reflect.DeepEqual(resources, mock.expectResources) {
+				t.Fatalf("Expected resources %v got %v", mock.expectResources, resources)
+			}
+		})
+	}
+}
+
+func TestGetImagePrefix(t *testing.T) {
+	var tests = map[string]struct {
+		container    types.Container
+		expectPrefix string
+	}{
+		"imagePrefix of the container takes precedence": {
+			container:    types.Container{ImagePrefix: "registry.example.com/openebs/"},
+			expectPrefix: "registry.example.com/openebs/",
+		},
+		"slash is added to the imagePrefix of the container": {
+			container:    types.Container{ImagePrefix: "registry.example.com/openebs"},
+			expectPrefix: "registry.example.com/openebs/",
+		},
+		"global imagePrefix is used if not set for the container": {
+			expectPrefix: "quay.io/openebs/",
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			prefix := getImagePrefix(mock.container, "quay.io/openebs/")
+			if prefix != mock.expectPrefix {
+				t.Fatalf("Expected imagePrefix %s got %s", mock.expectPrefix, prefix)
+			}
+		})
+	}
+}
+
+func TestGetImagePullPolicy(t *testing.T) {
+	var tests = map[string]struct {
+		container    types.Container
+		expectPolicy string
+	}{
+		"imagePullPolicy of the container takes precedence": {
+			container:    types.Container{ImagePullPolicy: "Always"},
+			expectPolicy: "Always",
+		},
+		"global imagePullPolicy is used if not set for the container": {
+			expectPolicy: "IfNotPresent",
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			p := &Planner{ObservedOpenEBS: &types.OpenEBS{}}
+			err := p.setDefaultImagePullPolicyIfNotSet()
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			policy := p.getImagePullPolicy(mock.container)
+			if policy != mock.expectPolicy {
+				t.Fatalf("Expected imagePullPolicy %s got %s", mock.expectPolicy, policy)
+			}
+		})
+	}
+}
+
+func TestGetImagePullSecrets(t *testing.T) {
+	var tests = map[string]struct {
+		componentSecrets []types.ImagePullSecret
+		expectSecrets    []interface{}
+	}{
+		"imagePullSecrets of the component take precedence": {
+			componentSecrets: []types.ImagePullSecret{{Name: "component-registry"}},
+			expectSecrets: []interface{}{
+				map[string]interface{}{"name": "component-registry"},
+			},
+		},
+		"global imagePullSecrets are used if not set for the component": {
+			expectSecrets: []interface{}{
+				map[string]interface{}{"name": "registry"},
+				map[string]interface{}{"name": "mirror"},
+			},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			p := &Planner{
+				ObservedOpenEBS: &types.OpenEBS{
+					Spec: types.OpenEBSSpec{
+						ImagePullSecrets: []types.ImagePullSecret{{Name: "registry"}, {Name: "mirror"}},
+					},
+				},
+			}
+			deploy := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{},
+						},
+					},
+				},
+			}
+			err := setDesiredImagePullSecrets(deploy, p.getImagePullSecrets(mock.componentSecrets),
+				"spec", "template", "spec", "imagePullSecrets")
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			secrets, _, _ := unstructured.NestedSlice(deploy.Object, "spec", "template", "spec", "imagePullSecrets")
+			if !reflect.DeepEqual(secrets, mock.expectSecrets) {
+				t.Fatalf("Expected imagePullSecrets %v got %v", mock.expectSecrets, secrets)
+			}
+		})
+	}
+}
+
+func TestSetImagePullSecretsEnv(t *testing.T) {
+	var tests = map[string]struct {
+		envs             []interface{}
+		imagePullSecrets []types.ImagePullSecret
+		expectEnvs       []interface{}
+	}{
+		"envs are kept as is without imagePullSecrets": {
+			envs: []interface{}{
+				map[string]interface{}{"name": "OPENEBS_NAMESPACE", "value": "openebs"},
+			},
+			expectEnvs: []interface{}{
+				map[string]interface{}{"name": "OPENEBS_NAMESPACE", "value": "openebs"},
+			},
+		},
+		"env is added if not present": {
+			envs: []interface{}{
+				map[string]interface{}{"name": "OPENEBS_NAMESPACE", "value": "openebs"},
+			},
+			imagePullSecrets: []types.ImagePullSecret{{Name: "registry"}, {Name: "mirror"}},
+			expectEnvs: []interface{}{
+				map[string]interface{}{"name": "OPENEBS_NAMESPACE", "value": "openebs"},
+				map[string]interface{}{"name": types.ImagePullSecretsEnvKey, "value": "registry,mirror"},
+			},
+		},
+		"env is updated if present": {
+			envs: []interface{}{
+				map[string]interface{}{"name": types.ImagePullSecretsEnvKey, "value": "old"},
+			},
+			imagePullSecrets: []types.ImagePullSecret{{Name: "registry"}},
+			expectEnvs: []interface{}{
+				map[string]interface{}{"name": types.ImagePullSecretsEnvKey, "value": "registry"},
+			},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			envs := setImagePullSecretsEnv(mock.envs, mock.imagePullSecrets)
+			if !reflect.DeepEqual(envs, mock.expectEnvs) {
+				t.Fatalf("Expected envs %v got %v", mock.expectEnvs, envs)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+	"mayadata.io/openebs-upgrade/unstruct"
+)
+
+// setComponentConditions sets one ComponentReady condition per observed
+// OpenEBS workload and derives the phase of OpenEBS from these i.e., OpenEBS
+// is Online if all the components are ready, Failed if any of the components
+// has no ready replica and Degraded otherwise.
+func (p *Planner) setComponentConditions(response *ReconcileResponse) {
+	observedConditions := make(map[string]types.OpenEBSStatusCondition)
+	for _, condition := range p.ObservedOpenEBS.Status.Conditions {
+		if condition.Type == types.ComponentReadyCondition {
+			observedConditions[condition.Kind+"/"+condition.Component] = condition
+		}
+	}
+
+	var notReady, failed []string
+	for _, component := range p.getManagedWorkloads() {
+		desiredReplicas, readyReplicas := getWorkloadReplicas(component)
+		condition := types.OpenEBSStatusCondition{
+			Type:            types.ComponentReadyCondition,
+			Status:          types.ConditionIsPresent,
+			Kind:            component.GetKind(),
+			Component:       component.GetName(),
+			ReadyReplicas:   &readyReplicas,
+			DesiredReplicas: &desiredReplicas,
+			ImageVersion:    getWorkloadImageVersion(component),
+		}
+		if readyReplicas < desiredReplicas {
+			condition.Status = types.ConditionIsAbsent
+			condition.Reason = fmt.Sprintf("%d/%d replicas ready", readyReplicas, desiredReplicas)
+			notReady = append(notReady, component.GetKind()+" "+component.GetName())
+			if readyReplicas == 0 {
+				failed = append(failed, component.GetKind()+" "+component.GetName())
+			}
+		}
+		setConditionTimes(&condition, observedConditions[condition.Kind+"/"+condition.Component])
+		response.Conditions = append(response.Conditions, condition)
+	}
+	sort.Slice(response.Conditions, func(i, j int) bool {
+		return response.Conditions[i].Kind+"/"+response.Conditions[i].Component <
+			response.Conditions[j].Kind+"/"+response.Conditions[j].Component
+	})
+
+	switch {
+	case len(failed) > 0:
+		sort.Strings(failed)
+		response.Phase = types.OpenEBSStatusPhaseFailed
+		response.Reason = "No ready replicas: " + strings.Join(failed, ", ")
+	case len(notReady) > 0:
+		sort.Strings(notReady)
+		response.Phase = types.OpenEBSStatusPhaseDegraded
+		response.Reason = "Not all replicas ready: " + strings.Join(notReady, ", ")
+	default:
+		response.Phase = types.OpenEBSStatusPhaseOnline
+	}
+}
+
+// setConditionTimes sets the times of the given condition. The times of the
+// observed condition are retained if nothing has changed so that the status
+// is not updated on every reconciliation.
+func setConditionTimes(condition *types.OpenEBSStatusCondition, observed types.OpenEBSStatusCondition) {
+	now := types.Now()
+	condition.LastObservedTime = now
+	condition.LastTransitionTime = now
+	if observed.Status != condition.Status {
+		return
+	}
+	condition.LastTransitionTime = observed.LastTransitionTime
+	if observed.Reason == condition.Reason && observed.ImageVersion == condition.ImageVersion &&
+		isSameReplicaCount(observed.ReadyReplicas, condition.ReadyReplicas) &&
+		isSameReplicaCount(observed.DesiredReplicas, condition.DesiredReplicas) {
+		condition.LastObservedTime = observed.LastObservedTime
+	}
+}
+
+// isSameReplicaCount returns true if both the replica counts are the same.
+func isSameReplicaCount(count1, count2 *int64) bool {
+	if count1 == nil || count2 == nil {
+		return count1 == count2
+	}
+	return *count1 == *count2
+}
+
+// getWorkloadReplicas returns the number of desired and ready replicas of the
+// given Deployment, DaemonSet or StatefulSet.
+func getWorkloadReplicas(workload *unstructured.Unstructured) (desired int64, ready int64) {
+	if workload.GetKind() == types.KindDaemonSet {
+		desired, _, _ = unstructured.NestedInt64(workload.Object, "status", "desiredNumberScheduled")
+		ready, _, _ = unstructured.NestedInt64(workload.Object, "status", "numberReady")
+		return desired, ready
+	}
+	desired, exist, _ := unstructured.NestedInt64(workload.Object, "spec", "replicas")
+	if !exist {
+		desired = 1
+	}
+	ready, _, _ = unstructured.NestedInt64(workload.Object, "status", "readyReplicas")
+	return desired, ready
+}
+
+// getWorkloadImageVersion returns the tag of the image of the first container
+// of the given workload i.e., the main container of OpenEBS components.
+func getWorkloadImageVersion(workload *unstructured.Unstructured) string {
+	containers, err := unstruct.GetNestedSliceOrError(workload, "spec", "template", "spec", "containers")
+	if err != nil || len(containers) == 0 {
+		return ""
+	}
+	container, ok := containers[0].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	image, _, _ := unstructured.NestedString(container, "image")
+	// the tag follows the last colon unless the colon belongs to the
+	// registry host port
+	i := strings.LastIndex(image, ":")
+	if i == -1 || strings.Contains(image[i:], "/") {
+		return ""
+	}
+	return image[i+1:]
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+func TestSetComponentConditions(t *testing.T) {
+	var tests = map[string]struct {
+		components       []*unstructured.Unstructured
+		expectPhase      types.OpenEBSStatusPhase
+		expectReason     string
+		expectConditions []string
+		expectStatuses   []types.ConditionState
+		expectVersions   []string
+	}{
+		"all components ready": {
+			components: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name": "maya-apiserver",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+						"spec": map[string]interface{}{
+							"replicas": int64(2),
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{"image": "quay.io:443/openebs/m-apiserver:2.6.0"},
+									},
+								},
+							},
+						},
+						"status": map[string]interface{}{"readyReplicas": int64(2)},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name": "openebs-ndm",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+						"status": map[string]interface{}{
+							"desiredNumberScheduled": int64(3),
+							"numberReady":            int64(3),
+						},
+					},
+				},
+			},
+			expectPhase:      types.OpenEBSStatusPhaseOnline,
+			expectConditions: []string{"DaemonSet/openebs-ndm", "Deployment/maya-apiserver"},
+			expectStatuses:   []types.ConditionState{types.ConditionIsPresent, types.ConditionIsPresent},
+			expectVersions:   []string{"", "2.6.0"},
+		},
+		"component with some ready replicas degrades OpenEBS": {
+			components: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name": "openebs-ndm",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+						"status": map[string]interface{}{
+							"desiredNumberScheduled": int64(3),
+							"numberReady":            int64(2),
+						},
+					},
+				},
+			},
+			expectPhase:      types.OpenEBSStatusPhaseDegraded,
+			expectReason:     "Not all replicas ready: DaemonSet openebs-ndm",
+			expectConditions: []string{"DaemonSet/openebs-ndm"},
+			expectStatuses:   []types.ConditionState{types.ConditionIsAbsent},
+			expectVersions:   []string{""},
+		},
+		"component without ready replicas fails OpenEBS": {
+			components: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name": "openebs-ndm",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+						"status": map[string]interface{}{
+							"desiredNumberScheduled": int64(3),
+							"numberReady":            int64(2),
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name": "openebs-provisioner",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{"image": "openebs/openebs-k8s-provisioner:2.6.0"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectPhase:      types.OpenEBSStatusPhaseFailed,
+			expectReason:     "No ready replicas: Deployment openebs-provisioner",
+			expectConditions: []string{"DaemonSet/openebs-ndm", "Deployment/openebs-provisioner"},
+			expectStatuses:   []types.ConditionState{types.ConditionIsAbsent, types.ConditionIsAbsent},
+			expectVersions:   []string{"", "2.6.0"},
+		},
+		"components which are not managed are ignored": {
+			components: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name": "pvc-1-ctrl",
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindService,
+						"metadata": map[string]interface{}{
+							"name": "maya-apiserver-service",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectPhase: types.OpenEBSStatusPhaseOnline,
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			p := &Planner{
+				ObservedOpenEBS:           &types.OpenEBS{},
+				ObservedOpenEBSComponents: mock.components,
+			}
+			response := &ReconcileResponse{}
+			p.setComponentConditions(response)
+			if response.Phase != mock.expectPhase {
+				t.Fatalf("Expected phase %s got %s", mock.expectPhase, response.Phase)
+			}
+			if response.Reason != mock.expectReason {
+				t.Fatalf("Expected reason %q got %q", mock.expectReason, response.Reason)
+			}
+			var gotConditions []string
+			var gotStatuses []types.ConditionState
+			var gotVersions []string
+			for _, condition := range response.Conditions {
+				if condition.Type != types.ComponentReadyCondition {
+					t.Fatalf("Expected condition type %s got %s", types.ComponentReadyCondition, condition.Type)
+				}
+				gotConditions = append(gotConditions, condition.Kind+"/"+condition.Component)
+				gotStatuses = append(gotStatuses, condition.Status)
+				gotVersions = append(gotVersions, condition.ImageVersion)
+			}
+			if !reflect.DeepEqual(gotConditions, mock.expectConditions) {
+				t.Fatalf("Expected conditions %v got %v", mock.expectConditions, gotConditions)
+			}
+			if !reflect.DeepEqual(gotStatuses, mock.expectStatuses) {
+				t.Fatalf("Expected statuses %v got %v", mock.expectStatuses, gotStatuses)
+			}
+			if !reflect.DeepEqual(gotVersions, mock.expectVersions) {
+				t.Fatalf("Expected image versions %v got %v", mock.expectVersions, gotVersions)
+			}
+		})
+	}
+}
+
+func TestSetConditionTimes(t *testing.T) {
+	ready := int64(1)
+	desired := int64(2)
+	var tests = map[string]struct {
+		observed             types.OpenEBSStatusCondition
+		expectObservedTime   bool
+		expectTransitionTime bool
+	}{
+		"times of an unchanged condition are retained": {
+			observed: types.OpenEBSStatusCondition{
+				Status:             types.ConditionIsAbsent,
+				Reason:             "1/2 replicas ready",
+				ReadyReplicas:      &ready,
+				DesiredReplicas:    &desired,
+				ImageVersion:       "2.6.0",
+				LastObservedTime:   "2020-01-01 00:00:00.000000",
+				LastTransitionTime: "2020-01-01 00:00:00.000000",
+			},
+			expectObservedTime:   true,
+			expectTransitionTime: true,
+		},
+		"transition time is retained if only the replicas change": {
+			observed: types.OpenEBSStatusCondition{
+				Status:             types.ConditionIsAbsent,
+				Reason:             "0/2 replicas ready",
+				DesiredReplicas:    &desired,
+				ImageVersion:       "2.6.0",
+				LastObservedTime:   "2020-01-01 00:00:00.000000",
+				LastTransitionTime: "2020-01-01 00:00:00.000000",
+			},
+			expectTransitionTime: true,
+		},
+		"times of a condition whose status changes are updated": {
+			observed: types.OpenEBSStatusCondition{
+				Status:             types.ConditionIsPresent,
+				ReadyReplicas:      &desired,
+				DesiredReplicas:    &desired,
+				ImageVersion:       "2.6.0",
+				LastObservedTime:   "2020-01-01 00:00:00.000000",
+				LastTransitionTime: "2020-01-01 00:00:00.000000",
+			},
+		},
+		"times of a new condition are set": {},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			condition := types.OpenEBSStatusCondition{
+				Status:          types.ConditionIsAbsent,
+				Reason:          "1/2 replicas ready",
+				ReadyReplicas:   &ready,
+				DesiredReplicas: &desired,
+				ImageVersion:    "2.6.0",
+			}
+			setConditionTimes(&condition, mock.observed)
+			if (condition.LastObservedTime == mock.observed.LastObservedTime) != mock.expectObservedTime {
+				t.Fatalf("Expected observed time retained %t got %s",
+					mock.expectObservedTime, condition.LastObservedTime)
+			}
+			if (condition.LastTransitionTime == mock.observed.LastTransitionTime) != mock.expectTransitionTime {
+				t.Fatalf("Expected transition time retained %t got %s",
+					mock.expectTransitionTime, condition.LastTransitionTime)
+			}
+			if condition.LastObservedTime == "" || condition.LastTransitionTime == "" {
+				t.Fatalf("Expected times to be set got %+v", condition)
+			}
+		})
+	}
+}
@@ -60,14 +60,9 @@ func (p *Planner) getDesiredCustomResourceDefinition(crd *unstructured.Unstructu
 	if err != nil {
 		return crd, err
 	}
-	// add annotations that refers to the instance which
-	// triggered creation of this CustomResourceDefinition
-	// to the existing ones.
-	annotationsMap := crd.GetAnnotations()
-	annotationsMap[types.AnnKeyOpenEBSUID] = string(p.ObservedOpenEBS.GetUID())
-	crd.SetAnnotations(
-		annotationsMap,
-	)
+	// add the annotation that refers to the instance which triggered
+	// creation of this CustomResourceDefinition to the existing ones
+	p.setDesiredAnnotations(crd, nil)
 	return crd, nil
 }
 
@@ -14,8 +14,10 @@ limitations under the License.
 package openebs
 
 import (
+	"fmt"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"mayadata.io/openebs-upgrade/k8s"
 	"mayadata.io/openebs-upgrade/types"
@@ -71,176 +73,6 @@ var (
 	KubeletPath                           string
 )
 
-// SupportedCSIResizerVersionForOpenEBSVersion stores the mapping for
-// CSI resizer to OpenEBS version.
-var SupportedCSIResizerVersionForOpenEBSVersion = map[string]string{
-	types.OpenEBSVersion190:    types.CSIResizerVersion010,
-	types.OpenEBSVersion190EE:  types.CSIResizerVersion010,
-	types.OpenEBSVersion1100:   types.CSIResizerVersion040,
-	types.OpenEBSVersion1100EE: types.CSIResizerVersion040,
-	types.OpenEBSVersion1110:   types.CSIResizerVersion040,
-	types.OpenEBSVersion1110EE: types.CSIResizerVersion040,
-	types.OpenEBSVersion1120:   types.CSIResizerVersion040,
-	types.OpenEBSVersion1120EE: types.CSIResizerVersion040,
-	types.OpenEBSVersion200:    types.CSIResizerVersion040,
-	types.OpenEBSVersion200EE:  types.CSIResizerVersion040,
-	types.OpenEBSVersion210:    types.CSIResizerVersion040,
-	types.OpenEBSVersion210EE:  types.CSIResizerVersion040,
-	types.OpenEBSVersion220:    types.CSIResizerVersion040,
-	types.OpenEBSVersion220EE:  types.CSIResizerVersion040,
-	types.OpenEBSVersion240:    types.CSIResizerVersion040,
-	types.OpenEBSVersion250:    types.CSIResizerVersion110,
-	types.OpenEBSVersion260:    types.CSIResizerVersion110,
-	types.OpenEBSVersion270:    types.CSIResizerVersion110,
-	types.OpenEBSVersion280:    types.CSIResizerVersion110,
-	types.OpenEBSVersion290:    types.CSIResizerVersion110,
-}
-
-// SupportedCSISnapshotterVersionForOpenEBSVersion stores the mapping for
-// CSI snapshotter to OpenEBS version.
-var SupportedCSISnapshotterVersionForOpenEBSVersion = map[string]string{
-	types.OpenEBSVersion190:    types.CSISnapshotterVersion201,
-	types.OpenEBSVersion190EE:  types.CSISnapshotterVersion201,
-	types.OpenEBSVersion1100:   types.CSISnapshotterVersion201,
-	types.OpenEBSVersion1100EE: types.CSISnapshotterVersion201,
-	types.OpenEBSVersion1110:   types.CSISnapshotterVersion201,
-	types.OpenEBSVersion1110EE: types.CSISnapshotterVersion201,
-	types.OpenEBSVersion1120:   types.CSISnapshotterVersion201,
-	types.OpenEBSVersion1120EE: types.CSISnapshotterVersion201,
-	types.OpenEBSVersion200:    types.CSISnapshotterVersion201,
-	types.OpenEBSVersion200EE:  types.CSISnapshotterVersion201,
-	types.OpenEBSVersion210:    types.CSISnapshotterVersion201,
-	types.OpenEBSVersion210EE:  types.CSISnapshotterVersion201,
-	types.OpenEBSVersion220:    types.CSISnapshotterVersion201,
-	types.OpenEBSVersion220EE:  types.CSISnapshotterVersion201,
-	types.OpenEBSVersion240:    types.CSISnapshotterVersion201,
-	types.OpenEBSVersion250:    types.CSISnapshotterVersion303,
-	types.OpenEBSVersion260:    types.CSISnapshotterVersion303,
-	types.OpenEBSVersion270:    types.CSISnapshotterVersion303,
-	types.OpenEBSVersion280:    types.CSISnapshotterVersion303,
-	types.OpenEBSVersion290:    types.CSISnapshotterVersion303,
-}
-
-// SupportedCSISnapshotControllerVersionForOpenEBSVersion stores the mapping for
-// CSI snapshot controller to OpenEBS version.
-var SupportedCSISnapshotControllerVersionForOpenEBSVersion = map[string]string{
-	types.OpenEBSVersion190:    types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion190EE:  types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion1100:   types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion1100EE: types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion1110:   types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion1110EE: types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion1120:   types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion1120EE: types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion200:    types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion200EE:  types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion210:    types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion210EE:  types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion220:    types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion220EE:  types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion240:    types.CSISnapshotControllerVersion201,
-	types.OpenEBSVersion250:    types.CSISnapshotControllerVersion303,
-	types.OpenEBSVersion260:    types.CSISnapshotControllerVersion303,
-	types.OpenEBSVersion270:    types.CSISnapshotControllerVersion303,
-	types.OpenEBSVersion280:    types.CSISnapshotControllerVersion303,
-	types.OpenEBSVersion290:    types.CSISnapshotControllerVersion303,
-}
-
-// SupportedCSIProvisionerVersionForCSIControllerVersion stores the mapping for
-// CSI provisioner to csi-controller version.
-var SupportedCSIProvisionerVersionForCSIControllerVersion = map[string]string{
-	types.OpenEBSVersion190:    types.CSIProvisionerVersion150,
-	types.OpenEBSVersion190EE:  types.CSIProvisionerVersion150,
-	types.OpenEBSVersion1100:   types.CSIProvisionerVersion150,
-	types.OpenEBSVersion1100EE: types.CSIProvisionerVersion150,
-	types.OpenEBSVersion1110:   types.CSIProvisionerVersion160,
-	types.OpenEBSVersion1110EE: types.CSIProvisionerVersion160,
-	types.OpenEBSVersion1120:   types.CSIProvisionerVersion160,
-	types.OpenEBSVersion1120EE: types.CSIProvisionerVersion160,
-	types.OpenEBSVersion200:    types.CSIProvisionerVersion160,
-	types.OpenEBSVersion200EE:  types.CSIProvisionerVersion160,
-	types.OpenEBSVersion210:    types.CSIProvisionerVersion160,
-	types.OpenEBSVersion210EE:  types.CSIProvisionerVersion160,
-	types.OpenEBSVersion220:    types.CSIProvisionerVersion160,
-	types.OpenEBSVersion220EE:  types.CSIProvisionerVersion160,
-	types.OpenEBSVersion240:    types.CSIProvisionerVersion160,
-	types.OpenEBSVersion250:    types.CSIProvisionerVersion210,
-	types.OpenEBSVersion260:    types.CSIProvisionerVersion210,
-	types.OpenEBSVersion270:    types.CSIProvisionerVersion210,
-	types.OpenEBSVersion280:    types.CSIProvisionerVersion210,
-	types.OpenEBSVersion290:    types.CSIProvisionerVersion210,
-}
-
-// SupportedCSIAttacherVersionForCSIControllerVersion stores the mapping for
-// CSI provisioner to CSIController version.
-var SupportedCSIAttacherVersionForCSIControllerVersion = map[string]string{
-	types.OpenEBSVersion190:    types.CSIAttacherVersion200,
-	types.OpenEBSVersion190EE:  types.CSIAttacherVersion200,
-	types.OpenEBSVersion1100:   types.CSIAttacherVersion200,
-	types.OpenEBSVersion1100EE: types.CSIAttacherVersion200,
-	types.OpenEBSVersion1110:   types.CSIAttacherVersion200,
-	types.OpenEBSVersion1110EE: types.CSIAttacherVersion200,
-	types.OpenEBSVersion1120:   types.CSIAttacherVersion200,
-	types.OpenEBSVersion1120EE: types.CSIAttacherVersion200,
-	types.OpenEBSVersion200:    types.CSIAttacherVersion200,
-	types.OpenEBSVersion200EE:  types.CSIAttacherVersion200,
-	types.OpenEBSVersion210:    types.CSIAttacherVersion200,
-	types.OpenEBSVersion210EE:  types.CSIAttacherVersion200,
-	types.OpenEBSVersion220:    types.CSIAttacherVersion200,
-	types.OpenEBSVersion220EE:  types.CSIAttacherVersion200,
-	types.OpenEBSVersion240:    types.CSIAttacherVersion200,
-	types.OpenEBSVersion250:    types.CSIAttacherVersion310,
-	types.OpenEBSVersion260:    types.CSIAttacherVersion310,
-	types.OpenEBSVersion270:    types.CSIAttacherVersion310,
-	types.OpenEBSVersion280:    types.CSIAttacherVersion310,
-	types.OpenEBSVersion290:    types.CSIAttacherVersion310,
-}
-
-// SupportedCSIClusterDriverRegistrarVersionForOpenEBSVersion stores the mapping for
-// CSIClusterDriverRegistrar to OpenEBS version.
-var SupportedCSIClusterDriverRegistrarVersionForOpenEBSVersion = map[string]string{
-	types.OpenEBSVersion190:    types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion190EE:  types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion1100:   types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion1100EE: types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion1110:   types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion1110EE: types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion1120:   types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion1120EE: types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion200:    types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion200EE:  types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion210:    types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion210EE:  types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion220:    types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion220EE:  types.CSIClusterDriverRegistrarVersion101,
-	types.OpenEBSVersion240:    types.CSIClusterDriverRegistrarVersion101,
-}
-
-// SupportedCSINodeDriverRegistrarVersionForCSINodeVersion stores the mapping for
-// CSINodeDriverRegistrar to CSI node version.
-var SupportedCSINodeDriverRegistrarVersionForCSINodeVersion = map[string]string{
-	types.OpenEBSVersion190:    types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion190EE:  types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion1100:   types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion1100EE: types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion1110:   types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion1110EE: types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion1120:   types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion1120EE: types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion200:    types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion200EE:  types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion210:    types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion210EE:  types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion220:    types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion220EE:  types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion240:    types.CSINodeDriverRegistrarVersion101,
-	types.OpenEBSVersion250:    types.CSINodeDriverRegistrarVersion210,
-	types.OpenEBSVersion260:    types.CSINodeDriverRegistrarVersion210,
-	types.OpenEBSVersion270:    types.CSINodeDriverRegistrarVersion210,
-	types.OpenEBSVersion280:    types.CSINodeDriverRegistrarVersion210,
-	types.OpenEBSVersion290:    types.CSINodeDriverRegistrarVersion210,
-}
-
 // Set the default values for Cstor if not already given.
 func (p *Planner) setCStorDefaultsIfNotSet() error {
 	if p.ObservedOpenEBS.Spec.CstorConfig == nil {
@@ -251,7 +83,8 @@ func (p *Planner) setCStorDefaultsIfNotSet() error {
 		p.ObservedOpenEBS.Spec.CstorConfig.Pool.ImageTag = p.ObservedOpenEBS.Spec.Version +
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
-	p.ObservedOpenEBS.Spec.CstorConfig.Pool.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.CstorConfig.Pool.Image = getImagePrefix(p.ObservedOpenEBS.Spec.CstorConfig.Pool,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"cstor-pool:" + p.ObservedOpenEBS.Spec.CstorConfig.Pool.ImageTag
 
 	// form the cstor-pool-mgmt image
@@ -259,7 +92,8 @@ func (p *Planner) setCStorDefaultsIfNotSet() error {
 		p.ObservedOpenEBS.Spec.CstorConfig.PoolMgmt.ImageTag = p.ObservedOpenEBS.Spec.Version +
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
-	p.ObservedOpenEBS.Spec.CstorConfig.PoolMgmt.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.CstorConfig.PoolMgmt.Image = getImagePrefix(p.ObservedOpenEBS.Spec.CstorConfig.PoolMgmt,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"cstor-pool-mgmt:" + p.ObservedOpenEBS.Spec.CstorConfig.PoolMgmt.ImageTag
 
 	// form the cstor-istgt image
@@ -267,7 +101,8 @@ func (p *Planner) setCStorDefaultsIfNotSet() error {
 		p.ObservedOpenEBS.Spec.CstorConfig.Target.ImageTag = p.ObservedOpenEBS.Spec.Version +
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
-	p.ObservedOpenEBS.Spec.CstorConfig.Target.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.CstorConfig.Target.Image = getImagePrefix(p.ObservedOpenEBS.Spec.CstorConfig.Target,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"cstor-istgt:" + p.ObservedOpenEBS.Spec.CstorConfig.Target.ImageTag
 
 	// form the cstor-volume-mgmt image
@@ -275,7 +110,8 @@ func (p *Planner) setCStorDefaultsIfNotSet() error {
 		p.ObservedOpenEBS.Spec.CstorConfig.VolumeMgmt.ImageTag = p.ObservedOpenEBS.Spec.Version +
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
-	p.ObservedOpenEBS.Spec.CstorConfig.VolumeMgmt.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.CstorConfig.VolumeMgmt.Image = getImagePrefix(p.ObservedOpenEBS.Spec.CstorConfig.VolumeMgmt,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"cstor-volume-mgmt:" + p.ObservedOpenEBS.Spec.CstorConfig.VolumeMgmt.ImageTag
 	// form the cstor-volume-manager image
 	volumeManagerImageName := "cstor-volume-manager-amd64:"
@@ -288,7 +124,8 @@ func (p *Planner) setCStorDefaultsIfNotSet() error {
 	} else if OpenEBSVersionAbove240 {
 		volumeManagerImageName = "cstor-volume-manager:"
 	}
-	p.ObservedOpenEBS.Spec.CstorConfig.VolumeManager.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.CstorConfig.VolumeManager.Image = getImagePrefix(p.ObservedOpenEBS.Spec.CstorConfig.VolumeManager,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		volumeManagerImageName + p.ObservedOpenEBS.Spec.CstorConfig.VolumeManager.ImageTag
 	// form the cspi-mgmt image(CSPI_MGMT)
 	cspiImageName := "cstor-pool-manager-amd64:"
@@ -301,7 +138,8 @@ func (p *Planner) setCStorDefaultsIfNotSet() error {
 	} else if OpenEBSVersionAbove240 {
 		cspiImageName = "cstor-pool-manager:"
 	}
-	p.ObservedOpenEBS.Spec.CstorConfig.CSPIMgmt.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.CstorConfig.CSPIMgmt.Image = getImagePrefix(p.ObservedOpenEBS.Spec.CstorConfig.CSPIMgmt,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		cspiImageName + p.ObservedOpenEBS.Spec.CstorConfig.CSPIMgmt.ImageTag
 
 	// set the CSPC operator defaults
@@ -328,7 +166,8 @@ func (p *Planner) setCStorDefaultsIfNotSet() error {
 		cspcImage = "cspc-operator:"
 	}
 	// form the container image as per the image prefix and image tag.
-	p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Image = getImagePrefix(p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		cspcImage + p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.ImageTag
 	if p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Replicas == nil {
 		p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.Replicas = new(int32)
@@ -358,7 +197,8 @@ func (p *Planner) setCStorDefaultsIfNotSet() error {
 		cvcImage = "cvc-operator:"
 	}
 	// form the container image as per the image prefix and image tag.
-	p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Image = getImagePrefix(p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		cvcImage + p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.ImageTag
 	if p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Replicas == nil {
 		p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.Replicas = new(int32)
@@ -394,7 +234,8 @@ func (p *Planner) setCStorDefaultsIfNotSet() error {
 		cstorWebhookImage = "cstor-webhook:"
 	}
 	// form the container image as per the image prefix and image tag.
-	p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.Image = getImagePrefix(p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		cstorWebhookImage + p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.ImageTag
 	if p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.Replicas == nil {
 		p.ObservedOpenEBS.Spec.CstorConfig.AdmissionServer.Replicas = new(int32)
@@ -458,7 +299,8 @@ func (p *Planner) setCSIDefaultsIfNotSet() error {
 			p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.ImageTag = p.ObservedOpenEBS.Spec.Version +
 				p.ObservedOpenEBS.Spec.ImageTagSuffix
 		}
-		p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+		p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.Image = getImagePrefix(p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.Container,
+			p.ObservedOpenEBS.Spec.ImagePrefix) +
 			"cstor-csi-driver:" + p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.ImageTag
 	}
 
@@ -480,7 +322,8 @@ func (p *Planner) setCSIDefaultsIfNotSet() error {
 			p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.ImageTag = p.ObservedOpenEBS.Spec.Version +
 				p.ObservedOpenEBS.Spec.ImageTagSuffix
 		}
-		p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+		p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.Image = getImagePrefix(p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.Container,
+			p.ObservedOpenEBS.Spec.ImagePrefix) +
 			"cstor-csi-driver:" + p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.ImageTag
 
 		if p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.ISCSIPath == "" {
@@ -498,7 +341,8 @@ func (p *Planner) setCSIDefaultsIfNotSet() error {
 		}
 	}
 	// form the csi-resizer image
-	if csiResizerVersion, exist := SupportedCSIResizerVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+	sidecars := p.Release.CSISidecars.CStor
+	if csiResizerVersion := sidecars.Resizer; csiResizerVersion != "" {
 		CSIResizerImageTag = "csi-resizer:" + csiResizerVersion
 	} else {
 		return errors.Errorf("Failed to get csi-resizer version for the given OpenEBS version: %s",
@@ -506,7 +350,7 @@ func (p *Planner) setCSIDefaultsIfNotSet() error {
 	}
 
 	// form the csi-snapshotter image
-	if csiSnapshotterVersion, exist := SupportedCSISnapshotterVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+	if csiSnapshotterVersion := sidecars.Snapshotter; csiSnapshotterVersion != "" {
 		CSISnapshotterImageTag = "csi-snapshotter:" + csiSnapshotterVersion
 	} else {
 		return errors.Errorf("Failed to get csi-snapshotter version for the given OpenEBS version: %s",
@@ -514,7 +358,7 @@ func (p *Planner) setCSIDefaultsIfNotSet() error {
 	}
 
 	// form the CSI snapshot-controller image
-	if csiSnapshotControllerVersion, exist := SupportedCSISnapshotControllerVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+	if csiSnapshotControllerVersion := sidecars.SnapshotController; csiSnapshotControllerVersion != "" {
 		CSISnapshotControllerImageTag = "snapshot-controller:" + csiSnapshotControllerVersion
 	} else {
 		return errors.Errorf("Failed to get snapshot-controller version for the given OpenEBS version: %s",
@@ -522,8 +366,7 @@ func (p *Planner) setCSIDefaultsIfNotSet() error {
 	}
 
 	// form the CSI provisioner image for the CSI controller
-	if csiProvisionerForCSIController, exist :=
-		SupportedCSIProvisionerVersionForCSIControllerVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+	if csiProvisionerForCSIController := sidecars.Provisioner; csiProvisionerForCSIController != "" {
 		CSIProvisionerForCSIControllerImageTag = "csi-provisioner:" +
 			csiProvisionerForCSIController
 	} else {
@@ -533,8 +376,7 @@ func (p *Planner) setCSIDefaultsIfNotSet() error {
 	}
 
 	// form the CSI attacher for CSI controller
-	if csiAttacherForCSIController, exist :=
-		SupportedCSIAttacherVersionForCSIControllerVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+	if csiAttacherForCSIController := sidecars.Attacher; csiAttacherForCSIController != "" {
 		CSIAttacherForCSIControllerImageTag = "csi-attacher:" +
 			csiAttacherForCSIController
 	} else {
@@ -547,8 +389,7 @@ func (p *Planner) setCSIDefaultsIfNotSet() error {
 	// OpenEBS version 2.4.0 only.
 	if !OpenEBSVersionAbove240 {
 		// form the csi-cluster-driver-registrar image for the given OpenEBS version
-		if csiClusterDriverRegistrar, exist :=
-			SupportedCSIClusterDriverRegistrarVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+		if csiClusterDriverRegistrar := sidecars.ClusterDriverRegistrar; csiClusterDriverRegistrar != "" {
 			CSIClusterDriverRegistrarImageTag = "csi-cluster-driver-registrar:" +
 				csiClusterDriverRegistrar
 		} else {
@@ -558,8 +399,7 @@ func (p *Planner) setCSIDefaultsIfNotSet() error {
 		}
 	}
 	// form the csi-node-driver-registrar image for CSI node for the given OpenEBS version
-	if csiNodeDriverRegistrar, exist :=
-		SupportedCSINodeDriverRegistrarVersionForCSINodeVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+	if csiNodeDriverRegistrar := sidecars.NodeDriverRegistrar; csiNodeDriverRegistrar != "" {
 		CSINodeDriverRegistrarForCSINodeImageTag = "csi-node-driver-registrar:" +
 			csiNodeDriverRegistrar
 	} else {
@@ -626,6 +466,9 @@ func (p *Planner) deleteCSIComponentsIfRequired() error {
 		return errors.Errorf(
 			"Error getting the namespace where CSI components will be installed: %+v", err)
 	}
+	// isMoved is true if any of the CSI components is being deleted from
+	// kube-system namespace.
+	var isMoved bool
 	if isCSISupported && csiNamespace != types.NamespaceKubeSystem {
 		// check if csi-components are already installed in kube-system namespace.
 		for _, observedOpenEBSComp := range p.ObservedOpenEBSComponents {
@@ -638,11 +481,17 @@ func (p *Planner) deleteCSIComponentsIfRequired() error {
 					observedOpenEBSComp.GetName() == types.CStorCSINodeSANameKey {
 					if observedOpenEBSComp.GetNamespace() == types.NamespaceKubeSystem {
 						p.ExplicitDeletes = append(p.ExplicitDeletes, observedOpenEBSComp)
+						isMoved = true
 					}
 				}
 			}
 		}
 	}
+	if isMoved {
+		p.addEvent(corev1.EventTypeNormal, types.EventReasonCSIComponentsMoved,
+			fmt.Sprintf("Moving CSI components from %s to %s namespace",
+				types.NamespaceKubeSystem, csiNamespace))
+	}
 	return nil
 }
 
@@ -658,7 +507,7 @@ func (p *Planner) isCSISupported() (bool, error) {
 	}
 
 	// compare the kubernetes version with the supported version of csi.
-	comp, err = compareVersion(k8sVersion, types.CSISupportedVersion)
+	comp, err = compareVersion(getKubernetesReleaseVersion(k8sVersion), types.CSISupportedVersion)
 	if err != nil {
 		return false, errors.Errorf("Error comparing versions, error: %v", err)
 	}
@@ -695,7 +544,7 @@ func (p *Planner) getCSIComponentsNamespace() (string, error) {
 	}
 	if res >= 0 {
 		// compare the kubernetes version with the supported version of csi.
-		comp, err = compareVersion(k8sVersion, types.CSISupportedVersionFromOpenEBS200)
+		comp, err = compareVersion(getKubernetesReleaseVersion(k8sVersion), types.CSISupportedVersionFromOpenEBS200)
 		if err != nil {
 			return csiNamespace, errors.Errorf("Error comparing versions, error: %v", err)
 		}
@@ -707,7 +556,7 @@ func (p *Planner) getCSIComponentsNamespace() (string, error) {
 		}
 	} else {
 		// compare the kubernetes version with the supported version of csi.
-		comp, err = compareVersion(k8sVersion, types.CSISupportedVersion)
+		comp, err = compareVersion(getKubernetesReleaseVersion(k8sVersion), types.CSISupportedVersion)
 		if err != nil {
 			return csiNamespace, errors.Errorf("Error comparing versions, error: %v", err)
 		}
@@ -929,13 +778,9 @@ func (p *Planner) updateOpenEBSCStorCSINode(daemonset *unstructured.Unstructured
 		}
 
 		// Set the resource of the containers.
-		if p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.Resources != nil {
-			err = unstructured.SetNestedField(obj.Object, p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.Resources,
-				"spec", "resources")
-		} else if p.ObservedOpenEBS.Spec.Resources != nil {
-			err = unstructured.SetNestedField(obj.Object,
-				p.ObservedOpenEBS.Spec.Resources, "spec", "resources")
-		}
+		err = setDesiredContainerResources(obj, p.getContainerResources(containerName,
+			p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.ContainerResources,
+			p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.Resources))
 		if err != nil {
 			return err
 		}
@@ -1273,13 +1118,9 @@ func (p *Planner) updateOpenEBSCStorCSIControllerContainers() func(obj *unstruct
 		}
 
 		// Set the resource of the containers.
-		if p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.Resources != nil {
-			err = unstructured.SetNestedField(obj.Object, p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.Resources,
-				"spec", "resources")
-		} else if p.ObservedOpenEBS.Spec.Resources != nil {
-			err = unstructured.SetNestedField(obj.Object,
-				p.ObservedOpenEBS.Spec.Resources, "spec", "resources")
-		}
+		err = setDesiredContainerResources(obj, p.getContainerResources(containerName,
+			p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.ContainerResources,
+			p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.Resources))
 		if err != nil {
 			return err
 		}
@@ -1469,6 +1310,9 @@ func (p *Planner) updateCSPCOperator(deploy *unstructured.Unstructured) error {
 			if err != nil {
 				return err
 			}
+			// pass the imagePullSecrets to the cstor pool pods launched by it
+			envs = setImagePullSecretsEnv(envs,
+				p.getImagePullSecrets(p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.ImagePullSecrets))
 			envs, err = p.ignoreUpdatingImmutableEnvs(p.ObservedOpenEBS.Spec.CstorConfig.CSPCOperator.ENV, envs)
 			if err != nil {
 				return err
@@ -1566,6 +1410,9 @@ func (p *Planner) updateCVCOperator(deploy *unstructured.Unstructured) error {
 			if err != nil {
 				return err
 			}
+			// pass the imagePullSecrets to the cstor target pods launched by it
+			envs = setImagePullSecretsEnv(envs,
+				p.getImagePullSecrets(p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.ImagePullSecrets))
 			envs, err = p.ignoreUpdatingImmutableEnvs(p.ObservedOpenEBS.Spec.CstorConfig.CVCOperator.ENV, envs)
 			if err != nil {
 				return err
@@ -0,0 +1,581 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+const (
+	// DefaultDataPlaneUpgradeVolumeBatchSize is the default number of
+	// volumes that are upgraded at a time.
+	DefaultDataPlaneUpgradeVolumeBatchSize int32 = 1
+
+	// DefaultJivaVolumeUpgradeConcurrency is the default number of Jiva
+	// volumes that are upgraded at a time.
+	DefaultJivaVolumeUpgradeConcurrency int32 = 1
+
+	// DefaultJivaReplicaRebuildSeconds is the default time for which an
+	// upgraded Jiva replica must stay ready before the next one is upgraded.
+	DefaultJivaReplicaRebuildSeconds int32 = 60
+
+	// dataPlaneUpgradeJobBackoffLimit is the number of retries after which
+	// an upgrade job is marked as failed.
+	dataPlaneUpgradeJobBackoffLimit int64 = 4
+
+	// CStor data plane upgrade types i.e., these are used as the value of the
+	// data-plane-upgrade label of the upgrade jobs as well as the prefix of
+	// the job names.
+	cstorPoolUpgradeType   string = "cstor-pool"
+	cstorVolumeUpgradeType string = "cstor-volume"
+)
+
+var (
+	// cspiGVR is the GroupVersionResource of CStorPoolInstance i.e., the pools
+	// managed by CStorPoolCluster.
+	cspiGVR = schema.GroupVersionResource{
+		Group: "cstor.openebs.io", Version: "v1", Resource: "cstorpoolinstances",
+	}
+	// cspGVR is the GroupVersionResource of legacy CStorPool i.e., the pools
+	// managed by StoragePoolClaim.
+	cspGVR = schema.GroupVersionResource{
+		Group: types.GroupOpenEBSIO, Version: types.VersionV1Alpha1, Resource: "cstorpools",
+	}
+	// cstorVolumeGVR is the GroupVersionResource of CSI based cStor volumes.
+	cstorVolumeGVR = schema.GroupVersionResource{
+		Group: "cstor.openebs.io", Version: "v1", Resource: "cstorvolumes",
+	}
+	// legacyCStorVolumeGVR is the GroupVersionResource of legacy cStor volumes.
+	legacyCStorVolumeGVR = schema.GroupVersionResource{
+		Group: types.GroupOpenEBSIO, Version: types.VersionV1Alpha1, Resource: "cstorvolumes",
+	}
+)
+
+// dataPlaneUpgradeTarget is a pool or a volume which needs to be upgraded
+// by launching an upgrade job.
+type dataPlaneUpgradeTarget struct {
+	// kind is the kind of the resource passed to the upgrade job such as
+	// CStorPoolCluster, StoragePoolClaim, etc.
+	kind        string
+	name        string
+	fromVersion string
+	// upgradeType is one of the data plane upgrade types such as cstor-pool.
+	upgradeType string
+	// args are the arguments passed to the upgrade job before the version
+	// flags such as cstor-cspc, cstor-volume, etc.
+	args []string
+	// image is the name of the upgrade image without the tag.
+	image          string
+	serviceAccount string
+	// progress reports the number of pools of a pool cluster which are
+	// upgraded.
+	progress string
+}
+
+// setDataPlaneUpgradeDefaultsIfNotSet sets the default values for data plane
+// upgrade if not set.
+func (p *Planner) setDataPlaneUpgradeDefaultsIfNotSet() error {
+	if p.ObservedOpenEBS.Spec.DataPlaneUpgrade == nil {
+		p.ObservedOpenEBS.Spec.DataPlaneUpgrade = &types.DataPlaneUpgrade{}
+	}
+	if p.ObservedOpenEBS.Spec.DataPlaneUpgrade.Enabled == nil {
+		p.ObservedOpenEBS.Spec.DataPlaneUpgrade.Enabled = new(bool)
+		*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.Enabled = false
+	}
+	if p.ObservedOpenEBS.Spec.DataPlaneUpgrade.VolumeBatchSize == nil ||
+		*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.VolumeBatchSize < 1 {
+		p.ObservedOpenEBS.Spec.DataPlaneUpgrade.VolumeBatchSize = new(int32)
+		*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.VolumeBatchSize = DefaultDataPlaneUpgradeVolumeBatchSize
+	}
+	if p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaVolumeConcurrency == nil ||
+		*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaVolumeConcurrency < 1 {
+		p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaVolumeConcurrency = new(int32)
+		*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaVolumeConcurrency = DefaultJivaVolumeUpgradeConcurrency
+	}
+	if p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaReplicaRebuildSeconds == nil ||
+		*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaReplicaRebuildSeconds < 0 {
+		p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaReplicaRebuildSeconds = new(int32)
+		*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaReplicaRebuildSeconds = DefaultJivaReplicaRebuildSeconds
+	}
+	// the rollout of the replicas is paused only till the next resync hence
+	// a shorter time could let the next replica be upgraded before the
+	// upgraded replica is rebuilt.
+	minRebuildSeconds := int32(upgradeResyncPeriodSeconds)
+	if *p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaReplicaRebuildSeconds < minRebuildSeconds {
+		glog.Warningf("Using jivaReplicaRebuildSeconds %d instead of %d for OpenEBS %s %s: "+
+			"It can't be less than the resync period", minRebuildSeconds,
+			*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaReplicaRebuildSeconds,
+			p.ObservedOpenEBS.Namespace, p.ObservedOpenEBS.Name)
+		*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaReplicaRebuildSeconds = minRebuildSeconds
+	}
+	return nil
+}
+
+// upgradeDataPlane upgrades the pools and the volumes once the control plane
+// has been upgraded. No further pools or volumes are upgraded once an upgrade
+// fails.
+func (p *Planner) upgradeDataPlane(response *ReconcileResponse) error {
+	if !*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.Enabled {
+		return nil
+	}
+	// data plane is upgraded only after the control plane is upgraded.
+	if isUpgradeBlocked(response) || p.isUpgradeInProgress() {
+		return nil
+	}
+	targetVersion := p.ObservedOpenEBS.Spec.Version + p.ObservedOpenEBS.Spec.ImageTagSuffix
+
+	status := &types.DataPlaneUpgradeStatus{
+		TargetVersion: targetVersion,
+		Phase:         types.DataPlaneUpgradePhaseUpgrading,
+	}
+	// continue with the results of the earlier reconciliations if upgrading
+	// to the same version.
+	observedStatus := p.ObservedOpenEBS.Status.DataPlaneUpgrade
+	if observedStatus != nil && observedStatus.TargetVersion == targetVersion {
+		status.Phase = observedStatus.Phase
+		status.Reason = observedStatus.Reason
+		status.Pools = append(status.Pools, observedStatus.Pools...)
+		status.Volumes = append(status.Volumes, observedStatus.Volumes...)
+		status.JivaVolumes = append(status.JivaVolumes, observedStatus.JivaVolumes...)
+	}
+	response.DataPlaneUpgradeStatus = status
+
+	observedJobs := make(map[string]*unstructured.Unstructured)
+	for _, component := range p.ObservedOpenEBSComponents {
+		if component.GetKind() == types.KindJob &&
+			component.GetLabels()[types.OpenEBSDataPlaneUpgradeLabelKey] != "" {
+			observedJobs[component.GetName()] = component
+		}
+	}
+	// keep the failed jobs so that these can be looked into.
+	if status.Phase == types.DataPlaneUpgradePhaseFailed {
+		for _, items := range [][]types.DataPlaneUpgradeItem{status.Pools, status.Volumes} {
+			for _, item := range items {
+				if job, exist := observedJobs[item.Job]; exist && item.Phase == types.DataPlaneUpgradePhaseFailed {
+					response.DesiredOpenEBSComponents = append(response.DesiredOpenEBSComponents, job)
+				}
+			}
+		}
+		return nil
+	}
+
+	cstorUpgraded, err := p.upgradeCStorDataPlane(response, status, observedJobs)
+	if err != nil {
+		return err
+	}
+	if status.Phase == types.DataPlaneUpgradePhaseFailed {
+		return nil
+	}
+	// cStor and Jiva volumes are independent of each other hence these are
+	// upgraded alongside.
+	jivaUpgraded, err := p.upgradeJivaVolumes(status)
+	if err != nil {
+		return err
+	}
+	if status.Phase == types.DataPlaneUpgradePhaseFailed {
+		return nil
+	}
+	if cstorUpgraded && jivaUpgraded {
+		status.Phase = types.DataPlaneUpgradePhaseCompleted
+		status.Reason = ""
+		return nil
+	}
+	status.Phase = types.DataPlaneUpgradePhaseUpgrading
+	return nil
+}
+
+// upgradeCStorDataPlane launches the jobs to upgrade the cStor pools one pool
+// cluster at a time and then the cStor volumes in batches. It returns true if
+// all the pools and volumes are upgraded.
+func (p *Planner) upgradeCStorDataPlane(
+	response *ReconcileResponse, status *types.DataPlaneUpgradeStatus,
+	observedJobs map[string]*unstructured.Unstructured,
+) (bool, error) {
+	targetVersion := status.TargetVersion
+	pools, err := p.getCStorPoolsToUpgrade(targetVersion)
+	if err != nil {
+		return false, err
+	}
+	volumes, err := p.getCStorVolumesToUpgrade(targetVersion)
+	if err != nil {
+		return false, err
+	}
+	status.Pools = markUpgradedItemsAsCompleted(status.Pools, pools)
+	status.Volumes = markUpgradedItemsAsCompleted(status.Volumes, volumes)
+
+	var targets []dataPlaneUpgradeTarget
+	var items *[]types.DataPlaneUpgradeItem
+	if len(pools) > 0 {
+		// pool clusters are upgraded one at a time, the upgrade job upgrades
+		// the pools of a pool cluster one after the other.
+		targets = pools[:1]
+		items = &status.Pools
+	} else if len(volumes) > 0 {
+		batchSize := int(*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.VolumeBatchSize)
+		if len(volumes) < batchSize {
+			batchSize = len(volumes)
+		}
+		targets = volumes[:batchSize]
+		items = &status.Volumes
+	} else {
+		return true, nil
+	}
+
+	for _, target := range targets {
+		job, err := p.getDataPlaneUpgradeJob(target, targetVersion)
+		if err != nil {
+			return false, err
+		}
+		item := getDataPlaneUpgradeItem(items, target.kind, target.name, target.fromVersion)
+		item.Job = job.GetName()
+		item.Phase = types.DataPlaneUpgradePhaseUpgrading
+		item.Progress = target.progress
+		response.DesiredOpenEBSComponents = append(response.DesiredOpenEBSComponents, job)
+
+		observedJob, exist := observedJobs[job.GetName()]
+		if !exist {
+			continue
+		}
+		reason := ""
+		if isJobFailed(observedJob) {
+			reason = fmt.Sprintf("Upgrade job %s failed for %s %s",
+				observedJob.GetName(), target.kind, target.name)
+		} else if isJobSucceeded(observedJob) {
+			// the upgrade job updates the version of the pool or volume before
+			// completing, hence it is not yet upgraded if it's still not at the
+			// target version.
+			reason = fmt.Sprintf("Upgrade job %s completed but %s %s is still at version %s",
+				observedJob.GetName(), target.kind, target.name, target.fromVersion)
+		}
+		if reason != "" {
+			p.markDataPlaneUpgradeFailed(status, item, reason)
+		}
+	}
+	return false, nil
+}
+
+// markDataPlaneUpgradeFailed marks the given item as well as the data plane
+// upgrade as failed.
+func (p *Planner) markDataPlaneUpgradeFailed(
+	status *types.DataPlaneUpgradeStatus, item *types.DataPlaneUpgradeItem, reason string,
+) {
+	glog.Errorf("Failed to upgrade data plane of OpenEBS %s %s: %s",
+		p.ObservedOpenEBS.Namespace, p.ObservedOpenEBS.Name, reason)
+	item.Phase = types.DataPlaneUpgradePhaseFailed
+	status.Phase = types.DataPlaneUpgradePhaseFailed
+	status.Reason = reason
+}
+
+// markUpgradedItemsAsCompleted marks the items which were being upgraded as
+// completed if these are no longer required to be upgraded.
+func markUpgradedItemsAsCompleted(
+	items []types.DataPlaneUpgradeItem, targets []dataPlaneUpgradeTarget,
+) []types.DataPlaneUpgradeItem {
+	pending := make(map[string]bool)
+	for _, target := range targets {
+		pending[target.kind+"/"+target.name] = true
+	}
+	for i := range items {
+		if items[i].Phase == types.DataPlaneUpgradePhaseUpgrading &&
+			!pending[items[i].Kind+"/"+items[i].Name] {
+			items[i].Phase = types.DataPlaneUpgradePhaseCompleted
+			items[i].Progress = ""
+		}
+	}
+	return items
+}
+
+// getDataPlaneUpgradeItem returns the status item of the given target, a new
+// item is added if not present.
+func getDataPlaneUpgradeItem(
+	items *[]types.DataPlaneUpgradeItem, kind, name, fromVersion string,
+) *types.DataPlaneUpgradeItem {
+	for i := range *items {
+		if (*items)[i].Kind == kind && (*items)[i].Name == name {
+			return &(*items)[i]
+		}
+	}
+	*items = append(*items, types.DataPlaneUpgradeItem{
+		Kind:        kind,
+		Name:        name,
+		FromVersion: fromVersion,
+	})
+	return &(*items)[len(*items)-1]
+}
+
+// getCStorPoolsToUpgrade returns the pool clusters i.e., CStorPoolClusters and
+// StoragePoolClaims whose pools are not yet upgraded to the target version.
+func (p *Planner) getCStorPoolsToUpgrade(targetVersion string) ([]dataPlaneUpgradeTarget, error) {
+	var targets []dataPlaneUpgradeTarget
+	cspis, err := k8s.ListResources(cspiGVR)
+	if err != nil {
+		return nil, errors.Errorf("Error listing cStor pool instances: %+v", err)
+	}
+	targets = appendCStorPoolTargets(targets, cspis, targetVersion, "openebs.io/cstor-pool-cluster",
+		dataPlaneUpgradeTarget{
+			kind:           "CStorPoolCluster",
+			upgradeType:    cstorPoolUpgradeType,
+			args:           []string{"cstor-cspc"},
+			image:          p.ObservedOpenEBS.Spec.ImagePrefix + "upgrade",
+			serviceAccount: types.OpenEBSCstorOperatorSANameKey,
+		})
+	csps, err := k8s.ListResources(cspGVR)
+	if err != nil {
+		return nil, errors.Errorf("Error listing cStor pools: %+v", err)
+	}
+	targets = appendCStorPoolTargets(targets, csps, targetVersion, "openebs.io/storage-pool-claim",
+		dataPlaneUpgradeTarget{
+			kind:           "StoragePoolClaim",
+			upgradeType:    cstorPoolUpgradeType,
+			args:           []string{"cstor-spc"},
+			image:          p.ObservedOpenEBS.Spec.ImagePrefix + "m-upgrade",
+			serviceAccount: types.OpenEBSMayaOperatorSANameKey,
+		})
+	return targets, nil
+}
+
+// appendCStorPoolTargets appends a target for every pool cluster which has at
+// least one pool that needs to be upgraded. Pool clusters are upgraded as a
+// whole since there is no upgrade job for a single pool, the upgrade job of a
+// pool cluster upgrades its pools one after the other.
+func appendCStorPoolTargets(
+	targets []dataPlaneUpgradeTarget, pools []unstructured.Unstructured,
+	targetVersion, poolClusterLabelKey string, template dataPlaneUpgradeTarget,
+) []dataPlaneUpgradeTarget {
+	totalPools := make(map[string]int)
+	upgradedPools := make(map[string]int)
+	for _, pool := range pools {
+		poolCluster := pool.GetLabels()[poolClusterLabelKey]
+		if poolCluster == "" {
+			continue
+		}
+		totalPools[poolCluster]++
+		if !isUpgradeRequired(getCurrentVersion(pool), targetVersion) {
+			upgradedPools[poolCluster]++
+		}
+	}
+	added := make(map[string]bool)
+	sortByName(pools)
+	for _, pool := range pools {
+		poolCluster := pool.GetLabels()[poolClusterLabelKey]
+		if poolCluster == "" || added[poolCluster] {
+			continue
+		}
+		currentVersion := getCurrentVersion(pool)
+		if !isUpgradeRequired(currentVersion, targetVersion) {
+			continue
+		}
+		target := template
+		target.name = poolCluster
+		target.fromVersion = currentVersion
+		target.progress = fmt.Sprintf("%d/%d pools upgraded",
+			upgradedPools[poolCluster], totalPools[poolCluster])
+		targets = append(targets, target)
+		added[poolCluster] = true
+	}
+	return targets
+}
+
+// getCStorVolumesToUpgrade returns the cStor volumes which are not yet
+// upgraded to the target version.
+func (p *Planner) getCStorVolumesToUpgrade(targetVersion string) ([]dataPlaneUpgradeTarget, error) {
+	var targets []dataPlaneUpgradeTarget
+	for _, volumeType := range []struct {
+		gvr            schema.GroupVersionResource
+		image          string
+		serviceAccount string
+	}{
+		{cstorVolumeGVR, "upgrade", types.OpenEBSCstorOperatorSANameKey},
+		{legacyCStorVolumeGVR, "m-upgrade", types.OpenEBSMayaOperatorSANameKey},
+	} {
+		volumes, err := k8s.ListResources(volumeType.gvr)
+		if err != nil {
+			return nil, errors.Errorf("Error listing cStor volumes: %+v", err)
+		}
+		sortByName(volumes)
+		for _, volume := range volumes {
+			currentVersion := getCurrentVersion(volume)
+			if !isUpgradeRequired(currentVersion, targetVersion) {
+				continue
+			}
+			// the name of the cStor volume is same as the name of the
+			// persistent volume which is what the upgrade job expects.
+			targets = append(targets, dataPlaneUpgradeTarget{
+				kind:           "CStorVolume",
+				name:           volume.GetName(),
+				fromVersion:    currentVersion,
+				upgradeType:    cstorVolumeUpgradeType,
+				args:           []string{"cstor-volume"},
+				image:          p.ObservedOpenEBS.Spec.ImagePrefix + volumeType.image,
+				serviceAccount: volumeType.serviceAccount,
+			})
+		}
+	}
+	return targets, nil
+}
+
+// getCurrentVersion returns the version at which the given pool or volume
+// is running.
+func getCurrentVersion(resource unstructured.Unstructured) string {
+	version, _, _ := unstructured.NestedString(resource.Object, "versionDetails", "status", "current")
+	if version == "" {
+		version = resource.GetLabels()[types.OpenEBSVersionLabelKey]
+	}
+	return version
+}
+
+// isUpgradeRequired returns true if the current version is below the target
+// version.
+func isUpgradeRequired(currentVersion, targetVersion string) bool {
+	if currentVersion == "" || currentVersion == targetVersion {
+		return false
+	}
+	res, err := compareVersion(currentVersion, targetVersion)
+	if err != nil {
+		glog.Warningf("Can't compare versions %s and %s: %+v", currentVersion, targetVersion, err)
+		return false
+	}
+	return res < 0
+}
+
+// sortByName sorts the given resources by their names so that these are
+// upgraded in the same order across reconciliations.
+func sortByName(resources []unstructured.Unstructured) {
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].GetName() < resources[j].GetName()
+	})
+}
+
+// getDataPlaneUpgradeJob returns the job which upgrades the given target to
+// the target version. The job pulls its image the same way as the other
+// OpenEBS components i.e., using the global imagePullPolicy and
+// imagePullSecrets.
+func (p *Planner) getDataPlaneUpgradeJob(
+	target dataPlaneUpgradeTarget, targetVersion string,
+) (*unstructured.Unstructured, error) {
+	name := target.upgradeType + "-upgrade-" + target.name
+	// job names can't be longer than 63 characters
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-.")
+	}
+	args := toInterfaceSlice(target.args)
+	args = append(args,
+		"--from-version="+target.fromVersion,
+		"--to-version="+targetVersion,
+		"--v=4",
+		target.name,
+	)
+	labels := map[string]interface{}{
+		types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+		types.OpenEBSDataPlaneUpgradeLabelKey:  target.upgradeType,
+	}
+
+	job := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "batch/v1",
+			"kind":       types.KindJob,
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": p.ObservedOpenEBS.Namespace,
+				"labels":    labels,
+				"annotations": map[string]interface{}{
+					types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.UID),
+				},
+			},
+			"spec": map[string]interface{}{
+				"backoffLimit": dataPlaneUpgradeJobBackoffLimit,
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							types.OpenEBSDataPlaneUpgradeLabelKey: target.upgradeType,
+						},
+					},
+					"spec": map[string]interface{}{
+						"serviceAccountName": target.serviceAccount,
+						"restartPolicy":      "OnFailure",
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":            "upgrade",
+								"image":           target.image + ":" + targetVersion,
+								"imagePullPolicy": p.getImagePullPolicy(types.Container{}),
+								"args":            args,
+								"env": []interface{}{
+									map[string]interface{}{
+										"name": "OPENEBS_NAMESPACE",
+										"valueFrom": map[string]interface{}{
+											"fieldRef": map[string]interface{}{
+												"fieldPath": "metadata.namespace",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	err := setDesiredImagePullSecrets(job, p.getImagePullSecrets(nil),
+		"spec", "template", "spec", "imagePullSecrets")
+	if err != nil {
+		return nil, errors.Errorf("Error setting imagePullSecrets of upgrade job %s: %+v", name, err)
+	}
+	return job, nil
+}
+
+// toInterfaceSlice converts the given string slice to an interface slice
+// so that it can be used in unstructured objects.
+func toInterfaceSlice(values []string) []interface{} {
+	var result []interface{}
+	for _, value := range values {
+		result = append(result, value)
+	}
+	return result
+}
+
+// isJobFailed returns true if the given job has failed.
+func isJobFailed(job *unstructured.Unstructured) bool {
+	return hasJobCondition(job, "Failed")
+}
+
+// isJobSucceeded returns true if the given job has completed successfully.
+func isJobSucceeded(job *unstructured.Unstructured) bool {
+	return hasJobCondition(job, "Complete")
+}
+
+// hasJobCondition returns true if the given condition is true for the
+// given job.
+func hasJobCondition(job *unstructured.Unstructured, conditionType string) bool {
+	conditions, _, _ := unstructured.NestedSlice(job.Object, "status", "conditions")
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if conditionMap["type"] == conditionType && conditionMap["status"] == "True" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,728 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+func TestSetDataPlaneUpgradeDefaultsIfNotSet(t *testing.T) {
+	var tests = map[string]struct {
+		rebuildSeconds       *int32
+		expectRebuildSeconds int32
+	}{
+		"rebuild time defaults if not set": {
+			expectRebuildSeconds: DefaultJivaReplicaRebuildSeconds,
+		},
+		"negative rebuild time defaults": {
+			rebuildSeconds:       func() *int32 { v := int32(-1); return &v }(),
+			expectRebuildSeconds: DefaultJivaReplicaRebuildSeconds,
+		},
+		"rebuild time less than the resync period is raised to it": {
+			rebuildSeconds:       func() *int32 { v := int32(5); return &v }(),
+			expectRebuildSeconds: 10,
+		},
+		"rebuild time more than the resync period is kept": {
+			rebuildSeconds:       func() *int32 { v := int32(30); return &v }(),
+			expectRebuildSeconds: 30,
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			p := &Planner{
+				ObservedOpenEBS: &types.OpenEBS{
+					Spec: types.OpenEBSSpec{
+						DataPlaneUpgrade: &types.DataPlaneUpgrade{
+							JivaReplicaRebuildSeconds: mock.rebuildSeconds,
+						},
+					},
+				},
+			}
+			err := p.setDataPlaneUpgradeDefaultsIfNotSet()
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			rebuildSeconds := *p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaReplicaRebuildSeconds
+			if rebuildSeconds != mock.expectRebuildSeconds {
+				t.Fatalf("Expected rebuild seconds %d got %d", mock.expectRebuildSeconds, rebuildSeconds)
+			}
+		})
+	}
+}
+
+func TestUpgradeDataPlane(t *testing.T) {
+	var tests = map[string]struct {
+		resources      []runtime.Object
+		observedStatus *types.DataPlaneUpgradeStatus
+		observedJobs   []*unstructured.Unstructured
+		expectPhase    types.DataPlaneUpgradePhase
+		expectReason   string
+		expectJobs     []string
+		expectPools    []types.DataPlaneUpgradeItem
+		expectVolumes  []types.DataPlaneUpgradeItem
+	}{
+		"pools of a pool cluster are upgraded by a single job": {
+			resources: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "cstor.openebs.io/v1",
+						"kind":       "CStorPoolInstance",
+						"metadata": map[string]interface{}{
+							"name":      "cspc-a-1",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								"openebs.io/cstor-pool-cluster": "cspc-a",
+							},
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.6.0"},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "cstor.openebs.io/v1",
+						"kind":       "CStorPoolInstance",
+						"metadata": map[string]interface{}{
+							"name":      "cspc-a-2",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								"openebs.io/cstor-pool-cluster": "cspc-a",
+							},
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.5.0"},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "cstor.openebs.io/v1",
+						"kind":       "CStorPoolInstance",
+						"metadata": map[string]interface{}{
+							"name":      "cspc-b-1",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								"openebs.io/cstor-pool-cluster": "cspc-b",
+							},
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.5.0"},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "cstor.openebs.io/v1",
+						"kind":       "CStorVolume",
+						"metadata": map[string]interface{}{
+							"name":      "pvc-1",
+							"namespace": "openebs",
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.5.0"},
+						},
+					},
+				},
+			},
+			expectPhase: types.DataPlaneUpgradePhaseUpgrading,
+			expectJobs:  []string{"cstor-pool-upgrade-cspc-a"},
+			expectPools: []types.DataPlaneUpgradeItem{
+				{
+					Kind:        "CStorPoolCluster",
+					Name:        "cspc-a",
+					FromVersion: "2.5.0",
+					Job:         "cstor-pool-upgrade-cspc-a",
+					Phase:       types.DataPlaneUpgradePhaseUpgrading,
+					Progress:    "1/2 pools upgraded",
+				},
+			},
+		},
+		"volumes are upgraded in batches once the pools are upgraded": {
+			resources: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "cstor.openebs.io/v1",
+						"kind":       "CStorPoolInstance",
+						"metadata": map[string]interface{}{
+							"name":      "cspc-a-1",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								"openebs.io/cstor-pool-cluster": "cspc-a",
+							},
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.6.0"},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "cstor.openebs.io/v1",
+						"kind":       "CStorVolume",
+						"metadata": map[string]interface{}{
+							"name":      "pvc-3",
+							"namespace": "openebs",
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.5.0"},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "cstor.openebs.io/v1",
+						"kind":       "CStorVolume",
+						"metadata": map[string]interface{}{
+							"name":      "pvc-1",
+							"namespace": "openebs",
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.5.0"},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "cstor.openebs.io/v1",
+						"kind":       "CStorVolume",
+						"metadata": map[string]interface{}{
+							"name":      "pvc-2",
+							"namespace": "openebs",
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.5.0"},
+						},
+					},
+				},
+			},
+			observedStatus: &types.DataPlaneUpgradeStatus{
+				TargetVersion: "2.6.0",
+				Phase:         types.DataPlaneUpgradePhaseUpgrading,
+				Pools: []types.DataPlaneUpgradeItem{
+					{
+						Kind:        "CStorPoolCluster",
+						Name:        "cspc-a",
+						FromVersion: "2.5.0",
+						Job:         "cstor-pool-upgrade-cspc-a",
+						Phase:       types.DataPlaneUpgradePhaseUpgrading,
+						Progress:    "0/1 pools upgraded",
+					},
+				},
+			},
+			expectPhase: types.DataPlaneUpgradePhaseUpgrading,
+			expectJobs:  []string{"cstor-volume-upgrade-pvc-1", "cstor-volume-upgrade-pvc-2"},
+			expectPools: []types.DataPlaneUpgradeItem{
+				{
+					Kind:        "CStorPoolCluster",
+					Name:        "cspc-a",
+					FromVersion: "2.5.0",
+					Job:         "cstor-pool-upgrade-cspc-a",
+					Phase:       types.DataPlaneUpgradePhaseCompleted,
+				},
+			},
+			expectVolumes: []types.DataPlaneUpgradeItem{
+				{
+					Kind:        "CStorVolume",
+					Name:        "pvc-1",
+					FromVersion: "2.5.0",
+					Job:         "cstor-volume-upgrade-pvc-1",
+					Phase:       types.DataPlaneUpgradePhaseUpgrading,
+				},
+				{
+					Kind:        "CStorVolume",
+					Name:        "pvc-2",
+					FromVersion: "2.5.0",
+					Job:         "cstor-volume-upgrade-pvc-2",
+					Phase:       types.DataPlaneUpgradePhaseUpgrading,
+				},
+			},
+		},
+		"failed job fails the upgrade": {
+			resources: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "cstor.openebs.io/v1",
+						"kind":       "CStorVolume",
+						"metadata": map[string]interface{}{
+							"name":      "pvc-1",
+							"namespace": "openebs",
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.5.0"},
+						},
+					},
+				},
+			},
+			observedJobs: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "batch/v1",
+						"kind":       types.KindJob,
+						"metadata": map[string]interface{}{
+							"name":      "cstor-volume-upgrade-pvc-1",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSDataPlaneUpgradeLabelKey: cstorVolumeUpgradeType,
+							},
+						},
+						"status": map[string]interface{}{
+							"conditions": []interface{}{
+								map[string]interface{}{"type": "Failed", "status": "True"},
+							},
+						},
+					},
+				},
+			},
+			expectPhase:  types.DataPlaneUpgradePhaseFailed,
+			expectReason: "Upgrade job cstor-volume-upgrade-pvc-1 failed for CStorVolume pvc-1",
+			expectJobs:   []string{"cstor-volume-upgrade-pvc-1"},
+			expectVolumes: []types.DataPlaneUpgradeItem{
+				{
+					Kind:        "CStorVolume",
+					Name:        "pvc-1",
+					FromVersion: "2.5.0",
+					Job:         "cstor-volume-upgrade-pvc-1",
+					Phase:       types.DataPlaneUpgradePhaseFailed,
+				},
+			},
+		},
+		"failed upgrade is not continued and only the failed jobs are kept": {
+			resources: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "cstor.openebs.io/v1",
+						"kind":       "CStorVolume",
+						"metadata": map[string]interface{}{
+							"name":      "pvc-2",
+							"namespace": "openebs",
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.5.0"},
+						},
+					},
+				},
+			},
+			observedStatus: &types.DataPlaneUpgradeStatus{
+				TargetVersion: "2.6.0",
+				Phase:         types.DataPlaneUpgradePhaseFailed,
+				Reason:        "Upgrade job cstor-volume-upgrade-pvc-1 failed for CStorVolume pvc-1",
+				Volumes: []types.DataPlaneUpgradeItem{
+					{
+						Kind:        "CStorVolume",
+						Name:        "pvc-1",
+						FromVersion: "2.5.0",
+						Job:         "cstor-volume-upgrade-pvc-1",
+						Phase:       types.DataPlaneUpgradePhaseFailed,
+					},
+				},
+			},
+			observedJobs: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "batch/v1",
+						"kind":       types.KindJob,
+						"metadata": map[string]interface{}{
+							"name":      "cstor-volume-upgrade-pvc-1",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSDataPlaneUpgradeLabelKey: cstorVolumeUpgradeType,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "batch/v1",
+						"kind":       types.KindJob,
+						"metadata": map[string]interface{}{
+							"name":      "cstor-volume-upgrade-pvc-0",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSDataPlaneUpgradeLabelKey: cstorVolumeUpgradeType,
+							},
+						},
+					},
+				},
+			},
+			expectPhase:  types.DataPlaneUpgradePhaseFailed,
+			expectReason: "Upgrade job cstor-volume-upgrade-pvc-1 failed for CStorVolume pvc-1",
+			expectJobs:   []string{"cstor-volume-upgrade-pvc-1"},
+			expectVolumes: []types.DataPlaneUpgradeItem{
+				{
+					Kind:        "CStorVolume",
+					Name:        "pvc-1",
+					FromVersion: "2.5.0",
+					Job:         "cstor-volume-upgrade-pvc-1",
+					Phase:       types.DataPlaneUpgradePhaseFailed,
+				},
+			},
+		},
+		"earlier results are not continued with for another version": {
+			observedStatus: &types.DataPlaneUpgradeStatus{
+				TargetVersion: "2.5.0",
+				Phase:         types.DataPlaneUpgradePhaseFailed,
+				Reason:        "Upgrade job cstor-volume-upgrade-pvc-1 failed for CStorVolume pvc-1",
+			},
+			expectPhase: types.DataPlaneUpgradePhaseCompleted,
+		},
+	}
+	defer func() {
+		k8s.Clientset = nil
+		k8s.DynamicClient = nil
+	}()
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			k8s.Clientset = fake.NewSimpleClientset()
+			k8s.DynamicClient = dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), mock.resources...)
+			enabled := true
+			batchSize := int32(2)
+			p := &Planner{
+				ObservedOpenEBS: &types.OpenEBS{
+					Spec: types.OpenEBSSpec{
+						Version: "2.6.0",
+						DataPlaneUpgrade: &types.DataPlaneUpgrade{
+							Enabled:         &enabled,
+							VolumeBatchSize: &batchSize,
+						},
+					},
+					Status: types.OpenEBSStatus{DataPlaneUpgrade: mock.observedStatus},
+				},
+				ObservedOpenEBSComponents: mock.observedJobs,
+			}
+			response := &ReconcileResponse{}
+			err := p.upgradeDataPlane(response)
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			status := response.DataPlaneUpgradeStatus
+			if status.Phase != mock.expectPhase {
+				t.Fatalf("Expected phase %s got %s: %s", mock.expectPhase, status.Phase, status.Reason)
+			}
+			if status.Reason != mock.expectReason {
+				t.Fatalf("Expected reason %q got %q", mock.expectReason, status.Reason)
+			}
+			var gotJobs []string
+			for _, job := range response.DesiredOpenEBSComponents {
+				gotJobs = append(gotJobs, job.GetName())
+			}
+			if strings.Join(gotJobs, ",") != strings.Join(mock.expectJobs, ",") {
+				t.Fatalf("Expected jobs %v got %v", mock.expectJobs, gotJobs)
+			}
+			if !reflect.DeepEqual(status.Pools, mock.expectPools) {
+				t.Fatalf("Expected pools %+v got %+v", mock.expectPools, status.Pools)
+			}
+			if !reflect.DeepEqual(status.Volumes, mock.expectVolumes) {
+				t.Fatalf("Expected volumes %+v got %+v", mock.expectVolumes, status.Volumes)
+			}
+		})
+	}
+}
+
+func TestMarkUpgradedItemsAsCompleted(t *testing.T) {
+	var tests = map[string]struct {
+		items        []types.DataPlaneUpgradeItem
+		targets      []dataPlaneUpgradeTarget
+		expectPhases []types.DataPlaneUpgradePhase
+	}{
+		"items which are still to be upgraded are not completed": {
+			items: []types.DataPlaneUpgradeItem{
+				{Kind: "CStorVolume", Name: "pvc-1", Phase: types.DataPlaneUpgradePhaseUpgrading},
+			},
+			targets: []dataPlaneUpgradeTarget{
+				{kind: "CStorVolume", name: "pvc-1"},
+			},
+			expectPhases: []types.DataPlaneUpgradePhase{types.DataPlaneUpgradePhaseUpgrading},
+		},
+		"upgraded items are completed": {
+			items: []types.DataPlaneUpgradeItem{
+				{Kind: "CStorVolume", Name: "pvc-1", Phase: types.DataPlaneUpgradePhaseUpgrading},
+				{Kind: "CStorVolume", Name: "pvc-2", Phase: types.DataPlaneUpgradePhaseUpgrading},
+			},
+			targets: []dataPlaneUpgradeTarget{
+				{kind: "CStorVolume", name: "pvc-2"},
+			},
+			expectPhases: []types.DataPlaneUpgradePhase{
+				types.DataPlaneUpgradePhaseCompleted,
+				types.DataPlaneUpgradePhaseUpgrading,
+			},
+		},
+		"targets of another kind are not matched": {
+			items: []types.DataPlaneUpgradeItem{
+				{Kind: "StoragePoolClaim", Name: "pool", Phase: types.DataPlaneUpgradePhaseUpgrading},
+			},
+			targets: []dataPlaneUpgradeTarget{
+				{kind: "CStorPoolCluster", name: "pool"},
+			},
+			expectPhases: []types.DataPlaneUpgradePhase{types.DataPlaneUpgradePhaseCompleted},
+		},
+		"failed items are not completed": {
+			items: []types.DataPlaneUpgradeItem{
+				{Kind: "CStorVolume", Name: "pvc-1", Phase: types.DataPlaneUpgradePhaseFailed},
+			},
+			expectPhases: []types.DataPlaneUpgradePhase{types.DataPlaneUpgradePhaseFailed},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			items := markUpgradedItemsAsCompleted(mock.items, mock.targets)
+			var gotPhases []types.DataPlaneUpgradePhase
+			for _, item := range items {
+				gotPhases = append(gotPhases, item.Phase)
+			}
+			if !reflect.DeepEqual(gotPhases, mock.expectPhases) {
+				t.Fatalf("Expected phases %v got %v", mock.expectPhases, gotPhases)
+			}
+		})
+	}
+}
+
+func TestAppendCStorPoolTargets(t *testing.T) {
+	var tests = map[string]struct {
+		pools         []unstructured.Unstructured
+		expectTargets []dataPlaneUpgradeTarget
+	}{
+		"pools are grouped by their pool cluster": {
+			pools: []unstructured.Unstructured{
+				{
+					Object: map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"name": "spc-b-1",
+							"labels": map[string]interface{}{
+								"openebs.io/storage-pool-claim": "spc-b",
+								types.OpenEBSVersionLabelKey:    "2.4.0",
+							},
+						},
+					},
+				},
+				{
+					Object: map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"name": "spc-a-2",
+							"labels": map[string]interface{}{
+								"openebs.io/storage-pool-claim": "spc-a",
+							},
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.5.0"},
+						},
+					},
+				},
+				{
+					Object: map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"name": "spc-a-1",
+							"labels": map[string]interface{}{
+								"openebs.io/storage-pool-claim": "spc-a",
+							},
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.4.0"},
+						},
+					},
+				},
+				{
+					Object: map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"name": "spc-a-3",
+							"labels": map[string]interface{}{
+								"openebs.io/storage-pool-claim": "spc-a",
+							},
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.6.0"},
+						},
+					},
+				},
+			},
+			expectTargets: []dataPlaneUpgradeTarget{
+				{
+					kind:        "StoragePoolClaim",
+					name:        "spc-a",
+					fromVersion: "2.4.0",
+					progress:    "1/3 pools upgraded",
+				},
+				{
+					kind:        "StoragePoolClaim",
+					name:        "spc-b",
+					fromVersion: "2.4.0",
+					progress:    "0/1 pools upgraded",
+				},
+			},
+		},
+		"pool clusters whose pools are upgraded are skipped": {
+			pools: []unstructured.Unstructured{
+				{
+					Object: map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"name": "spc-a-1",
+							"labels": map[string]interface{}{
+								"openebs.io/storage-pool-claim": "spc-a",
+							},
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.6.0"},
+						},
+					},
+				},
+			},
+		},
+		"pools without a pool cluster are skipped": {
+			pools: []unstructured.Unstructured{
+				{
+					Object: map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"name": "pool-1",
+						},
+						"versionDetails": map[string]interface{}{
+							"status": map[string]interface{}{"current": "2.5.0"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			targets := appendCStorPoolTargets(nil, mock.pools, "2.6.0", "openebs.io/storage-pool-claim",
+				dataPlaneUpgradeTarget{kind: "StoragePoolClaim"})
+			if !reflect.DeepEqual(targets, mock.expectTargets) {
+				t.Fatalf("Expected targets %+v got %+v", mock.expectTargets, targets)
+			}
+		})
+	}
+}
+
+func TestGetDataPlaneUpgradeJob(t *testing.T) {
+	var tests = map[string]struct {
+		target                 dataPlaneUpgradeTarget
+		imagePullSecrets       []types.ImagePullSecret
+		expectName             string
+		expectArgs             []interface{}
+		expectImagePullSecrets []interface{}
+	}{
+		"job of a pool cluster": {
+			target: dataPlaneUpgradeTarget{
+				name:        "cspc-a",
+				fromVersion: "2.5.0",
+				upgradeType: cstorPoolUpgradeType,
+				args:        []string{"cstor-cspc"},
+				image:       "openebs/upgrade",
+			},
+			expectName: "cstor-pool-upgrade-cspc-a",
+			expectArgs: []interface{}{
+				"cstor-cspc", "--from-version=2.5.0", "--to-version=2.6.0", "--v=4", "cspc-a",
+			},
+		},
+		"job uses the global imagePullSecrets": {
+			target: dataPlaneUpgradeTarget{
+				name:        "pvc-1",
+				fromVersion: "2.5.0",
+				upgradeType: cstorVolumeUpgradeType,
+				args:        []string{"cstor-volume"},
+				image:       "openebs/upgrade",
+			},
+			imagePullSecrets: []types.ImagePullSecret{{Name: "registry"}},
+			expectName:       "cstor-volume-upgrade-pvc-1",
+			expectArgs: []interface{}{
+				"cstor-volume", "--from-version=2.5.0", "--to-version=2.6.0", "--v=4", "pvc-1",
+			},
+			expectImagePullSecrets: []interface{}{
+				map[string]interface{}{"name": "registry"},
+			},
+		},
+		"name of the job is truncated to 63 characters": {
+			target: dataPlaneUpgradeTarget{
+				name:        "pvc-0c0d5fc1-4a8f-4b5e-9f4e-1c2d3e4f5a6b-extra",
+				fromVersion: "2.5.0",
+				upgradeType: cstorVolumeUpgradeType,
+				args:        []string{"cstor-volume"},
+				image:       "openebs/upgrade",
+			},
+			expectName: "cstor-volume-upgrade-pvc-0c0d5fc1-4a8f-4b5e-9f4e-1c2d3e4f5a6b-e",
+			expectArgs: []interface{}{
+				"cstor-volume", "--from-version=2.5.0", "--to-version=2.6.0", "--v=4",
+				"pvc-0c0d5fc1-4a8f-4b5e-9f4e-1c2d3e4f5a6b-extra",
+			},
+		},
+		"trailing separators of the truncated name are trimmed": {
+			target: dataPlaneUpgradeTarget{
+				name:        "pvc-0c0d5fc1-4a8f-4b5e-9f4e-1c2d3e4f5a6b1-clone",
+				fromVersion: "2.5.0",
+				upgradeType: cstorVolumeUpgradeType,
+				args:        []string{"cstor-volume"},
+				image:       "openebs/upgrade",
+			},
+			expectName: "cstor-volume-upgrade-pvc-0c0d5fc1-4a8f-4b5e-9f4e-1c2d3e4f5a6b1",
+			expectArgs: []interface{}{
+				"cstor-volume", "--from-version=2.5.0", "--to-version=2.6.0", "--v=4",
+				"pvc-0c0d5fc1-4a8f-4b5e-9f4e-1c2d3e4f5a6b1-clone",
+			},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			p := &Planner{
+				ObservedOpenEBS: &types.OpenEBS{
+					Spec: types.OpenEBSSpec{
+						ImagePullPolicy:  "Always",
+						ImagePullSecrets: mock.imagePullSecrets,
+					},
+				},
+			}
+			job, err := p.getDataPlaneUpgradeJob(mock.target, "2.6.0")
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			if job.GetName() != mock.expectName {
+				t.Fatalf("Expected name %s got %s", mock.expectName, job.GetName())
+			}
+			if len(job.GetName()) > 63 {
+				t.Fatalf("Expected name of at most 63 characters got %d", len(job.GetName()))
+			}
+			containers, _, _ := unstructured.NestedSlice(job.Object, "spec", "template", "spec", "containers")
+			container := containers[0].(map[string]interface{})
+			if !reflect.DeepEqual(container["args"], mock.expectArgs) {
+				t.Fatalf("Expected args %v got %v", mock.expectArgs, container["args"])
+			}
+			if container["imagePullPolicy"] != "Always" {
+				t.Fatalf("Expected imagePullPolicy Always got %v", container["imagePullPolicy"])
+			}
+			imagePullSecrets, _, _ := unstructured.NestedSlice(job.Object,
+				"spec", "template", "spec", "imagePullSecrets")
+			if !reflect.DeepEqual(imagePullSecrets, mock.expectImagePullSecrets) {
+				t.Fatalf("Expected imagePullSecrets %v got %v", mock.expectImagePullSecrets, imagePullSecrets)
+			}
+		})
+	}
+}
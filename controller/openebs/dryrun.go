@@ -0,0 +1,338 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+const (
+	// attachmentCreateAnnotationKey is set by metac on the attachments it
+	// creates with the UID of the watch. Only such attachments are deleted
+	// by metac once these are no longer desired.
+	attachmentCreateAnnotationKey string = metacAnnotationPrefix + "created-due-to-watch"
+
+	// metacAnnotationPrefix is the prefix of the annotations that metac sets
+	// on the attachments.
+	metacAnnotationPrefix string = "metac.openebs.io/"
+
+	// maxDryRunFieldChanges is the maximum number of field changes that are
+	// reported per component so that the status stays within limits.
+	maxDryRunFieldChanges = 20
+
+	// maxDryRunFieldValueLength is the maximum length of the observed and
+	// desired values reported per field change.
+	maxDryRunFieldValueLength = 256
+)
+
+// isDryRun returns true if the changes should only be planned and reported
+// instead of being applied.
+//
+// NOTE: The spec as given by the user is checked since the spec being
+// rendered can be the last known good spec if the upgrade was rolled back.
+func (p *Planner) isDryRun() bool {
+	return p.GivenSpec != nil && p.GivenSpec.DryRun != nil && *p.GivenSpec.DryRun
+}
+
+// getDryRunPlan returns the components that will be added, changed or
+// deleted by metac if the given response is applied against the observed
+// components of OpenEBS with the given UID.
+func getDryRunPlan(
+	openebsUID string, observedComponents []*unstructured.Unstructured,
+	response ReconcileResponse,
+) *types.DryRunPlan {
+	plan := &types.DryRunPlan{}
+	observedByKey := make(map[string]*unstructured.Unstructured)
+	for _, observed := range observedComponents {
+		observedByKey[getComponentKey(observed)] = observed
+	}
+
+	desiredKeys := make(map[string]bool)
+	desiredComponents := append([]*unstructured.Unstructured{}, response.DesiredOpenEBSComponents...)
+	for _, update := range response.ExplicitUpdates {
+		if update.GetKind() != string(types.KindOpenEBS) {
+			desiredComponents = append(desiredComponents, update)
+		}
+	}
+	for _, desired := range desiredComponents {
+		key := getComponentKey(desired)
+		if desiredKeys[key] {
+			continue
+		}
+		desiredKeys[key] = true
+		observed, exist := observedByKey[key]
+		if !exist {
+			plan.Add = append(plan.Add, types.PlannedChange{
+				ComponentReference: getComponentReference(desired),
+			})
+			continue
+		}
+		fieldChanges := getFieldChanges(observed.Object, desired.Object, "")
+		if len(fieldChanges) == 0 {
+			continue
+		}
+		change := types.PlannedChange{
+			ComponentReference: getComponentReference(desired),
+			RestartsPods:       isPodRestartRequired(desired.GetKind(), fieldChanges),
+		}
+		// report how the pods will be restarted so that the pace of the
+		// upgrade of the node components can be reviewed
+		if change.RestartsPods {
+			change.RolloutStrategy = getRolloutStrategy(desired)
+		}
+		if len(fieldChanges) > maxDryRunFieldChanges {
+			fieldChanges = append(fieldChanges[:maxDryRunFieldChanges], types.FieldChange{
+				Path: fmt.Sprintf("... %d more", len(fieldChanges)-maxDryRunFieldChanges),
+			})
+		}
+		change.FieldChanges = fieldChanges
+		plan.Change = append(plan.Change, change)
+	}
+
+	deleteKeys := make(map[string]bool)
+	for _, component := range response.ExplicitDeletes {
+		key := getComponentKey(component)
+		if deleteKeys[key] {
+			continue
+		}
+		deleteKeys[key] = true
+		plan.Delete = append(plan.Delete, types.PlannedChange{
+			ComponentReference: getComponentReference(component),
+		})
+	}
+	// metac deletes the attachments which it has created but are no longer
+	// desired.
+	for _, observed := range observedComponents {
+		key := getComponentKey(observed)
+		if desiredKeys[key] || deleteKeys[key] ||
+			observed.GetAnnotations()[attachmentCreateAnnotationKey] != openebsUID {
+			continue
+		}
+		plan.Delete = append(plan.Delete, types.PlannedChange{
+			ComponentReference: getComponentReference(observed),
+		})
+	}
+
+	for _, changes := range [][]types.PlannedChange{plan.Add, plan.Change, plan.Delete} {
+		sort.Slice(changes, func(i, j int) bool {
+			return getComponentReferenceKey(changes[i].ComponentReference) <
+				getComponentReferenceKey(changes[j].ComponentReference)
+		})
+	}
+	plan.Summary = fmt.Sprintf("%d to add, %d to change, %d to delete",
+		len(plan.Add), len(plan.Change), len(plan.Delete))
+	return plan
+}
+
+// getFieldChanges returns the fields of the desired object whose values
+// differ from the observed object. Only the fields set in the desired object
+// are compared since the rest are either defaulted or managed by kubernetes.
+func getFieldChanges(observed, desired map[string]interface{}, path string) []types.FieldChange {
+	var fieldChanges []types.FieldChange
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+		if isIgnoredFieldPath(fieldPath) {
+			continue
+		}
+		observedValue, exist := observed[key]
+		if !exist {
+			fieldChanges = append(fieldChanges, newFieldChange(fieldPath, nil, desired[key]))
+			continue
+		}
+		fieldChanges = append(fieldChanges, getValueChanges(observedValue, desired[key], fieldPath)...)
+	}
+	return fieldChanges
+}
+
+// getValueChanges returns the changes between the given observed and desired
+// values. Maps are compared field by field while lists of named items such as
+// containers are compared item by item.
+func getValueChanges(observed, desired interface{}, path string) []types.FieldChange {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		observedValue, ok := observed.(map[string]interface{})
+		if !ok {
+			break
+		}
+		return getFieldChanges(observedValue, desiredValue, path)
+	case []interface{}:
+		observedValue, ok := observed.([]interface{})
+		if !ok {
+			break
+		}
+		if changes, ok := getNamedListChanges(observedValue, desiredValue, path); ok {
+			return changes
+		}
+		if len(observedValue) != len(desiredValue) {
+			break
+		}
+		var changes []types.FieldChange
+		for i := range desiredValue {
+			changes = append(changes,
+				getValueChanges(observedValue[i], desiredValue[i], fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return changes
+	}
+	if isSameValue(observed, desired) {
+		return nil
+	}
+	return []types.FieldChange{newFieldChange(path, observed, desired)}
+}
+
+// getNamedListChanges compares the lists whose items are identified by their
+// names such as containers, env, volumes, etc. It returns false if any of the
+// items is not named.
+func getNamedListChanges(observed, desired []interface{}, path string) ([]types.FieldChange, bool) {
+	observedByName := make(map[string]interface{})
+	for _, item := range observed {
+		name, ok := getItemName(item)
+		if !ok {
+			return nil, false
+		}
+		observedByName[name] = item
+	}
+	var changes []types.FieldChange
+	desiredNames := make(map[string]bool)
+	for _, item := range desired {
+		name, ok := getItemName(item)
+		if !ok {
+			return nil, false
+		}
+		desiredNames[name] = true
+		itemPath := fmt.Sprintf("%s[name=%s]", path, name)
+		observedItem, exist := observedByName[name]
+		if !exist {
+			changes = append(changes, newFieldChange(itemPath, nil, item))
+			continue
+		}
+		changes = append(changes, getValueChanges(observedItem, item, itemPath)...)
+	}
+	for _, item := range observed {
+		name, _ := getItemName(item)
+		if !desiredNames[name] {
+			changes = append(changes, newFieldChange(fmt.Sprintf("%s[name=%s]", path, name), item, nil))
+		}
+	}
+	return changes, true
+}
+
+// getItemName returns the name of the given list item if it is a map with
+// a name field.
+func getItemName(item interface{}) (string, bool) {
+	itemMap, ok := item.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	name, ok := itemMap["name"].(string)
+	return name, ok
+}
+
+// isIgnoredFieldPath returns true if the given field is not compared since
+// it is either managed by kubernetes or by metac.
+func isIgnoredFieldPath(path string) bool {
+	if path == "status" || strings.HasPrefix(path, "status.") {
+		return true
+	}
+	if !strings.HasPrefix(path, "metadata.") {
+		return false
+	}
+	return !strings.HasPrefix(path, "metadata.labels") &&
+		!strings.HasPrefix(path, "metadata.annotations")
+}
+
+// isSameValue returns true if both the values are the same once encoded
+// i.e., numbers of different types such as int64 and float64 are treated
+// as the same.
+func isSameValue(observed, desired interface{}) bool {
+	observedRaw, err := json.Marshal(observed)
+	if err != nil {
+		return false
+	}
+	desiredRaw, err := json.Marshal(desired)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(observedRaw, desiredRaw)
+}
+
+// newFieldChange returns the field change of the given field with the values
+// JSON encoded.
+func newFieldChange(path string, observed, desired interface{}) types.FieldChange {
+	return types.FieldChange{
+		Path:     path,
+		Observed: encodeFieldValue(observed),
+		Desired:  encodeFieldValue(desired),
+	}
+}
+
+// encodeFieldValue returns the given value JSON encoded and truncated to
+// the maximum length.
+func encodeFieldValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	valueRaw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	encoded := string(valueRaw)
+	if len(encoded) > maxDryRunFieldValueLength {
+		encoded = encoded[:maxDryRunFieldValueLength] + "..."
+	}
+	return encoded
+}
+
+// isPodRestartRequired returns true if any of the given changes updates the
+// pod template of a workload such as its images, env, etc.
+func isPodRestartRequired(kind string, fieldChanges []types.FieldChange) bool {
+	if !isWorkload(kind) {
+		return false
+	}
+	for _, change := range fieldChanges {
+		if strings.HasPrefix(change.Path, "spec.template.") {
+			return true
+		}
+	}
+	return false
+}
+
+// getComponentReference returns the reference to the given component.
+func getComponentReference(component *unstructured.Unstructured) types.ComponentReference {
+	return types.ComponentReference{
+		Kind:      component.GetKind(),
+		Namespace: component.GetNamespace(),
+		Name:      component.GetName(),
+	}
+}
+
+// getComponentReferenceKey returns the key with which the referred component
+// can be uniquely identified i.e., kind/namespace/name.
+func getComponentReferenceKey(reference types.ComponentReference) string {
+	return reference.Kind + "/" + reference.Namespace + "/" + reference.Name
+}
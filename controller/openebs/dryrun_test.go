@@ -0,0 +1,364 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+// getFieldChangePaths returns the paths of the given field changes.
+func getFieldChangePaths(fieldChanges []types.FieldChange) string {
+	var paths []string
+	for _, change := range fieldChanges {
+		paths = append(paths, change.Path)
+	}
+	return strings.Join(paths, ",")
+}
+
+func TestGetFieldChanges(t *testing.T) {
+	var tests = map[string]struct {
+		observed       map[string]interface{}
+		desired        map[string]interface{}
+		expectPaths    string
+		expectObserved string
+		expectDesired  string
+	}{
+		"no changes": {
+			observed: map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(1)}},
+			desired:  map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(1)}},
+		},
+		"numbers of different types are the same": {
+			observed: map[string]interface{}{"spec": map[string]interface{}{"replicas": float64(1)}},
+			desired:  map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(1)}},
+		},
+		"changed field": {
+			observed:       map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(1)}},
+			desired:        map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(2)}},
+			expectPaths:    "spec.replicas",
+			expectObserved: "1",
+			expectDesired:  "2",
+		},
+		"added field": {
+			observed:      map[string]interface{}{"spec": map[string]interface{}{}},
+			desired:       map[string]interface{}{"spec": map[string]interface{}{"paused": true}},
+			expectPaths:   "spec.paused",
+			expectDesired: "true",
+		},
+		"fields which are not desired are ignored": {
+			observed: map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(1), "paused": true}},
+			desired:  map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(1)}},
+		},
+		"status and metadata other than labels and annotations are ignored": {
+			observed: map[string]interface{}{
+				"metadata": map[string]interface{}{"resourceVersion": "1", "labels": map[string]interface{}{"a": "1"}},
+				"status":   map[string]interface{}{"replicas": int64(1)},
+			},
+			desired: map[string]interface{}{
+				"metadata": map[string]interface{}{"resourceVersion": "2", "labels": map[string]interface{}{"a": "2"}},
+				"status":   map[string]interface{}{"replicas": int64(2)},
+			},
+			expectPaths: "metadata.labels.a",
+		},
+		"named list items are compared by name": {
+			observed: map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"name": "b", "image": "b:1"},
+					map[string]interface{}{"name": "a", "image": "a:1"},
+					map[string]interface{}{"name": "c", "image": "c:1"},
+				},
+			},
+			desired: map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"name": "a", "image": "a:2"},
+					map[string]interface{}{"name": "b", "image": "b:1"},
+					map[string]interface{}{"name": "d", "image": "d:1"},
+				},
+			},
+			expectPaths: "containers[name=a].image,containers[name=d],containers[name=c]",
+		},
+		"unnamed list items are compared by index": {
+			observed:    map[string]interface{}{"args": []interface{}{"--a", "--b"}},
+			desired:     map[string]interface{}{"args": []interface{}{"--a", "--c"}},
+			expectPaths: "args[1]",
+		},
+		"unnamed lists of different lengths are changed as a whole": {
+			observed:       map[string]interface{}{"args": []interface{}{"--a"}},
+			desired:        map[string]interface{}{"args": []interface{}{"--a", "--b"}},
+			expectPaths:    "args",
+			expectObserved: `["--a"]`,
+			expectDesired:  `["--a","--b"]`,
+		},
+		"value of a different type is changed as a whole": {
+			observed:       map[string]interface{}{"spec": "value"},
+			desired:        map[string]interface{}{"spec": map[string]interface{}{"a": "b"}},
+			expectPaths:    "spec",
+			expectObserved: `"value"`,
+			expectDesired:  `{"a":"b"}`,
+		},
+		"long values are truncated": {
+			observed:       map[string]interface{}{"data": strings.Repeat("a", maxDryRunFieldValueLength)},
+			desired:        map[string]interface{}{"data": "b"},
+			expectPaths:    "data",
+			expectObserved: `"` + strings.Repeat("a", maxDryRunFieldValueLength-1) + "...",
+			expectDesired:  `"b"`,
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			fieldChanges := getFieldChanges(mock.observed, mock.desired, "")
+			if paths := getFieldChangePaths(fieldChanges); paths != mock.expectPaths {
+				t.Fatalf("Expected changed paths %q got %q", mock.expectPaths, paths)
+			}
+			if mock.expectObserved != "" && fieldChanges[0].Observed != mock.expectObserved {
+				t.Fatalf("Expected observed value %s got %s", mock.expectObserved, fieldChanges[0].Observed)
+			}
+			if mock.expectDesired != "" && fieldChanges[0].Desired != mock.expectDesired {
+				t.Fatalf("Expected desired value %s got %s", mock.expectDesired, fieldChanges[0].Desired)
+			}
+		})
+	}
+}
+
+func TestIsPodRestartRequired(t *testing.T) {
+	var tests = map[string]struct {
+		kind          string
+		paths         []string
+		expectRestart bool
+	}{
+		"pod template of a deployment is changed": {
+			kind:          types.KindDeployment,
+			paths:         []string{"spec.replicas", "spec.template.spec.containers[name=a].image"},
+			expectRestart: true,
+		},
+		"pod template of a daemonset is changed": {
+			kind:          types.KindDaemonSet,
+			paths:         []string{"spec.template.metadata.labels.a"},
+			expectRestart: true,
+		},
+		"fields other than the pod template are changed": {
+			kind:  types.KindDeployment,
+			paths: []string{"spec.replicas", "metadata.labels.a", "spec.strategy.type"},
+		},
+		"component is not a workload": {
+			kind:  types.KindConfigMap,
+			paths: []string{"spec.template.data"},
+		},
+		"no changes": {
+			kind: types.KindStatefulset,
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			var fieldChanges []types.FieldChange
+			for _, path := range mock.paths {
+				fieldChanges = append(fieldChanges, types.FieldChange{Path: path})
+			}
+			isRestart := isPodRestartRequired(mock.kind, fieldChanges)
+			if isRestart != mock.expectRestart {
+				t.Fatalf("Expected restart %t got %t", mock.expectRestart, isRestart)
+			}
+		})
+	}
+}
+
+func TestGetDryRunPlan(t *testing.T) {
+	observed := []*unstructured.Unstructured{
+		&unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"kind": types.KindDeployment,
+				"metadata": map[string]interface{}{
+					"name":       "maya-apiserver",
+					"namespace":  "openebs",
+					"generation": int64(1),
+					"labels": map[string]interface{}{
+						types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+						types.OpenEBSVersionLabelKey:           "2.5.0",
+					},
+				},
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{
+									"name":  "maya-apiserver",
+									"image": "openebs/maya-apiserver:2.5.0",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		&unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"kind": types.KindDaemonSet,
+				"metadata": map[string]interface{}{
+					"name":      "openebs-ndm",
+					"namespace": "openebs",
+					"labels": map[string]interface{}{
+						types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+					},
+				},
+			},
+		},
+		&unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"kind": types.KindService,
+				"metadata": map[string]interface{}{
+					"name":      "maya-apiserver-service",
+					"namespace": "openebs",
+					"labels": map[string]interface{}{
+						types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+					},
+				},
+			},
+		},
+		&unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"kind": types.KindDeployment,
+				"metadata": map[string]interface{}{
+					"name":      "openebs-provisioner",
+					"namespace": "openebs",
+					"annotations": map[string]interface{}{
+						attachmentCreateAnnotationKey: "uid",
+					},
+					"labels": map[string]interface{}{
+						types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+					},
+				},
+			},
+		},
+		&unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"kind": types.KindDeployment,
+				"metadata": map[string]interface{}{
+					"name":      "openebs-localpv-provisioner",
+					"namespace": "openebs",
+					"labels": map[string]interface{}{
+						types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+					},
+				},
+			},
+		},
+	}
+	response := ReconcileResponse{
+		DesiredOpenEBSComponents: []*unstructured.Unstructured{
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.6.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"metadata": map[string]interface{}{
+						"name":      "openebs-ndm",
+						"namespace": "openebs",
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+						},
+					},
+				},
+			},
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":      "cspc-operator",
+						"namespace": "openebs",
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+						},
+					},
+				},
+			},
+		},
+		ExplicitUpdates: []*unstructured.Unstructured{
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindService,
+					"metadata": map[string]interface{}{
+						"name":      "maya-apiserver-service",
+						"namespace": "openebs",
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+						},
+					},
+				},
+			},
+		},
+		ExplicitDeletes: []*unstructured.Unstructured{
+			&unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":      "openebs-localpv-provisioner",
+						"namespace": "openebs",
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+						},
+					},
+				},
+			},
+		},
+	}
+	plan := getDryRunPlan("uid", observed, response)
+	if plan.Summary != "1 to add, 1 to change, 2 to delete" {
+		t.Fatalf("Expected summary %q got %q", "1 to add, 1 to change, 2 to delete", plan.Summary)
+	}
+	if added := plan.Add[0].Name + "_" + plan.Add[0].Kind; added != types.CSPCOperatorManifestKey {
+		t.Fatalf("Expected %s to be added got %s", types.CSPCOperatorManifestKey, added)
+	}
+	change := plan.Change[0]
+	if change.Name != "maya-apiserver" || !change.RestartsPods {
+		t.Fatalf("Expected maya-apiserver to be changed restarting the pods got %+v", change)
+	}
+	var deleted []string
+	for _, deletion := range plan.Delete {
+		deleted = append(deleted, deletion.Name+"_"+deletion.Kind)
+	}
+	expectDeleted := types.LocalProvisionerManifestKey + "," + types.ProvisionerManifestKey
+	if strings.Join(deleted, ",") != expectDeleted {
+		t.Fatalf("Expected deletes %s got %v", expectDeleted, deleted)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+// componentState is the state of a managed component as observed in a sync,
+// it is compared with the state observed in the next sync to find out if the
+// component has been created, updated or deleted in the meantime.
+type componentState struct {
+	generation int64
+	version    string
+}
+
+var (
+	// observedComponentStates stores the states of the managed components
+	// of each OpenEBS, by its UID, as observed in the last sync.
+	observedComponentStates     = make(map[apitypes.UID]map[string]componentState)
+	observedComponentStatesLock sync.Mutex
+)
+
+// recordComponentEvents records an event against OpenEBS for each of its
+// managed components which has been created, updated or deleted since the
+// last sync i.e., as per the state transitions actually observed rather than
+// the changes being planned. No events are recorded in the first sync of an
+// OpenEBS since there is nothing to compare with.
+func recordComponentEvents(openebs *unstructured.Unstructured, observedComponents []*unstructured.Unstructured) {
+	states := make(map[string]componentState)
+	for _, component := range observedComponents {
+		labels := component.GetLabels()
+		if labels[types.OpenEBSUpgradeDAOManagedLabelKey] != types.OpenEBSUpgradeDAOManagedLabelValue {
+			continue
+		}
+		states[getComponentReferenceKey(getComponentReference(component))] = componentState{
+			generation: component.GetGeneration(),
+			version:    labels[types.OpenEBSVersionLabelKey],
+		}
+	}
+	observedComponentStatesLock.Lock()
+	previousStates, exist := observedComponentStates[openebs.GetUID()]
+	observedComponentStates[openebs.GetUID()] = states
+	observedComponentStatesLock.Unlock()
+	if !exist {
+		return
+	}
+
+	keys := make([]string, 0, len(states))
+	for key := range states {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		state := states[key]
+		previousState, exist := previousStates[key]
+		switch {
+		case !exist:
+			k8s.RecordEvent(openebs, corev1.EventTypeNormal, types.EventReasonComponentCreated,
+				"Created "+key)
+		case previousState.version != state.version:
+			k8s.RecordEvent(openebs, corev1.EventTypeNormal, types.EventReasonComponentUpdated,
+				fmt.Sprintf("Updated %s from version %s to version %s",
+					key, previousState.version, state.version))
+		case previousState.generation != state.generation:
+			k8s.RecordEvent(openebs, corev1.EventTypeNormal, types.EventReasonComponentUpdated,
+				"Updated "+key)
+		}
+	}
+	keys = keys[:0]
+	for key := range previousStates {
+		if _, exist := states[key]; !exist {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		k8s.RecordEvent(openebs, corev1.EventTypeNormal, types.EventReasonComponentDeleted,
+			"Deleted "+key)
+	}
+}
+
+// forgetComponentEvents forgets the observed components and the events
+// recorded against the OpenEBS with the given UID once it is uninstalled.
+func forgetComponentEvents(uid apitypes.UID) {
+	observedComponentStatesLock.Lock()
+	delete(observedComponentStates, uid)
+	observedComponentStatesLock.Unlock()
+	k8s.ForgetEvents(uid)
+}
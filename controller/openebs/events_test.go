@@ -0,0 +1,287 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+// getRecordedEvents returns the events recorded by the given fake recorder.
+func getRecordedEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestRecordComponentEvents(t *testing.T) {
+	apiServer := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind": types.KindDeployment,
+			"metadata": map[string]interface{}{
+				"name":       "maya-apiserver",
+				"namespace":  "openebs",
+				"generation": int64(1),
+				"labels": map[string]interface{}{
+					types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+					types.OpenEBSVersionLabelKey:           "2.5.0",
+				},
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":  "maya-apiserver",
+								"image": "openebs/maya-apiserver:2.5.0",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	upgradedAPIServer := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind": types.KindDeployment,
+			"metadata": map[string]interface{}{
+				"name":       "maya-apiserver",
+				"namespace":  "openebs",
+				"generation": int64(2),
+				"labels": map[string]interface{}{
+					types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+					types.OpenEBSVersionLabelKey:           "2.6.0",
+				},
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":  "maya-apiserver",
+								"image": "openebs/maya-apiserver:2.6.0",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	updatedAPIServer := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind": types.KindDeployment,
+			"metadata": map[string]interface{}{
+				"name":       "maya-apiserver",
+				"namespace":  "openebs",
+				"generation": int64(2),
+				"labels": map[string]interface{}{
+					types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+					types.OpenEBSVersionLabelKey:           "2.5.0",
+				},
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":  "maya-apiserver",
+								"image": "openebs/maya-apiserver:2.5.0",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	ndm := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind": types.KindDaemonSet,
+			"metadata": map[string]interface{}{
+				"name":       "openebs-ndm",
+				"namespace":  "openebs",
+				"generation": int64(1),
+				"labels": map[string]interface{}{
+					types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+					types.OpenEBSVersionLabelKey:           "2.5.0",
+				},
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":  "openebs-ndm",
+								"image": "openebs/openebs-ndm:2.5.0",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	var tests = map[string]struct {
+		previous     []*unstructured.Unstructured
+		observed     []*unstructured.Unstructured
+		expectEvents []string
+	}{
+		"no events are recorded in the first sync": {
+			observed: []*unstructured.Unstructured{apiServer},
+		},
+		"no events are recorded if nothing changed": {
+			previous: []*unstructured.Unstructured{apiServer},
+			observed: []*unstructured.Unstructured{apiServer},
+		},
+		"created component": {
+			previous: []*unstructured.Unstructured{apiServer},
+			observed: []*unstructured.Unstructured{apiServer, ndm},
+			expectEvents: []string{
+				"Normal ComponentCreated Created DaemonSet/openebs/openebs-ndm",
+			},
+		},
+		"upgraded component": {
+			previous: []*unstructured.Unstructured{apiServer},
+			observed: []*unstructured.Unstructured{upgradedAPIServer},
+			expectEvents: []string{
+				"Normal ComponentUpdated Updated Deployment/openebs/maya-apiserver from version 2.5.0 to version 2.6.0",
+			},
+		},
+		"updated component": {
+			previous: []*unstructured.Unstructured{apiServer},
+			observed: []*unstructured.Unstructured{updatedAPIServer},
+			expectEvents: []string{
+				"Normal ComponentUpdated Updated Deployment/openebs/maya-apiserver",
+			},
+		},
+		"deleted component": {
+			previous: []*unstructured.Unstructured{apiServer, ndm},
+			observed: []*unstructured.Unstructured{apiServer},
+			expectEvents: []string{
+				"Normal ComponentDeleted Deleted DaemonSet/openebs/openebs-ndm",
+			},
+		},
+		"components which are not managed are ignored": {
+			previous: []*unstructured.Unstructured{apiServer},
+			observed: []*unstructured.Unstructured{
+				apiServer, &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-ndm",
+							"namespace": "openebs",
+						},
+					},
+				},
+			},
+		},
+	}
+	defer func(recorder record.EventRecorder) {
+		k8s.EventRecorder = recorder
+	}(k8s.EventRecorder)
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			k8s.EventRecorder = recorder
+			openebs := &unstructured.Unstructured{}
+			openebs.SetUID(apitypes.UID(name))
+			defer forgetComponentEvents(openebs.GetUID())
+			if mock.previous != nil {
+				recordComponentEvents(openebs, mock.previous)
+			}
+			recordComponentEvents(openebs, mock.observed)
+			events := getRecordedEvents(recorder)
+			if strings.Join(events, ",") != strings.Join(mock.expectEvents, ",") {
+				t.Fatalf("Expected events %v got %v", mock.expectEvents, events)
+			}
+		})
+	}
+}
+
+func TestRecordComponentEventsPerOpenEBS(t *testing.T) {
+	defer func(recorder record.EventRecorder) {
+		k8s.EventRecorder = recorder
+	}(k8s.EventRecorder)
+	recorder := record.NewFakeRecorder(10)
+	k8s.EventRecorder = recorder
+	apiServer := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind": types.KindDeployment,
+			"metadata": map[string]interface{}{
+				"name":       "maya-apiserver",
+				"namespace":  "openebs",
+				"generation": int64(1),
+				"labels": map[string]interface{}{
+					types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+					types.OpenEBSVersionLabelKey:           "2.5.0",
+				},
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":  "maya-apiserver",
+								"image": "openebs/maya-apiserver:2.5.0",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	openebs1 := &unstructured.Unstructured{}
+	openebs1.SetUID("uid-1")
+	openebs2 := &unstructured.Unstructured{}
+	openebs2.SetUID("uid-2")
+	defer forgetComponentEvents(openebs1.GetUID())
+	defer forgetComponentEvents(openebs2.GetUID())
+
+	recordComponentEvents(openebs1, nil)
+	recordComponentEvents(openebs2, nil)
+	recordComponentEvents(openebs1, []*unstructured.Unstructured{apiServer})
+	// the same event of another OpenEBS is not deduplicated
+	recordComponentEvents(openebs2, []*unstructured.Unstructured{apiServer})
+	if events := getRecordedEvents(recorder); len(events) != 2 {
+		t.Fatalf("Expected 2 events got %v", events)
+	}
+
+	// the identical event of the same OpenEBS is deduplicated till the
+	// OpenEBS is forgotten
+	recordComponentEvents(openebs1, nil)
+	recordComponentEvents(openebs1, []*unstructured.Unstructured{apiServer})
+	events := getRecordedEvents(recorder)
+	if len(events) != 1 || !strings.Contains(events[0], "Deleted") {
+		t.Fatalf("Expected only the delete event got %v", events)
+	}
+	forgetComponentEvents(openebs1.GetUID())
+	recordComponentEvents(openebs1, nil)
+	recordComponentEvents(openebs1, []*unstructured.Unstructured{apiServer})
+	events = getRecordedEvents(recorder)
+	if len(events) != 1 || !strings.Contains(events[0], "Created") {
+		t.Fatalf("Expected the create event got %v", events)
+	}
+}
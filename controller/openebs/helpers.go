@@ -28,7 +28,8 @@ func (p *Planner) setHelperDefaultsIfNotSet() error {
 		p.ObservedOpenEBS.Spec.Helper.ImageTag = p.ObservedOpenEBS.Spec.Version +
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
-	p.ObservedOpenEBS.Spec.Helper.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.Helper.Image = getImagePrefix(p.ObservedOpenEBS.Spec.Helper.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"linux-utils:" + p.ObservedOpenEBS.Spec.Helper.ImageTag
 	return nil
 }
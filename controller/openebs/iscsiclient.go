@@ -5,9 +5,10 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
-	"io/ioutil"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/pkg/templates"
 	"mayadata.io/openebs-upgrade/types"
 	"strings"
 )
@@ -62,6 +63,8 @@ func (p *Planner) getISCSISetupComponentsStatus() (bool, error) {
 		// update the isSetupDone field in OpenEBS CR since it is a one time process
 		// and should not be done again once completed.
 		p.ObservedOpenEBS.Spec.PreInstallation.ISCSIClient.IsSetupDone = true
+		p.addEvent(corev1.EventTypeNormal, types.EventReasonISCSISetupCompleted,
+			"ISCSI client has been set up on the nodes")
 		p.ObservedOpenEBS.Status.Phase = "Online"
 		p.ObservedOpenEBS.Status.Reason = ""
 		var openebs *unstructured.Unstructured
@@ -143,16 +146,16 @@ func (p *Planner) getISCSIInstallationManifest() (map[string]*unstructured.Unstr
 	osImageInLowercase := strings.ToLower(osImage)
 	switch true {
 	case strings.Contains(osImageInLowercase, "ubuntu"):
-		yamlFile = "/templates/iscsi-ubuntu-setup.yaml"
+		yamlFile = iscsiUbuntuSetupTemplate
 	case strings.Contains(osImageInLowercase, strings.ToLower("Red Hat Enterprise Linux")) ||
 		strings.Contains(osImageInLowercase, "centos") ||
 		strings.Contains(osImageInLowercase, "amazon linux"):
-		yamlFile = "/templates/iscsi-amazonlinux-setup.yaml"
+		yamlFile = iscsiAmazonLinuxSetupTemplate
 	default:
 		glog.V(3).Infof("ISCSI installation is not yet supported for %s.", osImage)
 		return componentsYAMLMap, nil
 	}
-	iscsiYaml, err := ioutil.ReadFile(yamlFile)
+	iscsiYaml, err := templates.ReadFile(yamlFile)
 	if err != nil {
 		return componentsYAMLMap, errors.New("Error reading ISCSI installation YAML file.")
 	}
@@ -20,31 +20,8 @@ import (
 const (
 	// DefaultJivaReplicaCount is the default value of jiva replicas
 	DefaultJivaReplicaCount int32 = 3
-	// JivaVersion162 is the supported image tag for Jiva components
-	// for OpenEBS version 1.6.0.
-	JivaVersion162 string = "1.6.2"
-	// JivaVersion171 is the supported image tag for Jiva components
-	// for OpenEBS version 1.7.0.
-	JivaVersion171 string = "1.7.1"
 )
 
-// supportedJivaVersionForOpenEBSVersion stores the mapping for
-// Jiva to OpenEBS version i.e., a Jiva version for each of the
-// supported OpenEBS versions.
-// Note: This will be referred to form the container images in
-// order to install/update jiva controller/replica components for a
-// particular OpenEBS version.
-//
-// NOTE: There will be an entry for only those OpenEBS versions and the
-// supported Jiva versions where the image tag for Jiva images are different
-// from the OpenEBS version such as in case of OpenEBS version 1.6.0, the jiva
-// controller/replica image that should be used is 1.6.2 instead of 1.6.0 since
-// 1.6.2 have some critical fixes which 1.6.0 doesn't have.
-var supportedJivaVersionForOpenEBSVersion = map[string]string{
-	types.OpenEBSVersion160: JivaVersion162,
-	types.OpenEBSVersion170: JivaVersion171,
-}
-
 // Set the default values for JIVA.
 func (p *Planner) setJIVADefaultsIfNotSet() error {
 	if p.ObservedOpenEBS.Spec.JivaConfig == nil {
@@ -53,7 +30,10 @@ func (p *Planner) setJIVADefaultsIfNotSet() error {
 	// form the jiva image being used by jiva-controller and
 	// replica.
 	if p.ObservedOpenEBS.Spec.JivaConfig.ImageTag == "" {
-		if jivaVersion, exist := supportedJivaVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+		// the jiva version can differ from the OpenEBS version such as in case
+		// of OpenEBS version 1.6.0, the jiva image that should be used is 1.6.2
+		// since it has some critical fixes which 1.6.0 doesn't have.
+		if jivaVersion := p.Release.Components.Jiva; jivaVersion != "" {
 			p.ObservedOpenEBS.Spec.JivaConfig.ImageTag = jivaVersion +
 				p.ObservedOpenEBS.Spec.ImageTagSuffix
 		} else {
@@ -61,7 +41,8 @@ func (p *Planner) setJIVADefaultsIfNotSet() error {
 				p.ObservedOpenEBS.Spec.ImageTagSuffix
 		}
 	}
-	p.ObservedOpenEBS.Spec.JivaConfig.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.JivaConfig.Image = getImagePrefix(p.ObservedOpenEBS.Spec.JivaConfig.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"jiva:" + p.ObservedOpenEBS.Spec.JivaConfig.ImageTag
 
 	// Set the default replica count for Jiva which is 3.
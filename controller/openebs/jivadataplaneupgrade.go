@@ -0,0 +1,538 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+const (
+	// kindJivaVolume is the kind with which Jiva volumes are reported in
+	// the data plane upgrade status.
+	kindJivaVolume string = "JivaVolume"
+
+	// Labels set by OpenEBS on the deployments of a Jiva volume.
+	jivaReplicaLabelSelector    string = "openebs.io/replica=jiva-replica"
+	jivaControllerLabelSelector string = "openebs.io/controller=jiva-controller"
+	jivaPersistentVolumeLabel   string = "openebs.io/persistent-volume"
+
+	// jivaControllerServiceLabelSelector selects the services of the Jiva
+	// controllers which serve the REST API of the controllers.
+	jivaControllerServiceLabelSelector string = "openebs.io/controller-service=jiva-controller-svc"
+	// jivaControllerAPIPortName is the name of the port of the REST API in
+	// the controller service, jivaControllerAPIPort is used if not found.
+	jivaControllerAPIPortName string = "api"
+	jivaControllerAPIPort     int32  = 9501
+	// jivaControllerAPITimeout is the timeout of the requests made to the
+	// REST API of a Jiva controller.
+	jivaControllerAPITimeout = 10 * time.Second
+	// jivaReplicaModeRW is the mode of a replica which is rebuilt and
+	// serves IOs.
+	jivaReplicaModeRW string = "RW"
+
+	// Names of the images, without the registry and the tag, which are
+	// updated in the deployments of a Jiva volume.
+	jivaImageName      string = "jiva"
+	mExporterImageName string = "m-exporter"
+
+	// deploymentProgressDeadlineExceeded is the reason set by kubernetes on
+	// the Progressing condition of a deployment whose rollout is stuck.
+	deploymentProgressDeadlineExceeded string = "ProgressDeadlineExceeded"
+)
+
+// getJivaReplicaModes returns the modes of the replicas of the given Jiva
+// volume, it is a variable so that it can be mocked in the tests.
+var getJivaReplicaModes = getJivaReplicaModesFromController
+
+// jivaReplicaCollection is the response of the replicas API of a Jiva
+// controller.
+type jivaReplicaCollection struct {
+	Data []jivaReplica `json:"data"`
+}
+
+// jivaReplica is a replica as reported by a Jiva controller.
+type jivaReplica struct {
+	Address string `json:"address"`
+	Mode    string `json:"mode"`
+}
+
+// jivaReplicaStrategy is the strategy of a replica deployment of a Jiva volume
+// which is saved before upgrading it.
+type jivaReplicaStrategy struct {
+	Strategy        appsv1.DeploymentStrategy `json:"strategy"`
+	MinReadySeconds int32                     `json:"minReadySeconds"`
+	Paused          bool                      `json:"paused"`
+}
+
+// jivaVolume is a Jiva volume along with the deployments of its replicas and
+// its controller.
+type jivaVolume struct {
+	name       string
+	replica    *appsv1.Deployment
+	controller *appsv1.Deployment
+}
+
+// isUpgradeRequired returns true if any of the deployments of the Jiva volume
+// is running a version below the target version.
+func (v *jivaVolume) isUpgradeRequired(targetVersion string) bool {
+	return isUpgradeRequired(v.replica.Labels[types.OpenEBSVersionLabelKey], targetVersion) ||
+		isUpgradeRequired(v.controller.Labels[types.OpenEBSVersionLabelKey], targetVersion)
+}
+
+// upgradeJivaVolumes upgrades the Jiva volumes by updating the replica
+// deployment of a volume followed by its controller deployment. The replica
+// deployment is rolled out one replica at a time and the next replica is
+// upgraded only after the upgraded replica is rebuilt i.e., all the replicas
+// are reported in RW mode by the controller. It returns true if all the Jiva volumes are upgraded.
+func (p *Planner) upgradeJivaVolumes(status *types.DataPlaneUpgradeStatus) (bool, error) {
+	targetVersion := status.TargetVersion
+	volumes, err := getJivaVolumes()
+	if err != nil {
+		return false, err
+	}
+
+	// the volumes which were being upgraded are continued with first
+	// followed by the volumes which are yet to be upgraded.
+	var inProgress, pending []*jivaVolume
+	for _, volume := range volumes {
+		item := findDataPlaneUpgradeItem(status.JivaVolumes, kindJivaVolume, volume.name)
+		if item != nil && item.Phase == types.DataPlaneUpgradePhaseUpgrading {
+			inProgress = append(inProgress, volume)
+		} else if volume.isUpgradeRequired(targetVersion) {
+			pending = append(pending, volume)
+		}
+	}
+	// volumes which are no longer found are not tracked any further
+	for i := range status.JivaVolumes {
+		if status.JivaVolumes[i].Phase == types.DataPlaneUpgradePhaseUpgrading &&
+			!hasJivaVolume(volumes, status.JivaVolumes[i].Name) {
+			status.JivaVolumes[i].Phase = types.DataPlaneUpgradePhaseCompleted
+			status.JivaVolumes[i].Progress = ""
+		}
+	}
+	if len(inProgress) == 0 && len(pending) == 0 {
+		return true, nil
+	}
+
+	concurrency := int(*p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaVolumeConcurrency)
+	targets := inProgress
+	for _, volume := range pending {
+		if len(targets) >= concurrency {
+			break
+		}
+		targets = append(targets, volume)
+	}
+
+	for _, volume := range targets {
+		item := getDataPlaneUpgradeItem(&status.JivaVolumes, kindJivaVolume, volume.name,
+			volume.replica.Labels[types.OpenEBSVersionLabelKey])
+		item.Phase = types.DataPlaneUpgradePhaseUpgrading
+		err := p.upgradeJivaVolume(volume, item, targetVersion)
+		if err != nil {
+			return false, err
+		}
+		if item.Phase == types.DataPlaneUpgradePhaseFailed {
+			p.markDataPlaneUpgradeFailed(status, item, item.Progress)
+		}
+	}
+	return false, nil
+}
+
+// upgradeJivaVolume moves the upgrade of the given Jiva volume by a step i.e.,
+// it updates the replica deployment once all the replicas are in RW mode,
+// waits for every upgraded replica to be rebuilt, restores the strategy of the
+// replica deployment, updates the controller deployment and waits for it to be
+// ready.
+func (p *Planner) upgradeJivaVolume(
+	volume *jivaVolume, item *types.DataPlaneUpgradeItem, targetVersion string,
+) error {
+	replicas := getDeploymentReplicas(volume.replica)
+	rwReplicas, err := getJivaRWReplicas(volume)
+	if err != nil {
+		glog.Warningf("Failed to get replica status of Jiva volume %s: %+v", volume.name, err)
+	}
+
+	if volume.replica.Labels[types.OpenEBSVersionLabelKey] != targetVersion {
+		// a replica is taken down only if the volume is healthy
+		if err != nil || rwReplicas < replicas {
+			item.Progress = fmt.Sprintf("0/%d replicas upgraded, waiting for %d/%d replicas "+
+				"to be in RW mode", replicas, rwReplicas, replicas)
+			return nil
+		}
+		glog.V(2).Infof("Upgrading replicas of Jiva volume %s to version %s",
+			volume.name, targetVersion)
+		err := p.updateJivaDeployment(volume.replica, targetVersion, true)
+		if err != nil {
+			return handleJivaDeploymentUpdateErr(item, err,
+				"Failed to upgrade replicas of Jiva volume %s", volume.name)
+		}
+		item.Progress = fmt.Sprintf("0/%d replicas upgraded", replicas)
+		return nil
+	}
+	isRolledOut, isFailed := getDeploymentRolloutStatus(volume.replica)
+	if isFailed {
+		item.Phase = types.DataPlaneUpgradePhaseFailed
+		item.Progress = fmt.Sprintf("Replicas of Jiva volume %s failed to be upgraded within "+
+			"the progress deadline", volume.name)
+		return nil
+	}
+	if !isRolledOut || err != nil || rwReplicas < replicas {
+		// The rollout is paused while an upgraded replica is being rebuilt
+		// i.e., all the pods are ready but some replica is not yet in RW
+		// mode, and is resumed once all the replicas are in RW mode. The
+		// rollout is not paused while a pod is missing or not ready since
+		// the deployment controller is yet to replace it.
+		isRebuilding := err != nil || rwReplicas < replicas
+		arePodsReady := volume.replica.Status.Replicas == replicas &&
+			volume.replica.Status.ReadyReplicas == replicas
+		err := setJivaReplicaRolloutPaused(volume.replica, isRebuilding && arePodsReady)
+		if err != nil {
+			return handleJivaDeploymentUpdateErr(item, err,
+				"Failed to update rollout of replicas of Jiva volume %s", volume.name)
+		}
+		item.Progress = fmt.Sprintf("%d/%d replicas upgraded, %d/%d replicas in RW mode",
+			volume.replica.Status.UpdatedReplicas, replicas, rwReplicas, replicas)
+		return nil
+	}
+	if _, exist := volume.replica.Annotations[types.AnnKeyJivaReplicaOriginalStrategy]; exist {
+		err := restoreJivaReplicaStrategy(volume.replica)
+		if err != nil {
+			return handleJivaDeploymentUpdateErr(item, err,
+				"Failed to restore strategy of replicas of Jiva volume %s", volume.name)
+		}
+		item.Progress = fmt.Sprintf("%d/%d replicas upgraded", replicas, replicas)
+		return nil
+	}
+
+	if volume.controller.Labels[types.OpenEBSVersionLabelKey] != targetVersion {
+		glog.V(2).Infof("Upgrading controller of Jiva volume %s to version %s",
+			volume.name, targetVersion)
+		err := p.updateJivaDeployment(volume.controller, targetVersion, false)
+		if err != nil {
+			return handleJivaDeploymentUpdateErr(item, err,
+				"Failed to upgrade controller of Jiva volume %s", volume.name)
+		}
+		item.Progress = fmt.Sprintf("%d/%d replicas upgraded, upgrading controller",
+			replicas, replicas)
+		return nil
+	}
+	isRolledOut, isFailed = getDeploymentRolloutStatus(volume.controller)
+	if isFailed {
+		item.Phase = types.DataPlaneUpgradePhaseFailed
+		item.Progress = fmt.Sprintf("Controller of Jiva volume %s failed to be upgraded within "+
+			"the progress deadline", volume.name)
+		return nil
+	}
+	if !isRolledOut {
+		item.Progress = fmt.Sprintf("%d/%d replicas upgraded, upgrading controller",
+			replicas, replicas)
+		return nil
+	}
+
+	glog.V(2).Infof("Jiva volume %s upgraded to version %s", volume.name, targetVersion)
+	item.Phase = types.DataPlaneUpgradePhaseCompleted
+	item.Progress = ""
+	return nil
+}
+
+// handleJivaDeploymentUpdateErr returns the given error of updating a
+// deployment of a Jiva volume. A conflict i.e., the deployment was changed
+// after it was listed, is not returned but is recorded in the progress of the
+// volume so that the update is retried on the next resync.
+func handleJivaDeploymentUpdateErr(
+	item *types.DataPlaneUpgradeItem, err error, format string, args ...interface{},
+) error {
+	message := fmt.Sprintf(format, args...)
+	if apierrors.IsConflict(err) {
+		glog.V(2).Infof("%s, will be retried: %+v", message, err)
+		item.Progress = fmt.Sprintf("%s, will be retried: %v", message, err)
+		return nil
+	}
+	return errors.Errorf("%s: %+v", message, err)
+}
+
+// updateJivaDeployment updates the images and the version labels of the given
+// Jiva deployment. The replica deployment is updated one replica at a time so
+// that the volume keeps serving IOs from the remaining replicas, its original
+// strategy is saved as an annotation to be restored after the upgrade.
+func (p *Planner) updateJivaDeployment(
+	deployment *appsv1.Deployment, targetVersion string, isReplica bool,
+) error {
+	deployment = deployment.DeepCopy()
+	if deployment.Labels == nil {
+		deployment.Labels = make(map[string]string)
+	}
+	deployment.Labels[types.OpenEBSVersionLabelKey] = targetVersion
+	if deployment.Spec.Template.Labels == nil {
+		deployment.Spec.Template.Labels = make(map[string]string)
+	}
+	deployment.Spec.Template.Labels[types.OpenEBSVersionLabelKey] = targetVersion
+
+	for i, container := range deployment.Spec.Template.Spec.Containers {
+		switch getImageName(container.Image) {
+		case jivaImageName:
+			deployment.Spec.Template.Spec.Containers[i].Image =
+				p.ObservedOpenEBS.Spec.JivaConfig.Image
+		case mExporterImageName:
+			if p.ObservedOpenEBS.Spec.Policies != nil &&
+				p.ObservedOpenEBS.Spec.Policies.Monitoring != nil {
+				deployment.Spec.Template.Spec.Containers[i].Image =
+					p.ObservedOpenEBS.Spec.Policies.Monitoring.Image
+			}
+		}
+	}
+
+	if isReplica {
+		// the strategy saved by an earlier attempt is the original one
+		if _, exist := deployment.Annotations[types.AnnKeyJivaReplicaOriginalStrategy]; !exist {
+			original, err := json.Marshal(jivaReplicaStrategy{
+				Strategy:        deployment.Spec.Strategy,
+				MinReadySeconds: deployment.Spec.MinReadySeconds,
+				Paused:          deployment.Spec.Paused,
+			})
+			if err != nil {
+				return err
+			}
+			if deployment.Annotations == nil {
+				deployment.Annotations = make(map[string]string)
+			}
+			deployment.Annotations[types.AnnKeyJivaReplicaOriginalStrategy] = string(original)
+		}
+		maxSurge := intstr.FromInt(0)
+		maxUnavailable := intstr.FromInt(1)
+		deployment.Spec.Strategy = appsv1.DeploymentStrategy{
+			Type: appsv1.RollingUpdateDeploymentStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDeployment{
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+			},
+		}
+		// minReadySeconds gives enough time to pause the rollout once an
+		// upgraded replica is ready and before the next one is taken down.
+		deployment.Spec.MinReadySeconds = *p.ObservedOpenEBS.Spec.DataPlaneUpgrade.JivaReplicaRebuildSeconds
+		deployment.Spec.Paused = false
+	}
+	_, err := k8s.UpdateDeployment(deployment)
+	return err
+}
+
+// setJivaReplicaRolloutPaused pauses or resumes the rollout of the given
+// replica deployment of a Jiva volume.
+func setJivaReplicaRolloutPaused(deployment *appsv1.Deployment, paused bool) error {
+	if deployment.Spec.Paused == paused {
+		return nil
+	}
+	deployment = deployment.DeepCopy()
+	deployment.Spec.Paused = paused
+	_, err := k8s.UpdateDeployment(deployment)
+	return err
+}
+
+// restoreJivaReplicaStrategy restores the strategy of the given replica
+// deployment of a Jiva volume which was saved before upgrading it.
+func restoreJivaReplicaStrategy(deployment *appsv1.Deployment) error {
+	var original jivaReplicaStrategy
+	err := json.Unmarshal(
+		[]byte(deployment.Annotations[types.AnnKeyJivaReplicaOriginalStrategy]), &original)
+	if err != nil {
+		return errors.Errorf("Invalid annotation %s: %+v",
+			types.AnnKeyJivaReplicaOriginalStrategy, err)
+	}
+	deployment = deployment.DeepCopy()
+	deployment.Spec.Strategy = original.Strategy
+	deployment.Spec.MinReadySeconds = original.MinReadySeconds
+	deployment.Spec.Paused = original.Paused
+	delete(deployment.Annotations, types.AnnKeyJivaReplicaOriginalStrategy)
+	_, err = k8s.UpdateDeployment(deployment)
+	return err
+}
+
+// getJivaRWReplicas returns the number of replicas of the given Jiva volume
+// which are in RW mode as reported by its controller i.e., the replicas which
+// are rebuilt and serving IOs.
+func getJivaRWReplicas(volume *jivaVolume) (int32, error) {
+	modes, err := getJivaReplicaModes(volume)
+	if err != nil {
+		return 0, err
+	}
+	var rwReplicas int32
+	for _, mode := range modes {
+		if mode == jivaReplicaModeRW {
+			rwReplicas++
+		}
+	}
+	return rwReplicas, nil
+}
+
+// getJivaReplicaModesFromController returns the modes of the replicas of the
+// given Jiva volume as reported by the REST API of its controller.
+func getJivaReplicaModesFromController(volume *jivaVolume) ([]string, error) {
+	services, err := k8s.ListServices(volume.controller.Namespace,
+		jivaControllerServiceLabelSelector+","+jivaPersistentVolumeLabel+"="+volume.name)
+	if err != nil {
+		return nil, errors.Errorf("Failed to list controller service: %+v", err)
+	}
+	if len(services.Items) == 0 {
+		return nil, errors.Errorf("Controller service not found")
+	}
+	service := services.Items[0]
+	port := jivaControllerAPIPort
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Name == jivaControllerAPIPortName {
+			port = servicePort.Port
+		}
+	}
+	url := fmt.Sprintf("http://%s/v1/replicas",
+		net.JoinHostPort(service.Spec.ClusterIP, strconv.Itoa(int(port))))
+	client := &http.Client{Timeout: jivaControllerAPITimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("Failed to get %s: %s", url, resp.Status)
+	}
+	var collection jivaReplicaCollection
+	err = json.NewDecoder(resp.Body).Decode(&collection)
+	if err != nil {
+		return nil, errors.Errorf("Failed to decode replicas from %s: %+v", url, err)
+	}
+	var modes []string
+	for _, replica := range collection.Data {
+		modes = append(modes, replica.Mode)
+	}
+	return modes, nil
+}
+
+// getJivaVolumes returns the Jiva volumes along with their deployments sorted
+// by the volume names. Volumes whose replica or controller deployment is not
+// found are skipped.
+func getJivaVolumes() ([]*jivaVolume, error) {
+	replicas, err := k8s.ListDeployments(jivaReplicaLabelSelector)
+	if err != nil {
+		return nil, errors.Errorf("Failed to list Jiva replica deployments: %+v", err)
+	}
+	controllers, err := k8s.ListDeployments(jivaControllerLabelSelector)
+	if err != nil {
+		return nil, errors.Errorf("Failed to list Jiva controller deployments: %+v", err)
+	}
+
+	controllerByVolume := make(map[string]*appsv1.Deployment)
+	for i := range controllers.Items {
+		controller := &controllers.Items[i]
+		name := controller.Labels[jivaPersistentVolumeLabel]
+		controllerByVolume[controller.Namespace+"/"+name] = controller
+	}
+	var volumes []*jivaVolume
+	for i := range replicas.Items {
+		replica := &replicas.Items[i]
+		name := replica.Labels[jivaPersistentVolumeLabel]
+		if name == "" {
+			continue
+		}
+		controller, exist := controllerByVolume[replica.Namespace+"/"+name]
+		if !exist {
+			glog.Warningf("Skipping upgrade of Jiva volume %s: Controller deployment not found", name)
+			continue
+		}
+		volumes = append(volumes, &jivaVolume{
+			name:       name,
+			replica:    replica,
+			controller: controller,
+		})
+	}
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].name < volumes[j].name
+	})
+	return volumes, nil
+}
+
+// hasJivaVolume returns true if a Jiva volume with the given name is present.
+func hasJivaVolume(volumes []*jivaVolume, name string) bool {
+	for _, volume := range volumes {
+		if volume.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// findDataPlaneUpgradeItem returns the status item with the given kind and
+// name, nil is returned if not present.
+func findDataPlaneUpgradeItem(
+	items []types.DataPlaneUpgradeItem, kind, name string,
+) *types.DataPlaneUpgradeItem {
+	for i := range items {
+		if items[i].Kind == kind && items[i].Name == name {
+			return &items[i]
+		}
+	}
+	return nil
+}
+
+// getImageName returns the name of the given image without the registry and
+// the tag, for example, jiva for quay.io/openebs/jiva:1.12.0.
+func getImageName(image string) string {
+	image = image[strings.LastIndex(image, "/")+1:]
+	if i := strings.Index(image, ":"); i != -1 {
+		image = image[:i]
+	}
+	return image
+}
+
+// getDeploymentReplicas returns the number of desired replicas of the given
+// deployment.
+func getDeploymentReplicas(deployment *appsv1.Deployment) int32 {
+	if deployment.Spec.Replicas == nil {
+		return 1
+	}
+	return *deployment.Spec.Replicas
+}
+
+// getDeploymentRolloutStatus returns if the rollout of the given deployment is
+// complete and if it has failed to progress within its progress deadline.
+func getDeploymentRolloutStatus(deployment *appsv1.Deployment) (isRolledOut bool, isFailed bool) {
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false, false
+	}
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing &&
+			condition.Status == corev1.ConditionFalse &&
+			condition.Reason == deploymentProgressDeadlineExceeded {
+			return false, true
+		}
+	}
+	replicas := getDeploymentReplicas(deployment)
+	isRolledOut = deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.AvailableReplicas == replicas &&
+		deployment.Status.Replicas == replicas
+	return isRolledOut, false
+}
@@ -0,0 +1,1006 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+func TestUpgradeJivaVolume(t *testing.T) {
+	replicas := int32(3)
+	var tests = map[string]struct {
+		replica         *appsv1.Deployment
+		controller      *appsv1.Deployment
+		modes           []string
+		conflict        bool
+		expectPhase     types.DataPlaneUpgradePhase
+		expectProgress  string
+		expectVersion   string
+		expectPaused    bool
+		expectStrategy  appsv1.DeploymentStrategyType
+		expectOriginal  bool
+		expectCtrlImage string
+	}{
+		"replicas are not upgraded till all are in RW mode": {
+			replica: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-rep",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.5.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			controller: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-ctrl",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			modes:          []string{"RW", "WO", "RW"},
+			expectPhase:    types.DataPlaneUpgradePhaseUpgrading,
+			expectProgress: "waiting for 2/3 replicas to be in RW mode",
+			expectVersion:  "2.5.0",
+			expectStrategy: appsv1.RecreateDeploymentStrategyType,
+		},
+		"replicas are upgraded one at a time saving the original strategy": {
+			replica: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-rep",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.5.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			controller: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-ctrl",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			modes:          []string{"RW", "RW", "RW"},
+			expectPhase:    types.DataPlaneUpgradePhaseUpgrading,
+			expectProgress: "0/3 replicas upgraded",
+			expectVersion:  "2.6.0",
+			expectStrategy: appsv1.RollingUpdateDeploymentStrategyType,
+			expectOriginal: true,
+		},
+		"conflicting update of replicas is retried on the next resync": {
+			replica: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-rep",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.5.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			controller: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-ctrl",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			modes:          []string{"RW", "RW", "RW"},
+			conflict:       true,
+			expectPhase:    types.DataPlaneUpgradePhaseUpgrading,
+			expectProgress: "Failed to upgrade replicas of Jiva volume pvc-1, will be retried",
+			expectVersion:  "2.5.0",
+			expectStrategy: appsv1.RecreateDeploymentStrategyType,
+		},
+		"rollout is paused while an upgraded replica is rebuilt": {
+			replica: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-rep",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+					Annotations: map[string]string{
+						types.AnnKeyJivaReplicaOriginalStrategy: "{}",
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    1,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			controller: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-ctrl",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			modes:          []string{"RW", "WO", "RW"},
+			expectPhase:    types.DataPlaneUpgradePhaseUpgrading,
+			expectProgress: "1/3 replicas upgraded, 2/3 replicas in RW mode",
+			expectVersion:  "2.6.0",
+			expectPaused:   true,
+			expectStrategy: appsv1.RecreateDeploymentStrategyType,
+			expectOriginal: true,
+		},
+		"rollout is not paused while a pod is being replaced": {
+			replica: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-rep",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+					Annotations: map[string]string{
+						types.AnnKeyJivaReplicaOriginalStrategy: "{}",
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Paused:   true,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    1,
+					ReadyReplicas:      2,
+					AvailableReplicas:  replicas,
+				},
+			},
+			controller: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-ctrl",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			modes:          []string{"RW", "RW"},
+			expectPhase:    types.DataPlaneUpgradePhaseUpgrading,
+			expectProgress: "1/3 replicas upgraded, 2/3 replicas in RW mode",
+			expectVersion:  "2.6.0",
+			expectStrategy: appsv1.RecreateDeploymentStrategyType,
+			expectOriginal: true,
+		},
+		"rollout is resumed once the upgraded replica is rebuilt": {
+			replica: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-rep",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+					Annotations: map[string]string{
+						types.AnnKeyJivaReplicaOriginalStrategy: "{}",
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Paused:   true,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    1,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			controller: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-ctrl",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			modes:          []string{"RW", "RW", "RW"},
+			expectPhase:    types.DataPlaneUpgradePhaseUpgrading,
+			expectProgress: "1/3 replicas upgraded, 3/3 replicas in RW mode",
+			expectVersion:  "2.6.0",
+			expectStrategy: appsv1.RecreateDeploymentStrategyType,
+			expectOriginal: true,
+		},
+		"rollout is paused if the replica status is not known": {
+			replica: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-rep",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+					Annotations: map[string]string{
+						types.AnnKeyJivaReplicaOriginalStrategy: "{}",
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			controller: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-ctrl",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			expectPhase:    types.DataPlaneUpgradePhaseUpgrading,
+			expectProgress: "3/3 replicas upgraded, 0/3 replicas in RW mode",
+			expectVersion:  "2.6.0",
+			expectPaused:   true,
+			expectStrategy: appsv1.RecreateDeploymentStrategyType,
+			expectOriginal: true,
+		},
+		"original strategy is restored once all the replicas are rebuilt": {
+			replica: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-rep",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+					Annotations: map[string]string{
+						types.AnnKeyJivaReplicaOriginalStrategy: `{"strategy":{"type":"Recreate"},"minReadySeconds":5}`,
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas:        &replicas,
+					Strategy:        appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType},
+					MinReadySeconds: 60,
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			controller: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-ctrl",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			modes:          []string{"RW", "RW", "RW"},
+			expectPhase:    types.DataPlaneUpgradePhaseUpgrading,
+			expectProgress: "3/3 replicas upgraded",
+			expectVersion:  "2.6.0",
+			expectStrategy: appsv1.RecreateDeploymentStrategyType,
+		},
+		"controller is upgraded after the replicas": {
+			replica: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-rep",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			controller: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-ctrl",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.5.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			modes:           []string{"RW", "RW", "RW"},
+			expectPhase:     types.DataPlaneUpgradePhaseUpgrading,
+			expectProgress:  "upgrading controller",
+			expectVersion:   "2.6.0",
+			expectStrategy:  appsv1.RecreateDeploymentStrategyType,
+			expectCtrlImage: "openebs/jiva:2.6.0",
+		},
+		"replicas which fail to progress fail the upgrade": {
+			replica: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-rep",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+					Conditions: []appsv1.DeploymentCondition{
+						{
+							Type:   appsv1.DeploymentProgressing,
+							Status: v1.ConditionFalse,
+							Reason: deploymentProgressDeadlineExceeded,
+						},
+					},
+				},
+			},
+			controller: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-ctrl",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			modes:          []string{"RW", "RW", "RW"},
+			expectPhase:    types.DataPlaneUpgradePhaseFailed,
+			expectProgress: "failed to be upgraded within the progress deadline",
+			expectVersion:  "2.6.0",
+			expectStrategy: appsv1.RecreateDeploymentStrategyType,
+		},
+		"volume is upgraded": {
+			replica: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-rep",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			controller: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "pvc-1-ctrl",
+					Namespace:  "openebs",
+					Generation: 1,
+					Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.6.0"},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{Name: "jiva", Image: "openebs/jiva:2.6.0"},
+							},
+						},
+					},
+				},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           replicas,
+					UpdatedReplicas:    replicas,
+					ReadyReplicas:      replicas,
+					AvailableReplicas:  replicas,
+				},
+			},
+			modes:          []string{"RW", "RW", "RW"},
+			expectPhase:    types.DataPlaneUpgradePhaseCompleted,
+			expectVersion:  "2.6.0",
+			expectStrategy: appsv1.RecreateDeploymentStrategyType,
+		},
+	}
+	defer func(modes func(*jivaVolume) ([]string, error)) {
+		k8s.Clientset = nil
+		getJivaReplicaModes = modes
+	}(getJivaReplicaModes)
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset(mock.replica, mock.controller)
+			if mock.conflict {
+				clientset.PrependReactor("update", "deployments",
+					func(action k8stesting.Action) (bool, runtime.Object, error) {
+						return true, nil, apierrors.NewConflict(
+							schema.GroupResource{Group: "apps", Resource: "deployments"},
+							action.(k8stesting.UpdateAction).GetObject().(*appsv1.Deployment).Name,
+							errors.New("the object has been modified"))
+					})
+			}
+			k8s.Clientset = clientset
+			getJivaReplicaModes = func(volume *jivaVolume) ([]string, error) {
+				if mock.modes == nil {
+					return nil, errors.New("connection refused")
+				}
+				return mock.modes, nil
+			}
+			rebuildSeconds := int32(60)
+			p := &Planner{
+				ObservedOpenEBS: &types.OpenEBS{
+					Spec: types.OpenEBSSpec{
+						Components: types.Components{
+							JivaConfig: &types.JivaConfig{Container: types.Container{Image: "openebs/jiva:2.6.0"}},
+						},
+						DataPlaneUpgrade: &types.DataPlaneUpgrade{
+							JivaReplicaRebuildSeconds: &rebuildSeconds,
+						},
+					},
+				},
+			}
+			item := &types.DataPlaneUpgradeItem{Phase: types.DataPlaneUpgradePhaseUpgrading}
+			volume := &jivaVolume{name: "pvc-1", replica: mock.replica, controller: mock.controller}
+			err := p.upgradeJivaVolume(volume, item, "2.6.0")
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			if item.Phase != mock.expectPhase {
+				t.Fatalf("Expected phase %s got %s", mock.expectPhase, item.Phase)
+			}
+			if !strings.Contains(item.Progress, mock.expectProgress) {
+				t.Fatalf("Expected progress %q got %q", mock.expectProgress, item.Progress)
+			}
+			got, err := k8s.Clientset.AppsV1().Deployments("openebs").Get("pvc-1-rep", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			if version := got.Labels[types.OpenEBSVersionLabelKey]; version != mock.expectVersion {
+				t.Fatalf("Expected replica version %s got %s", mock.expectVersion, version)
+			}
+			if got.Spec.Paused != mock.expectPaused {
+				t.Fatalf("Expected replica paused %t got %t", mock.expectPaused, got.Spec.Paused)
+			}
+			if got.Spec.Strategy.Type != mock.expectStrategy {
+				t.Fatalf("Expected replica strategy %s got %s", mock.expectStrategy, got.Spec.Strategy.Type)
+			}
+			if _, exist := got.Annotations[types.AnnKeyJivaReplicaOriginalStrategy]; exist != mock.expectOriginal {
+				t.Fatalf("Expected original strategy annotation %t got %t", mock.expectOriginal, exist)
+			}
+			if mock.expectCtrlImage != "" {
+				gotCtrl, err := k8s.Clientset.AppsV1().Deployments("openebs").Get("pvc-1-ctrl", metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("Expected no error got %v", err)
+				}
+				if image := gotCtrl.Spec.Template.Spec.Containers[0].Image; image != mock.expectCtrlImage {
+					t.Fatalf("Expected controller image %s got %s", mock.expectCtrlImage, image)
+				}
+			}
+		})
+	}
+}
+
+func TestUpgradeJivaVolumeRestoresOriginalStrategy(t *testing.T) {
+	defer func(modes func(*jivaVolume) ([]string, error)) {
+		k8s.Clientset = nil
+		getJivaReplicaModes = modes
+	}(getJivaReplicaModes)
+	getJivaReplicaModes = func(volume *jivaVolume) ([]string, error) {
+		return []string{"RW", "RW", "RW"}, nil
+	}
+	replicas := int32(3)
+	replica := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "pvc-1-rep",
+			Namespace:  "openebs",
+			Generation: 1,
+			Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.5.0"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas:        &replicas,
+			Strategy:        appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+			MinReadySeconds: 5,
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "jiva", Image: "openebs/jiva:2.5.0"},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+			Replicas:           replicas,
+			UpdatedReplicas:    replicas,
+			ReadyReplicas:      replicas,
+			AvailableReplicas:  replicas,
+		},
+	}
+	controller := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "pvc-1-ctrl",
+			Namespace:  "openebs",
+			Generation: 1,
+			Labels:     map[string]string{types.OpenEBSVersionLabelKey: "2.5.0"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "jiva", Image: "openebs/jiva:2.5.0"},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+			Replicas:           replicas,
+			UpdatedReplicas:    replicas,
+			ReadyReplicas:      replicas,
+			AvailableReplicas:  replicas,
+		},
+	}
+	k8s.Clientset = fake.NewSimpleClientset(replica, controller)
+	rebuildSeconds := int32(60)
+	p := &Planner{
+		ObservedOpenEBS: &types.OpenEBS{
+			Spec: types.OpenEBSSpec{
+				Components: types.Components{
+					JivaConfig: &types.JivaConfig{Container: types.Container{Image: "openebs/jiva:2.6.0"}},
+				},
+				DataPlaneUpgrade: &types.DataPlaneUpgrade{
+					JivaReplicaRebuildSeconds: &rebuildSeconds,
+				},
+			},
+		},
+	}
+	item := &types.DataPlaneUpgradeItem{}
+	deployments := k8s.Clientset.AppsV1().Deployments("openebs")
+	// the replica deployment is updated and then its strategy is restored
+	// once it is rolled out
+	for i := 0; i < 2; i++ {
+		volume := &jivaVolume{name: "pvc-1", replica: replica, controller: controller}
+		err := p.upgradeJivaVolume(volume, item, "2.6.0")
+		if err != nil {
+			t.Fatalf("Expected no error got %v", err)
+		}
+		replica, err = deployments.Get("pvc-1-rep", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Expected no error got %v", err)
+		}
+		if i == 0 && replica.Spec.MinReadySeconds != rebuildSeconds {
+			t.Fatalf("Expected minReadySeconds %d while upgrading got %d",
+				rebuildSeconds, replica.Spec.MinReadySeconds)
+		}
+	}
+	if replica.Spec.MinReadySeconds != 5 {
+		t.Fatalf("Expected minReadySeconds 5 got %d", replica.Spec.MinReadySeconds)
+	}
+	if replica.Spec.Strategy.Type != appsv1.RecreateDeploymentStrategyType ||
+		replica.Spec.Strategy.RollingUpdate != nil {
+		t.Fatalf("Expected strategy Recreate got %+v", replica.Spec.Strategy)
+	}
+	if image := replica.Spec.Template.Spec.Containers[0].Image; image != "openebs/jiva:2.6.0" {
+		t.Fatalf("Expected image openebs/jiva:2.6.0 got %s", image)
+	}
+}
+
+func TestGetJivaReplicaModesFromController(t *testing.T) {
+	var tests = map[string]struct {
+		status      int
+		body        string
+		noService   bool
+		expectModes string
+		isErr       bool
+	}{
+		"modes of the replicas are returned": {
+			status:      http.StatusOK,
+			body:        `{"data":[{"address":"tcp://10.0.0.1:9502","mode":"RW"},{"address":"tcp://10.0.0.2:9502","mode":"WO"}]}`,
+			expectModes: "RW,WO",
+		},
+		"controller service is not found": {
+			noService: true,
+			isErr:     true,
+		},
+		"controller fails to respond": {
+			status: http.StatusInternalServerError,
+			isErr:  true,
+		},
+		"malformed response": {
+			status: http.StatusOK,
+			body:   `{"data":`,
+			isErr:  true,
+		},
+	}
+	defer func() { k8s.Clientset = nil }()
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/replicas" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(mock.status)
+				_, _ = w.Write([]byte(mock.body))
+			}))
+			defer server.Close()
+			host, port, _ := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+			portNumber, _ := strconv.Atoi(port)
+			service := &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pvc-1-ctrl-svc",
+					Namespace: "openebs",
+					Labels: map[string]string{
+						"openebs.io/controller-service": "jiva-controller-svc",
+						jivaPersistentVolumeLabel:       "pvc-1",
+					},
+				},
+				Spec: v1.ServiceSpec{
+					ClusterIP: host,
+					Ports: []v1.ServicePort{
+						{Name: "iscsi", Port: 3260},
+						{Name: jivaControllerAPIPortName, Port: int32(portNumber)},
+					},
+				},
+			}
+			if mock.noService {
+				k8s.Clientset = fake.NewSimpleClientset()
+			} else {
+				k8s.Clientset = fake.NewSimpleClientset(service)
+			}
+			volume := &jivaVolume{
+				name: "pvc-1",
+				controller: &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "pvc-1-ctrl", Namespace: "openebs"},
+				},
+			}
+			modes, err := getJivaReplicaModesFromController(volume)
+			if mock.isErr {
+				if err == nil {
+					t.Fatalf("Expected error got modes %v", modes)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			if strings.Join(modes, ",") != mock.expectModes {
+				t.Fatalf("Expected modes %s got %v", mock.expectModes, modes)
+			}
+		})
+	}
+}
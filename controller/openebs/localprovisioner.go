@@ -43,7 +43,8 @@ func (p *Planner) setLocalProvisionerDefaultsIfNotSet() error {
 		p.ObservedOpenEBS.Spec.LocalProvisioner.ImageTag = p.ObservedOpenEBS.Spec.Version +
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
-	p.ObservedOpenEBS.Spec.LocalProvisioner.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.LocalProvisioner.Image = getImagePrefix(p.ObservedOpenEBS.Spec.LocalProvisioner.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"provisioner-localpv:" + p.ObservedOpenEBS.Spec.LocalProvisioner.ImageTag
 
 	if p.ObservedOpenEBS.Spec.LocalProvisioner.Replicas == nil {
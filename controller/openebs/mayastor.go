@@ -4,64 +4,16 @@ import (
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/pkg/catalog"
 	"mayadata.io/openebs-upgrade/types"
 	"mayadata.io/openebs-upgrade/unstruct"
-	"strings"
 )
 
 const (
-	MayastorVersion010EE     string = "0.1.0-ee"
-	MayastorVersion020       string = "0.2.0"
-	MayastorVersion020EE     string = "0.2.0-ee"
-	MayastorVersion030       string = "v0.3.0"
-	MayastorVersion030EE     string = "v0.3.0-ee"
-	MayastorVersion040       string = "v0.4.0"
-	MayastorVersion040EE     string = "v0.4.0-ee"
-	MayastorCSIVersion030    string = "v0.3.0"
-	MayastorCSIVersion030EE  string = "v0.3.0-ee"
-	MayastorCSIVersion040    string = "v0.4.0"
-	MayastorCSIVersion040EE  string = "v0.4.0-ee"
-	NATSVersion21Alpine311   string = "2.1-alpine3.11"
-	NATSVersion21Alpine311EE string = "2.1-alpine3.11"
-	DefaultMoacReplicaCount  int32  = 1
-	DefaultNATSReplicaCount  int32  = 1
+	DefaultMoacReplicaCount int32 = 1
+	DefaultNATSReplicaCount int32 = 1
 )
 
-// supportedMayastorVersionForOpenEBSVersion stores the mapping for
-// Mayastor to OpenEBS version i.e., a Mayastor version for each of the
-// supported OpenEBS versions.
-var supportedMayastorVersionForOpenEBSVersion = map[string]string{
-	types.OpenEBSVersion1100EE: MayastorVersion010EE,
-	types.OpenEBSVersion1110:   MayastorVersion020,
-	types.OpenEBSVersion1110EE: MayastorVersion020EE,
-	types.OpenEBSVersion1120:   MayastorVersion020,
-	types.OpenEBSVersion1120EE: MayastorVersion020EE,
-	types.OpenEBSVersion200:    MayastorVersion030,
-	types.OpenEBSVersion200EE:  MayastorVersion030EE,
-	types.OpenEBSVersion210:    MayastorVersion040,
-	types.OpenEBSVersion210EE:  MayastorVersion040EE,
-}
-
-// supportedNATSVersionForOpenEBSVersion stores the mapping for
-// NATS to OpenEBS version i.e., a NATS version for each of the
-// supported OpenEBS versions.
-var supportedNATSVersionForOpenEBSVersion = map[string]string{
-	types.OpenEBSVersion200:   NATSVersion21Alpine311,
-	types.OpenEBSVersion200EE: NATSVersion21Alpine311EE,
-	types.OpenEBSVersion210:   NATSVersion21Alpine311,
-	types.OpenEBSVersion210EE: NATSVersion21Alpine311EE,
-}
-
-// supportedMayastorCSIVersionForOpenEBSVersion stores the mapping for
-// mayastor-csi to OpenEBS version i.e., a mayastor-csi version for each of the
-// supported OpenEBS versions.
-var supportedMayastorCSIVersionForOpenEBSVersion = map[string]string{
-	types.OpenEBSVersion200:   MayastorCSIVersion030,
-	types.OpenEBSVersion200EE: MayastorCSIVersion030EE,
-	types.OpenEBSVersion210:   MayastorCSIVersion040,
-	types.OpenEBSVersion210EE: MayastorCSIVersion040EE,
-}
-
 var (
 	// List of images which are by default fetched from quay.io/k8scsi registry.
 	CSIProvisionerForMOACImage                string
@@ -70,59 +22,6 @@ var (
 	CSINodeDriverRegistrarForMayastorCSIImage string
 )
 
-// SupportedCSIProvisionerVersionForMOACVersion stores the mapping for
-// CSI provisioner to moac(Mayastor) version.
-var SupportedCSIProvisionerVersionForMOACVersion = map[string]string{
-	types.OpenEBSVersion1100:   types.CSIProvisionerVersion150,
-	types.OpenEBSVersion1100EE: types.CSIProvisionerVersion111,
-	types.OpenEBSVersion1110:   types.CSIProvisionerVersion160,
-	types.OpenEBSVersion1110EE: types.CSIProvisionerVersion160,
-	types.OpenEBSVersion1120:   types.CSIProvisionerVersion160,
-	types.OpenEBSVersion1120EE: types.CSIProvisionerVersion160,
-	types.OpenEBSVersion200:    types.CSIProvisionerVersion160,
-	types.OpenEBSVersion200EE:  types.CSIProvisionerVersion160,
-	types.OpenEBSVersion210:    types.CSIProvisionerVersion160,
-	types.OpenEBSVersion210EE:  types.CSIProvisionerVersion160,
-}
-
-// SupportedCSIAttacherVersionForMOACVersion stores the mapping for
-// CSI provisioner to MOAC(mayastor) version.
-var SupportedCSIAttacherVersionForMOACVersion = map[string]string{
-	types.OpenEBSVersion1100:   types.CSIAttacherVersion111,
-	types.OpenEBSVersion1100EE: types.CSIAttacherVersion111,
-	types.OpenEBSVersion1110:   types.CSIAttacherVersion220,
-	types.OpenEBSVersion1110EE: types.CSIAttacherVersion220,
-	types.OpenEBSVersion1120:   types.CSIAttacherVersion220,
-	types.OpenEBSVersion1120EE: types.CSIAttacherVersion220,
-	types.OpenEBSVersion200:    types.CSIAttacherVersion220,
-	types.OpenEBSVersion200EE:  types.CSIAttacherVersion220,
-	types.OpenEBSVersion210:    types.CSIAttacherVersion220,
-	types.OpenEBSVersion210EE:  types.CSIAttacherVersion220,
-}
-
-// SupportedCSINodeDriverRegistrarVersionForMayastorVersion stores the mapping for
-// CSINodeDriverRegistrar to mayastor version.
-var SupportedCSINodeDriverRegistrarVersionForMayastorVersion = map[string]string{
-	types.OpenEBSVersion1100EE: types.CSINodeDriverRegistrarVersion110,
-	types.OpenEBSVersion1110:   types.CSINodeDriverRegistrarVersion130,
-	types.OpenEBSVersion1110EE: types.CSINodeDriverRegistrarVersion130,
-	types.OpenEBSVersion1120:   types.CSINodeDriverRegistrarVersion130,
-	types.OpenEBSVersion1120EE: types.CSINodeDriverRegistrarVersion130,
-	types.OpenEBSVersion200:    types.CSINodeDriverRegistrarVersion130,
-	types.OpenEBSVersion200EE:  types.CSINodeDriverRegistrarVersion130,
-	types.OpenEBSVersion210:    types.CSINodeDriverRegistrarVersion130,
-	types.OpenEBSVersion210EE:  types.CSINodeDriverRegistrarVersion130,
-}
-
-// SupportedCSINodeDriverRegistrarVersionForMayastorCSIVersion stores the mapping for
-// CSINodeDriverRegistrar to mayastor-csi version.
-var SupportedCSINodeDriverRegistrarVersionForMayastorCSIVersion = map[string]string{
-	types.OpenEBSVersion200:   types.CSINodeDriverRegistrarVersion130,
-	types.OpenEBSVersion200EE: types.CSINodeDriverRegistrarVersion130,
-	types.OpenEBSVersion210:   types.CSINodeDriverRegistrarVersion130,
-	types.OpenEBSVersion210EE: types.CSINodeDriverRegistrarVersion130,
-}
-
 // Set the default values for Mayastor if not already given.
 func (p *Planner) setMayastorDefaultsIfNotSet() error {
 	var (
@@ -133,12 +32,7 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 		CSINodeDriverRegistrarForMayastorImageTag    string
 		CSINodeDriverRegistrarForMayastorCSIImageTag string
 	)
-	isMayastorSupported, err := p.isMayastorSupported()
-	// Do not return the error as not to block installing other components.
-	if err != nil {
-		isMayastorSupported = false
-		glog.Errorf("Failed to set Mayastor defaults, error: %v", err)
-	}
+	isMayastorSupported := p.isMayastorSupported()
 	// set the default image registry value.
 	defaultImageRegistryForMayastor = p.ObservedOpenEBS.Spec.ImagePrefix
 	// If OpenEBS version is lower than 2.0.0 then use the default imageRegistry if no custom registry
@@ -178,8 +72,7 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 			p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Service.Name = types.MoacServiceNameKey
 		}
 		if p.ObservedOpenEBS.Spec.MayastorConfig.Moac.ImageTag == "" {
-			if moacVersion, exist :=
-				supportedMayastorVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+			if moacVersion := p.Release.Components.Mayastor; moacVersion != "" {
 				p.ObservedOpenEBS.Spec.MayastorConfig.Moac.ImageTag = moacVersion +
 					p.ObservedOpenEBS.Spec.ImageTagSuffix
 			} else {
@@ -187,7 +80,8 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 					p.ObservedOpenEBS.Spec.Version)
 			}
 		}
-		p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Image = defaultImageRegistryForMayastor +
+		p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Image = getImagePrefix(p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Container,
+			defaultImageRegistryForMayastor) +
 			"moac:" + p.ObservedOpenEBS.Spec.MayastorConfig.Moac.ImageTag
 
 		if p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Replicas == nil {
@@ -196,8 +90,7 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 		}
 
 		// form the CSI provisioner image for MOAC(Mayastor)
-		if csiProvisionerForMOAC, exist :=
-			SupportedCSIProvisionerVersionForMOACVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+		if csiProvisionerForMOAC := p.Release.CSISidecars.Mayastor.MOACProvisioner; csiProvisionerForMOAC != "" {
 			CSIProvisionerForMOACImageTag = "csi-provisioner:" +
 				csiProvisionerForMOAC
 		} else {
@@ -207,8 +100,7 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 		}
 
 		// form the CSI attacher image for MOAC(mayastor)
-		if csiAttacherForMOAC, exist :=
-			SupportedCSIAttacherVersionForMOACVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+		if csiAttacherForMOAC := p.Release.CSISidecars.Mayastor.MOACAttacher; csiAttacherForMOAC != "" {
 			CSIAttacherForMOACImageTag = "csi-attacher:" +
 				csiAttacherForMOAC
 		} else {
@@ -228,8 +120,7 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 			p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Name = types.MayastorDaemonsetNameKey
 		}
 		if p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Mayastor.ImageTag == "" {
-			if mayastorVersion, exist :=
-				supportedMayastorVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+			if mayastorVersion := p.Release.Components.Mayastor; mayastorVersion != "" {
 				p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Mayastor.ImageTag = mayastorVersion +
 					p.ObservedOpenEBS.Spec.ImageTagSuffix
 			} else {
@@ -238,8 +129,7 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 			}
 		}
 		if p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.MayastorGRPC.ImageTag == "" {
-			if mayastorVersion, exist :=
-				supportedMayastorVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+			if mayastorVersion := p.Release.Components.Mayastor; mayastorVersion != "" {
 				p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.MayastorGRPC.ImageTag = mayastorVersion +
 					p.ObservedOpenEBS.Spec.ImageTagSuffix
 			} else {
@@ -248,14 +138,16 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 			}
 		}
 
-		p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Mayastor.Image = defaultImageRegistryForMayastor +
+		p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Mayastor.Image = getImagePrefix(p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Mayastor,
+			defaultImageRegistryForMayastor) +
 			"mayastor:" + p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Mayastor.ImageTag
-		p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.MayastorGRPC.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+		p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.MayastorGRPC.Image = getImagePrefix(p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.MayastorGRPC,
+			p.ObservedOpenEBS.Spec.ImagePrefix) +
 			"mayastor-grpc:" + p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.MayastorGRPC.ImageTag
 
 		// form the csi-node-driver-registrar image for mayastor for the given OpenEBS version
-		if csiNodeDriverRegistrar, exist :=
-			SupportedCSINodeDriverRegistrarVersionForMayastorVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+		if csiNodeDriverRegistrar :=
+			p.Release.CSISidecars.Mayastor.MayastorNodeDriverRegistrar; csiNodeDriverRegistrar != "" {
 			CSINodeDriverRegistrarForMayastorImageTag = "csi-node-driver-registrar:" +
 				csiNodeDriverRegistrar
 		} else {
@@ -274,12 +166,7 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 		p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Service = &types.NATSService{}
 	}
 	// Update NATS default only if it supported
-	isNATSSupported, err := p.isNATSSupported()
-	// Do not return the error as not to block installing other components.
-	if err != nil {
-		isNATSSupported = false
-		glog.Errorf("Failed to set NATS defaults, error: %v", err)
-	}
+	isNATSSupported := catalog.HasFeature(p.Release, types.ReleaseFeatureNATS)
 	if isMayastorSupported && isNATSSupported &&
 		*p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Enabled == true {
 		if len(p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Name) == 0 {
@@ -289,16 +176,15 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 			p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Service.Name = types.NATSServiceNameKey
 		}
 		if p.ObservedOpenEBS.Spec.MayastorConfig.NATS.ImageTag == "" {
-			if natsVersion, exist :=
-				supportedNATSVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+			if natsVersion := p.Release.Components.NATS; natsVersion != "" {
 				p.ObservedOpenEBS.Spec.MayastorConfig.NATS.ImageTag = natsVersion
 			} else {
 				return errors.Errorf("Failed to get nats version for the given OpenEBS version: %s",
 					p.ObservedOpenEBS.Spec.Version)
 			}
 		}
-		p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Image =
-			"nats:" + p.ObservedOpenEBS.Spec.MayastorConfig.NATS.ImageTag
+		p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Image = getImagePrefix(
+			p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Container, "") + "nats:" + p.ObservedOpenEBS.Spec.MayastorConfig.NATS.ImageTag
 
 		if p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Replicas == nil {
 			p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Replicas = new(int32)
@@ -311,20 +197,14 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 		*p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Enabled = false
 	}
 	// Update mayastor-csi default only if it supported
-	isMayastorCSISupported, err := p.isMayastorCSISupported()
-	// Do not return the error as not to block installing other components.
-	if err != nil {
-		isMayastorCSISupported = false
-		glog.Errorf("Failed to set mayastor-csi defaults, error: %v", err)
-	}
+	isMayastorCSISupported := catalog.HasFeature(p.Release, types.ReleaseFeatureMayastorCSI)
 	if isMayastorSupported && isMayastorCSISupported &&
 		*p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Enabled == true {
 		if len(p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Name) == 0 {
 			p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Name = types.MayastorCSIDaemonsetNameKey
 		}
 		if p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.ImageTag == "" {
-			if mayastorCSIVersion, exist :=
-				supportedMayastorCSIVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+			if mayastorCSIVersion := p.Release.Components.MayastorCSI; mayastorCSIVersion != "" {
 				p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.ImageTag = mayastorCSIVersion +
 					p.ObservedOpenEBS.Spec.ImageTagSuffix
 			} else {
@@ -332,12 +212,13 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 					p.ObservedOpenEBS.Spec.Version)
 			}
 		}
-		p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Image = defaultImageRegistryForMayastor +
+		p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Image = getImagePrefix(p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Container,
+			defaultImageRegistryForMayastor) +
 			"mayastor-csi:" + p.ObservedOpenEBS.Spec.MayastorConfig.Moac.ImageTag
 
 		// form the csi-node-driver-registrar image for mayastor-csi for the given OpenEBS version
-		if csiNodeDriverRegistrar, exist :=
-			SupportedCSINodeDriverRegistrarVersionForMayastorCSIVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+		if csiNodeDriverRegistrar :=
+			p.Release.CSISidecars.Mayastor.MayastorCSINodeDriverRegistrar; csiNodeDriverRegistrar != "" {
 			CSINodeDriverRegistrarForMayastorCSIImageTag = "csi-node-driver-registrar:" +
 				csiNodeDriverRegistrar
 		} else {
@@ -367,52 +248,15 @@ func (p *Planner) setMayastorDefaultsIfNotSet() error {
 	return nil
 }
 
-// isMayastorSupported checks if mayastor is supported or not in the current kubernetes cluster of openebs version,
-// if not it will return false else true.
-func (p *Planner) isMayastorSupported() (bool, error) {
-	// compare the openebs version with the supported version of mayastor.
-	comp, err := compareVersion(p.ObservedOpenEBS.Spec.Version, types.MayastorSupportedVersion)
-	if err != nil {
-		return false, errors.Errorf("Error comparing versions, error: %v", err)
-	}
-
-	// if the versions are equal, check if that contains the "ee" in the image. As mayastor installation
-	// is supported from 1.10.0-ee.
-	if comp < 0 || (comp == 0 && !strings.Contains(p.ObservedOpenEBS.Spec.Version, "ee")) {
-		glog.Warningf("Mayastor is not supported in %s openebs version. "+
-			"Mayastor is supported from %s openebs version.", p.ObservedOpenEBS.Spec.Version, types.MayastorSupportedVersion)
-		return false, nil
-	}
-
-	return true, nil
-}
-
-// isNATSSupported checks if nats is supported or not for the given OpenEBS version.
-func (p *Planner) isNATSSupported() (bool, error) {
-	// compare the openebs version with the supported version of nats.
-	comp, err := compareVersion(p.ObservedOpenEBS.Spec.Version, types.NATSSupportedVersion)
-	if err != nil {
-		return false, errors.Errorf("Error comparing versions, error: %v", err)
-	}
-	if comp < 0 {
-		return false, nil
+// isMayastorSupported checks if mayastor is supported or not in the release of the
+// given openebs version, if not it will return false else true.
+func (p *Planner) isMayastorSupported() bool {
+	if !catalog.HasFeature(p.Release, types.ReleaseFeatureMayastor) {
+		glog.Warningf("Mayastor is not supported in %s openebs version.", p.ObservedOpenEBS.Spec.Version)
+		return false
 	}
 
-	return true, nil
-}
-
-// isMayastorCSISupported checks if mayastor-csi is supported or not for the given OpenEBS version.
-func (p *Planner) isMayastorCSISupported() (bool, error) {
-	// compare the openebs version with the supported version of nats.
-	comp, err := compareVersion(p.ObservedOpenEBS.Spec.Version, types.MayastorCSISupportedVersion)
-	if err != nil {
-		return false, errors.Errorf("Error comparing versions, error: %v", err)
-	}
-	if comp < 0 {
-		return false, nil
-	}
-
-	return true, nil
+	return true
 }
 
 // updateMoac updates the moac manifest as per the reconcile.ObservedOpenEBS values.
@@ -542,18 +386,23 @@ func (p *Planner) updateMayastor(daemonset *unstructured.Unstructured) error {
 				return err
 			}
 
-			// Set the resource of the container.
-			resources := map[string]interface{}{
-				"limits": map[string]interface{}{
-					"cpu":           "1",
-					"memory":        "500Mi",
-					"hugepages-2Mi": "1Gi",
-				},
-				"requests": map[string]interface{}{
-					"cpu":           "1",
-					"memory":        "500Mi",
-					"hugepages-2Mi": "1Gi",
-				},
+			// Set the resource of the container, the resources of the
+			// component and the global ones are not used since mayastor
+			// requires the hugepages.
+			resources, exist := p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.ContainerResources[containerName]
+			if !exist {
+				resources = map[string]interface{}{
+					"limits": map[string]interface{}{
+						"cpu":           "1",
+						"memory":        "500Mi",
+						"hugepages-2Mi": "1Gi",
+					},
+					"requests": map[string]interface{}{
+						"cpu":           "1",
+						"memory":        "500Mi",
+						"hugepages-2Mi": "1Gi",
+					},
+				}
 			}
 			err = unstructured.SetNestedField(obj.Object, resources, "spec", "resources")
 		}
@@ -574,14 +423,10 @@ func (p *Planner) updateMayastor(daemonset *unstructured.Unstructured) error {
 			}
 		}
 
-		if p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Resources != nil &&
-			containerName != types.MayastorContainerKey {
-			err = unstructured.SetNestedField(obj.Object, p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Resources,
-				"spec", "resources")
-		} else if p.ObservedOpenEBS.Spec.Resources != nil &&
-			containerName != types.MayastorContainerKey {
-			err = unstructured.SetNestedField(obj.Object,
-				p.ObservedOpenEBS.Spec.Resources, "spec", "resources")
+		if containerName != types.MayastorContainerKey {
+			err = setDesiredContainerResources(obj, p.getContainerResources(containerName,
+				p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.ContainerResources,
+				p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Resources))
 		}
 		if err != nil {
 			return err
@@ -629,31 +474,40 @@ func (p *Planner) updateMayastorNamespace(namespace *unstructured.Unstructured)
 
 // removeMayastorManifests removes the manifests of mayastor if disabled.
 func (p *Planner) removeMayastorManifests() {
-	if *p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Enabled == false &&
-		*p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Enabled == false {
-		delete(p.ComponentManifests, types.MayastorNamespaceManifestKey)
-		delete(p.ComponentManifests, types.MayastorPoolsCRDManifestKey)
-	}
-
-	if *p.ObservedOpenEBS.Spec.MayastorConfig.Moac.Enabled == false {
-		delete(p.ComponentManifests, types.MoacSAManifestKey)
-		delete(p.ComponentManifests, types.MoacClusterRoleManifestKey)
-		delete(p.ComponentManifests, types.MoacClusterRoleBindingManifestKey)
-		delete(p.ComponentManifests, types.MoacDeploymentManifestKey)
-		delete(p.ComponentManifests, types.MoacServiceManifestKey)
+	mayastorConfig := p.ObservedOpenEBS.Spec.MayastorConfig
+	if *mayastorConfig.Moac.Enabled == false &&
+		*mayastorConfig.Mayastor.Enabled == false {
+		p.removeDisabledManifest(types.MayastorNamespaceManifestKey)
+		p.removeDisabledManifest(types.MayastorPoolsCRDManifestKey)
+	}
+
+	if *mayastorConfig.Moac.Enabled == false {
+		p.removeDisabledManifest(types.MoacSAManifestKey)
+		p.removeDisabledManifest(types.MoacClusterRoleManifestKey)
+		p.removeDisabledManifest(types.MoacClusterRoleBindingManifestKey)
+		p.removeDisabledManifest(types.MoacDeploymentManifestKey, mayastorConfig.Moac.Name)
+		if mayastorConfig.Moac.Service != nil {
+			p.removeDisabledManifest(types.MoacServiceManifestKey, mayastorConfig.Moac.Service.Name)
+		} else {
+			p.removeDisabledManifest(types.MoacServiceManifestKey)
+		}
 	}
 
-	if *p.ObservedOpenEBS.Spec.MayastorConfig.Mayastor.Enabled == false {
-		delete(p.ComponentManifests, types.MayastorDaemonsetManifestKey)
+	if *mayastorConfig.Mayastor.Enabled == false {
+		p.removeDisabledManifest(types.MayastorDaemonsetManifestKey, mayastorConfig.Mayastor.Name)
 	}
 
-	if *p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Enabled == false {
-		delete(p.ComponentManifests, types.MayastorCSIDaemonsetManifestKey)
+	if *mayastorConfig.MayastorCSI.Enabled == false {
+		p.removeDisabledManifest(types.MayastorCSIDaemonsetManifestKey, mayastorConfig.MayastorCSI.Name)
 	}
 
-	if *p.ObservedOpenEBS.Spec.MayastorConfig.NATS.Enabled == false {
-		delete(p.ComponentManifests, types.NATSDeploymentManifestKey)
-		delete(p.ComponentManifests, types.NATSServiceManifestKey)
+	if *mayastorConfig.NATS.Enabled == false {
+		p.removeDisabledManifest(types.NATSDeploymentManifestKey, mayastorConfig.NATS.Name)
+		if mayastorConfig.NATS.Service != nil {
+			p.removeDisabledManifest(types.NATSServiceManifestKey, mayastorConfig.NATS.Service.Name)
+		} else {
+			p.removeDisabledManifest(types.NATSServiceManifestKey)
+		}
 	}
 }
 
@@ -786,13 +640,9 @@ func (p *Planner) updateMayastorCSI(daemonset *unstructured.Unstructured) error
 			}
 		}
 
-		if p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Resources != nil {
-			err = unstructured.SetNestedField(obj.Object, p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Resources,
-				"spec", "resources")
-		} else if p.ObservedOpenEBS.Spec.Resources != nil {
-			err = unstructured.SetNestedField(obj.Object,
-				p.ObservedOpenEBS.Spec.Resources, "spec", "resources")
-		}
+		err = setDesiredContainerResources(obj, p.getContainerResources(containerName,
+			p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.ContainerResources,
+			p.ObservedOpenEBS.Spec.MayastorConfig.MayastorCSI.Resources))
 		if err != nil {
 			return err
 		}
@@ -23,39 +23,6 @@ import (
 	"mayadata.io/openebs-upgrade/unstruct"
 )
 
-// SupportedNDMVersionForOpenEBSVersion stores the mapping for
-// NDM to OpenEBS version i.e., a NDM version for each of the
-// supported OpenEBS versions.
-// Note: This will be referred to form the container images in
-// order to install/update NDM components for a particular OpenEBS
-// version.
-var SupportedNDMVersionForOpenEBSVersion = map[string]string{
-	types.OpenEBSVersion150:    types.NDMVersion045,
-	types.OpenEBSVersion160:    types.NDMVersion046,
-	types.OpenEBSVersion170:    types.NDMVersion047,
-	types.OpenEBSVersion180:    types.NDMVersion048,
-	types.OpenEBSVersion190:    types.NDMVersion049,
-	types.OpenEBSVersion190EE:  types.NDMVersion049EE,
-	types.OpenEBSVersion1100:   types.NDMVersion050,
-	types.OpenEBSVersion1100EE: types.NDMVersion050EE,
-	types.OpenEBSVersion1110:   types.NDMVersion060,
-	types.OpenEBSVersion1110EE: types.NDMVersion060EE,
-	types.OpenEBSVersion1120:   types.NDMVersion070,
-	types.OpenEBSVersion1120EE: types.NDMVersion070EE,
-	types.OpenEBSVersion200:    types.NDMVersion082,
-	types.OpenEBSVersion200EE:  types.NDMVersion082EE,
-	types.OpenEBSVersion210:    types.NDMVersion082,
-	types.OpenEBSVersion210EE:  types.NDMVersion082EE,
-	types.OpenEBSVersion220:    types.NDMVersion091,
-	types.OpenEBSVersion220EE:  types.NDMVersion091EE,
-	types.OpenEBSVersion240:    types.NDMVersion101,
-	types.OpenEBSVersion250:    types.NDMVersion110,
-	types.OpenEBSVersion260:    types.NDMVersion120,
-	types.OpenEBSVersion270:    types.NDMVersion130,
-	types.OpenEBSVersion280:    types.NDMVersion140,
-	types.OpenEBSVersion290:    types.NDMVersion141,
-}
-
 // add/update NDM defaults if not already provided
 func (p *Planner) setNDMDefaultsIfNotSet() error {
 	// Check if NDM field is set or not, if not then
@@ -79,8 +46,7 @@ func (p *Planner) setNDMDefaultsIfNotSet() error {
 	// then set the NDM image tag as per the OpenEBS version
 	// given.
 	if p.ObservedOpenEBS.Spec.NDMDaemon.ImageTag == "" {
-		if ndmVersion, exist :=
-			SupportedNDMVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+		if ndmVersion := p.Release.Components.NDM; ndmVersion != "" {
 			p.ObservedOpenEBS.Spec.NDMDaemon.ImageTag = ndmVersion +
 				p.ObservedOpenEBS.Spec.ImageTagSuffix
 		} else {
@@ -94,7 +60,8 @@ func (p *Planner) setNDMDefaultsIfNotSet() error {
 	}
 	// Form the container image for NDM components based on the image prefix
 	// and image tag.
-	p.ObservedOpenEBS.Spec.NDMDaemon.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.NDMDaemon.Image = getImagePrefix(p.ObservedOpenEBS.Spec.NDMDaemon.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		nodeDiskManagerImage + p.ObservedOpenEBS.Spec.NDMDaemon.ImageTag
 	// set the default values for NDM probes if not already set.
 	err := p.setNDMProbeDefaultsIfNotSet()
@@ -264,8 +231,7 @@ func (p *Planner) setNDMOperatorDefaultsIfNotSet() error {
 	}
 	// set the NDM operator image as per the given config values
 	if p.ObservedOpenEBS.Spec.NDMOperator.ImageTag == "" {
-		if ndmOperatorVersion, exist :=
-			SupportedNDMVersionForOpenEBSVersion[p.ObservedOpenEBS.Spec.Version]; exist {
+		if ndmOperatorVersion := p.Release.Components.NDM; ndmOperatorVersion != "" {
 			p.ObservedOpenEBS.Spec.NDMOperator.ImageTag = ndmOperatorVersion +
 				p.ObservedOpenEBS.Spec.ImageTagSuffix
 		} else {
@@ -279,7 +245,8 @@ func (p *Planner) setNDMOperatorDefaultsIfNotSet() error {
 		ndmOperatorImage = "node-disk-operator:"
 	}
 	// Form the NDM operator image as per the image prefix and image tag.
-	p.ObservedOpenEBS.Spec.NDMOperator.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.NDMOperator.Image = getImagePrefix(p.ObservedOpenEBS.Spec.NDMOperator.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		ndmOperatorImage + p.ObservedOpenEBS.Spec.NDMOperator.ImageTag
 	// set the replicas value for NDM operator to 1
 	if p.ObservedOpenEBS.Spec.NDMOperator.Replicas == nil {
@@ -556,13 +523,9 @@ func (p *Planner) updateNDM(daemonset *unstructured.Unstructured) error {
 		}
 
 		// Set the resource of the containers.
-		if p.ObservedOpenEBS.Spec.NDMDaemon.Resources != nil {
-			err = unstructured.SetNestedField(obj.Object, p.ObservedOpenEBS.Spec.NDMDaemon.Resources,
-				"spec", "resources")
-		} else if p.ObservedOpenEBS.Spec.Resources != nil {
-			err = unstructured.SetNestedField(obj.Object,
-				p.ObservedOpenEBS.Spec.Resources, "spec", "resources")
-		}
+		err = setDesiredContainerResources(obj, p.getContainerResources(containerName,
+			p.ObservedOpenEBS.Spec.NDMDaemon.ContainerResources,
+			p.ObservedOpenEBS.Spec.NDMDaemon.Resources))
 		if err != nil {
 			return err
 		}
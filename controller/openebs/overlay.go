@@ -0,0 +1,133 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+// setOverlaysDefaultsIfNotSet sets the default patch type of the overlays
+// if not already set.
+func (p *Planner) setOverlaysDefaultsIfNotSet() error {
+	for i := range p.ObservedOpenEBS.Spec.Overlays {
+		if p.ObservedOpenEBS.Spec.Overlays[i].Type == "" {
+			p.ObservedOpenEBS.Spec.Overlays[i].Type = types.OverlayPatchTypeStrategicMerge
+		}
+	}
+	return nil
+}
+
+// applyOverlays applies the overlays to the rendered manifests of the
+// components in the given order. A failed overlay does not fail the
+// reconciliation, the manifest is left as it is and the error is reported
+// against the overlay in the status.
+func (p *Planner) applyOverlays() error {
+	for i, overlay := range p.ObservedOpenEBS.Spec.Overlays {
+		status := types.OverlayStatus{
+			Index:  i,
+			Target: overlay.Target,
+		}
+		err := p.applyOverlay(overlay)
+		if err != nil {
+			glog.Errorf("Failed to apply overlay %d to %s of OpenEBS %s %s: %+v", i, overlay.Target,
+				p.ObservedOpenEBS.Namespace, p.ObservedOpenEBS.Name, err)
+			status.Error = err.Error()
+			p.addEvent(corev1.EventTypeWarning, types.EventReasonOverlayFailed,
+				fmt.Sprintf("Failed to apply overlay %d to %s: %s", i, overlay.Target, err.Error()))
+		} else {
+			status.Applied = true
+		}
+		p.OverlayStatuses = append(p.OverlayStatuses, status)
+	}
+	return nil
+}
+
+// applyOverlay patches the manifest targeted by the given overlay in place.
+func (p *Planner) applyOverlay(overlay types.Overlay) error {
+	manifest, exist := p.ComponentManifests[overlay.Target]
+	if !exist {
+		if p.DisabledComponentKeys[overlay.Target] {
+			return errors.Errorf("Target %s is disabled", overlay.Target)
+		}
+		return errors.Errorf("Target %s not found, want name_kind of a component", overlay.Target)
+	}
+	if overlay.Patch == nil {
+		return errors.Errorf("Missing patch")
+	}
+	patch, err := json.Marshal(overlay.Patch)
+	if err != nil {
+		return errors.Wrapf(err, "Invalid patch")
+	}
+	original, err := json.Marshal(manifest.Object)
+	if err != nil {
+		return errors.Wrapf(err, "Can't marshal manifest")
+	}
+
+	var patched []byte
+	switch overlay.Type {
+	case types.OverlayPatchTypeJSON:
+		jsonPatch, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return errors.Wrapf(err, "Invalid json patch")
+		}
+		patched, err = jsonPatch.Apply(original)
+		if err != nil {
+			return errors.Wrapf(err, "Can't apply json patch")
+		}
+	case types.OverlayPatchTypeStrategicMerge:
+		// the patch strategy of the lists such as containers are known only
+		// for the kinds registered with the kubernetes scheme.
+		dataStruct, err := scheme.Scheme.New(manifest.GroupVersionKind())
+		if err != nil {
+			patched, err = jsonpatch.MergePatch(original, patch)
+			if err != nil {
+				return errors.Wrapf(err, "Can't apply merge patch")
+			}
+			break
+		}
+		patched, err = strategicpatch.StrategicMergePatch(original, patch, dataStruct)
+		if err != nil {
+			return errors.Wrapf(err, "Can't apply strategic merge patch")
+		}
+	default:
+		return errors.Errorf("Invalid patch type %s, want %s or %s", overlay.Type,
+			types.OverlayPatchTypeJSON, types.OverlayPatchTypeStrategicMerge)
+	}
+
+	patchedManifest := &unstructured.Unstructured{}
+	err = json.Unmarshal(patched, &patchedManifest.Object)
+	if err != nil {
+		return errors.Wrapf(err, "Can't unmarshal patched manifest")
+	}
+	// the manifests are keyed by name_kind hence these can't be changed
+	if patchedManifest.GetName() != manifest.GetName() ||
+		patchedManifest.GetKind() != manifest.GetKind() ||
+		patchedManifest.GetNamespace() != manifest.GetNamespace() {
+		return errors.Errorf("Patch must not change the name, kind or namespace")
+	}
+	// the manifest is updated in place since it may be referred elsewhere
+	// such as in the desired CRDs.
+	manifest.Object = patchedManifest.Object
+	return nil
+}
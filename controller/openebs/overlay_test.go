@@ -0,0 +1,295 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+func TestApplyOverlay(t *testing.T) {
+	var tests = map[string]struct {
+		overlay          types.Overlay
+		isDisabled       bool
+		expectContainers string
+		expectLabel      string
+		errContains      string
+	}{
+		"strategic merge patch merges the containers by name": {
+			overlay: types.Overlay{
+				Target: types.NDMManifestKey,
+				Type:   types.OverlayPatchTypeStrategicMerge,
+				Patch: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{"name": "exporter", "image": "openebs/exporter:2.0.0"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectContainers: "node-disk-manager=openebs/node-disk-manager:1.0.0,exporter=openebs/exporter:2.0.0",
+		},
+		"json patch replaces the given path": {
+			overlay: types.Overlay{
+				Target: types.NDMManifestKey,
+				Type:   types.OverlayPatchTypeJSON,
+				Patch: []interface{}{
+					map[string]interface{}{
+						"op":    "replace",
+						"path":  "/spec/template/spec/containers/0/image",
+						"value": "openebs/node-disk-manager:2.0.0",
+					},
+					map[string]interface{}{
+						"op":    "add",
+						"path":  "/metadata/labels/team",
+						"value": "storage",
+					},
+				},
+			},
+			expectContainers: "node-disk-manager=openebs/node-disk-manager:2.0.0,exporter=openebs/exporter:1.0.0",
+			expectLabel:      "storage",
+		},
+		"json patch removes the given path": {
+			overlay: types.Overlay{
+				Target: types.NDMManifestKey,
+				Type:   types.OverlayPatchTypeJSON,
+				Patch: []interface{}{
+					map[string]interface{}{"op": "remove", "path": "/spec/template/spec/containers/1"},
+				},
+			},
+			expectContainers: "node-disk-manager=openebs/node-disk-manager:1.0.0",
+		},
+		"json patch with an invalid path": {
+			overlay: types.Overlay{
+				Target: types.NDMManifestKey,
+				Type:   types.OverlayPatchTypeJSON,
+				Patch: []interface{}{
+					map[string]interface{}{"op": "remove", "path": "/spec/missing"},
+				},
+			},
+			errContains: "Can't apply json patch",
+		},
+		"json patch which is not a list of operations": {
+			overlay: types.Overlay{
+				Target: types.NDMManifestKey,
+				Type:   types.OverlayPatchTypeJSON,
+				Patch:  map[string]interface{}{"spec": "value"},
+			},
+			errContains: "Invalid json patch",
+		},
+		"patch must not change the name": {
+			overlay: types.Overlay{
+				Target: types.NDMManifestKey,
+				Type:   types.OverlayPatchTypeStrategicMerge,
+				Patch: map[string]interface{}{
+					"metadata": map[string]interface{}{"name": "other-ndm"},
+				},
+			},
+			errContains: "must not change the name, kind or namespace",
+		},
+		"patch must not change the kind": {
+			overlay: types.Overlay{
+				Target: types.NDMManifestKey,
+				Type:   types.OverlayPatchTypeJSON,
+				Patch: []interface{}{
+					map[string]interface{}{"op": "replace", "path": "/kind", "value": "Deployment"},
+				},
+			},
+			errContains: "must not change the name, kind or namespace",
+		},
+		"patch must not change the namespace": {
+			overlay: types.Overlay{
+				Target: types.NDMManifestKey,
+				Type:   types.OverlayPatchTypeJSON,
+				Patch: []interface{}{
+					map[string]interface{}{"op": "replace", "path": "/metadata/namespace", "value": "default"},
+				},
+			},
+			errContains: "must not change the name, kind or namespace",
+		},
+		"missing target": {
+			overlay: types.Overlay{
+				Target: "missing_DaemonSet",
+				Type:   types.OverlayPatchTypeStrategicMerge,
+				Patch:  map[string]interface{}{},
+			},
+			errContains: "Target missing_DaemonSet not found",
+		},
+		"disabled target": {
+			overlay: types.Overlay{
+				Target: "missing_DaemonSet",
+				Type:   types.OverlayPatchTypeStrategicMerge,
+				Patch:  map[string]interface{}{},
+			},
+			isDisabled:  true,
+			errContains: "Target missing_DaemonSet is disabled",
+		},
+		"missing patch": {
+			overlay: types.Overlay{
+				Target: types.NDMManifestKey,
+				Type:   types.OverlayPatchTypeStrategicMerge,
+			},
+			errContains: "Missing patch",
+		},
+		"invalid patch type": {
+			overlay: types.Overlay{
+				Target: types.NDMManifestKey,
+				Type:   "merge",
+				Patch:  map[string]interface{}{},
+			},
+			errContains: "Invalid patch type merge",
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			manifest := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "apps/v1",
+					"kind":       types.KindDaemonSet,
+					"metadata": map[string]interface{}{
+						"name":      types.NDMNameKey,
+						"namespace": "openebs",
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{"name": "node-disk-manager", "image": "openebs/node-disk-manager:1.0.0"},
+									map[string]interface{}{"name": "exporter", "image": "openebs/exporter:1.0.0"},
+								},
+							},
+						},
+					},
+				},
+			}
+			p := &Planner{
+				ObservedOpenEBS:       &types.OpenEBS{},
+				ComponentManifests:    map[string]*unstructured.Unstructured{types.NDMManifestKey: manifest},
+				DisabledComponentKeys: map[string]bool{},
+			}
+			if mock.isDisabled {
+				p.DisabledComponentKeys[mock.overlay.Target] = true
+			}
+			err := p.applyOverlay(mock.overlay)
+			if mock.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), mock.errContains) {
+					t.Fatalf("Expected error %q got %v", mock.errContains, err)
+				}
+				// the manifest is left as it is
+				if manifest.GetName() != types.NDMNameKey || manifest.GetNamespace() != "openebs" {
+					t.Fatalf("Expected manifest to be left as it is got %s/%s",
+						manifest.GetNamespace(), manifest.GetName())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			// the manifest is patched in place
+			patched := p.ComponentManifests[types.NDMManifestKey]
+			if patched != manifest {
+				t.Fatalf("Expected manifest to be patched in place")
+			}
+			containers, _, _ := unstructured.NestedSlice(patched.Object, "spec", "template", "spec", "containers")
+			var gotContainers []string
+			for _, container := range containers {
+				container := container.(map[string]interface{})
+				gotContainers = append(gotContainers, container["name"].(string)+"="+container["image"].(string))
+			}
+			if strings.Join(gotContainers, ",") != mock.expectContainers {
+				t.Fatalf("Expected containers %s got %v", mock.expectContainers, gotContainers)
+			}
+			if label := patched.GetLabels()["team"]; label != mock.expectLabel {
+				t.Fatalf("Expected label %q got %q", mock.expectLabel, label)
+			}
+		})
+	}
+}
+
+func TestApplyOverlays(t *testing.T) {
+	manifest := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       types.KindDaemonSet,
+			"metadata": map[string]interface{}{
+				"name":      types.NDMNameKey,
+				"namespace": "openebs",
+				"labels": map[string]interface{}{
+					types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+				},
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"name": "node-disk-manager", "image": "openebs/node-disk-manager:1.0.0"},
+							map[string]interface{}{"name": "exporter", "image": "openebs/exporter:1.0.0"},
+						},
+					},
+				},
+			},
+		},
+	}
+	p := &Planner{
+		ObservedOpenEBS: &types.OpenEBS{
+			Spec: types.OpenEBSSpec{
+				Overlays: []types.Overlay{
+					{Target: "missing_DaemonSet", Patch: map[string]interface{}{}},
+					{
+						Target: types.NDMManifestKey,
+						Patch: map[string]interface{}{
+							"metadata": map[string]interface{}{
+								"labels": map[string]interface{}{"team": "storage"},
+							},
+						},
+					},
+				},
+			},
+		},
+		ComponentManifests: map[string]*unstructured.Unstructured{types.NDMManifestKey: manifest},
+	}
+	err := p.setOverlaysDefaultsIfNotSet()
+	if err != nil {
+		t.Fatalf("Expected no error got %v", err)
+	}
+	err = p.applyOverlays()
+	if err != nil {
+		t.Fatalf("Expected no error got %v", err)
+	}
+	if len(p.OverlayStatuses) != 2 {
+		t.Fatalf("Expected 2 overlay statuses got %d", len(p.OverlayStatuses))
+	}
+	// a failed overlay is reported and does not stop the later overlays
+	if p.OverlayStatuses[0].Applied || p.OverlayStatuses[0].Error == "" {
+		t.Fatalf("Expected overlay 0 to fail got %+v", p.OverlayStatuses[0])
+	}
+	if !p.OverlayStatuses[1].Applied || manifest.GetLabels()["team"] != "storage" {
+		t.Fatalf("Expected overlay 1 to be applied got %+v", p.OverlayStatuses[1])
+	}
+	if len(p.Events) != 1 || p.Events[0].reason != types.EventReasonOverlayFailed {
+		t.Fatalf("Expected an overlay failed event got %+v", p.Events)
+	}
+}
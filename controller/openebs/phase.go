@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+// setLifecyclePhase sets the current and the target versions of OpenEBS and
+// derives the phase of OpenEBS by comparing these. The phase derived from the
+// readiness of the components i.e., Online, Degraded or Failed is retained
+// only if OpenEBS is neither being installed nor being upgraded.
+func (p *Planner) setLifecyclePhase(response *ReconcileResponse) {
+	response.TargetVersion = p.ObservedOpenEBS.Spec.Version + p.ObservedOpenEBS.Spec.ImageTagSuffix
+	workloads := p.getManagedWorkloads()
+	response.CurrentVersion = getLowestVersion(workloads)
+
+	isUpgrading := isUpgradeBlocked(response) || p.isUpgradeInProgress()
+	switch {
+	case len(workloads) == 0:
+		response.Phase = types.OpenEBSStatusPhasePending
+		response.Reason = "Waiting for the components to be created"
+	case isUpgrading:
+		response.Phase = types.OpenEBSStatusPhaseUpgrading
+		if isUpgradeBlocked(response) {
+			response.Reason = response.UpgradeStatus.BlockedBy
+		}
+	case response.Phase == types.OpenEBSStatusPhaseOnline:
+		// the components are ready
+	case p.LastKnownGood == nil:
+		// the components have never been ready
+		response.Phase = types.OpenEBSStatusPhaseInstalling
+	case !p.IsRolledBack && p.LastKnownGood.Version != p.GivenSpec.Version:
+		// the components are yet to be ready after the upgrade
+		response.Phase = types.OpenEBSStatusPhaseUpgrading
+	}
+}
+
+// getManagedWorkloads returns the observed Deployments, DaemonSets and
+// StatefulSets managed by openebs-upgrade.
+func (p *Planner) getManagedWorkloads() []*unstructured.Unstructured {
+	var workloads []*unstructured.Unstructured
+	for _, component := range p.ObservedOpenEBSComponents {
+		if !isWorkload(component.GetKind()) ||
+			component.GetLabels()[types.OpenEBSUpgradeDAOManagedLabelKey] !=
+				types.OpenEBSUpgradeDAOManagedLabelValue {
+			continue
+		}
+		workloads = append(workloads, component)
+	}
+	return workloads
+}
+
+// getLowestVersion returns the lowest of the openebs.io/version labels of the
+// given components, empty string is returned if none of these is labelled.
+func getLowestVersion(components []*unstructured.Unstructured) string {
+	var lowestVersion string
+	for _, component := range components {
+		version, exist := component.GetLabels()[types.OpenEBSVersionLabelKey]
+		if !exist || version == "" {
+			continue
+		}
+		if lowestVersion == "" {
+			lowestVersion = version
+			continue
+		}
+		if res, err := compareVersion(version, lowestVersion); err == nil && res < 0 {
+			lowestVersion = version
+		}
+	}
+	return lowestVersion
+}
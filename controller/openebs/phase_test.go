@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+func TestSetLifecyclePhase(t *testing.T) {
+	var tests = map[string]struct {
+		runningVersion       string
+		upgradeStatus        *types.UpgradeStatus
+		readinessPhase       types.OpenEBSStatusPhase
+		lastKnownGood        *types.LastKnownGood
+		isRolledBack         bool
+		expectPhase          types.OpenEBSStatusPhase
+		expectReason         string
+		expectCurrentVersion string
+	}{
+		"components are yet to be created": {
+			expectPhase:  types.OpenEBSStatusPhasePending,
+			expectReason: "Waiting for the components to be created",
+		},
+		"components running an older version are being upgraded": {
+			runningVersion:       "2.5.0",
+			readinessPhase:       types.OpenEBSStatusPhaseOnline,
+			expectPhase:          types.OpenEBSStatusPhaseUpgrading,
+			expectCurrentVersion: "2.5.0",
+		},
+		"blocked upgrade is reported as the reason": {
+			runningVersion: "2.6.0",
+			upgradeStatus: &types.UpgradeStatus{
+				Stage:     "Operators",
+				BlockedBy: "Deployment openebs/maya-apiserver has 0/1 replicas ready",
+			},
+			readinessPhase:       types.OpenEBSStatusPhaseDegraded,
+			expectPhase:          types.OpenEBSStatusPhaseUpgrading,
+			expectReason:         "Deployment openebs/maya-apiserver has 0/1 replicas ready",
+			expectCurrentVersion: "2.6.0",
+		},
+		"ready components are online": {
+			runningVersion:       "2.6.0",
+			readinessPhase:       types.OpenEBSStatusPhaseOnline,
+			expectPhase:          types.OpenEBSStatusPhaseOnline,
+			expectCurrentVersion: "2.6.0",
+		},
+		"components which have never been ready are being installed": {
+			runningVersion:       "2.6.0",
+			readinessPhase:       types.OpenEBSStatusPhaseDegraded,
+			expectPhase:          types.OpenEBSStatusPhaseInstalling,
+			expectCurrentVersion: "2.6.0",
+		},
+		"components which are not yet ready after the upgrade are being upgraded": {
+			runningVersion:       "2.6.0",
+			readinessPhase:       types.OpenEBSStatusPhaseDegraded,
+			lastKnownGood:        &types.LastKnownGood{Version: "2.5.0"},
+			expectPhase:          types.OpenEBSStatusPhaseUpgrading,
+			expectCurrentVersion: "2.6.0",
+		},
+		"components which are not ready after the rollback are degraded": {
+			runningVersion:       "2.6.0",
+			readinessPhase:       types.OpenEBSStatusPhaseDegraded,
+			lastKnownGood:        &types.LastKnownGood{Version: "2.5.0"},
+			isRolledBack:         true,
+			expectPhase:          types.OpenEBSStatusPhaseDegraded,
+			expectCurrentVersion: "2.6.0",
+		},
+		"components which are no longer ready have failed": {
+			runningVersion:       "2.6.0",
+			readinessPhase:       types.OpenEBSStatusPhaseFailed,
+			lastKnownGood:        &types.LastKnownGood{Version: "2.6.0"},
+			expectPhase:          types.OpenEBSStatusPhaseFailed,
+			expectCurrentVersion: "2.6.0",
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			var observed []*unstructured.Unstructured
+			if mock.runningVersion != "" {
+				observed = append(observed, &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":      "maya-apiserver",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           mock.runningVersion,
+							},
+						},
+					},
+				})
+			}
+			spec := types.OpenEBSSpec{Version: "2.6.0"}
+			p := &Planner{
+				ObservedOpenEBS:           &types.OpenEBS{Spec: spec},
+				ObservedOpenEBSComponents: observed,
+				GivenSpec:                 &spec,
+				LastKnownGood:             mock.lastKnownGood,
+				IsRolledBack:              mock.isRolledBack,
+			}
+			response := &ReconcileResponse{
+				Phase:         mock.readinessPhase,
+				UpgradeStatus: mock.upgradeStatus,
+			}
+			p.setLifecyclePhase(response)
+			if response.Phase != mock.expectPhase {
+				t.Fatalf("Expected phase %s got %s", mock.expectPhase, response.Phase)
+			}
+			if response.Reason != mock.expectReason {
+				t.Fatalf("Expected reason %q got %q", mock.expectReason, response.Reason)
+			}
+			if response.CurrentVersion != mock.expectCurrentVersion {
+				t.Fatalf("Expected current version %s got %s", mock.expectCurrentVersion, response.CurrentVersion)
+			}
+			if response.TargetVersion != "2.6.0" {
+				t.Fatalf("Expected target version 2.6.0 got %s", response.TargetVersion)
+			}
+		})
+	}
+}
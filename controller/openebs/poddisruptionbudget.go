@@ -0,0 +1,123 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+// podDisruptionBudgetAPIVersion is the api version of the
+// PodDisruptionBudgets of the components.
+const podDisruptionBudgetAPIVersion string = "policy/v1beta1"
+
+// addDesiredPodDisruptionBudget adds the PodDisruptionBudget of the given
+// deployment to the desired PodDisruptionBudgets if one is requested for its
+// component and the deployment runs more than one replica.
+//
+// NOTE: A PodDisruptionBudget of a single replica either blocks the drain of
+// the node or does not protect anything, hence it is not created.
+func (p *Planner) addDesiredPodDisruptionBudget(deploy *unstructured.Unstructured,
+	replicas int32, podDisruptionBudget *types.PodDisruptionBudget) error {
+	if podDisruptionBudget == nil || replicas <= 1 {
+		return nil
+	}
+	if podDisruptionBudget.MinAvailable != nil && podDisruptionBudget.MaxUnavailable != nil {
+		return errors.Errorf(
+			"Invalid podDisruptionBudget of deployment %s: only one of minAvailable and maxUnavailable can be set",
+			deploy.GetName(),
+		)
+	}
+	if podDisruptionBudget.MinAvailable == nil && podDisruptionBudget.MaxUnavailable == nil {
+		return errors.Errorf(
+			"Invalid podDisruptionBudget of deployment %s: one of minAvailable and maxUnavailable must be set",
+			deploy.GetName(),
+		)
+	}
+	selector, _, err := unstructured.NestedMap(deploy.Object, "spec", "selector")
+	if err != nil {
+		return err
+	}
+	if len(selector) == 0 {
+		return errors.Errorf(
+			"Can't create podDisruptionBudget of deployment %s: selector not found", deploy.GetName())
+	}
+	spec := map[string]interface{}{
+		"selector": selector,
+	}
+	if podDisruptionBudget.MinAvailable != nil {
+		spec["minAvailable"] = getIntOrStringValue(*podDisruptionBudget.MinAvailable)
+	} else {
+		spec["maxUnavailable"] = getIntOrStringValue(*podDisruptionBudget.MaxUnavailable)
+	}
+
+	pdb := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": podDisruptionBudgetAPIVersion,
+			"kind":       types.KindPodDisruptionBudget,
+			"metadata": map[string]interface{}{
+				"name":      deploy.GetName() + "-pdb",
+				"namespace": deploy.GetNamespace(),
+			},
+			"spec": spec,
+		},
+	}
+	// the PodDisruptionBudget is labelled same as its deployment which
+	// includes the label that marks it as managed by openebs-upgrade.
+	pdb.SetLabels(deploy.GetLabels())
+	p.setDesiredAnnotations(pdb, nil)
+	p.DesiredPodDisruptionBudgets = append(p.DesiredPodDisruptionBudgets, pdb)
+	return nil
+}
+
+// getIntOrStringValue returns the value of the given IntOrString so that it
+// can be used in unstructured objects.
+func getIntOrStringValue(value intstr.IntOrString) interface{} {
+	if value.Type == intstr.Int {
+		return int64(value.IntVal)
+	}
+	return value.StrVal
+}
+
+// getDesiredPodDisruptionBudgets adds the desired PodDisruptionBudgets to the
+// component manifests and deletes the PodDisruptionBudgets which were created
+// earlier but are no longer desired i.e., the component is disabled, is
+// scaled to one replica or does not request a PodDisruptionBudget anymore.
+func (p *Planner) getDesiredPodDisruptionBudgets() error {
+	for _, pdb := range p.DesiredPodDisruptionBudgets {
+		p.ComponentManifests[pdb.GetName()+"_"+pdb.GetKind()] = pdb
+	}
+	for _, component := range p.ObservedOpenEBSComponents {
+		if component.GetKind() != types.KindPodDisruptionBudget ||
+			component.GetNamespace() != p.ObservedOpenEBS.Namespace {
+			continue
+		}
+		if _, exist := p.ComponentManifests[component.GetName()+"_"+component.GetKind()]; exist {
+			continue
+		}
+		if component.GetLabels()[types.OpenEBSUpgradeDAOManagedLabelKey] !=
+			types.OpenEBSUpgradeDAOManagedLabelValue {
+			continue
+		}
+		glog.V(2).Infof(
+			"Will delete PodDisruptionBudget %s %s since it is no longer desired",
+			component.GetNamespace(), component.GetName(),
+		)
+		p.ExplicitDeletes = append(p.ExplicitDeletes, component)
+	}
+	return nil
+}
@@ -35,7 +35,8 @@ func (p *Planner) setPoliciesDefaultsIfNotSet() error {
 		p.ObservedOpenEBS.Spec.Policies.Monitoring.ImageTag = p.ObservedOpenEBS.Spec.Version +
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
-	p.ObservedOpenEBS.Spec.Policies.Monitoring.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.Policies.Monitoring.Image = getImagePrefix(p.ObservedOpenEBS.Spec.Policies.Monitoring.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"m-exporter:" + p.ObservedOpenEBS.Spec.Policies.Monitoring.ImageTag
 	return nil
 }
@@ -17,13 +17,9 @@ func (p *Planner) getDesiredPriorityClass(pc *unstructured.Unstructured) (*unstr
 	if err != nil {
 		return pc, err
 	}
-	// create annotations that refers to the instance which
-	// triggered creation of this PriorityClass.
-	pc.SetAnnotations(
-		map[string]string{
-			types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.GetUID()),
-		},
-	)
+	// add the annotation that refers to the instance which triggered
+	// creation of this PriorityClass to the existing ones
+	p.setDesiredAnnotations(pc, nil)
 	return pc, nil
 }
 
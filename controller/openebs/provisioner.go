@@ -45,7 +45,8 @@ func (p *Planner) setProvisionerDefaultsIfNotSet() error {
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
 	// form the image for openebs-k8s-provisioner.
-	p.ObservedOpenEBS.Spec.Provisioner.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.Provisioner.Image = getImagePrefix(p.ObservedOpenEBS.Spec.Provisioner.Container,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"openebs-k8s-provisioner:" + p.ObservedOpenEBS.Spec.Provisioner.ImageTag
 
 	if p.ObservedOpenEBS.Spec.Provisioner.Replicas == nil {
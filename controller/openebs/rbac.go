@@ -23,13 +23,9 @@ import (
 // in OpenEBS CR.
 func (p *Planner) getDesiredNamespace(namespace *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	var err error
-	// create annotations that refers to the instance which
-	// triggered creation of this namespace
-	namespace.SetAnnotations(
-		map[string]string{
-			types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.GetUID()),
-		},
-	)
+	// add the annotation that refers to the instance which triggered
+	// creation of this namespace to the existing ones
+	p.setDesiredAnnotations(namespace, nil)
 
 	switch namespace.GetName() {
 	case types.MayastorNamespaceNameKey:
@@ -47,26 +43,24 @@ func (p *Planner) getDesiredNamespace(namespace *unstructured.Unstructured) (*un
 func (p *Planner) getDesiredServiceAccount(sa *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	var err error
 	sa.SetNamespace(p.ObservedOpenEBS.Namespace)
-	// create annotations that refers to the instance which
-	// triggered creation of this ServiceAccount
-	sa.SetAnnotations(
-		map[string]string{
-			types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.GetUID()),
-		},
-	)
 	// get the namespace where CSI based components should be installed.
 	csiNamespace, err := p.getCSIComponentsNamespace()
 	if err != nil {
 		return sa, err
 	}
+	// imagePullSecrets of the component which exclusively uses this
+	// ServiceAccount, the global ones are used for the shared ServiceAccounts.
+	var imagePullSecrets []types.ImagePullSecret
 	switch sa.GetName() {
 	case types.OpenEBSMayaOperatorSANameKey:
 		err = p.updateOpenEBSServiceAccount(sa)
 	case types.CStorCSIControllerSANameKey:
 		sa.SetNamespace(csiNamespace)
+		imagePullSecrets = p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSIController.ImagePullSecrets
 		err = p.updateCStorCSIControllerServiceAccount(sa)
 	case types.CStorCSINodeSANameKey:
 		sa.SetNamespace(csiNamespace)
+		imagePullSecrets = p.ObservedOpenEBS.Spec.CstorConfig.CSI.CSINode.ImagePullSecrets
 		err = p.updateCStorCSINodeServiceAccount(sa)
 	case types.OpenEBSCstorOperatorSANameKey:
 		err = p.updateOpenEBSCstorServiceAccount(sa)
@@ -74,11 +68,24 @@ func (p *Planner) getDesiredServiceAccount(sa *unstructured.Unstructured) (*unst
 		// Overwrite the namespace to mayastor for mayastor based components.
 		// Note: mayastor based components will be installed only in mayastor namespace only.
 		sa.SetNamespace(types.MayastorNamespaceNameKey)
+		imagePullSecrets = p.ObservedOpenEBS.Spec.MayastorConfig.Moac.ImagePullSecrets
 		err = p.updateMoacServiceAccount(sa)
 	}
 	if err != nil {
 		return sa, err
 	}
+	// add the annotation that refers to the instance which triggered
+	// creation of this ServiceAccount to the existing ones, this is done
+	// once the namespace of the ServiceAccount is set so that the
+	// annotations of the observed ServiceAccount are retained.
+	p.setDesiredAnnotations(sa, nil)
+	// set the imagePullSecrets so that the pods which are not formed by
+	// openebs-upgrade such as the data plane pods and the upgrade jobs
+	// are also able to pull the images.
+	err = setDesiredImagePullSecrets(sa, p.getImagePullSecrets(imagePullSecrets), "imagePullSecrets")
+	if err != nil {
+		return sa, err
+	}
 
 	return sa, nil
 }
@@ -235,13 +242,9 @@ func (p *Planner) getDesiredClusterRole(cr *unstructured.Unstructured) (*unstruc
 	if err != nil {
 		return cr, err
 	}
-	// create annotations that refers to the instance which
-	// triggered creation of this ClusterRole
-	cr.SetAnnotations(
-		map[string]string{
-			types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.GetUID()),
-		},
-	)
+	// add the annotation that refers to the instance which triggered
+	// creation of this ClusterRole to the existing ones
+	p.setDesiredAnnotations(cr, nil)
 	return cr, nil
 }
 
@@ -542,13 +545,9 @@ func (p *Planner) getDesiredClusterRoleBinding(crb *unstructured.Unstructured) (
 		return crb, err
 	}
 
-	// create annotations that refers to the instance which
-	// triggered creation of this ClusterRoleBinding
-	crb.SetAnnotations(
-		map[string]string{
-			types.AnnKeyOpenEBSUID: string(p.ObservedOpenEBS.GetUID()),
-		},
-	)
+	// add the annotation that refers to the instance which triggered
+	// creation of this ClusterRoleBinding to the existing ones
+	p.setDesiredAnnotations(crb, nil)
 	return crb, nil
 }
 
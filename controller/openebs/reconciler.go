@@ -15,10 +15,16 @@ package openebs
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/pkg/health"
+	"mayadata.io/openebs-upgrade/pkg/metrics"
 	"mayadata.io/openebs-upgrade/pkg/utils/metac"
 	"mayadata.io/openebs-upgrade/types"
 	"openebs.io/metac/controller/generic"
@@ -27,11 +33,32 @@ import (
 type reconcileErrHandler struct {
 	openebs      *unstructured.Unstructured
 	hookResponse *generic.SyncHookResponse
+	// isHandled is true if an error has been handled, this is used to
+	// count the failed reconciliations.
+	isHandled bool
 }
 
 type reconcileSuccessHandler struct {
-	openebs      *unstructured.Unstructured
-	hookResponse *generic.SyncHookResponse
+	openebs           *unstructured.Unstructured
+	hookResponse      *generic.SyncHookResponse
+	removedComponents []types.ComponentReference
+	upgradeStatus     *types.UpgradeStatus
+	// dataPlaneUpgradeStatus is kept in unstructured form since it is set
+	// against the status as it is.
+	dataPlaneUpgradeStatus map[string]interface{}
+	// lastKnownGood and upgradeHistory are kept in unstructured form since
+	// these are set against the status as it is.
+	lastKnownGood  map[string]interface{}
+	upgradeHistory []interface{}
+	rollbackReason string
+	// phase and reason are derived from the readiness of the components
+	// reported as conditions.
+	phase          types.OpenEBSStatusPhase
+	reason         string
+	conditions     []interface{}
+	overlays       []interface{}
+	currentVersion string
+	targetVersion  string
 }
 
 func (h *reconcileErrHandler) handle(err error) {
@@ -49,6 +76,18 @@ func (h *reconcileErrHandler) handle(err error) {
 	h.hookResponse.Status = map[string]interface{}{}
 	h.hookResponse.Status["phase"] = types.OpenEBSStatusPhaseFailed
 	h.hookResponse.Status["reason"] = err.Error()
+	h.hookResponse.Status["observedGeneration"] = h.openebs.GetGeneration()
+	h.isHandled = true
+	k8s.RecordEvent(h.openebs, corev1.EventTypeWarning, types.EventReasonReconcileFailed, err.Error())
+	// the last known good spec and the upgrade history are retained so that
+	// a failed upgrade can still be rolled back. The progress of the upgrade
+	// is retained as well since the data plane upgrade continues from the
+	// pools and volumes reported earlier.
+	for _, field := range []string{"lastKnownGood", "upgradeHistory", "upgrade", "dataPlaneUpgrade"} {
+		if value, exist, _ := unstructured.NestedFieldCopy(h.openebs.Object, "status", field); exist {
+			h.hookResponse.Status[field] = value
+		}
+	}
 	// this will stop further reconciliation at metac since there was
 	// an error
 	h.hookResponse.SkipReconcile = true
@@ -60,6 +99,61 @@ func (h *reconcileSuccessHandler) handle() {
 	// response status will be set against the watch's status by metac
 	h.hookResponse.Status = map[string]interface{}{}
 	h.hookResponse.Status["phase"] = types.OpenEBSStatusPhaseOnline
+	if h.phase != "" {
+		h.hookResponse.Status["phase"] = h.phase
+	}
+	h.hookResponse.Status["observedGeneration"] = h.openebs.GetGeneration()
+	if h.currentVersion != "" {
+		h.hookResponse.Status["currentVersion"] = h.currentVersion
+	}
+	if h.targetVersion != "" {
+		h.hookResponse.Status["targetVersion"] = h.targetVersion
+	}
+	if h.reason != "" {
+		h.hookResponse.Status["reason"] = h.reason
+	}
+	if len(h.conditions) > 0 {
+		h.hookResponse.Status["conditions"] = h.conditions
+	}
+	if len(h.overlays) > 0 {
+		h.hookResponse.Status["overlays"] = h.overlays
+	}
+	if len(h.removedComponents) > 0 {
+		var removedComponents []interface{}
+		for _, component := range h.removedComponents {
+			removedComponent := map[string]interface{}{
+				"kind": component.Kind,
+				"name": component.Name,
+			}
+			if component.Namespace != "" {
+				removedComponent["namespace"] = component.Namespace
+			}
+			removedComponents = append(removedComponents, removedComponent)
+		}
+		h.hookResponse.Status["removedComponents"] = removedComponents
+	}
+	if h.upgradeStatus != nil {
+		upgradeStatus := map[string]interface{}{
+			"stage":    h.upgradeStatus.Stage,
+			"progress": h.upgradeStatus.Progress,
+		}
+		if h.upgradeStatus.BlockedBy != "" {
+			upgradeStatus["blockedBy"] = h.upgradeStatus.BlockedBy
+		}
+		h.hookResponse.Status["upgrade"] = upgradeStatus
+	}
+	if h.dataPlaneUpgradeStatus != nil {
+		h.hookResponse.Status["dataPlaneUpgrade"] = h.dataPlaneUpgradeStatus
+	}
+	if h.lastKnownGood != nil {
+		h.hookResponse.Status["lastKnownGood"] = h.lastKnownGood
+	}
+	if len(h.upgradeHistory) > 0 {
+		h.hookResponse.Status["upgradeHistory"] = h.upgradeHistory
+	}
+	if h.rollbackReason != "" {
+		h.hookResponse.Status["reason"] = h.rollbackReason
+	}
 }
 
 // Sync implements the idempotent logic to reconcile OpenEBS
@@ -106,6 +200,12 @@ func Sync(request *generic.SyncHookRequest, response *generic.SyncHookResponse)
 		openebs:      request.Watch,
 		hookResponse: response,
 	}
+	health.Tick()
+	// record the outcome and the duration of this reconciliation
+	start := time.Now()
+	defer func() {
+		metrics.ObserveReconcile(metrics.ControllerSyncOpenEBS, start, errHandler.isHandled)
+	}()
 
 	var observedOpenEBS *unstructured.Unstructured
 	var observedOpenEBSComponents []*unstructured.Unstructured
@@ -170,6 +270,39 @@ func Sync(request *generic.SyncHookRequest, response *generic.SyncHookResponse)
 		errHandler.handle(err)
 		return nil
 	}
+	// report the planned changes instead of applying them in case of dry run
+	if resp.DryRunPlan != nil {
+		dryRunPlan, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resp.DryRunPlan)
+		if err != nil {
+			errHandler.handle(errors.Wrapf(err, "Can't convert dry run plan"))
+			return nil
+		}
+		glog.V(2).Infof(
+			"Dry run of OpenEBS %s %s: %s",
+			request.Watch.GetNamespace(), request.Watch.GetName(), resp.DryRunPlan.Summary,
+		)
+		// rest of the status is kept as it is
+		status, _, _ := unstructured.NestedMap(observedOpenEBS.Object, "status")
+		if status == nil {
+			status = map[string]interface{}{}
+		}
+		status["dryRun"] = dryRunPlan
+		status["reason"] = "Dry run: " + resp.DryRunPlan.Summary
+		overlayStatuses, err := getOverlayStatuses(resp.OverlayStatuses)
+		if err != nil {
+			errHandler.handle(err)
+			return nil
+		}
+		if len(overlayStatuses) > 0 {
+			status["overlays"] = overlayStatuses
+		} else {
+			delete(status, "overlays")
+		}
+		response.Status = status
+		// this will stop metac from applying the planned changes
+		response.SkipReconcile = true
+		return nil
+	}
 	// add all the desired OpenEBS components as attachments in the response
 	if resp.DesiredOpenEBSComponents != nil {
 		for _, desiredOpenEBSComponent := range resp.DesiredOpenEBSComponents {
@@ -196,18 +329,92 @@ func Sync(request *generic.SyncHookRequest, response *generic.SyncHookResponse)
 		}
 	}
 
+	// record the events for the components which have been created, updated
+	// or deleted since the last sync along with the other events of this
+	// reconciliation.
+	recordComponentEvents(request.Watch, observedOpenEBSComponents)
+	for _, event := range resp.Events {
+		k8s.RecordEvent(request.Watch, event.eventType, event.reason, event.message)
+	}
+
+	// check the rollout of the current stage again after some time if OpenEBS
+	// is being upgraded.
+	if isUpgradeBlocked(&resp) {
+		response.ResyncAfterSeconds = upgradeResyncPeriodSeconds
+	}
+	// check the progress of the data plane upgrade again after some time since
+	// the pools and volumes being upgraded are not watched.
+	if resp.DataPlaneUpgradeStatus != nil &&
+		resp.DataPlaneUpgradeStatus.Phase == types.DataPlaneUpgradePhaseUpgrading {
+		response.ResyncAfterSeconds = upgradeResyncPeriodSeconds
+	}
+	// check the readiness of the components again after some time till the
+	// upgrade either succeeds or gets rolled back.
+	if len(resp.UpgradeHistory) > 0 &&
+		resp.UpgradeHistory[len(resp.UpgradeHistory)-1].Phase == types.UpgradeHistoryPhaseUpgrading {
+		response.ResyncAfterSeconds = upgradeResyncPeriodSeconds
+	}
+
 	glog.V(2).Infof(
 		"OpenEBS %s %s reconciled successfully: %s",
 		request.Watch.GetNamespace(), request.Watch.GetName(),
 		metac.GetDetailsFromResponse(response),
 	)
 
+	metrics.SetOpenEBSState(request.Watch.GetNamespace(), request.Watch.GetName(),
+		getMetricsState(resp))
+
 	// If OpenEBS is not being updated explicitly then use this to update OpenEBS status.
 	if !isOpenEBSExplicitlyUpdated {
 		// construct the success handler
 		successHandler := &reconcileSuccessHandler{
-			openebs:      request.Watch,
-			hookResponse: response,
+			openebs:           request.Watch,
+			hookResponse:      response,
+			removedComponents: resp.RemovedComponents,
+			upgradeStatus:     resp.UpgradeStatus,
+		}
+		if resp.DataPlaneUpgradeStatus != nil {
+			dataPlaneUpgradeStatus, err :=
+				runtime.DefaultUnstructuredConverter.ToUnstructured(resp.DataPlaneUpgradeStatus)
+			if err != nil {
+				errHandler.handle(errors.Wrapf(err, "Can't convert data plane upgrade status"))
+				return nil
+			}
+			successHandler.dataPlaneUpgradeStatus = dataPlaneUpgradeStatus
+		}
+		if resp.LastKnownGood != nil {
+			lastKnownGood, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resp.LastKnownGood)
+			if err != nil {
+				errHandler.handle(errors.Wrapf(err, "Can't convert last known good spec"))
+				return nil
+			}
+			successHandler.lastKnownGood = lastKnownGood
+		}
+		for i := range resp.UpgradeHistory {
+			entry, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&resp.UpgradeHistory[i])
+			if err != nil {
+				errHandler.handle(errors.Wrapf(err, "Can't convert upgrade history"))
+				return nil
+			}
+			successHandler.upgradeHistory = append(successHandler.upgradeHistory, entry)
+		}
+		successHandler.rollbackReason = resp.RollbackReason
+		successHandler.phase = resp.Phase
+		successHandler.currentVersion = resp.CurrentVersion
+		successHandler.targetVersion = resp.TargetVersion
+		successHandler.reason = resp.Reason
+		for i := range resp.Conditions {
+			condition, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&resp.Conditions[i])
+			if err != nil {
+				errHandler.handle(errors.Wrapf(err, "Can't convert conditions"))
+				return nil
+			}
+			successHandler.conditions = append(successHandler.conditions, condition)
+		}
+		successHandler.overlays, err = getOverlayStatuses(resp.OverlayStatuses)
+		if err != nil {
+			errHandler.handle(err)
+			return nil
 		}
 		successHandler.handle()
 	}
@@ -215,6 +422,20 @@ func Sync(request *generic.SyncHookRequest, response *generic.SyncHookResponse)
 	return nil
 }
 
+// getOverlayStatuses converts the given overlay statuses to be set against
+// the status of OpenEBS.
+func getOverlayStatuses(overlayStatuses []types.OverlayStatus) ([]interface{}, error) {
+	var statuses []interface{}
+	for i := range overlayStatuses {
+		status, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&overlayStatuses[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "Can't convert overlay status")
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
 // Reconciler enables reconciliation of OpenEBS instance
 type Reconciler struct {
 	ObservedOpenEBS                           *types.OpenEBS
@@ -241,6 +462,37 @@ type ReconcileResponse struct {
 	DesiredOpenEBSComponents []*unstructured.Unstructured
 	ExplicitDeletes          []*unstructured.Unstructured
 	ExplicitUpdates          []*unstructured.Unstructured
+	// RemovedComponents are the components that are removed from the
+	// cluster since these are disabled.
+	RemovedComponents []types.ComponentReference
+	// UpgradeStatus is set only if OpenEBS is being upgraded in stages or
+	// the pods of the OnDelete daemonsets are yet to be deleted.
+	UpgradeStatus *types.UpgradeStatus
+	// DataPlaneUpgradeStatus is set only if data plane upgrade is enabled.
+	DataPlaneUpgradeStatus *types.DataPlaneUpgradeStatus
+	// LastKnownGood and UpgradeHistory are used for rolling back a failed
+	// upgrade.
+	LastKnownGood  *types.LastKnownGood
+	UpgradeHistory []types.UpgradeHistoryEntry
+	// Conditions report the readiness of the components, Phase and Reason
+	// are derived from these.
+	Conditions []types.OpenEBSStatusCondition
+	Phase      types.OpenEBSStatusPhase
+	Reason     string
+	// CurrentVersion is the lowest version the components are running at
+	// while TargetVersion is the version being installed.
+	CurrentVersion string
+	TargetVersion  string
+	// DryRunPlan is set only in case of dry run, the changes are not
+	// applied in this case.
+	DryRunPlan *types.DryRunPlan
+	// Events are recorded against OpenEBS once it is reconciled.
+	Events []reconcileEvent
+	// RollbackReason is set only if the last known good spec is being
+	// applied since the upgrade got rolled back.
+	RollbackReason string
+	// OverlayStatuses report the result of every overlay.
+	OverlayStatuses []types.OverlayStatus
 }
 
 // Planner ensures if any of the instances need
@@ -257,23 +509,88 @@ type Planner struct {
 	ComponentManifests map[string]*unstructured.Unstructured
 	ExplicitDeletes    []*unstructured.Unstructured
 	ExplicitUpdates    []*unstructured.Unstructured
+
+	// DesiredPodDisruptionBudgets are the PodDisruptionBudgets of the
+	// components, these are added to the component manifests once all the
+	// manifests are rendered.
+	DesiredPodDisruptionBudgets []*unstructured.Unstructured
+
+	// DisabledComponentKeys stores the keys i.e., name_kind of all the
+	// components which are disabled.
+	DisabledComponentKeys map[string]bool
+	RemovedComponents     []types.ComponentReference
+
+	// GivenSpec is the copy of the spec as given by the user i.e., before
+	// it gets rendered.
+	GivenSpec      *types.OpenEBSSpec
+	LastKnownGood  *types.LastKnownGood
+	UpgradeHistory []types.UpgradeHistoryEntry
+	// IsRolledBack is true if the last known good spec is being applied
+	// instead of the given spec.
+	IsRolledBack bool
+
+	// Release is the release descriptor of the OpenEBS version being
+	// installed as read from the release catalog.
+	Release *types.ReleaseDescriptor
+
+	// Events are the events to be recorded against OpenEBS.
+	Events []reconcileEvent
+
+	// OverlayStatuses report the result of every overlay.
+	OverlayStatuses []types.OverlayStatus
+}
+
+// reconcileEvent is an event to be recorded against OpenEBS once it
+// is reconciled.
+type reconcileEvent struct {
+	eventType string
+	reason    string
+	message   string
+}
+
+// addEvent adds an event to be recorded against OpenEBS.
+func (p *Planner) addEvent(eventType, reason, message string) {
+	p.Events = append(p.Events, reconcileEvent{
+		eventType: eventType,
+		reason:    reason,
+		message:   message,
+	})
+}
+
+// getMetricsState returns the state of OpenEBS to be exported as metrics
+// as per the given response.
+func getMetricsState(resp ReconcileResponse) metrics.OpenEBSState {
+	state := metrics.OpenEBSState{
+		DesiredVersion:    resp.TargetVersion,
+		RunningVersion:    resp.CurrentVersion,
+		ManagedComponents: make(map[string]int),
+	}
+	for _, component := range resp.DesiredOpenEBSComponents {
+		state.ManagedComponents[component.GetKind()]++
+	}
+	for _, condition := range resp.Conditions {
+		if condition.Type != types.ComponentReadyCondition {
+			continue
+		}
+		state.Components = append(state.Components, metrics.ComponentReadiness{
+			Kind:      condition.Kind,
+			Component: condition.Component,
+			IsReady:   condition.Status == types.ConditionIsPresent,
+		})
+	}
+	return state
 }
 
 // NewReconciler returns a new instance of Reconciler
 func NewReconciler(config ReconcilerConfig) (*Reconciler, error) {
 	// transform OpenEBS from unstructured to typed
-	var openebsTyped types.OpenEBS
-	openebsRaw, err := config.ObservedOpenEBS.MarshalJSON()
-	if err != nil {
-		return nil, errors.Wrapf(err, "Can't marshal OpenEBS")
-	}
-	err = json.Unmarshal(openebsRaw, &openebsTyped)
+	openebsTyped, err := toTypedOpenEBS(config.ObservedOpenEBS)
 	if err != nil {
-		return nil, errors.Wrapf(err, "Can't unmarshal OpenEBS")
+		return nil, err
 	}
 	// use above constructed object to build Reconciler instance
 	return &Reconciler{
-		ObservedOpenEBS:                           &openebsTyped,
+		ObservedOpenEBS:                           openebsTyped,
 		ObservedOpenEBSComponents:                 config.ObservedOpenEBSComponents,
 		ObservedOpenEBSCRDs:                       config.ObservedOpenEBSCRDs,
 		ObservedOpenEBSClusterRoleAndRoleBindings: config.ObservedOpenEBSClusterRoleAndRoleBindings,
@@ -281,6 +598,20 @@ func NewReconciler(config ReconcilerConfig) (*Reconciler, error) {
 	}, nil
 }
 
+// toTypedOpenEBS transforms the given unstructured OpenEBS to typed OpenEBS.
+func toTypedOpenEBS(openebs *unstructured.Unstructured) (*types.OpenEBS, error) {
+	var openebsTyped types.OpenEBS
+	openebsRaw, err := openebs.MarshalJSON()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't marshal OpenEBS")
+	}
+	err = json.Unmarshal(openebsRaw, &openebsTyped)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't unmarshal OpenEBS")
+	}
+	return &openebsTyped, nil
+}
+
 // Reconcile runs through the reconciliation logic
 //
 // NOTE:
@@ -298,9 +629,28 @@ func (r *Reconciler) Reconcile() (ReconcileResponse, error) {
 
 // Plan builds the desired instances/components of OpenEBS
 func (p *Planner) Plan() (ReconcileResponse, error) {
+	// apply the last known good spec if the upgrade has been rolled back
+	err := p.applyRollbackIfNeeded()
+	if err != nil {
+		return ReconcileResponse{}, err
+	}
+	// get the release of the OpenEBS version to be installed, the
+	// unsupported versions are rejected here.
+	err = p.setRelease()
+	if err != nil {
+		return ReconcileResponse{}, err
+	}
+	// reject the upgrades which are not allowed from the running version,
+	// a rollback is an intended downgrade hence it is not validated.
+	if !p.IsRolledBack {
+		err = p.validateUpgradePath()
+		if err != nil {
+			return ReconcileResponse{}, err
+		}
+	}
 	// check if some dependencies/tools/components needs to be installed before OpenEBS
 	// installation.
-	err := p.getPreInstallationManifests()
+	err = p.getPreInstallationManifests()
 	if err != nil {
 		return ReconcileResponse{}, err
 	}
@@ -308,7 +658,28 @@ func (p *Planner) Plan() (ReconcileResponse, error) {
 	if err != nil {
 		return ReconcileResponse{}, err
 	}
-	return p.getDesiredOpenEBSComponents(), nil
+	response := p.getDesiredOpenEBSComponents()
+	// upgrade the components in stages if OpenEBS is being upgraded
+	p.stageDesiredComponents(&response)
+	// snapshot the spec once ready or roll back if the upgrade has failed
+	p.recordUpgrade(&response)
+	// report the readiness of the components and the phase of OpenEBS
+	p.setComponentConditions(&response)
+	p.setLifecyclePhase(&response)
+	response.OverlayStatuses = p.OverlayStatuses
+	// changes are only reported in case of dry run
+	if p.isDryRun() {
+		response.DryRunPlan = getDryRunPlan(
+			string(p.ObservedOpenEBS.UID), p.ObservedOpenEBSComponents, response)
+		return response, nil
+	}
+	// upgrade the pools and volumes once the components are upgraded
+	err = p.upgradeDataPlane(&response)
+	if err != nil {
+		return ReconcileResponse{}, err
+	}
+	response.Events = p.Events
+	return response, nil
 }
 
 // getDesiredOpenEBSComponents gets all the desired OpenEBS components which
@@ -329,6 +700,7 @@ func (p *Planner) getDesiredOpenEBSComponents() ReconcileResponse {
 	for _, componentToUpdate := range p.ExplicitUpdates {
 		response.ExplicitUpdates = append(response.ExplicitUpdates, componentToUpdate)
 	}
+	response.RemovedComponents = p.RemovedComponents
 	// add the observed OpenEBS CRDs to desired OpenEBS CRDs that are not already present
 	// in the desiredOpenEBS components list.
 	if len(p.ObservedOpenEBSCRDs) > 0 {
@@ -353,6 +725,11 @@ func (p *Planner) getDesiredOpenEBSComponents() ReconcileResponse {
 	if len(p.ObservedOpenEBSClusterRoleAndRoleBindings) > 0 {
 		for _, observedOpenEBSClusterRoleAndRoleBindings := range p.ObservedOpenEBSClusterRoleAndRoleBindings {
 			key := observedOpenEBSClusterRoleAndRoleBindings.GetName() + "_" + observedOpenEBSClusterRoleAndRoleBindings.GetKind()
+			// clusterroles & clusterrolebindings of the disabled components are deleted
+			// and hence should not be added back.
+			if p.DisabledComponentKeys[key] {
+				continue
+			}
 			if desiredClusterRoleAndRoleBinding, exist := p.ComponentManifests[key]; exist {
 				// If already exists then check if the APIVersion is same or not, if
 				// not then add it to the desired OpenEBS components list.
@@ -407,9 +784,14 @@ func (p *Planner) init() error {
 		p.setHelperDefaultsIfNotSet,
 		p.setPoliciesDefaultsIfNotSet,
 		p.setAnalyticsDefaultsIfNotSet,
+		p.setDataPlaneUpgradeDefaultsIfNotSet,
+		p.setRollbackDefaultsIfNotSet,
+		p.setOverlaysDefaultsIfNotSet,
 		p.getDesiredValuesFromObservedResources,
 		p.removeDisabledManifests,
 		p.getDesiredManifests,
+		p.getDesiredPodDisruptionBudgets,
+		p.applyOverlays,
 	}
 	for _, fn := range initFuncs {
 		err := fn()
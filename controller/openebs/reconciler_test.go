@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+	"openebs.io/metac/controller/generic"
+)
+
+func TestReconcileErrHandler(t *testing.T) {
+	var tests = map[string]struct {
+		status       map[string]interface{}
+		expectStatus map[string]interface{}
+	}{
+		"status of the upgrade is retained": {
+			status: map[string]interface{}{
+				"phase":          "Upgrading",
+				"lastKnownGood":  map[string]interface{}{"version": "2.5.0"},
+				"upgradeHistory": []interface{}{map[string]interface{}{"toVersion": "2.6.0"}},
+				"upgrade": map[string]interface{}{
+					"stage":     "Operators",
+					"progress":  "2/5 stages completed",
+					"blockedBy": "Deployment openebs/maya-apiserver has 0/1 replicas ready",
+				},
+				"dataPlaneUpgrade": map[string]interface{}{
+					"targetVersion": "2.6.0",
+					"phase":         "Upgrading",
+					"pools": []interface{}{
+						map[string]interface{}{"kind": "CStorPoolInstance", "name": "pool-1", "phase": "Completed"},
+					},
+				},
+				"conditions": []interface{}{map[string]interface{}{"type": "Ready"}},
+			},
+			expectStatus: map[string]interface{}{
+				"phase":              types.OpenEBSStatusPhaseFailed,
+				"reason":             "error",
+				"observedGeneration": int64(2),
+				"lastKnownGood":      map[string]interface{}{"version": "2.5.0"},
+				"upgradeHistory":     []interface{}{map[string]interface{}{"toVersion": "2.6.0"}},
+				"upgrade": map[string]interface{}{
+					"stage":     "Operators",
+					"progress":  "2/5 stages completed",
+					"blockedBy": "Deployment openebs/maya-apiserver has 0/1 replicas ready",
+				},
+				"dataPlaneUpgrade": map[string]interface{}{
+					"targetVersion": "2.6.0",
+					"phase":         "Upgrading",
+					"pools": []interface{}{
+						map[string]interface{}{"kind": "CStorPoolInstance", "name": "pool-1", "phase": "Completed"},
+					},
+				},
+			},
+		},
+		"status without any upgrade": {
+			status: map[string]interface{}{
+				"phase": "Online",
+			},
+			expectStatus: map[string]interface{}{
+				"phase":              types.OpenEBSStatusPhaseFailed,
+				"reason":             "error",
+				"observedGeneration": int64(2),
+			},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			openebs := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"name":       "openebs",
+						"namespace":  "openebs",
+						"generation": int64(2),
+					},
+					"status": mock.status,
+				},
+			}
+			handler := &reconcileErrHandler{
+				openebs:      openebs,
+				hookResponse: &generic.SyncHookResponse{},
+			}
+			handler.handle(errors.New("error"))
+			if !reflect.DeepEqual(handler.hookResponse.Status, mock.expectStatus) {
+				t.Fatalf("Expected status %v got %v", mock.expectStatus, handler.hookResponse.Status)
+			}
+			if !handler.isHandled || !handler.hookResponse.SkipReconcile {
+				t.Fatalf("Expected error to be handled skipping the reconciliation")
+			}
+		})
+	}
+}
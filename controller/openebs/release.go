@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/pkg/catalog"
+)
+
+// setRelease sets the release descriptor of the OpenEBS version being
+// installed from the release catalog and verifies if the release can be
+// installed on this kubernetes cluster.
+func (p *Planner) setRelease() error {
+	release, err := catalog.GetRelease(p.ObservedOpenEBS.Spec.Version)
+	if err != nil {
+		return err
+	}
+	p.Release = release
+
+	minVersion := release.Kubernetes.MinVersion
+	maxVersion := release.Kubernetes.MaxVersion
+	if minVersion == "" && maxVersion == "" {
+		return nil
+	}
+	k8sVersion, err := k8s.GetK8sVersion()
+	if err != nil {
+		return errors.Errorf("Unable to find kubernetes version, error: %v", err)
+	}
+	version := getKubernetesReleaseVersion(k8sVersion)
+	if minVersion != "" {
+		res, err := compareVersion(version, getKubernetesReleaseVersion(minVersion))
+		if err != nil {
+			return errors.Errorf("Error comparing versions, error: %v", err)
+		}
+		if res < 0 {
+			return errors.Errorf(
+				"OpenEBS version %s requires kubernetes version %s or above, found %s",
+				release.Version, minVersion, k8sVersion)
+		}
+	}
+	if maxVersion != "" {
+		res, err := compareVersion(version, getKubernetesReleaseVersion(maxVersion))
+		if err != nil {
+			return errors.Errorf("Error comparing versions, error: %v", err)
+		}
+		if res > 0 {
+			return errors.Errorf(
+				"OpenEBS version %s requires kubernetes version %s or below, found %s",
+				release.Version, maxVersion, k8sVersion)
+		}
+	}
+	return nil
+}
+
+// getKubernetesReleaseVersion returns the major, minor and patch versions
+// of the given kubernetes version i.e., 1.18.9 for v1.18.9-eks-d1db3c so
+// that the vendor specific suffixes are not compared.
+func getKubernetesReleaseVersion(version string) string {
+	version = strings.TrimPrefix(version, "v")
+	if i := strings.IndexAny(version, "-+"); i != -1 {
+		version = version[:i]
+	}
+	return version
+}
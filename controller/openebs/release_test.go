@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/pkg/catalog"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+func TestSetRelease(t *testing.T) {
+	var tests = map[string]struct {
+		version     string
+		k8sVersion  string
+		errContains string
+	}{
+		"release without kubernetes versions": {
+			version: "2.5.0",
+		},
+		"kubernetes version within the range": {
+			version:    "2.6.0",
+			k8sVersion: "v1.18.9-eks-d1db3c",
+		},
+		"kubernetes version at the minimum": {
+			version:    "2.6.0",
+			k8sVersion: "v1.14.0",
+		},
+		"kubernetes version below the minimum": {
+			version:     "2.6.0",
+			k8sVersion:  "v1.13.5",
+			errContains: "requires kubernetes version v1.14.0 or above",
+		},
+		"kubernetes version above the maximum": {
+			version:     "2.6.0",
+			k8sVersion:  "v1.21.0+k3s1",
+			errContains: "requires kubernetes version 1.20 or below",
+		},
+		"missing release": {
+			version:     "2.7.0",
+			errContains: "Unsupported OpenEBS version",
+		},
+	}
+	dir, err := ioutil.TempDir("", "catalog")
+	if err != nil {
+		t.Fatalf("Expected no error got %v", err)
+	}
+	defer os.RemoveAll(dir)
+	operatorYAML := filepath.Join(dir, "operator.yaml")
+	releases := map[string]string{
+		"operator.yaml":      "kind: Namespace\n",
+		"release-2.5.0.yaml": "version: 2.5.0\noperatorYAML: " + operatorYAML + "\n",
+		"release-2.6.0.yaml": "version: 2.6.0\noperatorYAML: " + operatorYAML + "\n" +
+			"kubernetes:\n  minVersion: v1.14.0\n  maxVersion: \"1.20\"\n",
+	}
+	for name, content := range releases {
+		err = ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatalf("Expected no error got %v", err)
+		}
+	}
+	err = catalog.Init(catalog.Config{Dir: dir})
+	if err != nil {
+		t.Fatalf("Expected no error got %v", err)
+	}
+	defer func() { k8s.Clientset = nil }()
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			clientset.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion =
+				&version.Info{GitVersion: mock.k8sVersion}
+			k8s.Clientset = clientset
+			p := &Planner{
+				ObservedOpenEBS: &types.OpenEBS{Spec: types.OpenEBSSpec{Version: mock.version}},
+			}
+			err := p.setRelease()
+			if mock.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), mock.errContains) {
+					t.Fatalf("Expected error %q got %v", mock.errContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			if p.Release == nil || p.Release.Version != mock.version {
+				t.Fatalf("Expected release %s got %+v", mock.version, p.Release)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+const (
+	// DefaultRollbackTimeoutSeconds is the default time given to an upgrade
+	// to make progress before it is rolled back.
+	DefaultRollbackTimeoutSeconds int32 = 600
+
+	// maxUpgradeHistoryEntries is the number of the most recent upgrades that
+	// are kept in the upgrade history.
+	maxUpgradeHistoryEntries = 10
+)
+
+// irreversibleCRDChangeVersions are the OpenEBS versions which change the
+// CRDs in a way that can't be undone, for example, by migrating the custom
+// resources to a new API version. An upgrade from a version below any of
+// these to a version at or above it is never rolled back.
+var irreversibleCRDChangeVersions = []string{
+	// cStor custom resources are migrated to cstor.openebs.io/v1
+	types.OpenEBSVersion200,
+}
+
+// setRollbackDefaultsIfNotSet sets the default values for rollback if not set.
+func (p *Planner) setRollbackDefaultsIfNotSet() error {
+	if p.ObservedOpenEBS.Spec.Rollback == nil {
+		p.ObservedOpenEBS.Spec.Rollback = &types.Rollback{}
+	}
+	if p.ObservedOpenEBS.Spec.Rollback.Enabled == nil {
+		p.ObservedOpenEBS.Spec.Rollback.Enabled = new(bool)
+		*p.ObservedOpenEBS.Spec.Rollback.Enabled = false
+	}
+	if p.ObservedOpenEBS.Spec.Rollback.TimeoutSeconds == nil ||
+		*p.ObservedOpenEBS.Spec.Rollback.TimeoutSeconds < 1 {
+		p.ObservedOpenEBS.Spec.Rollback.TimeoutSeconds = new(int32)
+		*p.ObservedOpenEBS.Spec.Rollback.TimeoutSeconds = DefaultRollbackTimeoutSeconds
+	}
+	return nil
+}
+
+// applyRollbackIfNeeded keeps a copy of the spec as given by the user and
+// replaces the spec with the last known good spec if the upgrade to the given
+// version has been rolled back.
+//
+// NOTE: This is done before the spec gets rendered so that the last known
+// good spec is rendered exactly the way it was rendered earlier.
+func (p *Planner) applyRollbackIfNeeded() error {
+	givenSpec, err := copyOpenEBSSpec(&p.ObservedOpenEBS.Spec)
+	if err != nil {
+		return err
+	}
+	p.GivenSpec = givenSpec
+	p.LastKnownGood = p.ObservedOpenEBS.Status.LastKnownGood
+	p.UpgradeHistory = append(p.UpgradeHistory, p.ObservedOpenEBS.Status.UpgradeHistory...)
+
+	entry := p.getLatestUpgradeHistoryEntry()
+	if entry == nil || entry.Phase != types.UpgradeHistoryPhaseRolledBack ||
+		entry.ToVersion != givenSpec.Version || p.LastKnownGood == nil ||
+		p.LastKnownGood.Spec == nil || p.LastKnownGood.Version != entry.FromVersion {
+		return nil
+	}
+	lastKnownGoodSpec, err := copyOpenEBSSpec(p.LastKnownGood.Spec)
+	if err != nil {
+		return err
+	}
+	glog.V(2).Infof(
+		"Applying last known good version %s of OpenEBS %s %s since upgrade to version %s was rolled back",
+		entry.FromVersion, p.ObservedOpenEBS.Namespace, p.ObservedOpenEBS.Name, entry.ToVersion,
+	)
+	p.ObservedOpenEBS.Spec = *lastKnownGoodSpec
+	p.IsRolledBack = true
+	return nil
+}
+
+// recordUpgrade snapshots the given spec once all the components are ready
+// and records the upgrades in the upgrade history. An upgrade which does not
+// make any progress within the timeout i.e., neither moves to the next stage
+// nor rolls out any further component, is marked as rolled back so that the
+// last known good spec gets applied from the next reconciliation onwards.
+func (p *Planner) recordUpgrade(response *ReconcileResponse) {
+	defer func() {
+		response.LastKnownGood = p.LastKnownGood
+		response.UpgradeHistory = p.UpgradeHistory
+	}()
+	if p.IsRolledBack {
+		entry := p.getLatestUpgradeHistoryEntry()
+		response.RollbackReason = fmt.Sprintf(
+			"Upgrade to version %s was rolled back to version %s: %s, "+
+				"change spec.version to upgrade again",
+			entry.ToVersion, entry.FromVersion, entry.Reason,
+		)
+		return
+	}
+
+	version := p.GivenSpec.Version
+	isReady := p.isOpenEBSReady(response)
+	if p.LastKnownGood == nil || p.LastKnownGood.Version == version {
+		if isReady && (p.LastKnownGood == nil || !isSameOpenEBSSpec(p.LastKnownGood.Spec, p.GivenSpec)) {
+			p.snapshotLastKnownGood()
+		}
+		return
+	}
+
+	// OpenEBS is being upgraded from the last known good version
+	entry := p.getLatestUpgradeHistoryEntry()
+	if entry == nil || entry.FromVersion != p.LastKnownGood.Version || entry.ToVersion != version ||
+		entry.Phase == types.UpgradeHistoryPhaseSucceeded {
+		now := time.Now().UTC().Format(time.RFC3339)
+		p.UpgradeHistory = append(p.UpgradeHistory, types.UpgradeHistoryEntry{
+			FromVersion:      p.LastKnownGood.Version,
+			ToVersion:        version,
+			Phase:            types.UpgradeHistoryPhaseUpgrading,
+			StartTime:        now,
+			LastProgressTime: now,
+		})
+		if len(p.UpgradeHistory) > maxUpgradeHistoryEntries {
+			p.UpgradeHistory = p.UpgradeHistory[len(p.UpgradeHistory)-maxUpgradeHistoryEntries:]
+		}
+		entry = p.getLatestUpgradeHistoryEntry()
+		p.addEvent(corev1.EventTypeNormal, types.EventReasonUpgradeStarted,
+			fmt.Sprintf("Upgrading OpenEBS from version %s to version %s", entry.FromVersion, entry.ToVersion))
+	}
+	if isReady {
+		glog.V(2).Infof("OpenEBS %s %s upgraded from version %s to version %s",
+			p.ObservedOpenEBS.Namespace, p.ObservedOpenEBS.Name, entry.FromVersion, entry.ToVersion)
+		entry.Phase = types.UpgradeHistoryPhaseSucceeded
+		entry.Reason = ""
+		entry.CompletionTime = time.Now().UTC().Format(time.RFC3339)
+		p.snapshotLastKnownGood()
+		p.addEvent(corev1.EventTypeNormal, types.EventReasonUpgradeSucceeded,
+			fmt.Sprintf("Upgraded OpenEBS from version %s to version %s", entry.FromVersion, entry.ToVersion))
+		return
+	}
+	if entry.Phase != types.UpgradeHistoryPhaseUpgrading {
+		return
+	}
+	// the upgrade has made progress if it has moved to another stage or
+	// the component blocking the stage has changed or progressed.
+	var progress string
+	if response.UpgradeStatus != nil {
+		progress = response.UpgradeStatus.Stage + ": " + response.UpgradeStatus.BlockedBy
+	}
+	if progress != entry.Progress || entry.LastProgressTime == "" {
+		entry.Progress = progress
+		entry.LastProgressTime = time.Now().UTC().Format(time.RFC3339)
+		return
+	}
+	if !*p.ObservedOpenEBS.Spec.Rollback.Enabled {
+		return
+	}
+	lastProgressTime, err := time.Parse(time.RFC3339, entry.LastProgressTime)
+	if err != nil {
+		glog.Warningf("Can't parse last progress time %s of upgrade to version %s: %+v",
+			entry.LastProgressTime, entry.ToVersion, err)
+		return
+	}
+	timeout := time.Duration(*p.ObservedOpenEBS.Spec.Rollback.TimeoutSeconds) * time.Second
+	if time.Since(lastProgressTime) < timeout {
+		return
+	}
+
+	reason := fmt.Sprintf("No progress within %s", timeout)
+	if response.UpgradeStatus != nil && response.UpgradeStatus.BlockedBy != "" {
+		reason = reason + ": " + response.UpgradeStatus.BlockedBy
+	}
+	if irreversibleVersion := getIrreversibleCRDChangeVersion(entry.FromVersion, entry.ToVersion); irreversibleVersion != "" {
+		glog.Warningf("Can't roll back OpenEBS %s %s from version %s to version %s: "+
+			"CRD changes of version %s can't be undone",
+			p.ObservedOpenEBS.Namespace, p.ObservedOpenEBS.Name,
+			entry.ToVersion, entry.FromVersion, irreversibleVersion)
+		entry.Phase = types.UpgradeHistoryPhaseRollbackRefused
+		entry.Reason = fmt.Sprintf("%s, rollback refused since CRD changes of version %s can't be undone",
+			reason, irreversibleVersion)
+		p.addEvent(corev1.EventTypeWarning, types.EventReasonRollbackRefused,
+			fmt.Sprintf("Upgrade to version %s failed: %s", entry.ToVersion, entry.Reason))
+		return
+	}
+	glog.Warningf("Rolling back OpenEBS %s %s from version %s to version %s: %s",
+		p.ObservedOpenEBS.Namespace, p.ObservedOpenEBS.Name,
+		entry.ToVersion, entry.FromVersion, reason)
+	entry.Phase = types.UpgradeHistoryPhaseRolledBack
+	entry.Reason = reason
+	entry.CompletionTime = time.Now().UTC().Format(time.RFC3339)
+	p.addEvent(corev1.EventTypeWarning, types.EventReasonUpgradeRolledBack,
+		fmt.Sprintf("Rolling back OpenEBS from version %s to version %s: %s",
+			entry.ToVersion, entry.FromVersion, reason))
+}
+
+// snapshotLastKnownGood snapshots the spec as given by the user as the last
+// known good spec.
+func (p *Planner) snapshotLastKnownGood() {
+	p.LastKnownGood = &types.LastKnownGood{
+		Version:      p.GivenSpec.Version,
+		Spec:         p.GivenSpec,
+		SnapshotTime: time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
+// getLatestUpgradeHistoryEntry returns the most recent upgrade, nil is
+// returned if OpenEBS has never been upgraded.
+func (p *Planner) getLatestUpgradeHistoryEntry() *types.UpgradeHistoryEntry {
+	if len(p.UpgradeHistory) == 0 {
+		return nil
+	}
+	return &p.UpgradeHistory[len(p.UpgradeHistory)-1]
+}
+
+// isOpenEBSReady returns true if all the desired workloads are updated and
+// ready i.e., OpenEBS is neither being upgraded nor any of its components
+// are failing.
+func (p *Planner) isOpenEBSReady(response *ReconcileResponse) bool {
+	if isUpgradeBlocked(response) || p.isUpgradeInProgress() {
+		return false
+	}
+	observedComponents := make(map[string]*unstructured.Unstructured)
+	for _, component := range p.ObservedOpenEBSComponents {
+		observedComponents[getComponentKey(component)] = component
+	}
+	for _, desired := range response.DesiredOpenEBSComponents {
+		if !isWorkload(desired.GetKind()) || isPreInstallationComponent(desired) {
+			continue
+		}
+		isRolledOut, _ := isComponentRolledOut(desired, observedComponents[getComponentKey(desired)])
+		if !isRolledOut {
+			return false
+		}
+	}
+	return true
+}
+
+// getIrreversibleCRDChangeVersion returns the version whose CRD changes can't
+// be undone if OpenEBS is upgraded across it from the given version to the
+// given version, empty string is returned otherwise.
+func getIrreversibleCRDChangeVersion(fromVersion, toVersion string) string {
+	for _, version := range irreversibleCRDChangeVersions {
+		fromRes, err := compareVersion(fromVersion, version)
+		if err != nil {
+			continue
+		}
+		toRes, err := compareVersion(toVersion, version)
+		if err != nil {
+			continue
+		}
+		if fromRes < 0 && toRes >= 0 {
+			return version
+		}
+	}
+	return ""
+}
+
+// copyOpenEBSSpec returns a deep copy of the given spec.
+func copyOpenEBSSpec(spec *types.OpenEBSSpec) (*types.OpenEBSSpec, error) {
+	specRaw, err := json.Marshal(spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't marshal OpenEBS spec")
+	}
+	var specCopy types.OpenEBSSpec
+	err = json.Unmarshal(specRaw, &specCopy)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't unmarshal OpenEBS spec")
+	}
+	return &specCopy, nil
+}
+
+// isSameOpenEBSSpec returns true if both the specs are the same.
+func isSameOpenEBSSpec(spec1, spec2 *types.OpenEBSSpec) bool {
+	spec1Raw, err := json.Marshal(spec1)
+	if err != nil {
+		return false
+	}
+	spec2Raw, err := json.Marshal(spec2)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(spec1Raw, spec2Raw)
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"mayadata.io/openebs-upgrade/types"
+)
+
+func TestSetRollbackDefaultsIfNotSet(t *testing.T) {
+	p := &Planner{ObservedOpenEBS: &types.OpenEBS{}}
+	err := p.setRollbackDefaultsIfNotSet()
+	if err != nil {
+		t.Fatalf("Expected no error got %v", err)
+	}
+	if *p.ObservedOpenEBS.Spec.Rollback.Enabled {
+		t.Fatalf("Expected rollback to be disabled by default")
+	}
+	if *p.ObservedOpenEBS.Spec.Rollback.TimeoutSeconds != DefaultRollbackTimeoutSeconds {
+		t.Fatalf("Expected timeout %d got %d",
+			DefaultRollbackTimeoutSeconds, *p.ObservedOpenEBS.Spec.Rollback.TimeoutSeconds)
+	}
+}
+
+func TestRecordUpgrade(t *testing.T) {
+	longAgo := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	recently := time.Now().UTC().Format(time.RFC3339)
+	blocked := &types.UpgradeStatus{
+		Stage:     "Operators",
+		BlockedBy: "Deployment maya-apiserver has 0/1 replicas ready",
+	}
+	progress := "Operators: Deployment maya-apiserver has 0/1 replicas ready"
+	var tests = map[string]struct {
+		fromVersion      string
+		toVersion        string
+		isDisabled       bool
+		entry            *types.UpgradeHistoryEntry
+		upgradeStatus    *types.UpgradeStatus
+		expectPhase      types.UpgradeHistoryPhase
+		expectReason     string
+		expectProgressed bool
+	}{
+		"upgrade is recorded once started": {
+			fromVersion:   "2.5.0",
+			toVersion:     "2.6.0",
+			upgradeStatus: blocked,
+			expectPhase:   types.UpgradeHistoryPhaseUpgrading,
+		},
+		"upgrade without progress is rolled back after the timeout": {
+			fromVersion: "2.5.0",
+			toVersion:   "2.6.0",
+			entry: &types.UpgradeHistoryEntry{
+				Progress:         progress,
+				StartTime:        longAgo,
+				LastProgressTime: longAgo,
+			},
+			upgradeStatus: blocked,
+			expectPhase:   types.UpgradeHistoryPhaseRolledBack,
+			expectReason:  "No progress within 10m0s: " + blocked.BlockedBy,
+		},
+		"upgrade without progress is not rolled back within the timeout": {
+			fromVersion: "2.5.0",
+			toVersion:   "2.6.0",
+			entry: &types.UpgradeHistoryEntry{
+				Progress:         progress,
+				StartTime:        longAgo,
+				LastProgressTime: recently,
+			},
+			upgradeStatus: blocked,
+			expectPhase:   types.UpgradeHistoryPhaseUpgrading,
+		},
+		"upgrade which makes progress is not rolled back": {
+			fromVersion: "2.5.0",
+			toVersion:   "2.6.0",
+			entry: &types.UpgradeHistoryEntry{
+				Progress:         "CRDs: CustomResourceDefinition cstorvolumes.cstor.openebs.io is not yet created",
+				StartTime:        longAgo,
+				LastProgressTime: longAgo,
+			},
+			upgradeStatus:    blocked,
+			expectPhase:      types.UpgradeHistoryPhaseUpgrading,
+			expectProgressed: true,
+		},
+		"upgrade is not rolled back if rollback is disabled": {
+			fromVersion: "2.5.0",
+			toVersion:   "2.6.0",
+			isDisabled:  true,
+			entry: &types.UpgradeHistoryEntry{
+				Progress:         progress,
+				StartTime:        longAgo,
+				LastProgressTime: longAgo,
+			},
+			upgradeStatus: blocked,
+			expectPhase:   types.UpgradeHistoryPhaseUpgrading,
+		},
+		"upgrade across irreversible CRD changes is not rolled back": {
+			fromVersion: "1.12.0",
+			toVersion:   "2.6.0",
+			entry: &types.UpgradeHistoryEntry{
+				Progress:         progress,
+				StartTime:        longAgo,
+				LastProgressTime: longAgo,
+			},
+			upgradeStatus: blocked,
+			expectPhase:   types.UpgradeHistoryPhaseRollbackRefused,
+			expectReason:  "rollback refused since CRD changes of version 2.0.0 can't be undone",
+		},
+		"upgrade which is complete succeeds": {
+			fromVersion: "2.5.0",
+			toVersion:   "2.6.0",
+			entry: &types.UpgradeHistoryEntry{
+				Progress:         progress,
+				StartTime:        longAgo,
+				LastProgressTime: longAgo,
+			},
+			expectPhase: types.UpgradeHistoryPhaseSucceeded,
+		},
+		"upgrade waiting for the pods of OnDelete daemonsets to be deleted succeeds": {
+			fromVersion: "2.5.0",
+			toVersion:   "2.6.0",
+			entry: &types.UpgradeHistoryEntry{
+				Progress:         progress,
+				StartTime:        longAgo,
+				LastProgressTime: longAgo,
+			},
+			upgradeStatus: &types.UpgradeStatus{
+				Stage:    "NodeComponents",
+				Progress: "5/5 stages completed, waiting for pods to be deleted",
+				PendingPodDeletions: []string{
+					"DaemonSet openebs/openebs-ndm is waiting for 2/3 pods to be deleted",
+				},
+			},
+			expectPhase: types.UpgradeHistoryPhaseSucceeded,
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			enabled := !mock.isDisabled
+			timeoutSeconds := DefaultRollbackTimeoutSeconds
+			spec := types.OpenEBSSpec{
+				Version:  mock.toVersion,
+				Rollback: &types.Rollback{Enabled: &enabled, TimeoutSeconds: &timeoutSeconds},
+			}
+			p := &Planner{
+				ObservedOpenEBS: &types.OpenEBS{Spec: spec},
+				GivenSpec:       &spec,
+				LastKnownGood:   &types.LastKnownGood{Version: mock.fromVersion},
+			}
+			if mock.entry != nil {
+				entry := *mock.entry
+				entry.FromVersion = mock.fromVersion
+				entry.ToVersion = mock.toVersion
+				entry.Phase = types.UpgradeHistoryPhaseUpgrading
+				p.UpgradeHistory = []types.UpgradeHistoryEntry{entry}
+			}
+			response := &ReconcileResponse{UpgradeStatus: mock.upgradeStatus}
+			p.recordUpgrade(response)
+			if len(response.UpgradeHistory) != 1 {
+				t.Fatalf("Expected 1 upgrade history entry got %d", len(response.UpgradeHistory))
+			}
+			entry := response.UpgradeHistory[0]
+			if entry.Phase != mock.expectPhase {
+				t.Fatalf("Expected phase %s got %s: %s", mock.expectPhase, entry.Phase, entry.Reason)
+			}
+			if !strings.Contains(entry.Reason, mock.expectReason) {
+				t.Fatalf("Expected reason %q got %q", mock.expectReason, entry.Reason)
+			}
+			if mock.expectProgressed && (entry.Progress != progress || entry.LastProgressTime == longAgo) {
+				t.Fatalf("Expected progress %q to be recorded got %q at %s",
+					progress, entry.Progress, entry.LastProgressTime)
+			}
+			if mock.expectPhase == types.UpgradeHistoryPhaseSucceeded &&
+				response.LastKnownGood.Version != mock.toVersion {
+				t.Fatalf("Expected last known good version %s got %s",
+					mock.toVersion, response.LastKnownGood.Version)
+			}
+		})
+	}
+}
+
+func TestGetIrreversibleCRDChangeVersion(t *testing.T) {
+	var tests = map[string]struct {
+		fromVersion   string
+		toVersion     string
+		expectVersion string
+	}{
+		"upgrade across 2.0.0": {
+			fromVersion:   "1.12.0",
+			toVersion:     "2.6.0",
+			expectVersion: types.OpenEBSVersion200,
+		},
+		"upgrade to 2.0.0": {
+			fromVersion:   "1.12.0",
+			toVersion:     "2.0.0",
+			expectVersion: types.OpenEBSVersion200,
+		},
+		"upgrade after 2.0.0": {
+			fromVersion: "2.0.0",
+			toVersion:   "2.6.0",
+		},
+		"upgrade before 2.0.0": {
+			fromVersion: "1.10.0",
+			toVersion:   "1.12.0",
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			version := getIrreversibleCRDChangeVersion(mock.fromVersion, mock.toVersion)
+			if version != mock.expectVersion {
+				t.Fatalf("Expected version %q got %q", mock.expectVersion, version)
+			}
+		})
+	}
+}
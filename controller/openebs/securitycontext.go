@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+	"mayadata.io/openebs-upgrade/unstruct"
+)
+
+// getContainerSecurityContext returns the security context of the given
+// container if set otherwise the containerSecurityContext of the component.
+func getContainerSecurityContext(container types.Container,
+	componentSecurityContext map[string]interface{}) map[string]interface{} {
+	if container.SecurityContext != nil {
+		return container.SecurityContext
+	}
+	return componentSecurityContext
+}
+
+// setDesiredContainerSecurityContext merges the given security context with
+// the security context of the given container of the given workload.
+//
+// The containers which are privileged as per the templates such as the ones
+// of NDM, CSI node, mayastor and ISCSI setup stay privileged, the settings
+// which prevent these from working are ignored with a warning.
+func (p *Planner) setDesiredContainerSecurityContext(workload, container *unstructured.Unstructured,
+	securityContext map[string]interface{}) error {
+	if len(securityContext) == 0 {
+		return nil
+	}
+	desiredSecurityContext, _, err := unstructured.NestedMap(container.Object, "spec", "securityContext")
+	if err != nil {
+		return err
+	}
+	isPrivileged := isPrivilegedSecurityContext(desiredSecurityContext)
+	if desiredSecurityContext == nil {
+		desiredSecurityContext = make(map[string]interface{})
+	}
+	for key, value := range securityContext {
+		desiredSecurityContext[key] = value
+	}
+	if isPrivileged {
+		containerName, _, _ := unstructured.NestedString(container.Object, "spec", "name")
+		ignored := removePrivilegeConflicts(desiredSecurityContext)
+		if privileged, _ := desiredSecurityContext["privileged"].(bool); !privileged {
+			desiredSecurityContext["privileged"] = true
+			ignored = append(ignored, "privileged")
+		}
+		p.warnSecurityContextIgnored(workload,
+			fmt.Sprintf("container %s", containerName), ignored)
+	}
+	return unstructured.SetNestedField(container.Object, desiredSecurityContext, "spec", "securityContext")
+}
+
+// setDesiredPodSecurityContext sets the given security context as the
+// security context of the pods of the given workload.
+//
+// NOTE: This must be invoked once the security contexts of the containers
+// are set so that the settings which prevent the privileged containers from
+// working can be ignored.
+func (p *Planner) setDesiredPodSecurityContext(workload *unstructured.Unstructured,
+	podSecurityContext map[string]interface{}) error {
+	if len(podSecurityContext) == 0 {
+		return nil
+	}
+	desiredPodSecurityContext := make(map[string]interface{}, len(podSecurityContext))
+	for key, value := range podSecurityContext {
+		desiredPodSecurityContext[key] = value
+	}
+	containers, err := unstruct.GetNestedSliceOrError(workload, "spec", "template", "spec", "containers")
+	if err != nil {
+		return err
+	}
+	isPrivileged := false
+	checkPrivileged := func(obj *unstructured.Unstructured) error {
+		securityContext, _, err := unstructured.NestedMap(obj.Object, "spec", "securityContext")
+		if err != nil {
+			return err
+		}
+		if isPrivilegedSecurityContext(securityContext) {
+			isPrivileged = true
+		}
+		return nil
+	}
+	err = unstruct.SliceIterator(containers).ForEach(checkPrivileged)
+	if err != nil {
+		return err
+	}
+	if isPrivileged {
+		p.warnSecurityContextIgnored(workload, "pods",
+			removePrivilegeConflicts(desiredPodSecurityContext))
+	}
+	return unstructured.SetNestedField(workload.Object, desiredPodSecurityContext,
+		"spec", "template", "spec", "securityContext")
+}
+
+// isPrivilegedSecurityContext returns true if the given security context
+// makes the container privileged.
+func isPrivilegedSecurityContext(securityContext map[string]interface{}) bool {
+	privileged, _ := securityContext["privileged"].(bool)
+	return privileged
+}
+
+// removePrivilegeConflicts removes the settings of the given security
+// context which prevent a privileged container from working i.e., running
+// as a non root user or disallowing privilege escalation, and returns the
+// removed settings.
+func removePrivilegeConflicts(securityContext map[string]interface{}) []string {
+	var removed []string
+	if runAsNonRoot, _ := securityContext["runAsNonRoot"].(bool); runAsNonRoot {
+		delete(securityContext, "runAsNonRoot")
+		removed = append(removed, "runAsNonRoot")
+	}
+	if runAsUser, exist := securityContext["runAsUser"]; exist && fmt.Sprint(runAsUser) != "0" {
+		delete(securityContext, "runAsUser")
+		removed = append(removed, "runAsUser")
+	}
+	if allowPrivilegeEscalation, exist := securityContext["allowPrivilegeEscalation"].(bool); exist &&
+		!allowPrivilegeEscalation {
+		delete(securityContext, "allowPrivilegeEscalation")
+		removed = append(removed, "allowPrivilegeEscalation")
+	}
+	return removed
+}
+
+// warnSecurityContextIgnored logs and records a warning if any settings of
+// the security context of the given workload have been ignored.
+func (p *Planner) warnSecurityContextIgnored(workload *unstructured.Unstructured, target string,
+	ignored []string) {
+	if len(ignored) == 0 {
+		return
+	}
+	message := fmt.Sprintf("Ignoring %s of the security context of %s of %s %s since it requires privileges",
+		strings.Join(ignored, ", "), target, workload.GetKind(), workload.GetName())
+	glog.Warningf("%s, OpenEBS %s %s", message, p.ObservedOpenEBS.Namespace, p.ObservedOpenEBS.Name)
+	p.addEvent(corev1.EventTypeWarning, types.EventReasonSecurityContextIgnored, message)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+func TestRemovePrivilegeConflicts(t *testing.T) {
+	var tests = map[string]struct {
+		securityContext       map[string]interface{}
+		expectSecurityContext map[string]interface{}
+		expectRemoved         []string
+	}{
+		"settings which prevent privileges are removed": {
+			securityContext: map[string]interface{}{
+				"runAsNonRoot":             true,
+				"runAsUser":                int64(1000),
+				"allowPrivilegeEscalation": false,
+				"readOnlyRootFilesystem":   true,
+			},
+			expectSecurityContext: map[string]interface{}{
+				"readOnlyRootFilesystem": true,
+			},
+			expectRemoved: []string{"runAsNonRoot", "runAsUser", "allowPrivilegeEscalation"},
+		},
+		"settings which allow privileges are kept": {
+			securityContext: map[string]interface{}{
+				"runAsNonRoot":             false,
+				"runAsUser":                int64(0),
+				"allowPrivilegeEscalation": true,
+			},
+			expectSecurityContext: map[string]interface{}{
+				"runAsNonRoot":             false,
+				"runAsUser":                int64(0),
+				"allowPrivilegeEscalation": true,
+			},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			removed := removePrivilegeConflicts(mock.securityContext)
+			if !reflect.DeepEqual(removed, mock.expectRemoved) {
+				t.Fatalf("Expected removed settings %v got %v", mock.expectRemoved, removed)
+			}
+			if !reflect.DeepEqual(mock.securityContext, mock.expectSecurityContext) {
+				t.Fatalf("Expected security context %v got %v", mock.expectSecurityContext, mock.securityContext)
+			}
+		})
+	}
+}
+
+func TestSetDesiredContainerSecurityContext(t *testing.T) {
+	var tests = map[string]struct {
+		templateSecurityContext map[string]interface{}
+		securityContext         map[string]interface{}
+		expectSecurityContext   map[string]interface{}
+		expectEvents            []reconcileEvent
+	}{
+		"security context is merged with the one of the template": {
+			templateSecurityContext: map[string]interface{}{
+				"readOnlyRootFilesystem": false,
+			},
+			securityContext: map[string]interface{}{
+				"runAsNonRoot": true,
+				"runAsUser":    int64(1000),
+			},
+			expectSecurityContext: map[string]interface{}{
+				"readOnlyRootFilesystem": false,
+				"runAsNonRoot":           true,
+				"runAsUser":              int64(1000),
+			},
+		},
+		"privileged container stays privileged with a warning": {
+			templateSecurityContext: map[string]interface{}{
+				"privileged": true,
+			},
+			securityContext: map[string]interface{}{
+				"privileged":   false,
+				"runAsNonRoot": true,
+				"capabilities": map[string]interface{}{"drop": []interface{}{"NET_RAW"}},
+			},
+			expectSecurityContext: map[string]interface{}{
+				"privileged":   true,
+				"capabilities": map[string]interface{}{"drop": []interface{}{"NET_RAW"}},
+			},
+			expectEvents: []reconcileEvent{
+				{
+					eventType: corev1.EventTypeWarning,
+					reason:    types.EventReasonSecurityContextIgnored,
+					message: "Ignoring runAsNonRoot, privileged of the security context of " +
+						"container node-disk-manager of DaemonSet openebs-ndm since it requires privileges",
+				},
+			},
+		},
+		"privileged container without conflicting settings has no warning": {
+			templateSecurityContext: map[string]interface{}{
+				"privileged": true,
+			},
+			securityContext: map[string]interface{}{
+				"readOnlyRootFilesystem": true,
+			},
+			expectSecurityContext: map[string]interface{}{
+				"privileged":             true,
+				"readOnlyRootFilesystem": true,
+			},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			p := &Planner{ObservedOpenEBS: &types.OpenEBS{}}
+			workload := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"metadata": map[string]interface{}{
+						"name": "openebs-ndm",
+					},
+				},
+			}
+			container := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"name":            "node-disk-manager",
+						"securityContext": mock.templateSecurityContext,
+					},
+				},
+			}
+			err := p.setDesiredContainerSecurityContext(workload, container, mock.securityContext)
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			securityContext, _, _ := unstructured.NestedMap(container.Object, "spec", "securityContext")
+			if !reflect.DeepEqual(securityContext, mock.expectSecurityContext) {
+				t.Fatalf("Expected security context %v got %v", mock.expectSecurityContext, securityContext)
+			}
+			if !reflect.DeepEqual(p.Events, mock.expectEvents) {
+				t.Fatalf("Expected events %+v got %+v", mock.expectEvents, p.Events)
+			}
+		})
+	}
+}
@@ -45,7 +45,8 @@ func (p *Planner) setSnapshotOperatorDefaultsIfNotSet() error {
 		p.ObservedOpenEBS.Spec.SnapshotOperator.Provisioner.ImageTag = p.ObservedOpenEBS.Spec.Version +
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
-	p.ObservedOpenEBS.Spec.SnapshotOperator.Provisioner.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.SnapshotOperator.Provisioner.Image = getImagePrefix(p.ObservedOpenEBS.Spec.SnapshotOperator.Provisioner,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"snapshot-provisioner:" + p.ObservedOpenEBS.Spec.SnapshotOperator.Provisioner.ImageTag
 
 	// form the snapshot-controller image
@@ -53,7 +54,8 @@ func (p *Planner) setSnapshotOperatorDefaultsIfNotSet() error {
 		p.ObservedOpenEBS.Spec.SnapshotOperator.Controller.ImageTag = p.ObservedOpenEBS.Spec.Version +
 			p.ObservedOpenEBS.Spec.ImageTagSuffix
 	}
-	p.ObservedOpenEBS.Spec.SnapshotOperator.Controller.Image = p.ObservedOpenEBS.Spec.ImagePrefix +
+	p.ObservedOpenEBS.Spec.SnapshotOperator.Controller.Image = getImagePrefix(p.ObservedOpenEBS.Spec.SnapshotOperator.Controller,
+		p.ObservedOpenEBS.Spec.ImagePrefix) +
 		"snapshot-controller:" + p.ObservedOpenEBS.Spec.SnapshotOperator.Controller.ImageTag
 
 	if p.ObservedOpenEBS.Spec.SnapshotOperator.Replicas == nil {
@@ -0,0 +1,32 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+// componentStage is a set of kinds whose components are handled together
+// such as while upgrading or uninstalling OpenEBS in stages.
+type componentStage struct {
+	name  string
+	kinds []string
+}
+
+// hasKind returns true if the components of the given kind are part of
+// this stage.
+func (s componentStage) hasKind(kind string) bool {
+	for _, stageKind := range s.kinds {
+		if stageKind == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+// setDesiredDaemonSetUpdateStrategy sets the given update strategy of the
+// component as the update strategy of the given daemonset, the fields which
+// are not set are retained as per the template.
+//
+// NOTE: The type is set to RollingUpdate if maxUnavailable is given without
+// the type since the template could have some other type.
+func setDesiredDaemonSetUpdateStrategy(daemon *unstructured.Unstructured,
+	updateStrategy *types.UpdateStrategy) error {
+	if updateStrategy == nil {
+		return nil
+	}
+	strategyType := updateStrategy.Type
+	if strategyType == "" && updateStrategy.MaxUnavailable != nil {
+		strategyType = string(appsv1.RollingUpdateDaemonSetStrategyType)
+	}
+	switch strategyType {
+	case "":
+	case string(appsv1.RollingUpdateDaemonSetStrategyType):
+		if updateStrategy.MaxUnavailable != nil {
+			err := unstructured.SetNestedField(daemon.Object,
+				getIntOrStringValue(*updateStrategy.MaxUnavailable),
+				"spec", "updateStrategy", "rollingUpdate", "maxUnavailable")
+			if err != nil {
+				return err
+			}
+		}
+	case string(appsv1.OnDeleteDaemonSetStrategyType):
+		if updateStrategy.MaxUnavailable != nil {
+			return errors.Errorf(
+				"Invalid updateStrategy of daemonset %s: maxUnavailable can't be set with type %s",
+				daemon.GetName(), strategyType,
+			)
+		}
+		unstructured.RemoveNestedField(daemon.Object, "spec", "updateStrategy", "rollingUpdate")
+	default:
+		return errors.Errorf(
+			"Invalid updateStrategy of daemonset %s: unsupported type %s, supported types are %s and %s",
+			daemon.GetName(), strategyType,
+			appsv1.RollingUpdateDaemonSetStrategyType, appsv1.OnDeleteDaemonSetStrategyType,
+		)
+	}
+	if strategyType != "" {
+		err := unstructured.SetNestedField(daemon.Object, strategyType, "spec", "updateStrategy", "type")
+		if err != nil {
+			return err
+		}
+	}
+	return setDesiredMinReadySeconds(daemon, updateStrategy.MinReadySeconds)
+}
+
+// setDesiredDeploymentStrategy sets the given strategy of the component as
+// the strategy of the given deployment, the fields which are not set are
+// retained as per the template.
+//
+// NOTE: The type is set to RollingUpdate if maxUnavailable or maxSurge is
+// given without the type since some of the templates use Recreate.
+func setDesiredDeploymentStrategy(deploy *unstructured.Unstructured,
+	strategy *types.DeploymentStrategy) error {
+	if strategy == nil {
+		return nil
+	}
+	strategyType := strategy.Type
+	if strategyType == "" && (strategy.MaxUnavailable != nil || strategy.MaxSurge != nil) {
+		strategyType = string(appsv1.RollingUpdateDeploymentStrategyType)
+	}
+	switch strategyType {
+	case "":
+	case string(appsv1.RollingUpdateDeploymentStrategyType):
+		if strategy.MaxUnavailable != nil {
+			err := unstructured.SetNestedField(deploy.Object,
+				getIntOrStringValue(*strategy.MaxUnavailable),
+				"spec", "strategy", "rollingUpdate", "maxUnavailable")
+			if err != nil {
+				return err
+			}
+		}
+		if strategy.MaxSurge != nil {
+			err := unstructured.SetNestedField(deploy.Object,
+				getIntOrStringValue(*strategy.MaxSurge),
+				"spec", "strategy", "rollingUpdate", "maxSurge")
+			if err != nil {
+				return err
+			}
+		}
+	case string(appsv1.RecreateDeploymentStrategyType):
+		if strategy.MaxUnavailable != nil || strategy.MaxSurge != nil {
+			return errors.Errorf(
+				"Invalid strategy of deployment %s: maxUnavailable and maxSurge can't be set with type %s",
+				deploy.GetName(), strategyType,
+			)
+		}
+		unstructured.RemoveNestedField(deploy.Object, "spec", "strategy", "rollingUpdate")
+	default:
+		return errors.Errorf(
+			"Invalid strategy of deployment %s: unsupported type %s, supported types are %s and %s",
+			deploy.GetName(), strategyType,
+			appsv1.RollingUpdateDeploymentStrategyType, appsv1.RecreateDeploymentStrategyType,
+		)
+	}
+	if strategyType != "" {
+		err := unstructured.SetNestedField(deploy.Object, strategyType, "spec", "strategy", "type")
+		if err != nil {
+			return err
+		}
+	}
+	return setDesiredMinReadySeconds(deploy, strategy.MinReadySeconds)
+}
+
+// setDesiredMinReadySeconds sets the minReadySeconds of the given workload
+// if given.
+func setDesiredMinReadySeconds(workload *unstructured.Unstructured, minReadySeconds *int32) error {
+	if minReadySeconds == nil {
+		return nil
+	}
+	if *minReadySeconds < 0 {
+		return errors.Errorf("Invalid minReadySeconds of %s %s: %d can't be negative",
+			workload.GetKind(), workload.GetName(), *minReadySeconds)
+	}
+	return unstructured.SetNestedField(workload.Object, int64(*minReadySeconds),
+		"spec", "minReadySeconds")
+}
+
+// getRolloutStrategy returns the strategy with which the pods of the given
+// workload are restarted for example, RollingUpdate maxUnavailable=1
+// minReadySeconds=30. An empty string is returned if the workload does not
+// set any strategy.
+func getRolloutStrategy(workload *unstructured.Unstructured) string {
+	var strategyPath string
+	switch workload.GetKind() {
+	case types.KindDeployment:
+		strategyPath = "strategy"
+	case types.KindDaemonSet, types.KindStatefulset:
+		strategyPath = "updateStrategy"
+	default:
+		return ""
+	}
+	var details []string
+	strategyType, _, _ := unstructured.NestedString(workload.Object, "spec", strategyPath, "type")
+	if strategyType != "" {
+		details = append(details, strategyType)
+	}
+	rollingUpdate, _, _ := unstructured.NestedMap(workload.Object, "spec", strategyPath, "rollingUpdate")
+	for _, key := range []string{"maxUnavailable", "maxSurge", "partition"} {
+		if value, exist := rollingUpdate[key]; exist {
+			details = append(details, fmt.Sprintf("%s=%v", key, value))
+		}
+	}
+	if minReadySeconds, exist, _ := unstructured.NestedFieldNoCopy(workload.Object,
+		"spec", "minReadySeconds"); exist {
+		details = append(details, fmt.Sprintf("minReadySeconds=%v", minReadySeconds))
+	}
+	return strings.Join(details, " ")
+}
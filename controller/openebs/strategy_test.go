@@ -0,0 +1,464 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+func TestSetDesiredDaemonSetUpdateStrategy(t *testing.T) {
+	maxUnavailable := intstr.FromInt(2)
+	maxUnavailablePercent := intstr.FromString("25%")
+	minReadySeconds := int32(30)
+	negativeMinReadySeconds := int32(-1)
+	var tests = map[string]struct {
+		daemon         *unstructured.Unstructured
+		updateStrategy *types.UpdateStrategy
+		expectSpec     map[string]interface{}
+		isErr          bool
+	}{
+		"template is retained if update strategy is not set": {
+			daemon: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{
+							"type": "RollingUpdate",
+							"rollingUpdate": map[string]interface{}{
+								"maxUnavailable": int64(1),
+							},
+						},
+					},
+				},
+			},
+			expectSpec: map[string]interface{}{
+				"updateStrategy": map[string]interface{}{
+					"type": "RollingUpdate",
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": int64(1),
+					},
+				},
+			},
+		},
+		"maxUnavailable without type sets RollingUpdate": {
+			daemon: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{"type": "OnDelete"},
+					},
+				},
+			},
+			updateStrategy: &types.UpdateStrategy{MaxUnavailable: &maxUnavailable},
+			expectSpec: map[string]interface{}{
+				"updateStrategy": map[string]interface{}{
+					"type": "RollingUpdate",
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": int64(2),
+					},
+				},
+			},
+		},
+		"percentage of maxUnavailable is set": {
+			daemon: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{
+							"type": "RollingUpdate",
+							"rollingUpdate": map[string]interface{}{
+								"maxUnavailable": int64(1),
+							},
+						},
+					},
+				},
+			},
+			updateStrategy: &types.UpdateStrategy{MaxUnavailable: &maxUnavailablePercent},
+			expectSpec: map[string]interface{}{
+				"updateStrategy": map[string]interface{}{
+					"type": "RollingUpdate",
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": "25%",
+					},
+				},
+			},
+		},
+		"OnDelete removes the rolling update of the template": {
+			daemon: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{
+							"type": "RollingUpdate",
+							"rollingUpdate": map[string]interface{}{
+								"maxUnavailable": int64(1),
+							},
+						},
+					},
+				},
+			},
+			updateStrategy: &types.UpdateStrategy{Type: "OnDelete"},
+			expectSpec: map[string]interface{}{
+				"updateStrategy": map[string]interface{}{"type": "OnDelete"},
+			},
+		},
+		"only minReadySeconds is set": {
+			daemon: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{
+							"type": "RollingUpdate",
+							"rollingUpdate": map[string]interface{}{
+								"maxUnavailable": int64(1),
+							},
+						},
+					},
+				},
+			},
+			updateStrategy: &types.UpdateStrategy{MinReadySeconds: &minReadySeconds},
+			expectSpec: map[string]interface{}{
+				"updateStrategy": map[string]interface{}{
+					"type": "RollingUpdate",
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": int64(1),
+					},
+				},
+				"minReadySeconds": int64(30),
+			},
+		},
+		"OnDelete with maxUnavailable is invalid": {
+			daemon: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{
+							"type": "RollingUpdate",
+							"rollingUpdate": map[string]interface{}{
+								"maxUnavailable": int64(1),
+							},
+						},
+					},
+				},
+			},
+			updateStrategy: &types.UpdateStrategy{
+				Type:           "OnDelete",
+				MaxUnavailable: &maxUnavailable,
+			},
+			isErr: true,
+		},
+		"unsupported type is invalid": {
+			daemon: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{
+							"type": "RollingUpdate",
+							"rollingUpdate": map[string]interface{}{
+								"maxUnavailable": int64(1),
+							},
+						},
+					},
+				},
+			},
+			updateStrategy: &types.UpdateStrategy{Type: "Recreate"},
+			isErr:          true,
+		},
+		"negative minReadySeconds is invalid": {
+			daemon: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{
+							"type": "RollingUpdate",
+							"rollingUpdate": map[string]interface{}{
+								"maxUnavailable": int64(1),
+							},
+						},
+					},
+				},
+			},
+			updateStrategy: &types.UpdateStrategy{MinReadySeconds: &negativeMinReadySeconds},
+			isErr:          true,
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			err := setDesiredDaemonSetUpdateStrategy(mock.daemon, mock.updateStrategy)
+			if mock.isErr {
+				if err == nil {
+					t.Fatalf("Expected error got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			if !reflect.DeepEqual(mock.daemon.Object["spec"], mock.expectSpec) {
+				t.Fatalf("Expected spec %v got %v", mock.expectSpec, mock.daemon.Object["spec"])
+			}
+		})
+	}
+}
+
+func TestSetDesiredDeploymentStrategy(t *testing.T) {
+	maxUnavailable := intstr.FromInt(0)
+	maxSurge := intstr.FromString("50%")
+	minReadySeconds := int32(10)
+	negativeMinReadySeconds := int32(-10)
+	var tests = map[string]struct {
+		deploy     *unstructured.Unstructured
+		strategy   *types.DeploymentStrategy
+		expectSpec map[string]interface{}
+		isErr      bool
+	}{
+		"template is retained if strategy is not set": {
+			deploy: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"spec": map[string]interface{}{
+						"strategy": map[string]interface{}{"type": "Recreate"},
+					},
+				},
+			},
+			expectSpec: map[string]interface{}{
+				"strategy": map[string]interface{}{"type": "Recreate"},
+			},
+		},
+		"maxUnavailable and maxSurge without type set RollingUpdate": {
+			deploy: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"spec": map[string]interface{}{
+						"strategy": map[string]interface{}{"type": "Recreate"},
+					},
+				},
+			},
+			strategy: &types.DeploymentStrategy{
+				MaxUnavailable: &maxUnavailable,
+				MaxSurge:       &maxSurge,
+			},
+			expectSpec: map[string]interface{}{
+				"strategy": map[string]interface{}{
+					"type": "RollingUpdate",
+					"rollingUpdate": map[string]interface{}{
+						"maxUnavailable": int64(0),
+						"maxSurge":       "50%",
+					},
+				},
+			},
+		},
+		"only maxSurge without type sets RollingUpdate": {
+			deploy: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"spec": map[string]interface{}{},
+				},
+			},
+			strategy: &types.DeploymentStrategy{MaxSurge: &maxSurge},
+			expectSpec: map[string]interface{}{
+				"strategy": map[string]interface{}{
+					"type": "RollingUpdate",
+					"rollingUpdate": map[string]interface{}{
+						"maxSurge": "50%",
+					},
+				},
+			},
+		},
+		"Recreate removes the rolling update of the template": {
+			deploy: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"spec": map[string]interface{}{
+						"strategy": map[string]interface{}{
+							"type": "RollingUpdate",
+							"rollingUpdate": map[string]interface{}{
+								"maxSurge": int64(1),
+							},
+						},
+					},
+				},
+			},
+			strategy: &types.DeploymentStrategy{
+				Type:            "Recreate",
+				MinReadySeconds: &minReadySeconds,
+			},
+			expectSpec: map[string]interface{}{
+				"strategy":        map[string]interface{}{"type": "Recreate"},
+				"minReadySeconds": int64(10),
+			},
+		},
+		"Recreate with maxUnavailable is invalid": {
+			deploy: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"spec": map[string]interface{}{
+						"strategy": map[string]interface{}{"type": "Recreate"},
+					},
+				},
+			},
+			strategy: &types.DeploymentStrategy{
+				Type:           "Recreate",
+				MaxUnavailable: &maxUnavailable,
+			},
+			isErr: true,
+		},
+		"Recreate with maxSurge is invalid": {
+			deploy: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"spec": map[string]interface{}{
+						"strategy": map[string]interface{}{"type": "Recreate"},
+					},
+				},
+			},
+			strategy: &types.DeploymentStrategy{
+				Type:     "Recreate",
+				MaxSurge: &maxSurge,
+			},
+			isErr: true,
+		},
+		"unsupported type is invalid": {
+			deploy: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"spec": map[string]interface{}{
+						"strategy": map[string]interface{}{"type": "Recreate"},
+					},
+				},
+			},
+			strategy: &types.DeploymentStrategy{Type: "OnDelete"},
+			isErr:    true,
+		},
+		"negative minReadySeconds is invalid": {
+			deploy: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"spec": map[string]interface{}{
+						"strategy": map[string]interface{}{"type": "Recreate"},
+					},
+				},
+			},
+			strategy: &types.DeploymentStrategy{MinReadySeconds: &negativeMinReadySeconds},
+			isErr:    true,
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			err := setDesiredDeploymentStrategy(mock.deploy, mock.strategy)
+			if mock.isErr {
+				if err == nil {
+					t.Fatalf("Expected error got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			if !reflect.DeepEqual(mock.deploy.Object["spec"], mock.expectSpec) {
+				t.Fatalf("Expected spec %v got %v", mock.expectSpec, mock.deploy.Object["spec"])
+			}
+		})
+	}
+}
+
+func TestGetRolloutStrategy(t *testing.T) {
+	var tests = map[string]struct {
+		workload       *unstructured.Unstructured
+		expectStrategy string
+	}{
+		"deployment with rolling update": {
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"spec": map[string]interface{}{
+						"strategy": map[string]interface{}{
+							"type": "RollingUpdate",
+							"rollingUpdate": map[string]interface{}{
+								"maxUnavailable": int64(1),
+								"maxSurge":       "25%",
+							},
+						},
+					},
+				},
+			},
+			expectStrategy: "RollingUpdate maxUnavailable=1 maxSurge=25%",
+		},
+		"daemonset with minReadySeconds": {
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{
+							"type": "OnDelete",
+						},
+						"minReadySeconds": int64(30),
+					},
+				},
+			},
+			expectStrategy: "OnDelete minReadySeconds=30",
+		},
+		"statefulset with partition": {
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindStatefulset,
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{
+							"type": "RollingUpdate",
+							"rollingUpdate": map[string]interface{}{
+								"partition": int64(2),
+							},
+						},
+					},
+				},
+			},
+			expectStrategy: "RollingUpdate partition=2",
+		},
+		"workload without strategy": {
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"spec": map[string]interface{}{},
+				},
+			},
+		},
+		"non workload": {
+			workload: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindService,
+					"spec": map[string]interface{}{},
+				},
+			},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			strategy := getRolloutStrategy(mock.workload)
+			if strategy != mock.expectStrategy {
+				t.Fatalf("Expected strategy %q got %q", mock.expectStrategy, strategy)
+			}
+		})
+	}
+}
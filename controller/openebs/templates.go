@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"mayadata.io/openebs-upgrade/pkg/catalog"
+	"mayadata.io/openebs-upgrade/pkg/templates"
+)
+
+const (
+	// iscsiUbuntuSetupTemplate is the template used to setup the ISCSI
+	// client on ubuntu nodes.
+	iscsiUbuntuSetupTemplate = "iscsi-ubuntu-setup.yaml"
+	// iscsiAmazonLinuxSetupTemplate is the template used to setup the ISCSI
+	// client on amazon linux, centos and RHEL nodes.
+	iscsiAmazonLinuxSetupTemplate = "iscsi-amazonlinux-setup.yaml"
+)
+
+// ValidateTemplates verifies if the local operator YAML of every version in
+// the release catalog and the ISCSI setup YAMLs can be read and parsed the
+// same way as it is done while forming the OpenEBS components.
+//
+// NOTE: The release catalog must be initialized before this is invoked.
+func ValidateTemplates() error {
+	versions := catalog.GetVersions()
+	if len(versions) == 0 {
+		return errors.Errorf("No OpenEBS versions found in the release catalog")
+	}
+	for _, version := range versions {
+		release, err := catalog.GetRelease(version)
+		if err != nil {
+			return err
+		}
+		// the remote templates are fetched and verified only once these
+		// are used so that an unreachable repository doesn't make the
+		// operator unready.
+		if catalog.IsRemote(release) {
+			continue
+		}
+		operatorYAML, err := catalog.ReadOperatorYAML(release)
+		if err != nil {
+			return err
+		}
+		err = validateTemplate(operatorYAML)
+		if err != nil {
+			return errors.Wrapf(err, "Invalid operator YAML %s of version %s",
+				release.OperatorYAML, version)
+		}
+	}
+	for _, name := range []string{iscsiUbuntuSetupTemplate, iscsiAmazonLinuxSetupTemplate} {
+		iscsiYAML, err := templates.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		err = validateTemplate(iscsiYAML)
+		if err != nil {
+			return errors.Wrapf(err, "Invalid template %s", name)
+		}
+	}
+	return nil
+}
+
+// validateTemplate verifies if every component of the given template can
+// be parsed.
+func validateTemplate(templateYAML []byte) error {
+	for _, componentYAML := range strings.Split(string(templateYAML), "---") {
+		if componentYAML == "" {
+			continue
+		}
+		var component map[string]interface{}
+		if err := yaml.Unmarshal([]byte(componentYAML), &component); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,378 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/pkg/health"
+	"mayadata.io/openebs-upgrade/pkg/metrics"
+	"mayadata.io/openebs-upgrade/types"
+	"openebs.io/metac/controller/generic"
+)
+
+const (
+	// uninstallResyncPeriodSeconds is the period after which uninstallation
+	// of OpenEBS is retried till all the components get deleted.
+	uninstallResyncPeriodSeconds float64 = 10
+
+	// annKeyProvisionedBy is the annotation set by kubernetes on a persistent
+	// volume with the name of the provisioner that provisioned it.
+	annKeyProvisionedBy string = "pv.kubernetes.io/provisioned-by"
+)
+
+// openEBSProvisioners are the names of the provisioners and CSI drivers
+// installed by OpenEBS. Persistent volumes provisioned by any of these, by
+// the CSI drivers installed by OpenEBS, by the provisioners of the OpenEBS
+// storage classes or by the provisioners given in spec.uninstall block the
+// uninstallation of OpenEBS.
+var openEBSProvisioners = []string{
+	"openebs.io/provisioner-iscsi",
+	"openebs.io/snapshot-promoter",
+	"openebs.io/local",
+	"cstor.csi.openebs.io",
+	"io.openebs.csi-mayastor",
+}
+
+// annKeyCASType is the annotation set on the storage classes of OpenEBS with
+// the type of the storage engine such as jiva, cstor, etc.
+const annKeyCASType string = "openebs.io/cas-type"
+
+// listPersistentVolumes and listStorageClasses list the resources which
+// decide whether the uninstallation of OpenEBS should be blocked, these can
+// be replaced while testing.
+var (
+	listPersistentVolumes = k8s.GetPersistentVolumes
+	listStorageClasses    = k8s.GetStorageClasses
+)
+
+// uninstallStages are the stages in which OpenEBS components are deleted i.e.,
+// in the reverse order of their dependencies. A stage is started only after all
+// the components of the previous stage are deleted.
+var uninstallStages = []componentStage{
+	{
+		name:  "UpgradeJobs",
+		kinds: []string{types.KindJob},
+	},
+	{
+		name:  "NodeComponents",
+		kinds: []string{types.KindDaemonSet},
+	},
+	{
+		name:  "CSIController",
+		kinds: []string{types.KindStatefulset},
+	},
+	{
+		name:  "ControlPlane",
+		kinds: []string{types.KindDeployment, types.KindPodDisruptionBudget},
+	},
+	{
+		name:  "Configuration",
+		kinds: []string{types.KindService, types.KindConfigMap, types.KindCSIDriver},
+	},
+	{
+		name:  "RBAC",
+		kinds: []string{types.KindClusterRoleBinding, types.KindClusterRole, types.KindServiceAccount},
+	},
+	{
+		name:  "CRDs",
+		kinds: []string{types.KindCustomResourceDefinition},
+	},
+	{
+		name:  "ClusterResources",
+		kinds: []string{types.KindPriorityClass, types.KindNamespace},
+	},
+}
+
+// Finalize implements the logic to uninstall OpenEBS once the OpenEBS resource
+// gets deleted.
+//
+// NOTE: Finalize is invoked by metac instead of Sync as long as OpenEBS is
+// pending deletion. The finalizer on OpenEBS is removed only after all the
+// OpenEBS components are deleted.
+func Finalize(request *generic.SyncHookRequest, response *generic.SyncHookResponse) error {
+	if request == nil {
+		return errors.Errorf("Failed to finalize OpenEBS: Nil request found")
+	}
+	if response == nil {
+		return errors.Errorf("Failed to finalize OpenEBS: Nil response found")
+	}
+	health.Tick()
+	// the state of OpenEBS is no longer exported once it is being deleted
+	metrics.DeleteOpenEBSState(request.Watch.GetNamespace(), request.Watch.GetName())
+	// Nothing needs to be deleted if there are no attachments in request
+	if request.Attachments == nil || request.Attachments.IsEmpty() {
+		response.Finalized = true
+		forgetComponentEvents(request.Watch.GetUID())
+		return nil
+	}
+
+	glog.V(3).Infof(
+		"Will finalize OpenEBS %s %s:",
+		request.Watch.GetNamespace(), request.Watch.GetName(),
+	)
+
+	var observedOpenEBS *unstructured.Unstructured
+	var observedOpenEBSComponents []*unstructured.Unstructured
+	for _, attachment := range request.Attachments.List() {
+		if attachment.GetKind() == string(types.KindOpenEBS) {
+			if request.Watch.GetUID() == attachment.GetUID() {
+				observedOpenEBS = attachment
+			}
+			continue
+		}
+		observedOpenEBSComponents = append(observedOpenEBSComponents, attachment)
+	}
+	if observedOpenEBS == nil {
+		observedOpenEBS = request.Watch
+	}
+
+	openebs, err := toTypedOpenEBS(observedOpenEBS)
+	if err != nil {
+		handleUninstallErr(request.Watch, response, err)
+		return nil
+	}
+	uninstaller := &Uninstaller{
+		ObservedOpenEBS:           openebs,
+		ObservedOpenEBSComponents: observedOpenEBSComponents,
+	}
+	resp, err := uninstaller.Uninstall()
+	if err != nil {
+		handleUninstallErr(request.Watch, response, err)
+		return nil
+	}
+	if resp.Finalized {
+		glog.V(2).Infof(
+			"OpenEBS %s %s uninstalled successfully",
+			request.Watch.GetNamespace(), request.Watch.GetName(),
+		)
+		response.Finalized = true
+		forgetComponentEvents(request.Watch.GetUID())
+		return nil
+	}
+
+	// the components which are not being deleted in this stage are kept
+	// as it is.
+	response.Attachments = append(response.Attachments, resp.RetainedComponents...)
+	response.ExplicitDeletes = append(response.ExplicitDeletes, resp.ExplicitDeletes...)
+	response.ResyncAfterSeconds = uninstallResyncPeriodSeconds
+
+	uninstallStatus := map[string]interface{}{
+		"remainingComponents": resp.Status.RemainingComponents,
+	}
+	if resp.Status.Stage != "" {
+		uninstallStatus["stage"] = resp.Status.Stage
+	}
+	response.Status = map[string]interface{}{}
+	response.Status["phase"] = types.OpenEBSStatusPhaseUninstalling
+	response.Status["observedGeneration"] = request.Watch.GetGeneration()
+	if resp.Status.BlockedBy != "" {
+		uninstallStatus["blockedBy"] = resp.Status.BlockedBy
+		response.Status["reason"] = resp.Status.BlockedBy
+	}
+	response.Status["uninstall"] = uninstallStatus
+
+	return nil
+}
+
+// handleUninstallErr reports an error of the uninstallation against the
+// status of OpenEBS. Unlike the errors of Sync, OpenEBS stays in the
+// Uninstalling phase and the stage and the remaining components of the
+// uninstallation are retained so that it continues from where it stopped.
+func handleUninstallErr(openebs *unstructured.Unstructured, response *generic.SyncHookResponse, err error) {
+	glog.Errorf(
+		"Failed to finalize OpenEBS %s %s: %+v",
+		openebs.GetNamespace(), openebs.GetName(), err,
+	)
+	response.Status = map[string]interface{}{}
+	response.Status["phase"] = types.OpenEBSStatusPhaseUninstalling
+	response.Status["reason"] = err.Error()
+	response.Status["observedGeneration"] = openebs.GetGeneration()
+	if value, exist, _ := unstructured.NestedFieldCopy(openebs.Object, "status", "uninstall"); exist {
+		response.Status["uninstall"] = value
+	}
+	k8s.RecordEvent(openebs, v1.EventTypeWarning, types.EventReasonReconcileFailed, err.Error())
+	// this will stop metac from deleting any of the components since
+	// there was an error, the uninstallation is retried after a while
+	response.SkipReconcile = true
+	response.ResyncAfterSeconds = uninstallResyncPeriodSeconds
+}
+
+// Uninstaller deletes the OpenEBS components in stages once OpenEBS
+// gets deleted.
+type Uninstaller struct {
+	ObservedOpenEBS           *types.OpenEBS
+	ObservedOpenEBSComponents []*unstructured.Unstructured
+}
+
+// UninstallResponse is a helper struct used to form the response
+// of an uninstall attempt.
+type UninstallResponse struct {
+	// RetainedComponents are the components which are not being deleted
+	// in the current stage.
+	RetainedComponents []*unstructured.Unstructured
+	ExplicitDeletes    []*unstructured.Unstructured
+	Status             types.UninstallStatus
+	// Finalized is true if all the OpenEBS components have been deleted.
+	Finalized bool
+}
+
+// Uninstall finds out the OpenEBS components that needs to be deleted in the
+// current stage of uninstallation.
+func (u *Uninstaller) Uninstall() (UninstallResponse, error) {
+	response := UninstallResponse{}
+	managedComponents := u.getManagedComponents()
+	if len(managedComponents) == 0 {
+		response.Finalized = true
+		return response, nil
+	}
+	response.Status.RemainingComponents = int64(len(managedComponents))
+
+	if !u.isForceUninstall() {
+		provisioners, err := u.getOpenEBSProvisioners()
+		if err != nil {
+			return response, errors.Errorf(
+				"Failed to list the provisioners of OpenEBS: %+v", err)
+		}
+		count, err := getOpenEBSPersistentVolumeCount(provisioners)
+		if err != nil {
+			return response, errors.Errorf(
+				"Failed to list persistent volumes provisioned by OpenEBS: %+v", err)
+		}
+		if count > 0 {
+			response.RetainedComponents = u.ObservedOpenEBSComponents
+			response.Status.BlockedBy = fmt.Sprintf(
+				"%d persistent volume(s) provisioned by OpenEBS still exist, "+
+					"delete these or set annotation %s: \"true\" to uninstall",
+				count, types.AnnKeyForceUninstall,
+			)
+			return response, nil
+		}
+	}
+
+	for _, stage := range uninstallStages {
+		stageComponents := make(map[*unstructured.Unstructured]bool)
+		for _, component := range managedComponents {
+			if stage.hasKind(component.GetKind()) {
+				stageComponents[component] = true
+			}
+		}
+		if len(stageComponents) == 0 {
+			continue
+		}
+		response.Status.Stage = stage.name
+		for _, component := range u.ObservedOpenEBSComponents {
+			if !stageComponents[component] {
+				response.RetainedComponents = append(response.RetainedComponents, component)
+				continue
+			}
+			// components which are already being deleted are waited upon
+			if component.GetDeletionTimestamp() != nil {
+				continue
+			}
+			glog.V(2).Infof(
+				"Will delete %s %s %s as part of %s uninstall stage",
+				component.GetKind(), component.GetNamespace(), component.GetName(), stage.name,
+			)
+			response.ExplicitDeletes = append(response.ExplicitDeletes, component)
+		}
+		break
+	}
+	return response, nil
+}
+
+// getManagedComponents returns the observed components which are managed by
+// openebs-upgrade and needs to be deleted.
+func (u *Uninstaller) getManagedComponents() []*unstructured.Unstructured {
+	retainCRDs := u.ObservedOpenEBS.Spec.Uninstall.RetainCRDs != nil &&
+		*u.ObservedOpenEBS.Spec.Uninstall.RetainCRDs
+	var managedComponents []*unstructured.Unstructured
+	for _, component := range u.ObservedOpenEBSComponents {
+		if component.GetLabels()[types.OpenEBSUpgradeDAOManagedLabelKey] !=
+			types.OpenEBSUpgradeDAOManagedLabelValue {
+			continue
+		}
+		if retainCRDs && component.GetKind() == types.KindCustomResourceDefinition {
+			continue
+		}
+		managedComponents = append(managedComponents, component)
+	}
+	return managedComponents
+}
+
+// isForceUninstall returns true if OpenEBS should be uninstalled even if there
+// are persistent volumes provisioned by OpenEBS.
+func (u *Uninstaller) isForceUninstall() bool {
+	return u.ObservedOpenEBS.GetAnnotations()[types.AnnKeyForceUninstall] == "true"
+}
+
+// getOpenEBSProvisioners returns the names of the provisioners and CSI
+// drivers whose persistent volumes block the uninstallation of OpenEBS i.e.,
+// the ones installed by OpenEBS, the observed CSI drivers, the provisioners
+// of the storage classes of OpenEBS and the ones given in spec.uninstall.
+func (u *Uninstaller) getOpenEBSProvisioners() (map[string]bool, error) {
+	provisioners := make(map[string]bool)
+	for _, provisioner := range openEBSProvisioners {
+		provisioners[provisioner] = true
+	}
+	for _, provisioner := range u.ObservedOpenEBS.Spec.Uninstall.Provisioners {
+		provisioners[provisioner] = true
+	}
+	for _, component := range u.ObservedOpenEBSComponents {
+		if component.GetKind() == types.KindCSIDriver {
+			provisioners[component.GetName()] = true
+		}
+	}
+	scs, err := listStorageClasses()
+	if err != nil {
+		return nil, err
+	}
+	for _, sc := range scs.Items {
+		if _, exist := sc.GetAnnotations()[annKeyCASType]; exist {
+			provisioners[sc.Provisioner] = true
+		}
+	}
+	return provisioners, nil
+}
+
+// getOpenEBSPersistentVolumeCount returns the number of persistent volumes
+// provisioned by the given provisioners or CSI drivers.
+func getOpenEBSPersistentVolumeCount(provisioners map[string]bool) (int, error) {
+	pvs, err := listPersistentVolumes()
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	for _, pv := range pvs.Items {
+		if isProvisionedBy(pv, provisioners) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// isProvisionedBy returns true if the given persistent volume is provisioned
+// by one of the given provisioners or CSI drivers.
+func isProvisionedBy(pv v1.PersistentVolume, provisioners map[string]bool) bool {
+	provisioner := pv.GetAnnotations()[annKeyProvisionedBy]
+	if pv.Spec.CSI != nil {
+		provisioner = pv.Spec.CSI.Driver
+	}
+	return provisioner != "" && provisioners[provisioner]
+}
@@ -0,0 +1,617 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+	"openebs.io/metac/controller/common"
+	"openebs.io/metac/controller/generic"
+)
+
+func TestUninstall(t *testing.T) {
+	var tests = map[string]struct {
+		annotations    map[string]string
+		uninstall      types.Uninstall
+		observed       []*unstructured.Unstructured
+		pvs            []v1.PersistentVolume
+		scs            []storagev1.StorageClass
+		expectFinalize bool
+		expectBlocked  bool
+		expectStage    string
+		expectDeletes  []string
+	}{
+		"no managed components": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":      "maya-apiserver",
+							"namespace": "openebs",
+						},
+					},
+				},
+			},
+			expectFinalize: true,
+		},
+		"jobs are deleted first": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindJob,
+						"metadata": map[string]interface{}{
+							"name":      "upgrade",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-ndm",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectStage:   "UpgradeJobs",
+			expectDeletes: []string{"upgrade_" + types.KindJob},
+		},
+		"node components are deleted before the control plane": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":      "maya-apiserver",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-ndm",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindCustomResourceDefinition,
+						"metadata": map[string]interface{}{
+							"name":      "csivolumes.openebs.io",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectStage:   "NodeComponents",
+			expectDeletes: []string{types.NDMManifestKey},
+		},
+		"components being deleted are waited upon": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":              "deleting",
+							"namespace":         "openebs",
+							"deletionTimestamp": "2020-01-01T00:00:00Z",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-provisioner",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindService,
+						"metadata": map[string]interface{}{
+							"name":      "maya-apiserver-service",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectStage:   "ControlPlane",
+			expectDeletes: []string{types.ProvisionerManifestKey},
+		},
+		"pod disruption budgets are deleted with the control plane": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindPodDisruptionBudget,
+						"metadata": map[string]interface{}{
+							"name":      "maya-apiserver-pdb",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectStage:   "ControlPlane",
+			expectDeletes: []string{"maya-apiserver-pdb_" + types.KindPodDisruptionBudget},
+		},
+		"CRDs are deleted before the namespaces": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindNamespace,
+						"metadata": map[string]interface{}{
+							"name":      "mayastor",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindCustomResourceDefinition,
+						"metadata": map[string]interface{}{
+							"name":      "csivolumes.openebs.io",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectStage:   "CRDs",
+			expectDeletes: []string{types.CSIVolumeCRDManifestKey},
+		},
+		"CRDs are retained if retainCRDs is set": {
+			uninstall: types.Uninstall{RetainCRDs: func() *bool { v := true; return &v }()},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindNamespace,
+						"metadata": map[string]interface{}{
+							"name":      "mayastor",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindCustomResourceDefinition,
+						"metadata": map[string]interface{}{
+							"name":      "csivolumes.openebs.io",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectStage:   "ClusterResources",
+			expectDeletes: []string{types.MayastorNamespaceManifestKey},
+		},
+		"only retained CRDs are finalized": {
+			uninstall: types.Uninstall{RetainCRDs: func() *bool { v := true; return &v }()},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindCustomResourceDefinition,
+						"metadata": map[string]interface{}{
+							"name":      "csivolumes.openebs.io",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			expectFinalize: true,
+		},
+		"volumes of the OpenEBS provisioners block uninstall": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-ndm",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			pvs: []v1.PersistentVolume{
+				v1.PersistentVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{annKeyProvisionedBy: "openebs.io/local"},
+					},
+				},
+			},
+			expectBlocked: true,
+		},
+		"volumes of the other provisioners do not block uninstall": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-ndm",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			pvs: []v1.PersistentVolume{
+				v1.PersistentVolume{
+					Spec: v1.PersistentVolumeSpec{
+						PersistentVolumeSource: v1.PersistentVolumeSource{
+							CSI: &v1.CSIPersistentVolumeSource{Driver: "ebs.csi.aws.com"},
+						},
+					},
+				},
+			},
+			expectStage:   "NodeComponents",
+			expectDeletes: []string{types.NDMManifestKey},
+		},
+		"volumes of the observed CSI drivers block uninstall": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-ndm",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindCSIDriver,
+						"metadata": map[string]interface{}{
+							"name":      "new.csi.openebs.io",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			pvs: []v1.PersistentVolume{
+				v1.PersistentVolume{
+					Spec: v1.PersistentVolumeSpec{
+						PersistentVolumeSource: v1.PersistentVolumeSource{
+							CSI: &v1.CSIPersistentVolumeSource{Driver: "new.csi.openebs.io"},
+						},
+					},
+				},
+			},
+			expectBlocked: true,
+		},
+		"volumes of the provisioners of OpenEBS storage classes block uninstall": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-ndm",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			pvs: []v1.PersistentVolume{
+				v1.PersistentVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{annKeyProvisionedBy: "openebs.io/custom"},
+					},
+				},
+			},
+			scs: []storagev1.StorageClass{
+				{
+					ObjectMeta:  metav1.ObjectMeta{Annotations: map[string]string{annKeyCASType: "jiva"}},
+					Provisioner: "openebs.io/custom",
+				},
+			},
+			expectBlocked: true,
+		},
+		"volumes of the given provisioners block uninstall": {
+			uninstall: types.Uninstall{Provisioners: []string{"zfs.csi.openebs.io"}},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-ndm",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			pvs: []v1.PersistentVolume{
+				v1.PersistentVolume{
+					Spec: v1.PersistentVolumeSpec{
+						PersistentVolumeSource: v1.PersistentVolumeSource{
+							CSI: &v1.CSIPersistentVolumeSource{Driver: "zfs.csi.openebs.io"},
+						},
+					},
+				},
+			},
+			expectBlocked: true,
+		},
+		"force uninstall ignores the volumes": {
+			annotations: map[string]string{types.AnnKeyForceUninstall: "true"},
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":      "openebs-ndm",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			pvs: []v1.PersistentVolume{
+				v1.PersistentVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{annKeyProvisionedBy: "openebs.io/local"},
+					},
+				},
+			},
+			expectStage:   "NodeComponents",
+			expectDeletes: []string{types.NDMManifestKey},
+		},
+	}
+	defer func(pvs func() (*v1.PersistentVolumeList, error),
+		scs func() (*storagev1.StorageClassList, error)) {
+		listPersistentVolumes = pvs
+		listStorageClasses = scs
+	}(listPersistentVolumes, listStorageClasses)
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			listPersistentVolumes = func() (*v1.PersistentVolumeList, error) {
+				return &v1.PersistentVolumeList{Items: mock.pvs}, nil
+			}
+			listStorageClasses = func() (*storagev1.StorageClassList, error) {
+				return &storagev1.StorageClassList{Items: mock.scs}, nil
+			}
+			openebs := &types.OpenEBS{Spec: types.OpenEBSSpec{Uninstall: mock.uninstall}}
+			openebs.SetAnnotations(mock.annotations)
+			u := &Uninstaller{
+				ObservedOpenEBS:           openebs,
+				ObservedOpenEBSComponents: mock.observed,
+			}
+			resp, err := u.Uninstall()
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			if resp.Finalized != mock.expectFinalize {
+				t.Fatalf("Expected finalized %t got %t", mock.expectFinalize, resp.Finalized)
+			}
+			if mock.expectBlocked != (resp.Status.BlockedBy != "") {
+				t.Fatalf("Expected blocked %t got %q", mock.expectBlocked, resp.Status.BlockedBy)
+			}
+			if resp.Status.Stage != mock.expectStage {
+				t.Fatalf("Expected stage %q got %q", mock.expectStage, resp.Status.Stage)
+			}
+			gotDeletes := getComponentKeys(resp.ExplicitDeletes)
+			if strings.Join(gotDeletes, ",") != strings.Join(mock.expectDeletes, ",") {
+				t.Fatalf("Expected deletes %v got %v", mock.expectDeletes, gotDeletes)
+			}
+			// the components which are neither deleted nor being deleted
+			// are retained as it is
+			if !resp.Finalized && !mock.expectBlocked {
+				var waiting int
+				for _, component := range mock.observed {
+					if component.GetDeletionTimestamp() != nil {
+						waiting++
+					}
+				}
+				if len(resp.RetainedComponents)+len(resp.ExplicitDeletes)+waiting != len(mock.observed) {
+					t.Fatalf("Expected %d components to be retained got %d",
+						len(mock.observed)-len(resp.ExplicitDeletes)-waiting, len(resp.RetainedComponents))
+				}
+			}
+		})
+	}
+}
+
+func TestFinalizeError(t *testing.T) {
+	var tests = map[string]struct {
+		spec            map[string]interface{}
+		uninstallStatus map[string]interface{}
+		listErr         error
+		expectReason    string
+	}{
+		"uninstall status is retained if the persistent volumes can't be listed": {
+			uninstallStatus: map[string]interface{}{
+				"stage":               "Operators",
+				"remainingComponents": int64(3),
+			},
+			listErr:      errors.Errorf("connection refused"),
+			expectReason: "Failed to list persistent volumes provisioned by OpenEBS: connection refused",
+		},
+		"uninstall which has not started yet has no uninstall status": {
+			listErr:      errors.Errorf("connection refused"),
+			expectReason: "Failed to list persistent volumes provisioned by OpenEBS: connection refused",
+		},
+		"uninstall status is retained if OpenEBS is invalid": {
+			spec: map[string]interface{}{
+				"version": int64(2),
+			},
+			uninstallStatus: map[string]interface{}{
+				"stage":               "RBAC",
+				"remainingComponents": int64(1),
+			},
+			expectReason: "Can't unmarshal OpenEBS",
+		},
+	}
+	defer func(
+		pvs func() (*v1.PersistentVolumeList, error),
+		scs func() (*storagev1.StorageClassList, error),
+	) {
+		listPersistentVolumes = pvs
+		listStorageClasses = scs
+	}(listPersistentVolumes, listStorageClasses)
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			listPersistentVolumes = func() (*v1.PersistentVolumeList, error) {
+				return nil, mock.listErr
+			}
+			listStorageClasses = func() (*storagev1.StorageClassList, error) {
+				return &storagev1.StorageClassList{}, nil
+			}
+			watch := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": types.APIVersionDAOMayaDataV1Alpha1,
+					"kind":       string(types.KindOpenEBS),
+					"metadata": map[string]interface{}{
+						"name":       "openebs",
+						"namespace":  "openebs",
+						"uid":        "openebs-uid",
+						"generation": int64(2),
+					},
+				},
+			}
+			if mock.spec != nil {
+				watch.Object["spec"] = mock.spec
+			}
+			if mock.uninstallStatus != nil {
+				watch.Object["status"] = map[string]interface{}{
+					"phase":     string(types.OpenEBSStatusPhaseUninstalling),
+					"uninstall": mock.uninstallStatus,
+				}
+			}
+			component := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "apps/v1",
+					"kind":       types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":      "maya-apiserver",
+						"namespace": "openebs",
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+						},
+					},
+				},
+			}
+			attachments := common.AnyUnstructRegistry{}
+			attachments.Insert(component)
+			request := &generic.SyncHookRequest{
+				Watch:       watch,
+				Attachments: attachments,
+			}
+			response := &generic.SyncHookResponse{}
+
+			err := Finalize(request, response)
+			if err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			if response.Finalized {
+				t.Fatalf("Expected finalized false got true")
+			}
+			if !response.SkipReconcile {
+				t.Fatalf("Expected reconcile to be skipped got false")
+			}
+			if response.ResyncAfterSeconds != uninstallResyncPeriodSeconds {
+				t.Fatalf("Expected resync after %v seconds got %v",
+					uninstallResyncPeriodSeconds, response.ResyncAfterSeconds)
+			}
+			if response.Status["phase"] != types.OpenEBSStatusPhaseUninstalling {
+				t.Fatalf("Expected phase %s got %v", types.OpenEBSStatusPhaseUninstalling, response.Status["phase"])
+			}
+			reason, _ := response.Status["reason"].(string)
+			if !strings.Contains(reason, mock.expectReason) {
+				t.Fatalf("Expected reason %q got %q", mock.expectReason, reason)
+			}
+			uninstallStatus, exist := response.Status["uninstall"]
+			if mock.uninstallStatus == nil && exist {
+				t.Fatalf("Expected no uninstall status got %v", uninstallStatus)
+			}
+			if mock.uninstallStatus != nil && !reflect.DeepEqual(uninstallStatus, mock.uninstallStatus) {
+				t.Fatalf("Expected uninstall status %v got %v", mock.uninstallStatus, uninstallStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+	"mayadata.io/openebs-upgrade/unstruct"
+)
+
+// upgradeResyncPeriodSeconds is the period after which the rollout of the
+// current upgrade stage is checked again.
+const upgradeResyncPeriodSeconds float64 = 10
+
+// upgradeStages are the stages in which OpenEBS components are upgraded. A
+// stage is started only after the Deployments, DaemonSets and StatefulSets
+// of all the previous stages are updated and ready.
+var upgradeStages = []componentStage{
+	{
+		name: "CRDs",
+		kinds: []string{types.KindNamespace, types.KindPriorityClass,
+			types.KindCustomResourceDefinition},
+	},
+	{
+		name:  "RBAC",
+		kinds: []string{types.KindServiceAccount, types.KindClusterRole, types.KindClusterRoleBinding},
+	},
+	{
+		name:  "Operators",
+		kinds: []string{types.KindConfigMap, types.KindService, types.KindDeployment, types.KindPodDisruptionBudget},
+	},
+	{
+		name:  "CSIController",
+		kinds: []string{types.KindCSIDriver, types.KindStatefulset},
+	},
+	{
+		name:  "NodeComponents",
+		kinds: []string{types.KindDaemonSet},
+	},
+}
+
+// getComponentKey returns the key with which a component can be uniquely
+// identified i.e., kind/namespace/name.
+func getComponentKey(component *unstructured.Unstructured) string {
+	return component.GetKind() + "/" + component.GetNamespace() + "/" + component.GetName()
+}
+
+// isWorkload returns true if the given kind runs pods i.e., it has to be
+// ready before the upgrade can move to the next stage.
+func isWorkload(kind string) bool {
+	return kind == types.KindDeployment || kind == types.KindDaemonSet ||
+		kind == types.KindStatefulset
+}
+
+// isUpgradeInProgress returns true if any of the observed OpenEBS workloads is
+// running a version other than the desired one.
+func (p *Planner) isUpgradeInProgress() bool {
+	desiredVersion := p.ObservedOpenEBS.Spec.Version + p.ObservedOpenEBS.Spec.ImageTagSuffix
+	for _, component := range p.ObservedOpenEBSComponents {
+		if !isWorkload(component.GetKind()) {
+			continue
+		}
+		labels := component.GetLabels()
+		if labels[types.OpenEBSUpgradeDAOManagedLabelKey] != types.OpenEBSUpgradeDAOManagedLabelValue {
+			continue
+		}
+		if version, exist := labels[types.OpenEBSVersionLabelKey]; exist && version != desiredVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// stageDesiredComponents ensures that the components are upgraded in stages
+// i.e., the components of a stage are given to metac only after the workloads
+// of all the previous stages are updated and ready. The components of the
+// later stages are kept as it is till then.
+func (p *Planner) stageDesiredComponents(response *ReconcileResponse) {
+	observedComponents := make(map[string]*unstructured.Unstructured)
+	for _, component := range p.ObservedOpenEBSComponents {
+		observedComponents[getComponentKey(component)] = component
+	}
+	if !p.isUpgradeInProgress() {
+		setPendingPodDeletions(response, observedComponents)
+		return
+	}
+
+	// find out the first stage which is not yet rolled out
+	currentStage := -1
+	var blockedBy string
+	for i, stage := range upgradeStages {
+		for _, desired := range response.DesiredOpenEBSComponents {
+			if !stage.hasKind(desired.GetKind()) || isPreInstallationComponent(desired) {
+				continue
+			}
+			isRolledOut, reason := isComponentRolledOut(desired,
+				observedComponents[getComponentKey(desired)])
+			if !isRolledOut {
+				blockedBy = reason
+				break
+			}
+		}
+		if blockedBy != "" {
+			currentStage = i
+			break
+		}
+	}
+	if currentStage == -1 {
+		setPendingPodDeletions(response, observedComponents)
+		return
+	}
+
+	// hold back the components of the stages after the current stage
+	var desiredComponents []*unstructured.Unstructured
+	for _, desired := range response.DesiredOpenEBSComponents {
+		stage := getUpgradeStageIndex(desired.GetKind())
+		if stage <= currentStage || isPreInstallationComponent(desired) {
+			desiredComponents = append(desiredComponents, desired)
+			continue
+		}
+		// the observed component is given as it is so that metac neither
+		// updates it nor deletes it.
+		if observed, exist := observedComponents[getComponentKey(desired)]; exist {
+			desiredComponents = append(desiredComponents, observed)
+		}
+	}
+	response.DesiredOpenEBSComponents = desiredComponents
+
+	glog.V(2).Infof(
+		"Upgrading OpenEBS %s %s: stage %s blocked by %s",
+		p.ObservedOpenEBS.Namespace, p.ObservedOpenEBS.Name,
+		upgradeStages[currentStage].name, blockedBy,
+	)
+	response.UpgradeStatus = &types.UpgradeStatus{
+		Stage:     upgradeStages[currentStage].name,
+		Progress:  fmt.Sprintf("%d/%d stages completed", currentStage, len(upgradeStages)),
+		BlockedBy: blockedBy,
+	}
+}
+
+// setPendingPodDeletions sets the upgrade status if the pods of the desired
+// daemonsets having the OnDelete update strategy are yet to be deleted. These
+// pods are updated only once they are deleted, hence the upgrade is not
+// blocked by them.
+func setPendingPodDeletions(response *ReconcileResponse,
+	observedComponents map[string]*unstructured.Unstructured) {
+	var pendingPodDeletions []string
+	for _, desired := range response.DesiredOpenEBSComponents {
+		if desired.GetKind() != types.KindDaemonSet {
+			continue
+		}
+		pending := getPendingPodDeletion(observedComponents[getComponentKey(desired)])
+		if pending != "" {
+			pendingPodDeletions = append(pendingPodDeletions, pending)
+		}
+	}
+	if len(pendingPodDeletions) == 0 {
+		return
+	}
+	response.UpgradeStatus = &types.UpgradeStatus{
+		Stage: upgradeStages[len(upgradeStages)-1].name,
+		Progress: fmt.Sprintf("%d/%d stages completed, waiting for pods to be deleted",
+			len(upgradeStages), len(upgradeStages)),
+		PendingPodDeletions: pendingPodDeletions,
+	}
+}
+
+// getPendingPodDeletion returns the number of pods of the given daemonset
+// which are yet to be deleted if its update strategy is OnDelete, empty
+// string is returned otherwise.
+func getPendingPodDeletion(daemon *unstructured.Unstructured) string {
+	if daemon == nil || !isOnDeleteDaemonSet(daemon) {
+		return ""
+	}
+	desiredReplicas, _, _ := unstructured.NestedInt64(daemon.Object, "status", "desiredNumberScheduled")
+	updatedReplicas, _, _ := unstructured.NestedInt64(daemon.Object, "status", "updatedNumberScheduled")
+	if updatedReplicas >= desiredReplicas {
+		return ""
+	}
+	return fmt.Sprintf("DaemonSet %s/%s is waiting for %d/%d pods to be deleted",
+		daemon.GetNamespace(), daemon.GetName(), desiredReplicas-updatedReplicas, desiredReplicas)
+}
+
+// isOnDeleteDaemonSet returns true if the given component is a daemonset
+// whose pods are updated only once they are deleted.
+func isOnDeleteDaemonSet(component *unstructured.Unstructured) bool {
+	if component.GetKind() != types.KindDaemonSet {
+		return false
+	}
+	strategyType, _, _ := unstructured.NestedString(component.Object, "spec", "updateStrategy", "type")
+	return strategyType == string(appsv1.OnDeleteDaemonSetStrategyType)
+}
+
+// isUpgradeBlocked returns true if the staged upgrade is blocked by a
+// component which is not yet rolled out.
+func isUpgradeBlocked(response *ReconcileResponse) bool {
+	return response.UpgradeStatus != nil && response.UpgradeStatus.BlockedBy != ""
+}
+
+// getUpgradeStageIndex returns the index of the upgrade stage in which the
+// components of the given kind are upgraded. Unknown kinds are upgraded in
+// the first stage.
+func getUpgradeStageIndex(kind string) int {
+	for i, stage := range upgradeStages {
+		if stage.hasKind(kind) {
+			return i
+		}
+	}
+	return 0
+}
+
+// isPreInstallationComponent returns true if the given component is installed
+// prior to OpenEBS, these components are not staged.
+func isPreInstallationComponent(component *unstructured.Unstructured) bool {
+	return component.GetName() == types.OpenEBSNodeSetupDaemonsetNameKey ||
+		component.GetName() == types.OpenEBSNodeSetupConfigmapNameKey
+}
+
+// isComponentRolledOut returns true if the observed component has been updated
+// as per the desired component and if it is a workload, all its replicas are
+// updated and ready. The replicas of a daemonset having the OnDelete update
+// strategy are updated only once its pods are deleted, hence these need to be
+// ready only. If not, the reason is returned as well.
+func isComponentRolledOut(desired, observed *unstructured.Unstructured) (bool, string) {
+	component := fmt.Sprintf("%s %s/%s", desired.GetKind(), desired.GetNamespace(), desired.GetName())
+	if observed == nil {
+		return false, component + " is not yet created"
+	}
+	desiredVersion, exist := desired.GetLabels()[types.OpenEBSVersionLabelKey]
+	if exist && observed.GetLabels()[types.OpenEBSVersionLabelKey] != desiredVersion {
+		return false, component + " is not yet updated to version " + desiredVersion
+	}
+	if !isWorkload(desired.GetKind()) {
+		return true, ""
+	}
+	if !isContainerImagesUpdated(desired, observed) {
+		return false, component + " is not yet updated with the desired images"
+	}
+
+	generation := observed.GetGeneration()
+	observedGeneration, _, _ := unstructured.NestedInt64(observed.Object, "status", "observedGeneration")
+	if observedGeneration < generation {
+		return false, component + " rollout is not yet observed"
+	}
+
+	var desiredReplicas, updatedReplicas, readyReplicas int64
+	if desired.GetKind() == types.KindDaemonSet {
+		desiredReplicas, _, _ = unstructured.NestedInt64(observed.Object, "status", "desiredNumberScheduled")
+		updatedReplicas, _, _ = unstructured.NestedInt64(observed.Object, "status", "updatedNumberScheduled")
+		readyReplicas, _, _ = unstructured.NestedInt64(observed.Object, "status", "numberReady")
+	} else {
+		var exist bool
+		desiredReplicas, exist, _ = unstructured.NestedInt64(observed.Object, "spec", "replicas")
+		if !exist {
+			desiredReplicas = 1
+		}
+		updatedReplicas, _, _ = unstructured.NestedInt64(observed.Object, "status", "updatedReplicas")
+		readyReplicas, _, _ = unstructured.NestedInt64(observed.Object, "status", "readyReplicas")
+	}
+	if updatedReplicas < desiredReplicas && !isOnDeleteDaemonSet(observed) {
+		return false, fmt.Sprintf("%s has %d/%d replicas updated", component, updatedReplicas, desiredReplicas)
+	}
+	if readyReplicas < desiredReplicas {
+		return false, fmt.Sprintf("%s has %d/%d replicas ready", component, readyReplicas, desiredReplicas)
+	}
+	return true, ""
+}
+
+// isContainerImagesUpdated returns true if all the containers of the observed
+// workload are running the images of the desired workload.
+func isContainerImagesUpdated(desired, observed *unstructured.Unstructured) bool {
+	desiredImages := getContainerImages(desired)
+	observedImages := getContainerImages(observed)
+	for name, image := range desiredImages {
+		if observedImages[name] != image {
+			return false
+		}
+	}
+	return true
+}
+
+// getContainerImages returns the mapping of container name to its image
+// for the given workload.
+func getContainerImages(workload *unstructured.Unstructured) map[string]string {
+	images := make(map[string]string)
+	containers, err := unstruct.GetNestedSliceOrError(workload, "spec", "template", "spec", "containers")
+	if err != nil {
+		return images
+	}
+	for _, container := range containers {
+		containerMap, ok := container.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(containerMap, "name")
+		image, _, _ := unstructured.NestedString(containerMap, "image")
+		images[name] = image
+	}
+	return images
+}
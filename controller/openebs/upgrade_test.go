@@ -0,0 +1,1305 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+func TestIsComponentRolledOut(t *testing.T) {
+	var tests = map[string]struct {
+		desired      *unstructured.Unstructured
+		observed     *unstructured.Unstructured
+		isRolledOut  bool
+		expectReason string
+	}{
+		"component is not yet created": {
+			desired: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.6.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectReason: "is not yet created",
+		},
+		"component is running the older version": {
+			desired: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.6.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.5.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.5.0",
+									},
+								},
+							},
+						},
+					},
+					"status": map[string]interface{}{
+						"observedGeneration": int64(1),
+						"updatedReplicas":    int64(1),
+						"readyReplicas":      int64(1),
+					},
+				},
+			},
+			expectReason: "is not yet updated to version 2.6.0",
+		},
+		"non workload is rolled out once updated": {
+			desired: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindService,
+					"metadata": map[string]interface{}{
+						"name":      "maya-apiserver-service",
+						"namespace": "openebs",
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+						},
+					},
+				},
+			},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindService,
+					"metadata": map[string]interface{}{
+						"name":      "maya-apiserver-service",
+						"namespace": "openebs",
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+						},
+					},
+				},
+			},
+			isRolledOut: true,
+		},
+		"rollout is not yet observed": {
+			desired: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.6.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.6.0",
+									},
+								},
+							},
+						},
+					},
+					"status": map[string]interface{}{
+						"observedGeneration": int64(0),
+					},
+				},
+			},
+			expectReason: "rollout is not yet observed",
+		},
+		"replicas of the deployment are not yet updated": {
+			desired: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.6.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.6.0",
+									},
+								},
+							},
+						},
+					},
+					"status": map[string]interface{}{
+						"observedGeneration": int64(1),
+					},
+				},
+			},
+			expectReason: "has 0/1 replicas updated",
+		},
+		"replicas of the deployment are not yet ready": {
+			desired: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.6.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.6.0",
+									},
+								},
+							},
+						},
+					},
+					"status": map[string]interface{}{
+						"observedGeneration": int64(1),
+						"updatedReplicas":    int64(1),
+					},
+				},
+			},
+			expectReason: "has 0/1 replicas ready",
+		},
+		"deployment is rolled out": {
+			desired: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.6.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDeployment,
+					"metadata": map[string]interface{}{
+						"name":       "maya-apiserver",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "maya-apiserver",
+										"image": "openebs/maya-apiserver:2.6.0",
+									},
+								},
+							},
+						},
+					},
+					"status": map[string]interface{}{
+						"observedGeneration": int64(1),
+						"updatedReplicas":    int64(1),
+						"readyReplicas":      int64(1),
+					},
+				},
+			},
+			isRolledOut: true,
+		},
+		"daemonset is partly updated": {
+			desired: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"metadata": map[string]interface{}{
+						"name":       "openebs-ndm",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "openebs-ndm",
+										"image": "openebs/openebs-ndm:2.6.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"metadata": map[string]interface{}{
+						"name":       "openebs-ndm",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "openebs-ndm",
+										"image": "openebs/openebs-ndm:2.6.0",
+									},
+								},
+							},
+						},
+					},
+					"status": map[string]interface{}{
+						"observedGeneration":     int64(1),
+						"desiredNumberScheduled": int64(3),
+						"updatedNumberScheduled": int64(2),
+						"numberReady":            int64(3),
+					},
+				},
+			},
+			expectReason: "has 2/3 replicas updated",
+		},
+		"daemonset is rolled out": {
+			desired: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"metadata": map[string]interface{}{
+						"name":       "openebs-ndm",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "openebs-ndm",
+										"image": "openebs/openebs-ndm:2.6.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"metadata": map[string]interface{}{
+						"name":       "openebs-ndm",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "openebs-ndm",
+										"image": "openebs/openebs-ndm:2.6.0",
+									},
+								},
+							},
+						},
+					},
+					"status": map[string]interface{}{
+						"observedGeneration":     int64(1),
+						"desiredNumberScheduled": int64(3),
+						"updatedNumberScheduled": int64(3),
+						"numberReady":            int64(3),
+					},
+				},
+			},
+			isRolledOut: true,
+		},
+		"daemonset with OnDelete strategy is rolled out before its pods are deleted": {
+			desired: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"metadata": map[string]interface{}{
+						"name":       "openebs-ndm",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "openebs-ndm",
+										"image": "openebs/openebs-ndm:2.6.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"metadata": map[string]interface{}{
+						"name":       "openebs-ndm",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{"type": "OnDelete"},
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "openebs-ndm",
+										"image": "openebs/openebs-ndm:2.6.0",
+									},
+								},
+							},
+						},
+					},
+					"status": map[string]interface{}{
+						"observedGeneration":     int64(1),
+						"desiredNumberScheduled": int64(3),
+						"updatedNumberScheduled": int64(0),
+						"numberReady":            int64(3),
+					},
+				},
+			},
+			isRolledOut: true,
+		},
+		"daemonset with OnDelete strategy is not rolled out till its pods are ready": {
+			desired: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"metadata": map[string]interface{}{
+						"name":       "openebs-ndm",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "openebs-ndm",
+										"image": "openebs/openebs-ndm:2.6.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			observed: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"kind": types.KindDaemonSet,
+					"metadata": map[string]interface{}{
+						"name":       "openebs-ndm",
+						"namespace":  "openebs",
+						"generation": int64(1),
+						"labels": map[string]interface{}{
+							types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							types.OpenEBSVersionLabelKey:           "2.6.0",
+						},
+					},
+					"spec": map[string]interface{}{
+						"updateStrategy": map[string]interface{}{"type": "OnDelete"},
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "openebs-ndm",
+										"image": "openebs/openebs-ndm:2.6.0",
+									},
+								},
+							},
+						},
+					},
+					"status": map[string]interface{}{
+						"observedGeneration":     int64(1),
+						"desiredNumberScheduled": int64(3),
+						"updatedNumberScheduled": int64(0),
+						"numberReady":            int64(2),
+					},
+				},
+			},
+			expectReason: "has 2/3 replicas ready",
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			isRolledOut, reason := isComponentRolledOut(mock.desired, mock.observed)
+			if isRolledOut != mock.isRolledOut {
+				t.Fatalf("Expected rolled out %t got %t: %s", mock.isRolledOut, isRolledOut, reason)
+			}
+			if !strings.Contains(reason, mock.expectReason) {
+				t.Fatalf("Expected reason %q got %q", mock.expectReason, reason)
+			}
+		})
+	}
+}
+
+func TestIsComponentRolledOutWithOlderImages(t *testing.T) {
+	desired := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind": types.KindDeployment,
+			"metadata": map[string]interface{}{
+				"name":       "maya-apiserver",
+				"namespace":  "openebs",
+				"generation": int64(1),
+				"labels": map[string]interface{}{
+					types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+					types.OpenEBSVersionLabelKey:           "2.6.0",
+				},
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":  "maya-apiserver",
+								"image": "openebs/maya-apiserver:2.6.0",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	observed := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind": types.KindDeployment,
+			"metadata": map[string]interface{}{
+				"name":       "maya-apiserver",
+				"namespace":  "openebs",
+				"generation": int64(1),
+				"labels": map[string]interface{}{
+					types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+					types.OpenEBSVersionLabelKey:           "2.6.0",
+				},
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":  "maya-apiserver",
+								"image": "openebs/maya-apiserver:2.5.0",
+							},
+						},
+					},
+				},
+			},
+			"status": map[string]interface{}{
+				"observedGeneration": int64(1),
+				"updatedReplicas":    int64(1),
+				"readyReplicas":      int64(1),
+			},
+		},
+	}
+	isRolledOut, reason := isComponentRolledOut(desired, observed)
+	if isRolledOut || !strings.Contains(reason, "desired images") {
+		t.Fatalf("Expected not rolled out due to images got %t %q", isRolledOut, reason)
+	}
+}
+
+func TestStageDesiredComponents(t *testing.T) {
+	var tests = map[string]struct {
+		observed       []*unstructured.Unstructured
+		desired        []*unstructured.Unstructured
+		expectStage    string
+		expectDesired  []string
+		expectHeldBack map[string]bool
+		expectPending  []string
+	}{
+		"no upgrade is in progress": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":       "maya-apiserver",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "maya-apiserver",
+											"image": "openebs/maya-apiserver:2.6.0",
+										},
+									},
+								},
+							},
+						},
+						"status": map[string]interface{}{
+							"observedGeneration": int64(1),
+							"updatedReplicas":    int64(1),
+							"readyReplicas":      int64(1),
+						},
+					},
+				},
+			},
+			desired: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":       "maya-apiserver",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "maya-apiserver",
+											"image": "openebs/maya-apiserver:2.6.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":       "openebs-ndm",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "openebs-ndm",
+											"image": "openebs/openebs-ndm:2.6.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectDesired: []string{"maya-apiserver_Deployment", "openebs-ndm_DaemonSet"},
+		},
+		"pods of the daemonsets with OnDelete strategy are yet to be deleted": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":       "maya-apiserver",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "maya-apiserver",
+											"image": "openebs/maya-apiserver:2.6.0",
+										},
+									},
+								},
+							},
+						},
+						"status": map[string]interface{}{
+							"observedGeneration": int64(1),
+							"updatedReplicas":    int64(1),
+							"readyReplicas":      int64(1),
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":       "openebs-ndm",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"updateStrategy": map[string]interface{}{"type": "OnDelete"},
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "openebs-ndm",
+											"image": "openebs/openebs-ndm:2.6.0",
+										},
+									},
+								},
+							},
+						},
+						"status": map[string]interface{}{
+							"observedGeneration":     int64(1),
+							"desiredNumberScheduled": int64(3),
+							"updatedNumberScheduled": int64(1),
+							"numberReady":            int64(3),
+						},
+					},
+				},
+			},
+			desired: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":       "maya-apiserver",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "maya-apiserver",
+											"image": "openebs/maya-apiserver:2.6.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":       "openebs-ndm",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "openebs-ndm",
+											"image": "openebs/openebs-ndm:2.6.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectStage:   "NodeComponents",
+			expectDesired: []string{"maya-apiserver_Deployment", "openebs-ndm_DaemonSet"},
+			expectPending: []string{"DaemonSet openebs/openebs-ndm is waiting for 2/3 pods to be deleted"},
+		},
+		"node components are held back till the operators are rolled out": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":       "maya-apiserver",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.5.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "maya-apiserver",
+											"image": "openebs/maya-apiserver:2.5.0",
+										},
+									},
+								},
+							},
+						},
+						"status": map[string]interface{}{
+							"observedGeneration": int64(1),
+							"updatedReplicas":    int64(1),
+							"readyReplicas":      int64(1),
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":       "openebs-ndm",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.5.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "openebs-ndm",
+											"image": "openebs/openebs-ndm:2.5.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindCustomResourceDefinition,
+						"metadata": map[string]interface{}{
+							"name":      "csivolumes.openebs.io",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+			},
+			desired: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindCustomResourceDefinition,
+						"metadata": map[string]interface{}{
+							"name":      "csivolumes.openebs.io",
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":       "maya-apiserver",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "maya-apiserver",
+											"image": "openebs/maya-apiserver:2.6.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":       "openebs-ndm",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "openebs-ndm",
+											"image": "openebs/openebs-ndm:2.6.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectStage: "Operators",
+			expectDesired: []string{
+				types.CSIVolumeCRDManifestKey, "maya-apiserver_Deployment", "openebs-ndm_DaemonSet",
+			},
+			expectHeldBack: map[string]bool{"openebs-ndm": true},
+		},
+		"new components of the later stages are not created": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":       "maya-apiserver",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.5.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "maya-apiserver",
+											"image": "openebs/maya-apiserver:2.5.0",
+										},
+									},
+								},
+							},
+						},
+						"status": map[string]interface{}{
+							"observedGeneration": int64(1),
+							"updatedReplicas":    int64(1),
+							"readyReplicas":      int64(1),
+						},
+					},
+				},
+			},
+			desired: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":       "maya-apiserver",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "maya-apiserver",
+											"image": "openebs/maya-apiserver:2.6.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":       "openebs-ndm",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "openebs-ndm",
+											"image": "openebs/openebs-ndm:2.6.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectStage:   "Operators",
+			expectDesired: []string{"maya-apiserver_Deployment"},
+		},
+		"pre installation components are not staged": {
+			observed: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":       "maya-apiserver",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.5.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "maya-apiserver",
+											"image": "openebs/maya-apiserver:2.5.0",
+										},
+									},
+								},
+							},
+						},
+						"status": map[string]interface{}{
+							"observedGeneration": int64(1),
+							"updatedReplicas":    int64(1),
+							"readyReplicas":      int64(1),
+						},
+					},
+				},
+			},
+			desired: []*unstructured.Unstructured{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":       "maya-apiserver",
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "maya-apiserver",
+											"image": "openebs/maya-apiserver:2.6.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDaemonSet,
+						"metadata": map[string]interface{}{
+							"name":       types.OpenEBSNodeSetupDaemonsetNameKey,
+							"namespace":  "openebs",
+							"generation": int64(1),
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           "2.6.0",
+							},
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  types.OpenEBSNodeSetupDaemonsetNameKey,
+											"image": "openebs/" + types.OpenEBSNodeSetupDaemonsetNameKey + ":2.6.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectStage: "Operators",
+			expectDesired: []string{
+				"maya-apiserver_Deployment", types.OpenEBSNodeSetupDaemonsetNameKey + "_DaemonSet",
+			},
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			p := &Planner{
+				ObservedOpenEBS:           &types.OpenEBS{Spec: types.OpenEBSSpec{Version: "2.6.0"}},
+				ObservedOpenEBSComponents: mock.observed,
+			}
+			response := &ReconcileResponse{DesiredOpenEBSComponents: mock.desired}
+			p.stageDesiredComponents(response)
+			var gotStage string
+			var gotPending []string
+			if response.UpgradeStatus != nil {
+				gotStage = response.UpgradeStatus.Stage
+				gotPending = response.UpgradeStatus.PendingPodDeletions
+			}
+			if gotStage != mock.expectStage {
+				t.Fatalf("Expected stage %q got %q", mock.expectStage, gotStage)
+			}
+			if strings.Join(gotPending, ",") != strings.Join(mock.expectPending, ",") {
+				t.Fatalf("Expected pending pod deletions %v got %v", mock.expectPending, gotPending)
+			}
+			// the upgrade is not blocked by the pods which are yet to be deleted
+			if mock.expectPending != nil && isUpgradeBlocked(response) {
+				t.Fatalf("Expected upgrade not to be blocked got %q", response.UpgradeStatus.BlockedBy)
+			}
+			gotDesired := getComponentKeys(response.DesiredOpenEBSComponents)
+			expectDesired := append([]string{}, mock.expectDesired...)
+			sort.Strings(expectDesired)
+			if strings.Join(gotDesired, ",") != strings.Join(expectDesired, ",") {
+				t.Fatalf("Expected desired %v got %v", expectDesired, gotDesired)
+			}
+			// the components of the later stages are given as observed
+			for _, desired := range response.DesiredOpenEBSComponents {
+				if !mock.expectHeldBack[desired.GetName()] {
+					continue
+				}
+				if version := desired.GetLabels()[types.OpenEBSVersionLabelKey]; version != "2.5.0" {
+					t.Fatalf("Expected %s to be held back got version %s", desired.GetName(), version)
+				}
+			}
+		})
+	}
+}
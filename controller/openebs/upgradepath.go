@@ -0,0 +1,203 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"mayadata.io/openebs-upgrade/pkg/catalog"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+// getSupportedVersions returns the OpenEBS versions that can be installed as
+// per the release catalog in the ascending order, these are the versions an
+// upgrade path can go through.
+func getSupportedVersions() []string {
+	// the versions from the catalog are sorted by their names hence the
+	// stable sort keeps the order of the versions which compare equal such
+	// as 1.10.0 and 1.10.0-ee deterministic.
+	versions := catalog.GetVersions()
+	sort.SliceStable(versions, func(i, j int) bool {
+		res, err := compareVersion(versions[i], versions[j])
+		return err == nil && res < 0
+	})
+	return versions
+}
+
+// validateUpgradePath verifies if OpenEBS can be upgraded from the running
+// version to the given version, an error with the reason is returned if not.
+func (p *Planner) validateUpgradePath() error {
+	targetVersion := p.ObservedOpenEBS.Spec.Version
+	runningVersion := p.getRunningVersion()
+	if runningVersion == "" || runningVersion == targetVersion {
+		return nil
+	}
+	if !isSupportedVersion(targetVersion) {
+		// this is reported while getting the release of the version
+		return nil
+	}
+	res, err := compareVersion(targetVersion, runningVersion)
+	if err != nil {
+		return errors.Errorf("Can't compare versions %s and %s: %+v",
+			targetVersion, runningVersion, err)
+	}
+	if res < 0 {
+		return errors.Errorf(
+			"Downgrade of OpenEBS from version %s to version %s is not allowed",
+			runningVersion, targetVersion)
+	}
+	path := getUpgradePath(runningVersion, targetVersion)
+	if len(path) == 0 {
+		return errors.Errorf(
+			"Upgrade of OpenEBS from version %s to version %s is not supported",
+			runningVersion, targetVersion)
+	}
+	// path includes both the running and the target versions
+	if len(path) > 2 {
+		return errors.Errorf(
+			"Upgrade of OpenEBS from version %s to version %s is not allowed directly, "+
+				"upgrade to version %s first: upgrade path is %s",
+			runningVersion, targetVersion, path[1], strings.Join(path, " -> "))
+	}
+	return nil
+}
+
+// getRunningVersion returns the lowest supported version the OpenEBS
+// components are running at as per their openebs.io/version labels. Empty
+// string is returned if the version can't be determined i.e., OpenEBS is not
+// yet installed.
+func (p *Planner) getRunningVersion() string {
+	var runningVersion string
+	for _, component := range p.ObservedOpenEBSComponents {
+		labels := component.GetLabels()
+		if labels[types.OpenEBSUpgradeDAOManagedLabelKey] != types.OpenEBSUpgradeDAOManagedLabelValue {
+			continue
+		}
+		label, exist := labels[types.OpenEBSVersionLabelKey]
+		if !exist {
+			continue
+		}
+		version := getSupportedVersionFromLabel(label)
+		if version == "" {
+			glog.Warningf("Ignoring unknown version %s of %s %s %s while validating upgrade path",
+				label, component.GetKind(), component.GetNamespace(), component.GetName())
+			continue
+		}
+		if runningVersion == "" {
+			runningVersion = version
+			continue
+		}
+		if res, err := compareVersion(version, runningVersion); err == nil && res < 0 {
+			runningVersion = version
+		}
+	}
+	return runningVersion
+}
+
+// getSupportedVersionFromLabel returns the supported version from the given
+// version label which may have the image tag suffix appended to it such as
+// 1.10.0-RC1. The longest matching version is returned so that 1.10.0-ee-RC1
+// is matched to 1.10.0-ee and not 1.10.0.
+func getSupportedVersionFromLabel(label string) string {
+	var matchedVersion string
+	for _, version := range getSupportedVersions() {
+		if label != version && !strings.HasPrefix(label, version+"-") {
+			continue
+		}
+		if len(version) > len(matchedVersion) {
+			matchedVersion = version
+		}
+	}
+	return matchedVersion
+}
+
+// isSupportedVersion returns true if the given version can be installed.
+func isSupportedVersion(version string) bool {
+	_, err := catalog.GetRelease(version)
+	return err == nil
+}
+
+// isDirectUpgradeAllowed returns true if OpenEBS can be upgraded from the
+// given version to the given version without any intermediate versions i.e.,
+// the from version lies within the upgradeFrom range of the release of the
+// to version. Downgrades are never allowed.
+func isDirectUpgradeAllowed(fromVersion, toVersion string) bool {
+	if res, err := compareVersion(toVersion, fromVersion); err != nil || res < 0 {
+		return false
+	}
+	release, err := catalog.GetRelease(toVersion)
+	if err != nil {
+		return false
+	}
+	upgradeFrom := release.UpgradeFrom
+	if upgradeFrom.MinVersion == "" && upgradeFrom.MaxVersion == "" {
+		return false
+	}
+	return isVersionInRange(fromVersion, upgradeFrom.MinVersion, upgradeFrom.MaxVersion)
+}
+
+// isVersionInRange returns true if the given version lies within the given
+// inclusive range, an empty bound is not checked.
+func isVersionInRange(version, min, max string) bool {
+	if min != "" {
+		res, err := compareVersion(version, min)
+		if err != nil || res < 0 {
+			return false
+		}
+	}
+	if max != "" {
+		res, err := compareVersion(version, max)
+		if err != nil || res > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// getUpgradePath returns the shortest sequence of versions, starting with the
+// from version and ending with the to version, through which OpenEBS has to be
+// upgraded. Nil is returned if there is no such path.
+func getUpgradePath(fromVersion, toVersion string) []string {
+	if isDirectUpgradeAllowed(fromVersion, toVersion) {
+		return []string{fromVersion, toVersion}
+	}
+	// breadth first search over the supported versions so that the path
+	// with the least number of hops is found.
+	supportedVersions := getSupportedVersions()
+	previous := map[string]string{fromVersion: ""}
+	queue := []string{fromVersion}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range supportedVersions {
+			if _, visited := previous[next]; visited || !isDirectUpgradeAllowed(current, next) {
+				continue
+			}
+			previous[next] = current
+			if next != toVersion {
+				queue = append(queue, next)
+				continue
+			}
+			var path []string
+			for version := toVersion; version != ""; version = previous[version] {
+				path = append([]string{version}, path...)
+			}
+			return path
+		}
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openebs
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/pkg/catalog"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+// initTestCatalog initializes the release catalog with the releases of the
+// templates.
+func initTestCatalog(t *testing.T) {
+	err := catalog.Init(catalog.Config{})
+	if err != nil {
+		t.Fatalf("Expected no error while initializing catalog got %v", err)
+	}
+}
+
+func TestGetUpgradePath(t *testing.T) {
+	initTestCatalog(t)
+	var tests = map[string]struct {
+		fromVersion string
+		toVersion   string
+		expectPath  string
+	}{
+		"direct upgrade within 1.x": {
+			fromVersion: "1.5.0",
+			toVersion:   "1.12.0",
+			expectPath:  "1.5.0 -> 1.12.0",
+		},
+		"direct upgrade within 2.x": {
+			fromVersion: "2.0.0",
+			toVersion:   "2.9.0",
+			expectPath:  "2.0.0 -> 2.9.0",
+		},
+		"direct upgrade from the last 1.x release to 2.x": {
+			fromVersion: "1.12.0-ee",
+			toVersion:   "2.0.0",
+			expectPath:  "1.12.0-ee -> 2.0.0",
+		},
+		"upgrade from an older 1.x release to 2.x hops through the last 1.x release": {
+			fromVersion: "1.5.0",
+			toVersion:   "2.6.0",
+			expectPath:  "1.5.0 -> 1.12.0 -> 2.6.0",
+		},
+		"downgrade has no path": {
+			fromVersion: "2.6.0",
+			toVersion:   "2.5.0",
+		},
+		"upgrade to an unknown version has no path": {
+			fromVersion: "2.6.0",
+			toVersion:   "3.0.0",
+		},
+		"upgrade from an invalid version has no path": {
+			fromVersion: "invalid",
+			toVersion:   "2.6.0",
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			path := strings.Join(getUpgradePath(mock.fromVersion, mock.toVersion), " -> ")
+			if path != mock.expectPath {
+				t.Fatalf("Expected path %q got %q", mock.expectPath, path)
+			}
+		})
+	}
+}
+
+func TestGetUpgradePathOfCatalogRelease(t *testing.T) {
+	defer func() {
+		k8s.Clientset = nil
+		initTestCatalog(t)
+	}()
+	k8s.Clientset = fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "openebs", Name: "releases"},
+		Data: map[string]string{
+			"release-2.10.0.yaml": "version: 2.10.0\noperatorYAML: openebs-operator-2.9.0.yaml\n" +
+				"upgradeFrom:\n  minVersion: 2.0.0\n",
+			"release-2.11.0.yaml": "version: 2.11.0\noperatorYAML: openebs-operator-2.9.0.yaml\n",
+		},
+	})
+	err := catalog.Init(catalog.Config{ConfigMap: "openebs/releases"})
+	if err != nil {
+		t.Fatalf("Expected no error while initializing catalog got %v", err)
+	}
+	var tests = map[string]struct {
+		fromVersion string
+		toVersion   string
+		expectPath  string
+	}{
+		"direct upgrade to a release of the catalog": {
+			fromVersion: "2.9.0",
+			toVersion:   "2.10.0",
+			expectPath:  "2.9.0 -> 2.10.0",
+		},
+		"upgrade to a release of the catalog hops through the versions it can be upgraded from": {
+			fromVersion: "1.12.0",
+			toVersion:   "2.10.0",
+			expectPath:  "1.12.0 -> 2.0.0 -> 2.10.0",
+		},
+		"release of the catalog without upgradeFrom can't be upgraded to": {
+			fromVersion: "2.10.0",
+			toVersion:   "2.11.0",
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			path := strings.Join(getUpgradePath(mock.fromVersion, mock.toVersion), " -> ")
+			if path != mock.expectPath {
+				t.Fatalf("Expected path %q got %q", mock.expectPath, path)
+			}
+		})
+	}
+}
+
+func TestValidateUpgradePath(t *testing.T) {
+	initTestCatalog(t)
+	var tests = map[string]struct {
+		runningVersions []string
+		targetVersion   string
+		expectErr       string
+	}{
+		"fresh installation": {
+			targetVersion: "2.6.0",
+		},
+		"same version": {
+			runningVersions: []string{"2.6.0"},
+			targetVersion:   "2.6.0",
+		},
+		"direct upgrade": {
+			runningVersions: []string{"2.5.0"},
+			targetVersion:   "2.6.0",
+		},
+		"lowest running version is upgraded from": {
+			runningVersions: []string{"2.6.0", "1.12.0"},
+			targetVersion:   "2.6.0",
+		},
+		"running version with an image tag suffix": {
+			runningVersions: []string{"1.12.0-ee-RC1"},
+			targetVersion:   "2.0.0",
+		},
+		"downgrade": {
+			runningVersions: []string{"2.6.0"},
+			targetVersion:   "2.5.0",
+			expectErr:       "Downgrade of OpenEBS from version 2.6.0 to version 2.5.0 is not allowed",
+		},
+		"upgrade through an intermediate version": {
+			runningVersions: []string{"1.5.0"},
+			targetVersion:   "2.6.0",
+			expectErr:       "upgrade to version 1.12.0 first: upgrade path is 1.5.0 -> 1.12.0 -> 2.6.0",
+		},
+		"unknown running versions are ignored": {
+			runningVersions: []string{"0.9.0"},
+			targetVersion:   "2.6.0",
+		},
+	}
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			var observed []*unstructured.Unstructured
+			for i, version := range mock.runningVersions {
+				observed = append(observed, &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": types.KindDeployment,
+						"metadata": map[string]interface{}{
+							"name":      "deploy-" + strconv.Itoa(i),
+							"namespace": "openebs",
+							"labels": map[string]interface{}{
+								types.OpenEBSUpgradeDAOManagedLabelKey: types.OpenEBSUpgradeDAOManagedLabelValue,
+								types.OpenEBSVersionLabelKey:           version,
+							},
+						},
+					},
+				})
+			}
+			p := &Planner{
+				ObservedOpenEBS:           &types.OpenEBS{Spec: types.OpenEBSSpec{Version: mock.targetVersion}},
+				ObservedOpenEBSComponents: observed,
+			}
+			err := p.validateUpgradePath()
+			if mock.expectErr == "" {
+				if err != nil {
+					t.Fatalf("Expected no error got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), mock.expectErr) {
+				t.Fatalf("Expected error %q got %v", mock.expectErr, err)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ limitations under the License.
 package k8s
 
 import (
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -22,6 +23,10 @@ import (
 // Clientset can be used globally across different packages
 var Clientset kubernetes.Interface
 
+// DynamicClient can be used globally across different packages to
+// access the custom resources
+var DynamicClient dynamic.Interface
+
 // BuildConfig will build the rest config based on the
 // kubeconfig given, if not given it will use the incluster
 // config.
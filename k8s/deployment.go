@@ -0,0 +1,35 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8s
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListDeployments returns the list of deployments across all the namespaces
+// matching the given label selector.
+func ListDeployments(labelSelector string) (*appsv1.DeploymentList, error) {
+	deployments, err := Clientset.AppsV1().Deployments(metav1.NamespaceAll).List(
+		metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return &appsv1.DeploymentList{}, err
+	}
+	return deployments, nil
+}
+
+// UpdateDeployment updates the given deployment.
+func UpdateDeployment(deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
+	return Clientset.AppsV1().Deployments(deployment.Namespace).Update(deployment)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8s
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// eventDedupPeriod is the period within which an identical event is not
+// recorded again against the same object.
+const eventDedupPeriod = 10 * time.Minute
+
+// EventRecorder is used to record the events against OpenEBS and
+// AdoptOpenEBS. Events are not recorded if it is not set.
+var EventRecorder record.EventRecorder
+
+var (
+	// recordedEvents stores the time at which an event was last recorded
+	// against an object, these are scoped by the UID of the object so that
+	// these can be forgotten once the object is deleted.
+	recordedEvents     = make(map[apitypes.UID]map[string]time.Time)
+	recordedEventsLock sync.Mutex
+)
+
+// NewEventRecorder returns an event recorder which records the events
+// with the given component as their source.
+func NewEventRecorder(component string) record.EventRecorder {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartLogging(glog.Infof)
+	broadcaster.StartRecordingToSink(
+		&typedcorev1.EventSinkImpl{Interface: Clientset.CoreV1().Events("")})
+	return broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: component})
+}
+
+// RecordEvent records an event against the given object unless an identical
+// event has already been recorded against it within the dedup period.
+func RecordEvent(object *unstructured.Unstructured, eventType, reason, message string) {
+	if EventRecorder == nil || object == nil {
+		return
+	}
+	uid := object.GetUID()
+	key := eventType + "/" + reason + "/" + message
+	now := time.Now()
+
+	recordedEventsLock.Lock()
+	events, exist := recordedEvents[uid]
+	if !exist {
+		events = make(map[string]time.Time)
+		recordedEvents[uid] = events
+	}
+	for recordedKey, recordedTime := range events {
+		if now.Sub(recordedTime) >= eventDedupPeriod {
+			delete(events, recordedKey)
+		}
+	}
+	if _, exist := events[key]; exist {
+		recordedEventsLock.Unlock()
+		return
+	}
+	events[key] = now
+	recordedEventsLock.Unlock()
+
+	EventRecorder.Event(object, eventType, reason, message)
+}
+
+// ForgetEvents forgets the events recorded against the object with the given
+// UID, this is used once the object is deleted.
+func ForgetEvents(uid apitypes.UID) {
+	recordedEventsLock.Lock()
+	delete(recordedEvents, uid)
+	recordedEventsLock.Unlock()
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8s
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListServices returns the list of services in the given namespace matching
+// the given label selector.
+func ListServices(namespace, labelSelector string) (*v1.ServiceList, error) {
+	services, err := Clientset.CoreV1().Services(namespace).List(
+		metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return &v1.ServiceList{}, err
+	}
+	return services, nil
+}
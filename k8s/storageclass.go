@@ -0,0 +1,28 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8s
+
+import (
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetStorageClasses returns the list of storage classes.
+func GetStorageClasses() (*storagev1.StorageClassList, error) {
+	scs, err := Clientset.StorageV1().StorageClasses().List(metav1.ListOptions{})
+	if err != nil {
+		return &storagev1.StorageClassList{}, err
+	}
+	return scs, nil
+}
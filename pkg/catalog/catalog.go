@@ -0,0 +1,375 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/pkg/templates"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+const (
+	// releaseFilePrefix is the prefix of the files or the ConfigMap keys
+	// having the release descriptors.
+	releaseFilePrefix = "release-"
+
+	// releasesTemplatesDir is the directory of the templates having the
+	// release descriptors.
+	releasesTemplatesDir = "releases"
+
+	// configMapCachePeriod is the period for which the releases read from
+	// the ConfigMap are cached.
+	configMapCachePeriod = 30 * time.Second
+)
+
+// Config is used to initialize the release catalog.
+type Config struct {
+	// Dir is the directory having the release descriptors. The release
+	// descriptors of the templates are used if it is not set.
+	Dir string
+	// ConfigMap is the namespace/name of an optional ConfigMap having
+	// additional release descriptors. The releases of the ConfigMap take
+	// precedence over the releases of the directory.
+	ConfigMap string
+	// CacheDir is the directory where the remote templates are cached once
+	// verified. The remote templates are not cached if it is not set.
+	CacheDir string
+	// HTTPClient is the client used to fetch the remote templates. A client
+	// with a default timeout is used if it is not set.
+	HTTPClient *http.Client
+}
+
+// catalog stores the releases which can be installed.
+type catalog struct {
+	sync.Mutex
+	config             Config
+	configMapNamespace string
+	configMapName      string
+	// dirReleases are the releases read from the directory, these are
+	// read only once.
+	dirReleases map[string]*types.ReleaseDescriptor
+	// configMapReleases are the releases read from the ConfigMap, these
+	// are read again once cached for configMapCachePeriod.
+	configMapReleases map[string]*types.ReleaseDescriptor
+	// configMapFiles are all the entries of the ConfigMap so that the
+	// operator YAMLs can also be shipped in the ConfigMap.
+	configMapFiles    map[string]string
+	configMapReadTime time.Time
+	// fetchMutex serializes fetching the remote templates.
+	fetchMutex sync.Mutex
+}
+
+// releaseCatalog is the catalog used by the controllers, it is set by Init.
+var releaseCatalog *catalog
+
+// Init reads the release descriptors from the catalog directory and sets
+// up the catalog. An error is returned if any of the release descriptors
+// is invalid.
+func Init(config Config) error {
+	c := &catalog{config: config}
+	if config.ConfigMap != "" {
+		parts := strings.Split(config.ConfigMap, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return errors.Errorf(
+				"Invalid release catalog ConfigMap %q: Want namespace/name", config.ConfigMap)
+		}
+		c.configMapNamespace, c.configMapName = parts[0], parts[1]
+	}
+	files, err := readReleaseFiles(config.Dir)
+	if err != nil {
+		return err
+	}
+	c.dirReleases, err = parseReleases(files)
+	if err != nil {
+		return errors.Wrapf(err, "Invalid releases in %s", getReleasesDirName(config.Dir))
+	}
+	for _, release := range c.dirReleases {
+		// the remote templates are verified once these are used so that an
+		// unreachable repository doesn't block the startup.
+		if IsRemote(release) {
+			continue
+		}
+		if _, err := c.readOperatorYAML(release); err != nil {
+			return errors.Wrapf(err, "Invalid release %s", release.Version)
+		}
+	}
+	if len(c.dirReleases) == 0 && config.ConfigMap == "" {
+		return errors.Errorf("No releases found in %s", getReleasesDirName(config.Dir))
+	}
+	releaseCatalog = c
+	return nil
+}
+
+// GetRelease returns the release descriptor of the given OpenEBS version.
+func GetRelease(version string) (*types.ReleaseDescriptor, error) {
+	releases, err := getReleases()
+	if err != nil {
+		return nil, err
+	}
+	release, exist := releases[version]
+	if !exist {
+		return nil, errors.Errorf("Unsupported OpenEBS version provided, version: %s", version)
+	}
+	return release, nil
+}
+
+// GetVersions returns the OpenEBS versions of all the releases in the
+// catalog.
+func GetVersions() []string {
+	releases, err := getReleases()
+	if err != nil {
+		glog.Errorf("Failed to list releases: %+v", err)
+		return nil
+	}
+	var versions []string
+	for version := range releases {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// ReadOperatorYAML returns the operator YAML of the given release. The
+// operator YAML is verified against the digest of the release if it is set.
+func ReadOperatorYAML(release *types.ReleaseDescriptor) ([]byte, error) {
+	if releaseCatalog == nil {
+		return nil, errors.Errorf("Release catalog is not initialized")
+	}
+	var (
+		content []byte
+		err     error
+	)
+	if IsRemote(release) {
+		// the catalog is not locked while fetching so that the releases can
+		// still be looked up.
+		content, err = releaseCatalog.readRemoteFile(release.OperatorYAML, release.OperatorYAMLDigest)
+	} else {
+		releaseCatalog.Lock()
+		content, err = releaseCatalog.readOperatorYAML(release)
+		releaseCatalog.Unlock()
+	}
+	if err != nil {
+		return nil, errors.Errorf(
+			"Error reading YAML file for version %s: %+v", release.Version, err)
+	}
+	return content, nil
+}
+
+// HasFeature returns true if the given feature is available in the given
+// release.
+func HasFeature(release *types.ReleaseDescriptor, feature types.ReleaseFeature) bool {
+	for _, releaseFeature := range release.Features {
+		if releaseFeature == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// getReleases returns the releases of the directory along with the releases
+// of the ConfigMap.
+func getReleases() (map[string]*types.ReleaseDescriptor, error) {
+	if releaseCatalog == nil {
+		return nil, errors.Errorf("Release catalog is not initialized")
+	}
+	c := releaseCatalog
+	c.Lock()
+	defer c.Unlock()
+	if c.configMapName != "" && time.Since(c.configMapReadTime) > configMapCachePeriod {
+		err := c.readConfigMap()
+		if err != nil {
+			// the releases of the directory are still usable
+			glog.Errorf("Failed to read release catalog ConfigMap %s: %+v", c.config.ConfigMap, err)
+		}
+	}
+	releases := make(map[string]*types.ReleaseDescriptor)
+	for version, release := range c.dirReleases {
+		releases[version] = release
+	}
+	for version, release := range c.configMapReleases {
+		releases[version] = release
+	}
+	return releases, nil
+}
+
+// readConfigMap reads the releases from the ConfigMap. The previously read
+// releases are retained if the ConfigMap can't be read.
+//
+// NOTE: The ConfigMap is read again only after the cache period even if it
+// can't be read so that the API server is not flooded.
+func (c *catalog) readConfigMap() error {
+	c.configMapReadTime = time.Now()
+	if k8s.Clientset == nil {
+		return errors.Errorf("Kubernetes client is not yet built")
+	}
+	configMap, err := k8s.Clientset.CoreV1().ConfigMaps(c.configMapNamespace).
+		Get(c.configMapName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	releaseFiles := make(map[string]string)
+	for key, value := range configMap.Data {
+		if strings.HasPrefix(key, releaseFilePrefix) {
+			releaseFiles[key] = value
+		}
+	}
+	releases, err := parseReleases(releaseFiles)
+	if err != nil {
+		return err
+	}
+	// the releases of the ConfigMap may only refer to the operator YAMLs of
+	// the ConfigMap or the templates, not to any other file of the pod
+	for _, release := range releases {
+		if !isRemoteLocation(release.OperatorYAML) && filepath.IsAbs(release.OperatorYAML) {
+			return errors.Errorf(
+				"Invalid release %s: Absolute operatorYAML %s is not allowed in ConfigMap",
+				release.Version, release.OperatorYAML)
+		}
+	}
+	c.configMapReleases = releases
+	c.configMapFiles = configMap.Data
+	return nil
+}
+
+// readOperatorYAML returns the content of the local operator YAML of the
+// given release once it is verified against the digest of the release if
+// it is set.
+func (c *catalog) readOperatorYAML(release *types.ReleaseDescriptor) ([]byte, error) {
+	content, err := c.readFile(release.OperatorYAML)
+	if err != nil {
+		return nil, err
+	}
+	if release.OperatorYAMLDigest != "" {
+		err = verifyDigest(release.OperatorYAML, content, release.OperatorYAMLDigest)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return content, nil
+}
+
+// readFile returns the content of the given file. The file is looked up in
+// the ConfigMap first and then in the templates if the path is relative.
+func (c *catalog) readFile(path string) ([]byte, error) {
+	if content, exist := c.configMapFiles[path]; exist {
+		return []byte(content), nil
+	}
+	if filepath.IsAbs(path) {
+		return ioutil.ReadFile(path)
+	}
+	return templates.ReadFile(filepath.ToSlash(path))
+}
+
+// readReleaseFiles returns the release descriptor files of the given
+// directory keyed by their names. The release descriptors of the templates
+// are returned if the directory is not set.
+func readReleaseFiles(dir string) (map[string]string, error) {
+	files := make(map[string]string)
+	if dir == "" {
+		names, err := templates.Glob(releasesTemplatesDir + "/" + releaseFilePrefix + "*.yaml")
+		if err != nil {
+			return nil, errors.Wrapf(err, "Can't list releases in templates")
+		}
+		for _, name := range names {
+			content, err := templates.ReadFile(name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Can't read release %s", name)
+			}
+			files[path.Base(name)] = string(content)
+		}
+		return files, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, releaseFilePrefix+"*.yaml"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't list releases in %s", dir)
+	}
+	for _, p := range paths {
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Can't read release %s", p)
+		}
+		files[filepath.Base(p)] = string(content)
+	}
+	return files, nil
+}
+
+// getReleasesDirName returns the name of the directory the releases are
+// read from to be used in the error messages.
+func getReleasesDirName(dir string) string {
+	if dir == "" {
+		return "templates " + releasesTemplatesDir
+	}
+	return dir
+}
+
+// parseReleases parses the given release descriptor files keyed by their
+// names and returns the releases keyed by their versions.
+func parseReleases(files map[string]string) (map[string]*types.ReleaseDescriptor, error) {
+	releases := make(map[string]*types.ReleaseDescriptor)
+	for name, content := range files {
+		release := &types.ReleaseDescriptor{}
+		if err := yaml.Unmarshal([]byte(content), release); err != nil {
+			return nil, errors.Wrapf(err, "Can't parse release %s", name)
+		}
+		if release.Version == "" {
+			return nil, errors.Errorf("Invalid release %s: Missing version", name)
+		}
+		if release.OperatorYAML == "" {
+			return nil, errors.Errorf("Invalid release %s: Missing operatorYAML", name)
+		}
+		if isOutsideTemplates(release.OperatorYAML) {
+			return nil, errors.Errorf(
+				"Invalid release %s: operatorYAML %s is outside the templates directory",
+				name, release.OperatorYAML)
+		}
+		if release.OperatorYAMLDigest == "" && isRemoteLocation(release.OperatorYAML) {
+			return nil, errors.Errorf(
+				"Invalid release %s: Missing operatorYAMLDigest of remote operatorYAML %s",
+				name, release.OperatorYAML)
+		}
+		if release.OperatorYAMLDigest != "" {
+			if _, err := parseDigest(release.OperatorYAMLDigest); err != nil {
+				return nil, errors.Wrapf(err, "Invalid release %s", name)
+			}
+		}
+		if _, exist := releases[release.Version]; exist {
+			return nil, errors.Errorf("Invalid release %s: Duplicate version %s", name, release.Version)
+		}
+		releases[release.Version] = release
+	}
+	return releases, nil
+}
+
+// isOutsideTemplates returns true if the given relative path of a local
+// operator YAML leaves the templates directory.
+func isOutsideTemplates(location string) bool {
+	if isRemoteLocation(location) || filepath.IsAbs(location) {
+		return false
+	}
+	cleaned := path.Clean(filepath.ToSlash(location))
+	return cleaned == ".." || strings.HasPrefix(cleaned, "../")
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"mayadata.io/openebs-upgrade/k8s"
+)
+
+func TestParseReleases(t *testing.T) {
+	var tests = map[string]struct {
+		files          map[string]string
+		expectVersions string
+		errContains    string
+	}{
+		"valid releases": {
+			files: map[string]string{
+				"release-2.5.0.yaml":    "version: 2.5.0\noperatorYAML: openebs-operator-2.5.0.yaml\n",
+				"release-2.0.0-ee.yaml": "version: 2.0.0-ee\noperatorYAML: openebs-operator-2.0.0-ee.yaml\nfeatures:\n- Mayastor\n",
+			},
+			expectVersions: "2.0.0-ee,2.5.0",
+		},
+		"malformed yaml": {
+			files: map[string]string{
+				"release-2.5.0.yaml": "version: [2.5.0\n",
+			},
+			errContains: "Can't parse release release-2.5.0.yaml",
+		},
+		"missing version": {
+			files: map[string]string{
+				"release-2.5.0.yaml": "operatorYAML: openebs-operator-2.5.0.yaml\n",
+			},
+			errContains: "Missing version",
+		},
+		"missing operator yaml": {
+			files: map[string]string{
+				"release-2.5.0.yaml": "version: 2.5.0\n",
+			},
+			errContains: "Missing operatorYAML",
+		},
+		"remote operator yaml without digest": {
+			files: map[string]string{
+				"release-2.5.0.yaml": "version: 2.5.0\noperatorYAML: https://example.com/openebs-operator.yaml\n",
+			},
+			errContains: "Missing operatorYAMLDigest",
+		},
+		"invalid digest": {
+			files: map[string]string{
+				"release-2.5.0.yaml": "version: 2.5.0\noperatorYAML: openebs-operator-2.5.0.yaml\noperatorYAMLDigest: md5:abc\n",
+			},
+			errContains: "Invalid release release-2.5.0.yaml",
+		},
+		"operator yaml outside the templates directory": {
+			files: map[string]string{
+				"release-2.5.0.yaml": "version: 2.5.0\noperatorYAML: releases/../../openebs-operator-2.5.0.yaml\n",
+			},
+			errContains: "is outside the templates directory",
+		},
+		"duplicate versions": {
+			files: map[string]string{
+				"release-2.5.0.yaml":   "version: 2.5.0\noperatorYAML: openebs-operator-2.5.0.yaml\n",
+				"release-2.5.0-1.yaml": "version: 2.5.0\noperatorYAML: openebs-operator-2.5.0.yaml\n",
+			},
+			errContains: "Duplicate version 2.5.0",
+		},
+	}
+	for name, mock := range tests {
+		name, mock := name, mock
+		t.Run(name, func(t *testing.T) {
+			releases, err := parseReleases(mock.files)
+			if mock.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), mock.errContains) {
+					t.Fatalf("Expected error containing %q, got %v", mock.errContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			var versions []string
+			for version := range releases {
+				versions = append(versions, version)
+			}
+			sort.Strings(versions)
+			if got := strings.Join(versions, ","); got != mock.expectVersions {
+				t.Fatalf("Expected versions %s, got %s", mock.expectVersions, got)
+			}
+		})
+	}
+}
+
+func TestInit(t *testing.T) {
+	var tests = map[string]struct {
+		releases    map[string]string
+		configMap   string
+		errContains string
+	}{
+		"release with a local operator yaml and matching digest": {
+			releases: map[string]string{
+				"release-2.5.0.yaml": "version: 2.5.0\noperatorYAML: {{dir}}/operator.yaml\n" +
+					"operatorYAMLDigest: " + getTestDigest(testOperatorYAML) + "\n",
+			},
+		},
+		"release with a local operator yaml and mismatching digest": {
+			releases: map[string]string{
+				"release-2.5.0.yaml": "version: 2.5.0\noperatorYAML: {{dir}}/operator.yaml\n" +
+					"operatorYAMLDigest: " + getTestDigest("kind: Namespace") + "\n",
+			},
+			errContains: "Invalid release 2.5.0",
+		},
+		"release with a missing operator yaml": {
+			releases: map[string]string{
+				"release-2.5.0.yaml": "version: 2.5.0\noperatorYAML: {{dir}}/missing.yaml\n",
+			},
+			errContains: "Invalid release 2.5.0",
+		},
+		"malformed release": {
+			releases: map[string]string{
+				"release-2.5.0.yaml": "version: 2.5.0\noperatorYAML: [\n",
+			},
+			errContains: "Can't parse release",
+		},
+		"no releases": {
+			errContains: "No releases found",
+		},
+		"no releases but a ConfigMap": {
+			configMap: "openebs/releases",
+		},
+		"invalid ConfigMap": {
+			configMap:   "releases",
+			errContains: "Want namespace/name",
+		},
+	}
+	for name, mock := range tests {
+		name, mock := name, mock
+		t.Run(name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "catalog")
+			if err != nil {
+				t.Fatalf("Failed to create temp dir: %v", err)
+			}
+			defer os.RemoveAll(dir)
+			err = ioutil.WriteFile(filepath.Join(dir, "operator.yaml"), []byte(testOperatorYAML), 0644)
+			if err != nil {
+				t.Fatalf("Failed to write operator YAML: %v", err)
+			}
+			for fileName, content := range mock.releases {
+				content = strings.Replace(content, "{{dir}}", dir, -1)
+				err = ioutil.WriteFile(filepath.Join(dir, fileName), []byte(content), 0644)
+				if err != nil {
+					t.Fatalf("Failed to write release: %v", err)
+				}
+			}
+			err = Init(Config{Dir: dir, ConfigMap: mock.configMap})
+			if mock.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), mock.errContains) {
+					t.Fatalf("Expected error containing %q, got %v", mock.errContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestInitWithTemplates(t *testing.T) {
+	err := Init(Config{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	release, err := GetRelease("2.5.0")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if release.OperatorYAML != "openebs-operator-2.5.0.yaml" {
+		t.Fatalf("Expected operator YAML openebs-operator-2.5.0.yaml, got %s", release.OperatorYAML)
+	}
+	_, err = GetRelease("0.9.0")
+	if err == nil || !strings.Contains(err.Error(), "Unsupported OpenEBS version") {
+		t.Fatalf("Expected unsupported version error, got %v", err)
+	}
+}
+
+func TestConfigMapReleases(t *testing.T) {
+	var tests = map[string]struct {
+		data               map[string]string
+		noConfigMap        bool
+		version            string
+		expectOperatorYAML string
+		errContains        string
+	}{
+		"release of the ConfigMap with its operator yaml": {
+			data: map[string]string{
+				"release-2.6.0.yaml": "version: 2.6.0\noperatorYAML: operator-2.6.0.yaml\n" +
+					"operatorYAMLDigest: " + getTestDigest(testOperatorYAML) + "\n",
+				"operator-2.6.0.yaml": testOperatorYAML,
+			},
+			version:            "2.6.0",
+			expectOperatorYAML: testOperatorYAML,
+		},
+		"release of the ConfigMap takes precedence over the directory": {
+			data: map[string]string{
+				"release-2.5.0.yaml":  "version: 2.5.0\noperatorYAML: operator-2.5.0.yaml\n",
+				"operator-2.5.0.yaml": "kind: Namespace\n",
+			},
+			version:            "2.5.0",
+			expectOperatorYAML: "kind: Namespace\n",
+		},
+		"operator yaml of the ConfigMap with mismatching digest": {
+			data: map[string]string{
+				"release-2.6.0.yaml": "version: 2.6.0\noperatorYAML: operator-2.6.0.yaml\n" +
+					"operatorYAMLDigest: " + getTestDigest("kind: Namespace") + "\n",
+				"operator-2.6.0.yaml": testOperatorYAML,
+			},
+			version:     "2.6.0",
+			errContains: "Checksum mismatch",
+		},
+		"malformed release of the ConfigMap is ignored": {
+			data: map[string]string{
+				"release-2.6.0.yaml": "version: [2.6.0\n",
+			},
+			version:     "2.6.0",
+			errContains: "Unsupported OpenEBS version",
+		},
+		"release of the ConfigMap with absolute operator yaml is ignored": {
+			data: map[string]string{
+				"release-2.6.0.yaml": "version: 2.6.0\noperatorYAML: /etc/passwd\n",
+			},
+			version:     "2.6.0",
+			errContains: "Unsupported OpenEBS version",
+		},
+		"release of the ConfigMap with operator yaml outside the templates is ignored": {
+			data: map[string]string{
+				"release-2.6.0.yaml": "version: 2.6.0\noperatorYAML: ../../etc/passwd\n",
+			},
+			version:     "2.6.0",
+			errContains: "Unsupported OpenEBS version",
+		},
+		"missing ConfigMap keeps the releases of the directory": {
+			noConfigMap:        true,
+			version:            "2.5.0",
+			expectOperatorYAML: testOperatorYAML,
+		},
+	}
+	defer func() { k8s.Clientset = nil }()
+	for name, mock := range tests {
+		name, mock := name, mock
+		t.Run(name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "catalog")
+			if err != nil {
+				t.Fatalf("Failed to create temp dir: %v", err)
+			}
+			defer os.RemoveAll(dir)
+			operatorYAML := filepath.Join(dir, "operator.yaml")
+			err = ioutil.WriteFile(operatorYAML, []byte(testOperatorYAML), 0644)
+			if err != nil {
+				t.Fatalf("Failed to write operator YAML: %v", err)
+			}
+			err = ioutil.WriteFile(filepath.Join(dir, "release-2.5.0.yaml"),
+				[]byte("version: 2.5.0\noperatorYAML: "+operatorYAML+"\n"), 0644)
+			if err != nil {
+				t.Fatalf("Failed to write release: %v", err)
+			}
+			if mock.noConfigMap {
+				k8s.Clientset = fake.NewSimpleClientset()
+			} else {
+				k8s.Clientset = fake.NewSimpleClientset(&v1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "openebs", Name: "releases"},
+					Data:       mock.data,
+				})
+			}
+			err = Init(Config{Dir: dir, ConfigMap: "openebs/releases"})
+			if err != nil {
+				t.Fatalf("Failed to init catalog: %v", err)
+			}
+
+			release, err := GetRelease(mock.version)
+			if err == nil {
+				var content []byte
+				content, err = ReadOperatorYAML(release)
+				if err == nil && string(content) != mock.expectOperatorYAML {
+					t.Fatalf("Expected operator YAML %q, got %q", mock.expectOperatorYAML, content)
+				}
+			}
+			if mock.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), mock.errContains) {
+					t.Fatalf("Expected error containing %q, got %v", mock.errContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
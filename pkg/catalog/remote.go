@@ -0,0 +1,366 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+const (
+	// digestAlgorithmSHA256 is the only supported digest algorithm of the
+	// templates.
+	digestAlgorithmSHA256 = "sha256"
+
+	// ociReferencePrefix is the prefix of the templates which are fetched
+	// as OCI artifacts.
+	ociReferencePrefix = "oci://"
+
+	// maxRemoteTemplateSize is the maximum size of a remote template in
+	// bytes so that a wrong URL doesn't exhaust the memory.
+	maxRemoteTemplateSize = 32 << 20
+
+	// remoteFetchTimeout is the timeout of every request made to fetch a
+	// remote template.
+	remoteFetchTimeout = 30 * time.Second
+
+	// ociTitleAnnotation is the annotation of an OCI artifact layer having
+	// its file name.
+	ociTitleAnnotation = "org.opencontainers.image.title"
+)
+
+// ociManifestMediaTypes are the manifest media types accepted while
+// fetching an OCI artifact.
+var ociManifestMediaTypes = []string{
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// ociManifest is the part of an OCI image manifest used to find the layer
+// having the template.
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+// ociDescriptor describes a layer of an OCI artifact.
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// IsRemote returns true if the operator YAML of the given release is
+// fetched from a remote repository.
+func IsRemote(release *types.ReleaseDescriptor) bool {
+	return isRemoteLocation(release.OperatorYAML)
+}
+
+// isRemoteLocation returns true if the given template location is an
+// http(s) URL or an OCI artifact reference.
+func isRemoteLocation(location string) bool {
+	return strings.HasPrefix(location, "http://") ||
+		strings.HasPrefix(location, "https://") ||
+		strings.HasPrefix(location, ociReferencePrefix)
+}
+
+// parseDigest returns the hex encoded SHA-256 sum of the given digest of
+// the form sha256:<hex>.
+func parseDigest(digest string) (string, error) {
+	parts := strings.SplitN(digest, ":", 2)
+	if len(parts) != 2 || parts[0] != digestAlgorithmSHA256 {
+		return "", errors.Errorf("Invalid digest %q: Want %s:<hex>", digest, digestAlgorithmSHA256)
+	}
+	sum := strings.ToLower(parts[1])
+	if decoded, err := hex.DecodeString(sum); err != nil || len(decoded) != sha256.Size {
+		return "", errors.Errorf("Invalid digest %q: Want %d hex encoded bytes", digest, sha256.Size)
+	}
+	return sum, nil
+}
+
+// verifyDigest returns an error if the SHA-256 sum of the given content
+// doesn't match the given digest.
+func verifyDigest(location string, content []byte, digest string) error {
+	expectedSum, err := parseDigest(digest)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(content)
+	foundSum := hex.EncodeToString(sum[:])
+	if foundSum != expectedSum {
+		return errors.Errorf(
+			"Checksum mismatch of template %s: Expected digest %s:%s, found %s:%s",
+			location, digestAlgorithmSHA256, expectedSum, digestAlgorithmSHA256, foundSum)
+	}
+	return nil
+}
+
+// readRemoteFile returns the content of the given remote template once it
+// is verified against the given digest. The verified templates are cached
+// in the cache directory keyed by their digests so that these are fetched
+// only once.
+func (c *catalog) readRemoteFile(location, digest string) ([]byte, error) {
+	sum, err := parseDigest(digest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't read template %s", location)
+	}
+	// the templates are fetched one at a time so that the same template is
+	// not fetched again by a concurrent reconcile.
+	c.fetchMutex.Lock()
+	defer c.fetchMutex.Unlock()
+
+	var cachePath string
+	if c.config.CacheDir != "" {
+		cachePath = filepath.Join(c.config.CacheDir, digestAlgorithmSHA256, sum)
+		content, err := ioutil.ReadFile(cachePath)
+		if err == nil {
+			if verifyDigest(location, content, digest) == nil {
+				return content, nil
+			}
+			// the cached template is corrupt, it is fetched again
+			glog.Warningf("Discarding corrupt cached template %s of %s", cachePath, location)
+			os.Remove(cachePath)
+		} else if !os.IsNotExist(err) {
+			glog.Warningf("Failed to read cached template %s of %s: %v", cachePath, location, err)
+		}
+	}
+
+	glog.Infof("Fetching template %s", location)
+	var content []byte
+	if strings.HasPrefix(location, ociReferencePrefix) {
+		content, err = c.fetchOCIArtifact(location)
+	} else {
+		content, err = c.fetchURL(location, "")
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't fetch template %s", location)
+	}
+	err = verifyDigest(location, content, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	if cachePath != "" {
+		// failing to cache is not an error since the template has already
+		// been fetched.
+		if err := writeFileAtomically(cachePath, content); err != nil {
+			glog.Warningf("Failed to cache template %s at %s: %v", location, cachePath, err)
+		}
+	}
+	return content, nil
+}
+
+// fetchURL returns the body of the given URL. The given media types are
+// sent as the accepted media types if set.
+func (c *catalog) fetchURL(location, accept string) ([]byte, error) {
+	request, err := http.NewRequest(http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	response, err := c.getHTTPClient().Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	// registries ask for a token even for the anonymous pulls
+	if response.StatusCode == http.StatusUnauthorized {
+		token, err := c.getBearerToken(response.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+		response.Body.Close()
+		response, err = c.getHTTPClient().Do(request)
+		if err != nil {
+			return nil, err
+		}
+		defer response.Body.Close()
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("GET %s: Unexpected status %s", location, response.Status)
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(response.Body, maxRemoteTemplateSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxRemoteTemplateSize {
+		return nil, errors.Errorf("GET %s: Response exceeds %d bytes", location, maxRemoteTemplateSize)
+	}
+	return content, nil
+}
+
+// getBearerToken returns an anonymous token as per the given bearer
+// challenge of a registry.
+func (c *catalog) getBearerToken(challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", errors.Errorf("Unauthorized: Unsupported challenge %q", challenge)
+	}
+	params := make(map[string]string)
+	for _, param := range strings.Split(strings.TrimPrefix(challenge, "Bearer "), ",") {
+		parts := strings.SplitN(strings.TrimSpace(param), "=", 2)
+		if len(parts) == 2 {
+			params[parts[0]] = strings.Trim(parts[1], `"`)
+		}
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", errors.Errorf("Unauthorized: Invalid realm in challenge %q", challenge)
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+	response, err := c.getHTTPClient().Get(realm.String())
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", errors.Errorf("GET %s: Unexpected status %s", realm.String(), response.Status)
+	}
+	var tokenResponse struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	err = json.NewDecoder(io.LimitReader(response.Body, maxRemoteTemplateSize)).Decode(&tokenResponse)
+	if err != nil {
+		return "", errors.Wrapf(err, "Can't decode token from %s", realm.String())
+	}
+	if tokenResponse.Token != "" {
+		return tokenResponse.Token, nil
+	}
+	if tokenResponse.AccessToken != "" {
+		return tokenResponse.AccessToken, nil
+	}
+	return "", errors.Errorf("No token found in the response of %s", realm.String())
+}
+
+// fetchOCIArtifact returns the template stored in the given OCI artifact
+// reference of the form oci://registry/repository:tag or
+// oci://registry/repository@sha256:<hex>. The artifact must have either a
+// single layer or a layer titled as a YAML file.
+func (c *catalog) fetchOCIArtifact(reference string) ([]byte, error) {
+	registry, repository, tagOrDigest, err := parseOCIReference(reference)
+	if err != nil {
+		return nil, err
+	}
+	baseURL := "https://" + registry + "/v2/" + repository
+	manifestJSON, err := c.fetchURL(
+		baseURL+"/manifests/"+tagOrDigest, strings.Join(ociManifestMediaTypes, ", "))
+	if err != nil {
+		return nil, err
+	}
+	var manifest ociManifest
+	err = json.Unmarshal(manifestJSON, &manifest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't decode manifest of %s", reference)
+	}
+	var layer *ociDescriptor
+	if len(manifest.Layers) == 1 {
+		layer = &manifest.Layers[0]
+	} else {
+		for i := range manifest.Layers {
+			if strings.HasSuffix(manifest.Layers[i].Annotations[ociTitleAnnotation], ".yaml") {
+				layer = &manifest.Layers[i]
+				break
+			}
+		}
+	}
+	if layer == nil {
+		return nil, errors.Errorf(
+			"No YAML layer found in %s: Want a single layer or a layer titled *.yaml", reference)
+	}
+	content, err := c.fetchURL(baseURL+"/blobs/"+layer.Digest, "")
+	if err != nil {
+		return nil, err
+	}
+	// the blob is verified against the layer digest as well so that a
+	// broken registry is reported as such instead of a checksum mismatch
+	// of the template.
+	if err := verifyDigest(reference, content, layer.Digest); err != nil {
+		return nil, errors.Wrapf(err, "Invalid layer %s", layer.Digest)
+	}
+	return content, nil
+}
+
+// parseOCIReference returns the registry, the repository and the tag or
+// the digest of the given OCI artifact reference.
+func parseOCIReference(reference string) (string, string, string, error) {
+	trimmed := strings.TrimPrefix(reference, ociReferencePrefix)
+	slash := strings.Index(trimmed, "/")
+	if slash <= 0 {
+		return "", "", "", errors.Errorf(
+			"Invalid OCI reference %q: Want oci://registry/repository:tag", reference)
+	}
+	registry, repository := trimmed[:slash], trimmed[slash+1:]
+	tagOrDigest := "latest"
+	if at := strings.Index(repository, "@"); at != -1 {
+		repository, tagOrDigest = repository[:at], repository[at+1:]
+	} else if colon := strings.LastIndex(repository, ":"); colon > strings.LastIndex(repository, "/") {
+		repository, tagOrDigest = repository[:colon], repository[colon+1:]
+	}
+	if repository == "" || tagOrDigest == "" {
+		return "", "", "", errors.Errorf(
+			"Invalid OCI reference %q: Want oci://registry/repository:tag", reference)
+	}
+	return registry, repository, tagOrDigest, nil
+}
+
+// getHTTPClient returns the client used to fetch the remote templates.
+func (c *catalog) getHTTPClient() *http.Client {
+	if c.config.HTTPClient != nil {
+		return c.config.HTTPClient
+	}
+	return &http.Client{Timeout: remoteFetchTimeout}
+}
+
+// writeFileAtomically writes the given content to the given path such that
+// a partially written file is never read.
+func writeFileAtomically(path string, content []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	file, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testOperatorYAML = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: openebs
+`
+
+func getTestDigest(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// initTestCatalog initializes the catalog with a single release having the
+// given remote operator YAML and digest.
+func initTestCatalog(t *testing.T, server *httptest.Server, operatorYAML, digest string) string {
+	dir, err := ioutil.TempDir("", "catalog")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	release := fmt.Sprintf(
+		"version: 2.5.0\noperatorYAML: %s\noperatorYAMLDigest: %s\n", operatorYAML, digest)
+	err = ioutil.WriteFile(filepath.Join(dir, "release-2.5.0.yaml"), []byte(release), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write release: %v", err)
+	}
+	err = Init(Config{
+		Dir:        dir,
+		CacheDir:   filepath.Join(dir, "cache"),
+		HTTPClient: server.Client(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to init catalog: %v", err)
+	}
+	return dir
+}
+
+func TestReadOperatorYAMLFromURL(t *testing.T) {
+	var tests = map[string]struct {
+		digest      string
+		isErr       bool
+		errContains string
+	}{
+		"matching digest": {
+			digest: getTestDigest(testOperatorYAML),
+		},
+		"mismatching digest": {
+			digest:      getTestDigest("kind: Namespace"),
+			isErr:       true,
+			errContains: "Checksum mismatch",
+		},
+	}
+	for name, mock := range tests {
+		name, mock := name, mock
+		t.Run(name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewTLSServer(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					requests++
+					fmt.Fprint(w, testOperatorYAML)
+				}))
+			defer server.Close()
+			dir := initTestCatalog(t, server, server.URL+"/openebs-operator.yaml", mock.digest)
+			defer os.RemoveAll(dir)
+
+			release, err := GetRelease("2.5.0")
+			if err != nil {
+				t.Fatalf("Failed to get release: %v", err)
+			}
+			// the second read must be served from the cache
+			for i := 0; i < 2; i++ {
+				content, err := ReadOperatorYAML(release)
+				if mock.isErr {
+					if err == nil || !strings.Contains(err.Error(), mock.errContains) {
+						t.Fatalf("Expected error containing %q, got %v", mock.errContains, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				if string(content) != testOperatorYAML {
+					t.Fatalf("Expected content %q, got %q", testOperatorYAML, content)
+				}
+			}
+			if !mock.isErr && requests != 1 {
+				t.Fatalf("Expected 1 request, got %d", requests)
+			}
+			if mock.isErr && requests != 2 {
+				t.Fatalf("Expected 2 requests, got %d", requests)
+			}
+		})
+	}
+}
+
+func TestReadOperatorYAMLFromOCIArtifact(t *testing.T) {
+	layerDigest := getTestDigest(testOperatorYAML)
+	manifest := fmt.Sprintf(`{
+  "schemaVersion": 2,
+  "layers": [
+    {
+      "mediaType": "application/vnd.oci.image.layer.v1.tar",
+      "digest": "%s"
+    },
+    {
+      "mediaType": "application/yaml",
+      "digest": "%s",
+      "annotations": {"org.opencontainers.image.title": "openebs-operator.yaml"}
+    }
+  ]
+}`, getTestDigest("{}"), layerDigest)
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/token":
+				fmt.Fprint(w, `{"token": "anonymous"}`)
+			case r.Header.Get("Authorization") != "Bearer anonymous":
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(
+					`Bearer realm="https://%s/token",service="registry",scope="repository:openebs/operator:pull"`,
+					r.Host))
+				w.WriteHeader(http.StatusUnauthorized)
+			case r.URL.Path == "/v2/openebs/operator/manifests/2.5.0-hotfix1":
+				fmt.Fprint(w, manifest)
+			case r.URL.Path == "/v2/openebs/operator/blobs/"+layerDigest:
+				fmt.Fprint(w, testOperatorYAML)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	defer server.Close()
+	reference := "oci://" + strings.TrimPrefix(server.URL, "https://") + "/openebs/operator:2.5.0-hotfix1"
+	dir := initTestCatalog(t, server, reference, layerDigest)
+	defer os.RemoveAll(dir)
+
+	release, err := GetRelease("2.5.0")
+	if err != nil {
+		t.Fatalf("Failed to get release: %v", err)
+	}
+	content, err := ReadOperatorYAML(release)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(content) != testOperatorYAML {
+		t.Fatalf("Expected content %q, got %q", testOperatorYAML, content)
+	}
+}
+
+func TestParseOCIReference(t *testing.T) {
+	var tests = map[string]struct {
+		reference   string
+		registry    string
+		repository  string
+		tagOrDigest string
+		isErr       bool
+	}{
+		"reference with tag": {
+			reference:   "oci://quay.io/mayadata/openebs-operator:2.5.0",
+			registry:    "quay.io",
+			repository:  "mayadata/openebs-operator",
+			tagOrDigest: "2.5.0",
+		},
+		"reference with registry port and no tag": {
+			reference:   "oci://localhost:5000/openebs-operator",
+			registry:    "localhost:5000",
+			repository:  "openebs-operator",
+			tagOrDigest: "latest",
+		},
+		"reference with digest": {
+			reference:   "oci://quay.io/mayadata/openebs-operator@sha256:abcd",
+			registry:    "quay.io",
+			repository:  "mayadata/openebs-operator",
+			tagOrDigest: "sha256:abcd",
+		},
+		"reference without repository": {
+			reference: "oci://quay.io",
+			isErr:     true,
+		},
+	}
+	for name, mock := range tests {
+		name, mock := name, mock
+		t.Run(name, func(t *testing.T) {
+			registry, repository, tagOrDigest, err := parseOCIReference(mock.reference)
+			if mock.isErr && err == nil {
+				t.Fatalf("Expected error, got none")
+			}
+			if !mock.isErr && err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if registry != mock.registry || repository != mock.repository ||
+				tagOrDigest != mock.tagOrDigest {
+				t.Fatalf("Expected %s %s %s, got %s %s %s",
+					mock.registry, mock.repository, mock.tagOrDigest,
+					registry, repository, tagOrDigest)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+const (
+	// ReadinessCheckKubernetesClient is the readiness check which passes
+	// once the kubernetes clients are built.
+	ReadinessCheckKubernetesClient = "kubernetes-client"
+	// ReadinessCheckTemplates is the readiness check which passes once the
+	// templates are found to be readable and parseable.
+	ReadinessCheckTemplates = "templates"
+	// ReadinessCheckReleaseCatalog is the readiness check which passes once
+	// the release catalog is found to be valid.
+	ReadinessCheckReleaseCatalog = "release-catalog"
+	// readinessCheckControllers is the readiness check which passes once
+	// the metac controllers have synced.
+	readinessCheckControllers = "controllers"
+)
+
+var (
+	// watchGVRs are the resources watched by the metac controllers.
+	watchGVRs = []schema.GroupVersionResource{
+		{Group: types.GroupDAOMayaDataIO, Version: types.VersionV1Alpha1, Resource: "openebses"},
+		{Group: types.GroupDAOMayaDataIO, Version: types.VersionV1Alpha1, Resource: "adoptopenebses"},
+	}
+
+	lock sync.Mutex
+	// readinessErrors stores the result of the readiness checks which are
+	// evaluated once, every check fails till its result is set.
+	readinessErrors = map[string]error{
+		ReadinessCheckKubernetesClient: errors.Errorf("Kubernetes client is not yet built"),
+		ReadinessCheckTemplates:        errors.Errorf("Templates are not yet validated"),
+		ReadinessCheckReleaseCatalog:   errors.Errorf("Release catalog is not yet read"),
+	}
+	// lastTickTime is the time at which the reconcile loop last invoked
+	// any of the hooks.
+	lastTickTime time.Time
+	// isStandby is true if this replica is waiting to become the leader,
+	// the controllers are not started till then.
+	isStandby bool
+)
+
+// SetReadiness sets the result of the given readiness check, nil error
+// marks the check as passed.
+func SetReadiness(check string, err error) {
+	lock.Lock()
+	defer lock.Unlock()
+	readinessErrors[check] = err
+}
+
+// SetStandby marks this replica as a standby or as the leader.
+func SetStandby(standby bool) {
+	lock.Lock()
+	defer lock.Unlock()
+	isStandby = standby
+}
+
+// Tick records that the reconcile loop is alive. This is invoked by every
+// sync and finalize hook.
+func Tick() {
+	lock.Lock()
+	defer lock.Unlock()
+	lastTickTime = time.Now()
+}
+
+// isStandbyReplica returns true if this replica is waiting to become the
+// leader.
+func isStandbyReplica() bool {
+	lock.Lock()
+	defer lock.Unlock()
+	return isStandby
+}
+
+// getLastTickTime returns the time at which the reconcile loop last ticked,
+// zero time is returned if it has never ticked.
+func getLastTickTime() time.Time {
+	lock.Lock()
+	defer lock.Unlock()
+	return lastTickTime
+}
+
+// ReadyzHandler reports if the operator is ready i.e., the kubernetes
+// client is built, the templates are valid and the metac controllers have
+// synced.
+func ReadyzHandler(w http.ResponseWriter, r *http.Request) {
+	lock.Lock()
+	checkErrors := make(map[string]error)
+	for check, err := range readinessErrors {
+		checkErrors[check] = err
+	}
+	lock.Unlock()
+	// the controllers can be checked only once the clients are built
+	if checkErrors[ReadinessCheckKubernetesClient] == nil {
+		checkErrors[readinessCheckControllers] = checkControllersSynced()
+	}
+	writeCheckResults(w, checkErrors)
+}
+
+// HealthzHandler returns the handler which reports if the operator is live
+// i.e., the reconcile loop has ticked within the given window.
+func HealthzHandler(window time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeCheckResults(w, map[string]error{
+			"reconcile-loop": checkReconcileLoop(window),
+		})
+	}
+}
+
+// checkControllersSynced verifies if the metac controllers have synced.
+//
+// NOTE: metac invokes the hooks only after its informers have synced hence
+// the controllers are considered synced once the reconcile loop ticks or
+// if there is nothing to reconcile. A standby replica has no controllers to
+// sync.
+func checkControllersSynced() error {
+	if isStandbyReplica() || !getLastTickTime().IsZero() {
+		return nil
+	}
+	isPresent, err := isAnyWatchPresent()
+	if err != nil {
+		return err
+	}
+	if isPresent {
+		return errors.Errorf("Waiting for metac controllers to sync")
+	}
+	return nil
+}
+
+// checkReconcileLoop verifies if the reconcile loop has ticked within the
+// given window.
+//
+// NOTE: metac resyncs every watch once its informers are relisted hence the
+// window should be larger than the cache flush interval. The loop is not
+// expected to tick if there is nothing to reconcile.
+func checkReconcileLoop(window time.Duration) error {
+	lastTick := getLastTickTime()
+	if lastTick.IsZero() || time.Since(lastTick) <= window {
+		return nil
+	}
+	isPresent, err := isAnyWatchPresent()
+	if err != nil {
+		// restarting the operator won't help if the watches can't be listed
+		glog.Warningf("Can't verify if reconcile loop is live: %+v", err)
+		return nil
+	}
+	if !isPresent {
+		return nil
+	}
+	return errors.Errorf("Reconcile loop has not ticked since %s",
+		lastTick.UTC().Format(time.RFC3339))
+}
+
+// isAnyWatchPresent returns true if any of the resources watched by the metac
+// controllers i.e., OpenEBS or AdoptOpenEBS is present.
+func isAnyWatchPresent() (bool, error) {
+	if k8s.DynamicClient == nil {
+		return false, errors.Errorf("Kubernetes dynamic client is not yet built")
+	}
+	for _, gvr := range watchGVRs {
+		watches, err := k8s.ListResources(gvr)
+		if err != nil {
+			return false, errors.Wrapf(err, "Can't list %s", gvr.Resource)
+		}
+		if len(watches) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// writeCheckResults writes the result of every check, status code 500 is
+// written if any of the checks has failed.
+func writeCheckResults(w http.ResponseWriter, checkErrors map[string]error) {
+	checks := make([]string, 0, len(checkErrors))
+	for check := range checkErrors {
+		checks = append(checks, check)
+	}
+	sort.Strings(checks)
+	var message string
+	var isFailed bool
+	for _, check := range checks {
+		err := checkErrors[check]
+		if err != nil {
+			isFailed = true
+			message += fmt.Sprintf("[-]%s failed: %v\n", check, err)
+			continue
+		}
+		message += fmt.Sprintf("[+]%s ok\n", check)
+	}
+	if isFailed {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	fmt.Fprint(w, message)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"mayadata.io/openebs-upgrade/k8s"
+	"mayadata.io/openebs-upgrade/types"
+)
+
+func TestCheckReconcileLoop(t *testing.T) {
+	var tests = map[string]struct {
+		lastTick        time.Duration
+		watches         []runtime.Object
+		noDynamicClient bool
+		expectErr       string
+	}{
+		"reconcile loop which has never ticked is live": {
+			watches: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": types.APIVersionDAOMayaDataV1Alpha1,
+						"kind":       "OpenEBS",
+						"metadata": map[string]interface{}{
+							"name":      "openebs",
+							"namespace": "openebs",
+						},
+					},
+				},
+			},
+		},
+		"reconcile loop which has ticked within the window is live": {
+			lastTick: time.Minute,
+			watches: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": types.APIVersionDAOMayaDataV1Alpha1,
+						"kind":       "OpenEBS",
+						"metadata": map[string]interface{}{
+							"name":      "openebs",
+							"namespace": "openebs",
+						},
+					},
+				},
+			},
+		},
+		"reconcile loop which has not ticked within the window is not live": {
+			lastTick: time.Hour,
+			watches: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": types.APIVersionDAOMayaDataV1Alpha1,
+						"kind":       "AdoptOpenEBS",
+						"metadata": map[string]interface{}{
+							"name":      "openebs",
+							"namespace": "openebs",
+						},
+					},
+				},
+			},
+			expectErr: "Reconcile loop has not ticked since",
+		},
+		"reconcile loop without anything to reconcile is live": {
+			lastTick: time.Hour,
+		},
+		"reconcile loop is live if the watches can't be listed": {
+			lastTick:        time.Hour,
+			noDynamicClient: true,
+		},
+	}
+	defer func() {
+		k8s.DynamicClient = nil
+		lastTickTime = time.Time{}
+	}()
+	for name, mock := range tests {
+		name := name
+		mock := mock
+		t.Run(name, func(t *testing.T) {
+			k8s.DynamicClient = nil
+			if !mock.noDynamicClient {
+				k8s.DynamicClient = dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), mock.watches...)
+			}
+			lastTickTime = time.Time{}
+			if mock.lastTick != 0 {
+				lastTickTime = time.Now().Add(-mock.lastTick)
+			}
+
+			err := checkReconcileLoop(30 * time.Minute)
+			if mock.expectErr == "" && err != nil {
+				t.Fatalf("Expected no error got %v", err)
+			}
+			if mock.expectErr != "" && (err == nil || !strings.Contains(err.Error(), mock.expectErr)) {
+				t.Fatalf("Expected error %q got %v", mock.expectErr, err)
+			}
+
+			recorder := httptest.NewRecorder()
+			HealthzHandler(30*time.Minute)(recorder, httptest.NewRequest("GET", "/healthz", nil))
+			expectCode := http.StatusOK
+			if mock.expectErr != "" {
+				expectCode = http.StatusInternalServerError
+			}
+			if recorder.Code != expectCode {
+				t.Fatalf("Expected status code %d got %d: %s", expectCode, recorder.Code, recorder.Body.String())
+			}
+		})
+	}
+}
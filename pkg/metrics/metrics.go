@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// namespace is the prefix of all the metrics exported by
+	// openebs-upgrade.
+	namespace = "openebs_upgrade"
+
+	// ControllerSyncOpenEBS is the name of the controller which reconciles
+	// OpenEBS.
+	ControllerSyncOpenEBS = "sync/openebs"
+	// ControllerSyncAdoptOpenEBS is the name of the controller which
+	// reconciles AdoptOpenEBS.
+	ControllerSyncAdoptOpenEBS = "sync/adoptopenebs"
+)
+
+var (
+	reconcileTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reconcile_total",
+			Help:      "Total number of reconciliations per controller.",
+		},
+		[]string{"controller"},
+	)
+	reconcileErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reconcile_errors_total",
+			Help:      "Total number of failed reconciliations per controller.",
+		},
+		[]string{"controller"},
+	)
+	reconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "reconcile_duration_seconds",
+			Help:      "Time taken to reconcile per controller.",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{"controller"},
+	)
+
+	desiredVersionDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "openebs_desired_version_info"),
+		"OpenEBS version as per the spec, the value is always 1.",
+		[]string{"namespace", "name", "version"}, nil,
+	)
+	runningVersionDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "openebs_running_version_info"),
+		"Lowest OpenEBS version the components are running at, the value is always 1.",
+		[]string{"namespace", "name", "version"}, nil,
+	)
+	managedComponentsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "openebs_managed_components"),
+		"Number of OpenEBS components managed per kind.",
+		[]string{"namespace", "name", "kind"}, nil,
+	)
+	componentReadyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "openebs_component_ready"),
+		"Readiness of an OpenEBS component, 1 if all its replicas are ready and 0 otherwise.",
+		[]string{"namespace", "name", "kind", "component"}, nil,
+	)
+)
+
+// ComponentReadiness is the readiness of an OpenEBS component.
+type ComponentReadiness struct {
+	Kind      string
+	Component string
+	IsReady   bool
+}
+
+// OpenEBSState is the state of an OpenEBS installation as observed in
+// its last successful reconciliation.
+type OpenEBSState struct {
+	DesiredVersion    string
+	RunningVersion    string
+	ManagedComponents map[string]int
+	Components        []ComponentReadiness
+}
+
+// openebsCollector exports the last observed state of every OpenEBS
+// installation. A collector is used instead of gauges so that the metrics
+// of the older versions, removed components, etc. are not exported once
+// the state changes.
+type openebsCollector struct {
+	sync.Mutex
+	// states are keyed by namespace/name of OpenEBS
+	states map[string]openebsStateEntry
+}
+
+type openebsStateEntry struct {
+	namespace string
+	name      string
+	state     OpenEBSState
+}
+
+var collector = &openebsCollector{
+	states: make(map[string]openebsStateEntry),
+}
+
+func init() {
+	prometheus.MustRegister(
+		reconcileTotal,
+		reconcileErrorsTotal,
+		reconcileDuration,
+		collector,
+	)
+}
+
+// ObserveReconcile records a reconciliation of the given controller which
+// started at the given time.
+func ObserveReconcile(controller string, start time.Time, isFailed bool) {
+	reconcileTotal.WithLabelValues(controller).Inc()
+	reconcileDuration.WithLabelValues(controller).Observe(time.Since(start).Seconds())
+	if isFailed {
+		reconcileErrorsTotal.WithLabelValues(controller).Inc()
+	}
+}
+
+// SetOpenEBSState sets the state of the given OpenEBS to be exported.
+func SetOpenEBSState(namespace, name string, state OpenEBSState) {
+	collector.Lock()
+	defer collector.Unlock()
+	collector.states[namespace+"/"+name] = openebsStateEntry{
+		namespace: namespace,
+		name:      name,
+		state:     state,
+	}
+}
+
+// DeleteOpenEBSState stops exporting the state of the given OpenEBS, this
+// is used once OpenEBS is deleted.
+func DeleteOpenEBSState(namespace, name string) {
+	collector.Lock()
+	defer collector.Unlock()
+	delete(collector.states, namespace+"/"+name)
+}
+
+// Describe implements prometheus.Collector.
+func (c *openebsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- desiredVersionDesc
+	ch <- runningVersionDesc
+	ch <- managedComponentsDesc
+	ch <- componentReadyDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *openebsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.Lock()
+	defer c.Unlock()
+	for _, entry := range c.states {
+		if entry.state.DesiredVersion != "" {
+			ch <- prometheus.MustNewConstMetric(desiredVersionDesc, prometheus.GaugeValue, 1,
+				entry.namespace, entry.name, entry.state.DesiredVersion)
+		}
+		if entry.state.RunningVersion != "" {
+			ch <- prometheus.MustNewConstMetric(runningVersionDesc, prometheus.GaugeValue, 1,
+				entry.namespace, entry.name, entry.state.RunningVersion)
+		}
+		for kind, count := range entry.state.ManagedComponents {
+			ch <- prometheus.MustNewConstMetric(managedComponentsDesc, prometheus.GaugeValue,
+				float64(count), entry.namespace, entry.name, kind)
+		}
+		for _, component := range entry.state.Components {
+			var ready float64
+			if component.IsReady {
+				ready = 1
+			}
+			ch <- prometheus.MustNewConstMetric(componentReadyDesc, prometheus.GaugeValue, ready,
+				entry.namespace, entry.name, component.Kind, component.Component)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestObserveReconcile(t *testing.T) {
+	var tests = map[string]struct {
+		isFailed     bool
+		expectErrors float64
+	}{
+		"successful reconciliation": {},
+		"failed reconciliation": {
+			isFailed:     true,
+			expectErrors: 1,
+		},
+	}
+	for name, mock := range tests {
+		name, mock := name, mock
+		t.Run(name, func(t *testing.T) {
+			controller := "test/" + name
+			ObserveReconcile(controller, time.Now(), mock.isFailed)
+			if total := testutil.ToFloat64(reconcileTotal.WithLabelValues(controller)); total != 1 {
+				t.Fatalf("Expected 1 reconciliation, got %v", total)
+			}
+			errors := testutil.ToFloat64(reconcileErrorsTotal.WithLabelValues(controller))
+			if errors != mock.expectErrors {
+				t.Fatalf("Expected %v failed reconciliations, got %v", mock.expectErrors, errors)
+			}
+		})
+	}
+}
+
+func TestOpenEBSCollector(t *testing.T) {
+	var tests = map[string]struct {
+		state         *OpenEBSState
+		expectMetrics string
+	}{
+		"state of OpenEBS is exported": {
+			state: &OpenEBSState{
+				DesiredVersion:    "2.6.0",
+				RunningVersion:    "2.5.0",
+				ManagedComponents: map[string]int{"Deployment": 2},
+				Components: []ComponentReadiness{
+					{Kind: "Deployment", Component: "maya-apiserver", IsReady: true},
+					{Kind: "Deployment", Component: "openebs-provisioner"},
+				},
+			},
+			expectMetrics: `
+# HELP openebs_upgrade_openebs_component_ready Readiness of an OpenEBS component, 1 if all its replicas are ready and 0 otherwise.
+# TYPE openebs_upgrade_openebs_component_ready gauge
+openebs_upgrade_openebs_component_ready{component="maya-apiserver",kind="Deployment",name="openebs",namespace="openebs"} 1
+openebs_upgrade_openebs_component_ready{component="openebs-provisioner",kind="Deployment",name="openebs",namespace="openebs"} 0
+# HELP openebs_upgrade_openebs_desired_version_info OpenEBS version as per the spec, the value is always 1.
+# TYPE openebs_upgrade_openebs_desired_version_info gauge
+openebs_upgrade_openebs_desired_version_info{name="openebs",namespace="openebs",version="2.6.0"} 1
+# HELP openebs_upgrade_openebs_managed_components Number of OpenEBS components managed per kind.
+# TYPE openebs_upgrade_openebs_managed_components gauge
+openebs_upgrade_openebs_managed_components{kind="Deployment",name="openebs",namespace="openebs"} 2
+# HELP openebs_upgrade_openebs_running_version_info Lowest OpenEBS version the components are running at, the value is always 1.
+# TYPE openebs_upgrade_openebs_running_version_info gauge
+openebs_upgrade_openebs_running_version_info{name="openebs",namespace="openebs",version="2.5.0"} 1
+`,
+		},
+		"versions which are not known are not exported": {
+			state: &OpenEBSState{
+				ManagedComponents: map[string]int{"DaemonSet": 1},
+			},
+			expectMetrics: `
+# HELP openebs_upgrade_openebs_managed_components Number of OpenEBS components managed per kind.
+# TYPE openebs_upgrade_openebs_managed_components gauge
+openebs_upgrade_openebs_managed_components{kind="DaemonSet",name="openebs",namespace="openebs"} 1
+`,
+		},
+		"state of deleted OpenEBS is not exported": {},
+	}
+	defer DeleteOpenEBSState("openebs", "openebs")
+	for name, mock := range tests {
+		name, mock := name, mock
+		t.Run(name, func(t *testing.T) {
+			DeleteOpenEBSState("openebs", "openebs")
+			if mock.state != nil {
+				SetOpenEBSState("openebs", "openebs", *mock.state)
+			}
+			err := testutil.CollectAndCompare(collector, strings.NewReader(mock.expectMetrics))
+			if err != nil {
+				t.Fatalf("Expected matching metrics, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// gen.go generates the Go file having all the YAMLs of the templates
+// directory so that these are bundled into the openebs-upgrade binary.
+// It is run by go generate.
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+var (
+	templatesDir = flag.String("templates", "../../templates", "Directory having the templates")
+	output       = flag.String("output", "zz_generated_templates.go", "Go file to be generated")
+)
+
+func main() {
+	flag.Parse()
+	if err := generate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to generate templates: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate writes the gzip compressed and base64 encoded templates keyed
+// by their paths relative to the templates directory.
+func generate() error {
+	var names []string
+	err := filepath.Walk(*templatesDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".yaml" {
+			return nil
+		}
+		name, err := filepath.Rel(*templatesDir, path)
+		if err != nil {
+			return err
+		}
+		names = append(names, filepath.ToSlash(name))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by gen.go; DO NOT EDIT.
+
+package templates
+
+// bundledTemplates are the gzip compressed and base64 encoded templates keyed
+// by their paths relative to the templates directory.
+var bundledTemplates = map[string]string{
+`)
+	for _, name := range names {
+		content, err := ioutil.ReadFile(filepath.Join(*templatesDir, filepath.FromSlash(name)))
+		if err != nil {
+			return err
+		}
+		var compressed bytes.Buffer
+		// the modification time is not set so that the output only changes
+		// if the templates change.
+		writer, err := gzip.NewWriterLevel(&compressed, gzip.BestCompression)
+		if err != nil {
+			return err
+		}
+		if _, err := writer.Write(content); err != nil {
+			return err
+		}
+		if err := writer.Close(); err != nil {
+			return err
+		}
+		fmt.Fprintf(&buf, "\t%q: %q,\n", name,
+			base64.StdEncoding.EncodeToString(compressed.Bytes()))
+	}
+	buf.WriteString("}\n")
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(*output, source, 0644)
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package templates serves the operator, the ISCSI setup and the release
+// YAMLs from which the OpenEBS components are formed. These templates are
+// bundled into the binary and can be overridden by a templates directory.
+package templates
+
+//go:generate go run gen.go -templates ../../templates -output zz_generated_templates.go
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// overrideDir is the directory whose templates take precedence over
+	// the bundled templates. The bundled templates are used if it is not
+	// set.
+	overrideDir string
+
+	// decodedTemplates caches the bundled templates once these are
+	// decompressed.
+	decodedTemplates = make(map[string][]byte)
+	mutex            sync.Mutex
+)
+
+// SetDir sets the directory whose templates override the bundled
+// templates, a template which is not found in this directory is still
+// read from the bundled templates.
+func SetDir(dir string) {
+	overrideDir = dir
+}
+
+// GetDir returns the directory whose templates override the bundled
+// templates.
+func GetDir() string {
+	return overrideDir
+}
+
+// ReadFile returns the content of the template at the given slash
+// separated path relative to the templates directory.
+func ReadFile(name string) ([]byte, error) {
+	// the templates outside the templates directory are never read
+	cleaned := path.Clean(name)
+	if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return nil, errors.Errorf("Invalid template %s: Not within the templates directory", name)
+	}
+	if overrideDir != "" {
+		content, err := ioutil.ReadFile(filepath.Join(overrideDir, filepath.FromSlash(name)))
+		if err == nil {
+			return content, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, errors.Wrapf(err, "Can't read template %s from %s", name, overrideDir)
+		}
+	}
+	return readBundledFile(name)
+}
+
+// Glob returns the paths, relative to the templates directory, of all the
+// templates matching the given slash separated pattern. The pattern syntax
+// is the same as that of path.Match.
+func Glob(pattern string) ([]string, error) {
+	matched := make(map[string]bool)
+	for name := range bundledTemplates {
+		ok, err := path.Match(pattern, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid template pattern %s", pattern)
+		}
+		if ok {
+			matched[name] = true
+		}
+	}
+	if overrideDir != "" {
+		paths, err := filepath.Glob(filepath.Join(overrideDir, filepath.FromSlash(pattern)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "Can't list templates in %s", overrideDir)
+		}
+		for _, p := range paths {
+			name, err := filepath.Rel(overrideDir, p)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Can't list templates in %s", overrideDir)
+			}
+			matched[filepath.ToSlash(name)] = true
+		}
+	}
+	var names []string
+	for name := range matched {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// readBundledFile returns the decompressed content of the given bundled
+// template.
+func readBundledFile(name string) ([]byte, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	if content, exist := decodedTemplates[name]; exist {
+		return content, nil
+	}
+	encoded, exist := bundledTemplates[name]
+	if !exist {
+		return nil, errors.Errorf("Template %s not found", name)
+	}
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't decode bundled template %s", name)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't decompress bundled template %s", name)
+	}
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't decompress bundled template %s", name)
+	}
+	decodedTemplates[name] = content
+	return content, nil
+}
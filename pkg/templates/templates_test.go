@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templates
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadFile(t *testing.T) {
+	var tests = map[string]struct {
+		name          string
+		noOverrideDir bool
+		expectContent string
+		errContains   string
+	}{
+		"template of the override directory takes precedence": {
+			name:          "openebs-operator-2.6.0.yaml",
+			expectContent: "kind: Namespace\n",
+		},
+		"nested template of the override directory takes precedence": {
+			name:          "releases/release-2.6.0.yaml",
+			expectContent: "version: 2.6.0\n",
+		},
+		"bundled template is read if not found in the override directory": {
+			name:          "openebs-operator-2.5.0.yaml",
+			expectContent: "# Create Maya Service Account",
+		},
+		"bundled template is read without an override directory": {
+			name:          "openebs-operator-2.6.0.yaml",
+			noOverrideDir: true,
+			expectContent: "# Create Maya Service Account",
+		},
+		"template which can't be read from the override directory": {
+			name:        "releases",
+			errContains: "Can't read template releases",
+		},
+		"template outside the templates directory": {
+			name:        "releases/../../openebs-operator-2.6.0.yaml",
+			errContains: "Not within the templates directory",
+		},
+		"absolute template": {
+			name:        "/etc/passwd",
+			errContains: "Not within the templates directory",
+		},
+		"template which is not found": {
+			name:        "openebs-operator-0.9.0.yaml",
+			errContains: "Template openebs-operator-0.9.0.yaml not found",
+		},
+	}
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	err = os.Mkdir(filepath.Join(dir, "releases"), 0755)
+	if err != nil {
+		t.Fatalf("Failed to create releases dir: %v", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "openebs-operator-2.6.0.yaml"), []byte("kind: Namespace\n"), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "releases", "release-2.6.0.yaml"), []byte("version: 2.6.0\n"), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+	defer SetDir("")
+	for name, mock := range tests {
+		name, mock := name, mock
+		t.Run(name, func(t *testing.T) {
+			SetDir(dir)
+			if mock.noOverrideDir {
+				SetDir("")
+			}
+			content, err := ReadFile(mock.name)
+			if mock.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), mock.errContains) {
+					t.Fatalf("Expected error containing %q, got %v", mock.errContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !strings.HasPrefix(string(content), mock.expectContent) {
+				t.Fatalf("Expected content starting with %q, got %q", mock.expectContent, content)
+			}
+		})
+	}
+}
+
+func TestGlob(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	err = os.Mkdir(filepath.Join(dir, "releases"), 0755)
+	if err != nil {
+		t.Fatalf("Failed to create releases dir: %v", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "releases", "release-2.10.0.yaml"), []byte("version: 2.10.0\n"), 0644)
+	if err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+	SetDir(dir)
+	defer SetDir("")
+
+	names, err := Glob("releases/release-2.*.yaml")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	joined := strings.Join(names, ",")
+	for _, name := range []string{"releases/release-2.10.0.yaml", "releases/release-2.9.0.yaml"} {
+		if !strings.Contains(joined, name) {
+			t.Fatalf("Expected %s to be matched, got %v", name, names)
+		}
+	}
+	if strings.Contains(joined, "releases/release-1.12.0.yaml") {
+		t.Fatalf("Expected releases/release-1.12.0.yaml not to be matched, got %v", names)
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package templates
+
+// bundledTemplates are the gzip compressed and base64 encoded templates keyed
+// by their paths relative to the templates directory.
+var bundledTemplates = map[string]string{
+	"iscsi-amazonlinux-setup.yaml":    "H4sIAAAAAAAC/9xWS2/bRhC+81dMWQFJDiStNkULAj6ktosaiOTASnIJAmO1HIoDLXfZ3VklguP/XixJyaQix6mBXrIHPWbn++bx7Us09B6tI6Nz2EyjQrDIIwBtCnTIvkldlcOXCADg558y72y2JJ2h3sBSuCpqJxwyJBfeQEMNloIUlF5LtkJi53B9dfX2Znb1bv725vzy+jSe3I4teZJZY/gu7twDhbf4/AXctv8BlJFCgSKN2pxOpiNr7Vank196E8rKQPyXIIUFCIZJh8lhUrtV3DrdtZ9sRQPP+kgwuX19Ob+YX91BPPnz1eLvm7Or2ezV/Dx+BhfX11F0T31WoVyTXgGVcLk4W1yCVISagRyQdixUiGwsaMNpmnYhqYQPkCBkyDIjJx1lpIlJsLFa1Ji2NvjYZVahbn/sYx7zLUkhkIPGokPNfSBUDr8fqw0f4EuKdgl38MlzWQVp4CvN7mJwW8dYS1bgWLB30NIXL2L4AiuLDST/QGy91qRX8QO1HfZQKIui2EIPS+O9+2diOHmgykOWUNmYYVdZ798pFXQcQveCfaPora93Qnf1JvsWJ55JOUi2w1AXWizbQF1zvifEfV8xgLGHDlkXLCw/ldUF8BHS92ip3P7wi7s0FghIw+00Tacn3XlQmAj6av6Xlf/Y0q+EgyWiHvTZeSnRudIrtT26D560EXaKTQhOT2F60gtzkGEfZ3fSht4NvwujMVqTLnI4M7qk1Uw0UY0s9veHqDFvb5GkvUZ6k2uExBxMgxqXLoqSJImi4RUkmsZlm2nPfS6wNnqBfIS750geiwGgxBKVC0jYWVMymTR1YzRqTh4kdA3KgHOoULKxHUctWFavB6T/lRaAsW6UYOwJB8UBjBN+CjvALvEwKuN4jvzJ2HUObD0O7G8uz0e2jVG+xkHopO922AlJbbzm/VTPILjKBzaAJlgg+4riIMVuyN3qGXN8AwFQYCm84pkpMIeT31++7GdJE58ZzYI02lENVIsV5u2LJf8tPYkOAwVk28JRZnUtwiL8EGdOWmrYZcNXUfxx4Ix6My5gV/T45Bi5AGyE8phD+/YZTDmU3hJvQy34mQ+6a2lDCldYjIS7F28WRHLHszmqYhit7U0n3EE2jwh4iO5bFe3lPSJHR/dJ0DBOr9H6D5eupA1rvRHeYf5rujuE2Ci0gsnoERuWJUrOYW4WssLCq2FP1rjtE7dGYbr2S7QaGdvNVAvHaCMA3xSCccFWMK62HTlvm6CfaV8J71qH6N8BAAms9rotCwAA",
+	"iscsi-ubuntu-setup.yaml":         "H4sIAAAAAAAC/9xW32/bNhB+119x0wy0fZAUbx02CMhDl2RYgNou4rYvRRHQ1EkiTJEseXJjpPnfB0q0I7lOkwXYy/SQH8f7vvvx8UgyIz6idUKrHDbTqGDE8ghA6QIdUmtSV+fwLQIA+PmnrHU2WwmVodrAirk66hYcEiQXrQYjDJZMSChbxckyjr3D1WLx/nq2+DB/f31+eXUaT27HljzJrNZ0F/funqK1+PIV3Hb/A0jNmQQpFCp9OpmOrI2rTie/BBPyWkP8FxMSC2AEkx6Tw6RxVdw53XU/yTIDL0IkmNy+vZxfzBd3EE/+fLP8+/psMZu9mZ/HL+Di6iqK7qnPauRroSoQJVwuz5aXwKVARSAcCOWISR9ZW1Ca0jTtQ4oSPkGCkCHxTDjuRCaUIMFIW8UaTDsbfO4zq1F1f+xjHvMthUQQDoxFh4pCIJQOn45Vmg7wpYh2CffwyUtee2ngO83uYnBbR9hwkuCIUeugoy9exfANKosGki8Q21Ypoar4gdoOe8ikRVZsIcDSeO9+IwhOHqjykMVXNmbYVRb8e6W8jkPoXrAfFM0MJRUStKZghJB82T4VEbaHh4A2qJJejZvSGasrN8zvQrFVl13f0afkdS8GejAG6JB1SczSc1mdBx8h/YhWlNv//USU2oIAoeB2mqbTk/4QKXQEoZr/ZFwem5eaOVghqkGfXcs5Ole2Um6PDs+zpmen2ETA6SlMT4IwBxmGOLvj2fdu+LvQCqO1UEUOZ1qVopoxEzVIbH/psAbz7upJursnmJxhHPNuZHDloihJkiga3lvMGJdtpoH7nGGj1RLpCHfgSB6LASDZCqXzSNhZU6EzrhujFSpKHiR0BrnHOZTISdueo2HE67cD0n9LC0DYGMkIA+GgOIBxws9hB9gl7r9aO5ojfdV2nQPZFgf2d5fnI9tGy7bBQegkdNtPQtLoVtF+KTAwqvOBDcB4C2TfURyk2H98t3vGHD9AABRYslbSTBeYw8nvr1+HVaEEnWlFTCi0oxpEwyrMu2dO/lt6Eh0G8siuhaPMmob5Tfgpzhy3wpDLhk+p+PPAGdVmXMCu6PHJMXIB2DDZYg7dg2mw5JC3VtDW14I3dNBdKzZCYoXFSLh78WZeJHc8m6Mq+q+zveuFO8jmEQEP0aFV0V7eI3L0dF+ZGMYJGq3/cGnFrd/rhrUO81/T3SFEWqJlJLQasWFZIqcc5nrJayxaOezJGrchcaslput2hVYhYTdMDXOENoLwAFiSZYTVtienrfH66e5p8aFziP4ZAECdqAhiCwAA",
+	"openebs-operator-1.10.0-ee.yaml": "H4sIAAAAAAAC/+x9a3MbN5bod/2KU9TsOs6KpOQ8Jpc1niqZUhxNbElXlLM3dyqrgN0giVE30AOgKTN797/fwquf6GaTImV7V6pULHWjDw4ODs4bwCGMOUYSw3u0QjDBfEkCDKdBwFIqD1BCfsFcEEZHsDw5uCc0HLlGrk2MJQqRRKMDAIpiPAKWYIqnoh+jFeqzBHMkGbdvRYKCvMlBv98/OIQzPCMUww2LMMgFkoCiiD0IMN8SRgUwCj//ICBhoRiGOInYKsZUCovSOEqFxFwBKOHMpygYoFQuGCd/aEiD+x/EgLDh8mSKJTrpjD1PIyxUoz6ghLzlLE3ECP7e+7r32wEAAMeCpTzA+iFlIRa9IzC/DBPOPq5suyXm08KHnaA5smmQwlBf/66o4f4d4o84UH8UqFP5czgjFEXkD8z1G46TiASaKgGjkrMoKr8R2IDASwcM0zBhxP4RMDoj8xglFq+A2/b/YFPTgDOqf9966EIiiWdp5DAJEY4Z1X9tDdP98c+USaShRiQmkiM6xzWwERFSNXlAMlh07oLQOcdCmEnSvMeoRFHCQpRKJgLkCL3EXJLA9yZQb2ZqerAgc6oh/jPFQu4IRSEZR3McRMjhmahFIySmcsmiNFavSOx90434ti1FiVgwOcAfJeYURX3bs12IPtzKXxpClR6pBVtDYo6lmcwiOTQXKvmmfkuT0P6WuJchjrDEPvRRQhTKVAkS0YJskArJYvcoVJKMaJFVwQ+qCPrwsuhkGHrwsuLJjw30vq502/u6Bx4ogZqFwY5gMcZDQovi1UeoCCOBm2Ytmy1HnZwSOXUsxXyTFcZEqJnieE6E5OtwWaKIhEgSOn/A0wVj90aWpeZDzXFxKlsa+EeRT2nbMIpTSxm9sYh9uHmnYQ1jLDkJvH10Xd0JC0MieJoobKdpOMfSC8/LjjnOTqQYLf2G0BDkAletBHggcqFfaAV+zcmSRHiOxeDgEG6vzq5GMF7g4B7IDEI8Q2kkAdlPUSQYUIxDAZLBVKl/zHFdq6u+CZ3vSbmLdPoPHEir371WDkA7CPe+Yt9wFuEbPFOA65bKOohunluGqqemSBSUJGKYmWpnmfL30EJ3hxKiLArcYJ8BRGiKI02Zxu8AckEyDFicMIqp7HdpvXR4nwxOjgfHfYwPRIID1Z3AEQ4k46brWLHiuwIuLdhsho8zdkZwonpVwgPPV6YTuUrwCG6wWRz6kTKTCJ1/0Mt5BDSNogMAieMkUg8MsgVaA5RpuAbzTWm5np4AAI6m+vcSY1+u4WkAZRsiQjEvDKDfPgQAEqM5vk6j6JpFJFiN4GJ2yeQ1xwLbxVRoZ+AoghE2jHN4o/IgzE/CuCxgYrDJcLxmXI7gu++/+77QAtNl+YNDuLo+vzx/M7m7uLr7+cOb87vx1eWPF28BUzSNsND4qOXniAWSqT4oDqT69ecfRAXeFAkcAqMgF0SAURYDuFV/qP/mlHEcApkBjhO5GlS+du1Emqjh4RBmjJeRwBzs3MLx4LvBK2D0AfGwgoebF//wKr3CEkUpHkFvuGAxHqbTlMp0OLhPp3hoRtBrodoPk7v3p5Pb85tdEQ2FIcdCfCZUy4bXRLSFlMloODz586vBqx8GJ69+GHwz+uH4h+Mmml2evj+fXJ+OzyHhbElCLLTKzGQusJmhQ+6wARKAaAUepkvCGdXvl4gTRfvKaiiPJuu41MoO5EfO4lHlBcCM4Ci0asv77hrJxSgTc4NsEA1jn5zf/HIxPr87HY+vPlzelinguMWZBI4OCQsBiceOvtL1zmmgJOugLlIbCPH+9NfTu+urMz0pdUbY8dhLve139iuoXsyKi2l8c356e353dv7j6Yd3t3eT26ub07eZzCUCZigS2vSjcJVgev5m4gzFCtyi1wqIhu5BwlgEDySKgDIJUwxGYYeDVvqsQ81HshH0JE9xi2S8uJzcnr57l8EcK6B3k+vTm8n53fXV1TsIcaCn/WGBlbnrxgraKQORIC4w6CGJBUujEKZV+jpvBCs2AQQJ4nrhWD0OhAqJoshYivW5MUMA1NKzJuYUF3o6AjKDnp6qHhBZJnfWyiukNeVAonssAM9mSiEwGq0UwK3m4lCLfazVihrKulleOyUNM20Gu36qxzdn3tUJREAqcAiSWS05DInQb9QnxVmqdDLjLDYh2dPrC6vKBvBmlc2YEhnjmzORTZQFhsMKpBZyjG/OKo07M/mb08n53dnFTXGEGRNoxQ4h4dp+X2m17DiTUVgwISFBclHllX9Xvl8mAQJE9fLGoCJfRPH6jERYDODM0kB3owBlRBguER/mvksrU7ghNMx9EVQLIQwr3Z7evD2/VdA02lOcEUXpBzJb6flSA1foVqBJln2gKCXQktC5/kAskBq2sm4VW02xfMCYmlckxBAgXlUSbGYXs4mYKU1SX5I4YyNNPd01ESXqDY0wWCs/K8PvQEwLeS1N1bp0a/RzIqwWj4qsRradX/4CRJhAhsNNBStU5FYDjFjgW+Fa1WMnc0Osg/r7n6oKVXcyX3+7+OXUAN7JPLkB/40sEUyMgr9WRI8YCnEI05WTEdtQ63PUTyUCPk4evbsan767/uXup6vJ7fXp7U+7nRIXJ3BflQ2ynczPUK2X6HOcJR9pu6wfPaBeJx4YX13e3ly9e3d+c3fx/vTteQP4QrjkH2SJ8jhJt15uzq/fXYxPn6KLRqdrBL1vehtpl47YaiHdJ0LO5QZIF4TjRv0oZbBdN+/fvr/dvK9+PI83H9cvV+8+vD/foktjRGzaqevu6vJC9d6xx7iPP+o4Dt+OoOf/5/rq5vZ8v/39dP7uunsXEaHpx34qSSSa+igJ7/PL0zfvzu9OL0/f/Xp7MZ4AmRUFFAhMQwGIMrqKWSogFWhedQ1Nsl598paxeYThlKJoJUmw1iCu9t7Z/W12Mc5v7m5/vW6ilNMmmErME04EzmLPvYMGEmXY3V1fXL69u7i8Pb/55fRdm4oLbdYOviIUFizl4mUJulJwCeaEhSSARJmIloYCU+kj5KD0ufNGiIBX3y7K794TSuI0Vu9OFgP4laUa0YBRlfk3sR7J4AHj+2ilFKfAUiUd4eT7HxYH7SHKBkoceKOVr75dFEkakSWmWIhrzqa4HOdRZSTlJwABi2NEw+pjgD4MU8GNhhtOCR0q5g9k5Glo47ClNzpVjqIzHKHVBAeMhmIE3xyX2piZyd5+X3zLMQrJFzuOauquWmC1NmXXt3HHLqm7zlmlvlgGWQ6ukGxxHIgSYseVVHMtCWeSBSwawe342j6TiM+xLKVlyom9hkSSwDqffzrT9RSrEVwyih+T7XTCRgdd1debpDz9H7dStcMnj09+NnWyBWafLA3aPIat6AufNCHahtVjsqIO7v0PJdjeDOmadGc9cWeBQwHwp8nd+RDZd/7uWOfu1P9b03cNieLHk243ueIdUm5n+WIH/PLq7Hw/KSidfKMsxLWU22eYBNXZOJeOrOf/yhpQsY0TTHooRxWouky6ONs2VTRjXM2zBmlDwEZqD01ZF7gyUk9aiDIFUgujI+iVEXK2Ri+LtWfBoYix+zTZDZ9+078Zn3Tj1Ro9m9i1MhD7mxpL5YtrzgIshO4nH55WvsomJwJ0pbDxMBSBT76DYIE4CiSuxYstIYGYsHIy5zgBlsoklVVSTRjM0ijS3QaIvpAZeXVCRJfXme+/+qvtUrwcnALH8zRCHPBH1Zmox5g1k2j8LZNhKgnHzg42A10gkXtMpJ7BO6XBgnH4/T9+h1EGDNEVCMlNPB1JEBJxKQyqv5eV4O8VeL8Pvv69GZDCpgoAZkztBzChxT8wZ6CmhamBLBDfi1sjFp6H/cDzUGIh4XczPf0Aev9RMQEGX/d+h9faqNq1w7CF9esqqNdsy2izgX0gOllqaz/cnT3s72prLDeyjR9jAzfh/Qj6flp7OMMp32HSaud62ntt3K6WdM0W/kwMgx0qm86qZk+KZgdqZhslU+GU3w821DHV778sJVPBfodKZn+Gq2UUhzrkS9xnvObN1pivuzRdd2u2bmCybmau1qRrS7jBL17XxRC+fPm6e7eryr9FZvBxcBAx2sX72q3vtWvPayO/a1Ov61kN7kwN1p2tzdTgF+lrVbDfsa+VLyU1myrO1A+JuO/HiKI55sCxsvNDF8I7OIQLWU1Emu2h5A9TC6Y+10twik398oxEmo+9eaCxhvseJS0OHQ3jvun/kVmgAiDX0yGkIV72NbqgdybYKiAOCScx4iszFHhYkGCRFyvb6KgeP0+pAq2hmcGaToR1GyIXARXAZo4c0LeLmHAlDmKYMSA0iNIQa0CKcvij/tswFgicIK4nw3C4OID6hA1MTyP4fwc2XTXFtnPHl324x6tRYdwVR029gPILvUd9VKwgskAERmrdSS8g99IPTBceV6DFiDeAUm/WIFUifWWwTBgqWYr2TdtKJ0xo5s3IXmlUJwK4pipgfDTEMhgumJDiaDhlTPbKKCwxDRn392zedenQssgIej0PEuN3Vx/O3vx6e2SL0yooqII0PwLqzeO7jxhLjoYhXg5n4bH5RXD7C0ex+SWM++aXOOytC/fooxAmWLYLh83jO+ajdbJif7EbB7wDBmZP9cQXjymmJx8ZlMkR6kSUzQMuh9XC/rqqcYafEqeMAooiUHkXTrHEQrcXBwVrcsVSeNDSOCL3GCQzVlRWcKLrJgWLTVfiCARaZT3nkKyZsMSuzhOQlEpuhUe6i8B0oagHcsGE+V6L5zSTX4f64cQygbN3DrO3eDAfZDDynjQmxsjpQ+HUg6F6MVfbonuv3SkWffWsl0FUlAlkZIGqd/AX9X89UX/dDFgR93y+GmCMwAtk04CakpOXWD4wfl8SMW2BthrLtPqBtdZ9FIfffztSjkDFE0R8Xttu21++/rbicmCYYSRTjvtzJD0bXwx34Qf48OHiDFA0Z5zIRez3XJADBkHKOaZSVflSOI2SBTKit/SZwqhf7F687r29vn2DBA5Vd702x/Y0ekCroiMhcJByIldjRiX+KMtjT9zhBuGoWjNssm3v1RQLv0+c2WnFn1h9YDxarSCHTWZL5TuRTs1XHdtzjMIrGq1GzbXOyrhpwY6ndFhr4r5NOAvi7KSEluGphpujpnLmtTL1MvRiyXVRFhfBmK0MGwCqba3wFFdk+hUItabw5dl7ICLfk5XJ7wQJuyxUkzN3nFEFIhLdN3t+wsy2GowWVqqBRTpDFlKhPM2QPeiQgt7HZl14NfRMln02JQOH5aLRbPfagw4KFPbq6F1o+tASu03Cu9n0EIiJ6mSu/hHoHa4lKJ33rNotOz9evHvkvp0J+QN/NV1JLF5W9iApjNpG5ENlcvF/m6p7T47//M2fvz354dW3taKaSYHQNI2nmCtUSoQp4bEWjbZtBsf7KXzVUQjP8x4N497O4vBGqXj0fU3GBy5YUEa2JVrQKvzVstYrpoxmiyYwhveZWzcH+XRr4Qpa8sNXkgEKdMjPPNZcx2ZagainJ+oP1ftLINRsxquJikOQTGsNA6Ovz6RT35n4CqKh3hFtzuE66KKqWodbU1lNQ23RVq0dtKmuSl9XfJwXYa5VbZ17NV/vosJAsdj2xQWVr9dGrPZfSlDrZROcnqxwwIPlZrSDT1omkNuwNaTa3RfXvNl/WW/w73AXgfJNPI+HMk6GGaoivO+rPlfrtcS3bUripKxCZohEKce3C47FgkXhCE7a7FZH+n8/vR3/9OnqIx0an+SYksbjZ3BpgUDCGkyQz+BwmUJ67uZUbYZrouIIevV146/YHb87P738cH33t6s3+9rqtuejM5SL4ca41bkZmx2b0bQ37rPIbzZnN4M4BCLgL5BnOZ/aVma9z6MwMjtBtN/9OESUJCPIP7Qnha4zW9Z+0GK77GSTzloDqHFcm1koLeTZikSf1kRpxslnoVTZ6VFFNt4QLIoEi9hcMiFDzHk9Qvuq8ujVX//15Auo3MkROT17fzGZXFxd3v37+Zufrq5+blNtTev4v221yealIhXSNJeK+CtFqp93rhTZQWXMZ1FsUiHA51XYr7cuJ8vHbW/1A+lUfN7h09355E2dPQLTJ/PUm3F/FJ3hc9v22vecLLTeF69r0iJIO/jnXa/Pu16fd71+Gbte/4cf/fsFHbPzFOcdPdd7P7beu6ION673rn7/ZdV7V7Dfdb33Fj/Fj6DtZ81H48n1WCcux5Pri64fbdZT55+KL1K/Bym7YMXWqesad3ePzVl2BVLJXTF1MhCnwi4aW3qAAyNTBUxxxB6ONBF0XFY3UOXfI/hLEqUcRX8d/EUX2v01c3/0MXUJY1Fg7lURg+rNRpmPcQj6W/0hSAap0NDh5nxyq6pTRjBECRFD28XwL9aSUJ3pRyOowQY4zAyObeAuCzX/AIeAiT4Q/NIp1RAYd1fGHACIgCV4VHjtXDyzzA7BkClDxYk5S8sPN+8akRk6EmtI5ncPeW0/qrRHi8ZqT0gAooAigoSx9TCM312YrQ6MQ0hEEiGT+HIw6r3YThRzARFAGY9RFJlykTGKcTTWtmSGhPLP9OFqPLsFCWJEyQwLqbMI2qowktdyrDpWVp0pOy71KBaMax0uzA2E5gHmmRhllnVXpb7ygZqBZVDyQvtAJMEBKI9BrwwUXXOi1PFY1XdQ27APf5tcXRrjYiAkkqkYLDCK5GJ1QYVENMhqcw33/+R/GWIRcKLvfhrBbanGxoKDwBGg+q3xdRVurobUh1Uuh0M/ZtfNDVqwK4B9LIYhFoQ3YXfmf9mCmQW3NVaZvaxjCITRWxJjIVGclBA7neMSPF29Xo8OfRESmTgKPYvkvYhkUuLAvclk180TCuWLcpd7lcpkQ6msfD5dn597elYWlx+WZIl6p5vZYtKMuJBNIgi1rSLN89BGAJghNkq5ACUoIHI1UBNdliSRPncehz58lGxDsXO53Y4Lzb/azXAelcIwcx2mGFNIFiuhLiyNVoAqPWyG8IzjMv1+zB+04Ko+s4iiJSKRKRXI0d0KF8kkikrIjO0rL0KqNQi1pdPVz1oCbopAskCiTISJfuHr9SJU3uWMWFfT7ouw+twh0hmB/2HqyFbR/s9WRPuW3L9oIu9T69lp3J++WxaHsE+1U+5LP1tu5R7sXISU0NqfEFknk334jy3C7ljDclOv4C4N4IsVXHbb9rP42r/4GuebI/YqxOyU7luUBcXhPIlAK/RoxFrw1HLN4JHHEtTQRPHjZ9OoJmESlY8nzxbSU4gYU/uwfxHj5nTfMiYpjudJZExpZEbKJNnvGTpPKnQUBLONLafGs6TxSBpXZ/UsafYvaW4Mrfcvatyk7lvU8NKA9ihrlnwrAdIcjvsgOkXiFLOYiBsR0IvY3MTaehAwKtLYZOnNLeFIovzggMfGuzaIFupToAyiRaSQw0jPJooiIFJoOJgqUSmeODCWCWT1sMSfTyuWOxfyqgzhFhtnq5+1FpG2Nn58eW4d/EbY7L0E14ffhvT6tGW2fnwec69MCaLdRNupuvaz2y1SqfqzRX97rMDsWPmnCnvuss2Zn2Sb6/O93XVCPN8xnZdWf6Z3THvhX19sfXNq6aSzz+Nq2Ce/yvTmfPLr5dh3WWQR9DfHvUeZMsutLJnlBobMcq92zHIjM2b5xFbMckMjZvl52TDLXZswy+0smGe92KwX7ZXbW6rEBZItejFQJAjTOMnVnQvZs3D/yikf2q700rPpG+NPfJP7Hq4838Ra2Nfl5zvYF2wGtfXpFtXPO55x0fGzz+Oki2Zktzjvom3kjyAafEFnX5hx2JYtGvn5+IvdH3/hX+694nUWLn0AoZYfJmzqjJTx5MLdywRJhCiGjEvF0cGh2YSGhGABUeFiGN8I+Fe4eXM6Bp5qY6bcR1Y4usRc7387ODSHj2tAH/RmWjj5fnD8rdn8hKm8moC5KADCFINkCk8dQ1YpP2D04BDuMac4KqAGbAZkopB3d5APAH5kHJjO05hNuzCL0JKlXB8QeXAIercihERIzsSRCatTjEN3+GjMQrtl71Bd7UGF5Gmgj5RUDfTb1ejg0I6if/KDGwWasiUegdprLUbD4ZzIRTodBCzOzAozTYEgw2nEpsMYCYn50EzIkIhAEBTG/bQAuI9o2NeA+yFOxGCF4ujgECYfJufwTg/kPNveqS+QxxxOXo3g8ViIVODi3lF7oc/Jqw0Q+W6fiHxXROTgUM+73SeubE4EIZnNFMdIuJpYjpALZEIxmqSKs09ewltszgeLiDDpGBPBYdN/4MAcVCoKtjvj4FCDKaGIr0wuDelurIQeHBzCq5cwXuDg3p4NbeAgjgFF+hjA6kbWjBr9kBNFvkw0F1sGgpij7bXVfAjfvAR99rhNeFgstRygc9upPVBVd+Jgau7+NBsf1Xq9dBcGnJmx6rPZPtnGx73k1Gt5pMw+cXqfqIkkdMZUWpwMbD257TIzibLkdq1FKbvs0sAXirQXdMYqWdu8szxTnaeulygioUY3vzHo9Pril28mwQLHme2TcGU+SIILmjkQxExi4VklWffOriw184a5BfCU6hotm5fNbpAw1//kHRVdQyJxLKon89cxMj+mo+pTT5Izx8r6sEoj2NXP8UwhK9mgBqeWTsx/1GAuzjp0rRqakwsVDSwS2bHGS4IfNupXskRZR6uf8Uqs6d1NyT1eFY/XmK4KmNT79kzAWqTcS8Q5Wh00PS2UWiB138PJgUkKq85QEOBE4vCyyu29XonJ9Z9qb7dejGIEf/9NOxGM49Aub/Nwb3U0tboXc3GKctZaSl+ay1fKBShr6ks8FRqF7pvLK/JG/nqS0/L7NVVaFWj67RJtsdG+g/xvaT5x1zVazQKba5dH6JWDPXKXIbO7Ai+IkBBYDNzfa7RIUzOPKjGT7+g4Vv3ot8pM+rmphRIrJWb0YlvhRU8bn446NJJZu1TlQ4EILV6LdDI4+e7fdHtney5P+iffDUIWiEHeTk2CejSUSNyLoTmdvp+/76OEDM09fn1XRSSqD/phNlViqPrUphpf4g/0nrIH+qNCVuQ3u3XUsyVR7SFzdrCIgARxFGOptZTSXghSGmIerUwBtJ7lA8fSYiUkjiEVWKg9idQaKdpcNrOQcwicul6CRl5QPy50Pl3Z9rpnIu1hL4QCqnxvlevABxbngBHHQBnt06K8a9L5hfXWZIW8UIQ2bUBPm6scN89wqDdjxig7j4hja3jXk6xsBohm4zD+jnBXIQaMLjGXwHHA5pT8kUEW7nQbFV0SsnJ+icScosj49qbSMkYr4Fj1ASktQNNNxADeM64IPGMlr9NJGHUWS0qJXA21a0+mqWRcqHvmcKRK/voaT6p5dxCHhxmTvzhYo9j1rbaEURvBaSJ4uRmEiktjTfaHBdb+WJUzxjZr7rsARC44S+emMtW7JLJ7KHW/ODQcrhhxylIaVj4qE1+4jwYwycSKIbPmw94NVn5TT09L70y37Q3yxzFGVFTQRdKHqx2hgqNQcztrs3UHjBZWcAlktmPXrGKF1z1O5CBDyKCxpusSyI5o+LrOCHZjvc5BKc6WxtVAm0Gy8tAQcC3D1Wz5MqPp19mNrSiu7dEt2vcLREN918yClKfMw1X+0Xlx1FqzUfb8bEt/kf3MMFcuYlyaTJclu5VYRzFzS+xnIhc+SlgQOsPcqknnVWAaGo/CXE5Nqiwg0mmsWMDdUa2cHRgjaq8nMpcthgO4oHmp8q5FjyKo6CsKrhc+ucIrUrtYtev3CL3AKtOUwzZzdY9XfTNRMUpM8LLCUU5FVm5aycDMGM+VrFtcOl6KBS5KGJagf5rA58+5iVKjhZl/+9iFe4qR+7LMLb7Q+B54gRXcDGOUZo+yqmdre2bvXa6hciOepY59tkcXTvkTT2dkG8n5BFZ2QUQ32tmmzTpL26Lcbmpn3dWMbZFOM3vA4GsnE/7zv55t8fJUFMSxCS4HMkUR9BjVt270Mn44qIhxG/lt1LPP9u7nYe8+q/anUe3FRHaNzuplxsf5ktCsuYEbMV21rzm/xdcUZm72hLbwhvTkrrfZ19rtreOzi7YGsavf1Oor1fHs6jtt4rQ80nEp+0w1yF3Qae2+BrGjH3VBIVxRFJMgh+aSSDWYARL4qKiEXe3gjOibZwk15qoaQu+sxHo984UnP6DWVrY10Odj158XMiQqA1sDmnDcxx+JKNu/R5AK9dX7D5PbQm1hPphibKoGs3lanILyLWG/W9romja4p63JDn/KqaOrWkhDZaWoWn6pt3W6brzwbY2GJvnUD1TYC30z1DiWKad5Xkjh+BbL6yidE6ps+a9egtqqqN0ctWTqTGUySY0z0khLoyZbaWmaFGKhWjGas9ZQThi3Mry326qf25IpS+I4lfqAMTSTmGdLsHkMzTlIyLD4SQcd6u+rQyo1LwzNUb/nWtyRsKd1nwdkw7LryiJ+8aB+iuQZbJoGNL5HR0IUG/vIYN47ItSOMCr/WL4gwaLIF4WAoRG7Zks2usdUf7FPani8+SKRSlJXF/G1rYOiFPFKbWYHryWs8AVAjQUQMToXnVdoGVVPAZxnQgutK7PqTRGAZDU0C0NpUwJArP1STTMM1DXUA++ntZJmcCV85mgEreswDbDxa5oweGFTH0Yo1iFOidlUoMNVMFVbusy+9Sk2TqlWpYDKq7ibXWQGf2QQcMfRm33bXg6xH2RqwV7UUGd4NZgCqguUJJgO9io7/T6ul7vUNfPWr813f+vJkhvLqbxmc12vLy5mpht38ACChOAAl9xlfdgnRqF56AEJ7gYBN3dmmWrkCi62MkQAGQbRG9eHb1mLcDIxFLMhXmXjj0CkSv4Jd6ix2luPB65kc5AXAv/91W8DL0jFlPgjipMIHwExVC7WyeiVQYQhRAbPHi3qRxISFtoBP+iBKvELzA40xRCRezyCnt5/kKP4n4qr/6vnhfmVMQB6qkkvN09r9laOoLH1OZnPMcd+aqoPsHJrXwLj5or/AgANluRZ2bCG8N9f/daAbZlOQGiIP8IrY4SbO1Fe2lUmVlSij6qfYMEEprqi1o+tWp9LDIKps2dxFPWdbf+AVmr8brpM5jdBXJYjPF6ot1dnVyODlWKhOTVHmUiYESXHTPFWOSjohDgEC0TnfuXszpJJZcrx4MWmq7Uam2mJ0VQFw9NEOjqNgnp0fH0UlwX2bR2FJ7IaMKpC8GLIlmqLAH4YPjB+T+i8r/iubyZeDLW6GB7qf7Yahdlc0mkoJbW0z/GofsRw4+G4WF1XJfRi4uolKl9WLLCisPQuiRiFRsIiuto1lyq66cNSglXflvzrIvOAUUGEVM83JlRKOizBDxdnT8O7Kdl4ATaY4/UEG7Ql2aCeaHMPDTtUHtZM4oM1GOX5NL/zqF9XsxHuaBxFZR5rc0wL+zxL1SHOWSxmbjXziw1dlEO6CmgT15H6yGpCZZ/QvnrX7JIQYf2x9VHb/UXPHJQ7hWvP2CUeWWEjJdrX1C7qWH1XmF4dg+/B/OZ6rIMmPiu/MX7Qhu2jUM08apVQdMiKAprO0nMJbxPyrQsuIgdwMdMGQWYHHQGR2ZEVlh0tiporgAhITXZOp8R9XkeM8hviMtMCwQdKPgJFlAlznZOBh2nAwuzIMULl99/WjZkrqr8+MvjYa7t+V7kQ+Ld/Ef9y+bslUXn9GHxpsc/6bBEl1U/+15+P+8cn/eMTOD4e6f/gw+14cOAb28hg6RVIxXtF3A/mnLWHGHULt/ZM5g3Y1CTr7csw5aXAkJuUo/qsGiW0gdcWYyFUCcBahWAbllJnIZaIRC5jpmYzVXlCh7gHJjQORuGeX8AGl1e35yOHnU6pTTFEbD7H4ZEXronxOw9M8bXzwgSmgkiyxEWhurnpKkkHo0+2C1Iznw9IFInls98dtyk211J3RwErvZfpln0Q7Xohb1aQB6SohkBbRihcFQ4ROPB4Nhyb4xRcxWq76K+J+no8qUX0a3zuJLtLhROndUlfg7iV5K9I+oYwVKPkX4+pLxK1jeAHIo/MdroHIvCRJztT01MCayewd6sut2zQEi7thjUTEIpFnQasyi9Od3iZdcpYhCsRF8s8E/LHOnbN2tXsKaYCIBKX7wJptGCU7aJs2c24tDLRPrD53CtE7nQvjTzaxJM1wJvwaDdUu+PZYIo049jMoQN1Cgu101iqb1c9+Xm2OLjy7NpPdYz08krHSYU6wLWwo9TnPGYcRGbaDhJFls9WkOV51drwgqbnDJGoLsbX2ldI5sg3r482yyMmlMRpPILjziZJe46rLb9F2hJbrTn1dd7AekLVQDavzgUS7uxfNS/m3F87o1LNFqGQcDbX14J3S590LOdUA3iu2dymZnP/xZqtVZpryjPb6zIb9vo912R2qMk07kQqMH+RFb4pgecWcGHHUykIcVCVZkYGJZibsJi7gOYIyomwBg05eK7dfK7dfK7ddHQuFW9qk83drOoueVfrJxAVxW8HXy/hNCt84/ixFfFDe9qX60cc+qSz+8lS1y86BEq3KVtaV7FkqlGetGwpF3v2gqQIkfiyS5Kq8ctKvUchFesBCXDtg1MpnNf1anmBA/JVkniBWzCG8EX/rWNRkBdofSq2LJHyVKV0rJfyfNlA9jVhZ6hvh6uUWDZw36esg3rR8JGv2rJtR7P7yaSPSz+UP/J5GbJwcGVjTEDvcM8WuTnacgaMYtDzIXyA7dEoHJuku694t9Sp6eSoxanJ3Uw4jxNZryQwE+aS/PquDXsAUm5nDnRVwFcfCaLzfxJEX44AgT0DrmDM1WAzClh1ajvZlSDv2wMGXnRzwprye7Vs3TbZuBfbpuNMpLqy81eDsoeyuhPKFFsaVEXxfCvjA7vz3CLMhbXQ0ooZZ00Je9BaCGnCqJ5fFAQpR8FqAOMciBON+hy3VJRi3yWwmYnTJkCsltLnw62MlWVjF9a2ru7PNjhJU9TmYnCdVLKuAvylq2Qtz2H7t9W5tQJm44L1Yn1AQ4GeLzVqyxu7BoW6wV0gYRkDUxCp9vpmqVKCujvj8rQNpgZxhR1Pw8ThuGZSAEWMYggZ1sh492g4RsnpYWsnizyrQ3YoekArYXmtXH3pqc00QN25/T5VqFnQTwJPvSpaMhJCwoQgyjoTOEg5kSsgQijH6EXXctvnFPxzCn4XKXgrDcp5+LVpjLWx5Ka8YiM/b5zI3kEau2i1Bs7QX+AomaURSAZpkmAOkfKHi/rzKzLAgzpQlOjDik0CJ2v90hxeGpAQF3b24VWxlJfQFPui2g+IKBZ0leP5yExK1PmDUyRw6HZzKAKr+fMnyDk2McDnDP5zBv85g/+cwX/O4D9n8J8z+F98Bv85q1w+zNUmi83xNTcswm9sFKGYbuZTFAxQKheMkz+Mxq/knNddqBEIksWaJOZ9F6uwO130ePp2PJPSTQyFG6/qIHPjsS9Q1tJe06HCYH17HgxnEbYbLWsj7ogyN01RQt6a/HcjVQxl66TdM001gvpWAUNNh6ma7V7vtwPD1C4NDn/v5RkHm8WxjZaYT3WDOZa9I+ipVL3690FdCNL7bTvggUpDbN1DucTA159tYeOYuxyJ3r5Wg1gGdWT9V6x+MylG9VuyST8CBxzLVtR9lPFXYfg68B4pu/WMbN2vPWCr2nFOPy8KJbqaoz/2gtbQSNwqdrbvXfbYjfT5uHfa98bDDBjjIaFFSeXrJsKomamyEbkh2nksza3lAw8KvqonHw6mlMY9KhTSNLNcjeY5Zhb3jDJPfiJ3HpZVOn5O6BOfyO3VykVVtuymq6rKukFV+82SPehOvWNfAdxGd24nrLtp4SPo2duoOouInJN9Syp5IsXdKqu699Uum9rGs7GZoEhnbvj47E2G/eiZrYhYuyNhz2y6c1tjDQUepeu+9kH82qunvPdNGJ7M3ojeUfFPc8mqt9MGr2N/Dl1RgH4hDl1N5m/g0NnBSCTxLI0mWHrvmtxMEzarQd+Vz2sgtd6WuMm3j78Sem1vj8MVQE2ep+FBdpGjucGxcNEf6dWuydzmOukOuD12bG2jA1g/V1CpKk04YSpznheD2cxzPzCLph9wIlXFnvcuzG7rdM0t1oL0OVaxOV6/C/OfKVqpodz/IAJBhoW2o+Xx4NvB8ZrLLXv9/vL1d736UwUJhSHHQrz+01enZ2c355PJS0/DCKMQ877mbcJor8vFlxac/4JJfSlyRKZqMEPBgnssxdBY7wlnH1dDfSsZC+63uN/TKAJ9eZ3wo2Y67IekmjWM1UfmVs21GHrnsBBv6jSPhfaj5avB8eBk/Vyun7XnOcmgZvV7dSHSNC+ebzrPjX+dVZbPa70ppbcxGYuMVlDUnRit0H60PBl8Nziufrblzbm9fhH2a3PXmOKUXAY/RvL4CTrDSKYc9+dIYvH61t5O91plBDqtg/e/7uc289JVvE+zAj+ltDNWeUcOdI3NWtoh+22r255OSn7KOXImjEmE9jmeEyE56jZnTR8rEVKWh3uZw6xbnWkU/dwLrC3151nPoBZM+r5p2OF69fx64Efdrb72GvTx1eXtzdW7d+c3d2c3F7+c3/jp7VXXHmiTi7vzy7Prq4vLWz+glJKPo+Fwm/nzd3d6fXH34eadvze3wwAluf5TF1NvfzN8Np3r15Db+Pf6T1/56OPTrimPKq3t8HyNDbmK7atz+bL3ydjf9ObxrLyd6C0iZ4TrDc46LNQe5Fe1J304tRoMGtMYeRN9rO8Ug944p6rwGb839S7Xv4gjfT+vKJR7nxbuU32S4L7Txk+VFd849P2omHP9Tmt/fDG/u3p/ifeGAOYGQfVadu0JY5gZn3whAcwyX39u5SjOnsoMqfbl15mNjeZuyd9mBQFPHgSvD/kL4aSGudqEpXaS/25pqG7k3yLnvVW2+6Ca0vZOWBeOUIJvfYb7SdZjx3XYPYW5PrG3o6yz1iJGhXRPhzf2uO/02yZkyTOfDt0nl1k7l1U5y+9aSj1COpluzhCOGX1Mtk4N7rF5Ogtjw8yQ76t95OayfrbDbzdptAIS25LpKVNnmuU3zpsVFwrAggl5iaXynUol4OsyaRrKVgEv75dPFO3qHBNXyyvCMkNQLe1+guTi9Z++Mo743c3527vJ1fjnu+vT259KMCIyw8EqqB6nl3A8kSwpPwTAH3FQfQbuaGMl2YdTQodCC/N+oP7PY+jzGQyLyA19WYEOTdQIdVTG6pNHRfCMndQe6PFRb01A0E6GBS+842jv9OcPb87vLq/OzveTk9AXhii+vmzKSei+26JxzdKiS5jHUGZNmKcWqMy/L7F5O5Ri083iom73vN5v/1FWFwdZkgjPKztRzE+AEjQlEfFteUWhXiTj0+u79z9fXp2pBaL+mPw6uTs9e39xqR7kf/xW+lwfjHLtuj4XAYqQ3fhZxmHPMd0GIaZDJ2EhHKjZ6OLsZe8zCE0WWLpb9L8yiE+wCjePaXeRaB6C+IGWjAQfhM8g8J2DuDl/f/WhiT69Wn59/0IqxMqyWfN9iJf+zlko+rphx2C4UzqVpodAMQ5xCIKZDX+Irsz3AgSWaQKEChKacwZzKwoQxzVIah8/mttTAYJ7d2ijMskgRsGC0NqRXAZT+50WVL03xTNRet7BE2V8oTBWvlbL0IUyNFzbdUF/72wo1Ou3ryX+qTHbF8808oyvDjZQSq39NJgNdxbeatiGxRU3W3UPOnHvNnj4zZftcGpi6o3w2mhSGliptUMfXxV7+5FEWPvLRfe4HJStniw8uTgzV/XUvWcfeTP/9BBuWX59NyAJPKX6kAdzhlPMwvxsA0gFFkeKyvqkCXdxtAbjCRwPcbLAMeYo6gGmkq/UorfiQq3jhIUXdMau6HvjmlkTwwR+3EGP9nGNHFk0MDtV2DN0ZZ4onJq/XhtLjBkKypGGEtDtI4ZNfWUBwUOT0DPxqqxXCHhod3CHDAv6QpqD9p5ua5Z5euhuEUUhBIJYcaD5YpuM1cY7PsoYZNRJGItEAxY5+/swcCA0hC3yixlC5lkVJbN/5lFIrd8fWCLK8IGTIh52DWfwt8VluxSsb6dJK9lsN48knIWyjnQWEfzRngVdKC+Ef4VCzdkTJKubKLXjBHbLkD/tprT/bvvKn26T2BNvydo1BzYvsz1uf8v4cCdFDk1KfaNcjv6ikrbJjI5OORsLoUteJh/2GU4itlLE65iWaTGNyimGlsxBNtZu6ZTyXqG1yRWUJIUuuiCyWd6kCr/bUDdPeVSTGAVQ6/ISjyzaP+m2A+HVY3ILj62s3/8Ok14xTPkpCg53WQq/50l9nqYC2NKa90XNVYPR8eCkfXPCqT70d9NtWuvC3Zngfv2nr97/end9dZaHR33tE8bl62+Pj4/r75adOGB9mPlxNdPVQex3r40JOjw9k+WfqQmpdZUphGvG5QjUfNWPS5UsYNEIbsfXlXcG0R7HQvZRQnqPK3Uu7tE2Kmz9ISUtVoW/qKKohAsU6XvHmVSJIhGfY1mgVS1W5Aygau1KFfUqtrUglNcoGmI6J7TcrN2MaGj3mCIUQ8Ii4K4oGPN5oqLSeL4aHeRBzBsWqZNzP+gG+jkvPnFdx+jjB4qWiESqbN3YdTGhN+oA0Ym5mXgEJ8dbmmblIXWn6+YmWlPdiHKIJiX6q5/ydRCIB4sRoDjMLjA8tOcDn6Ngka9oEyhZoCWGGMfDIEkhIvpiJXMbUQiEAuMh5vnZ7YcwxREz13u9TRFHVGIcwv9mE3erxgLTAEOAKFC8xBzmWILKZdjL1gIkCvcAHEKitEvKMaQ0wkKY46Dxx0BBlQsmsMVpYP/VUeLs4ie7RyGD5m6+GXQwZ2sz5FWottEWSrVt66bSKxfXu0/R6rDUQEXCr6vqHf7e63PlomZjyn6pF6hsW0uwXmmZvNYWGcZQLOL2z4bVFtWZXqMqPQxRuVPQ/RhWHFVgBUk6gt5J9UjXGMeMK3Pyu+Pj96T6dpHOcYLmWPRfvSfq87eVJo7X999bjVxzngSdVoduucUSOYQHk8Ep8BRMcYBSgdUrPTvq6F1sSvLM6lcv43vKwh0wbOv+6k9Y0PTkMiLv+ubD5Pbuzen459ubxsKGKt+5by8m48nF6dn7hq90qV3GNC5l2evmiRjb8PXQuqBGaNWbZuBd+wZx5y8H0trbODDZ9Pucl9wtymbq5Ro/Zo+yUVkLfc6YbPlWtdmxdNyw9qRcdZd/7IpBNyoceUThxjZC/fg4bpG07zeT2ZsA6+KUnRyfvPp2Y6+sNqHFYNBuS49PuhzwU4w2rFvkzfXDjWUh2er3FLXuurrYXjdMhKtmygzpGeMqbmHuQZH6fit3rLlLeQuYM4o3rliuFiwTlo1UUTZb5a4gGco0/m33tWZN6/2x1bBf0ApeU961r9Iunz5o7WAj6BVLPA/PlMkRkjQewXtNltIrde7XOzVVTQaos1I7d/JTOsfXyqw96KjcSrvnN+DP/RXG7bEgzif5tqqH8yvqTXBqpcD/HwDZv3qReWEBAA==",
+	"openebs-operator-1.10.0.yaml":    "H4sIAAAAAAAC/+x9a3MbN5bod/2KU9TuOs6KpOU8xpc1mSqZUhxNZEklypmbO5VVwG6QxKgb6AHQlJm9+99v4dVPdLNJkbJ9R6pULHWjDw4ODs4bwCGMOUYSw3u0QjDBfEkCDCdBwFIqD1BCfsFcEEZHsDw+uCc0HLlGrk2MJQqRRKMDAIpiPAKWYIqnoh+jFeqzBHMkGbdvRYKCvMlBv98/OIRTPCMUww2LMMgFkoCiiD0IMN8SRgUwCj+/EZCwUAxDnERsFWMqhUVpHKVCYq4AlHDmUxQMUCoXjJM/NKTB/RsxIGy4PJ5iiY47Y8/TCAvVqA8oIe84SxMxgr/3vu79dgAAwLFgKQ+wfkhZiEXvCMwvw4Szjyvbbon5tPBhJ2iObBqkMNTXvytquH+H+CMO1B8F6lT+HM4IRRH5A3P9huMkIoGmSsCo5CyKym8ENiDw0gHDNEwYsX8EjM7IPEaJxSvgtv0/2NQ04Izq37ceupBI4lkaOUxChGNG9V9bw3R//DNlEmmoEYmJ5IjOcQ1sRIRUTR6QDBaduyB0zrEQZpI07zEqUZSwEKWSiQA5Qi8xlyTwvQnUm5maHizInGqI/0yxkDtCUUjG0RwHEXJ4JmrRCImpXLIojdUrEnvfdCO+bUtRIhZMDvBHiTlFUd/2bBeiD7fyl4ZQpUdqwdaQmGNpJrNIDs2FSr6p39IktL8l7mWIIyyxD32UEIUyVYJEtCAbpEKy2D0KlSQjWmRV8IMqgj68LDoZhh68rHjyYwO9ryvd9r7ugQdKoGZhsCNYjPGQ0KJ49REqwkjgplnLZstRJ6dETh1LMd9khTERaqY4nhMh+TpcligiIZKEzh/wdMHYvZFlqflQc1ycypYG/lHkU9o2jOLUUkZvLGIfbi40rGGMJSeBt4+uqzthYUgETxOF7TQN51h64XnZMcfZiRSjpd8SGoJc4KqVAA9ELvQLrcCvOVmSCM+xGBwcwu3V6dUIxgsc3AOZQYhnKI0kIPspigQDinEoQDKYKvWPOa5rddU3ofM9KXeRTv+BA2n1u9fKAWgH4d5X7BvOInyDZwpw3VJZB9HNc8tQ9dQUiYKSRAwzU+00U/4eWujuUEKURYEb7DOACE1xpCnT+B1ALkiGAYsTRjGV/S6tlw7v48Hxq8GrA5HgQPUlcIQDybjpN1Z8eFFApAWVzZBxls4IjlWvSnLg+cp0IlcJHsENNitDP1I2EqHzD3otj4CmUXQAIHGcROqBQbZAaIAyAddgvikh1xATAMARVP9eYunLNdwMoKxCRCjmBez77fgDkBjN8XUaRdcsIsFqBOezSyavORbYLqNCuxH8M0UrhbvFYxjnQEeFYZifhHFZwMXgk2F5zbgcwXfff/d9oQWmy/IHh3B1fXZ59nZyd3519/OHt2d346vLH8/fAaZoGmGhR6aWniMXSKb6oDiQ6tef34gKvCkSOARGQS6IAKMoBnCr/lD/zSnjOAQyAxwncjWofO3aiTRRw8MhzBgvI4E52KmFV4PvBq+B0QfEwwoebmb8w6v0CksUpXgEveGCxXiYTlMq0+HgPp3ioRlBr4VqbyZ3708mt2c3uyIaCkOOhfhMqJYNr4loCymT0XB4/KfXg9dvBsev3wy+Gb159eZVE80uT96fTa5PxmeQcLYkIRZaXWbyFtjM0CF31gAJQLQCD9Ml4Yzq90vEiaJ9ZTWUR5N1XGplB/IjZ/Go8gJgRnAUWpXlfXeN5GKUSblBNoiGsU/Obn45H5/dnYzHVx8ub8sUcNzizAFHh4SFgMRjR1/peuc0ULJ1UBeqDYR4f/Lryd311amelDoj7Hjspd72O/sVVM9nxcU0vjk7uT27Oz378eTDxe3d5Pbq5uRdJnOJgBmKhDb7KFwlmJ69nTgjsQK36LECoqF7kDAWwQOJIqBMwhSD0dfhoJU+61DzkWwEPclT3CIZzy8ntycXFxnMsQJ6N7k+uZmc3V1fXV1AiAM97Q8LrExdN1bQDhmIBHGBQQ9JLFgahTCt0td5IlixCSBIENcLx2pQIFRIFEXGSqzPjRkCoJaeNTGnuNDTEZAZ9PRU9YDIMrmzVl4hrSkHEt1jAXg2UwqB0WilAG41F4da7GOtVtRQ1s3y2ilpmGkz2PVTPb459a5OIAJSgUOQzGrJYUiEfqM+Kc5SpZMZZ7EJx55cn1tVNoC3q2zGlMgY35yKbKIsMBxWILWQY3xzWmncmcnfnkzO7k7Pb4ojzJhAK3YICdfm+0qrZceZjMKCCQkJkosqr/xN+X2ZBAgQ1csbg4p6EcXrMxJhMYBTSwPdjQKUEWG4RHyY+y2tTOGG0DD3RVAthDCsdHty8+7sVkHTaE9xRhSlH8hspedLDVyhW4EmWfaBopRAS0Ln+gOxQGrYyrpVbDXF8gFjal6REEOAeFVJsJldzCZapjRJfUnijI009XTXRJSoNzTCYK38rAy/AzEt5LU0VevSrdHPibBaPCqyGtl2dvkLEGGCGA43FahQUVsNMGKBb4VrVY+dzA2xDujvf6oqVN3JfP31/JcTA3gn8+QG/FeyRDAxCv5aET1iKMQhTFdORmxDrc9RP5UI+Dh5dHE1Prm4/uXup6vJ7fXJ7U+7nRIXKXBflQ2ynczPUK2X6HOcJR9pu6wfPaBeJx4YX13e3lxdXJzd3J2/P3l31gC+GjD5B1kiGynp1s/N2fXF+fjkaTppdLxG0Pumt5GG2QRfLa37RMi57Ip2QURu3pPSC1t09P7d+9ste+vH83jDsf1ydfHh/dm2nRqbYqNuXYdXl+eq/036jPv4ow7s8C3Ieva/r69ubs/23eNPZxfXG3YSEZp+7KeSRMLbS0mcn12evL04uzu5PLn49fZ8PAEyK4osEJiGAhBldBWzVEAq0LzqLJrUvfrkHWPzCMMJRdFKkmCtiVztvbND3Ox0nN3c3f563UQrp18wlZgnnAicxaN7Bw0kyrC7uz6/fHd3fnl7dvPLyUWb0gttDg++IhQWLOXiZQm6UnkJ5oSFJIBEGY2WhgJT6SPkoPS580+IgNffLsrv3hNK4jRW744XA/iVpRrRgFFVB2CiP5LBA8b30UqpUoGlSkHC8fdvFgftQcsGShx445evv10USRqRJaZYiGvOprgc+VFFJeUnAAGLY0TD6mOAPgxTwY3OG04JHap4bCAjT0MbmS290YlzFJ3iCK0mOGA0FCP45lWpjZmZ7O33xbcco5B8seOoJvKq5VZrE3h9G4nsksjrnGbqi2WQJeUK6RfHgSghdlxJNfuScCZZwKIR3I6v7TOJ+BzLUqKmnOlrSC4JrLP7JzNdXbEawSWj+DG5TydsdBhWfb1JAtT/cStVO3zyyFRoUw9boPXJkqLNY9iKuPDp0qNtKD06R+qA378pdVDPl65JftbTeBYyFKB+mkyeD5F9Z/Ne6Uye+n9rMq8hbfx40u0mc7xDyu0se+yAX16dnu0nIaVTcZSFuJaA+wxTojo355KT9WxgWfsptnGiSQ/lqAJVF0wXZ9smjmaMq3nWIG1A2AjtoSnwAldQ6kkSUaZAanGkSuG8dkYvi7xnoaKIsfs02Q2fftO/GR9349UaPZvYtTIQ+5saS+WLa84CLITuJx+e1r3KHicCdM2w8S4UgY+/g2CBOAokrkWPLSGBmCBzMuc4AZbKJJVVUk0YzNIo0t0GiL6QGXl1ekQX2pnvv/qL7VK8HJwAx/M0QhzwR9WZqEecNZNo/C2TYSoJx84GNgNdIJF7S6SezzuhwYJx+P2/fodRBgzRFQjJTXQdSRAScSkMqr+X1eDvFXi/D77+vRmQwqYKAGZM7QwwgcY/MGegpoWpgSwQ34tLIxaeh/3A81BiIeF3Mz39AHr/VTECBl/3focftE21a2dhC8vX1VKv2aDRZv/6QHQy1NZ+uCNb2N/P1ihuZBc/xv5twvsRxP2EtnCGUL7LZL2N6/mobt92taNrdvBnYhTsUNF0VjN7UjI7UDHbKJgKm/x+sKF+qX7/ZSmYCvY7VDD7M1otozjUIV/fPsM1b7bGdN2l2bpbk3UDc3UzU7UmX1uCDS0CtjWC8OVL2N07XVUOLrKDj4eDiNEuvtduPa9d+10beV2b+lzPinBnirDuam2mCL9IT6uC/Y49rXwpqdlUUaZ+SMR9P0YUzTEHjpWhH7oA3sEhnMtqCtJsEyV/mLow9bleglNsaplnJNJ87M0AjTXc9yhpcedoGPdN/4/M/xQAuZ4OIQ3xsq/RBb1LwVYEcUg4iRFfmaHAw4IEi7xw2cZG9fh5ShVoDc0M1nQirOsQufinADZz5IC+XcSEK3EQw4wBoUGUhlgDUpTDH/XfhrFA4ARxPRmGw8UB1CdsYHoawf89sImqKbadO77swz1ejQrjrnhq6gWUX+i96qNiNZEFIjBS6056AbmXfmC6CLkCLUa8AZR6swapEukrg2XCUMlStG/aVjphQjNvRvZKozoRwDVV4eKjIZbBcMGEFEfDKWOyV0ZhiWnIuL9n865Lh5ZFRtDreZAYX1x9OH376+2RLVSroKCK0/wIqDeP7z5iLDkahng5nIWvzC+C2184is0vYdw3v8Rhb12wRx+JMMGyXThsHt0xH62TFXuK3DjIHbo3G6snvmhMMTH5yJBMjlAnimwYbjmslvfXlYwz+ZQgZRRQFIHKt3CKJRa6vTgo2JErlsKDlsMRuccgmbGfsiITXT0pWGy6Ekcg0CrrOYdkDYQldtWegKRUEis80l0EpgtFOpALJsz3WjCnmeQ61A8nlgOcpXOYvcWD+SCDkfekMTHmTR8K5x4M1Yu52hjd+8GdY9FXz3oZREWZQEYWqHoHf1b/17P0l82AFXHP56sBxgi8QDYNpykJeYnlA+P3JeHSFmarscx6H7D2SR/F4fffjpQfUDSMEJ/Xtt32lz98W3E3MMwwkinH/TmSng0whr/wA3z4cH4KKJozTuQi9nstyAGDIOUcU6mqfSmcRMkCGbFb+kxh1C92L37ovbu+fYsEDlV3vTan9iR6QKuiEyFwkHIiV2NGJf4oy2NP3AEH4ahaO2zybO/VJAu/P5zZaMWfWH1gvFmtHIdNJkvlO5FOzVcd23OMwisarUbNNc/KsGnBjqd0WGvivk04C+LstISW4amGm6OmsuW1cvUy9GLpdVEUF8GYLQ0bAKptsfCUVWS6FQi1ZvDl6XsgIt+blUnwBAm7LFSTU3ekUQUiEt03fX7CnLYajBZXqoFFOkMWUqG8zJA96HCC3s9m3Xc19EyafTbFAoflUtFsF9uDDggU9uzo3Wj64BK7XcK76fQQiInoZG7+EeidriUonfeu2q07P55fPHL/zoT8gb+ariQWLyt7kRRGbSPyoTI5/z9NNb3Hr/70zZ++PX7z+ttaOc2kQGiaxlPMFSolwpTwWItG21aDV/spd9URCM/zHg3j3s6i8EapeDR+TcYHLlBQRrYlUtAq/NWy1iumjGaLJjB296lbNwf5dGvhClryw1eSAQp0uM881lzHZlqBqKfH6g/V+0sg1GzKq4mKQ5BMaw0Do6/PpVPfmdgKoqHeGW3O4jrooqpah1tTWU1DbdFWrR20qa5KX1d8nFdfrlVtnXs1X++itkCx2PZlBZWv10ar9lxEUOtiE4SerGTAg+VmhINPVyCQW681jDq4Lu6bBt9lvbG/w30Dyi/xPB7KOBlmeIrwvq/6XK3XEN+2KYjjsvqYIRKlHN8uOBYLFoUjOG6zWR3x/3ZyO/7p01VFOjQ+yVEljUfQ4NL6gIQ1mB+fwQEzhbTczYnaAtdExRH06ovGX6c7vjg7ufxwfffXq7f729625wM0lIPhRrnV6RmbHZ7RtB/us8hsNuc1gzgEIuDPkOc3n9pSZr3PoyAyO0O03/1ARJQkI8g/tGeFrjNa1n7QZLnsZGPOWvOncVCb2ScttNmKPp/QQGlGqNE+qXLT9qU13uArigSL2FwyIUPMeT02+7ry6PVf/uP4C6jXyRE5OX1/PpmcX13e/e3s7U9XVz+3KbamNfz/bY3J5gUiFdI0F4j460Oqn3euD9lBPcxnUWJSIcDnVcyvtyony8dtZ/UD6VRz3uHTHXnjTT09As0n89GbcX8UkeGz2uba95wqtN4Hb9CiRbh2+M+7XJ93uT7vcv0Cdrn+ix/8+wUdqfM0pxs913g/tsa7og03rvGufv9l1XhXsN91jfcWP8WPoO1nzUfjyfVYJyzHk+vzrh9t1lPnn4onUr8DKbtcxdam67p2d4fNaXb9UclZMfUxEKfCLhpbcoADI1UFTHHEHo40EXREVjdQJd8j+HMSpRxFfxn8WZfY/SVzfvS5dAljUWDuVBGD6q1GmZNxCPpb/SFIBqnQ0OHmbHKrqlJGMEQJEUPbxfDP1pZQnelHI6jBBjjMTI5t4C4Ldf4Ah4CJPhD80qnVEBh318UcAIiAJXhUeO0cPLPMDsGQKUPFiTlLyw83F43IDB2JNSTzu4e8th9V0qNFY7UnJABRQBFBwlh7GMYX52Z7A+MQEpFEyCS9HIx6L7YTxVxABFDGYxRFpkxkjGIcjbU1mSGhHDR9lBrPbkCCGFEyw0Lq/IG2K4zktRyrjpVVZ8qOSz2KBeNaiwtz+6B5gHkmRpll3VWpr3ygZmAZlLy4PhBJcADKZ9ArA0XXnCiFPFZ1HdQ27MNfJ1eXxrwYCIlkKgYLjCK5WJ1TIRENsqpcw/0/+V+GWASc6HufRnBbqq2x4CBwBKh+a5xdhZurHvVhlcvh0I/ZdXODFuwKYB+LYYgF4U3YnfpftmBmwW2NVWYx6yACYfSWxFhIFCclxE7muARPF63XY0NfhEQmjkLPInkvIpmUOHBvMtl184RC+bzc5V6lMtlQKiuvT1fm576elcXlhyVZot7pZraINCMuZJMIQm2oSPMMtBEAZoiNUi5ACQqIXA3URJclSaTPncehDx8l21DsnG6310Lzr3YznEelMMxchynGFJLFSqjLSqMVoEoPmyE847hMvx/zBy24qs8somiJSGSKBHJ0t8JFMomiEjJj+8qLkGoNQm3jdHWzloCbIpAskCgTYaJf+Ho9D5V3OSPW1bT7Iaw+d4h0RuBfTB3Z6tl/bUW0b8n9iybyPrWencb96btlcQj7VDvlvvSz5Vbuwc5FSAmt/QmRdTLZh//YIuwOMiw39Qru0gC+WMFlt2o/i6/9i69xvilir0LMTum+RVlQHM6TCLRCj0asBU8t1wweeSxBDU0UP342jWoSJlE5efJsIT2FiDH1D/sXMW5O9y1jkuJ4nkTGlEZmpEyS/Z6h86RCR0Ew29dyajxLGo+kcYVWz5Jm/5LmxtB6/6LGTeq+RQ0vDWiPsmbJtxIgzeG4D6JTJE4xi4m4EQG9iM1NrK0HAaMijU2W3twSjiTKDwx4bLxrg2ihPvnJIFpECjmM9GyiKAIihYaDqRKV4okDY5lAVg9L/Pm0YrlzGa/KEG6xYbb6WWsVaWvjRxbn1mFvhMreC3B9+G1IrE9YZOtH5tG3yJTA2s2z62trP7t9IpWaP1vyt8f6y451f6qo5y7blPlJtrc+39ldJ8Tz/dJ5YfVner+0F/71+eNuSy2db/ZZXAn7CS4vvTmb/Ho59l0OWQT+zaveo4yZ5Va2zHIDU2a5P0tmuZEhs3xiO2a5oRmz/IysmOVejJjlFjbMs2Zs1oz2su0tleICyRbNGCgShGmc5ArPBexZuH/1lA9tV5rp2fiN8Se/w31fF513thj2d+X5DnYGmzFtfbZF9fOOJ1x0/OwzOOeiGdMtTrtoG/YjKAZf1MkXZiS2eZNOfj78YveHX/iXeq94hYVLH0CoZYcJmzozZTw5d7cxQRIhiiFjUnF0cGg2oSEhWEBUuBjGNwL+A27enoyBp9qcKfeRFY4uMdf73w4OzbHjGtAHvZ0Wjr8fvPrWbH7CVF5NwFwOAGGKQTKFp44hq5QfMHpwCPeYUxwVUAM2AzJRyLsbxwcAPzIOTOdpzLZdmEVoyVKuD4Y8OAS9VRFCIiRn4siE1SnGoTt0NGah3bJ3qK7zoELyNNBHSaoG+u1qdHBoR9E/fuNGgaZsiUegdluL0XA4J3KRTgcBiyvrIxBkOI3YdBgjITEfmgkZEhEIgsK4nxYA9xEN+xpwP8SJGKxQHB0cwuTD5Awu9EDOsg2e+rp4zOH49Qgej4VIBS7uHrWX+By/3gCR7/aJyHdFRA4O9bzbneLK6kQQktlMcYyEq4nlCLlAJhyjSao4+/glvMPmZLCICJOOMVEcNv0HDswBpaJgvTMODjWYEor4yuTSkO7GCujBwSG8fgnjBQ7u7ZnQBg7iGFCkjwCsbmTNqNEPOVHky4RzsWUgiDnUXtvNh/DNS9BnjtuEh8VSywE6t53ag1R1Jw6m5u5Ps/FRrddLd1XAqRmrPpXtk2183EtOvZZHyswTp/aJmkhCZ0ylxcnA1pPbLjOLKEtu11qUsssuDXyuSHtOZ6yStc07yzPVeep6iSISanTzW4JOrs9/+WYSLHCcmT4JVwaEJLigmQNBzCQWnlWSdRd2ZamZN8wtgKdU12jZvGx2d4S58ifvqOgcEoljUT2Rv46R+TEdVZ96kpw5VtaLVRrBrn6OZwpZyQY1OLV0Yv6jBnN+2qFr1dCcWahoYJHIjjNeEvywUb+SJco6Wv2MV2JN725K7vGqeMDGdFXApN63ZwLWIuVeIs7R6qDpaaHUAql7Ho4PTFJYdYaCACcSh5dVbu/1Skyu/1R7u/ViFCP4+2/ah2Ach3Z5m4d7q6Op1b2YK1OUo9ZS+tJcvlIuQFlTX+Kp0Ch031xekTfy15OclN+vqdKqQNNvl2iLjfYd5H9L84m7otFqFthcuzxCrxzskbsMmd21d0GEhMBi4P5eo0WamnlUiZl8R8ex6ke/VWbSz00tlFgpMaMX2wovetr4dNShkczapSofC0Ro8UKk48Hxd/+p2zvbc3ncP/5uELJADPJ2ahLUo6FE4l4Mzan0/fx9HyVkaO7u67sqIlF90A+zqRJD1ac21fgSf6D3lD3QHxWyIr/NraOeLYlqD5mzg0UEJIijGEutpZT2QpDSEPNoZQqg9SwfOJYWKyFxDKnAQu1JpNZI0eaymYWcQ+DE9RI08oL6ccHz6cq21z0TaQ97IRRQ5XurXAc+sDgHjDgGymifFuVdk84vrLcmK+SFIrRpA3raXOW4eYZDvRkzRtmJRBxbw7ueaGUzQDQbh/F3hLv+MGB0ibkEjgM2p+SPDLJwp9uo4JKQlfNLJOYURca3N5WWMVoBx6oPSGkBmm4iBvCecUXgGSt5nU7CqLNYUkrkaqhdezJNJeNC3S2HI1Xy19d4Us27gzg8zJj8xcEaxa5vsiWM2ghOE8HLzSBUXBprsj8ssPbHqpwxtplz38UfcsFZOjeVqd4lkd09qfvFoeFwxYhTltKw8lGZ+MJ9NIBJJlYMmTUf9m6w8pt6elp6p7ptb5A/jjGiooIukj5c7QgVHIWa21mbrTtgtLCCSyCzHbtmFSu87nEiBxlCBo01XZdAdkTD13VGsBvrdQ5KcbY0rgbaDJKVh4aAaxmuZsuXGU2/zm5pRXFtj27Rvl8gGuo7ZhakPGUervKPzouj1pqNsudnW/qL7GeGuXIR4xJluizZrcQ6iplbYj8TufBRwoLQGeZWTTqvAtPQeBTmQmpSZQGRTmPFAu5eauXswBhRey2RuWMxHMA5zUuVdy16FEFFX1FwvfDJFV6R2sWqXb9H6AVWmaYctpmre7zqm4mKUWKClxWOciqycsNKBmbGeK5k3eLS8VIscFHCsAT90wQ+f85NlBotzPzbxy7cU4zdl2Vu8YXG98ALrOBmGKM0e5RVPVvbM3vvsg2Vm/AsdeyzPbpwyp94OiPbSM4nsLILIrrRzjZt1lnaFuV2UzvrrmZsi3Sa2QMGXzuZ8N//82yLl6eiII5NcDmQKYqgx6i+caOX8cNBRYzbyG+jnn22dz8Pe/dZtT+Nai+msmt0Vi8zPs6XhGbNDdyI6ap9zfktvqYwc7MntIU3pCd3vc2+1m5vHZ9dtDWIXf2mVl+pjmdX32kTp+WRjkvZZ6pB7oJOa/c1iB39qHMK4YqimAQ5NJdEqsEMkMBHRSXsqgdnRN84S6gxV9UQeqcl1uuZLzz5AbW2sq2BPh+7/ryQIVEZ2BrQhOM+/khE2f49glSor95/mNwWqgvzwRRjUzWYzdPiFJRvCfvd0kbXtME9bU12+FNOHV3VQhoqK0bV8ku9rdN144VvazQ0yad+oMJe5JuhxrFMOc3zQgrHd1heR+mcUGXLf/US1FZF7eaoJVNnKpNJapyRRloaNdlKS9OkEAvVitGctYZywriV4b3VVv3clkxZEsep1AeMoZnEPFuCzWNozkFChsVPOuhQf18dUql5YWiO+j3X4o6EPa37PCAbll1XFvGLB/VTJM9g0zSg8T06EqLY2EcG894RoXaEUfnH8gUJFkW+KAQMjdg1W7LRPab6i31Sw+PNF4lUkrq6jK9tHRSliFdqMzt4LWGFLwBqLICI0bnovELLqHoK4DwTWmhdmVVvigAkq6FZGEqbEgBi7ZdqmmGgrp8eeD+tFTWDK+EzRyNoXYdpgI1f04TBC5v6MEKxDnFKzLYCHa6CqdrWZfatT7FxSrUqBVRexd3sIjP4I4OAO47e7Nv2coj9IFML9qqGOsOrwRRQXaAkwXSwV9np93G93KWul7d+bb77W0+W3FhO5TWb63p9cT4z3biDBxAkBAe45C7rwz4xCs1DD0hwNwi4uTPLVCNXcLGVIQLIMIjeuD58x1qEk4mhmA3xKht/BCJV8k+4Q43V3no8cCWbg7wU+O+vfxt4QSqmxB9RnET4CIihcrFORq8MIgwhMnj2aFE/kpCw0A74QQ9UiV9gdqAphojc4xH09A6EHMX/Vlz9Pz0vzK+MAdBTTXq5eVqzt3IEja3PyXyOOfZTU32AlVv7Ehg3V/sXAGiwJM/KhjWE//76twZsy3QCQkP8EV4bI9zcivLSrjKxohJ9VP0ECyYw1RW1fmzV+lxiEEydPYujqO9s+we0UuN302Uyvwnishzh8UK9vTq9GhmsFAvNqTnKRMKMKDlmirfKQUEnxCFYIDr3K2d3lkwqU44HLzZdrdXYTEuMpioYnibS0WkU1KPj66O4LLBv6yg8kdWAURWCF0O2VJsE8MPwgfF7Qud9xXd9M/FiqNXF8FD/s9UozMaSTkMpqaV9jkf1I4YbD8fF6roqoRcTVy9R+bJigRWFpXdJxCg0EhbR1a65VNFNH5YSrPq25F8XmQeMCiKker4xoVLSYQl+OD99Gt5NycYLsMEcryfYoC3JBvVEm3to2KHysGYSH6zBKM+n+Z1H/bqajXBH4ygq81ibY1rY51mqDnHOYjFzq5lfbOiiHNJVQJu4jtRHVhMq+4T21btml4QI64+tj9ruL3rmoNwpXHvGLvHIChsp0b6mdlHH6rvC9OoYfA/mN9djHTTxWfmN8YM2bB+FauZRq4SiQ1YU0HSWnkt4m5BvXXAROYDzmTYIMjvoCIjMjq2w7GhR1FwBREBqsnM6Je7zOmKU3xGXmRYIPlDyESiiTJjrnAw8TAMWZkeOESq//7ZuzFxR/fWRwcde2/W7yoXAf/67+PfL3y2JyuvH4EuLfdZniyipfvy//vSq/+q4/+oYXr0a6f/gw+14cOAb28hg6RVIxXtF3A/mnLWHGHULt/ZM5g3Y1CTr7csw5aXAkJuUo/qsGiW0gdcWYyFUCcBahWAbllJnIZaIRC5jpmYzVXlCh7gHJjQORuGeX8AGl1e3ZyOHnU6pTTFEbD7H4ZEXronxOw9M8bXzwgSmgkiyxEWhurnpKkkHo0+2C1Iznw9IFInls98dtyk211J3RwErvZfpln0Q7Xohb1aQB6SohkBbRihcFY4ROPB4NhybAxVcxWq76K+J+no8qUX0a3zuJLtLhROndUlfg7iV5K9I+oYwVKPkX4+pLxK1jeAHIo/MdroHIvCRJztT01MCayewd6uut2zQEi7thjUTEIpFnQasyi9Od3iZdcpYhCsRF8s8E/LHOnbN2tXsKaYCIBKX7wJptGCU7aJs2c24tDLRPrD53CtE7nQvjTzaxJM1wJvwaDdUu+PZYIo049jMoQN1Dgu101iqb1c9+Xm2OLjy7NpPdYz08krHSYU6wLWwo9TnPGYcRGbaDhJFls9WkOV51drwgqbnDJGoLsbX2ldI5sg3r482yyMmlMRpPIJXnU2S9hxXW36LtCW2WnPq67yB9YSqgWxenQsk3Nm/al7Mub92RqWaLUIh4WyuLwbvlj7pWM6pBvBcs7lNzeb+izVbqzTXlGe212U27PV7rsnsUJNp3IlUYP4iK3xTAs8t4MKOp1IQ4qAqzYwMSjA3YTF3Ac0RlBNhDRpy8Fy7+Vy7+Vy76ehcKt7UJpu7WdVd8q7WTyAqit8Ovl7CaVb4xvFjK+KH9qAv14849Eln95Olrl90CJRuU7a0rmLJVKM8adlSLvbsBUkRIvFllyRV45eVeo9CKtYDEuDaB6dSOK/r1fICB+SrJPECt2AM4Yv+W8eiIC/Q+lRsWSLlqUrpWC/l+bKB7GvCzlDfDlcpsWzgvk9ZB/Wi4SNftWXbjmb3k0kfl34of+TzMmTh6MrGmIDe4Z4tcnO45QwYxaDnQ/gA26NRODZJd1/xbqlT08lRi1OTu5lwFieyXklgJswl+fVdG/YApNzOHOiqgK8+EkTn/ySIvhwBAnsAXMGYq8FmFLDq1HayK0HetwcMvOjmhDXl92rZum2ycS+2TceZSHVl568GZY9ldSeUKbY0qIri+VbGB3bnuUWYC2uhpRUzzpoS9qC1ENKEUT2/KAhSjoLVAMY5ECca9TluqSjFvktgMxOnTYBYLaXPh1sZK8vGLqxtXd2fbXCSpqjNxeA6qWRdBfhLV8lansP2b6tzawXMxgXrxfqAhgI9X2rUljd2DQp1g7tAwjIGpiBS7fXNUqUEdXfG5WkbTA3iCjuehonDcc2kAIoYxRAyrJHx7tFwjJLTw9ZOFnlWh+xQ9IBWwvJaufrSU5tpgLqz+32qULOgnwSeelW0ZCSEhAlBlHUmcJByIldAhFCO0Yuu5bbPKfjnFPwuUvBWGpTz8GvTGGtjyU15xUZ+3jiRvYM0dtFqDZyhv8BRMksjkAzSJMEcIuUPF/XnV2SAB3WgKNFnFZsETtb6pTm8NCAhLuzsw6tiKS+hKfZFtR8QUSzoKsfzkZmUqPMHp0jg0O3mUARW8+dPkHNsYoDPGfznDP5zBv85g/+cwX/O4D9n8L/4DP5zVrl8mKtNFpvja25YhN/aKEIx3cynKBigVC4YJ38YjV/JOa+7TCMQJIs1Scz7LlZhd7ro8fTteCaluxgK913VQebGY1+grKW9okOFwfr2PBjOImw3WtZG3BFlbpqihLwz+e9GqhjK1km7Z5pqBPWtAoaaDlM1273ebweGqV0aHP7eyzMONotjGy0xn+oGcyx7R9BTqXr174O6D6T323bAA5WG2LqHcomBrz/bwsYxdzkSvX2tBrEM6sj6r1j9ZlKM6rdkk34EDjiWraj7KOOvwvB14D1SdusZ2bpfe8BWteOcfl4USnQ1R3/sBa2hkbhV7Gzfu+yxG+nzce+0742HGTDGQ0KLksrXTYRRM1NlI3JDtPNYmlvLBx4UfFVPPhxMKY17VCikaWa5Gs1zzCzuGWWe/ETuPCyrdPyc0Cc+kdurlYuqbNlNV1WVdYOq9psle9Cdese+AriN7txOWHfTwkfQs/dRdRYROSf7llTyRIq7VVZ176tdNrWNZ2MzQZHO3PDx2ZsM+9EzWxGxdkfCntl057bGGgo8Std97YP4tVdPee+bMDyZvRG9o+Kf5ppVb6cNXsf+HLqiAP1CHLqazN/AobODkUjiWRpNsPTeM7mZJmxWg74Ln9dAar0scZNvH3kh9NquHocogJo5T8OD7B5Hc4Fj4ZY/0qtdkbnNZdIdcHvs2NpGB7BmoqBST5pwwlTOPC8DsznnfmCWSz/gRKpaPe89mN1W6Jo7rAXpc6yicrz5Hsz7N0LdZldoO1q+Gnw7eLXmWstev7/84bte/amChMKQYyF++LevTk5Pb84mk5eehhFGIeZ9zdiE0V6XKy8tOP/VkvpC5IhM1WCGggX3WIqhsdsTzj6uhvo+Mhbcb3Gzp1EB+to64UfNdNgPSTVfGKuPzH2aazH0zmEh0tRpHgvtR8vXg1eD4/VzuX7Wnuckg5pV7tUlSNO8eL7pPDf+dVZZPj/o7Si9jclYZLSCiu7EaIX2o+Xx4Lud3Znb6xdh/2BuGVOckgvgx0geP0FnGMmU4/4cSSx+uLX30v2gcgGd1sH7X/dzk3npEt6nWYGfUtoZe7wjB7rGZi3tkP221W1PJyU/5Rw5E8akQPscz4mQHHWbs6aPlQgpy8O9zGHWrc4xin7u/9WW+vOsZ1AL9nzfNOx6tXp+O/D296qvvQJ9fHV5e3N1cXF2c3d6c/7L2Y2f4l6F7YE2Ob87uzy9vjq/vPUDSin5OBoOt5lBf3cn1+d3H24u/L253QUoyTWgupR6+1vhswldv4rcpr8f/u0rH318+jXlUaW1HZ6vsSFXsX11Ll/2PtkCML15fCtvJ3p7yCnhenOzDgm1B/hV3UkfTqwOg8YURt5EH+k7xaA3zakKfMbvTa3L9S/iSN/NKwql3ieFu1SfJLDv9PFTZcQ3Dns/Kt5cv8/aH1vM763eX9K9IXi5QUC9lll7wvhlxidfSPCyzNefWymKs6gyU6p9+XVmY6O2W3K3WTHAkwfA60P+QjipYa42Yamd5L5bGqrb+LfId2+V6T6oprO9E9aFI5TgW5/dfpL12HEddk9frk/q7SjjrLWIUSHdU+GNPe479bYJWfKsp0P3yWXWzmVVzvK7llKPkE6mm1OEY0Yfk6lTg3tsjs7C2DAx5Ptq53m5rJPtkNtNCq2AxLY0erK0mWb2jXNmxSUCsGBCXmKpvKZS4fe6LJqGslWwy/vlE0W6OsfD1cKKsMwQVIu6nyC5+OHfvjIu+N3N2bu7ydX457vrk9ufSjAiMsPBKqgeopdwPJEsKT8EwB9xUH0G7kBjJdOHU0KHQovxfqD+z2Po8xkMi8gNfRmBDk3UCHU8xmqSR0XvjIXUHuLxUW9NMNBOhgUvvONo7/TnD2/P7i6vTs/2k4/Q14Qovr5sykfovtvicM2iokuAx1BmTYCnFqTMvy+xeTuUYtPNYqJuz7zeZf9RVhcHWZIIzyv7T8xPgBI0JRHxbXRFoV4k45Pru/c/X16dqgWi/pj8Ork7OX1/fqke5H/8VvpcH4dy7bo+EwGKkN3uWcZh7/HcBjGmwyZhIRSoGen89GXvMwhLFpi6W+y/MohPsA43j2d3kWkegviBlmwEH4TPIOidg7g5e3/1oYk+vVp2ff9iKsTKtlnzfYiX/s5ZKPq6YcdAuFM7laaHQDEOcQiCmY1+iK7M9wIElmkChAoSmvMFczsKEMc1SGr/Pprb0wCCe3dYozLKIEbBgtDaUVwGU/udFlW9t8WzUHrewRNlfqEwVn5Wy9CFMjVc23UBf+9sKNTrt64l/qkx2xZPNfKMrw42UEut/TQYDncW3mrYhsUVN1t0Dzpx7zZ4+A2Y7XBqYuqN8NpoUhpYqbVDH18Ve/uRRFj7ykXXuByQrZ4oPDk/NVf01D1nH3kz9/QQbll+bTcgCTyl+nAHc3ZTzML8TANIBRZHisr6hAl3YbQG4wkaD3GywDHmKOoBppKv1KK34mJwAArOOZ2xK/reOGfWyDBBH3fAo338/wYAITdzP9g7AQA=",
+	"openebs-operator-1.11.0-ee.yaml": "H4sIAAAAAAAC/+x9/XMbN5Lo7/oruqi9c5wTScn52DzWeqtkSnG0sSU9Uc69vK2cAs6AJFYzwCyAoczcu//9Fb7mEzMcUqRs30mViqUZTHej0ehudDeAQxhzjCSG92iFYIL5kgQYToOApVQeoIT8grkgjI5geXJwT2g4co1cmxhLFCKJRgcAFMV4BCzBFE9FP0Yr1GcJ5kgybt+KBAV5k4N+v39wCGd4RiiGGxZhkAskAUURexBgviWMCmAUfv5BQMJCMQxxErFVjKkUlqRxlAqJuQJQoplPUTBAqVwwTv7QkAb3P4gBYcPlyRRLdNKZep5GWKhGfUAJectZmogR/L33de+3AwAAjgVLeYD1Q8pCLHpHYH4ZJpx9XNl2S8ynhQ87QXNs0yCF4b7+XXHD/TvEH3Gg/ihwp/LncEYoisgfmOs3HCcRCTRXAkYlZ1FUfiOwAYGXDhimYcKI/SNgdEbmMUosXQG37f/BpqYBZ1T/vnXXhUQSz9LIURIiHDOq/9oapvvjnymTSEONSEwkR3SOa2AjIqRq8oBksOiMgtA5x0KYQdKyx6hEUcJClEomAuQYvcRcksD3JlBvZmp4sCBzqiH+M8VC7ohEIRlHcxxEyNGZqEkjJKZyyaI0Vq9I7H3Tjfm2LUWJWDA5wB8l5hRFfYvZTkQfbeUvDaNKj9SErRExx9IMZpEdWgqVflO/pUlof0vcyxBHWGIf+SghimSqFIloITZIhWSxexQqTUa0yqrQB1UCfXRZcjIKPXRZ9eSnBnpfV9D2vu6BB0qgRmGwI1iM8ZDQonr1MSrCSOCmUctGy3En50TOHcsx32CFMRFqpDieEyH5OlqWKCIhkoTOH/B0wdi90WWp+VBLXJzKlgb+XuRD2taN4tBSRm8sYR9u3mlYwxhLTgIvjq6zO2FhSARPE0XtNA3nWHrhecUxp9mpFGOl3xAaglzgqpcAD0Qu9AttwK85WZIIz7EYHBzC7dXZ1QjGCxzcA5lBiGcojSQg+ymKBAOKcShAMpgq8485rlt1hZvQ+Z6Mu0in/8CBtPbd6+UAtINw7yv+DWcRvsEzBbjuqayD6Ma5pat6aIpMQUkihpmrdpYZfw8vNDqUEOVR4Ab/DCBCUxxpzjR+B5ArkmHA4oRRTGW/S+ulo/tkcHIyOO5jfCASHCh0Akc4kIwb1LESxXcFWlqo2Ywe5+yM4ERhVcoDz1cGiVwleAQ32EwO/Ui5SYTOP+jpPAKaRtEBgMRxEqkHhtgCrwHKPFxD+aa8XM9PAADHU/17SbAv18g0gPINEaGYFzrQb+8CAInRHF+nUXTNIhKsRnAxu2TymmOB7WQqtDNwFMMIG8Y5vFG5E+YnYVwWKDHUZDReMy5H8N33331faIHpsvzBIVxdn1+ev5ncXVzd/fzhzfnd+Oryx4u3gCmaRlhoetT0c8wCyRQOigOpfv35B1GBN0UCh8AoyAURYIzFAG7VH+q/OWUch0BmgONErgaVr107kSaqeziEGeNlIjAHO7ZwPPhu8AoYfUA8rNDhxsXfvQpWWKIoxSPoDRcsxsN0mlKZDgf36RQPTQ96LVz7YXL3/nRye36zK6ahMORYiM+Ea1n3mpi2kDIZDYcnf341ePXD4OTVD4NvRj8c/3DcxLPL0/fnk+vT8TkknC1JiIU2mZnOBTYzfMgXbIAEIFqBh+mScEb1+yXiRPG+MhvKvckQl1rZjvzIWTyqvACYERyF1mx5310juRhlam6QdaKh75Pzm18uxud3p+Px1YfL2zIHnLQ4l8DxIWEhIPHY3ldQ75wHSrMO6iq1gRHvT389vbu+OtODUheEHfe9hG2/o18h9WJWnEzjm/PT2/O7s/MfTz+8u72b3F7dnL7NdC4RMEOR0K4fhasE0/M3E+coVuAWV62AaOgeJIxF8ECiCCiTMMVgDHY4aOXPOtJ8LBtBT/IUt2jGi8vJ7em7dxnMsQJ6N7k+vZmc311fXb2DEAd62B8WWLm7rq+gF2UgEsQFBt0lsWBpFMK0yl+3GsFKTABBgrieONaOA6FCoigynmJ9bEwXALVg1syc4gKmIyAz6Omh6gGRZXZnrbxKWnMOJLrHAvBspgwCo9FKAdxqLA612sfarKiurBvltUPSMNKms+uHenxz5p2dQASkAocgmbWSw5AI/UZ9UhylCpIZZ7EJyZ5eX1hTNoA3q2zElMoY35yJbKAsMBxWILWwY3xzVmncWcjfnE7O784uboo9zIRAG3YICdf++0qbZSeZjMKCCQkJkouqrPy7WvtlGiBAVE9vDCryRZSsz0iExQDOLA80GgUoY8JwifgwX7u0CoXrQsPYF0G1MMKI0u3pzdvzWwVNkz3FGVOUfSCzlR4v1XFFbgWaZNkHilMCLQmd6w/EAqluK+9WidUUyweMqXlFQgwB4lUjwWZ2MpuImbIk9SmJMzHS3NOoiShxb2iUwVr9Wel+B2ZayGt5qualm6OfE2O1elRsNbrt/PIXIMIEMhxtKlihIrcaYMQC3wzXph47nRtiHdTf/1BVuLqT8frbxS+nBvBOxsl1+G9kiWBiDPy1YnrEUIhDmK6cjtiGW5+jfSox8HH66N3V+PTd9S93P11Nbq9Pb3/a7ZC4OIH7quyQ7WR8hmq+RJ/jKPlY22X+6A71OsnA+Ory9ubq3bvzm7uL96dvzxvAF8Il/yBLlMdJumG5Ob9+dzE+fQoUjYuuEfS+6W1kXTpSq5V0nwg5lxsQXVCOG+FRxmA7NO/fvr/dHFc/nseb9+uXq3cf3p9vgdI4EZsideiuLi8U9o4Y4z7+qOM4fDuGnv+f66ub2/P94vvp/N11dxQRoenHfipJJJpwlJT3+eXpm3fnd6eXp+9+vb0YT4DMigoKBKahAEQZXcUsFZAKNK8uDU2yXn3ylrF5hOGUomglSbDWIa5i77z8bV5inN/c3f563cQpZ00wlZgnnAicxZ57Bw0syqi7u764fHt3cXl7fvPL6bs2ExfarB18RSgsWMrFyxJ0ZeASzAkLSQCJchEtDwWm0sfIQelztxohAl59uyi/e08oidNYvTtZDOBXlmpCA0ZV5t/EeiSDB4zvo5UynAJLlXSEk+9/WBy0hygbOHHgjVa++nZRZGlElphiIa45m+JynEeVkZSfAAQsjhENq48B+jBMBTcWbjgldKiEP5CRp6GNw5be6FQ5is5whFYTHDAaihF8c1xqY0Yme/t98S3HKCRfbD+qqbtqgdXalF3fxh27pO46Z5X6YhlkObhCssVJIEqI7VdSzbUknEkWsGgEt+Nr+0wiPseylJYpJ/YaEkkC63z+6UzXU6xGcMkofky20ykbHXRVX2+S8vR/3MrVDp88PvnZhGQLyj5ZGrS5D1vxFz5pQrSNqsdkRR3c+x9KsL0Z0jXpznrizgKHAuBPk7vzEbLv/N2xzt2p/7em7xoSxY9n3W5yxTvk3M7yxQ745dXZ+X5SUDr5RlmIaym3zzAJqrNxLh1Zz/+VLaASG6eYdFeOKlB1mXRxtG2qaMa4GmcN0oaAjdYemrIucGWknrQQZQqkVkZH0CsT5HyNXhZrz4JDEWP3abIbOf2mfzM+6SarNX42iWulI/Y31ZfKF9ecBVgIjSfvnja+yicnAnSlsFlhKAaffAfBAnEUSFyLF1tGAjFh5WTOcQIslUkqq6yaMJilUaTRBoi+kBl7dUJEl9eZ77/6q0UpXg5OgeN5GiEO+KNCJuoxZi0kmn4rZJhKwrHzg01HF0jkKyZSz+Cd0mDBOPz+H7/DKAOG6AqE5CaejiQIibgUhtTfy0bw9wq83wdf/94MSFFTBQAzpvYDmNDiH5gzUMPCVEcWiO9lWSMWnof9wPNQYiHhdzM8/QB6/1FxAQZf936H19qp2vWCYQvv11VQr9mW0eYD+0B08tTWfrg7f9iPamsqN/KNH+MDN9H9CP5+Wn84oynfYdLq53rae33crp50zRf+TByDHRqbzqZmT4ZmB2ZmGyNTkZTfDza0MdXvvywjU6F+h0Zmf46rFRRHOuRT3Oe85s3WuK+7dF1367Zu4LJu5q7WtGtLuMGvXtfFEL58/br7ZVdVfovC4JPgIGK0y+prt2uvXa+8Nlp3bbrqejaDOzOD9cXWZmbwi1xrVajf8Vorn0pqNFWcqR8Scd+PEUVzzIFj5eeHLoR3cAgXspqINNtDyR+mFkx9rqfgFJv65RmJtBx780BjDfc9SloWdDSM+wb/I7NABUAO0yGkIV72NbmgdybYKiAOCScx4ivTFXhYkGCRFyvb6KjuP0+pAq2hmc4aJMIuGyIXARXAZo4d0LeTmHClDmKYMSA0iNIQa0CKc/ij/tsIFgicIK4Hw0i4OID6gA0MphH8vwObrppii9zJZR/u8WpU6HdloaZeQPmF3qM+KlYQWSACIzXvpBeQe+kHpguPK9BixBtAqTdriCqxvtJZJgyXLEf7pm0FCRNaeDO2VxrVmQCuqQoYHw2xDIYLJqQ4Gk4Zk70yCUtMQ8b9mM27LgitiIyg1/MQMX539eHsza+3R7Y4rUKCKkjzE6DePB59xFhyNAzxcjgLj80vgttfOIrNL2HcN7/EoX0zDXvr4j76TIQJlu1aYvNAj/londLYXxDHAe9AgdlcPfEFZop5ykdGZ3KCOjFl88jLYbXCv25znAeo9CqjgKIIVAKGUyyx0O3FQcGtXLEUHrRajsg9BsmMO5VVnugCSsFig0ocgUCrDHMOyfoLS+wKPgFJqRRYeKRRBAaF4h7IBRPme62n00yRHeqHEysEzvE5zN7iwXyQwcgxaUqMt9OHwvEHQ/VirvZH91674yz66lkvg6g4E8jIAlXv4C/q/3qg/roZsCLt+Xg1wBiBF8imkTWlMC+xfGD8vqRr2iJuNZFpXRDWWvdRHH7/7eh48H11SYj4vLbvtr98/W1l7YFhhpFMOe7PkfTsgDHShR/gw4eLM0DRnHEiF7F/CYMcMAhSzjGVqtyXwmmULJDRwaXPFEX9Inrxuvf2+vYNEjhU6HptK9zT6AGtiisKgYOUE7kaMyrxR1nue+JOOQhH1eJhk3Z7r4ZY+BfHmcNW/InVB2Zpqy3lsMl/qXwn0qn5qmN7jlF4RaPVqLnoWXk5LdTxlA5rTdy3CWdBnB2Z0NI91XBz0lTyvFavXoZerL0u6uIiGLOnYQNAtT0WniqLzL4CodYnvjx7D0Tkm7My/Z0gYaeFanLmzjWqQESi+67PT5jiVp3Ryko1sERnxEIq1JIzZA86tqA3tNm1vOp6pss+m9qBw3L1aLaN7UFHBwqbdvR2NH16id0v4d11egjEhHeyNf8R6K2uJSidN6/avTs/Xrx75AaeCfkDfzVdSSxeVjYjKYraeuQjZXLxf5vKfE+O//zNn789+eHVt7XqmkmB0TSNp5grUkqMKdGxloy2/QbH+6mA1eEIz/MeDePezgLyxqh47H1NxwcualAmtiVs0Kr81bTWM6ZMZoslMI73mZs3B/lwa+UKWvPDV5IBCnTszzzWUsdm2oCopyfqD4X9JRBqduXVVMUhSKathoHR14fTqe9MoAXRUG+NNgdyHXQxVa3drZmspq62WKtWBG2mq4Lrio/zasy1pq0zVvP1LkoNlIhtX2VQ+Xpt6Gr/NQU1LJvQ9GQVBB4qN+MdfNJ6gdyHrRHVvnxxzZvXL+sd/h1uJ1BrE8/joYyTYUaqCO/7CudqvZX4ts1InJRNyAyRKOX4dsGxWLAoHMFJm9/qWP/vp7fjnz5doaQj45OcV9J4Dg0uTRBIWIML8hmcMlPI092cql1xTVwcQa8+b/ylu+N356eXH67v/nb1Zl973vZ8hoZaYrg+bnWAxmbnZzRtkvssEp3Nac4gDoEI+Avk6c6n9pVZ7/OokMyOEu13PxcRJckI8g/tkaHr3Ja1H7T4LjvZrbPWAWrs12YeSgt7tmLRp3VRmmnyeShVcXpUtY03BIsiwSI2l0zIEHNej9C+qjx69dd/PfkCSnhyQk7P3l9MJhdXl3f/fv7mp6urn9tMW9M8/m9bdrJ5zUiFNc01I/6SkernnUtGdlAi81lUnVQY8HlV+Os9zMnycftc/UA6VaF3+HR3a/ImZI+g9MlW6s20P4rP8Lntf+17jhhavxavW9IiSNv55+2vz9tfn7e/fhnbX/+HnwH8BZ238xQHHz0Xfj+28LtiDjcu/K5+/2UVfleo33Xh9xY/xY+g7WfNR+PJ9VgnLseT64uuH22GqfNPZS1SvxApu2nFFqzrYnd3oc1ZdhdSabli6mQgToWdNLb0AAdGpwqY4og9HGkm6LisbqDqwEfwlyRKOYr+OviLLrT7a7b80efVJYxFgblgRQyqVxxla4xD0N/qD0EySIWGDjfnk1tVnTKCIUqIGFoUw79YT0Ih049GUIMNcJg5HNvAXRaK/wEOARN9MvilM6ohMO7ujjkAEAFL8Kjw2i3xzDQ7BMOmjBSn5iwvP9y8ayRm6FisIZnfPey1eFRpj1aNVUxIAKKAIoKE8fUwjN9dmD0PjENIRBIhk/hyMOpYLBIlXEAEUMZjFEWmXGSMYhyNtS+ZEaHWZ/qUNZ5dhwQxomSGhdRZBO1VGM1rJVadL6sOlx2XMIoF49qGC3MVoXmAeaZGmRXdVQlX3lHTsQxKXnEfiCQ4ALVi0DMDRdecKHM8VvUd1Dbsw98mV5fGuRgIiWQqBguMIrlYXVAhEQ2y2lwj/T/5X4ZYBJzoS6BGcFuqsbHgIHAMqH5r1rqKNldD6qMq18Ohn7Lr5gYt1BXAPpbCEAvCm6g7879socyC25qqzF/WMQTC6C2JsZAoTkqEnc5xCZ6uXq9Hh74IjUwch55V8l5UMilJ4N50skPzhEr5ooxyr1qZbKiV1ZpP1+fnKz2ri8sPS7pEvdPNbDFpxlzIBhGE2laR5nloowBMFxu1XIASFBC5GqiBLmuSSB9Aj0MfPUq3odgtud2OCy2/epnhVlSKwmzpMMWYQrJYCXVzabQCVMGwGcEzjsv8+zF/0EJrakoA1NeWXrREJNIPC1RvRZJkEkUlmsb2lZcu1bpOWVYIvikZrgi+RMBN+WGJgItQrTdnxC4+FT5TE6k398QsLJuSKWMRRtSDX4m0+trsOx0o83CDSKjvILxdJeVhKjyoDZNClNUUW6HalA3JAokyxol+0YEDdq+I9XEcIZ0J+B9mom1l8f9s47xva/aLZvI+PQE7jPvzAZbFLuzTFJdx6WfLrZZMO1chJbL2p0TWGSgf/WNLsDvzsdzUa8VKHfhiFZfd0/6svvavvsb5hpG9KjE7pPtWZUGxO0+i0AoYjVoLnlqvGTry+Irqmih+/Owa1TRMomoUyLOH9BQqxtSD7F/FuDHdt45Jiv15Eh1T6pnRMkn2e0bOkyodBcFs7cu58axpPJrG1Z49a5r9a5obw+v9qxo3qPtWNbzUoT3qmiXfSoE0hyg/iE7RSSUsJgpJBPQiNjfxxx4EjIo0NpUL5gp1JFF+mMJjg38bRFD1EVmG0CJRyFGkRxNFERApNBxMlaoUTxwYyxSyeliSz6dVy52Lm1XWdIvNxNXPWgtrWxs/vmS5Dn4javZeluyjb0N+fdrSYz89j7l0pwTRbizuVHH82e2gqVRC2kLIPValdqyGVMVOd9mG1U+y9ff5UvM6I54v4M7LzT/TC7i98K8vtr5WtnT62+dxb+6T3/N6cz759XLsu0mzCPqb496jXJnlVp7McgNHZrlXP2a5kRuzfGIvZrmhE7P8vHyY5a5dmOV2HsyzXWy2i/Y+8i1N4gLJFrsYKBaEaZzk5s6F7Fm4f+OUd21XdunZ9Y3xJ77mfg/3wW/iLezrZvgtrk8uacPdXJ5cBrnh1cl//u7PXa9ONk19VydXbMbeLk42ErD18SjVzzsektLxs8/jqJRmYrc4MKWt549gGnxBh6eYftiWLe7L8/kpuz8/xT/de8WLUVyuBUKtP0yM2Xl048mFu+ELkghRDJmUiqODQ7OLEQnBAqJi6zC+EfCvcPPmdAw81Z5fGUdWebzEXG+gPDg0Ba4a0Ae9GxtOvh8cf2t2z2EqryZgrpyAMMUgmaJTB9xVfhQYPTiEe8wpjgqkAZsBmSjinUoeAPzIODCd1DK7vmEWoSVLuT5h9OAQ9HZXCImQnIkjk4OgGIfu9NqYhXbP56G6JIYKydNAn0mqGui3q9HBoe1F/+QH1ws0ZUs8ArVZX4yGwzmRi3Q6CFic+WBmmAJBhtOITYcxEhLzoRmQIRGBICiM+2kBcB/RsK8B90OciMEKxdHBIUw+TM7hne7IebY/WNtSzOHk1QgeT4VIBS5uPrZXQ5282oCQ7/ZJyHdFQg4O9bjbgwaUg44gJLOZkhgJVxMrEXKBTNxKs1RJ9slLeIvNAXMRESZ3ZcJdbPoPHJiTbkVhocM4ONJgSijiK5N4RBqN1dCDg0N49RLGCxzc28PFDRzEMaBInyNZ3QmdcaMfcqLYl6nmYstAEHM3gl5iHMI3L0EfXm+zQ5ZKrQfo3CK1J/JqJA6mlu5Ps3NWzddLd+PEmemrPtzvk+2c3UsBQi3plvknzu4TNZCEzpiqISADW3xvUWYuUVYJUGtRSsW7nPmFYu0FnbFKijtHlqf18zz/EkUk1OTmTvTp9cUv30yCBY4z3yfhyn2QBBcscyCIGcTCs0pm852dWWrkjXAL4CnVBW02iZ1dQWIuksoRFdfRROJYVK92qFNkfgyi6lNPRjinyi74lUWws5/jmSJWskENTi33mv+ozlycdUCtGpqjLxUPLBHZudhLgh82witZoryj1c94JdZgd0Nyj1fF81mmqwIlddyeAVhLlHuJOEerg6anhboUpC4MOTkwGXSFDAUBTiQOL6vS3uuVhFz/qQ4H0JNRjODvv+lFBOM4tNPbPNxb0VGtSMjcvKMWay11Qs21PuVqnTXFOJ5ylgL65lqUvJG/+Oa0/H5NSVsFmn67RFuc1NBB/7c0n7iLP61lgc2tyyPsysEepcuw2V2mGERICCwG7u81VqSpmceUmMF3fBwrPPqtcpN+bmqh1EpJGL3UVmTR08Znow6NZtZLqvKpUoQW79U6GZx892+6vfM9lyf9k+8GIQvEIG+nBkE9Gkok7sXQXG/Qz9/3UUKG5kbIviu5EtUH/TAbKjFUOLWrxpf4A72n7IH+qIgV+R2BHe1sSVV72JydTCMgQRzFWGorpawXgpSGmEcrUy2uR/nAibRYCYljSAUWalMrtU6KdpfNKOQSAqcOS9AoC+rH5RmmK9teYybSnhZEKKDK99a4DnxgcQ4YcQyU0T4t6rsmm1+Yb01eyAvFaNMG9LC5MnvzDId6N2+MsgOtOLaOdz0jzWaAaNYPs94R7lLNgNEl5hI4Dtickj8yyMIdj6SiS0JWDsCRmFMUmbW9KUuN0Qo4VjggpQVouokYwHvGFYNnrLTqdBpGHeaTqrDiUC/tyTSVjAt1UyGOVH1kX9NJtewO4vAwE/IXB2sMu74fmTBqIzhNDC83g1BJaazZ/rDAej1WlYyxLTHw3SAjF5ylc1PG650S2Y2mGi8OjYQrQZyylIaVj8rMF+6jAUwytWLYrOWwd4PVuqmnh6V3ptv2BvnjGCMqKuQi6aPV9lDBUaS5rdnZvANGCzO4BDLb8m1msaLrHidykBFkyFiDugSyIxk+1BnDbuyqc1CKs6VxNdBmiKw8NAxcK3A1X74saPp1dvcvimsbmov+/QLRUF9WtCDlIfNIlb93Xhq11WzUPT/bOmlkPzPClasYl1PUNdxuJtZJzJYl9jORKx+lLAidYW7NpFtVYBqaFYW55pxURUCk01iJgLvtXC12YIyovd/K3NYZDuCC5nXdu1Y9iqGirzi4XvnkBq/I7WKJs39F6AVWGaYcthmre7zqm4GKUWKClxWJciayclVPBmbGeG5k3eTS8VIscFHDsAT90wQ+f85dlBovzPjbxy7cU4zcl3Vu8YWm98ALrLDMME5p9qiSFMvfu1xD5UpFyx37bI9LOLWeeDon22jOJ/CyCyq60c82bdZ52pbkdlc7Q1dztkU6zfwBQ68dTPjP/3r2xctDUVDHJrgcyBRF0GNUX9vSy+ThoKLGbeS30c4++7ufh7/7bNqfxrQXE9k1PquXmRznU0KL5gbLiOmqfc75Pb6mMHPzSmiL1ZAe3PU++1q/vbV/dtLWIHZdN7Wulep0dl07bbJoeeTCpbxmqkHuQk4r+hrEjuuoCwrhiqKYBDk0l0SqwQyQwEdFI+wKLWdEX11MqHFXVRd6ZyXR65kvPPkBNbeyfZS+NXb9eSFDojKwNaAJx338kYiy/3sEqVBfvf8wuS0UYuadKcamajCbh8UZKN8U9i9LG5emDcvT1mSHP+XUcalaSENldbtaf6m3db5uPPFtjYZm+dQPVNgboTPSOJYpp3leSNH4FsvrKJ0Tqnz5r16C2teplzlqytSFymSSGkekkZfGTLby0jQpxEK1YTSH9aGcMW5meK9HVj+3JVeWxHEq9alwaCYxz6Zgcx+ac5CQUfGTDjrU31e7VGpe6Jrjfs+1uCNhT9s+D8iGaddVRPzqQf0U2TPYNA1o1h4dGVFs7GODee+YUDvvqfxj5YIEi6JcFAKGRu2a/evoHlP9xT654VnNF5lU0rq6iK9tHhS1iFdrM9t5rWGFLwBqPICI0bnoPEPLpHoK4DwDWmhdGVVvigAkq5FZ6EqbEQBi/ZdqmmGg7jEfeD+t1X+DK+Ez50hoW4dpgM26pomCFzb1YZRiHeKUmB0YOlwFU7X/zWzyn2KzKNWmFFB5Fnfzi0znjwwB7j4Ds8ndKyH2g8ws2Js+6gKvOlMgdYGSBNPBXnWnf43rla7T6wu3rs23yuvBkhvrqbxmcx3WFxczg8ad0oAgITjApeWyPi0Wo9A89IAEdwWFGzszTTVxhSW2ckQAGQHRu/yHb1mLcjIxFHN6gMrGH4FIlf4T7lRsdRABHriSzUFeCPz3V78NvCCVUOKPKE4ifATEcLlYJ6NnBhGGERk8ezatn0hIWGg7/KA7qtQvMNvRFENE7vEIenqzRk7ifyqp/q+eF+ZXxgHoqSa93D2t+Vs5gcbX52Q+xxz7uak+wGpZ+xIYV32nrABAgyV5VjasEfz3V781UFvmExAa4o/wyjjh5lKdl3aWiRWV6KPCEyyYwFRX1PqpVfNziUEwdXgxjqK+8+0f0Er13w2XyfwmiMtyhMcL9fbq7GpkqFIiNKfm3BcJM6L0mCneKgcFnRKHYIHo3G+c3cE7qUw5HrzYdLZWYzMtMZqqYniaSEenXlCPja/34rIgvq298ERWA0ZVCF4M2VJtEcAPwwfG7wmd95Xc9c3Ai6E2F8ND/c9WvTCbSzp1pWSW9tkfhUcMN+6Oi9V1NUIvJq5eovJlxQMrKkvvlIhRaDQsoqtdS6nimz5ZJlj1bcm/LjIPGBVESPV8Y0alpMMU/HBx9jSym5KNJ2CDO15PsEFbkg3qiTb30IhD5WHNJT5YQ1GeT/MvHvXrajbCnSOkuMxj7Y5pZZ9nqTrEOYvFzK1ufrGhi3JIVwFt4jpSn+9NqOwT2lfvmpckRNj12Pqo7f6iZw7KnaK1Z/wSj66wkRK91tRL1LH6rjC8Ogbfg/nN9VgHTXxefmP8oI3aR5GarahVQtERKwpkOk/PJbxNyLeuuIgcwMVMOwSZH3QERGbne1hxtCRqqQAiIDXZOZ0S9606YpRfMZi5Fgg+UPIRKKJMmPvADDxMAxZm57MRKr//tu7MXFH99ZGhx9779rvKhcC//Yv4l8vfLYvK88fQS4s466NFlFY/+V9/Pu4fn/SPT+D4eKT/gw+348GBr28jQ6VXIRUvpnE/mHPWHmLULdzcM5k3YFOTrLcvw5SXAkNuUI7qo2qM0AarthgLoUoA1hoE27CUOguxRCRyGTM1mqnKEzrCPTChsTOK9vwGP7i8uj0fOep0Sm2KIWLzOQ6PvHBNjN+twJRcu1WYwFQQSZa4qFQ3d10l6eD0yXZFasbzAYkis3z+u5M2JeZa6+4oYKX3Mt2yD6LdLuTNCvqAFM0QaM8IhavCiQsHnpUNx+bsCVex2q76a6q+Hk9qUf2anjvJ7lLh1Gld09cgbqX5K5q+IQzVqPnXU+qLRG2j+IHII7Od7oEIfOTJztTslMB6Edi7VbejNlgJl3bDWggIxaLOA1aVF2c7vMJavDul4NIr4ZmQP9aJa9au5k8xFQCRGIR66eoMGz0Y5bsoX3YzKa0MtA9sPvaKkDuNpVFGm2SyBngTGe1Ganc6G1yRZhqbJXSgjqyhdhhL9e0Kk19mi50rj679VMdIL690nFSo024LO0p9i8dMgshM+0GiKPLZDLIyr1obWdD8nCES1dX4Wv8KyZz45vnR5nnEhJI4jUdw3Nklac9xteW3SFtiqzWnvm41sJ5RNZDNs3OBhDsoWY2LOSTZjqhUo0UoJJzN9b3y3dInHcs5VQeeaza3qdncf7Fma5XmmvLM9rrMhr1+zzWZHWoyzXIiFZi/yArflMJzE7iw46kUhDioajOjgxLMTVjM3dZzBOVEWIOFHDzXbj7Xbj7Xbjo+l4o3tcvmruZdII4CibmaP4GoGH7b+XoJp5nhG8ePrYof2qPRHB5x6NPO7idLXb/oECjdpmxpXcWSqUZ50rKlXO3Z26QiROLLLkmqxi8r9R6FVKwHJMC1D06lcF7Xq+UFDshXSeIFbsEYxhfXbx2LgrxA60OxZYmUpyqlY72U58sGtq8JO0N9O1ylxLJB+j5lHdSLho981ZZtO5rdT6Z9XPqh/JFvlSELp3w2xgT0DvdskptzQGfAKAY9HsIH2B6NwrFJuvuKd0tIDZKjlkVNvsyE8ziR9UoCM2Auya8vJrEHIOV+5kBXBXz1kSA6/ydB9OUIENgz4ArOXA02o4AVUotkV4q8bw8YeNFtEdaU36tl67bJxr3YNh1nItWVnb8alD3B1p1QpsTSkCqK51uZNbA7zy3CXFgPLa24cdaVsAethZAmjOrxRUGQchSsBjDOgTjVqM9xS0Up9l0Cm7k4bQrEWil9PtzKeFk2dmF96+r+bEOTNEVtLgbXySTrKsBfumrW8hi2f1sdW6tgNi5YL9YHNBTo+VKjtryxa1CoG9wFElYwMAWR6lXfLFVGUKMzS562ztQgrrCTaZg4GtcMCqCIUQwhw5oY7x4NJyg5P2ztZFFmdcgORQ9oJayslasvPbWZBqi75MBnCrUI+lngqVdFS0ZCSJgQRF81joOUE7kCIoRaGL3oWm77nIJ/TsHvIgVvtUE5D782jbE2ltyUV2yU540T2TtIYxe91sA5+gscJbM0AskgTRLMIVLr4aL9/IoM8KAOFCX6sGKTwMlavzSHlwYkxIWdfXhVLOUlNMW+qPYDIkoEXeV43jOTEnXrwSkSOHS7Odz9/f4EOccmBvicwX/O4D9n8J8z+M8Z/OcM/nMG/4vP4D9nlcuHuT7h0aJvUHCfJnBjjZg5X/QJjxbd87m1U9090XJObdMJtfbc3s3OqbXofKfTmlfVM2nf5E+9J9FO75PSH4W71Yu4Cg82vCBZbewy6qSwXbRYgeDLUZs3wKiN8xjMkGCuvDoclmZj453CCrX5sobaPB6q3G2YRl4a3pSbQOF+zS53GXe8xdhggdI3JfBPcfiys/DhE0tzFa9PrCttqvI9dq87CHpQFeUM9rNMd8GbcLxUXkoNswo2qBc+lO+QkIXAqOt2xX8sYX8CibfOypNJusPnk3D7rirZN4XHXonmQpb+KGjqErriky0E3X7ZIG6+ITdvdDMr60Rk/uEGcv7oKWZRbjrHNtHfdpRaFLiSZjus5rjAGxbhNzZrUxRzZcEHKJULxskfJsJSkfV1F5gFgmS5PYl53+WG7M5iPcR9KN0kZ2++Kty5VgeZB+v6AmUt7bVoKu3Yt+fvcRZhe7BFrccdSeamKUrIWzMNG7liLGOdtXvmqSZQ3+JkuOkoVd51r/fbARR2wupneYWHEU9hGy0xn+oGcyx7R9BTpZHq3wd1AVvvt+2AB6rsY2sM5ZJOHz7bwuaNd9kTfVxADWIZ1JHNF2D1mynpUr8lm+AROOBYtpLu44y/6tWHwHuE/9YjsjVee6BpFXHOPy8JJb6ao9b2QtbQ6MsqdRb3LjF2Y33e753i3ribAWM8JLSoqXxoIoyahSrrkeuiHcfS2Fo58JDg87Z8NJjSZfeoULjcLHI1nueUWdozzjx1mKKQBlee25zQJ74BxWuVK3fAdrFVVWPdYKr9bskebKc+IUkB3MZ2bqesu1nhI+jZ2z87q4hckn1TKnkiw92qq7rjatdNbf3Z2E1QrDM3qn32LsN+7MxWTKzdSbVnMd25r7GGA4+ydV/7IH7ttVPe+72MTGZvRO+o+GfA6IzMvUgbVh37W9AVFegXsqCr6fwNFnS2MxJJPEujCZbeu703s4TNZrB8P3UnSK23U2/ybcu93muv5O6I7XG0AqjB8zQ8yC7ONjdmFy5WJr3ateSb3Q/embbH9q2tdwDrxwoqu3gSTpiqVMyL722lXz8wk6YfcCLVDgnv3ePd5mn7DeTqG45VLpTX7x7/Z4pWqiv3Pwh1h3Ch7Wh5PPh2cLzmMvFev798/V2v/lRBQmHIsRCv//TV6dnZzflk8tLTMMIoxLyvZZsw2uty0bgF57/Qe7hEfBiRqerMULDgHksxNN57wtnH1VDfAsuC+y3uUzeGQF8WLPykGYT9kFSrtGL1kQkmrqXQO4aFeFOncSy0Hy1fDY4HJ+vHcv2oPY9JBjXbL1FXIk3j4vmm89j451ll+rzWm4B7G7OxKGgFQ91J0ArtR8uTwfeD4+pnHUaxodMF2K/N3a5KUnId/BjN42foDCOZctyfI4nF61t7G/BrVYHhaY0/So76xrfuOytWa9w4ad7/end5+v7cN2l+5MxzzL6uNfIcEw2lM3czezqg1dOY9zZdP6VqNC58R3F1jc3E26GsbmsIn06lfsoxcv6OqVLrczwnQnLUbcyaPlb6pqw89zKGGVpdBib6+ZKxSS88j3rRdSV907A+1jFaaUWlvPXMwzXsHpW96s2Gt5G7V9fnl+dvJnfjq8vbm6t3785v7s5uLn45v/Hz22vbPdAmF3fnl2fXVxeXt35AKSUfR8PhNuPnR3d6fXH34eadH5vb/omS3FgGLB62QlWWaHJ9Oj73w7Rw1s8hdyrD6z995eOPzxSnPKq0tt3zNTbsKravjuXL3icTf4PNswzzItH7d88I16fP6BhSe0ZAFQb34dRaMGjMeeRN9J0LUwz6VAO1RZLxe1OMfP2LOAIdfi3sxTstXHb/JJkAZ42fKoW+cZz8UQFqPZvXBk5tGFztotljlr4h2rlBBL6WinvCgGcmJ19ItLMs159b7YrzpzJHqn36dRZjY7lbkr1Z9cCTR8zrXf5CJKlhrDYRqZ0ky1saXrIQb5Eg3yo1flDNf3sHrItEKMW3Ph3+JPOx4zzsnu9cnwXcUYpaWxFjQrrnzhsx7jtXtwlb8jSpI/fJddbOdVUu8rvWUo/QTgbNGcIxo49J7anOPTapZ2FsmEbyfbWPRF6GZzv6dpNzKxCxLZueMs+mRX7jJFtxogAsmJCXWKq1U2l/3rq0m4ayVcDL++UTRbs6B9DV9IqwzAhUU7ufILl4/aevzEL87ub87d3kavzz3fXp7U8lGBGZ4WAVVM86TjieSJaUHwLgjzioPgN374TS7MMpoUOhlXk/UP/nMfT5DIZF4oa+FEKHJqqHOipj7cmjInjGT2oP9Pi4tyYgaAfDghfefrQj/fnDm/O7y6uz8/3kJPTuDSXXl005CY27LRrXrC26hHkMZ9aEeWqByvz7kpi3Qyk23Swu6o420ochfZTVyUGWJMLzyjZh8xOgBE1JRHznkaBQT5Lx6fXd+58vr87UBFF/TH6d3J2evb+4VA/yP34rfa5Prbt2qM9FgCJkT+Uo07DnmG6DEtOhk7AQDtRidHH2svcZhCYLIt0t+l/pxCeYhZvHtLtoNA9D/EBLToIPwmcQ+M5B3Jy/v/rQxJ9eLRm/fyUVYuXZrPk+xEs/chaKvm7YMRjujE6l6SFQjEMcgmDmNAZEV+Z7AQLLNAFCBQnNIdC5FwWI4xqkhLMEze2RTcG9O1FbuWQQo2BBaO28VEOp/U4rqt6b4oF1PW/niXK+UBirtVZL14VyNFzbdUF/72go0utX4yb+oTG7Bs808YyvDjYwSq14GtyGOwtvNWyj4oqbc1QOOknvNnT43ZftaGoS6o3o2mhQGkSpFaFProrYfiQRrm2CLgdlq9ufJxdn5h7Fhv3OFfZm69NDuGUQYol5TCgGJIGnVJ/AZXbQxizMD56CVGBxpLisjwGz54UJDcYTOB7iZIFjzFHUA0wlXwHi2KoLNY8TFl7QGbui783SzLoYJvDjTuG2j2vsyKKB2fZrT9eVe6Joav56bSwxZigoRxpKQLePGDbhygKChyahZ+JVGVYIeGiP1wkZFvSFNKcgP90+LvP00F3xjkIIBLHqQMvFNhmrjbeHlCnIuJMwFokGKnLx91HgQGgIW+QXM4LMsypJ2f7w7Ylav5mwxJThAydFOuwczuBvS8t2KVjftpRWtlk0j2SchbKOdZYQ/NFe1FGoRYR/hULN2RMkq5s4teMEdkuXP+0Otv9um9CfbkfZE+/f2rUENk+zPe6Vy+RwJ0UOTUZ9o1yO/qKStsmcjk45GwuhS14m7/YZTiK2UszrmJZpcY3KKYaWzEHW127plPLGorXJFZQkBRRdCNksb1KF362rm6c8qkmMAqh1eYmdVvg35hZePSa30LEM/xNuR+kVw5SfouBwt6Xwr/Y5qM/DVABbmvO+qLlqMDoevMrnfUPfTvWlDJtu61oX8c509+s/ffX+17vrq7M8QuprnzAuX397fHxcf7fsJATrI82PK5uudmK/221M3OHp5Sz/TA1IDVVmE64ZlyNQ41U/zl6ygEUjuB1fV94ZQnscC9lHCek9rtq5uKfbWLH1h5q0OBb+uoqiHS5wpO/tZ1JlikR8jmWBV54DCI0PVC1fqZJepbYWh/L6RUNM54SWm7V7Eg3tHlOHYlhYBNyVBONBT1RgGs9Xo4M8jnnDInWzwQfdQD/nxScOdYw+fqBoiUikKteNaxcTeqMOeJ9gdaax8veOt/TOyl3qztfNvbSm0hG1JpqU+K9+ytd1IR4sRoDiMLtg+tDe33COgkU+o02sZIGWGGIcD4MkhYjoiy/NbZEhEAqMh5jnd+scwhRHzFy/+jZFHFGJcQj/m03crWcLTAMMAaJA8RJzmGMJKp1hL8MNkCjc03QIibIuKceQ0ggLYa7rwB8DBVUumMCWpoH9VweKs4s57TaFDJq7mXDQwaOtjZDXptpG29nVtg2cyrRcXO8+UauDUwMVD7+uWnj4e6/P1UI161b2S71MZduKgvV2y2S3tsgzhmIRt382rLaoDvYaa+mRicq1z+7HSOOoAitI0hH0Tqqn7sc4Zlw5ld8dH78n1beLdI4TNMei/+o9UZ+/rTRx4r5/bDV2zXkSdJoguuV2s+QQHkwqpyBWMMUBSgVWr/QAqQsSsKnNMzpAvYzvKQt3ILOtG60/YWXTk6uJHPXNh8nt3ZvT8c+3N40VDlXRc99eTMaTi9Oz9w1f6Zq7TG5c7rLXbT1iPMTXQ7sWNXqr3jQD79o3aDx/XZC24WYZkw2/bwmTL46ykXq5ZjWzR/WofIY+Z0y2fKva7FhBbliEUi6/yz92VaEbVZA8ooJjG71+fBy3KNv3m6ntTYB1WZqdHJ+8+nbjtVltQItRod3WIH/TJVRUjDmsm+TNhcSN9SHZ7PdUt+66zPjQ1CkR4cqaMnd6xriKXpjb6qS+hdRdPuNy3wLmjOKNS5erlcuEZT1VnM1muatMhjKPf9t90VnTfH9sWewXNIPX1Hntq8bLZw9aEWwEveKM50GaMjtCksYjeK/ZUnqlTgt7p4aqyQd1jmpnJD+lc3ytPNuDjsattI1+A/ncX4XcHivjfJpvq8I4v6HehKZWDvz/AQCdXRLzaW0BAA==",
+	"openebs-operator-1.11.0.yaml":    "H4sIAAAAAAAC/+x9/XMbN5Lo7/oruqi9c5wTScn52DzWeqtkSnG0sSU9Uc69vK2cAs6AJFYzwCyAoczcu//9Fb7mEzMcUqRs30mViqUZTHej0ehudDeAQxhzjCSG92iFYIL5kgQYToOApVQeoIT8grkgjI5geXJwT2g4co1cmxhLFCKJRgcAFMV4BCzBFE9FP0Yr1GcJ5kgybt+KBAV5k4N+v39wCGd4RiiGGxZhkAskAUURexBgviWMCmAUfv5BQMJCMQxxErFVjKkUlqRxlAqJuQJQoplPUTBAqVwwTv7QkAb3P4gBYcPlyRRLdNKZep5GWKhGfUAJectZmogR/L33de+3AwAAjgVLeYD1Q8pCLHpHYH4ZJpx9XNl2S8ynhQ87QXNs0yCF4b7+XXHD/TvEH3Gg/ihwp/LncEYoisgfmOs3HCcRCTRXAkYlZ1FUfiOwAYGXDhimYcKI/SNgdEbmMUosXQG37f/BpqYBZ1T/vnXXhUQSz9LIURIiHDOq/9oapvvjnymTSEONSEwkR3SOa2AjIqRq8oBksOiMgtA5x0KYQdKyx6hEUcJClEomAuQYvcRcksD3JlBvZmp4sCBzqiH+M8VC7ohEIRlHcxxEyNGZqEkjJKZyyaI0Vq9I7H3Tjfm2LUWJWDA5wB8l5hRFfYvZTkQfbeUvDaNKj9SErRExx9IMZpEdWgqVflO/pUlof0vcyxBHWGIf+SghimSqFIloITZIhWSxexQqTUa0yqrQB1UCfXRZcjIKPXRZ9eSnBnpfV9D2vu6BB0qgRmGwI1iM8ZDQonr1MSrCSOCmUctGy3En50TOHcsx32CFMRFqpDieEyH5OlqWKCIhkoTOH/B0wdi90WWp+VBLXJzKlgb+XuRD2taN4tBSRm8sYR9u3mlYwxhLTgIvjq6zO2FhSARPE0XtNA3nWHrhecUxp9mpFGOl3xAaglzgqpcAD0Qu9AttwK85WZIIz7EYHBzC7dXZ1QjGCxzcA5lBiGcojSQg+ymKBAOKcShAMpgq8485rlt1hZvQ+Z6Mu0in/8CBtPbd6+UAtINw7yv+DWcRvsEzBbjuqayD6Ma5pat6aIpMQUkihpmrdpYZfw8vNDqUEOVR4Ab/DCBCUxxpzjR+B5ArkmHA4oRRTGW/S+ulo/tkcHIyOD4QCQ4ULoEjHEjGDd5YyeG7AiEtpGxGjPN0RnCisCrNgecrg0SuEjyCG2xmhn6kfCRC5x/0XB4BTaPoAEDiOInUA0NsgdEAZQauoXxTRq5hJgCAY6j+vSTSl2ukGUB5hYhQzAvU99vpByAxmuPrNIquWUSC1QguZpdMXnMssJ1GhXYj+GeKVop2S8cwzoGOCt0wPwnjskCLoSej8ppxOYLvvv/u+0ILTJflDw7h6vr88vzN5O7i6u7nD2/O78ZXlz9evAVM0TTCQvdMTT3HLpBM4aA4kOrXn38QFXhTJHAIjIJcEAHGUAzgVv2h/ptTxnEIZAY4TuRqUPnatRNporqHQ5gxXiYCc7BDC8eD7wavgNEHxMMKHW5k/N2rYIUlilI8gt5wwWI8TKcplelwcJ9O8dD0oNfCtR8md+9PJ7fnN7tiGgpDjoX4TLiWda+JaQspk9FwePLnV4NXPwxOXv0w+Gb0w/EPx008uzx9fz65Ph2fQ8LZkoRYaHOZ6VtgM8OHfLEGSACiFXiYLglnVL9fIk4U7yuzodybDHGple3Ij5zFo8oLgBnBUWhNlvfdNZKLUablBlknGvo+Ob/55WJ8fnc6Hl99uLwtc8BJi3MHHB8SFgISj+19BfXOeaB066CuVBsY8f7019O766szPSh1Qdhx30vY9jv6FVIvZsXJNL45P709vzs7//H0w7vbu8nt1c3p20znEgEzFAnt9lG4SjA9fzNxTmIFbnHFCoiG7kHCWAQPJIqAMglTDMZeh4NW/qwjzceyEfQkT3GLZry4nNyevnuXwRwroHeT69Obyfnd9dXVOwhxoIf9YYGVq+v6CnpBBiJBXGDQXRILlkYhTKv8dSsRrMQEECSI64ljLSgQKiSKIuMl1sfGdAFQC2bNzCkuYDoCMoOeHqoeEFlmd9bKq6Q150CieywAz2bKIDAarRTArcbiUKt9rM2K6sq6UV47JA0jbTq7fqjHN2fe2QlEQCpwCJJZKzkMidBv1CfFUaogmXEWm3Ds6fWFNWUDeLPKRkypjPHNmcgGygLDYQVSCzvGN2eVxp2F/M3p5Pzu7OKm2MNMCLRhh5Bw7b6vtFl2kskoLJiQkCC5qMrKv6t1X6YBAkT19Magol5EyfqMRFgM4MzyQKNRgDImDJeID/N1S6tQuC40jH0RVAsjjCjdnt68Pb9V0DTZU5wxRdkHMlvp8VIdV+RWoEmWfaA4JdCS0Ln+QCyQ6rbybpVYTbF8wJiaVyTEECBeNRJsZieziZYpS1KfkjgTI809jZqIEveGRhms1Z+V7ndgpoW8lqdqXro5+jkxVqtHxVaj284vfwEiTBDD0aYCFSpqqwFGLPDNcG3qsdO5IdYB/f0PVYWrOxmvv138cmoA72ScXIf/RpYIJsbAXyumRwyFOITpyumIbbj1OdqnEgMfp4/eXY1P313/cvfT1eT2+vT2p90OiYsUuK/KDtlOxmeo5kv0OY6Sj7Vd5o/uUK+TDIyvLm9vrt69O7+5u3h/+va8AXw1YPIPskQ2UtINz8359buL8enTIGlceI2g901vIwuzCb1aW/eJkHPZleyCitwck7ILWyB6//b97ZbY+vE83rBvv1y9+/D+fFukxqfYCK1DeHV5ofBvgjPu4486sMO3YOv5/7m+urk93zfGn87fXW+IJCI0/dhPJYmEF0tJnZ9fnr55d353enn67tfbi/EEyKyoskBgGgpAlNFVzFIBqUDz6mLRpO7VJ28Zm0cYTimKVpIEa13kKvbOC+LmRcf5zd3tr9dNvHL2BVOJecKJwFk8unfQwKKMurvri8u3dxeXt+c3v5y+azN6oc3hwVeEwoKlXLwsQVcmL8GcsJAEkCin0fJQYCp9jByUPnfrEyLg1beL8rv3hJI4jdW7k8UAfmWpJjRgVNUBmOiPZPCA8X20UqZUYKlSkHDy/Q+Lg/agZQMnDrzxy1ffLoosjcgSUyzENWdTXI78qKKS8hOAgMUxomH1MUAfhqngxuYNp4QOVTw2kJGnoY3Mlt7oxDmKznCEVhMcMBqKEXxzXGpjRiZ7+33xLccoJF9sP6qJvGq51doEXt9GIrsk8jqnmfpiGWRJuUL6xUkgSojtV1LNviScSRawaAS342v7TCI+x7KUqCln+hqSSwLr7P7pTFdXrEZwySh+TO7TKRsdhlVfb5IA9X/cytUOnzwyFdqEYQuyPllStLkPWzEXPl16tI2kR+dIHfD7H0oI6vnSNcnPehrPQoYC1E+TyfMRsu9s3rHO5Kn/tybzGtLGj2fdbjLHO+TczrLHDvjl1dn5fhJSOhVHWYhrCbjPMCWqc3MuOVnPBpatnxIbp5p0V44qUHXBdHG0beJoxrgaZw3SBoSN0h6aAi9wBaWeJBFlCqRWR6oUzutn9LLIexYqihi7T5PdyOk3/ZvxSTdZrfGzSVwrHbG/qb5UvrjmLMBCaDx597TtVf44EaBrhs3qQjH45DsIFoijQOJa9NgyEogJMidzjhNgqUxSWWXVhMEsjSKNNkD0hczYq9MjutDOfP/VXy1K8XJwChzP0whxwB8VMlGPOGsh0fRbIcNUEo6dD2w6ukAiXy2Rej7vlAYLxuH3//gdRhkwRFcgJDfRdSRBSMSlMKT+XjaDv1fg/T74+vdmQIqaKgCYMbUzwAQa/8CcgRoWpjqyQHwvSxqx8DzsB56HEgsJv5vh6QfQ+4+KEzD4uvc7vNY+1a4XC1t4vq6Wes0GjTb/1weik6O29sMd+cJ+PFuTuJFf/Bj/t4nuRzD3E/rCGUH5LpP1Pq7no7p/29WPrvnBn4lTsEND09nM7MnI7MDEbGNgKmLy+8GG9qX6/ZdlYCrU79DA7M9ptYLiSId8fvsc17zZGtd1l27rbl3WDdzVzVzVmn5tCTa0KNjWCMKXr2F3v+iqSnBRHHwyHESMdll77Xbltet110arrk3XXM+GcGeGsL7U2swQfpErrQr1O15p5VNJjaaKMvVDIu77MaJojjlwrBz90AXwDg7hQlZTkGabKPnD1IWpz/UUnGJTyzwjkZZjbwZorOG+R0nLco6Gcd/gf2T+pwDIYTqENMTLviYX9C4FWxHEIeEkRnxlugIPCxIs8sJlGxvV/ecpVaA1NNNZg0TYpUPk4p8C2MyxA/p2EhOu1EEMMwaEBlEaYg1IcQ5/1H8bwQKBE8T1YBgJFwdQH7CBwTSC/3dgE1VTbJE7uezDPV6NCv2urNTUCyi/0HvVR8VqIgtEYKTmnfQCci/9wHQRcgVajHgDKPVmDVEl1lc6y4ThkuVo37StIGFCC2/G9kqjOhPANVXh4qMhlsFwwYQUR8MpY7JXJmGJaci4H7N51wWhFZER9HoeIsbvrj6cvfn19sgWqlVIUMVpfgLUm8ejjxhLjoYhXg5n4bH5RXD7C0ex+SWM++aXOLRvpmFvXdRHn40wwbJdS2we5jEfrVMaewrhOMgd0Jsd1hNfWKaYoXxkbCYnqBNHNoy7HFbr/OvWxvl+SqMyCiiKQCVeOMUSC91eHBQcyhVL4UEr5IjcY5DMOFJZtYkuoxQsNqjEEQi0yjDnkKynsMSu7BOQlEp1hUcaRWBQKNaBXDBhvtcaOs1U2KF+OLES4Fyew+wtHswHGYwck6bE+Dl9KByAMFQv5mqHdO+1O9Cir571MoiKM4GMLFD1Dv6i/q9H6a+bASvSno9XA4wReIFsGldTqvISywfG70tapi3eVhOZ9YvB2id9FIfffzs6HnxfWg4iPq/tv+0vX39bWXdgmGEkU477cyQ9O2GMfOEH+PDh4gxQNGecyEXsX74gBwyClHNMpSr7pXAaJQtk9G/pM0VRv4hevO69vb59gwQOFbpe2+r2NHpAq+JqQuAg5USuxoxK/FGW+564kw7CUbWI2CTc3qtBFv6FceasFX9i9YFZ1morOWzyXSrfiXRqvurYnmMUXtFoNWouflYeTgt1PKXDWhP3bcJZEGfHJrR0TzXcnDSVNq/VrZehF2uwi6q4CMbsbdgAUG2vhae+IrOtQKj1hy/P3gMR+SatTIMnSNhpoZqcubONKhCR6L778xMmt1VntLpSDSzRGbGQCrXcDNmDjivojW12Ha+6nmmzz6Zq4LBcM5ptZ3vQkYHC5h29LU2fYGL3TXh3nx4CMaGdbL1/BHrLawlK502sdg/PjxfvHrmRZ0L+wF9NVxKLl5VNSYqith75SJlc/N+m4t6T4z9/8+dvT3549W2trmZSYDRN4ynmipQSY0p0rCWjbc/B8X7qXnUowvO8R8O4t7NwvDEqHotf0/GBixiUiW0JGbQqfzWt9Ywpk9liCYzffebmzUE+3Fq5gtb88JVkgAId9zOPtdSxmTYg6umJ+kNhfwmEmt15NVVxCJJpq2Fg9PUBdeo7E2RBNNRbpM2hXAddTFVrd2smq6mrLdaqFUGb6arguuLjvAxzrWnrjNV8vYsiAyVi29cXVL5eG7baczVBDcUmBD1Z7YCHys0YB5+uUiD3XmsUdVi6uG8a1i7rnf0dbiBQ6xLP46GMk2FGpwjv+wrnar2F+LbNQJyUzccMkSjl+HbBsViwKBzBSZvP6pj/76e3458+XXmkI+OTnFnSeBYNLs0PSFiD+/EZnDRTyM/dnKq9cE1cHEGvPmn8Bbvjd+enlx+u7/529WZ/+9z2fJKGWmC4Xm51jMZmp2g0bYz7LFKczQnOIA6BCPgL5InOp/aUWe/zqIzMDhPtdz8ZESXJCPIP7aGh65yWtR80eS472aGz1v1p7NRm/kkLb7bizyd0UJoJavRPqtK0fY2NN/iKIsEiNpdMyBBzXo/Nvqo8evXXfz35Agp3ckJOz95fTCYXV5d3/37+5qerq5/bDFvTHP5vW2yyeaVIhTXNlSL+QpHq550LRXZQGPNZ1JpUGPB5VfXrPcvJ8nH7Wv1AOhWfd/h0R6vxJkyPIPPJ1ujNtD+KyfBZ7Xfte44XWr8Gb7CiRbi2+8/bXZ+3uz5vd/0Ctrv+Dz8B+As6W+dpjjl6LvZ+bLF3xRpuXOxd/f7LKvauUL/rYu8tfoofQdvPmo/Gk+uxTliOJ9cXXT/aDFPnn8pKpH4ZUnbLii1S1wXu7jKbs+wepNJixdTHQJwKO2lsyQEOjFYVMMURezjSTNARWd1A1X6P4C9JlHIU/XXwF11i99ds8aMPqEsYiwJzuYoYVK83yhYZh6C/1R+CZJAKDR1uzie3qiplBEOUEDG0KIZ/sb6EQqYfjaAGG+Awczm2gbssFPwDHAIm+mTwS2dWQ2Dc3RtzACACluBR4bVb4JlpdgiGTRkpTs1ZXn64eddIzNCxWEMyv3vYa/Gokh6tGquYkABEAUUECePtYRi/uzD7HBiHkIgkQibp5WDUsVgkSriACKCMxyiKTJnIGMU4GmtvMiNCLdD0mWo8uwoJYkTJDAup8wfarzCa10qsOl9WHS47LmEUC8a1FRfmGkLzAPNMjTIruqsSrryjpmMZlLzKPhBJcABqzaBnBoquOVEGeazqOqht2Ie/Ta4ujXsxEBLJVAwWGEVysbqgQiIaZFW5Rvp/8r8MsQg40RdAjeC2VFtjwUHgGFD91ix2FW2uetRHVa6HQz9l180NWqgrgH0shSEWhDdRd+Z/2UKZBbc1VZnHrIMIhNFbEmMhUZyUCDud4xI8XbRejw19ERqZOA49q+S9qGRSksC96WSH5gmV8kUZ5V61MtlQK6tVn67Mz9d6VheXH5Z0iXqnm9ki0oy5kA0iCLWhIs0z0EYBmC42arkAJSggcjVQA13WJJE+gB6HPnqUbkOxW3S7vRZafvUyw62oFIXZ0mGKMYVksRLq1tJoBaiCYTOCZxyX+fdj/qCF1tQk/9XXll60RCTSDwtUb0WSZBJFJZrG9pWXLtW6TllWAL4pGa74vUTATflhiYCLUK03Z8QuPhU+Uwupt/XELCybkiljEUbUg1+JtPra7DUdKPNwg0io7x+8XSXlYSo8qA2TQpTVEluh2pQNyQKJMsaJftGBA3aPiPVxHCGdCfgfZqJtRfH/bOO8b2v2i2byPj0BO4z78wGWxS7s0xSXcelny62WTDtXISWy9qdE1hkoH/1jS7A75bHc1GvFSh34YhWX3cf+rL72r77G+UaRvSoxO6T7VmVBsTtPotAKGI1aC55arxk68viK6poofvzsGtU0TKLqFMizh/QUKsbUhOxfxbgx3beOSYr9eRIdU+qZ0TJJ9ntGzpMqHQXBbOnLufGsaTyaxhWfPWua/WuaG8Pr/asaN6j7VjW81KE96pol30qBNIcoP4hO0UklLCYKSQT0IjY38cceBIyKNDaVC+YKdSRRfojCY4N/G0RQ9bFYhtAiUchRpEcTRREQKTQcTJWqFE8cGMsUsnpYks+nVcudS5tV1nSLTcTVz1ora1sbP7JguQ57I1L2XpTso29DZn3CwmM/MY++YqcE1m4oXl9v/NntnanUQdoyyD3WpHashVSFTnfZRtVPsuX3+ULzOiOeL9/Oi80/08u3vfCvLx53lWzpzLfP4r7cT3Cz68355NfLse/mzCLwb457j3Jmllv5MssNXJnl/jyZ5UaOzPKJ/Zjlhm7M8jPyYpZ7cWKWW/gwz5ax2TLam8i3NIoLJFssY6BYEKZxkhs8F7Bn4f7NU961XVmmZ+c3xp/8gvt93QLf2WPY333wW1yYXFKIu7kuuQxyw8uS//zdn7telmya+i5LrtiOvV2VbARg68NRqp93PCKl42efwUEpzZRucVxKW7cfwTH4oo5OMT2xzZscmOfTU3Z/eop/qveKl6G4XAuEWneYGLPz6caTC3evFyQRohgyIRVHB4dmFyMSggVExdZhfCPgX+HmzekYeKp9vzKOrPJ4ibneQHlwaApcNaAPej82nHw/OP7W7J7DVF5NwFwzAWGKQTJFpw64q/woMHpwCPeYUxwVSAM2AzJRxDt1PAD4kXFgOqll9n3DLEJLlnJ9sujBIei9rhASITkTRyYHQTEO3am1MQvtns9DdTEMFZKngT6LVDXQb1ejg0Pbi/7JD64XaMqWeARqu74YDYdzIhfpdBCwuDI/AkGG04hNhzESEvOhGZAhEYEgKIz7aQFwH9GwrwH3Q5yIwQrF0cEhTD5MzuGd7sh5tkNY21HM4eTVCB5PhUgFLm4/ttdBnbzagJDv9knId0VCDg71uNujBpSLjiAks5mSGAlXEysRcoFM7EqzVEn2yUt4i83RchERJndlQl5s+g8cmBNuRWGpwzg40mBKKOIrk3hEGo1V0IODQ3j1EsYLHNzbQ8UNHMQxoEifIVndCZ1xox9yotiXKediy0AQcyuCXmQcwjcvQR9ab7NDlkqtB+jcIrUn8WokDqaW7k+zc1bN10t318SZ6as+1u+T7ZzdSwFCLemWuSfO7BM1kITOmKohIANbfG9RZh5RVglQa1FKxbuc+YVi7QWdsUqKO0eWp/XzPP8SRSTU5OYO9On1xS/fTIIFjjPXJ+HKgZAEFyxzIIgZxMKzSmbznZ1ZauSNcAvgKdUFbTaJnV0+Yi6PyhEVV9JE4lhUr3SoU2R+DKLqU09GOKfKLvmVRbCzn+OZIlayQQ1OLfea/6jOXJx1QK0amkMvFQ8sEdl52EuCHzbCK1mivKPVz3gl1mB3Q3KPV8UTWqarAiV13J4BWEuUe4k4R6uDpqeFuhSkLgo5OTAZdIUMBQFOJA4vq9Le65WEXP+pDgfQk1GM4O+/6TUE4zi009s83FvRUa1IyNy5oxZqLXVCzbU+5WqdNcU4nnKWAvrmWpS8kb/45rT8fk1JWwWafrtEW5zU0EH/tzSfuMs+rWWBza3LI+zKwR6ly7DZXaAYREgILAbu7zVWpKmZx5SYwXd8HCs8+q1yk35uaqHUSkkYvdRWZNHTxmejDo1m1kuq8rlShBZv1DoZnHz3b7q98z2XJ/2T7wYhC8Qgb6cGQT0aSiTuxdBca9DP3/dRQobmFsi+K7kS1Qf9MBsqMVQ4tavGl/gDvafsgf6oiBX5vYAd7WxJVXvYnJ1MIyBBHMVYaiulrBeClIaYRytTLa5H+cCJtFgJiWNIBRZqUyu1Top2l80o5BICpw5L0CgL6sdlGqYr215jJtKeFkQooMr31rgOfGBxDhhxDJTRPi3quyabX5hvTV7IC8Vo0wb0sLkye/MMh3o3b4yyI604to53PSvNZoBo1g+z3hHuIs2A0SXmEjgO2JySPzLIwh2PpIJLQlYOwJGYUxSZtb0pS43RCjhWOCClBWi6iRjAe8YVg2estOp0GkYd5pOqkOJQL+3JNJWMC3U7IY5UfWRf00m17A7i8DAT8hcHawy7vhOZMGojOE0MLzeDUElprNn+sMB6PVaVjLEtM/DdHCMXnKVzU8brnRLZLaYaLw6NhCtBnLKUhpWPyswX7qMBTDK1Ytis5bB3g9W6qaeHpXem2/YG+eMYIyoq5CLpo9X2UMFRpLmt2dm8A0YLM7gEMtvybWaxouseJ3KQEWTIWIO6BLIjGT7UGcNu7KpzUIqzpXE10GaIrDw0DFwrcDVfvixo+nV23y+Kaxuai/79AtFQX1K0IOUh80iVv3deGrXVbNQ9P9s6aWQ/M8KVqxiXVdQ13G4m1knMliX2M5ErH6UsCJ1hbs2kW1VgGpoVhbnanFRFQKTTWImAu+FcLXZgjKi918pc0hkO4ILmdd27Vj2KoaKvOLhe+eQGr8jtYomzf0XoBVYZphy2Gat7vOqbgYpRYoKXFYlyJrJyRU8GZsZ4bmTd5NLxUixwUcOwBP3TBD5/zl2UGi/M+NvHLtxTjN2XdW7xhab3wAussMwwTmn2qJIQy9+7bEPlKkXLHftsj0s4tZ54OifbaM4n8LILKrrRzzZt1nnaluR2VztDV3O2RTrN/AFDrx1M+M//evbFy0NRUMcmuBzIFEXQY1Rf2dLL5OGgosZt5LfRzj77u5+Hv/ts2p/GtBdT2TU+q5eZHOdTQovmBsuI6ap9zvk9vqYwc/NKaIvVkB7c9T77Wr+9tX920tYgdl03ta6V6nR2XTttsmh55MKlvGaqQe5CTiv6GsSO66gLCuGKopgEOTSXRKrBDJDAR0Uj7EotZ0RfWUyocVdVF3pnJdHrmS88+QE1t7J9lL41dv15IUOiMrA1oAnHffyRiLL/ewSpUF+9/zC5LZRi5p0pxqZqMJuHxRko3xT2L0sbl6YNy9PWZIc/5dRxqVpIQ2WVu1p/qbd1vm488W2Nhmb51A9U2JugM9I4limneV5I0fgWy+sonROqfPmvXoLa16mXOWrK1IXKZJIaR6SRl8ZMtvLSNCnEQrVhNIf1oZwxbmZ4r0VWP7clV5bEcSr1qXBoJjHPpmBzH5pzkJBR8ZMOOtTfV7tUal7omuN+z7W4I2FP2z4PyIZp11VE/OpB/RTZM9g0DWjWHh0ZUWzsY4N575hQO++p/GPlggSLolwUAoZG7Zr96+geU/3FPrnhWc0XmVTSurqMr20eFLWIV2sz23mtYYUvAGo8gIjRueg8Q8ukegrgPANaaF0ZVW+KACSrkVnoSpsRAGL9l2qaYaDuLx94P61VgIMr4TPnSGhbh2mAzbqmiYIXNvVhlGId4pSYPRg6XAVTtQfObPKfYrMo1aYUUHkWd/OLTOePDAHuPgOzyd0rIfaDzCzYuz7qAq86UyB1gZIE08Fedad/jeuVrtPrC7euzbfK68GSG+upvGZzHdYXFzODxp3SgCAhOMCl5bI+LRaj0Dz0gAR3BYUbOzNNNXGFJbZyRAAZAdG7/IdvWYtyMjEUc3qAysYfgUiV/hPuVGx1EAEeuJLNQV4K/PdXvw28IJVQ4o8oTiJ8BMRwuVgno2cGEYYRGTx7Nq2fSEhYaDv8oDuq1C8w29EUQ0Tu8Qh6ertGTuJ/Kqn+r54X5lfGAeipJr3cPa35WzmBxtfnZD7HHPu5qT7Aaln7EhhXfaesAECDJXlWNqwR/PdXvzVQW+YTEBrij/DKOOHmWp2XdpaJFZXoo8ITLJjAVFfU+qlV83OJQTB1eDGOor7z7R/QSvXfDZfJ/CaIy3KExwv19ursamSoUiI0p+bcFwkzovSYKd4qBwWdEodggejcb5zdwTupTDkevNh0tlZjMy0xmqpieJpIR6deUI+Nr/fisiC+rb3wRFYDRlUIXgzZUm0SwA/DB8bvCZ33ldz1zcCLoTYXw0P9z1a9MBtLOnWlZJb22R+FRww37o6L1XU1Qi8mrl6i8mXFAysqS++UiFFoNCyiq11LqeKbPlkmWPVtyb8uMg8YFURI9XxjRqWkwxT8cHH2NLKbko0nYIM7Xk+wQVuSDeqJNvfQiEPlYc0lPlhDUZ5P8y8e9etqNsKdI6S4zGPtjmlln2epOsQ5i8XMrW5+saGLckhXAW3iOlKf702o7BPaV++alyRE2PXY+qjt/qJnDsqdorVn/BKPrrCREr3W1EvUsfquMLw6Bt+D+c31WAdNfF5+Y/ygjdpHkZqtqFVC0RErCmQ6T88lvE3It664iBzAxUw7BJkfdAREZmd8WHG0JGqpACIgNdk5nRL3rTpilF8ymLkWCD5Q8hEookyY+8AMPEwDFmbnsxEqv/+27sxcUf31kaHH3vv2u8qFwL/9i/iXy98ti8rzx9BLizjro0WUVj/5X38+7h+f9I9P4Ph4pP+DD7fjwYGvbyNDpVchFS+mcT+Yc9YeYtQt3NwzmTdgU5Osty/DlJcCQ25QjuqjaozQBqu2GAuhSgDWGgTbsJQ6C7FEJHIZMzWaqcoTOsI9MKGxM4r2/AY/uLy6PR856nRKbYohYvM5Do+8cE2M363AlFy7VZjAVBBJlrioVDd3XSXp4PTJdkVqxvMBiSKzfP67kzYl5lrr7ihgpfcy3bIPot0u5M0K+oAUzRBozwiFq8KZCweelQ3H5vQJV7Harvprqr4eT2pR/ZqeO8nuUuHUaV3T1yBupfkrmr4hDNWo+ddT6otEbaP4gcgjs53ugQh85MnO1OyUwHoR2LtV96M2WAmXdsNaCAjFos4DVpUXZzu8wlq8O6Xg0ivhmZA/1olr1q7mTzEVAJEYhHrp6gwbPRjluyhfdjMprQy0D2w+9oqQO42lUUabZLIGeBMZ7UZqdzobXJFmGpsldKAOraF2GEv17QqTX2aLnSuPrv1Ux0gvr3ScVKjTbgs7Sn2Lx0yCyEz7QaIo8tkMsjKvWhtZ0PycIRLV1fha/wrJnPjm+dHmecSEkjiNR3Dc2SVpz3G15bdIW2KrNae+bjWwnlE1kM2zc4GEOyhZjYs5JNmOqFSjRSgknM31zfLd0icdyzlVB55rNrep2dx/sWZrleaa8sz2usyGvX7PNZkdajLNciIVmL/ICt+UwnMTuLDjqRSEOKhqM6ODEsxNWMzd1nME5URYg4UcPNduPtduPtduOj6Xije1y+au5l0gjgKJuZo/gagYftv5egmnmeEbx4+tih/aU9EcHnHo087uJ0tdv+gQKN2mbGldxZKpRnnSsqVc7dnbpCJE4ssuSarGLyv1HoVUrAckwLUPTqVwXter5QUOyFdJ4gVuwRjGF9dvHYuCvEDrQ7FliZSnKqVjvZTnywa2rwk7Q307XKXEskH6PmUd1IuGj3zVlm07mt1Ppn1c+qH8kW+VIQvnfDbGBPQO92ySm5NAZ8AoBj0ewgfYHo3CsUm6+4p3S0gNkqOWRU2+zITzOJH1SgIzYC7Jry8msQcg5X7mQFcFfPWRIDr/J0H05QgQ2APgCs5cDTajgBVSi2RXirxvDxh40W0R1pTfq2XrtsnGvdg2HWci1ZWdvxqUPcPWnVCmxNKQKornW5k1sDvPLcJcWA8trbhx1pWwB62FkCaM6vFFQZByFKwGMM6BONWoz3FLRSn2XQKbuThtCsRaKX0+3Mp4WTZ2YX3r6v5sQ5M0RW0uBtfJJOsqwF+6atbyGLZ/Wx1bq2A2Llgv1gc0FOj5UqO2vLFrUKgb3AUSVjAwBZHqVd8sVUZQozNLnrbO1CCusJNpmDga1wwKoIhRDCHDmhjvHg0nKDk/bO1kUWZ1yA5FD2glrKyVqy89tZkGqLvowGcKtQj6WeCpV0VLRkJImBBEXzWOg5QTuQIihFoYvehabvucgn9Owe8iBW+1QTkPvzaNsTaW3JRXbJTnjRPZO0hjF73WwDn6CxwlszQCySBNEswhUuvhov38igzwoA4UJfqsYpPAyVq/NIeXBiTEhZ19eFUs5SU0xb6o9gMiSgRd5XjeM5MSdevBKRI4dLs53P39/gQ5xyYG+JzBf87gP2fwnzP4zxn85wz+cwb/i8/gP2eVy4e5PuHRom9QcJ8mcGONmDlf9AmPFt3zubVT3T3Rck5t0wm19tzezc6pteh8p9OaV9Uzad/kT70n0U7vk9IfhbvVi7gKDza8IFlt7DLqpLBdtFiB4MtRmzfAqI3zGMyQYK68OhyWZmPjncIKtfmyhto8HqrcbZhGXhrelJtA4Y7NLncZd7zF2GCB0jcl8E9x+LKz8OETS3MVr0+sK22q8j12rzsIelAV5Qz2s0x3wZtwvFReSg2zCjaoFz6U75CQhcCo63bFfyxhfwKJt87Kk0m6w+eTcPuuKtk3hcdeieZClv4oaOoSuuKTLQTdftkgbr4hN290MyvrRGT+4QZy/ugpZlFuOsc20d92lFoUuJJmO6zmuMAbFuE3NmtTFHNlwQcolQvGyR8mwlKR9XWXlwWCZLk9iXnf5YbszmI9xH0o3SJn774q3LdWB5kH6/oCZS3tlWgq7di35+9xFmF7sEWtxx1J5qYpSshbMw0buWIsY521e+apJlDf4mS46ShV3nWv99sBFHbC6md5hYcRT2EbLTGf6gZzLHtH0FOlkerfB3X/Wu+37YAHquxjawzlkk4fPtvC5o132RN9XEANYhnUkc0XYPWbKelSvyWb4BE44Fi2ku7jjL/q1YfAe4T/1iOyNV57oGkVcc4/Lwklvpqj1vZC1tDoyyp1FvcuMXZjfd7vneLeuJsBYzwktKipfGgijJqFKuuR66Idx9LYWjnwkODztnw0mNJl96hQuNwscjWe55RZ2jPOPHWYopAGV57bnNAnvgHFa5Ur9792sVVVY91gqv1uyR5spz4hSQHcxnZup6y7WeEj6Nn7PzuriFySfVMqeSLD3aqruuNq101t/dnYTVCsMzeqffYuw37szFZMrN1JtWcx3bmvsYYDj7J1X/sgfu21U977vYxMZm9E76j4Z8DojMy9SBtWHftb0BUV6BeyoKvp/A0WdLYzEkk8S6MJlt57vTezhM1msHw9dSdIrZdTb/Jt053ea6/j7ojqcYQCqJHzNDzI7s02F2YXblUmvdqV5JvdDd6Ztsf2ra13AGsGCir7dxJOmKpRzMvubY1fPzDTpR9wItXeCO+9491maPvt4+objlUWlDffO37/gwgEGRbajpbHg28Hx2uuEe/1+8vX3/XqTxUkFIYcC/H6T1+dnp3dnE8mLz0NI4xCzPtasAmjvS5XjFtw/qu8h0vEhxGZqs4MBQvusRRD47cnnH1cDfX9ryy43+ImdWMC9DXBwk+aQdgPSbU+K1YfmTDiWgq9Y1iINHUax0L70fLV4Hhwsn4s14/a85hkULOdEnUN0jQunm86j41/nlWmz2u9/be3MRuLglYw0Z0ErdB+tDwZfD84rn7WYRQbOl2A/drc6qokJVfAj9E8fobOMJIpx/05kli8vrX3AL9WtRee1vij5KhvvOq+M2G1xo2T5v2vd5en7899k+ZHzjwH7OsqI88B0VA6bTczpgNaPYd5b9P1U6pG47x3FFfX2Ey8Hcrqtobw6VTqpxwj5++Y+rQ+x3MiJEfdxqzpY6VvyspzL2OYodUFYKKfLxab9MLzqBddV9I3DZvH2rYd5m6u4fmo4FdvNsCN/L26Pr88fzO5G19d3t5cvXt3fnN3dnPxy/mNn+Ne6+6BNrm4O788u766uLz1A0op+TgaDrcZQT+60+uLuw837/zY3NZPlOTmMmDxsBWqskWT69PxuR+mhbN+FrkTGV7/6Ssff3zGOOVRpbXtnq+xYVexfXUsX/Y+2QQw2DwLMS8SvXf3jHB98oyOH7VnA1RRcB9OrQ2DxnxH3kTftzDFoE80UNsjGb83hcjXv4gj0KHXwj6808JF90+SBXD2+KnS5xvHyB8VnNazeW3Q1IbA1Q6aPWboGyKdG0Tfa2m4Jwx2ZnLyhUQ6y3L9udWtOI8qc6Xap19nMTZmuyXRm1UOPHm0vN7lL0SSGsZqE5HaSaK8peElC/EWyfGt0uIH1dy3d8C6SIRSfOtT4U8yHzvOw+65zvUZwB2lp7UVMSake968EeO+83SbsCVPkTpyn1xn7VxX5SK/ay31CO1k0JwhHDP6mLSe6txjE3oWxoZZJN9XO0/iZUi2I243+bYCEdvy6MlybFrYN06wFacIwIIJeYmlWjWVduWtS7lpKFsFu7xfPlGkq3PwXE2sCMuMQDWp+wmSi9d/+soswe9uzt/eTa7GP99dn97+VIIRkRkOVkH1hOOE44lkSfkhAP6Ig+ozcLdNKJ0+nBI6FFqN9wP1fx5Dn89gWCRu6EsfdGiieqjjMdaSPCp6Zzyk9hCPj3trgoF2MCx44e1HO9KfP7w5v7u8OjvfTz5C79lQcn3ZlI/QuNvicM2qokuAx3BmTYCnFqTMvy+JeTuUYtPNYqLuQCN9BNJHWZ0cZEkiPK9sDjY/AUrQlETEdwoJCvUkGZ9e373/+fLqTE0Q9cfk18nd6dn7i0v1IP/jt9Ln+qy6a4f6XAQoQvYsjjINe4/nNqgxHTYJC6FALUgXZy97n0FYsiDU3WL/lU58gnm4eTy7i07zMMQPtOQj+CB8BkHvHMTN+furD0386dVS8ftXUyFWvs2a70O89CNnoejrhh0D4c7sVJoeAsU4xCEIZk5hQHRlvhcgsEwTIFSQ0Bz+nPtRgDiuQUo4S9DcHtUU3LuTtJVTBjEKFoTWzkk1lNrvtKrqvSkeVNfzdp4o9wuFsVpntXRdKFfDtV0X8PeOhiK9fiVu4h8as1vwTBPP+OpgA7PUiqfBcbiz8FbDNiquuDk/5aCT9G5Dh9+B2Y6mJqHeiK6NBqVBlFoR+uSqiO1HEuHa5udyQLa67XlycWbuT2zY51xhb7Y8PYRbBiGWmMeEYkASeEr1yVtm52zMwvzAKUgFFkeKy/r4L3tOmNBgPEHjIU4WOMYcRT3AVPKVmvRWXQwOQMG5oDN2Rd+bxZl1MkzQx52+bR/X2JFFArNt156ux2iFFE3NX6+NI8YMBeUoQwno9tHCJlxZMPDQJPNMrCrDCgEP7bE6IcOCvpDm9OOn279lnh66q91RCIEgVh1oudgmW7XxtpAyBRl3EsYi0UBFLv4+ChwIDWGL3GJGkHlWJSnbF749Ues3EZaYMnzgpEiHncMZ/G1p2S796tuO0so2i+aRjLNQ1rHOEoI/2gs6CpWI8K9QqDh7gkR1E6d2nLxu6fKn3bn2323z+dPtJHvifVu7lsDmabbHPXKZHO6kwKHJqG+Ux9FfVFI2mdPRKV9jIXTJyeTdPsNJxFaKeR1TMi2uUTnD0JI4yPraIZVS3lC0NrGCkqQAvwsVm+VMqvC79XPDdEc1gVGAsy4nsdPK/sa8wqvH5BU6lt9/wm0ovWKA8lOUGe62BP7VPgf1eZgKYEsTvjFirlqNjivDUuvdqb6IYdMNXeui3Znefv2nr97/end9dZZHR33tE8bl62+Pj4/r75adxGB9lPlx5dLVTux3o42JOTy9pOWfqQGpocqswjXjcgRqvOpH2EsWsGgEt+PryjtDaI9jIfsoIb3HVTkX93EbO7b+IJMWp8JfUlE0wwWO9L39TKpMkYjPsSzwynPooPF/qmUrVdKr1NZiUF6faIjpnNBys3ZHoqHd1vUnhn9FqF3xG9d5oiLSeL4aHeQBzBsWqasMPugG+jkvPnGoY/TxA0VLRCJVrm7cupjQG3Wi+wSrQ4yVr3e8pWdW7lJ3pm7ooTWVjKiV0KTEfPVTvpwL8WAxAhSH2XXSh/a2hnMULPK5bCIkC7TEEON4GCQpRERfc2nuhgyBUGA8xDy/SecQpjhi5rLVtyniiEqMQ/jfbOLuOFtgGmAIEAWKl5jDHEtQSQx79W2AROFWpkNIlF1JOYaURlgIczkH/hgoqHLBBLY0Dey/OjycXcNpNyZk0Nw9hIMO3mxteJrtqW25hU1t27apzMrF9e4TtDooNVBx8OuqdYe/9/pcLVCzPmW/1AtUtq0lWG+zTFZri/xiKBZx+2fDaovqcK+xlB6pqFzz7H6MPI4qsIIkHUHvpHrKfoxjxpVL+d3x8XtSfbtI5zhBcyz6r94T9fnbShMn8PvHVmPXnCdB9ymim28xTw7hwSRxCoIFUxygVGD1Sg+RuhIBm7o8owfUy/iesnAHUtu6wfoTVjU9uaLIUd98mNzevTkd/3x701jbUBU+9+3FZDy5OD173/CVrrfLhMZlLXvdViPGP3w9tGtRo7nqTTPwrn2DzvNXBGkjbhYx2fD7FjD50igbqZdr1jJ7VJDKb+hzxmTLt6rNjlXkhuUn5dK7/GNXEbpR7cgjaje20ezHx3GLun2/meLeBFiXhdnJ8cmrbzdemdUGtBgV2m398TddQkXFiMO6Sd5cRNxYGZLNfk9l665LjA9NhRIRrqApc6lnjKvYhbmfTup7R911My7rLWDOKN64bLlatUxY1lPF2WyWu6pkKPP4t92XmzXN98eWxH5BM3hNhde+qrt89qAVwUbQK+54HqIpsyMkaTyC95otpVfqlLB3aqiavFDnqnZG8lM6x9fKtz3oaNxKm+c3kM/91cbtsSbOp/m2KonzG+pNaGrlwP8fAKGVlTFXbQEA",
+	"openebs-operator-1.12.0-ee.yaml": "H4sIAAAAAAAC/+y9+3PjNrIw+rv/ii55v5PMfpZkTx6bq9rZKo/sTLzx2L6WJ+fmbuVzIBKSsCYBLgDKo5x7/vdbePEJUpQseWbO2pXK2CTY3Wg0uhvdDeAQxhwjieE9WiGYYL4kAYbTIGAplQcoIb9gLgijI1ieHDwQGo5cI9cmxhKFSKLRAQBFMR4BSzDFU9GP0Qr1WYI5kozbtyJBQd7koN/vHxzCGZ4RiuGWRRjkAklAUcQeBZhvCaMCGIWffxCQsFAMQ5xEbBVjKoUlaRylQmKuAJRo5lMUDFAqF4yTPzSkwcMPYkDYcHkyxRKddKaepxEWqlEfUELecZYmYgT/6P2599sBAADHgqU8wPohZSEWvSMwvwwTzj6ubLsl5tPCh52gObZpkMJwX/+uuOH+HeKPOFB/FLhT+XM4IxRF5A/M9RuOk4gEmisBo5KzKCq/EdiAwEsHDNMwYcT+ETA6I/MYJZaugNv2/2RT04Azqn/fuutCIolnaeQoCRGOGdV/bQ3T/fGvlEmkoUYkJpIjOsc1sBERUjV5RDJYdEZB6JxjIcwgadljVKIoYSFKJRMBcoxeYi5J4HsTqDczNTxYkDnVEP+VYiF3RKKQjKM5DiLk6EzUpBESU7lkURqrVyT2vunGfNuWokQsmBzgjxJziqK+xWwnoo+28peGUaVHasLWiJhjaQazyA4thUq/qd/SJLS/Je5liCMssY98lBBFMlWKRLQQG6RCstg9CpUmI1plVeiDKoE+uiw5GYUeuqx68lMDvT9X0Pb+3AMPlECNwmBHsBjjIaFF9epjVISRwE2jlo2W407OiZw7lmO+wQpjItRIcTwnQvJ1tCxRREIkCZ0/4umCsQejy1LzoZa4OJUtDfy9yIe0rRvFoaWM3lrCPtxealjDGEtOAi+OrrM7YWFIBE8TRe00DedYeuF5xTGn2akUY6XfEhqCXOCqlwCPRC70C23AbzhZkgjPsRgcHMLd9dn1CMYLHDwAmUGIZyiNJCD7KYoEA4pxKEAymCrzjzmuW3WFm9D5noy7SKf/xIG09t3r5QC0g3DvK/4NZxG+xTMFuO6prIPoxrmlq3poikxBSSKGmat2lhl/Dy80OpQQ5VHgBv8MIEJTHGnONH4HkCuSYcDihFFMZb9L66Wj+2Rw8npw3Mf4QCQ4UOgEjnAgGTeoYyWKlwVaWqjZjB7n7IzgRGFVygPPVwaJXCV4BLfYTA79SLlJhM4/6Ok8AppG0QGAxHESqQeG2AKvAco8XEP5prxcz08AAMdT/XtJsK/WyDSA8g0RoZgXOtBv7wIAidEc36RRdMMiEqxGcDG7YvKGY4HtZCq0M3AUwwgbxjm8UbkT5idhXBYoMdRkNN4wLkfw3ffffV9ogemy/MEhXN+cX52/ndxfXN///OHt+f34+urHi3eAKZpGWGh61PRzzALJFA6KA6l+/fkHUYE3RQKHwCjIBRFgjMUA7tQf6r85ZRyHQGaA40SuBpWvXTuRJqp7OIQZ42UiMAc7tnA8+G7wGhh9RDys0OHGxd+9ClZYoijFI+gNFyzGw3SaUpkOBw/pFA9ND3otXPthcv/+dHJ3frsrpqEw5FiIz4RrWfeamLaQMhkNhyd/eT14/cPg5PUPg29GPxz/cNzEs6vT9+eTm9PxOSScLUmIhTaZmc4FNjN8yBdsgAQgWoGH6ZJwRvX7JeJE8b4yG8q9yRCXWtmO/MhZPKq8AJgRHIXWbHnf3SC5GGVqbpB1oqHvk/PbXy7G5/en4/H1h6u7MgectDiXwPEhYSEg8dTeV1DvnAdKsw7qKrWBEe9Pfz29v7k+04NSF4Qd972Ebb+jXyH1YlacTOPb89O78/uz8x9PP1ze3U/urm9P32U6lwiYoUho14/CdYLp+duJcxQrcIurVkA0dA8SxiJ4JFEElEmYYjAGOxy08mcdaT6WjaAneYpbNOPF1eTu9PIygzlWQO8nN6e3k/P7m+vrSwhxoIf9cYGVu+v6CnpRBiJBXGDQXRILlkYhTKv8dasRrMQEECSI64lj7TgQKiSKIuMp1sfGdAFQC2bNzCkuYDoCMoOeHqoeEFlmd9bKq6Q150CiBywAz2bKIDAarRTArcbiUKt9rM2K6sq6UV47JA0jbTq7fqjHt2fe2QlEQCpwCJJZKzkMidBv1CfFUaogmXEWm5Ds6c2FNWUDeLvKRkypjPHtmcgGygLDYQVSCzvGt2eVxp2F/O3p5Pz+7OK22MNMCLRhh5Bw7b+vtFl2kskoLJiQkCC5qMrKf6q1X6YBAkT19MagIl9EyfqMRFgM4MzyQKNRgDImDJeID/O1S6tQuC40jH0RVAsjjCjdnd6+O79T0DTZU5wxRdkHMlvp8VIdV+RWoEmWfaA4JdCS0Ln+QCyQ6rbybpVYTbF8xJiaVyTEECBeNRJsZieziZgpS1KfkjgTI809jZqIEveGRhms1Z+V7ndgpoW8lqdqXro5+jkxVqtHxVaj286vfgEiTCDD0aaCFSpyqwFGLPDNcG3qsdO5IdZB/f0PVYWrOxmvv1/8cmoA72ScXIf/TpYIJsbA3yimRwyFOITpyumIbbj1OdqnEgOfpo8ur8enlze/3P90Pbm7Ob37abdD4uIE7quyQ7aT8Rmq+RJ9jqPkY22X+aM71OskA+Prq7vb68vL89v7i/en784bwBfCJf8kS5THSbphuT2/ubwYnz4HisZF1wh63/Q2si4dqdVKuk+EnMsNiC4ox43wKGOwHZr3797fbY6rH8/jzfv1y/Xlh/fnW6A0TsSmSB2666sLhb0jxriPP+o4Dt+Ooef/z8317d35fvH9dH550x1FRGj6sZ9KEokmHCXlfX51+vby/P706vTy17uL8QTIrKigQGAaCkCU0VXMUgGpQPPq0tAk69Un7xibRxhOKYpWkgRrHeIq9s7L3+Ylxvnt/d2vN02cctYEU4l5wonAWey5d9DAooy6+5uLq3f3F1d357e/nF62mbjQZu3ga0JhwVIuXpWgKwOXYE5YSAJIlItoeSgwlT5GDkqfu9UIEfD620X53XtCSZzG6t3JYgC/slQTGjCqMv8m1iMZPGL8EK2U4RRYqqQjnHz/w+KgPUTZwIkDb7Ty9beLIksjssQUC3HD2RSX4zyqjKT8BCBgcYxoWH0M0IdhKrixcMMpoUMl/IGMPA1tHLb0RqfKUXSGI7Sa4IDRUIzgm+NSGzMy2dvvi285RiH5YvtRTd1VC6zWpuz6Nu7YJXXXOavUF8sgy8EVki1OAlFCbL+Saq4l4UyygEUjuBvf2GcS8TmWpbRMObHXkEgSWOfzT2e6nmI1gitG8VOynU7Z6KCr+nqTlKf/41audvjk6cnPJiRbUPbJ0qDNfdiKv/BJE6JtVD0lK+rgPvxQgu3NkK5Jd9YTdxY4FAB/mtydj5B95++Ode5O/b81fdeQKH4663aTK94h53aWL3bAr67PzveTgtLJN8pCXEu5fYZJUJ2Nc+nIev6vbAGV2DjFpLtyVIGqy6SLo21TRTPG1ThrkDYEbLT20JR1gSsj9aSFKFMgtTI6gl6ZIOdr9LJYexYcihh7SJPdyOk3/dvxSTdZrfGzSVwrHbG/qb5UvrjhLMBCaDx597TxVT45EaArhc0KQzH45DsIFoijQOJavNgyEogJKydzjhNgqUxSWWXVhMEsjSKNNkD0K5mxVydEdHmd+f7rv1mU4tXgFDiepxHigD8qZKIeY9ZCoum3QoapJBw7P9h0dIFEvmIi9QzeKQ0WjMPv/+d3GGXAEF2BkNzE05EEIRGXwpD6e9kI/l6B9/vgz783A1LUVAHAjKn9ACa0+AfmDNSwMNWRBeJ7WdaIhedhP/A8lFhI+N0MTz+A3v+puACDP/d+hzfaqdr1gmEL79dVUK/ZltHmA/tAdPLU1n64O3/Yj2prKjfyjZ/iAzfR/QT+flp/OKMp32HS6ud62nt93K6edM0X/kwcgx0am86mZk+GZgdmZhsjU5GU3w82tDHV778sI1OhfodGZn+OqxUURzrkU9znvObN1rivu3Rdd+u2buCybuau1rRrS7jBr17XxRC+fP26+2VXVX6LwuCT4CBitMvqa7drr12vvDZad2266noxgzszg/XF1mZm8Itca1Wo3/FaK59KajRVnKkfEvHQjxFFc8yBY+Xnhy6Ed3AIF7KaiDTbQ8kfphZMfa6n4BSb+uUZibQce/NAYw33PUpaFnQ0jPsG/xOzQAVADtMhpCFe9jW5oHcm2CogDgknMeIr0xV4XJBgkRcr2+io7j9PqQKtoZnOGiTCLhsiFwEVwGaOHdC3k5hwpQ5imDEgNIjSEGtAinP4o/7bCBYInCCuB8NIuDiA+oANDKYR/H8HNl01xRa5k8s+PODVqNDvykJNvYDyC71HfVSsILJABEZq3kkvIPfSD0wXHlegxYg3gFJv1hBVYn2ls0wYLlmO9k3bChImtPBmbK80qjMBXFMVMD4aYhkMF0xIcTScMiZ7ZRKWmIaM+zGbd10QWhEZQa/nIWJ8ef3h7O2vd0e2OK1CgipI8xOg3jwdfcRYcjQM8XI4C4/NL4LbXziKzS9h3De/xKF9Mw176+I++kyECZbtWmLzQI/5aJ3S2F8QxwHvQIHZXD3xBWaKeconRmdygjoxZfPIy2G1wr9uc5wHqPQqo4CiCFQChlMssdDtxUHBrVyxFB61Wo7IAwbJjDuVVZ7oAkrBYoNKHIFAqwxzDsn6C0vsCj4BSakUWHikUQQGheIeyAUT5nutp9NMkR3qhxMrBM7xOcze4sF8kMHIMWlKjLfTh8LxB0P1Yq72R/feuOMs+upZL4OoOBPIyAJV7+Cv6v96oP62GbAi7fl4NcAYgRfIppE1pTCvsHxk/KGka9oibjWRaV0Q1lqPjgd/qS4GEZ/Xdtz2l2++raw6MMwwkinH/TmSnr0vRq7wI3z4cHEGKJozTuQi9i9ekAMGQco5plIV+lI4jZIFMtq39JmiqF9EL9703t3cvUUChwpdb4u1rcBByolcjRmV+KMscyBxpxyEo2rxsEm7vVdDLPyL48xhK/7E6gOztNWWctjkv1S+E+nUfNWxPccovKbRatRc9Ky8nBbqeEqHtSbu24SzIM6OTGjpnmq4OWkqeV6rVy9DL9ZeF3VxEYzZ07ABoNoeC0+VRWZfgVDrE1+dvQci8s1Zmf5OkLCTQzU5c+caVSAi0X3X5ydMcavOaGWlGliiM2IhFWrJGbJHHVvQG9rsWl51PdNln03twGG5ejTbxvaoowOFTTt6O5o+vcTul/DuOj0EYsI72Zr/CPRW1xKUzptX7d6dHy8un7iBZ0L+wF9PVxKLV5XNSIqith75SJlc/L9NZb4nx3/55i/fnvzw+ttadc2kwGiaxlPMFSklxpToWEtG236D4/1UwOpwhOd5j4Zxb2cBeWNUPPa+puMDFzUoE9sSNmhV/mpa6xlTJrPFEhjH+8zNm4N8uLVyBa354WvJAAU69mcea6ljM21A1NMT9YfC/goINbvyaqriECTTVsPA6OvD6dR3JtCCaKi3RpsDuQ66mKrW7tZMVlNXW6xVK4I201XBdc3HeTXmWtPWGav5ehelBkrEtq8yqHy9NnS1/5qCGpZNaHq2CgIPlZvxDj5pvUDuw9aIal++uOa+9UtXh3+H2wnUCsXzeCjjZOhI7Yvwoa9wrtZbiW/bjMRJ2YTMEIlSju8WHIsFi8IRnLT5rY71/3l6N/7p0xVKOjI+yXkljefQ4NIEgYQ1uCCfwSkzhTzd7anaFdfExRH06vPGX7o7vjw/vfpwc//367f72vO25zM01BLD9XGrAzQ2Oz+jaZPcZ5HobE5zBnEIRMBfIU93PrevzHqfR4VkdpRov/u5iChJRpB/aI8MXee2rP2gxXfZyW6dtQ5QY78281Ba2LMViz6ti9JMk89DqYrTk6ptvIFYFAkWsblkQoaY83qc9nXl0eu//cfJF1DCkxNyevb+YjK5uL66/8/ztz9dX//cZtqa5nGvI+gfTy8uP9yqY6EuL8a/NiD5EZHof2wdy+ZFKBVeNxeh+GtQqp93rkHZQc3NZ1HGUmHA57VlQG+KTpZP2zjrB9KprL3Dp7tb5DchewKlz7b0b6b9SXyGz21Dbd9zZtH2O2uLgC0LXnbVvuyqfdlV+2Xsqv03P1r4CzrG5znOU3qpJ39qPXnFHG5cT179/suqJ69Qv+t68i1+ih9B28+aj8aTm7HOh44nNxddP9oMU+efyoqkfs9SdoGLrYPXNfTunpyz7Iql0qLFlN9AnAo7aWxFAw6MThUwxRF7PNJM0OFe3UCVl4/gr0mUchT9bfBXXb/3t2wRpI/BSxiLAnNvixhUb07KVhqHoL/VH4JkkAoNHW7PJ3eq6GUEQ5QQMbQohn+1noRCph+NoAYb4DBzOLaBuyzsKQA4BEz0geNXzqiGwLi7kuYAQAQswaPCa7fQM9PsEAybMlKcmrO8/HB72UjM0LFYQzK/e9hr8aiKIa0aq5iQAEQBRQQJ4+thGF9emK0UjENIRBIhk09zMOpYLBIlXEAEUMZjFEWmCmWMYhyNtS+ZEaFWafrwNp7dsgQxomSGhdTJCe1VGM1rJVYdW6vOrB2XMIoF49qGC3PDoXmAeaZGmRXdVQlX3lHTsQxKXsgfiCQ4ALVi0DMDRTecKHM8VmUj1Dbsw98n11fGuRgIiWQqBguMIrlYXVAhEQ2ykl8j/T/5X4ZYBJzou6VGcFcq3bHgIHAMqH5rVryKNlea6qMq18Ohn7Kb5gYt1BXAPpXCEAvCm6g7879socyC25qqzF/WkQTC6B2JsZAoTkqEnc5xCZ4uiq/HiL4IjUwch15U8l5UMilJ4N50skPzjEr5ooxyr1qZbKiV1ZpPl/3nKz2ri8sPS7pEvdPNbI1qxlzIBhGE2q2R5ultowBMFxu1XIASFBC5GqiBLmuSSJ9rj0MfPUq3odgtud1GDi2/epnhVlSKwmzpMMWYQrJYCXUharQCVMGwGcEzjsv8+zF/0EJraioL1NeWXrREJNIPC1RvRZJkEkUlmsb2lZcu1bpOWVZfvikZrra+RMBt+WGJgItQrTdnxC4+FT5Taqn3DMUsLJuSKWMRRrQRf8H23tqIeJNFr7wvUXWL7bJcAGUDNsvC67XlepU/6yy6dV28xP3kfddOmIW3CwKVUlDNzYbggTKwt4iE+nLIu1VSFvTCg5qgK0RZsbedlpsKUrJAooxxol90kCG7lceyxhHSmYB/MyfHlnz/e7s3+/YHftFM3qcvZYdxf17UstiFfTozZVz62XKrRefOVUiJrP0pkXUm3kf/2BLsDuMsN/X6AaUOfLGKyx428KK+9q++xvlOnr0qMTuk+1ZlQbE7z6LQChiNWgueW68ZOvIIleqaKH784hrVNEyiaj3Ii4f0HCrG1NXsX8W4Md23jkmK/XkWHVPqmdEySfZ7Rs6zKh0Fwey5zLnxomk8msat4V80zf41jQ2y7F/V8GI0Z4+qhpc6tEdds+RbKZDmIO8H0Sm+q4TFxHGJgF7E5iaC24OAUZHGpvbD3G2PJMpPuXhq+HSDGLQ+u8wQWiQKOYr0aKIoAiKFhoOpUpXimQNjmUJWD3k52vicarlzkbjKO2+xy7v6WWuBcmvjp5d+18FvRM3ey7t99G3Ir09bwu2n5yk12yWIfRSH33/brWb7s9vaVKkltaWke6zr7VhPqsrF7rOdxJ9kT/bLbfN1RrzcjO4afbY3o3vh31xsfd+vOcqtquQ+6YXGz34B7+355Nerse+K0yLob457T3Jlllt5MssNHJnlXv2Y5UZuzPKZvZjlhk7M8vPyYZa7dmGW23kwL3ax2S7ai+K3NIkLJFvsYqBYEKZxkps7F7Jn4f6NU961XdmlF9c33oz3G5lRIuRcbm5Hn3xR/ybewr6u7N/iXuuSNtzNrdZlkBveaf2X7/7S9U5r09R3p3XFZuztRmsjAVufW1P9vOPpNR0/+zzOsGkmdouTbNp6/gSmwRd0qo3ph23Z4r68HGyz+4Nt/NN9D8fbFK7AcckbCLVCMkFr5yKOJxfuLjdIIkQxZGIvjg4OzcZSJAQLiArWw/hWwH/A7dvTMfBUu5JlHFkx+BJzvaf14NDUHGtAH/QGeTj5fnD8rdnQiKm8noC5XATCFINkik4dwVcJV2D04BAeMKc4KpAGbAZkooh3On4A8CPjwHSWzGzEh1mElizl+izZg0PQO5AhJEJyJo5MUoNiHLpzimMW2m24h+o6ICokTwN9+qxqoN+uRgeHthf9kx9cL9CULfEI1PkJYjQczolcpNNBwOLMqTPjHggynEZsOoyRkJgPzYAMiQgEQWHcTwuA+4iGfQ24H+JEDFYojg4OYfJhcg6XuiPn2ZZtbZwxh5PXI3g6FSIVuLgf3F4CdvJ6A0K+2ych3xUJOTjU427PflAeP4KQzGZKYiRcT6xEyAUygTDNUiXZJ6/gHTZHCUZEmGSYiZ+x6T9xYM40FoWVE+PgSIMpoYivTCYTaTRW5Q8ODuH1KxgvcPBgj5E3cBDHgCJ9Ymi1mDzjRj/kRLEv0/XFloEg5hYMvWY5hG9egb6mwKabLJVasdC5RWrPXtZIHEwt3Z9mM7Oar1fubpEz01d9jOMn28y8l4qGWhYvc3icI0HUQBI6Y6oogQxsNb9FmflYWWlBrUUpt++S8BeKtRd0xio58xxZXieQFw4sUURCTW7ulZ/eXPzyzSRY4DhzphKu/BFJcMHUB4KYQSw8q6RKL+3MUiNvhFsAT6mukLNZ8eyyGXNlWI6ouDAnEseieolHnSLzYxBVn3pSzDlVNoKgLIKd/RzPFLGSDWpwasnc/Ed15uKsA2rV0BxyqnhgichOQF8S/LgRXskS5W6tfsYrsQa7G5IHvCoemTNdFSip4/YMwFqi3EvEOVodND0tFLogdUHMyYFJyStkKAhwInF4VZX2Xq8k5PpPdV6DnoxiBP/4Ta9KGMehnd7m4d6qmGpVR+aOJbX6ayk8ai4eKpf/rKnu8dTHFNA3F7fkjfzVPKfl92tq5CrQ9Nsl2uLwjA76v6X5xF3xai0LbG5dnmBXDvYoXYbN7trMIEJCYDFwf6+xIk3NPKbEDL7j41jh0W+Vm/RzUwulVkrC6KW2IoueNj4bdWg0s16jlQ/6IrR4g9rJ4OS7/63bO99zedI/+W4QskAM8nZqENSjoUTiQQzNRRb9/H0fJWRo7v7suxouUX3QD7OhEkOFU7tqfIk/0AfKHumPiliR3wbZ0c6WVLWHzdlhQQISxFGMpbZSynohSGmIebQy5ed6lA+cSIuVkDiGVGCh9hlT66Rod9mMQi4hcOqwBI2yoH5c4mK6su01ZiLtAU6EAqp8b43rwAcW54ARx0AZ7dOivmuy+YX51uSFfKUYbdqAHjZXt2+e4VBvsI5RdsYYd/tB6yluNgNEs36Y9Y5w16cGjC4xl8BxwOaU/JFBFu7EKhWuErJyJpHEnKLIrOBNnWuMVsCxwgEpLUDTTcQA3jOuGDxjpVWn0zDqfKVUxSmHemlPpqlkXKg7KXGkCi77mk6qZXcQh4eZkH91sMaw65uwCaM2JNTE8HIzCJWUxprtjwus12NVyRjbmgXfXUFywVk6N3XB3imR3V2r8eLQSLgSxClLaVj5qMx84T4awCRTK4bNWg57t1itm3p6WHpnum1vkD+OMaKiQi6SPlptDxUcRZrbLZ/NO2C0MINLILNd+GYWK7oecCIHGUGGjDWoSyA7kuFDnTHs1q46B6XAXRpXI3eGyMpDw8C1Alfz5cuCpl9ntzyjuLZDuujfLxAN9bVUC1IeMo9U+XvnpVFbzUbd87MtvEb2MyNcuYpxSUpdFO5mYp3EbFliPxO58lHKgtAZ5tZMulUFpqFZUZgL7UlVBEQ6jZUIuHvt1WIHxojam8zMvazhAC5oXii+a9WjGCr6ioPrlU9u8IrcLtZM+1eEXmCVYcphm7F6wKu+GagYJSZ4WZEoZyIrlzJlYGaM50bWTS4dL8UCFzUMS9C/TODz59xFqfHCjL997MI9xVRAWecWX2h6D7zACssM45RmjypZtvy9S15ULs+03LHP9riEU+uJ53OyjeZ8Bi+7oKIb/WzTZp2nbUlud7UzdDVnW6TTzB8w9NrBhP/67xdfvDwUBXVsgsuBTFEEPUb1BT29TB4OKmrcRn4b7eyLv/t5+Lsvpv15THsxM17js3qZyXE+JbRobrCMmK7a55zf42sKMzevhLZYDenBXe+zr/XbW/tnJ20NYtd1U+taqU5n17XTJouWJy5cymumGuQu5LSir0HsuI66oBCuKIpJkENzSaQazAAJfFQ0wq5yc0b0JdWEGndVdaF3VhK9nvnCkx9QcyvbmOlbY9efFzIkKgNbA5pw3McfiSj7v0eQCvXV+w+Tu0JlZ96ZYmyqBrN5WJyB8k1h/7K0cWnasDxtTXb4U04dl6qFNFRWCKz1l3pb5+vGE9/WaGiWT/1Ahb37OyONY5lymueFFI3vsLyJ0jmhypf/+hWojaJ6maOmTF2oTCapcUQaeWnMZCsvTZNCLFQbRnN+IsoZ42aG9yJs9XNXcmVJHKdSH9SHZhLzbAo296E5BwkZFT/poEP9fbVLpeaFrjnu91yLexL2tO3zgGyYdl1FxK8e1E+RPYNN04Bm7dGREcXGPjaY944JtQOkyj9WLkiwKMpFIWBo1K7ZEI8eMNVf7JMbntV8kUklraurAtvmQVGLeLU2s53XGlb4AqDGA4gYnYvOM7RMqqeizjOghdaVUfWmCECyGpmFrrQZASDWf6mmGQbqxvqB99NaQTm4mkBzMIW2dZgG2Kxrmij4yqY+jFKsQ5wSs6VDh6tgqjbUmVMDptgsSrUpBVSexd38ItP5I0OAu2LC7Jr3Soj9IDML9vKVusCrzhRIXaAkwXSwV93pX+N6pev05sKta/O993qw5MZ6Ki8CXYf1q4uZQeOOfUCQEBzg0nJZH+CLUWgeekCCuxXEjZ2Zppq4whJbOSKAjIDoYwOG71iLcjIxFHMcgcrGH4FIlf4T7qBydbIBHriSzUFeWfyP178NvCCVUOKPKE4ifATEcLlYJ6NnBhGGERk8e1ywn0hIWGg7/Kg7qtQvMNvRFENEHvAIenr3R07ifymp/u+eF+bXxgHoqSa93D2t+Vs5gcbX52Q+xxz7uak+wGpZ+woYV32nrABAgyV5VjasEfyP1781UFvmExAa4o/w2jjh5p6jV3aWiRWV6KPCEyyYwFRX1PqpVfNziUEwdZ40jqK+8+0f0QrYLBsuk/lNEJflCI8X6t312fXIUKVEaE7NQTISZkTpMVO8VQ4KOiUOwQLRud84u5N8UplyPPhq09lajc20xGiqiuF5Ih2dekE9Nr7ei6uC+Lb2whNZDRhVIXgxZEu15wA/Dh8ZfyB03ldy1zcDL4baXAwP9T9b9cLsVunUlZJZ2md/FB4x3Lg7LlbX1Qh9NXH1EpUvKx5YUVl6p0SMQqNhEV3tWkoV3/RRNcGqb0v+dZF5wKggQqrnGzMqJR2m4IeLs+eR3ZRsPAEb3PF6gg3akmxQT7S5h0YcKg9rLvHBGoryfJp/8ahfV7MR7mAixWUea3dMK/s8S9UhzlksZm5184sNXZRDugpoE9eR+sBwQmWf0L5617wkIcKux9ZHbfcXPXNQ7hWtPeOXeHSFjZTotaZeoo7Vd4Xh1TH4Hsxvb8Y6aOLz8hvjB23UPonUbEWtEoqOWFEg03l6LuFtQr51xUXkAC5m2iHI/KAjIDI7MMSKoyVRSwUQAanJzumUuG/VEaP81sfMtUDwgZKPQBFlwlzRZuBhGrAwO/CNUPn9t3Vn5prqr48MPfYqvt9VLgT+9/8S/+vqd8ui8vwx9NIizvpoEaXVT/6vvxz3j0/6xydwfDzS/8GHu/HgwNe3kaHSq5CK1wm4H8w5aw8x6hZu7pnMG7CpSdbbl2HKS4EhNyhH9VE1RmiDVVuMhVAlAGsNgm1YSp2FWCISuYyZGs1U5Qkd4R6Y0NgZRXt+qSJcXd+djxx1OqU2xRCx+RyHR164JsbvVmBKrt0qTGAqiCRLXFSqm7uuknRw+mS7IjXj+YhEkVk+/91JmxJzrXV3FLDSe5nu2AfRbhfyZgV9QIpmCLRnhMJV4QiHA8/KhmNzmIWrWG1X/TVVX48ntah+Tc+9ZPepcOq0rulrELfS/BVN3xCGatT86yn1RaK2UfxA5JHZTvdIBD7yZGdqdkpgvQjs3akLaxushEu7YS0EhGJR5wGryouzHV5hLV5nU3DplfBMyB/rxDVrV/OnmAqASAxCvXR1ho0ejPJdlC+7mZRWBtoHNh97Rci9xtIoo00yWQO8iYx2I7U7nQ2uSDONzRI6UGfgUDuMpfp2hckvs8XOlUfXfqpjpFfXOk4q1PG5hR2lvsVjJkFkpv0gURT5bAZZmVetjSxofs4QiepqfK1/hWROfPP8aPM8YkJJnMYjOO7skrTnuNryW6QtsdWaU1+3GljPqBrI5tm5QMKdvKzGxZy6bEdUqtEiFBLO5vqq/27pk47lnKoDLzWb29Rs7r9Ys7VKc015ZntdZsNev5eazA41mWY5kQrMv8oK35TCcxO4sOOpFIQ4qGozo4MSzE1YzF3/cwTlRFiDhRy81G6+1G6+1G46PpeKN7XL5m5LXiCOAom5mj+BqBh+2/l6CaeZ4RvHj62KH9qz1hwecejTzu4nS11/1SFQuk3Z0rqKJVON8qxlS7nas9dTRYjEV12SVI1fVuo9CqlYD0iAGx+cSuG8rlfLCxyQr5LEC9yCMYwvrt86FgV5gdaHYssSKU9VSsd6Kc+XDWxfE3aG+na4Sollg/R9yjqorxo+8lVbtu1odj+Z9nHph/JHvlWGLBwb2hgT0Dvcs0luDhadAaMY9HgIH2B7NArHJunuK94tITVIjloWNfkyE87jRNYrCcyAuSS/vunEHoCU+5kDXRXw9UeC6PxfBNFXI0BgD5UrOHM12IwCVkgtkl0p8r49YOCrbouwpvxeLVu3TTbuq23TcSZSXdn5q0HZI3HdCWVKLA2poni+lVkDu/PcIsyF9dDSihtnXQl70FoIacKoHl8UBClHwWoA4xyIU436HLdUlGLfJbCZi9OmQKyV0ufDrYyXZWMX1reu7s82NElT1OZicJ1Msq4C/KWrZi2PYfu31bG1CmbjgvVifUBDgZ4vNWrLG7sGhbrBXSBhBQNTEKle9c1SZQQ1OrPkaetMDeIKO5mGiaNxzaAAihjFEDKsifHu0XCCkvPD1k4WZVaH7FD0iFbCylq5+tJTm2mAulsTfKZQi6CfBZ56VbRkJISECUH07e84SDmRKyBCqIXRV13LbV9S8C8p+F2k4K02KOfh16Yx1saSm/KKjfK8cSJ7B2nsotcaOEd/gaNklkYgGaRJgjlEaj1ctJ9fkwEe1IGiRJ9+bBI4WetX5vDSgIS4sLMPr4qlvISm2BfVfkREiaCrHM97ZlKibj04RQKHbjeHYrAaP3+CnGMTA3zJ4L9k8F8y+C8Z/JcM/ksG/yWD/8Vn8F+yyuXDXJ/xaNG3KHhIE7i1RsycL/qMR4vu+dzaqe6eaDmntumEWntu72bn1Fp0vtNpzavqmbRv86fek2inD0npj8Jl7UVchQcb3risNnYZdVLYLlqsQPDlqM0bYNTGeQxmSDBXXh0OS7Ox8ZJihdp8WUNtHg9V7jZMIy8Nb8tNoHBhZ5fLkTtei2ywQOmbEvjnOHzZWfjwmaW5itcn1pU2Vfkeu9cdBD2oinIG+0Wmu+BNOF4qL6WGWQUb1AsfykskZCEw6rpd8R9L2J9B4q2z8myS7vD5JNy+q0r2beGxV6K5kKU/Cpq6hK74ZAtBt182iJtvyM0b3czKOhGZf7iBnD95ilmUm86xTfS3HaUWBa6k2Q6rOS7wlkX4rc3aFMVcWfABSuWCcfKHibBUZH3djWiBIFluT2Led7khu7NYD3EfSlfT2au0Cpe41UHmwbq+QFlLe8+aSjv27fl7nEXYHmxR63FHkrlpihLyzkzDRq4Yy1hn7Z55qgnUtzgZbjpKlXfd6/12AIWdsPpZXuFhxFPYRkvMp7rBHMveEfQiIvS/j+pGt95v2wEPVNnH1hjKJZ0+fLaFzRvvsif6uIAaxDKoI5svwOo3U9Klfks2wSNwwLFsJd3HGX/Vqw+B9wj/rUdka7z2QNMq4px/XhJKfDVHre2FrKHRl1XqLO5dYuzG+rzfO8W9cTcDxnhIaFFT+dBEGDULVdYj10U7jqWxtXLgIcHnbfloMKXL7lGhcLlZ5Go8zymztGecee4wRSENrjy3OaHPfAOK1ypXLpXtYquqxrrBVPvdkj3YTn1CkgK4je3cTll3s8JH0LPXiXZWEbkk+6ZU8kyGu1VXdcfVrpva+rOxm6BYZ25U++xdhv3Yma2YWLuTas9iunNfYw0HnmTr/uyD+GevnfLe72VkMnsjekfFPwNGZ2TuRdqw6tjfgq6oQL+QBV1N52+woLOdkUjiWRpNsPReFr6ZJWw2g+ULrztBar3uepNvWy4KX3vHd0dsT6MVQA2ep+FBdhO3uYK7cFMz6dXuOd/swvHOtD21b229A1g/VlDZxZNwwlSlYl58byv9+oGZNP2AE6l2SHgvM+82T9uvNFffcKxyobx+mfm/UrRSXXn4Qag7hAttR8vjwbeD4zW3k/f6/eWb73r1pwoSCkOOhXjzp69Pz85uzyeTV56GEUYh5n0t24TRXpebyy04/7XdwyXiw4hMVWeGggUPWIqh8d4Tzj6uhvoWWBY8bHFBuzEE+rJg4SfNIOyHpFqlFauPTDBxLYXeMSzEmzqNY6H9aPl6cDw4WT+W60ftZUwyqNl+iboSaRoXzzedx8Y/zyrT543eBNzbmI1FQSsY6k6CVmg/Wp4Mvh8cVz/rMIoNnS7AfmPudlWSkuvgp2geP0NnGMmU4/4cSSze3NnbgN9IVbRVb40/So76xrfuOytWa9w4ad7/en91+v7cN2l+5MxzzL6uNfIcEw2lM3czezqg1dOY9zZdP6VqNC58R3F1jc3E26GsbmsIn0+lfsoxcv6OqVLrczwnQnLUbcyaPlb6pqw89zKGGVpdBib6+ZKxSS+8jHrRdSV907A+1jFaaUWlvPXMwzXsHpW96s2Gt5G71zfnV+dvJ/fj66u72+vLy/Pb+7Pbi1/Ob/389tp2D7TJxf351dnN9cXVnR9QSsnH0XC4zfj50Z3eXNx/uL30Y3PbP0vlCsWfQ7hOMD1/O8nXwIWd3PYzU4mg/uJI/ZKtp4Te4aZ3txEqJKpVvFYJVkZucnM6PveTa/Gtn57uwIc3f/rax3qflU95VGltOedrbEai2L4qJq96n2xmGWyeFZ4Xid4afEa4PthGh6fakw2q5rgPp9Y4QmM6JW+ir3OYYtAHJqjdl4w/mDrnm1/EEejIbmGb32nhHv1nSTI4Q/9c2fmNQ/BPin1rRbE2Jmsj7GqDzh4LABoCqRsE92tZvmeMpWZy8oUEUsty/bmVxThXLfPR2qdfZzE2TkFLHjkrTHj2YHy9y1+IJDWM1SYitZM8fEvDKxbiLXLvW2XdD6qpde+AdZEIpfjWZ9qfZT52nIfdU6nrE4w7yn5rK2JMSPe0fCPGfacBN2FLnoF15D67ztq5rspFftda6gnayaA5Qzhm9ClZQ9W5p+YLLYwNM1S+r/aRI8zwbEffbtJ5BSK2ZdNzpvC0yG+cvytOFIAFE/IKS7V2Km39W5fR01C2iqV5v3ymQFrn2LyaXhGWGYFqavcTJBdv/vS1WYjf356/u59cj3++vzm9+6kEIyIzHKyC6jHKCccTyZLyQwD8EQfVZ+CutFCafTgldCi0Mu8H6v88hj6fwbBI3NCXnejQRPVQB3ysPXlScND4Se0xJB/31sQa7WBY8MLbj3akP394e35/dX12vp90h94YouT6qindoXG3BfqatUWXMI/hzJowTy0Gmn9fEvN2KMWmm4Vc3alJ+pylj7I6OciSRHhe2YFsfgKUoCmJiO+oExTqSTI+vbl///PV9ZmaIOqPya+T+9Oz9xdX6kH+x2+lz/WBeDcO9bkIUITsgR9lGvYcLm5QYjp0EhbCgVqMLs5e9T6D0GRBpLslFiqd+ASzcPNweReN5mGIH2jJSfj3jKsbGs+pitkOz4hA+rDbVCo7pLUM2Pz3EejDdewKqAaB40DdIyf0gTrZeSV9fa6hvi7VS/bt+fvrD01D3quVLuxf74ZYOWtrvg/x0o+chaKvG3aM7zs7WuMmxTjEIQhmzq5AdGW+FyCwTBMlDCQ0R2bnjiEgjmuQEs4SNLcHXAUP7vxx5WVCjIIFobXTZQ2l9jute3tvi8f79bydJ8qfRGGslo8tXRfKd3Jt1+UxvKOhSK9fJJz4h8bssTzTxDO+OtjAzrbiafCE7i281bCNimtuTp056CS929Dh98i2o6lJqDeia6NBaRClVoQ+uSpi+5FEuLZlvBxnrm4Wn1ycmVsnG3aHV9ibLbkP4Y5BiCXmMaEYkASeUn1emdlvHLMwP6YLUoHFkeKyPjTNnq4mNBhPLHyIkwWOMUdRDzCVfKUmvVUXah4nLLygM3ZN35vVpvWaTCzLnVluH9fYkQU4s83qnq4rj0vR1Pz12vBozFBQDp6UgG4fBG3ClcU4D02O0oTgMqwQ8NAeRhQyLOhX0pwZ/Xy73szTQ3chPgohEMSqAy0X2yThNt5MU6Yg407CWCQaqMjF30eBA6EhbJEyzQgyz6okZbvptydq/dbLElOGj5wU6bBzOIO/LS3bZZV9m3ha2WbRPJFxFso61llC8Ed7rUmhchP+AwoVes+Qf2/i1I5z8i1d/rT7/f6nbdl/vv13z7zbbdcS2DzN9rizMJPDndRtNBn1jdJT+otKJipzOjqloSyELqmmvNtnOInYSjGvY6apxTUqZ01akiFZX7tliMrbsNbmi1CSFFB0IWSzVFAVfreubp7FqeZlCqDWpVp2uh+iMV3y+inpko6bFj7h5p1eMfL6KWood7tx4PU+B/VlmApgS3PelwhQDUbHg/Wx/1N9hcWmm+DWBfEz3f3mT1+///X+5vosj8r62ieMyzffHh8f198tOwnB+uD504rMq53Y7+YkE3d4fjnLP1MDUkOV2YQbxuUI1HjVD/+XLGDRCO7GN5V3htAex0L2UUJ6TyvgLu6AN1Zs/REwLY6Fv1SkaIcLHOl7+5lUmSIRn2NZ4JXnuEbjA1UrcqqkV6mtxaG8ftEQ0zmh5WbtnkRDu6eU1hgWFgF3JcF40BPJkcTz1eggj2PeskjdA/FBN9DPefGJQx2jjx8oWiISqYSOce1iQm/VcfgTrE6AVv7e8ZbeWblL3fm6uZfWVA2j1kSTEv/VT/lyM8SDxQhQHGbXcR/a2y7OUbDIZ7SJlSzQEkOM42GQpBARfU2ouVszBEKB8RDz/CaiQ5jiiJnLat+liCMqMQ7h/2YTd0fcAtMAQ4AoULzEHOZYgkpn2KuDAyQKt1odQqKsS8oxpDTCQpjLTfDHQEGVCyawpWlg/9WB4uwaU7vzIoPm7nEcdPBoayPktam20XZ2tW27qzItFze7zz3r4NRAxcNvqhYe/tHrc7VQzbqV/VKvvNm2SGK93TLZrS3yjKFYxO2fDastqoO9xlp6ZKJySbb7MdI4qsAKknQEvZPqHQUxjhlXTuV3x8fvSfXtIp3jBM2x6L9+T9Tn7ypNnLjvH1uNXXOeBJ0miG653Sw5hEeTyimIFUxxgFKB1SubjicRNuWGRgeol/EDZeEOZLZ1W/onLNZ6djWRo779MLm7f3s6/vnutrGqoip67tuLyXhycXr2vuErXUaYyY3LXfa6rUeMh/hmaNeiRm/Vm2bgXfsGjecvddI23CxjsuH3LWHyxVE2Uq/WrGb2qB6Vz9DnjMmWb1WbHSvIDYtQyhWF+ceu0HWjCpInVHBso9ePj+MWZft+M7W9CbAuS7OT45PX3268NqsNaDEqtNuy6m+6hIqKMYd1k7y5NrqxPiSb/Z6C3V1XTh+aOiUiXFlT5k7PGFfRC3O3n8SBzK/qcblvAXNG8cbV2NVibMKynirOZrPcFVtDmce/7b7orGm+P7XS9wuawWvqvPZV4+WzB60INoJeccbzIE2ZHSFJ4xG812wpvVJnq12qoWryQZ2j2hnJT+kc3yjP9qCjcSudDLCBfO6vQm6PlXE+zbdVYZzfUG9CUysH/v8BAMAQqGeBcAEA",
+	"openebs-operator-1.12.0.yaml":    "H4sIAAAAAAAC/+y9+3PjNrIw+rv/ii55v5PMfpZkTx6bq9rZKo/sTLzx2L6WJ+fmbuVzIBKSsCYBLgDKo5x7/vdbePEJUpQseWbO2pXK2CTY3Wg0uhvdDeAQxhwjieE9WiGYYL4kAYbTIGAplQcoIb9gLgijI1ieHDwQGo5cI9cmxhKFSKLRAQBFMR4BSzDFU9GP0Qr1WYI5kozbtyJBQd7koN/vHxzCGZ4RiuGWRRjkAklAUcQeBZhvCaMCGIWffxCQsFAMQ5xEbBVjKoUlaRylQmKuAJRo5lMUDFAqF4yTPzSkwcMPYkDYcHkyxRKddKaepxEWqlEfUELecZYmYgT/6P2599sBAADHgqU8wPohZSEWvSMwvwwTzj6ubLsl5tPCh52gObZpkMJwX/+uuOH+HeKPOFB/FLhT+XM4IxRF5A/M9RuOk4gEmisBo5KzKCq/EdiAwEsHDNMwYcT+ETA6I/MYJZaugNv2/2RT04Azqn/fuutCIolnaeQoCRGOGdV/bQ3T/fGvlEmkoUYkJpIjOsc1sBERUjV5RDJYdEZB6JxjIcwgadljVKIoYSFKJRMBcoxeYi5J4HsTqDczNTxYkDnVEP+VYiF3RKKQjKM5DiLk6EzUpBESU7lkURqrVyT2vunGfNuWokQsmBzgjxJziqK+xWwnoo+28peGUaVHasLWiJhjaQazyA4thUq/qd/SJLS/Je5liCMssY98lBBFMlWKRLQQG6RCstg9CpUmI1plVeiDKoE+uiw5GYUeuqx68lMDvT9X0Pb+3AMPlECNwmBHsBjjIaFF9epjVISRwE2jlo2W407OiZw7lmO+wQpjItRIcTwnQvJ1tCxRREIkCZ0/4umCsQejy1LzoZa4OJUtDfy9yIe0rRvFoaWM3lrCPtxealjDGEtOAi+OrrM7YWFIBE8TRe00DedYeuF5xTGn2akUY6XfEhqCXOCqlwCPRC70C23AbzhZkgjPsRgcHMLd9dn1CMYLHDwAmUGIZyiNJCD7KYoEA4pxKEAymCrzjzmuW3WFm9D5noy7SKf/xIG09t3r5QC0g3DvK/4NZxG+xTMFuO6prIPoxrmlq3poikxBSSKGmat2lhl/Dy80OpQQ5VHgBv8MIEJTHGnONH4HkCuSYcDihFFMZb9L66Wj+2Rw8npwfCASHChcAkc4kIwbvLGSw8sCIS2kbEaM83RGcKKwKs2B5yuDRK4SPIJbbGaGfqR8JELnH/RcHgFNo+gAQOI4idQDQ2yB0QBlBq6hfFNGrmEmAIBjqP69JNJXa6QZQHmFiFDMC9T32+kHIDGa45s0im5YRILVCC5mV0zecCywnUaFdhn+YZwDGxXINz8J47JAg6Ejo+6GcTmC777/7vtCC0yX5Q8O4frm/Or87eT+4vr+5w9vz+/H11c/XrwDTNE0wkL3SE05xyaQTOGgOJDq159/EBV4UyRwCIyCXBABxkAM4E79of6bU8ZxCGQGOE7kalD52rUTaaK6h0OYMV4mAnOwQwrHg+8Gr4HRR8TDCh1uRPzdq2CFJYpSPILecMFiPEynKZXpcPCQTvHQ9KDXwrUfJvfvTyd357e7YhoKQ46F+Ey4lnWviWkLKZPRcHjyl9eD1z8MTl7/MPhm9MPxD8dNPLs6fX8+uTkdn0PC2ZKEWGgzmelZYDPDh3yRBkgAohV4mC4JZ1S/XyJOFO8rs6HcmwxxqZXtyI+cxaPKC4AZwVFoTZX33Q2Si1Gm3QZZJxr6Pjm//eVifH5/Oh5ff7i6K3PASYtzAxwfEhYCEk/tfQX1znmgdOqgrkwbGPH+9NfT+5vrMz0odUHYcd9L2PY7+hVSL2bFyTS+PT+9O78/O//x9MPl3f3k7vr29F2mc4mAGYqEdvcoXCeYnr+dOOewAre4UgVEQ/cgYSyCRxJFQJmEKQZjp8NBK3/WkeZj2Qh6kqe4RTNeXE3uTi8vM5hjBfR+cnN6Ozm/v7m+voQQB3rYHxdYubiur6AXYiASxAUG3SWxYGkUwrTKX7cCwUpMAEGCuJ441oICoUKiKDLeYX1sTBcAtWDWzJziAqYjIDPo6aHqAZFldmetvEpacw4kesAC8GymDAKj0UoB3GosDrXax9qsqK6sG+W1Q9Iw0qaz64d6fHvmnZ1ABKQChyCZtZLDkAj9Rn1SHKUKkhlnsQnDnt5cWFM2gLerbMSUyhjfnolsoCwwHFYgtbBjfHtWadxZyN+eTs7vzy5uiz3MhEAbdggJ1277SptlJ5mMwoIJCQmSi6qs/Kda72UaIEBUT28MKtpFlKzPSITFAM4sDzQaBShjwnCJ+DBfr7QKhetCw9gXQbUwwojS3entu/M7BU2TPcUZU5R9ILOVHi/VcUVuBZpk2QeKUwItCZ3rD8QCqW4r71aJ1RTLR4ypeUVCDAHiVSPBZnYymyiZsiT1KYkzMdLc06iJKHFvaJTBWv1Z6X4HZlrIa3mq5qWbo58TY7V6VGw1uu386hcgwgQvHG0qQKGitRpgxALfDNemHjudG2IdyN//UFW4upPx+vvFL6cG8E7GyXX472SJYGIM/I1iesRQiEOYrpyO2IZbn6N9KjHwafro8np8ennzy/1P15O7m9O7n3Y7JC5C4L4qO2Q7GZ+hmi/R5zhKPtZ2mT+6Q71OMjC+vrq7vb68PL+9v3h/+u68AbyD/E+yRDZC0g3+7fnN5cX4dL/AGxdaI+h909vIonShU2vlPhFyLruSW1CF3TEovb8Fgvfv3t9tiKUfz+MN+/LL9eWH9+ebIjM+wkboHKLrqwuFtwuuuI8/6gAN34J95//PzfXt3fm+MP10fnnTEXhEaPqxn0oSCS/0kho+vzp9e3l+f3p1evnr3cV4AmRWVDUgMA0FIMroKmapgFSgeXWRZ1Lt6pN3jM0jDKcURStJgrWubRV754Vs82Lh/Pb+7tebNTzqYyoxTzgROIsf9w4aWJRRd39zcfXu/uLq7vz2l9PLNmMV2pwbfE0oLFjKxasSdGWqEswJC0kAiXL2LA8FptLHyEHpc7euIAJef7sov3tPKInTWL07WQzgV5ZqQgNGVd7eRG0kg0eMH6KVMoECS5UyhJPvf1gctAcbGzhx4I07vv52UWRpRJaYYiFuOJvicsRGFYGUnwAELI4RDauPAfowTAU3tmo4JXSo4qiBjDwNbUS19EYnulF0hiO0muCA0VCM4JvjUhszMtnb74tvOUYh+WL7UU28Vcuj1ibc+jaC2CXx1jkt1BfLIEuiFdImTgJRQmy/kmrWJOFMsoBFI7gb39hnEvE5lqUESzkz15AMElhn409nuhpiNYIrRvFTcpVO2ejwqfp6k4Sl/+NWrnb45ImpyyYMW5D1yZKYzX3Yirnw6dKZbSRtndN0QB9+KAGu5zfXJCvraTcLGQpQP03mzUfIvrNvxzrzpv7fmnxrSPM+nXW7yfTukHM7y/Y64FfXZ+f7SSDp1BllIa4lzD7DFKbOpblkYj17V7Z6SmycStJdOapA1YXNxdG2iZ4Z42qcNUgbwDXKemgKscAVfnqSOpQpkFoNqZI1r3/RyyLlWWgnYuwhTXYjp9/0b8cn3WS1xs8mca10xP6m+lL54oazAAuh8eTd0zZX+eFEgK7tNasKxeCT7yBYII4CiWvRXstIICYonMw5ToClMklllVUTBrM0ijTaANGvZMZenc7QBXHm+6//ZlGKV4NT4HieRogD/qiQiXqEWAuJpt8KGaaScOx8X9PRBRL5KonU82+nNFgwDr//n99hlAFDdAVCchMNRxKERFwKQ+rvZfP3ewXe74M//94MSFFTBQAzpir4TWDwD8wZqGFhqiMLxPeylBELz8N+4HkosZDwuxmefgC9/1Mx/oM/936HN9qX2vUiYQuP19U8r9lI0eb3+kB0ctDWfrgjH9iPZ2sSN/KHn+L3NtH9BOZ+Qh84IyjfDdLs23oa1/3arn5zzf/9TJyBHRqYzuZlT8ZlB6ZlG8NSEZPfDza0K9XvvyzDUqF+h4Zlf86qFRRHOuTz2+ew5s3WuKy7dFd366pu4KZu5qLW9GpLcMGjWFsjBl++Zt39IqsquUUx8MluEDHaZa2125XWrtdZG62yNl1jvRjAnRnA+tJqMwP4Ra6sKtTveGWVTyU1miqq1A+JeOjHiKI55sCxcuxDF7A7OIQLWU01mu2b5A9Tt6U+11Nwik2t8YxEWo69mZ6xhvseJS3LNxrGfYP/iXmeAiCH6RDSEC/7mlzQuwhsxQ6HhJMY8ZXpCjwuSLDIC4ttLFT3n6dUgdbQTGcNEmGXCpGLdwpgM8cO6NtJTLhSBzHMGBAaRGmINSDFOfxR/20ECwROENeDYSRcHEB9wAYG0wj+vwObkJpii9zJZR8e8GpU6HdlZaZeQPmF3kM+Klb7WCACIzXvpBeQe+kHpouEK9BixBtAqTdriCqxvtJZJgyXLEf7pm0FCRNaeDO2VxrVmQCuqQoPHw2xDIYLJqQ4Gk4Zk70yCUtMQ8b9mM27LgitiIyg1/MQMb68/nD29te7I1tIViFBFY/5CVBvno4+Yiw5GoZ4OZyFx+YXwe0vHMXmlzDum1/i0L6Zhr11UR59ZsEEy3YtsXlYx3y0TmnsKWTjIHdAb3Y+T3xhmGIm8omxmJygThzZMM5yWK3Dr1sb5/spjcoooCgClWjhFEssdHtxUHAoVyyFR62QI/KAQTLjSGVVJbrMUbDYoBJHINAqw5xDsp7CEruyTEBSKtUVHmkUgUGhWAdywYT5XmvoNFNhh/rhxEqAc3kOs7d4MB9kMHJMmhLj5/ShcDDBUL2Yq53LvTfuoIm+etbLICrOBDKyQNU7+Kv6vx6lv20GrEh7Pl4NMEbgBbJpHE2pyissHxl/KGmZtvhaTWSaF4G1pqPjwV9KC0DE57Udsf3lm28rKw0MM4xkynF/jqRnb4qRKPwIHz5cnAGK5owTuYj9CxbkgEGQco6pVIW4FE6jZIGMxi19pijqF9GLN713N3dvkcChQtfbYj0rcJByIldjRiX+KMscSNzJA+GoWtxrEmvv1eAK/4I4c9KKP7H6wCxntXUcNvksle9EOjVfdWzPMQqvabQaNRclK8+mhTqe0mGtifs24SyIs2MMWrqnGm5OmkqP1+rJy9CLtdFFFVwEY/YcbACotgfCU0eR2VQg1PrBV2fvgYh881SmuRMk7ORQTc7cWUMViEh035X5CZPYqjNaTakGluiMWEiFWmaG7FHHE/SGM7t+V13PtNhnUx1wWK4JzbaZPeqIQGFTjd4upk8UsfsZvLtCD4GYkE62zj8CvRW1BKXz5lK7t+bHi8snbrCZkD/w19OVxOJVZbOQoqitRz5SJhf/b1Px7snxX775y7cnP7z+tlY/MykwmqbxFHNFSokxJTrWktG2N+B4P3WtOgThed6jYdzbWfjdGBWPpa/p+MBFCsrEtoQKWpW/mtZ6xpTJbLEExt8+c/PmIB9urVxBa374WjJAgY73mcda6thMGxD19ET9obC/AkLNrrmaqjgEybTVMDD6+sA49Z0JriAa6q3L5pCsgy6mqrW7NZPV1NUWa9WKoM10VXBd83FeZrnWtHXGar7eRTGBErHt6wgqX68NV+25aqCGYhOCnq1GwEPlZoyDT1cRkHuvNYpaliyubW3N0tXJ3+HGALUq8TweyjgZOjr7InzoK5yr9Zbh2zbDcFI2GzNEopTjuwXHYsGicAQnbb6qY/p/nt6Nf/p05Y+OjE9yhkjj2TC4NC8gYQ1ux2dw8kshH3d7qva0NXFxBL36pPEX5I4vz0+vPtzc//367e73re35RAu1oHC92+o4i81Os2ja6PZZpDKbE5lBHAIR8FfIE5rP7Rmz3udR8Zgd5tnvfjIhSpIR5B/aQzvXOSlrP2jyVHay42atu9PYqc38kRbebMWfT+iQNBNU80eqUrR9DY031IoiwSI2l0zIEHNej8S+rjx6/bf/OPkCCnNyQk7P3l9MJhfXV/f/ef72p+vrn9sMWdPc7XUE/ePpxeWHW3Uw0+XF+NcGJD8iEv2PrU7ZvLSkwuvm0hJ/ZUn1886VJTuopPksilMqDPi8yv71ZuZk+bQNr34gnarTO3y6o2V8E6YnkPlsi/tm2p/EZPisNsL2PecFbbkjtgjVdv5lN+zLbtiX3bBfwG7Yf/MDfb+gI3f2e+rRS034U2vCK1Zw45rw6vdfVk14hfpd14Rv8VP8CNp+1nw0ntyMdX5zPLm56PrRZpg6/1TWH/W7jLJLUmwtu66Dd3fRnGXXGJWWKKacBuJU2EljKxRwYLSpgCmO2OORZoIO6OoGqkR8BH9NopSj6G+Dv+pKvL9lSx59Ll3CWBSYu1HEoHo7Uba0OAT9rf4QJINUaOhwez65U0UsIxiihIihRTH8q/UhFDL9aAQ12ACHmauxDdxlYV8AwCFgog/4vnLmNATG3bUvBwAiYAkeFV67ZZ2ZZodg2JSR4tSc5eWH28tGYoaOxRqS+d3DXotHVQBp1VjFhAQgCigiSBgvD8P48sJsh2AcQiKSCJlcmYNRx2KRKOECIoAyHqMoMlUlYxTjaKy9yIwItSzTR6zx7CYjiBElMyykTj9of8JoXiux6phYdUbsuIRRLBjX1luYWwTNA8wzNcqs6K5KuPKOmo5lUPJi/EAkwQGotYKeGSi64UQZ4rEqA6G2YR/+Prm+Mm7FQEgkUzFYYBTJxeqCColokBXvGun/yf8yxCLgRN/fNIK7UimOBQeBY0D1W7PEVbS5IlMfVbkeDv2U3TQ3aKGuAPapFIZYEN5E3Zn/ZQtlFtzWVGWesg4dEEbvSIyFRHFSIux0jkvwdG17PSL0RWhk4jj0opL3opJJSQL3ppMdmmdUyhdllHvVymRDraxWe7qAP1/jWV1cfljSJeqdbmZrTjPmQjaIINS+izRPYBsFYLrYqOUClKCAyNVADXRZk0T6HHkc+uhRug3FbrHttmRo+dXLDLeiUhRmS4cpxhSSxUqoS0ejFaAKhs0InnFc5t+P+YMWWlNTO6C+tvSiJSKRfligeiuSJJMoKtE0tq+8dKnWdcqyevFNyXC18iUCbssPSwRchGq9OSN28anwmdJJvfsnZmHZlEwZizCijfgLtvfWhsCbLHrlfYmqW2yX5QIoG7BZFk+vLder/Fln0a3r4iXuJ++7dsIsvF0QqJSCam429Q6Ugb1FJNQXMN6tkrKgFx7UBF0hyoq37bTcVJCSBRJljBP9ooMM2a05ljWOkM4E/Js5ObaE+9/bvdm3P/CLZvI+fSk7jPvzopbFLuzTmSnj0s+WWy06d65CSmTtT4msM/E++seWYHd8Zrmp1w8odeCLVVz2wIAX9bV/9TXOd+bsVYnZId23KguK3XkWhVbAaNRa8Nx6zdCRR6hU10Tx4xfXqKZhElXfQV48pOdQMaaWZv8qxo3pvnVMUuzPs+iYUs+Mlkmy3zNynlXpKAhmD2XOjRdN49E0bg3/omn2r2lskGX/qoYXozl7VDW81KE96pol30qBNAd5P4hO8V0lLCaOSwT0IjY3EdweBIyKNDa1H+YueSRRfmrFU8OnG8Sg9fljhtAiUchRpEcTRREQKTQcTJWqFM8cGMsUsnrIy9HG51TLnUvCVd55i13b1c9aK5JbGz+x0LsOeyNS9l7M7aNvQ2Z9woJtPzFbV2iXwPVRHH7/bYcK7c9u81KlctQWju6xirdj9agqEbvPdgZ/kj3WLze61xnxcvu4a/TZ3j7uhX9zsd1du+YstpJ6+0QXCD/jFbi355Nfr8a+q0aLQL857j3JWVlu5assN3BVlvvzVJYbOSrLZ/ZTlhu6KcvPyEtZ7tRJWW7ho7xYvmbLZ69e39LoLZBssXyBYkGYxklu0FwgnoX7Nz9513ZleV6c2xh/shv9d30NfmdPYPcX4m9xg3RJ8e3m/ugyyA1vj/7Ld3/penu0aeq7PbpiG/Z2d7QZ+K1Pl6l+3vGMmY6ffQYnzTRTusV5M23dfgLH4Is4e8b0wDZrclBejp/Z/fEz/im+h0NoCtfPuKQLhFoJmWCzcwLHkwt3gxokEaIYMmkXRweHZkMoEoIFRAXZYXwr4D/g9u3pGHiqncUyjqyIe4m53ot6cGhqhTWgD3pLO5x8Pzj+1mxExFReT8Bc7AFhikEyRaeOvKtEKTB6cAgPmFMcFUgDNgMyUcQ7vT4A+JFxYDq7ZbbOwyxCS5ZyfabrwSHobcMQEiE5E0cmGUExDt15wTEL7fbZQ3UVDxWSp4E+BVY10G9Xo4ND24v+yQ+uF2jKlngE6sQDMRoO50Qu0ukgYPGwPOECQYbTiE2HMRIS86EZkCERgSAojPtpAXAf0bCvAfdDnIjBCsXRwSFMPkzO4VJ35DzbZK0NMuZw8noET6dCpAIXd3DbC7hOXm9AyHf7JOS7IiEHh3rc7WkNyqdHEJLZTEmMhOuJlQi5QCaYpVmqJPvkFbzD5pC/iAiTxDIxMDb9Jw7M2cKisDZiHBxpMCUU8ZXJQCKNxmr6wcEhvH4F4wUOHuxx7gYO4hhQpE/xrBaBZ9zoh5wo9mVavtgyEMTcQ6FXJYfwzSvQ1wXYNJGlUisWOrdI7RnIGomDqaX702xCVvP1yt3ucWb6qg9Y/GSbkPdSiVDLvmV+jvMfiBpIQmdMFROQga3Ctygz1yorCai1KOXkXfL8QrH2gs5YJdedI8vz+3nCf4kiEmpyc0/89Obil28mwQLHmQ+VcOWJSIILpj4QxAxi4VklxXlpZ5YaeSPcAnhKdWWbzWZn172Y67pyRMWlN5E4FtXLNOoUmR+DqPrUkxrOqbIxAmUR7OzneKaIlWxQg1NLwuY/qjMXZx1Qq4bm+FHFA0tEdhL5kuDHjfBKlih3a/UzXok12N2QPOBV8ZCb6apASR23ZwDWEuVeIs7R6qDpaaFABamLWk4OTCpdIUNBgBOJw6uqtPd6JSHXf6pzFvRkFCP4x296McI4Du30Ng/3Vn1UqxYytxypFV9LwVBz0U+5bGdNVY6nrqWAvrkoJW/kr8I5Lb9fU9tWgabfLtEWh1500P8tzSfuelVrWWBz6/IEu3KwR+kybHZXVgYREgKLgft7jRVpauYxJWbwHR/HCo9+q9ykn5taKLVSEkYvtRVZ9LTx2ahDo5n1Gq18NBehxTvMTgYn3/1v3d75nsuT/sl3g5AFYpC3U4OgHg0lEg9iaC6U6Ofv+yghQ3PvZt/VXonqg36YDZUYKpzaVeNL/IE+UPZIf1TEivwmxo52tqSqPWzODvkRkCCOYiy1lVLWC0FKQ8yjlSkb16N84ERarITEMaQCC7U/mFonRbvLZhRyCYFThyVolAX141IT05VtrzETaQ9eIhRQ5XtrXAc+sDgHjDgGymifFvVdk80vzLcmL+QrxWjTBvSwuXp78wyHemN0jLJTwbjbx1lPU7MZIJr1w6x3hLu6NGB0ibkEjgM2p+SPDLJwJ02pKJWQlbOEJOYURWYFb+pTY7QCjhUOSGkBmm4iBvCeccXgGSutOp2GUecipSo2OdRLezJNJeNC3QeJI1Uo2dd0Ui27gzg8zIT8q4M1hl3fQk0YtSGhJoaXm0GopDTWbH9cYL0eq0rG2NYd+O7skQvO0rmp5/VOiezeWI0Xh0bClSBOWUrDykdl5gv30QAmmVoxbNZy2LvFat3U08PSO9Nte4P8cYwRFRVykfTRanuo4CjS3C73bN4Bo4UZXAKZ7Z43s1jR9YATOcgIMmSsQV0C2ZEMH+qMYbd21TkoBe7SuBq5M0RWHhoGrhW4mi9fFjT9OrthGcW1nc1F/36BaKivh1qQ8pB5pMrfOy+N2mo26p6fbcE0sp8Z4cpVjEtD6mJuNxPrJGbLEvuZyJWPUhaEzjC3ZtKtKjANzYrCXCZPqiIg0mmsRMDdKa8WOzBG1N4oZq5FDQdwQfMC712rHsVQ0VccXK98coNX5Hax1tm/IvQCqwxTDtuM1QNe9c1AxSgxwcuKRDkTWbkcKQMzYzw3sm5y6XgpFrioYViC/mUCnz/nLkqNF2b87WMX7ikmAco6t/hC03vgBVZYZhinNHtUyazl713aonKJpeWOfbbHJZxaTzyfk2005zN42QUV3ehnmzbrPG1LcrurnaGrOdsinWb+gKHXDib813+/+OLloSioYxNcDmSKIugxqi/N6WXycFBR4zby22hnX/zdz8PffTHtz2PaiznxGp/Vy0yO8ymhRXODZcR01T7n/B5fU5i5eSW0xWpID+56n32t397aPztpaxC7rpta10p1OruunTZZtDxx4VJeM9UgdyGnFX0NYsd11AWFcEVRTIIcmksi1WAGSOCjohF2tZkzoi+LJtS4q6oLvbOS6PXMF578gJpb2YZK3xq7/ryQIVEZ2BrQhOM+/khE2f89glSor95/mNwVajfzzhRjUzWYzcPiDJRvCvuXpY1L04blaWuyw59y6rhULaShslJfrb/U2zpfN574tkZDs3zqByrsHdwZaRzLlNM8L6RofIflTZTOCVW+/NevQG3w1MscNWXqQmUySY0j0shLYyZbeWmaFGKh2jCacw9Rzhg3M7wXUqufu5IrS+I4lfqAPTSTmGdTsLkPzTlIyKj4SQcd6u+rXSo1L3TNcb/nWtyTsKdtnwdkw7TrKiJ+9aB+iuwZbJoGNGuPjowoNvaxwbx3TKgd/FT+sXJBgkVRLgoBQ6N2zUZ29ICp/mKf3PCs5otMKmldXQ/YNg+KWsSrtZntvNawwhcANR5AxOhcdJ6hZVI9FXWeAS20royqN0UAktXILHSlzQgAsf5LNc0wUDfHD7yf1krGwdUEmgMltK3DNMBmXdNEwVc29WGUYh3ilJhNGzpcBVO1Kc7s9p9isyjVphRQeRZ384tM548MAe5qCLPb3Ssh9oPMLNjrUuoCrzpTIHWBkgTTwV51p3+N65Wu05sLt67N98zrwZIb66m8CHQd1q8uZgaNO64BQUJwgEvLZX3wLkaheegBCe42Dzd2Zppq4gpLbOWIADICorf7D9+xFuVkYijmGAGVjT8CkSr9J9wB4+pEAjxwJZuDvKb4H69/G3hBKqHEH1GcRPgIiOFysU5GzwwiDCMyePaYXz+RkLDQdvhRd1SpX2C2oymGiDzgEfT0/o6cxP9SUv3fPS/Mr40D0FNNerl7WvO3cgKNr8/JfI459nNTfYDVsvYVMK76TlkBgAZL8qxsWCP4H69/a6C2zCcgNMQf4bVxws3NRK/sLBMrKtFHhSdYMIGprqj1U6vm5xKDYOocaBxFfefbP6IVsFk2XCbzmyAuyxEeL9S767PrkaFKidCcmgNgJMyI0mOmeKscFHRKHIIFonO/cXYn8KQy5Xjw1aaztRqbaYnRVBXD80Q6OvWCemx8vRdXBfFt7YUnshowqkLwYsiWarcBfhw+Mv5A6Lyv5K5vBl4MtbkYHup/tuqF2aHSqSsls7TP/ig8Yrhxd1ysrqsR+mri6iUqX1Y8sKKy9E6JGIVGwyK62rWUKr7pI2aCVd+W/Osi84BRQYRUzzdmVEo6TMEPF2fPI7sp2XgCNrjj9QQbtCXZoJ5ocw+NOFQe1lzigzUU5fk0/+JRv65mI9yBQorLPNbumFb2eZaqQ5yzWMzc6uYXG7ooh3QV0CauI/VB34TKPqF99a55SUKEXY+tj9ruL3rmoNwrWnvGL/HoChsp0WtNvUQdq+8Kw6tj8D2Y396MddDE5+U3xg/aqH0SqdmKWiUUHbGiQKbz9FzC24R864qLyAFczLRDkPlBR0BkduiHFUdLopYKIAJSk53TKXHfqiNG+T2NmWuB4AMlH4EiyoS5Ws3AwzRgYXZQG6Hy+2/rzsw11V8fGXrsFXq/q1wI/O//Jf7X1e+WReX5Y+ilRZz10SJKq5/8X3857h+f9I9P4Ph4pP+DD3fjwYGvbyNDpVchFa8BcD+Yc9YeYtQt3NwzmTdgU5Osty/DlJcCQ25QjuqjaozQBqu2GAuhSgDWGgTbsJQ6C7FEJHIZMzWaqcoTOsI9MKGxM4r2/DJEuLq+Ox856nRKbYohYvM5Do+8cE2M363AlFy7VZjAVBBJlrioVDd3XSXp4PTJdkVqxvMRiSKzfP67kzYl5lrr7ihgpfcy3bEPot0u5M0K+oAUzRBozwiFq8IhDQeelQ3H5rgKV7Harvprqr4eT2pR/Zqee8nuU+HUaV3T1yBupfkrmr4hDNWo+ddT6otEbaP4gcgjs53ukQh85MnO1OyUwHoR2LtTV8w2WAmXdsNaCAjFos4DVpUXZzu8wlq8hqbg0ivhmZA/1olr1q7mTzEVAJEYhHrp6gwbPRjluyhfdjMprQy0D2w+9oqQe42lUUabZLIGeBMZ7UZqdzobXJFmGpsldKBOuaF2GEv17QqTX2aLnSuPrv1Ux0ivrnWcVKhjbws7Sn2Lx0yCyEz7QaIo8tkMsjKvWhtZ0PycIRLV1fha/wrJnPjm+dHmecSEkjiNR3Dc2SVpz3G15bdIW2KrNae+bjWwnlE1kM2zc4GEOzFZjYs5LdmOqFSjRSgknM315fzd0icdyzlVB15qNrep2dx/sWZrleaa8sz2usyGvX4vNZkdajLNciIVmH+VFb4phecmcGHHUykIcVDVZkYHJZibsJi7tucIyomwBgs5eKndfKndfKnddHwuFW9ql83dcrxAHAUSczV/AlEx/Lbz9RJOM8M3jh9bFT+0x6o5POLQp53dT5a6/qpDoHSbsqV1FUumGuVZy5ZytWevlYoQia+6JKkav6zUexRSsR6QADc+OJXCeV2vlhc4IF8liRe4BWMYX1y/dSwK8gKtD8WWJVKeqpSO9VKeLxvYvibsDPXtcJUSywbp+5R1UF81fOSrtmzb0ex+Mu3j0g/lj3yrDFk4GLQxJqB3uGeT3BwdOgNGMejxED7A9mgUjk3S3Ve8W0JqkBy1LGryZSacx4msVxKYAXNJfn1DiT0AKfczB7oq4OuPBNH5vwiir0aAwJ4oV3DmarAZBayQWiS7UuR9e8DAV90WYU35vVq2bpts3FfbpuNMpLqy81eDsofeuhPKlFgaUkXxfCuzBnbnuUWYC+uhpRU3zroS9qC1ENKEUT2+KAhSjoLVAMY5EKca9TluqSjFvktgMxenTYFYK6XPh1sZL8vGLqxvXd2fbWiSpqjNxeA6mWRdBfhLV81aHsP2b6tjaxXMxgXrxfqAhgI9X2rUljd2DQp1g7tAwgoGpiBSveqbpcoIanRmydPWmRrEFXYyDRNH45pBARQxiiFkWBPj3aPhBCXnh62dLMqsDtmh6BGthJW1cvWlpzbTAHU3H/hMoRZBPws89apoyUgICROC6FvbcZByIldAhFALo6+6ltu+pOBfUvC7SMFbbVDOw69NY6yNJTflFRvleeNE9g7S2EWvNXCO/gJHySyNQDJIkwRziNR6uGg/vyYDPKgDRYk+9NgkcLLWr8zhpQEJcWFnH14VS3kJTbEvqv2IiBJBVzme98ykRN16cIoEDt1uDneRvz9BzrGJAb5k8F8y+C8Z/JcM/ksG/yWD/5LB/+Iz+C9Z5fJhrs94tOhbFDykCdxaI2bOF33Go0X3fG7tVHdPtJxT23RCrT23d7Nzai063+m05lX1TNq3+VPvSbTTh6T0R+GS9SKuwoMNb0pWG7uMOilsFy1WIPhy1OYNMGrjPAYzJJgrrw6HpdnYeLmwQm2+rKE2j4cqdxumkZeGt+UmULh0s8ulxh2vMzZYoPRNCfxzHL7sLHz4zNJcxesT60qbqnyP3esOgh5URTmD/SLTXfAmHC+Vl1LDrIIN6oUP5SUSshAYdd2u+I8l7M8g8dZZeTZJd/h8Em7fVSX7tvDYK9FcyNIfBU1dQld8soWg2y8bxM035OaNbmZlnYjMP9xAzp88xSzKTefYJvrbjlKLAlfSbIfVHBd4yyL81mZtimKuLPgApXLBOPnDRFgqsr7uFrRAkCy3JzHvu9yQ3Vmsh7gPpevo7CVahYvb6iDzYF1foKylvVtNpR379vw9ziJsD7ao9bgjydw0RQl5Z6ZhI1eMZayzds881QTqW5wMNx2lyrvu9X47gMJOWP0sr/Aw4ilsoyXmU91gjmXvCHoREfrfR3WRW++37YAHquxjawzlkk4fPtvC5o132RN9XEANYhnUkc0XYPWbKelSvyWb4BE44Fi2ku7jjL/q1YfAe4T/1iOyNV57oGkVcc4/Lwklvpqj1vZC1tDoyyp1FvcuMXZjfd7vneLeuJsBYzwktKipfGgijJqFKuuR66Idx9LYWjnwkODztnw0mNJl96hQuNwscjWe55RZ2jPOPHeYopAGV57bnNBnvgHFa5UrF8l2sVVVY91gqv1uyR5spz4hSQHcxnZup6y7WeEj6NmLRDuriFySfVMqeSbD3aqruuNq101t/dnYTVCsMzeqffYuw37szFZMrN1JtWcx3bmvsYYDT7J1f/ZB/LPXTnnv9zIymb0RvaPinwGjMzL3Im1YdexvQVdUoF/Igq6m8zdY0NnOSCTxLI0mWHovCN/MEjabwfI9150gtd5yvcm3TZeDr73XuyOqpxEKoEbO0/Agu4Db3LxduKaZ9Gp3m292yXhn2p7at7beAawZKKjs30k4YapGMS+7tzV+/cBMl37AiVR7I7wXmHeboe3XmKtvOFZZUF6/wPxfKVqpfjz8INTtwYW2o+Xx4NvB8Zp7yXv9/vLNd736UwUJhSHHQrz509enZ2e355PJK0/DCKMQ874WbMJor8ud5Rac/8Lu4RLxYUSmqjNDwYIHLMXQ+O0JZx9XQ33/Kwsetria3ZgAfU2w8JNmEPZDUq3PitVHJoy4lkLvGBYiTZ3GsdB+tHw9OB6crB/L9aP2MiYZ1GynRF2DNI2L55vOY+OfZ5Xp80Zv/+1tzMaioBVMdCdBK7QfLU8G3w+Oq591GMWGThdgvzG3uipJyRXwUzSPn6EzjGTKcX+OJBZv7uw9wG+kKteqt8YfJUd941X3nQmrNW6cNO9/vb86fX/umzQ/cuY5YF9XGXkOiIbSabuZMR3Q6jnMe5uun1I1Gue9o7i6xmbi7VBWtzWEz6dSP+UYOX/H1Kf1OZ4TITnqNmZNHyt9U1aeexnDDK0uABP9fLHYpBdeRr3oupK+aVgfa9tmmLu3htejgj+92cA28vX65vzq/O3kfnx9dXd7fXl5fnt/dnvxy/mtn9Neq+6BNrm4P786u7m+uLrzA0op+TgaDrcZOT+605uL+w+3l35sbstnqUSh+HMI1wmm528n+bq3sHvbfmaqD9RfHKlfsmWU0Lva9I42QoVEtSrXKsHKvE1uTsfnfnItvvUT0x3y8OZPX/tY77PvKY8qrS3nfI3NSBTbV8XkVe+TzSmDzbO28yLR24HPCNeH2eiQVHuCQdUZ9+HUmkVoTKHkTfQVDlMM+pAEteOS8QdT23zzizgCHc0tbO07Ldyd/yyJBWfinysjv3HY/Unxbq0o1sZhbVRdbcrZY9K/IXi6QUC/ltl7xvhpJidfSPC0LNefWymMc9Iy76x9+nUWY+MRtOSOs2KEZw/A17v8hUhSw1htIlI7yb23NLxiId4i375Vpv2gmk73DlgXiVCKb312/VnmY8d52D19uj6puKOMt7YixoR0T8U3Ytx36m8TtuRZV0fus+usneuqXOR3raWeoJ0MmjOEY0afkilUnXtqjtDC2DAx5ftq53nBDMl2xO0mhVcgYlsePVvaTgv7xjm74hQBWDAhr7BUq6bSRr91WTwNZav4mffLZwqedY7Hq4kVYZkRqCZ1P0Fy8eZPX5sl+P3t+bv7yfX45/ub07ufSjAiMsPBKqgempxwPJEsKT8EwB9xUH0G7gILpdOHU0KHQqvxfqD+z2Po8xkMi8QNfRmJDk1UD3Wox1qSJwUEjYfUHj3ycW9NfNEOhgUvvP1oR/rzh7fn91fXZ+f7SXHobSBKrq+aUhwad1uIr1lVdAnwGM6sCfDU4p759yUxb4dSbLpZmNWdkaRPVfooq5ODLEmE55X9xuYnQAmakoj4DjZBoZ4k49Ob+/c/X12fqQmi/pj8Ork/PXt/caUe5H/8VvpcH39341CfiwBFyB7vUaZhbyHiBvWlwyVhIQSoBeji7FXvMwhHFoS5Wxqh0olPMP82D5F30WUehviBlnyDf89YuqHxnKo47fCMCKQPtU2lskBav4DNdh+BPkTHrnpqEDgO1H1xQh+ck51L0tfnF+prUb1k356/v/7QNOS9WqHC/jVuiJWbtub7EC/9yFko+rphx5i+s6A1blKMQxyCYOaMCkRX5nsBAss0UcJAQnM0du4SAuK4BinhLEFze5BV8ODOGVf+JcQoWBBaO0XWUGq/01q397Z4jF/P23miPEkUxmrJ2NJ1obwm13Zd7sI7Gor0+oXBiX9ozF7KM00846uDDSxsK54GH+jewlsN26i45uZ0mYNO0rsNHX5fbDuamoR6I7o2GpQGUWpF6JOrIrYfSYRrW8PLseXqpvDJxZm5XbJhF3iFvdlK+xDuGIRYYh4TigFJ4CnV55KZfcUxC/PjuCAVWBwpLuvD0ewpakKD8cS/hzhZ4BhzFPUAU8lXatJbdaHmccLCCzpj1/S9WWdaf8nEr9zZ5PZxjR1ZUDPblO7peoxWSNHU/PXakGjMUFAOmJSAbh/4bMKVxTUPTV7ShN0yrBDw0B46FDIs6FfSnA39fLvbzNNDd/E9CiEQxKoDLRfbJN423jRTpiDjTsJYJBqoyMXfR4EDoSFskSbNCDLPqiRlu+a3J2r9FssSU4aPnBTpsHM4g78tLdtlkn2bdVrZZtE8kXEWyjrWWULwR3t9SaFOE/4DCvV4z5Bzb+LUjvPwLV3+tPv6/qdtzX++fXbPvKtt1xLYPM32uIMwk8Od1Go0GfWNUlL6i0r2KXM6OqWeLIQu6aW822c4idhKMa9jdqnFNSonS1pyIFlfO2SFytut1uaIUJIU4HehYrP0TxV+t35umLmp5mIKcNalV3a676ExRfL6KSmSjpsTPuEmnV4x5vopKiZ3u0Hg9T4H9WWYCmBLE74W/FdvR8eDNfH+U309xabb3NYF7jN9/eZPX7//9f7m+iyPxPraJ4zLN98eHx/X3y07Df/6gPnTismrndjv9iMTa3h+Ccs/UwNSQ5VZgxvG5QjUeNUP9pcsYNEI7sY3lXeG0B7HQvZRQnpPK9Qu7m439mv98S4tzoS/KqRofgsc6Xv7mVSZIhGfY1nglecoRuP3VCtvqqRXqa3Fnry+0BDTOaHlZu0OREO7rUtoDP+KULviNy7zRHIk8Xw1OsgDl7csUhc8fNAN9HNefOJQx+jjB4qWiEQqg2PcuZjQW3XO/QSro52Vj3e8pUdW7lJ3pm7omTVVvagV0KTEfPVTvrIM8WAxAhSH2SXbh/YOi3MULPK5bCIjC7TEEON4GCQpRERf/mluzAyBUGA8xDy/X+gQpjhi5gradyniiEqMQ/i/2cTd/LbANMAQIAoULzGHOZagkhf2QuAAicJdVYeQKLuScgwpjbAQ5soS/DFQUOWCCWxpGth/dVg4u5zU7q3IoLnbGQcdvNja8NTtqG2xhS1t28SqzMnFze5zzDoINVBx75uqVYd/9PpcLUizPmW/1Gtrti2DWG+rTBZri3xiKBZx+2fDaovqMK+xkB5pqFx67X6MHI4qsIIkHUHvpHrnQIxjxpUL+d3x8XtSfbtI5zhBcyz6r98T9fm7ShMn6PvHVmPXnCfB+qmhm20xPw7h0SRrCgIFUxygVGD1yibcSYRNKaGZ9+pl/EBZuANpbd1m/gkLsZ5dQeSobz9M7u7fno5/vrttrJuoCp379mIynlycnr1v+EqXCGZC47KTvW6rD+MPvhnaNafRWPWmGXjXvkHX+YuZtNE2i5Zs+H0LlnwplI3UqzVrlz0qRuUn9DljsuVb1WbHqnHDMpNytWD+sSti3ahG5Ak1Gtto9OPjuEXNvt9MYW8CrMtC7OT45PW3G6/EagNajP7stmT6my4hoWKEYd0kb657bqwAyWa/pxh311XRh6YSiQhXuJS50DPGVazC3NIncSDzS3dcdlvAnFG8caV1tdCasKynirPZLHeF1FDm8W+7Lytrmu9PreL9gmbwmkqufVVx+exBK4KNoFfc8DwkU2ZHSNJ4BO81W0qv1Flpl2qomrxP56J2RvJTOsc3yqc96GjcSvv9N5DP/dXA7bH2zaf5tip98xvqTWhq5cD/PwBXh2RfZ28BAA==",
+	"openebs-operator-1.5.0.yaml":     "H4sIAAAAAAAC/+xaW2/bOPZ/z6cg/PB/GFR2MtN2BgL+CyiO2nrWsQ3b6Wx3sTBo6djhhCI5JOXGg/3wC+pmWdbNl6Rd7OahlXn5nR8PeQ7PIYkF+QxSEc5stLm5eiLMt9EM5IZ44HgeD5m+CkBjH2tsXyHEcAA24gIYLJUV4C22uACJNZdJrRLY2zW5siwrQe3TUGmQU07hKi9WLrHXxaF+5JL8iTXhrPv0i+oS3tvcLEHjm9YEZEhB2VcWwoJ8lDwUykb/6PzQ+ecVQhIUD6UHURHjPqjOGxR/9ITkz9uo1QbkMuvUAicdbwSmYrVF34L72f89eAbP/PBBUL4NgGlV+NlbEYYp+RNkVCNBUOJFuvA405JTul+jIIaATQoGzBecJD88zlZkHWCR8PJk0v53vowbSM6i7xMGrTTWsAppysHHEHAW/ToBLf3xR8g1jvAoCYiWmK2hAEiJ0qbBV6y9x1bghK0lKBVPSbS+ONOYCu7jUHPl4VStG5CaeGU1gvs+UTIUZjKWob9ORu2ZHiszSaDImkWS/ghB6bNJK80lXoNHccpcGFNRGpjecBoGpooEpTXNU5C0Y1ioR6678KxBMkytRGpieoes9vvFStsrMgZaEL8GHU9oXgXR6gOswXyFwk++RFrpAwUNh8SxIIYsM05DVdL0QqV5kBb5sCKMmJnbZ4aK1MoYJUQybi3mDnV8op4i5Swp9558yBxC7ncyfYWZQq3wPTNPgnPqxd40NvB47uLidGkcFBZ8zAFSoT7X/1SuWGkIBDUmEvmukGls1HPeyPfZlw4rXpmprywUF39fZDoIUxozD/bJZaV5kqcLWmLvKRQ7ERLMvzmZHg+EWbR+2rJRlse59AnLb7uHRkUBKyi37cymU0vaWc3OkhLrOjRpPyDK2LOENVFa1rPYYEp8rAlbf4XlI+dP8S4Xxt0iJQShrmlQxn9n+HUD2DkAxtk0IfUwHUZIvQC0JF4JfquZDcVaYh8qLaMsfrolzCds/UJhlAqXv4Ono0iqNCA8IQyUnMIUVkb0YTTYhJfqsGaQkZ7y6sBCqF4W0d5loVaJFiJxWBATv0EFf4QoXgJVpldlP5Q2N1o3tsgZMG21ab1Jed9033Wvr5QAz4hSQMHTXMZiA7MMhzkeNUyO45K6ShvdGKnGFGG9jYXorQAbTSE2lajIBKSErR8iA7ERCym9Qih19gnZnJ4R2tdfA/Nj9VivS4QQSvUZfe8t5lHtyjN/JgLHhIHMyFv15EmA1zAJKZ1wSrytjQarEdcTCQoi48m1stEfId4a0gmDXrADtHf8zZ/gUuf0Z+2ITbjUNnr3/t37rBbYJt805jueuCP3drYYOffubOL03awFQhtMQ/ggeWDnChFaEaB+YrgH5ROsH+1soruZ2VQKnrnTz4O+u3D6/fHDaH4R8WZqu4dzWsnh3vniLCbju0gLl1dApdzBeNGfus7cXdy5H5yH4Xwxm4+nzkd30R+PPgw+FqnYqKNlCJ06wMFoNneGwwyxbyAXs4kznbmLyXg8LAFdYarqUWOUuTP96M4Xd4NpCUhvg2W2ZJXAshWkYZSyuwzur4PPTgzbjFcLNBz3neHk8+LTeDafOPNPbfhR7mHaTK8/Hs2n4+HQnS4G985HtwS26AR+JxscW38z/NSdDAd950WxS63VRp2f2q+jtvSiONYiSq91C5a5dXWcABOdH4d///F+foIQK1gH7UfyeTx8uHdPkRXnMm2lpXLGo4ER21ZUYMGz2YZAtpCR0537t8l4OndfSNAndzg5ApsSFj5boSZUtQB3R87t0F04I2f4ZT7oz85w0+50Mf8yKaOYhh/ANEghiYIsCNkBU7IBBkpNJF9Cfjsyx4v53wh5PAgw8/cLLdQLlYy9Vm9JWM+EL56mhUZJ9JQrjY5QML0Dircz8DjzlY1+us61ECAJ97O697s6Cdgn/zGsi0lE8US8MXmwkkCkTRLROsa11MbLMoIsCkzXGRbkKg0Pc0GgkFxzj1MbzfuTqERjuQa9FyvupxcVQa2CKEd3VtFZ2tZGI87gnHwrXe1C8g0xvY9Juso712qzRZfz0q8qASew+maJWPUYTtLtt0rJ6viclZelwE+/7IEXcrTStGs0vnMvl21E+Y65t6rNcl4txTt/VxJrCaJQ1un+cBC1X8aln+Cn0huOhiOuOm9VBtHKrho7XsZzlYs5meFRXuwcb1XF+wzdvrbn2tluxmd355tf/OV+qaRTwSe1dXwF79XOp1R6lWq/0taz1PiWMu9S4V/KPUziY3Y66+xVNzuaupj3YD7LNqPmCa3eZf43o5UzmlPaJaa0MiHoR1dL91jU7BvMD6z4CurMdCAHlEniPljmstkKMMNrkN24gY3+lYb/S4iLcjHSE2xtFPqwsaL6glM1FWi/InrrYSOT4e6DKMDeIyhdCpRWloNFB5AFtADLCihT00BqRagGWT5YrmItwbNHQx+suG1BCFfINEJJI1RodKgElDY1R4JveqC93iNXWr3pLTnXnX0KG2A+l+WS47o2AglLBXZKSPSH44e72y/zN2MBzL2dFSgIrB/LCZia88VTzsWbng+b3sq/jj+UTD4kDuIPP7Dij8DvNIVl0ZOiGeh66zo+Dos7NRnby8RYKXAL6fHN86wsbsonfGcGTztCrRTysoGRsZ8R6K9cPu0tvepU78AFNqVwBx0sHPjv39qb6+7b7rvqNNGhX/FW7cwDvFASve1zpuFZ5zcnIcmGUFiDXzCf+HT23qiiJPDLNon0LzAN4+00ciy9Knef66PCZdyjRVtzKjdmdFtgaeU2ggo2MmS9veq0j5DcC5LXAZXDMI3a04jvf4yHqsA8vCxqSMRf+97zNTP/5F7tw2B41uVaHmY2+HvZafXN9c8//fz25pcf317X96+6NrruvPzxAfODzkXOsJNHY0XvU7ApL40H8/RqwsFKczN2Ek13nlqF7aWbwh2R0Ua0vWqyyRr0gm3WI5eYZg10iZ1e4FjG6PP0E5lC78b4+2XPXw4kHMPn1U5bSlgep7dvd7ay2xQPCLWIF9I+5QFDc8hQdw92VOaLkBWF5yXFPR2IXkZU+U+WkbltToLf1uXAN/sZ8goTGkqYP0pQj5z68ZKrOG3Yaf83Z97/VHHaUHPeUHfi0P7MYUej5NXPC8gvFV398ukyDJpeQRVOf6aOuZuv0oWNOodrv1OK1R+6zuhhsvh1fHtwAY+Ou4S/wJaQPSi22r/lxELYaNcxeTrctCU0dqjYFy5yv9e4uVSO6TjvX6Oak9Tz7dx/NZ9K719cSyefwWK5VkW3aGGqOOVrzZX2Qcpi9eb/fywU/fiX/2vlauuPdl/R2Tp394PZbDAeLX5zbz+Nx3+tczdVBnwRrxC9HRGb894blIO0umdq0fUyUWSVoDNYvlpsWc39LB2j7+cdgmUypL2UqSlsrPBMecxk3P/1zxC+x5fm39XrwRd9N/lipzjJ8r7EI5B/DwCJqpfh6j4AAA==",
+	"openebs-operator-1.6.0.yaml":     "H4sIAAAAAAAC/+xaW2/bOPZ/z6cg/PB/GFR20ul0BgL+CyiO2nrWsQ3b6Wx3sTBo6djhhCI5JOXGg/3wC+pmWdbNl6Rd7OahlXn5nR8PeQ7PIYkF+QxSEc5stLm5eiLMt9EM5IZ44HgeD5m+CkBjH2tsXyHEcAA24gIYLJUV4C22uACJNZdJrRLY2zW5siwrQe3TUGmQU07hKi9WLrHXxaF+5JL8iTXhrPv0i+oS3tvcLEHjm9YEZEhB2VcWwoJ8lDwUykb/6PzQ+ecVQhIUD6UHURHjPqjOGxR/9ITkz9uo1QbkMuvUAicdbwSmYrVF34L72f89eAbP/PBBUL4NgGlV+NlbEYYp+RNkVCNBUOJFuvA405JTul+jIIaATQoGzBecJD88zlZkHWCR8PJk0v53vowbSM6i7xMGrTTWsAppysHHEHAW/ToBLf3xR8g1jvAoCYiWmK2hAEiJ0qbBV6y9x1bghK0lKBVPSbS+ONOYCu7jUHPl4VStG5CaeGU1gvs+UTIUZjKWob9ORu2ZHiszSaDImkWS/ghB6bNJK80lXoNHccpcGFNRGpjecBoGpooEpTXNU5C0Y1ioR6678KxBMkytRGpieoes9vvFStsrMgZaEL8GHU9oXgXR6gOswXyFwk++RFrpAwUNh8SxIIYsM05DVdL0QqV5kBb5sCKMmJnbZ4aK1MoYJUQybi3mDnV8op4i5Swp9558yBxC7ncyfYWZQq3wPTNPgnPqxd40NvB47uLidGkcFBZ8zAFSoT7X/1SuWGkIBDUmEvmukGls1HPeyPfZlw4rXpmprywUF3/vVJYrFJwSj8C5XAlTGjMP9jlnpXnupwtaYu8pFDsREsy/OZkeD4RZy37aslGWx7n0Ccvvxoe2RgErKDf5zNRTA9sZ087AEqM7tHQ/IMqYuYQ1UVrWs9hgSnysCVt/heUj50/x5hfG3SIlBKGuaVDGf+cP6gaw8wuMs2lC6mE6jJB6AWhJvBL8VjMbirXEPlQaTFlYdUuYT9j6haIrFS5/B09HAVZpnHhCdCg5hSmsjOjDILEJL9VhzSAjPeXVgYVQvSzQvcsisBItROKwICasgwr+CFG8BKpMr8p+KG1utG5skTNg2mrTepPyvum+715fKQGeEaWAgqe5jMUGZhkOczxqmBzHJfWgNroxUo0pwnobC9FbATaaQmwqUZGJUwlbP0QGYiMWUnqFULoHJGRzekZoX38NzI/VY70uEUIo1Wf0vbeYR7Urz/yZwBwTBjIjb9WTJwFewySkdGL2lq2NBqsR1xMJCiLjybWy0R8h3hrSCYNesAO0d/zNn+BS5/Rn7YhNuNQ2+un9T++zWmCbfNOY73jijtzb2WLk3LuzidN3sxYIbTAN4YPkgZ0rRGhFgPqJ4R6UT7B+tLOJ7mZmUyl45k4/D/ruwun3xw+j+UXEm6ntHs5pJYd754uzmIzvIi1cXgGVcgfjRX/qOnN3ced+cB6G88VsPp46H91Ffzz6MPhYpGKjjpYhdOoAB6PZ3BkOM8S+gVzMJs505i4m4/GwBHSFqapHjVHmzvSjO1/cDaYlIL0NltmSVQLLVpCGUcruMri/Dj47MWwzXi3QcNx3hpPPi0/j2XzizD+14Ue5h2kzvf54NJ+Oh0N3uhjcOx/dEtiiE/idbHBk/W+b4afuZDjoOy+KXWqtNur82H4dtaUXxbEWUXqtY//Xdl0dJ8BE58fh33+8n58gxArWQfuRfB4PH+7dU2TF2Uxbaamc8WhgxLYVFVjwbLYhkC1k5HTn/m0yns7dFxL0yR1OjsCmhIXPVqgJVS3A3ZFzO3QXzsgZfpkP+rMz3LQ7Xcy/TMoopuEHMA1SSKIgC0J2wJRsgIFSE8mXkN+OzKlj/jdCHg8CzPz9Qgv1QiVjr9VbEtYz4YunaaFREj3lSqOTFUzvgOLtDDzOfGWjH69zLQRIwv2s7v2uTgL2yX8M62ISUTwob0werCQQaZNEtI5xLbXxsowgiwLTdYYFuUrDw1wQKCTX3OPURvP+JCrRWK5B78WK++lFRVCrIMrRnVV0xLa10YgzOCffSle7kHxDTO9jkq7yzrXabNHlvPSrSsAJrL5ZIlY9hpN0+61Ssjo+Z+VlKfDTL3vghRytNO0aje/cy2UbUb5jrrNqs5xXS/HO35XEWoIolHW6PxxE7Zdx6Sf4qfTio+GIq85blUG0sqvGjpfxXOViTmZ4lBc7x1tV8T5Dt6/tuXa2m/HZXQXnF3+5XyrpVPBJbR1fwXu18ymVXqXar7T1LDW+pcy7VPiXcg+T+Jidzjp71c2Opi7mPZjPss2oeUKrd5n/zWjljOaUdokprUwI+tHV0j0WNfsG8wMrvoI6Mx3IAWWSuA+WuYO2AszwGmQ3bmCjf6Xh/xLiolyM9ARbG4U+bKyovuBUTQXar4iegNjIZLj7IAqw9whKlwKlleVg0QFkAS3AsgLK1DSQWhGqQZYPlqtYS/Ds0dAHK25bEMIVMo1Q0ggVGh0qAaVNzZHgmx5or/fIlVZvekvOdWefwgaYz2W55LiujUDCUoGdEhL94fjh7vbL/M1YAHNvZwUKAuvHcgKm5nzxlHPxpufDprfyr+MPJZMPiYP4ww+s+CPwO01hWfTSaAa63rqOj8PiTk3G9jIxVgrcQnp88zwri5vyCd+ZwdOOUCuFvGxgZOxnBPorl097S6861TtwgU0p3EEHCwf++3f25rr7rvu+Ok106Fe8VTvzAC+URG/7nGl41vnNSUiyIRTW4BfMJz6dvTeqKAn8sk0i/QtMw3g7jRxLr8rd5/qocBn3aNHWnMqNGd0WWFq5jaCCjQxZb6867SMk94LkdUDlMEyj9jTi+x/joSowDy+LGhLx1773fM3MP7lX+zAYnnW5loeZDf5edlp9c/3zjz+/u/nl7bvr+v5V10bXnZc/PmB+0LnIGXbylqzofQo25aXxYJ5eTThYaW7GTqLpzlOrsL10U7gjMtqItldNNlmDXrDNeuQS06yBLrHTCxzLGH2efiJT6N0Yf7/s+cuBhGP4vNppSwnL4/T27c5WdpviAaEW8ULapzxgaA4Z6u7Bjsp8EbKi8LykuKcD0cuIKv/JMjK3zUnwu7oc+GY/Q15hQkMJ80cJ6pFTP15yFacNO+3/5sz7nypOG2rOG+pOHNqfOexolLz6eQH5paKrXz5dhkHTK6jC6c/UMXfzVbqwUedw7XdKsfpD1xk9TBa/jm8PLuDRcZfwF9gSsgfFVvu3nFgIG+06Jk+Hm7aExg4V+8JF7vcaN5fKMR3n/WtUc5J6vp37r+ZT6f2La+nkM1gs16roFi1MFad8rbnSPkhZrN78/9tC0du//F8rV1t/tPuKzta5ux/MZoPxaPGbe/tpPP5rnbupMuCLeIXo7YjYnPfeoByk1T1Ti66XiSKrBJ3B8tViy2ruZ+kYfT/vECyTIe2lTE1hY4VnymMm4/6vf4bwPb40/65eD77ou8kXO8VJlvclHoH8ewCILGSIAT8AAA==",
+	"openebs-operator-1.7.0.yaml":     "H4sIAAAAAAAC/+xabW/bOPJ/n09B+MX/xaKyk91uuxDwP0Bx1NZ7jm3YTvd6h4NBS2OHG4rkkpQbL+7DH6gny7Ke/JC0h7u8aGWSM/PjkDOcGRIL8hmkIpzZaHNz9USYb6MZyA3xwPE8HjJ9FYDGPtbYvkKI4QBsxAUwWCorwFtscQESay6TXiWwtxtyZVlWwrVPQ6VBTjmFq7xYucReF4f6kUvyJ9aEs+7TL6pLeG9zswSNb1oDkCEFZV9ZCAvyUfJQKBv9o/ND559XCElQPJQeRE2M+6A6b1D80ROSP2+jURuQy4yoBZ90vhEzFast+hbcz/7vwTN45ocPgvJtAEyrws/eijBMyZ8gox4JghIv0oXHmZac0v0eBTEL2KTMgPmCk+SHx9mKrAMsElyeTMb/zpfxAMlZ9H3CpJXGGlYhTTH4GALOol8ncEt//BFyjSN+lARES8zWUGBIidJmwFesvcdWzAlbS1AqXpJof3GmMRXcx6HmysOpWjcgNfHKejzTszKLAYqsWcTxjxCUPhuc0lziNXgUpwiFMQmlgekNp2FgukhQ2tOs6mQcw0I9ct2FZw2SYWolUhMTO0S1TxcrZ6/JGGJB/Bp0vHB5FUS7DLAG8xUKP/kSaacPFDQcAseCGLDMOAdVCdMLleZB2uTDijBizGUfGSpCK0OUAMmwtVg71PGJeoqUs6Tce/IhM/zc72T5CiuFWvH3zDoJzqkXe83YkOO1i5vTrXHQWPAlB5wK/Tn6U7FipSEQ1JhI5KNCprFRz3kz30dfOq14Z6Y+sdBc/L1TWa5RcEo8AudiJUxpzDzYx5y15rGfLmiJvadQ7ERIMP/mZHo8EGYv++nIRlke59InLH/qHtoaBayg3OQzU08NbGdMOwNLjO7Q0v2AKGPmEtZEaVmPYoMp8bEmbP0Vlo+cP8WHXBiTRUoIQl0zoAz/zh/UTWDnFxhn0wTUw3QYceoFoCXxSvi3OQRCsZbYh1J7aUMvuO8TJUNh5rgM/TXoEiSlHnA30+zMKovVbgnzCVu/UMimwuXv4OkoaisNPk8IOSWnMIWVEX0YeTbxSxVeM8lIT3l1YCFUL4ue77KwrkQLkTgsiIkVoQI/QhQvgSpDVUmH0uFG68bwOQOmrTajNynum+777vWVEuAZUQooeJrLWGxg9sQwh6MGyXFYUndtoxsj1dg9rLexEL0VYKMpxLs0ajLBL2Hrh8gabcRCSq8QSg+cBGxOzwjt668B+bF6rNclQgil+oy+9zbzqHbnmT8T7WPCQGbgrXrwJMBrmISUTsxBtrXRYDXieiJBQWQ8uVE2+iPEWwM6QdALdgztHX7zJ7jUOf1ZO2ATLrWNfn7387usF9gmPzTGO564I/d2thg59+5s4vTdbARCG0xD+CB5YOcaEVoRoH5iuAftE6wf7Wyhu5nZVAqeudPPg767cPr98cNofhHxZmm7h2taieHe+eIsJuO7SAuXV0Cl3MF40Z+6ztxd3LkfnIfhfDGbj6fOR3fRH48+DD4Wodioo2UInTqGg9Fs7gyHGce+YbmYTZzpzF1MxuNhCdMVpqqe660zcxd3g2kJcW+DZbpVa3nESObO9KM7b8GrpwSWClqwNLNKZ3gZvr8OPjsx2zPnPBz3neHk8+LTeDafOPNPbfBR7mHaDK8/Hs2n4+HQnS4G985Ht4Rt0ZH8TjY48iA3zeyn7mQ46DsvyrvU4m3U+an9PmoLLwq8LaL0Wsc+tO2+Ok6ASSeO43//8X5+ghArWAftZ/J5PHy4d0+RFadfbaWlcsajgRHbVlRgwbM5ykC2kJHTnfu3yXg6d19I0Cd3ODmCNyUsfLZCTahqwdwdObdDd+GMnOGX+aA/O8PVu9PF/MukDGIawgDTIIUkCrJAZseYkg0wUGoi+RLyR5oph+Z/I+TxIMDM32+0UC9UMvZavSVhPRMCeZoWBiURWK41KgVhegcUb2fgceYrG/10nRshQBLuZ33vdn0SsE/+Y1AXE5FiBb8xAbGSYKZNItI6TrbUxsuyiiySTPcZFuQqDTFzgaSQXHOPUxvN+5OoRWO5Br0Xb+6nKBWBsYKoqOCsoprg1kYjzuCcnC3d7ULyDTHUxyRu5cS12mxBcl4KVyXgBFTfLJmrnsNJuv1WaV0dnrNyu5Tx0y97zAt5XmnqNhrfuZfLWKKcydyz1WZKr5Ymnn8qibUEUWjrdH84iNov49JP8FPpTU1DmazOW5WxaGVXjYSX8VzlYk5GeJQXO8dbVeE+Q7ev7bl2tpvh2d1R5zd/uV8qISr4pLaOr+C92vmUSq9S7VfaepYa31LmXSr8S7mHSXzMTmedve5mR1MX8x6sZ9lh1Lyg1afM/1a0ckVzSrvEklYmBP3oLuwei5pzg/mBFd+ZnZkO5BhlkrgPlrk0twLM8BpkNx5go3+l4f8S4qZcjPQEWxuFPmysqL/gVE0H2u+I3qbYyGS4+0wUYO8RlC5llHaWM4uKmAVuAZYVrExPA6gVoRpk+WS5irUEzx4NfbDisQUhXCEzCCWDUGHQoRJQOtSUBN/0QHu9R660etNbcq47+xA2wHwuyyXHfW0EEpYK7JSA6A/HD3e3X+ZvxgKYezsrQBBYP5YDMD3ni6ecizc9Hza9lX8dfyiZfEgcxB9+YMUfgd9pCsuiJ1Az0PXWdXwcFhM1GdvLxFgp4xbS46vyWVnclE/4zgyedoBaKeRlAyNjPyPQX7l82tt61anegQtsSuEOCCwc+O/e2pvr7tvu++o00aFf8VbtzAO8UBK97XOm4VnnDychyYZQWINfMJ+4OntvVFES+GWHRPoXmIHxcRo5ll6Vu8/RqHAZU7QYa6pyY0a3BZRW7iCoQCND1tvrTmmE5F6QvDConIYZ1B7GEisw/qmCY/4qJr9HU/L4+qglg3hwQx7/2levr1k4SK7lPgyGZ93N5dnMBn8vK3bfXL//6f3bm19+fHtdT19163TdefnqA/ODzkVK4MnbuaLzKpikl4aTeXg10WSltRozi5Y7D63CdNMz5Y7I6BzbXjWZdA33gmnXcz6w7BrGVWZ+IGMs+7vCaK0baCktprxEBcms3enFowJ1Y6rwsqWiAwnH4Hm1wlAJyuP09u3KQLvz+wBQi9AmpSmPbZqjm7oru6OSdISsKJMoae7pQPQyoMp/sozMbXO+/rYuXb/ZT+ZXmNBQwvxRgnrk1I+3XEVhZKf935x5/1NFYaSmNFJXHGlfHtnBKHnk9ALyS0VXP/S6DIKmR1+FQtXUMc8IqnRho87h3u+U8uoPXWf0MFn8Or49eCuAjnsvcIEjIXusbbV/uoqFsNGOMHmW3XQkNBJUnAsXuYpsPFwq53Sc969RzUnq+XbuvxpPpfcv7qWTy8VYrlXRLVqYKk75WnOlfZCy2L35/x8LTT/+5f9audr6KvQrOlvn7n4wmw3Go8Vv7u2n8fivde6myoAv4hWiZy5ic97TiHImra7EWpBeJoqsEnQGyleLLauxn6Vj9P08mbBMhrSXMjWFjRWeKc8zmfd//YuJ7/Fh/Xf10PFFn3i+WMUo2d6XeK/y7wEAnjuhH0VAAAA=",
+	"openebs-operator-1.8.0.yaml":     "H4sIAAAAAAAC/+xa32/bOPJ/z19B+OH7sKjiZLfbLQR8D1ActfWeYxm2073e4WDQ0tjhhiK5JOXGi/vjD9Qvy7J+xXbSHu7y0MokZ+bDIWc4MyQW5DNIRTiz0eb64pGwwEYzkBvig+P7PGL6IgSNA6yxfYEQwyHYiAtgsFRWiLfY4gIk1lymvUpgfzfkwrKslOuARkqDnHIKF0Wxcon9SxzpBy7Jn1gTzi4f36tLwvub6yVofN0ZgIwoKPvCQliQj5JHQtnoH70fev+8QEiC4pH0IW5iPADVe4OSj76Q/Gkbj9qAXOZEHfhk842ZqURt8bfgQf5/H57ANz8CEJRvQ2BalX72V4RhSv4EGfdIEJT4sS58zrTklO73KEhYwCZjBiwQnKQ/fM5WZB1ikeLyZTr+d75MBkjO4u8jJq001rCKaIYhwBByFv86glv244+IaxzzoyQkWmK2hhJDSpQ2A75i7T90Yk7YWoJSyZLE+4szjangAY40Vz7O1LoBqYlf1eObnpVZDFBkzWKOf0Sg9MnglOYSr8GnOEMojEkoDUxvOI1C00XCyp52VafjGBbqgetLeNIgGaZWKjU1sUNU+3SJcvaajCGWxK9BJwtXVEG8ywBrMF+RCNIvkXUGQEHDIXAsiAHLjHNQtTD9SGkeZk0BrAgjxlz2kaEytCpEKZAcW4e1Q72AqMdYOUvK/ccAcsMv/E6Xr7RSqBN/36yT4Jz6iddMDDlZu6Q52xoHjSVfcsCp1F+gPxYrVhpCQY2JxD4qYhob9Zw28330ldNKdmbmE0vN5d87lRUaBafEJ3AqVsKUxsyHfcx5axH78YKW2H+MxE6EBPNvQabPQ2H2cpCNbJXlcy4Dwoqn7qGtUcAKqk0+N/XMwHbGtDOw1OgOLT0IiTJmLmFNlJbNKDaYkgBrwtZfYfnA+WNyyEUJWayEMNINA6rw7/xB0wR2foFxNk1B3U9HMad+CFoSv4J/l0MgEmuJA6i0ly70ggcBUTISZo7LKFiDrkBS6QF3M83PrKpY7YawgLD1C4VsKlr+Dr6Oo7bK4POIkFNyClNYGdGHkWcbv0zhDZOM9VRUBxZC9fPo+TYP6yq0EIvDgphYEWrwI0TxEqgyVLV0KBtutG4MnzNg2uoyepPhvr58f3l1oQT4RpQCCr7mMhEbmj0xKuBoQPI8LJm7ttG1kWrsHtbbRIjeCrDRFJJdGjeZ4Jew9X1sjTZiEaUXCGUHTgq2oGeE9vXXgvy5emzWJUIIZfqMv/c287hx55k/E+1jwkDm4K1m8CTEa5hElE7MQba10XA15noiQUFsPIVRNvojwlsDOkXQD3cM7R1+8ye41AX9WTtgEy61jX5+9/O7vBfYpjg0wetN3LF7M1uMnTt3NnEGbj4CoQ2mEXyQPLQLjQitCNAgNdyD9gnWD3a+0Je52dQKnrnTz8OBu3AGA+9+PD+LeLO0l4drWovhzvniLCbebayF8yugVu7QWwymrjN3F7fuB+d+NF/M5t7U+eguBt74w/BjGYqNelpG0GtiOBzP5s5olHMcGJaL2cSZztzFxPNGFUxXmKpmrjfOzF3cDqcVxP0NltlWbeSRIJk704/uvAOvvhJYKujA0swqm+F5+P46/OwkbE+c88gbOKPJ58UnbzafOPNPXfBR7mPaDm/gjedTbzRyp4vhnfPRrWBbdiS/kw1OPEg7+6k7GQ0HzovyrrR4G/V+6r6PusKLA2+LKL3WHVAW9tXzBJh04nn87z7ezY8QYoXrsPtMPnuj+zv3GFlJ+tVVWibHGw+N2K6iQguezFEGsoOMgu7cv0286dx9IUHu2LkZuQtn7Iy+zIeD2Qne2J0u5l8mVQizKAOYBikkUZDHGjvGlGyAgVITyZdQPHVMxbL4GyGfhyFmwX6jhfqRkolj6S8J65soxde0NCgNkgqtcbUG01ugeDsDn7NA2einq8IIAZLwIO97t+uTgAPyH4O6nCuUi+ytOYKVxhtdcoXOoaylNn4e+OfBXrbPsCAXWRRYiPWE5Jr7nNpoPpjELRrLNei9kHA/i6iJXRXEeb+zist2WxuNOYNT0qpstwvJN8RQPye3qiZu1GYHktOyrDoBR6D6ZvlW/RyO0u23yrya8JyUfmWMH9/vMS+lYpXZ1di7dc+XVMRpjbkKa0xmXi2TO/1UEmsJotTWu/zhILA+j0s/wk9llyktlawmb1XFopNdtRKex3NVizka4bO82Cneqg73Cbp9bc+1s90cz+4aubj5q/1SBVHJJ3V1fCXv1c2n1HqVer/S1bM0+JYq71LjX6o9TOpjdjrr7XW3O5qmmPdgPasOo/YFrT9l/reitStaUNo5lrQ2IRjE11V3WDScGywIreRa68R0oMAol8QDsMy9thVihtcgL5MBNvpXFv4vIWkqxEiPsLVRFMDGivtLTtV0oP2O+PmIjUyGu89EAfYfQOlKRllnNbO4zljiFmJZw8r0tIBaEapBVk+Wq0RL8OTTKAArGVsSwhUyg1A6CJUGHSoBZUNN1e5NH7Tff+BKqzf9Jee6tw9hAyzgslpy0tdFIGGZwF4FiMHIu7+9+TJ/4wlg7s2sBEFg/VANwPScLp5yLt70A9j0V8FV8qFk+iFxmHwEoZV8hEGvLSyLXynNQDdb1/PjsISozdheJsbKGHeQntxmz6ripmLCd2LwtAPUSSEvGxgZ+xmD/srl497Wq0/1DlxgWwp3QGDhMHj31t5cXb69fF+fJjr0K96qnXmAH0mitwPONDzp4uEkJNkQCmsISuaTFFDvjCoqAr/8kMj+QjMwOU5jx9Kvc/cFGhUtE4oOY01VzmN0W0JpFQ6CGjQyYv297oxGSO6H6SOA2mmYQd1hLLEC459qOBZvS4p7NCNPbng6MkgGt+Txr307+pqFg/Tm7MNwdNL1WZHNbPj3qmL39dUvP/3y9vr9j2+vmunrLoauei9ffWBB2DtLCTx93lZ2XiWT9LNwsgivIZqstVZjZvFyF6HVmG52ptwSGZ9j24s2k27gXjLtZs4Hlt3AuM7MD2R4crArjDa6gY7SEspzVJDM2h1fPCpRt6YKL1sqOpDwHDyvVhiqQPk8vX27MtDu/D4A1CG0yWiqY5v26Kbpyu5ZSTpCVpxJVDT3dSj6OVAVPFpG5rY9X3/blK5f7yfzK0xoJGH+IEE9cBokW66mMLLT/m/OfPCppjDSUBppKo50L4/sYFS8Q3oB+ZWi699inQdB27usUqFq6pib/jpd2Kh3uPd7lbwGI9cZ308Wv3o3B08FUMNzAUpY9GRFmlCVvRc4w5GQv6e2ur8uxULYaEeYvpxuOxJaCWrOhbNcRbYeLrVzep73b1DNUer5du6/Hk+t9y/vpaPLxViuVdktWpgqTvlac6UDkLLcvfn/H0tNP/7l/zq52uYq9Cs6W+f2bjibDb3x4jf35pPn/bXJ3dQZ8Fm8QvzMRWxOexpRzaTTlVgH0vNEkXWCTkD5arFlPfaTdIy+nycTlsmQ9lKmtrCxxjMVeabz/q9/MfE9vn3/rh46VjD+5I4mz3jhWRGyvXjFKN3e53iv8u8BANfGWRroPwAA",
+	"openebs-operator-1.9.0-ee.yaml":  "H4sIAAAAAAAC/+y9e3MbN7Io/r8+RRe95zjeI5KW89gsq/yrkinG0UaWVKLs/eWmcrXgTJPEaghMAAxl5tzz3W/hMW/McEg9nNySKhVLM5hGo9HoN4AXMBZIFMIHsiEwRbGmAcJxEPCEqQMS008oJOVsBOujg1vKwlHaKG2zQkVCosjoAICRFY6Ax8hwJvsrsiF9HqMgigv3VsYkyJsc9Pv9gxdwgnPKEK54hKCWRAGJIn4nwX5LOZPAGfz0vYSYh3IYYhzxzQqZkg6lcZRIhUIDKOEsZiQYkEQtuaC/G0iD2+/lgPLh+miGihx1xl4kEcrRQR9ITN8LnsRyBL/0/tr79QBAoOSJCNA8YjxE2TsE+8swFvzzxrRao5hlH3WAkxLLAJOW5uZ3TYP03yF+xkD/UaBJ5c/hnDIS0d9RmDcC44gGhhYBZ0rwKCq/kWhB4DoFhiyMOXV/BJzN6WJFYodXIFz7f/OZbSA4M7/vMWipiMJ5EqU4hARXnJm/9oCW/vFbwhUx8CK6okoQtsAKwIhKpRvcERUsOwGnbCFQSjslhr84UySKeUgSxWVAUrKuUSga+N4E+s1cTwZKumAG4m8JSnVv5KTigiwwiEiKYayXhFTI1JpHyUq/oivvm+2kdu0YieWSqwF+VigYifquV7fE6liVv7PEKT3SC7HS/QKVnbgiCQyXaamlf0vi0P0Wpy9DjFBhHXESU40s08JBNqIZJFLxVfoo1LKJGiFUwgyqqPkwcohkuHWYO+iFVN4a4swiHtyGmC38wt9u+iozBZ3gB3qeYs6jwEpNu5Dt3NnHKWvUHlZkSQ1S5X3h+31xJVLhKo70EjEyKmGKaPLcb+Rl7L3DspyZysTK4+rfOckKD2Me0YDifXGlTCrCAizjnD0t4r5/RzMS3CZx3oVA/f9CnwFfxZqXw7Tl1r4CzkVIWVHr1tdahESif8lnSz1dYPliyheYW3T1lR6uqNTLXOCCSiXasViTiIZEUba4w9mS81ur5BL7mSHCKlEtDXz45/KgbQC5XGCcXTmkPl6dGUjDFSpBAw/8LkogiReChOhdL12+j3kYUimSWI9xloQLVB5MvBIwH2mms4yh946yENQSq4Ym3FG1NC+MDXgp6JpGuEA5OHgB1xcnFyMYLzG4BTqHEOckiRQQ9ymJJAeGGEpQHGaowQisG4a6b8oWj2QfymT2bwyUMRG9ZvIexrHgEV7hXHddN3O3wUtnt2WQZlKK5CBxLIeZnX+S2ZAeKpjuSEy1YYoN+ANEZIaR1F81fgdpc011LWU4Q6b6XVqvU7yPBn8fvO4jHsgYA92bxAgDxYXteaV58KyASgsyu6GTqocRHOletZzBxcZ2ojYxjuAK7aowj7SxTdnio1n9I2BJFB0ApArOIVsgNUCZhFsw35WUW8kJAJCS1PxeYunzVv7TP9rBIJShyPDvt+NPV2SBl0kUXWrduRnB6fycq0uBEs0SKrSyMDSlKB+uclijEvb6J+ZCFQjYz9G65EKN4Nvvvv0ue4tsXWxqsb24nJxP3k1vzo8/TKaXx+NJ1gJgTaIEfxB8NSo8BJhTjEK3eGvPL4lajrKZHmRLp7Hj6eTq0+l4cnM8Hl98PL9+kO71xA7qM9qIw4fjn49vLi9ODBUengCN/Z5e3IyvJsfXk5uTyQ/HH8+ub6bXF1fH7yc344vzH07fV1EZQU+JBHttAE/Pp9fHZ2cZxLEGeTO9PL6aTm4uLy7OPEDnJJLtUP9x+ulYI3V9dXF2Nrm6Of1w/H7igVRg3H/TNck4djvwq8nl2en4+BEhe/lrBL2vW0FY+l0fX72fXHdBzpiUfSrVQnXD0XagZ6Y7eG0m7wz9w/sP17t10V8tVjuN4tPF2ccPkx17si7FDn2lvVycn+pOO3S06uNnLStRdOuhQLXJ/395cXU9eZRufpycXXaDHFGWfO4nikayG+jJ+fG7s8nN8fnx2c/Xp+PpPUTJ5Orm+udLH4KpgkSmUMSCSszUZA44omtkKOWl4DMsikwd3yv+DRDw1YqwsPywD8NEimHEAxINZ5QNNVkCFVUaORVfeGpiGyQ6wYhsphhwFsoRfP260CJGQXmYvfsufyeQhPRPg3XV2K3Gs7cauX2nLLsYu50Nsb5cB5nZmlkqKZ+RmB6kJkzBUIkFVzzg0Qiux5fmiSJigapkz5Rt4AazS6Lxko/nJsi1GcE5Z3gfvyDl9ljwNdVf7+Ic+D9upWaHT+7tJjT1sQdiX8xhaB7DXuT9gq5DG0r7+g8pzNvvS3DrvoTXPTi/OJk8nFVs7HKdPGq1xp/MFbm/ZpLLyoN+UHmgUCr4V7wQGEM/gN7/rszx4K+9f8Fbs2QeUgPsIdbSTMWWyE2bcPOB6LQGt374YILO39PeSO4k9O4j3Jrwvgd5v4Cgyxd7hlKeqS0ugZoY87Svi7CuUrIi7LqJoEYh1CyGugqiFlHkE0YN4sgjkDwiySuUKtT1CKUuYqnNoK7Nu0/HtU58q/J6nvn7zrwl7wPOvE2LXC+pBCpNAkRr/r5OAfdXhJEFChCo5WEINuekMyKnCgLCYIaQSAxBcbA5a/q7yX6A/lxCrIklgbAQ5jRSKKTf/xkbuB9I3KL3WLjq2/7v6f0UAGU9VUc8sA1G8H9Sb2eG9lHBGLzFzQiSENd9876iEfQLKL8wtSUj0A59GYhEEixRKi+g9KUfmIkJVqCtiGgApd9sQcpOlH+wXFoq4ecgSkLs27aVTrg0sw+uEVQa1YkAadMR9IaHQ1TBcMmlkofDGeeqV0ZhjSzkwt+zfdelQ8rSDnseJMZnFx9P3v18fXgRI5u8m1ZQiIla+hHQb+7ffcR5fDgMcT2ch6/tL1K4XwRZ2V/CVd/+sgp728xKU8I0RdW+una3I+1H2xbbo9mIKewOCNhs99Rn9xX923safzlCnWjy6IadXkXnqO64uC0xYLNnWxOELR5rrW2frMLvvhmtXw++Gfy9qPVrOv84uiMbmb2XGCSCqs2YM4WfVVFpxmkePqwsIRuN/qAJ4TFbM0WR/qx0Q6vmjXAZNon8wjcymdkvOrTVgcgLFm0qWPYLyqABG5GwYel1+k0seLByifvGYehG3dGYEYlaRjVAXBORxiGGRSZNP5cxEZ0B2MZbwhZPnc18yjiJy+X9cHo2uTk5vfLE5T306rWCmZ7+L198/+j1377+2zdH37/55nX7902ptdcPmQYwVmvlWY+Fq96DxPztuq8JrsqCDFKDsohciz3ZuFb1IjOTXUStYeGmKuWECqPGNgfbFnQL9MrCbodcW9ctgJsWea2PCzHOw8CtQqBjb/bLh4iB6bnbP/xV+Xqrs/Dowa5aJ7ug9GShLQ+Wu5Huiwaych1ew6nduEmbN1o32+2btjzlTuEDgL7xJzyPh2oVDzNcZXjb131utgcIvmmLDxyVowdzQqNE4PVSoFzyKByVIhCVkE1O+38eX49/bAjZtARt2sI23QM3ORqeyqFH6N/bdXP11MNgsK2SqhJCuzrWdRNNtBhBr87+PS+s8dnk+Pzj5c0/Lt7VyiNgnxKJhwu01c0QZ4jw3gNFze6pxLIC8X73ClYSxyPIP3Sl4NuU2NYPmjXZg2SLt6rDxmHtpq9aqLMXhb6owmpGyaevqsx0n9A7EQtZFeR9Ekke8YXiUoUoRPX1+u2byqM3/99/dlIO7RH9J1QPxycfTqfT04vzm39O3v14cfFTm4BsWsS9P07KoILaA6cM7in8TMFVvL5fkY4fSKdUa4dPH8y8b+rrHog+mdHfjPu9yAx/qOKdvnZgSx7tNou+LoCL4Nyon2t3/pjbCP5QZbePWG78ReqmKuvg0eqm6tuosw1zLqds8tHpfsaTbA+1RynVNhEPctmUCf2F3cpWeAOwzjPZJIqXxAjjgMd6VadLIXTdOEEUR4kgkadP81ZStkgiIurvzWs3sqni4pLzaFx4JZdcqPO8nz4EMg7uo6r193tE2aqfteqJ1sb3VsB16Dsh8+hK1offjuT6gorUj8zumrMEp6PO/GJ6bDy9HN9kEZQvsxft3fG0S0ap684ZlyC6R4qqBPfydId9RTHdee/Sg+7AeqrNRFeT6c/n45vT8+vJ1adj396+r1/37iWs13vJ6vUOonr9mJJ6vZOgXj+xnF7vKKbXfyApve4ipDtuUigC21FSP6jccts8H0BkfTFH6NG2rD7pbs9H2O5ZLEhdEUbnKBXYw8RseaoryoPx9BRclTnEEWEI2TqUhwcv7IEeREoeUFO8Or6S8J9w9e54DOYotUGljyWRMENk2qqnc4rhwQvgLNpYQB9nCVMJHH03eP0NEBbCGJm6mIKtVIQwQVBc44ksRBZQlMDZwQu4RcEwKqAGfA50qpFP99ANAH7gArhaooDE9jOPyJonwtTOHrwA4+hBSKUSXB6C0ogXDxxZ8dCgPDh4oYtzmVQiCeyxdYrbt5vRwQs3iv7R9+koyIyvcQRLpWI5Gg4XVC2T2SDgq2wFWXYIJB3OIj4broh2OdwBeEMqA0lJuOonBcB9wsK+AdwPMZaDDVlFBy9g+nE6gTMzkEnmEZutjyjg6M0I7o+FTCQW3W23wfHozQ6IfPuYiHxbROTghZl3mcR6EVC2AAIhnc81xyi4mDqOUEvCDOMbkmrOPnoF71GZZxGVCvgc5JIIDIGbI2B0CSpK0Ee5Uf10zgWkqMGMMiI2QDVMYrpx6kiXdb955U65uVvSYOngEIFAIpNOhtjqCPs5ZjopkLQfR8mCslwVFdsGkpoMPMRc8yh8/QpM6Z4BkuFp4tVs4bqlTNLQVpNnMA1/7/FT/Ajafpo/0iv2XA9BL5sTQTWzjK9OZOtHe/TU+efgUSIixlCinF3TFUpFVnGWxUu9PqonkrI5l4NA0kH5yL1quMTTohQOSSMap5q0p2zOK0GSvLM8tJKHPdJTszjLq/6PL08/fT0NlrjKrLpYaKNFUSxYdoGkdhILzwBClIGg5ripEZy5taVnPrRtQSRMn5IInGVbJQxDqCVSUehoUABKFa5Kvfgxsj+2o+rTCmbXSyxgZRey0Qlu/Quca2QVH9TgWINYKqEPoiq/0oM5PenQtW4Ic8FXdquHRcKcz6nJtaZ4t1O/isc6tbj5CTdyS+/plNziRqaCE0OYbQqY1Pv2TMBWpNKXRAiyOWh6Wg8ASkVUYjojQYCxwvC8yu29XonJzZ861GkWoxzBL78aN4YLDN3ytg8fPQpKXXHlk4Q/085qcU/3oiwePuXPqpFOG+ssfpU+sabkQyuKluZTt0srVQywu3K4h1o4eETmKJ+S6k51HaR/b1ECTc08msDOc0rHse7nwOYypPqpqYWWCiXu8mJbYTRPG5+KeWEFq3HygBaFDmVwm8yMsYYSjgZH3/6XaZ8aj+uj/tG3g5AHcpC305OgHw3NmYhDLSOk7Ofv+ySmQ7uVrp8dhVh90C+cCDvUfRpLS6zxI7tl/I79oJGV+d6wjmqyJGk9ZDYRCu1mSIiJICtURslo5UMgYSGKaKN1o5vlfHvHRipcQSJRwt0SmbMxjL1rZyHnEDhOewkaeUH/UGk3Hs42rr3pmSppWAooA1L53unGgQ8s5oCJQGCc9VlRgDWp7MJ6azIiXmpC2zZgpg2tF+tkJ4YgzSQAn1tOE+jsZjNhBbCgmxCWjcM6LBLkkieR2Zupz3kGgQFfMPp7BlmC4tZXIAqlqtR/2IOTrXd+aEyZFdmAQN0HJKwAzTSRA/jAhSbwnJfcxlTC6Exiog9yGRrfnM4SxYU+MH2N0ZDEtG/wZIZ3B6vwRcbkLw+26GVzoiflzMWsmghebgah5tKVIfvdEo1DVeUMs+2IKcuWGBYAA6il4MnCHgzqXRKW+DO0/WJoOVwz4ownLKx8VCa+TD8awDQTK5bMhg97V6jdnp6Zlt6Jadsb5I9XSJisoEuUD1c3Qg1HoxYvN1IfCJ6tO+CssIJLIN1qTlexxusWYzXIELJobOm6BLIjGr6uM4JdOadxUKoxS1bVFLZFsvLQEnArw9VM8TKjmdfZpmmyQruGMcO7aJ4vCQsjs/Zpeco8XOUfnRdHozUbZY/WmEAlEPeZZa5cxOhH5sjbyfQ6O3m3jmLmVbjPZC58tLCgbI7CqcnUKUgP7Dd/BBGtsoBMZivNAulx89pXgTFhjCuzqdwUkIYDOGUwJiuMxkTiQ4seTVDZ1xTcLnxyhVekNgmt0U6iywaHzgusMk05bDtXt7jp24lakdhGHysclarIUlcFMHMuciWbLi4T8ESJRQnDY/KbjVz+lJsoNVrY+XeP02hNMdRflrnFFwbfAy+wgvdgjdLsUVbC4GzP7H2aGqnsBnXUcc8e0QPT/sTTGdlWcj6BlV0Q0Y12tm2zzdJ2KLeb2ll3NWNbJrP8/G8LxE4m/Pf/PNvi5akoiGMbHQ5UQiLocWY2bvQyfjioiHEXum3Us8/27h/D3n1W7U+j2ot59xqd9cuMj/MlYVhzBzditmlfc36LrylK3OwJ7eENmcndbrNvtdtbx+cWbQ1iV7+p1Veq49nVd9rFabmn41L2mWqQu6DT2n0NYkc/6pRBuGFkRYMcWpoDqsEMiMTDohK+o1GkJ3FOo8iq4ewajd5JifV69gtPeF+vrTDVSj4fu/68kODQKdQa0FhgHz9TWbZ/DyGR+qsPH6fXadSoNJhibKoGs3laUgXlW8J+t7TRNW1wT1tzFf6MUUdXtZBFyg7yMvJLv63TdeeF74osDMlnfqBSo0MKqAlUiWB5Wkfj+B7Vpckva1v+q1cQkCgybo5eMnWmsomgxhlppKVVk620tE0KsVCjGO+WKBBITph0ZThtMKjheF0yZelKX+8zixDIXKHIlmDzGJpTiJBh8aMJOtTfV4dUal4YWkr9XtrihoY9o/s8IBuWXVcW8YsH/VMkz2DXLJ71PToSotjYRwb7PiWCflzKOpV/HF/QYFnki0LA0IpdEkUbUOQWmfniManh8eaLRCpJXVNz2LYOilLEK7W5G7yRsNIXALUWQMTZQnZeoWVUPeV4ngkttK7MqjdFAIrX0CwMpU0JAHX2SzXNMJjqTUreT2sb9SEtKoRVIp2uQxag9WuaMHjpUh9WKNYhzmhoTnYx4SqY2auoXPmYcUqNKgVSXsXd7CI7+EOLAGFhXn/s5xD3QaYWzKahEOsMrwdTQHVJ4hjZ4FFlp9/H9XLX8eVp6tem43STpXaWU3kV6bZeX57ObTfCUYVATDHAkrsMlEmFJLQPPSABtLskMJs7u0wNcgUXWxsiQCyDwD+mF+fD97xFONkYij3zUFeqH4JMtPyTegh6Jqb6zSCtuRzkdcu/vPl14AWpmRI/E3333yFQS+VimYtZGVRaQmTwjB1K/QMnEPPQDfjODFSLX+BuoAlCRG9xBD2zsTBH8b81V/9PzwvzK2sAmDtze7l5WrO3cgStrS/oYoEC/dTUH5g7cF8BF3rsjBcAGLA0z8qGNYR/efNrA7ZlOgFlIX6GN9YIp+aO41dulckNU+Sz7idYconMlMT6sdXrc40g+QrhDqOon9r2d2Sjx59Ol838xkSocoTHC9VevWewCs0dtUAlMK7AXDrpaq/KQcFUiEOwJGzhV87O3ZgnKhE4eLnraq3GZlpiNFXB8DSRjk6jYB4dXx/FeYF9W0fhiawGnOkQvBzytd7RgHdDfRglZYu+5ru+nXg5NOpi+ML8s9co7F6XTkMpqaXHHI/uRw53Hk4aq+uqhF5O03qJypcVC6woLL1LYkVCK2EJ2zw0l2q6JUJ3v0lPhjdV4gFn9ubnYLMzoRLaYQl+PD15Gt5N6M4LsMEcryfYoC3JBvVEW/rQskPlYc0kPtiCUZ5P8zuP5nU1G2FnWxkqi5Uxx4ywz7NUHeKcxVrkVjO/2DCNcqi0gNnGdfQjE7buU9bX75pdEiqdP7Y9avt40bMUyo3GtWftEo+scJES42saF9We2ZhPr4nB92BxdTk2QROfld8YP2jD9l6oZh61TihOs+vYczRTSy9NeNuQb11wUTWA07kxCDI76BCoZrzQXmtv2dGhaLgCqITEZudMStzndayIyjJUmWlB4COjn4ERxqU9nMDCQxbwEEMgEggDytR339SNmQtmvj60+NjzFuBfOhcC//Uf8j/O/+VIVF4/Fl9W7LM+W1RL9aO//+11//VR//URvH49Mv/Bx+vx4MA3tpHF0iuQdI5sUZElKARvDzGaFunas5k34DObrHcvw0SUAkPppBzWZ9UqoR28thVKqUsAtioE17CUOgtRERqlGTM9m4nOE6aIe2BC42A07hknDuD84noySrEzKbUZQsQXCwwPvXBtjD/1wDRfp16YRCapomssCtXdTVdFOxh9ql2Q2vm8I7JILJ/9nnKbZnMjdR8oYGU2I13zj7JdL+TNCvKAFtUQGMuIhBsXCdFB8AOPZ+Nuo88qVttFf03U1+NJLaLf4HOj+E0iU3Fal/Q1iHtJ/oqkbwhDNUr+7Zj6IlH7CH6g6tDuh7ujEg892ZmanpJonMDetT7Ip0FLpGk3zE9orYHmVX5JdYeXWWecR1iJuDjmmdLft7Fr1q5mT3EdAFEIUr9M6wwbLRhtu2hbdjcurUy0D2w+9xqRG9NLI4828WQN8C482g3V7ng2mCLNODZz6AD+qSWkncZSfbvuyc+zxcGVZ9d9amKk5xcmTipXJIps7Ih5l1aRg+jc2EGyyPLZCnI8r1tbXjD01Of71sX4VvuKqBz55vXRZnmsKKOrZDWC151NkvYcV1t+i7Yltlpz6tu8ge2EqoFsXp1LIgGpnjAzLxgCF+mMKj1blEEs+EKg7Jo+6VjOqQfwXLO5T83m4xdrtlZpbinPbK/LbNi891yT2aEm07oTiUTxMit80wIvXcCFHU+lIMRBVZpZGRSjsGEx5eboEMqJsAYNOXiu3Xyu3Xyu3UzpXCreNCabTUJCsCSCBAqFXj+BrCh+N/h6Cadd4TvHj52IH7ojddJ+5AufdE5/stT1yw6B0n3KlrZVLNlqlCctW8rFnpWu44jQ1XmXJFXjl5V6j0Iq1gMS4NIHp1I4b+rV8gIH4qsk8QJ3YCzhi/5bx6IgL9D6VOxZIuWpSulYL+X5soHsW8LOUN8OVymxbOC+L1kH9bLhI1+1ZduO5vQnkz5p+qH8kc/LsKJtTpJINccEzA73bJGbYk86B84QzHxIH2B3solAm3T3Fe+WOrWdHLY4NbmbCZNVrOqVBHbC0iQ/iSJ+504wyu3MgakK+OozJWzxGyXs1QgIuFsSCsZcDTZngLpT18lDCfK+O2DgZTcnrCm/V8vW7ZONe7lvOs5Gqis7fw0od89vesSYZkuLqiweUGV9YMiv/ZbOQksqZpwzJdxJaSEkMWdmfkkQJIIEmwGMcyCpaDQHsSWyFPsugc1MnDYB4rSUOeBtY60sF7twtnV1f7bFSdmitjQG10klmyrAT10la3kO27+tzq0TMDsXrBfrAxoK9HypUVfe2DUo1A3ukkjHGMhAJsbrmydaCZrurMvTNpgaxA2mPA3TFMctkwIk4gwh5GiQ8e7RSBklp4ernSzyrAnZEXPuseO1cvWlpzbTAp2hutMU8KlCw4J+EnjqVcma0xBiLiXV1ll6wypQKbVj9LJrue1zCv45Bf8QKXgnDcp5+K1pjK2x5Ka8YiM/75zIfoA0dtFqDVJDf4lRPE8iUBySOEYBkfaHi/rzKzrAQR0oic1pxzaBk7V+ZU8fDWiIhZ19uCmW8lKWoC+qfUeoZsG0cjwfmU2Jpv7gjEgM090cmsB6/vwJcoE2BvicwX/O4D9n8J8z+M8Z/OcM/nMG/0+fwX/OKpfPYnXJYnt8zRWP8J2LIhTTzWJGggFJ1JIL+rvV+JWcc/Mtidlh41msSaHop7EKt9PFjKfvxjMtXRzhuTMxB5kbj31Jspbu1hAdBuu782AEj9BttKyNuCPKwjYlMX1v89+NVLGUrZP2kWlqEDTXAlhqppjq2e71fj2wTJ2mweGXXp5xcFkc12iNYmYaLFD1DqGnU/X63zt99Unv1/2ABzoNsXcP5RIDX3+uhYtjPuRIzPa1GsQyqEPnv6L+zaYY9W/xLv1IDASqVtR9lPFXYfg68B4pu/eM7N2vO2Cr2nFOPy8KJbraoz8eBa2hlbhV7FzfD9ljN9Ln437QvnceZsC5CCkrSipfNxGSZqbKRpQO0c1jaW4dH3hQ8FU9+XCwpTTpo0IhTTPL1WieY+Zwzyjz5Cdy52FZcPdTPO2J3F6tXFRl6266qqqsG1S13yx5BN1ZvOZzZ925n7DupoUPoecuz+osInJO9i2p+IkUd6us6t5Xu2xqG8/OZoImnb2g4w9vMjyOntmLiBY+UYoEyxWy9h4egE0f3NbYQoG/erWK7rxtSfz1wdXmX3dAjhakRwFV2Tvcjvn26aqZXoXpavB2Hs+RLAruP4kjWdM1OziSbjCKKJwn0RSV94LN3TRws/r13bG5BVKna/S7fHvvOzi3dnY/VAH03HkaHmTXXdp7Lnt5C9qrXe+5z/2dHXC779jaRgewdaqgUssaC8p1vj4vQXP57r67DrwfCKp0naD3wtBuq9R3bWjxemfaF6gjgvkYsztBf0vIRo/k9nupr8IrtB2tXw+OBsVtPEQsZLXWqdfvr99+26s/1ZBIGAqU8u1fvjo+ObmaTKevPA0jJCGKvmFtylmxReke0uKQHLjSu+xiSnNtaERnejBDyYNbVHJofYZY8M+bobnKjAe3VWpsvUc1rSs0N95JP2q2w35Iq7nKlf7I3i66FUPvHBaiXJ3msdB+tH4zeD042j6X22fteU4yqFnVYF2GNM2L55vOc+NfZ5Xl89ZshentTMYioxXUdCdGK7QfrY8G3w5eVz/rMIsNgy7AfmtEoLmqMBfB95E8foLOkahEYH9BFMq31+5Ku7c6D9FpHXz4uXqTPDRfRQwt1xFDl1vlH3UFfklpZ/2qjhyYNrZr6QHZb1/d9nRS8kvOUWrC2PRrX+CCSiVItzlr+liLkLI8fJQ5zLo1+U3Zz/342lJ/nvUMav2a4Ppc124g140tuetXzXef3UbipjeXjy/Or68uzs4mVzcnV6efJld+cnu1tQfa9PRmcn5yeXF6fu0HlDD6eTQc7jN9/u6OL09vPl6d+XtLtzWQOFd/+jrrVqgfNMjpp7EfpJ4ovQ3X3WntPI9WgL5b9gsQHWKVNQmeRZnuX3z7l698FPep60REldaOYL7GdgKK7avc8ar3xdaT7c3jqnk7MTtdTqgw+7RNlKk9V6FLaPpw7FQiNGZj8ibmdOIZgtn/pzcTcHFry3YuP8lDc0uwLFStH+chz6fJ76fq/amS+ztH8O8VOq/frN0Q28xu0H68+oF6THvX3EAtSfiEIdGMT/4k8dAyX//RqmpSAy2zzNqXX2c2djewN6ehs7qGJ4+p14f8J+GkhrnahaUeJI3f0vCch7hH6n6vpP1BNTPvnbAuHKEF3/ZE/ZOsx47rsHsmdpeE172S50aLWBXSPavf2ONz6u/BxVPO5Q8tmO4hkGw3JwRXnN0n36cHd99Mn4OxY3LJ99UjZPeybvZD72EScQUk9qXSEybfDMPvnHkrLhOAJZfqHJV2lkql69tycQbKXiEz75dPFC/rHFXXiytClSGoF3Y/Jmr59i9fWc/75mry/mZ6Mf7p5vL4+scSjIjOMdgE1WMAY4FTxePyQwD8jEH1GaRHMmu5PpxRNpRGlPcD/X+xgr6Yw7CI3NCXV+jQRI/QBHacNrlXDNAaRu2xIh/1toQU3WQ48NI7jvZOf/r4bnJzfnEyeZyshrnoRPP1eVNWw/TdFtBrFhZd4jqWMlviOrVQZ/59ic3boRSb7hZZTXf9m3MCPqvq4qBrGuGisoPG/gQkJjMaUd9WXRKaRTI+vrz58NP5xYleIPqP6c/Tm+OTD6fn+kH+x6+lz82BLpdp1xMZkIi4DatlHB43Ktwgw0yoJCyE/wwXnZ686v0BQpEFju6WPqgM4gsswt2j4l0EmocgfqAlE8EH4f/F0HkO4mry4eJjE8F7tYz/4wu9EPVwt3wf4trfOQ9l3zTsGE1PlVil6QtgiCGGILnd+EjYxn4vQaJKYqBM0tCet5hbZUAE1iDFgsdk4U5HCG7Twyu1iQcrEiwpqx1NZjF13xnB13tXPBum5x081cYcCVfac2sZutSGS9p2W9bAOxsa9fotdLF/auw2zhODPBebgx2UXGs/DWbIjYO3GbZhcSHsluWDTty7Dx5+c2g/nJqYeie8dpqUBlZq7dDHV8XefqARWve76G2Xw7rVI5anpyf2zqK6M+6jb+bvvoBrnt9jDkSBSJg57cIeZrXiYX7IAyQS5aEmszlyI71B24DxhJ6HGC9xhYJEPUCmxAaIQCcv9EKOeXjK5vyCfbC+nrNZbEA+PfHSPf6/AwAsPJSP1+gAAA==",
+	"openebs-operator-1.9.0.yaml":     "H4sIAAAAAAAC/+x9a3MbN7bgd/4KFD33Os4VSct5TIZV3iqaYhxNZFElSp7NprIasPuQxKgb6ABoyszd+e9bePQb3Ww+JDu7UqViqRt9cHBwcN4AXqAxBywBfcAbjGbA18QDNPI8FlPZwRH5CFwQRodofdq5J9QfJo2SNiFI7GOJhx2EKA5hiFgEFOaiF+IN7rEIOJaM27ciwl7WpNPr9Tov0BksCAV0zQJAcoUlwkHAHgQy3xJGBWIU/fyDQBHzxcCHKGCbEKgUFqVxEAsJXAEo4Mzn2OvjWK4YJ39oSP37H0SfsMH6dA4Sn7bGnscBiGGnh3BE3nMWR2KIfu1+3f2tgxAHwWLugX5EmQ+ie4LML4OIs08b3WoNfJ5+1AJOQiwNTBia698VDZJ/B/AJPPVHjialPwcLQnFA/gCu33CIAuJpWniMSs6CoPhGgAEB6wQYUD9ixP7hMbogyxBHFi+P2/b/YnPTgDOqf99j0EJiCYs4SHDwMYSM6r/2gJb88XvMJNbwAhISyTFdQglgQIRUDR6w9FatgBO65CCEmRLNX4xKHETMx7FkwsMJWdfAJfFcbzz1ZqEmAwRZUg3x9xiEPBg5IRnHS/ACnGAYqSUhJFC5ZkEcqlckdL7ZTmrbjuJIrJjswycJnOKgZ3u1S6yKVfE7Q5zCI7UQS90vQZqJy5NAc5mSWuq3OPLtb1Hy0ocAJFQRxxFRyFIlHEQtml4sJAuTR76STUQLoQJmqIyaCyOLSIpbi7lDXZ+Ie02cecC8ex/ShZ/7205faaZQK/iemqeIscAzUtMsZDN35nHCGpWHJVlSgVR6n/t+X1yxkBBGgVoiWkbFVGJFnsNGXsTeOSzDmYlMLD0u/52RLPcwYgHxCByKK6FCYupBEef0aR73/TuaY+8+jrIuOKj/5/r0WBgpXvaTllv78hjjPqF5rVtdawFgAe4lny71ZIFliylbYHbRVVe6HxKhljmHJRGSN2OxxgHxsSR0+QDzFWP3RsnF5jNNhDCWDQ1c+GfyoGkAmVygjF5bpG6vLzSkQQiSE88Bv40SiKMlxz4410ub7yPm+0TwOFJjnMf+EqQDE6cEzEaa6ixt6L0j1EdyBWVDEz0QudIvtA14xcmaBLAE0e+8QDfTs+kQjVfg3SOyQD4scBxIhO2nOBAMUQBfIMnQHBQYDlXDUPVN6PKR7EMRz/8FntQmotNM3sM45iyAa1iorqtm7jZ4yew2DFJPSp4cOIrEILXzz1Ib0kEF3R2OiDJMoQZ/hAI8h0Cor2q/Q0lzRXUlZRgFKnttWq8TvE/7f+u/7ogIPNWVgAA8ybjpNlQMeJHDowGT3XBJdMMQnapelZCB5cZ0IjcRDNE1mCWhHylLm9DlrV76Q0TjIOgglGg3i2yOzggV6bcF813p2ExLhBBK6Kl/LzDzZSPnqR/lWmBCgafI95qRJyFewlUcBFdKa26G6HxxyeQVBwF68eRaDdHvMd4opC0GgzADOMzwVz8R4zJHv16G2BXjcoi++/6779O3QNf5pgbf6dXkcvJudnc5+jCZXY3Gk7QFQmscxPAjZ+Ew9xChBYHAtwu38vwKy9Uwneh+umxqO55Nrj+ejyd3o/F4ent5c5Tu1dT2q3Nai8OH0S+ju6vpmabC8QlQ2+/59G58PRndTO7OJj+Obi9u7mY30+vR+8ndeHr54/n7MipD1JU8hm4TwPPL2c3o4iKFOFYg72ZXo+vZ5O5qOr1wAF3gQDRDfTeaTe7Ozq8dHw/WmCes2gjDYHIzun4/uWkBayAizAW0AKlGlYzwOHD/fv5xZMAeOOaL6Xh0cfXx7qfp7OZqdPNTG/wC5uFgO3rj6eXN9fTiYnJ9d/5h9H7iAFsWJP8ia2wkyHbw15Ori/Px6FFhO1f8EHW/ac9HbdHTVn6PCLmULbDM8dVuHSjfZTf4H95/uNmjk164DNuP5OP04vbDZJ++jK/Xtrekn+nlueq2bVdhDz4pVQa8RR852k3+59X0+mbySB39NLm42gF2QGj8qRdLEogWwCeXo3cXk7vR5ejil5vz8ewAUT+5vrv55cqFYmLCAJXAI04EpIZMBjgga6AgxBVnc8irNBV7zf+NkMfCEFO/+LCHBrHgRmoN5oQOlAnkyaDUyFpguac67oSDMwjwZgYeo74Yom9e51pEwAnz03ffZ+84YJ/8abAuOyLlXMNWB6RnjZk2jkhrO7kn1l7qVaSWZMJnOCKdxMTMGZIRZ5J5LBiim/GVfiIxX4Is2JtFF6XGMBagIxijhQ5AboboklE4xGdLuD3ibE3U17s4bu6PG6nZ4pPDXLi6DvbA6rM5c/Vj2Iu2n8uta8LnIN8uAXz/QwF4yc9zum6X07PJ8TwW7TOppF6jp/RkbuLhWkmsSg96XumBBCHRP6Mlhwj1PNT936VZ7n/d/Sd6q1fMMaX/HiItySBtiag1CTYXiFZLcOuHxxFy7m72xnAngXeIYKvD+wDaPrWQy5Z5ik+WO88zv1uEOT4qia+2MrIk6NqJn1oBVC+C2gqhBjHkEkQ1osghjBziyCmQSqR1CKQ2IqnJkK7MvEvDbZ/6etX1PPeHzr2h7RHn3iSrblZEICJ0Wkrp/Z5KzPdCTPESOOKgBKKPTCZQ5anOJfIwRXNAsQAfSYZMJQH5Q+ekkPpcoEgRSyBMfbQggQQu3J7PWMP9gKMGrUf9sGf6P9DvyQFKeyqPuG8aDNH/SfycOZhHOWPwHjZDFPuw7un3JZWgXqDiC13xM0TKlS8CEYC9FQjpBJS8dAPT0doStBDzGlDqzRakzES5B8uEoRJ88oLYh55pW+qECT37yDZCpUZVIqCkqYp9ngxAeoMVE1KcDOaMyW4RhTVQn3F3z+Zdmw4JTTrsOpAYX0xvz979cnMyjYBO3s1KKERYrtwIqDeHdx8wFp0MfFgPFv5r84vg9heOQ/OLH/bML6Hf3WZU6sKyGcjm1bW7FWk+2rbYHsdCTAC36N0UIMxcVl/esz3Q9MsQakWQxzXr1Pq5BPnA+H2B9ep92ooI3OarVj7o4dD//tvh+nX/2/7f6v3hUfCANyJ9L8CLOZGbMaMSPsm8woySygi/tHxMGPqDIoXDbE2VRPITqoZGxWvBMqgT97lvRDw3X7Roq8KPUxpsSlj2coqgBhse00HhdfJNxJkX2lKK2mGoRu3RmGMBSj7VQMznnPI8mnxu8mQtAZjGWwIWT51jfsoIic0//nh+cVASMg9mdv6/XFH909d//eav357+8Obb183f16XXXh8z+K8t1tKzLvXD7lEi/WbdV0RXaUF6iTGZR67Blqxdq2qR6cnOo1azcBONcka41mKbzrYF3QC9tLCbIVfWdQPgukVe6WPKx1n8t1EItOzNfHmM6Jeau/0DX6WvtzoKjxvmqvSwCz5PFtRyYLkb3T5fCCvT3hWEWhg2yTduy2a7bdOUmdwpbIBQT/sRjscDGUaDFFHh3/dUn5vtgYFvm+ICp8WowQKTIOZws+IgVizwh4XIQylUk1H/H6Ob8U81oZqGYE1TuKZ9wCZDw1HL9Qj9O7uur2c7DgbbattKobPrkaqWqKPFEHWrvN91whpfTEaXt1d3f5++q5REoP3KIo4XYqsaIdYMYd0jxcsOVGFpwX6vfUUxjqIhyj60pfnbVNjWD2r02FEyxFuVYe2YdtNWDaTZizyfT13V41Orrcq8tHfAHfOlKIvxHg4EC9hSMiF94Lz8ev32TenRm//xn61UQ3Mc/wmVw+jsw/lsdj69vPvH5N1P0+nPTeKxbgF3v5xEQQm1IycKDhR8usAqWh9WlOMG0irD2uLT4xj2dR0dgOWTmfv1uB9EY/TlFOv0lNNa8GK3WfI1wjcP0477//tanS9xS8cXVWL7qMXFn6VSqrQMHq1SqrqhPd26aPPIOged7Cw9S3ezO1RSZTt3PxNOqdRfmk2FuTcIrbPsNQ6iFdbS2GORWtfJYvBtN1YYRUHMceDoU78VhC7jAPPqe/3ajmwmGb9iLBjnXokV4/Iy66eHPBF5hyhq9f0e0bXyZ42KorHxYeq3CnonTB5dxbrw25FWn0uNujHZU28WgLXRmJ9Ni41nV+O7NHLyeXYFHm+73fH3xo1nV+e77iSKyG77lY688+rptg9dT2a/XI7vzi9vJtcfR669lt+87h4krtd7Sev1DsJ6/Wiyer2TqF4/saRe7yio11+KnF63EdO7bErIQ9xFVn+hG4U/myP0iJtVn3iP56Ns8swXooaYkgUIiczRbqYs1RbjofHsHNnichQFmAJKF6M46bwwx6tgIZhHdNHq+Fqg/0TX70ZjpA+265f6WGGB5gAUrYGTBQG/8wIxGmwMoNt5TGWMTr/vv/5WF7KOgcrpDJkKReTHgCRTeAL1gXoEBGK08wLdA6cQ5FBDbIHITCGf7JrrI/Qj44jJFXAUm34WAV6zmOua2c4LpD095BMhORMnSCrE88e/hMzXKPc7L1RRLhWSx545RFAy83Yz7Lywo+id/pCMAs/ZGoZoJWUkhoPBkshVPO97LCxxgyfIYB6w+SDEyu2wxxEOiPAEwX7Yi3OAe5j6PQ2450Mk+hscBp0XaHY7m6ALPZBJ6hfrzY7A0embITocCxELyDvddkvj6ZsdEPnuMRH5Lo9I54WedxFHagUQukQY+WSxUBwj0XRmOUKuMNWMr0mqOPv0FXoPUj8LiJCILZBYYQ4+YvpAHlV6CgKpg/WIerpgHCWooTmhmG8QUTCx7sbqpH7nBXrzyp459LAi3srCwRwQDnQ6GUVGV5jPIdVNniC9KIiXhGYqKd/WE0Tn4FHEFI+ib14hXbangaR46og1XdpuCRXEN1XkKUzN33v85D9CTT/1H6kVe6mGoJbNGSeKWcbXZ6Lxoz16av3TeZSoiLaWCKM3JAQhcRilabzE/yNqIgldMNH3BOkXD0Ash0wcLQohkSSqca5Ie04XrBQoyTrLwitZ6CM5w4zRrNp/dHX+8ZuZt4IwNe0iruwWSSBn3nmCmEnMPUPIB+Fxog//GqILu7bUzPumLeIxVWdWIkbTLRKaIeQKCM911M8BJRLCQi9ujMyP6aj8tITZzQpyWJmFrHWCXf8cFgpZyfoVOMYqFpKrY8GKr9Rgzs9adK0aogVnodniYZDQp6Uqcq0JPOzUr2SRSi5ufoaN2NJ7MiX3sBGJ4AQfzTc5TKp9OyZgK1LJS8w53nTqnlaDgEJiGevOsOdBJMG/LHN7t1tgcv2nCnfqxSiG6NfftC/DOPh2eZuHjx4JJbaw8klCoElnldinfVEUDx+zZ+Vop4l35r9KnhhT8tiKoqH5zO7OShQD2l05HKAWOo/IHMUza+0Zu/3k7y1KoK6ZQxOYeU7oOFb9dEw+Q8if61ooqVDgLie2JUZztHGpmBdGsGpXD5G80CEU3cdzbayBQKf90+/+S7dPjMf1ae/0u77PPNHP2qlJUI8G+oTKgZIRQvSy9+owjIHZQtdLD6YsP+jlzucdqD61pcXXcEvvKXugPypkRbYnrKWaLEhaB5l1pEK5GQJFmOMQpFYy+uxyFFMfeLBRutHOcra1YyMkhCgWINDDCqi1MbS9a2Yh4xA0SnrxanlB/RBhNhzON7a97plIoVkKEYpw6XurG/susJABxhwQZbRH8wKsTmXn1ludEfFSEdq0QXrawHixVnaCj4SeBMQWhtM4WLtZT1gOLFJNME3HYRwWgcSKxYHek7kGLhEHjy0p+SOFLJBkxlfAEoQsVYCYY6yNf36iTZkQbxAH1QeKaQ6abiL66APjisALVnAbEwmjsomxOrploH1zMo8l4+r4+jUEAxyRnsaTat7th/6LlMlfdrboZX2+KmHUxq7qCF5shnzFpaEm+8MKtENV5gy95YhKw5bg5wAjJFecxUtzTKtzSRjiz8H0C77hcMWIcxZTv/RRkfgi+aiPZqlYMWTWfNi9BuX2dPW0dM90224/exwCpqKELpYuXO0IFRyFWrTaCHU8e7ruEKO5FVwAaVdzsooVXvcQyX6KkEFjS9cFkC3RcHWdEuzaOo39QpVZHJbT2AbJ0kNDwK0MVzHFi4ymX6ebpZXY0WsYUrzz5vkKUz/Qa58Up8zBVe7ROXHUWrNW9iiNiYhA2H5mmCsTMeqRPoB4MrtJz0Guoph6FfYzkQkfJSwIXQC3ajJxCpLrE/QfXkDKLCDieahYIDn8H0nWR2NMKZN6M7muIPX76JyiMQ4hGGMBxxY9iqCipyi4XfhkCi9Pbewbox0HVzUOnRNYaZoy2Gau7mHTMxMV4shEH0sclajIQlc5MAvGMyWbLC4d8AQBeQnDIvy7iVz+nJkoFVqY+bePk2hNPuBflLn5FxrfjhNYznswRmn6KC1jsLZn+j5JkZR2glrq2GeP6IEpf+LpjGwjOZ/Ays6J6Fo727TZZmlblJtN7bS7irEt4nl2GrsBYiYT/fe/n23x4lTkxLGJDnsyxgHqMqo3bnRTfuiUxLgN3dbq2Wd798uwd59V+9Oo9nz+vUJn9TLl42xJaNbcwY2Yb5rXnNviq4sS13tCe3hDenK32+xb7fbG8dlFW4HY1m9q9JWqeLb1nXZxWg50XIo+UwVyG3Qau69AbOlHnVPkbygOiZdBS3JAFZgeFnCSV8IPJAjQXJ/tExg1nF5q0j0rsF7XfOEI76u15SdayeVjV5/nEhwqhVoBGnHowSciivbvCYqF+urD7ewmiRoVBpOPTVVg1k9LoqBcS9jtlta6pjXuaWOuwp0xaumq5rJI6QFeWn6pt1W67rzwbZGFJvncDVQodHAONQ4y5jRL6ygc34O80vllZct/9Qp5OAi0m6OWTJWpTCKodkZqaWnUZCMtTZNcLFQrxocVcEA4I0yyMqw26FdwvCmYsiRUly3NA0B4IYGnS7B+DPUpRJRi8ZMOOlTfl4dUaJ4bWkL9btLijvhdrfscIGuWXVsWcYsH9ZMnT3/XLJ7xPVoSIt/YRQbzPiGCelzIOhV/LF8Qb5Xni1zA0IhdHAQbJPE9UP3FY1LD4c3niVSQurr2sGkd5KWIU2ozO3gtYYUrAKp+5hAwuhStV2gRVUdRnmNCc61Ls+pMESDJKmjmhtKkBBCx9ks5zdCfqa1Kzk8rG/VRUlqIwlhYXQfUA+PX1GHw0qY+jFCsQpwTX5/qosNVaG4uBrPlY9op1aoU4eIqbmcXmcGfGASUlZJmT9wcYj9I1YLeOORDleHVYHKornAUAe0/qux0+7hO7hpdnSd+bTJOO1lyZzmV1ZJu6/Xl+cJ0wy1VMIoIeFBwlxGhQgL2zUMHSISUu8QhnTuzTDVyORdbGSIIGwZBf59NLwfvWYNwMjEUc9ahqlU/QSJW8k+oIaiZmKk3/aTmsp/VL//65re+E6RiSviE1U2MJ4gYKufLXPTKIMIQIoWn7VDiHjhGEfPtgB/0QJX4RcwONAYUkHsYoq7eXpih+N+Kq//ddcL8yhgA+gbjbmaeVuytDEFj63OyXAIHNzXVB/pG4leIcTV2ynIANFiSZWX9CsK/vvmtBtsinRChPnxCb4wRTvSN06/sKhMbKvEn1Y+3YgKoLol1Y6vW5xqQYCGgBwiCXmLbP+CNGn8yXSbzG2EuixEeJ1RzEaLGytc3BiMiEGUS6StAbe1VMSiYCHHkrdStx24taoySRSxjDv2Xu67WcmymIUZTFgxPE+loNQrq0PHVUVzm2LdxFI7IqseoCsGLAVurnQ3wMFBHURK67Cm+65mJFwOtLgYv9D97jcLsdmk1lIJaeszxqH7EYOfhJLG6tkro5Syplyh9WbLA8sLSuSRC7BsJi+nm2Fyq6BZz1f0mORBeV4l7jJp7uL3NzoSKSYsleHt+9jS8G5OdF2CNOV5NsKGmJBuqJtqSh4YdSg8rJnFnC0ZZPs3tPOrX5WyEmW2pqcxDbY5pYZ9lqVrEOfO1yI1mfr5hEuWQSQGzieuoRzps3SO0p97VuyREWH9se9T28aJnCZQ7hWvX2CUOWWEjJdrX1C6qOa8xm14dg++i5fXVWAdNXFZ+bfygCduDUE09apVQnKWX42doJpZekvA2Id+q4CKyj84X2iBI7aATRBTj+cTD0vp5CYqaKxARKDbZOZ0Sd3kdIZZphio1LTC6peQTopgyYQ4oMPCAeswHH2GBMEWEyu+/rRozU6q/PjH4mDMX0D9VLgT913+I/7j8pyVRcf0YfGm+z+psESXVT//219e916e916fo9euh/g/d3oz7HdfYhgZLp0BSObJlSZYA56w5xKhbJGvPZN4Qm5tkvX3px7wQGEom5aQ6q0YJ7eC1hSCEKgHYqhBsw0LqzFfx1iDJmKnZjFWeMEHcARPVDkbhnnJiH11ObybDBDudUpsDCthyCf6JE66J8ScemOLrxAsTQAWRZA15obq76SpJC6NPNgtSM58PWOSJ5bLfE25TbK6l7pECVnoz0g27Fc16IWuWkwckr4aQtozUziYTCVFB8I7Ds+Ggy0HSitVm0V8R9dV4UoPo1/jcSXYXi0ScViV9BeJekr8k6WvCULWSfzumrkjUPoIfEXli9sM9EAEnjuxMRU8J0E5g90Yd51OjJZK0G2QntFZAszK/JLrDyaxzxgIoRVws88zIH9vYNW1XsaeYCoBIQEK9TOoMay0YZbsoW3Y3Li1NtAtsNvcKkTvdSy2P1vFkBfAuPNoO1fZ41pgi9TjWc2gf/UNJSDONhfp21ZObZ/ODK86u/VTHSC+nOk4qQhwEJnZEnUsrz0Fkoe0gkWf5dAVZnletDS9oeqrzfatifKt9hWWGfP36aLI8QkJJGIdD9Lq1SdKc42rKb5GmxFZjTn2bN7CdUBWQ9atzhQUCoiZMzwv4iPFkRqWaLUJRxNmSg2ibPmlZzqkG8FyzuU/N5uMXazZWaW4pz2yuy6zZvPdck9miJtO4E7EA/jItfFMCL1nAuR1PhSBEpyzNjAyKgJuwmLRzdIKKibAaDdl/rt18rt18rt1M6Fwo3tQmm0lCqsQQx54ErtaPJ0qK3w6+WsJpVvjO8WMr4gf2RJ2kH/HCJZ2TnzR1/bJFoHSfsqVtFUumGuVJy5YysWek6zjAJLxsk6Sq/bJU75FLxTpAInTlglMqnNf1almBA3ZVkjiBWzCG8Hn/rWVRkBNodSr2LJFyVKW0rJdyfFlD9i1hZ1TdDlcqsazhvs9ZB/Wy5iNXtWXTjubkJ5U+Sfqh+JHLyzCibYHjQNbHBPQO93SR62JPskCMAtLzIVyA7ckmHEzS3VW8W+jUdHLS4NRkbiaahJGsVhKYCUuS/DgI2IM9wSizM/u6KuCrTwTT5e8E01dDhJG9KSFnzFVgM4pAdWo7OZYg79kDBl62c8Lq8nuVbN0+2biX+6bjTKS6tPNXg7L3+yZHjCm2NKiK/AFVxgdG2W3fwlpoccmMs6aEPSnNR3HEqJ5f7Hkxx96mj8YZkEQ06oPYYlGIfRfApiZOkwCxWkof8LYxVpaNXVjburw/2+AkTVFbEoNrpZJ1FeDHtpK1OIfN35bn1gqYnQvW8/UBNQV6rtSoLW9sGxRqB3eFhWUMoEjE2utbxEoJ6u6My9M0mArEDSQ8jWYJjlsmBeGAUUA+A42Mc49GwigZPWztZJ5ndcgO6xOQLa8Vqy8dtZkG6Bzkg6KASxVqFnSTwFGviteM+ChiQhBlnSW3qyIihHKMXrYtt31OwT+n4I+RgrfSoJiH35rG2BpLrssr1vLzzonsI6Sx81arlxj6KwiiRRwgyVAcRcBRoPzhvP78ivShXwWKI33ksUngpK1fmdNHPeJDbmcfbPKlvITG4IpqP2CiWDCpHM9GZlKiiT84xwL8ZDeHIrCaP3eCnIOJAT5n8J8z+M8Z/OcM/nMG/zmD/5zB/9Nn8J+zysWzWG2y2Bxfc80CeGejCPl0M59jr49juWKc/GE0finnXH9PYnrYeBprksB7SazC7nTR4+nZ8cwKF0g4bk3MQGbGY0/gtKW9N0SFwXr2PBjOArAbLSsjbokyN01xRN6b/HctVQxlq6R9ZJpqBPW1AIaaCaZqtrvd3zqGqZM0OPq1m2UcbBbHNloDn+sGS5DdE9RVqXr174O6/6T7237APZWG2LuHYomBqz/bwsYxjzkSvX2tArEI6sT6r6B+MylG9Vu0Sz8CPA6yEXUXZdxVGK4OnEfK7j0je/drD9gqd5zRz4lCga7m6I9HQWtgJG4ZO9v3MXtsR/ps3Efte+dheoxxn9C8pHJ1EwCuZ6p0RMkQ7TwW5tbygQMFV9WTCwdTSpM8yhXS1LNcheYZZhb3lDJPfiJ3FpZF9n6Kpz2R26mV86ps3U5XlZV1jap2myWPoDvzV33urDv3E9bttPAJ6tpLtFqLiIyTXUsqeiLF3Sir2vfVLJuaxrOzmaBIZy7o+OJNhsfRM3sR0cDHUmJvFQJt7uEIbHp0W2MLBb52ahXVedOS+ProavPrHZAjOemRQ1V0T7Zjvn26KqZXbrpqvJ3HcyTzgvtP4khWdM0OjqQdjMQSFnEwA+m8YnM3DVyvfl23bG6B1Oou/TbfHnYL59aeDsMTITVxjoad9M5Lc9llN2tBupULPve5wbMFboeOrWl0CDXPEypVsUacMJWpz4rPbKa7Zy8D73mcSFUh6LwytN36dF0cmr/imfQ4qFhgNsDKraD3Pwh1CV6u7XD9un/az2/gwXwpylVO3V5v/fa7bvWpgoR9n4MQb//y1ejs7Hoym71yNAwA+8B7mq8Jo/kWhXtI80Oy4Arv0ksp9bWhAZmrwQwE8+5BioHxFiLOPm0G+hIz5t2XqbH1JtWkolDfdSfcqJkOez4pZylD9ZG5XXQrhs45zMW3Ws1jrv1w/ab/un+6fS63z9rznKRQ03rBqgCpmxfHN63nxr3OSsvnrd4E092ZjHlGyynoVoyWaz9cn/a/678uf9ZiFmsGnYP9VotAfUlhJn8PkTxugi4Ay5hDb4kliLc39jK7tyoD0WodfPilfJc8qr+KGDVcR4za3Cv/qCvwc0o741G15MCksVlLR2S/fXXb00nJzzlHiQljEq89DksiJMft5qzuYyVCivLwUeYw7VZnNkUv8+ArS/151lOo1QuC6+e6csWypXnpsvn281tL3uTe8vH08uZ6enExub47uz7/OLl2E9yprx3QZud3k8uzq+n55Y0bUEzJp+FgsM8EursbXZ3f3V5fuHtLtjTgKFOA6irrRqgfFMjZx7EbZIg3WG3BtfdZW9+jEaDrnv0cRItYaVUix7JM9i6+/ctXLoq7FHbMg1JrSzBXYzMB+fZl7njV/WwryvTmcNacnehdLmeE6z3aOsLUnKdQ5TM9NLJKEdVmYrIm+mTiOSC9909tJGD83pTsXH0UJ/qGYJGrWB9l4c6nye0nCv6pEvs7R+8PCptXb9WuiWumt2c/Xu1ANZ69a16gkiB8wnBoyid/klhoka+/tIqaxERLbbPm5deaje3t6/Up6LSm4cnj6dUh/0k4qWaudmGpo6TwGxpeMh/2SNvvlbDvlLPyzglrwxFK8G1P0j/Jemy5DttnYXdJdh2UONdaxKiQ9hn92h6f035HF08Zlx9bMB0gkEw3ZxhCRg/J9anBHZrlszB2zC25vjp2Zi/tYz/cjpOEyyGxL4meKvGmWX3nrFt+gSC0YkJeglRuUqFgfVseTkPZK1zm/PKJYmWtI+pqWQUgUwTVku5FWK7e/uUr43PfXU/e382m45/vrkY3PxVgBGQB3sYrH/4XcZhJFhUfIgSfwCs/Q8lBzEqiD+aEDoQW4j1P/Z+HqMcXaJBHbuDKKbRookaoQzpWjxwU/zMmUXOUyEW9LeFEOxkWvHCOo7nTn2/fTe4up2eTx8lo6OtNFF9f1mU0dN9Nobx6SdEmomMosyWiUwlzZt8X2LwZSr7pblHVZK+/Ph3gkywvDrImASxL+2bMj4cjPCcBcW3Qxb5eJOPR1d2Hny+nZ2qBqD9mv8zuRmcfzi/Vg+yP3wqf62NcrpKuJ8LDAbbbVIs4PHZEuEaK6TCJnwv9aT46P3vV/QLCkDmebpc8KA3iMyzD3SPibUSagyBuoAULwQXh/8WweQbievJheltH8G4l3//4Ys8HNdwt3/uwdnfOfNHTDVtG0hM1Vmr6AlEAH3wkmNnwiOnGfC+QABlHiFBBfHPOYmaXIcyhAiniLMJLeyqCd58cWqmMPBRib0Vo5Ugyg6n9Tou+7rv8mTBd5+CJMuewHyqvrWHoQpkuSdttGQPnbCjUq7fPRe6pMds3zzTyjG86O6i5xn5qDJE7C28zaMJiys1W5U4r7t0HD7dBtB9OdUy9E147TUoNKzV26OKrfG8/kgCM6533tIsh3fLRyrPzM3NXUdURd9E3dXdfoBuW3V+OsEQ8pvqUC3OIVcj87HAHFAsQJ4rM+qiN5OZsDcYRdh5AtIIQOA66CKjkG7Xqrbzod5CCc04XbEo/GG/PWi0mGJ+cdGkf/98BACg0QWBd6gAA",
+	"openebs-operator-2.0.0-ee.yaml":  "H4sIAAAAAAAC/+y9fXvbNpYo/r8+xfkps5tk1pLjtJ3taqdzr2O7rbaJ7bWd9M729qYwCUlYU4RKQHLUnf3uvwdvJEgCJKiXJJ2VnnmmMQkeHBwABwfn9QmcZRhxDG/QGsEtzlYkwnAaRXSZ8h5akHc4Y4SmI1id9B5IGo9MI9NmjjmKEUejHkCK5ngEdIFTfM8Gc7RGA7rAGeI002/ZAkVFk95gMOg9gXM8ISmGG5pg4DPEASUJfWSgviU0ZUBT+OFrBgsas+MYLxK6nuOUM43SWbJkHGcCQAnn7B5FQ7TkM5qR3ySk4cPXbEjo8erkHnN0Eox9tkwwE40GgBbku4wuF2wEP/X/2P+5BwCQYUaXWYTlw5TGmPWPQP3jeJHRD2vdboWze+vDIGiGbBIkU9SX/xbUMP89xh9wJP6wqFP583hCUpSQ33Am32R4kZBIUiWiKc9okpTfMKxA4JUBhtN4QYn+I6LphEznaKHxijLd/j/pvWqQ0VT+e+OhM444niwTg0mM8Jym8q+NYZo/fl1SjiTUhMwJz1A6xTWwCWFcNHlEPJoFd0HSaYYZU5Mk1x5NOUoWNEZLTlmEDKFXOOMkcr2JxJuJmB7MyDSVEH9dYsZ3hCLjNENTHCXI4LkQm4ZxnPIVTZZz8YrMnW/CiK/bpmjBZpQP8QeOsxQlA92z3ogu3MpfKkKVHokNW0NiirmaTJscchUK/ib+tVzE+l8L8zLGCebYhT5aEIFyKhgJa0A2WjJO5+ZRLDgZkSyrgh9UEXThpdHJMXTgpdmTGxvo/7HSbf+PfXBAicQsDHcEi9IsJqnNXl2ESjBi2Ddr+WwZ6hSUKKijKeaarHhOmJipDE8J41kbLiuUkBhxkk4f8f2M0gfFy5bqQ7ni5kve0MA9imJKm4ZhT21K0xuN2Nub1xLW8RzzjETOPkJ394LGMWHZciGwvV/GU8yd8JzLscDZsBR1Sr8iaQx8hqtSAjwSPpMv5AF+nZEVSfAUs2HvCdxdnV+N4GyGowcgE4jxBC0TDkh/ihJGIcU4ZsAp3IvjH2e4fqqLvkk63dPhzpb3/4kjrs93p5QD0AzCvK/INxlN8A2eCMB1SaUNopnnhqHKqbGJghYLdpyLauf54e+ghewOLYiQKLBHPgNI0D1OJGW83wEUjOQ4ovMFTXHKByGtVwbvl8MXwxcDjHtsgSPRG8MJjjjNVM9zsRJfW6g0INMNHSPrjOBE9Cp4B56uVSd8vcAjuMFqb8hHQkoi6fSt3M0jSJdJ0gPgeL5IxAOFrEVqgDIJWzDvSspWcgIAGJLKf5eW9WXLigYQkiEiKc4s/AfNIwAgczTF18skuaYJidYjGE8uKb/OMMN6K1ntFBxBL0KP5wW8UWkM6regGbcQUcjkKF7TjI/gqz999SerBU5X5Q+ewNX1xeXFq9v346v3P7x9dfH+7Ory2/F3gFN0n2Am0RF7z9AKOBV9pDji4p8/fM0q8O4RwzHQFPiMMFAnxRDuxB/if9OUZjgGMgE8X/D1sPK1aceWCzE8HMOEZmUkcAZ6ZuHF8KvhS6DpI8riCh5mWtzDq/QKK5Qs8Qj6xzM6x8fL+2XKl8fDh+U9PlYj6DdQ7evb929Ob+8ubnZFNBTHQmL+TKiWD89HtBnni9Hx8ck/vxy+/Hp48vLr4Rejr198/cJHs8vTNxe316dnF7DI6IrEmMnzMme4QCeKDsVtDRADlFbg4XRFMprK9yuUEUH7ym4ojybvuNRKD+TbjM5HlRcAE4KTWJ9ZznfXiM9GOZMb5oPwjP324ubd+Ozi/enZ2dXby7syBcxqMfKAocOCxoDYtqOvdL1zGgjGOqxzVA8h3pz+9fT99dW5nJT6Qtjx2Eu97Xf2K6iOJ/ZmOru5OL27eH9+8e3p29d372/vrm5Ov8t5LmEwQQmTcl8KVwucXry6NVJiBa59ZQWUxubBgtIEHkmSQEo53GNQx3U8bKRPG2ouko2gz7MlbuCM48vbu9PXr3OYZwLo+9vr05vbi/fXV1evIcaRnPbHGRayrhkryBsZsAXKGAY5JDajyySG+yp9zVUEx5JHwAJlcuPoYxxIyjhKEiUm1udGDQFQQ8+SmPfY6ukIyAT6cqr6QHiZ3HkrJ5OWlAOOHjADPJmIA4GmyVoA3Ggunki2j+WxIobSNsutU+KZaTXY9qk+uzl37k6B5lKccZzqU/I4Jky+EZ/Ys1TpZJLRudLHnl6P9VE2hFfrfMYEyzi7OWf5RGlgOK5AaiDH2c15pXHwIn91envx/nx8Y48wXwTyYIeYZFJ6X8tj2axMmsKMMg4LxGfVtfKjuPjlHCBCqdzeGITai4i1PiEJZkM41zSQ3QhAORGOVyg7Li4ujYvCDMEz9zaoBkKopXR3evPdxZ2AJtG+xzlRxPlAJms5X2LgAt0KNE7zDwSlGFqRdCo/YDMkhi2kW7Gs7jF/xDhVr0iMIUJZ9ZCgE72ZlbpMnCT1LYnzZSSpJ7smrES9Y8UMWvlnZfgBxNSQW2kq9qXZo58TYSV7FGRVvO3i8h0QprQYBjehqRBqWwkwoZFrh8ujHhueG2Op0d//VFWoupP5+rfxu1MFeCfzZAb8b2SF4FYd8NeC6AlFMY7hfm14xCbU+hzPpxIBt+NHr6/OTl9fv3v//dXt3fXp3fe7nRKjJjBflQWynczPsdgvyec4Sy7ShuwfOaB+0Bo4u7q8u7l6/fri5v34zel3Fx7wlrbkP8kK5WqSsE5uLq5fj89OP0IP3ivXCPpf9DudLYHIShY9IIxPeTjOFmfs1I04CTbq5c13b+66dzWYT+edR/Xu6vXbNxcb9Kjkh459mt6uLsei88AO5wP8QWpwso2oefF/rq9u7i722t33F6+vw3tISLr8MFhykjBPFyWefXF5+ur1xfvTy9PXf70bn90Cmdh8CRhOYwYopel6TpdC4EbT6o1QGejFJ99ROk0wnKYoWXMStcrB1d6Db73+m8XFzfu7v177CGUOEZxynC0ywnCuce73PCTKsXt/Pb787v348u7i5t3p66aTLdaWOnhGUpjRZcael6CLc22BM0JjEsFCSIaahkJD7SLksPS5uYQQBi+/nJXfvSEpmS/n4t3JbAh/pUuJaERTYe1XKh5O4RHjh2QtzkuGuTA0wsmfvp71mjWTHkr0nErKl1/ObJImZIVTzNh1Ru9xWb0jXEfKTwAiOp+jNK4+BhjA8ZJl6mA7vifpsVj7EU8cDbX6tfRGmsdRco4TtL7FEU1jNoIvXpTaqJnJ3/7JfpthFJPf7Tiq5rqqU1WrmW6g1Y0h5rpgU9KAraLc8GbZWMwKRAuix7WomlgWGeU0oskI7s6u9TOOsinmJWtM2ZrnMR8xLG34pxPpQ7EewSVN8TYWTsNspK5VfN3FzOn+uJGqAZ9sbfD09bEBYp/M9Okfw0bkhU9pBG1CahtLqIH78HUJtssq2mLirBvrNGyw4H4ae50LkX3b7F5Ie534/0aTncc4vD3pdmMf3iHldmYjNsAvr84vdmh2qpyXAM6PLYtcSmNcs8N9hpZRaaIzNsq6UbB8Pop1ZRiXHMpRBap0nLaXg7YfTWgmFoIEqfXCiqkfK0cvMI6lDltRSgVIyayOoF9GyEgi/VwBn2uMEkoflovdLOQvBjdnJ2GLuUZP33quDET/S4yl/EX5L0gwinEG8pwW6BGm2UI8dCP2+uL0/OLm/cXri7O78dWlvm2d+9BymF3Kf8F1RiPMmARfkFvKCuIGQRhIX2Z1HxITfvIVRDOUoYjjmlJbTywQpfteTDO8ALrkiyWvTt0thckySWS3EUqf8ny6pdVGOgCq75/9RXfJng9PIcPTZYIywB9EZ6yuCJeLVuKvFz1OOcmwkdrVQGeIFfc7UqM2nKbRjGbwy//7BUY5MJSugfFMKf0RB8ZRxplC9Zfyof1LBd4vwz/+4gcksKkCgAkVEQtK//kbziiIaaFiIDOU7eUSxmaOh4PI8ZBjxuEXNT2DCPr/ryKyDP/Y/wW+gZM9XG82kNWNj3dL4EiTxO4CESRYtn64M+nd3dPGSHaS5LeR2H14b0HeTyq95ygVETCNUrmjvUsiDxX7a5L7ZyKl7PCkCT5n9nTK7OCM2eSEqSyUX3odD5jq97+vE6aC/Q5PmP1J0XqhGNSh2OEuSbpo1iJL71KO3q0M3UF+7iY715hrg27EzV1bFB6/f/a6+ytgdfnaa8G1gKOEpiE3wd3eA3d9C+x0B+x6A7RvX098l7kGN7/KfdF24et1v1d2uFU23CmfHE75fZzy9Ytkt1P+d3mPrGC/43tkwSrEbAqd3iAm7GEwRyma4gwyLC4xsdGn9p7AmFdNwio4l/ymnPHE55LF3GPlQD4hiVzHTovcmYT7Bi0aLqtpPB+o/re0x1mATE9PYBnj1UCiC4QZzgE0g0VG5ihbq6HA44xEs8Jb3PAO4BSyZSpAS2hqsKoTpi9FiVFHM6ATQw4Y6E1MMsEO5jChQNIoWcZYAhKUwx/k32phAcMLlMnJUCtcDLw2YUPV0wj+1gNlOLzHunOzLgfwgNcja9yVW6h4AeUXMkPAyHbh0kAYRmLfcScg89INTHp+V6DNUeYBJd60IFUifWWwlCkqaYoOVNtKJ5TJxZuTvdKoTgQwTYX2/ugY8+hYuO2xo+N7Snm/jMIKpzHN3D2rdyEd6iUygn7fgcTZ66u356/+enekvQMrKAiPQDcC4s323SeULo6OY7w6nsQv1D9Ypv+Robn6RzwfqH/MY/3mPu636bRkRopbzJu5RHcllvqojWnsTUFlYAcgoCLbb11KJ9tivKXmqUAoiCadtUpPqgEW9RPHyLeCq9IUUJKAsIVlKeaYyfasZwnNa7qER8mUE/KAgVMlTOUeQNJ/ldG56oodAUPrvOcCkpYWVtj42wLiXLCv+Eh2EakuBPGAzyhT30suvczZ2BP58FavgWGvLAw+ATycDnMYRU8SEyXrDMBKPXEsXkxFbHr/G5NKZCCe9XOIgjIRTzRQ8Q7+LP5fztNfugGzcS/mywNjBE4gXbWGgl1eYv5Is4cSp3ki38D1uCT5RzLBgeBLS3mUktuz23GeKOFxpuMLLs/f5GBMbI9oUEj85rXo5Hp8Xuq6SZFZW62NF+1a69GL4ddDWypD2bQWaD1YffNl5S6HYYIRX2Z4MEXcEfKk1jN+hLdvx+eAkinNCJ/Nq6F5g4ENhn3T/+767pWwmovP+r4+odqnlM1ll9Ob6zObwkO4M09r0epPjEyPOPzLyclX0skJ7nNuoE31plPCIFpmGU65cD9P4TRZzJA6kkpAHaM6vR7f1q+ZodoMhqNlRvj6jKYcf+Dl2VmYrBvxqOrProy+b8SyZ251SC7C2r+5+EApM6TscOyT6CrfseW9+iqwfYZRfJUm65HfD1/IfQ3YZcv0uNbEfLvIaDTPU3g0DE807I5ajFdt0H2YCbeQWvRF+VM7ksA+2WwwKkKnA6BaxJDDfygXVoCk+oJxef4GCCtCDfPjcIGY3n+iyblJ0VWBiFh4DPMn9M0Qg5EcXjTQSOfIwpKJ+3tMH6UiSjIYrRgRQ8/588fwiglyenlSdorOgzIfparFCkGTwZUyEY+O/nHGUD8BonSBuQLlCGTgdglKcCi2jkT7dvx6y3C0W/Ibfna/5pg9r4TWCYyaRuRC5Xb8Hz7v9ZMX//zFP3958vXLL2t+Y7cWodPl/B5nApUSYUp4tKLRFD/zYj+O3VK343jeT+N5f2fGG53LrS7D1I6HyKhgysg26GAazw0pU4kdU0az4RBR15hzs296xXRL5gry0IBnnAKKpCJVPZarjk7k2SOenog/RO/PBfuUMaY1ViHD7sSBo2AMZJ5F8Z3SWqE0loH+KrdcL+SUaxxu7bTzDbXhoHsiF7s43yzWQjQRcAyMqsvLI1b3F00jzTF11KtsUgKKMmz8KXEMiIv7FicmQUYtqrlxmKET2nAmN8JvOqArfV1lZ4UvdesBHtyr+noXnjdiI23udFP5ulXbuXcXm1onXVD6aA41Diy7kQ4+pftMIeLXcGq+dprmtXtn6GVoh2FA4urmeHzM54tjg+eAxQ8D0ee6/Rj8sukUPCmfkRNEkmWG72YZZjOaxCM4aRLMDd1/PL07+/7TuTAbND5JeiFv2ihc2hzimHDLWJ9BUijLan1zKmJZfVQcQb++adxe92evL04v316//7erV3sKVd1zxhtxhTJD3CjdTbdsN14L+edgFffbxKN5LDD4MxS28Y99F6D9z8NVOM/6OwhPYYoWixEUH+rsvm0CS+sHfqllJ0F2raKPd1jdZJMG6mxEoU8qnPhRcskm1cW0jd+ZU3mOEkYTOuWU8RhnWV23/rLy6OVf/vHkd+DMViByev5mfHsrXJJ+vHj1/dXVD03Hmm8T9wNBf3s6fv32RmRwez0++6unk28RSfp/rx5P3d2VKrT2uyu5vZWqnwd7K+3AO+uzcHiqEODzCpyRiQwWq+2C3d1AgqI7Aj7d2d3e19cWiH60G78f963IDJ9ZEPzAkVxs82h4G7CmwCES/hAJ/z8sEv73Guj+PzwF+O8o79ZHyH/2dxNJcYie2PI6UjnUO0dPVL//fUVPVLDf62WiXsosr5Gkgx1koIQpRXWeVzEr3TdQmlKuCmCNeoowJkRyMMWpPA7vlySJcVYSQVcvhi+H/9ID5fpAaHpH5phxNF/kCi7tAMA4zRaUJpGqksSG1Tpl+YUBxbFEECXXGRE87Ux4FaR54rJ/u726VBx6qLwyhzOMEj5bj4XuOI1yB9sYsygjslDWCO5Kzhv6C4hEHmKRhLj6rcL6ezdgdWkQuBlvTBdWxSqIO2BmfdWG3bW/gzAMY8xI1gk7/UUbZuduwC1Y5UJHbTG5EDudYoHRmcFEl98q4XE6xaWutbP6VJXeqq1A9ZleaHr3uMCLwmo/OF+/JkwdW4tkmaHEse7lWzajGb8s+gIYQMQWirkIRzTB0Osf90CdJtkKv00fUvqYfivEFVbEtbCIinFeGqkt7kmvTauC3H/9dw/AFMmjaRG+dHo9fvfFbTTDczRy0bs6VP1WBlzVX6rwrLx03bDIKbjAGSe4pJnO+Vn+rNL3U4GcagOyDqM+yjQfwjEwiXguYWZYH7t1kxSdAEqByrJwQ+nHjTNmYqxMKs8MR3Sakt9yyLlvi9ANMF5h5ar+pZInjkCcqnO0hgyLPmCZWtBkEzaENzTDQNIJHYG4s7HR8fGUcMPBxbG0FIkSjyUfJvdLTjNRHXeFk2NGpgOURTPCcSRckI/Rggwk4qlk4MN5/CSf8KcWpmoPqLM2fyyXuZfuYpUDYYD0Zwr/grwm8f7Nxe1dPtlyCso0l9QuPmMF4QWhSDrBmfxKmQuVlKLq0eqIZoIrjrhseT8nnOWBzcDpEM7kOSblJmlliYcwTuEMzXFyhhjeO9kFhdlAkLSd8FXVUtFQUSt/bGt2Wnel8FU0gZXiQym3ImezEjnN3FkPXXtV/NDyw03OT0pvqhzaaghIe6UK5ddSBqSbN3kZkHvpYlmBCFBkypYXR1PIwdJaDSvfCMlDXA0czt3+YSnGLtZU/XlZKPF/L34oXV9N3K8ABo4z0N+qsmLs3wJxwXRG8P+e/d9/+tvg+f969uynF4N/+fmfnv3fofzHH5//r+d/M3/80/Pnz5799MOb7+6uL34mz//2U7qcP6i//vbsJ3zxcyCQ58//1x+c6HwYFOFSA5LygfCrkdg756DOaF5LyltnisqR8EHmW0Zzoz6orR73FKi7gnO7F3iKHR/TiInNHuEFZ8elSrDHKrRhoLsc5F0O8oV3/NTRvXMPF9tMcavDEvv4S+xG0766yEi60SIzbGsI4wnksAkDOifcaDBRwaWOgHCj2XBD5BT0NiATdRcmTNzqExIREZFkueZTPsPZI2FYfIRSIPNFgudG3hkoGSl29iJP8M9ua3hfCb7ffMyIY43Vzjz5nfyXUCbSJTMRj1KZQupbs4iyd4YTABCO544NWcPFeQQLdICmEokcB92ng1J+LJoPMAAhUtwgEusy0o4WFZTPSx8YxDNEYnVPgtLUi8E4YYL8bCrUL3KwQ2crDw0daOUomR3EtGu5ym1AJ4AsJD0QQdJeeZbTxNNo0cJtxe8+odHDuXJr97dqGV+T+Gauyq+KjkoDbwQJNlkkqiUXfAGIccKXHLMS1YaNUEPoUqOONDa2NK/Q4FX5a7MCi8KZWHXQChX0qIetLVvPvuIXoQWKRMr6boM605+Z0RgwG44IxvIwYGvG8RymOMVZLdLIs/zniEth4E9fBpKlWXCwEHtN0oeOZDlXXxmqxHgFifhbbNPSwt3dFJpzug3TQXUZ99q790h51WYoy9C6tyl6JcRYr6mjBnyaMXGF4W8qnQbMTWlNXFp9m4Wh/Cx0jJGde4xT7VDi6RrJoRRHbq5OJel0CDea2MrM19uAkAKoSh8UcLRe542LBa/LpAnc1MGqBokWi4T4Z5fmh5gRIoa9zXh20+19N/f4hu2gbvgb3OgD7/ZdDy//fX+zhR90QatfwsI4btCl7bO9vm11kduN1qAFr2Ilf1L9QeczpkmncFjGv4dlHKqZaBNVvSy5SUfRtgY8Gow2bUUL2M66DK23aAH7O9iwAY0EBtqVI/CIfnpWfFI42diA4Gqhtj8MBr2mhVlML51M4JoyRgQoZbiCESS/HYk3T1sG2Li5S0qKO9E+bJjn1e+MXKUutaLn4TZ4LTJCZR6eBDHWfJUtC3rV78SeyJe4Ee6UGEea7ja5TDSeQIInXHlLHpmqhI5+mIYeN22NZ7cYO21AQx/g5wKDe8pnDVBRhg1+KouwggGqanAIZoHCXMDMZR1l2r0JtDmvOIiwh7M/gHEfRNiDCHsQYW3J5yDC/h2IsBnNE19IEofKsTdX5e+UgKefPFvgLBJUnWIZb/K87Vws9IAy/ZJMyzoXyjO5mCpdPfuH53qdNoClEwXPpFGVvmYyZatKlyZfSo2lrvQtOhb9Nk375dXdxQhOnFiJHjSokxcvVCfag1vLiw2ADZYlFWqR/hJIoV3VfXz91T8M4WUTsjUE5arO4byAP39Tb/Pnbxognrx4MXy6GxmxmX/zGYkecq/ZwCV5V/qolP+URA8lBXN+x2oY7GBQ4j92QnLfoO4pTTBKfYOiCc5s1+32ERVfHJUuKkeFlnlB46fMhj3sbWzzrPkTL2gsL0IWJvK6oJMfy3uSetNiCELpGjhSXoKVKAeekUWC4c8PeH2k/DLxZIIj/heV6bGZCc5wEeuRZ535s/nXX5q2R6jVVGEzarWyWaS7kJ8ASWMSCdKoccrhK2jAqcK73QJ6Ie5tMMcoZeoTITGWgLEh/KgSQJrV0QpUC51GV4AyDJdUuBTHywQfwXWGJzgrngBK2y2Yl/TiA46WvPXcDBbIRFb6LmT/AedWXEWfB7xW600+ypdwO3lyNcCwkf4PeM3kWdBu95ejEdjpe3i+WOdLJv1gLz4Qxtm/mqjM+T1Jw5BVqHFqYWemNY3lnxLNXU2KQbzTzFzpjyzHYkACr6dM1RARjGtGFq2D1fY1JZ/BO+Edn2Ok1rGioxz7xa9LlLTvsHOLy6tPDBAxXb8uyQolOJV79pEkcYSyIHO+cTI3GRiR5KYRSnOWWawk1i5wAyCVfDKSMWSCr0xptt7ZvBbbw4QxdZngu+rX9kzLs0pGSLWOkU5Appksb1h4ptIfm71CJ4aP5kynnedZor/0XxeGZSvi+XlxkhUcpH3xFEGQ8sZCmMrNi/mRTtlstqeCHcQpZNdiCeEVzuBZTCVM4VXAnw/hP3BG5fJO8RRxsjIa31awRvDjyl8NEIMX8EyCBTKf45ggjpP1c6MrUz4sw97uHFfCr+5ySJ1W4DvxRZn9SyBdeX8umlDD2QteTZjmDEcFF/K7BpZ/97kKtliI/ynWM8rjQtUu3dGODrofdhLZm5xjHjPC8ZkQCrtaCn6sf2lmUQKFSLzbidmgzYNnUDd3bOJ5UtCik3vnj47PvJRo8Ao8eG4ePDcPnpsHz82D52Y4ervx3Aw4TZuQbUKzcjQxZxPbN7TXAXsfUq1G9sq681m+PRZ2eftxsF2j1mJ5Jg7xgMlgDbWzS4gB4kWrRESA1iAaqdyVNKNxoWdB4ZN65B1DKOsz5LNPGzm0AIN4xWW1XBDEAVplbRJyBMcpcApnt9djC8ghMvMQmenQEB4iMw9L7BCZWf39ziIzGwxPoeYmc6K5TU41PMcT+3X9uNKakO7HUkhoZ1fDleAFLkpvZK4qmaQcUIOMVM3XwiaD1KZmKGXm6DVZGEKNTxXjkgdmiMmpzaTUwn695qONjEatgT/hpiKPKchH/RYDUbMByEf+RrNQC2GbTUAbGX7Khh0P0kHmHr85xwPVa+QJM+I0EDg37XQx3bTQPtBMs4lxxja+tLowhJhkKiYXD8wwQ4zb0OIB2Wh+aTaveCD6jC7BRhUP3ABTS7t+qU1YbTCmbGJCsU0knmF1MJy4DCMeqB3MJY27qEGGarz2uvUkTmgqb194MijZvLotVQYzQCtEJFJA7xnOVg6ZS7KYs9vrs6d5YWSJQomV+qQKwRJIu3R4ZsNVCf2uzzaRz6qDPzP9V24VeUdqaK7rHgcEEc7EWgWZhKyrLJUgxu8ylDJi0hgG7JPXiPGcUxbkA54DwrHKj0ZTrJeCl6ECSuW6b978MeJ4ILrc5MwQg1TFbAIHeCcXXzFIwqxRPiKWJ23bH85zzBiahiB7CrPlHKXSf1PuEv2pkXtltWBtu0H3dMkbsqIUM7jR4ZxhxGgagPPdDOvGufUsJ/BTpmm/HS51DuTB5TYvmF9C40guXjqBu0wIQN+ihOEj0NksNxNdvEbhMm3Wi5y9FOhs0GOzPt27LZVaZ8+HBkA1pWuLhrncODfg5flexb35GXuuMzybk9XFDU0m2mHPvW1Jyr942X2ELvGjmu63cYzVFL4tY0QZNvA/wkhcKYIbR+NK+ZuLVrVRmdzjPccJJ3el70zfy3CdS11ncD1XnNQr2bwrNStXGTBcWLC85WKaoTgsiyWnb1XrRoqPJ3BaNLW88cXIlTu+bUQRDTQS9Uuy6HOOOIlQkqxlpnS65NKJeY7SJUqAcVwzwvl9wPVeD9niZo1ocufFmx+RupxqlKE4NXjmcLtYJCjNgYRau9xHhuuwENnDCiFNnR6ZuFtGJCFIC0NkOsViRI8znDqNXZos8l5lVrgZN8qwMmrVrcRNEp0GM2rTZBthtkJtOsnXR6/jaRPjBU5jIbvrFRi3InFe+8QirNksjzMsxEOpUIhmJIkz7HZiU+46GntJP7O8gdOc1vlIM8/9uTmSoV2UrEnKRfOc/xnRWS8eIVEKwGDkyt5mQmWr/b1xAhskztKY3hjxkgHyip4TYHSOAWcZzYBG0TJjvc7ii0+YrMRHi2aGtCjigj1VZEuFhjw8umIhP2pFQrCFfHrze1uZI3Tr2SNuOR7XxbyB1Eb3nF8UBRFOir/yigUqGb18Bbo8F45Ly0kHaulnBcdEkTDy4Piympe+3y+lmZd/Wjdu+OnnnoKK43cGG/Hw76CCBDGCz25KSIgsCDPK7No+ZQGSMi5R0Gk7czwgRwSYNi/E5eoRZai1ZekqyWC8zYYiVM9Xj6EwiOp1AzLZv5QobOeWvJ7KPcYpLGZrpkSPfEkLm0qEeAXv08pT4xdTVDDvNpRJhnH7UJaqHpBorIdTaKisQZUw/baA3A0lTjlKnDiJF3WsFEIkraNg3AW7oCEY+1WarF0IjMXJLUxerJjHcvBqqfubMqj6MdtSmeRG10bsOc+AXG+Y0qHkvKpxraZRlSjX3g7CCpPoG1gn5PQ34Uh+7+ykDUHBLookgEOfa7cPVymoaFWEOtXUBq5hZ8HacP8tZojhgCVmZGRFQINYDaFbW7PRjMB2hVzMxXZflVxK8B2lXMx7fy0XUqpr4ynmQnzFXEjR/2dQzcWMtlTOReIKpUPuUMrlUMoFDqVc9ljKpZo3nkyknUHpD3IvXpuD1PZkkx6jOTH8linhncZ9fzL4EHtetzAiLxKeHBnN9rv2oKHwcKjgQCEzNA9Q2FGIUMegqbawoI0CggLCfYKiREJCfLoH9wRgB93DekIDesJCeQKCeHYevhOUhq9TTExDsM5WCc2bbXSNQTkbBLQY7UGzHUo3qu6JwrXXrg3iDXxsrVLinaSmFO9hDu4N87+PhO5bpHJvrCbjTt++ZeJ2LVO6o448KdubOHFbmvYtC625d9TGqdlbVeRth05zFstuif9aU/6FJ/sLTPP3GSb42zC1315yU35GWSlbT5W2TJSHpfhplmLXEKGAxdg5TKghA1pwTklnqJAXcEA2yc91KzW+bklwvkVq89Y4rs5JzZvN8yGJzHeRwrwRi6C05VskLPfn4NgwVfkmCcmd/esk5VulIt/Ovp8FS2m7qIUL5Xq4jcnGD0LZQSg7CGWHpXgQyg5CWcjrkJTd2yTrVueJL7ZjgzTdJhm3E2T3BN12Gm4nyO6puUsJuJ0wuyTlriHgzyLQmo7bm3Q7UBry8cb2FNv7Sa4dmFa72Q21JZX2fpJoN1iS9pE4e/OU2Q0BvN2TZbdbodoSZO8lJwHsKS8B7Cg3AYSZYRpTXO8jTwFslaugAWhwQutKvoKmeeiayjqA4O3pq7fJX9AwmC4pq+0cBg0gA5NVO/IYNBG9JU111zTUAXPSIfX0NnkNmnZE53TTAeIndEk0bec3aEx+GpRiup7joAFmSHJpR56DBohbpZUOM7+HXPxakkhvk/ughbtulP+giblumjK6dfe13EMChUy3cT8wKfTO00E3DNrvb9Ce/NlLqpCEzztO9Xzwyzr4ZR38sjY/Gg5+WZ/CL2uDRAluRBrTIXsTIW+SqUcHPBMT5KPtkeorzHbj8evbhe6dV9bhRsVji68XcQEdXJlERNeo59RE+PTwIRr4Vt172UXKG9GVa2vQhOMM8IcoWcZuiIWntxRmJU9foIw41QmfldK/s7pfBsh98lm7Xc6tKcvXJElNlm+5YeVETOs79u9hHoRy+DPaPCaGVNH9mdgFhLN8Wzw3OVd93hfWRhMKpTVJp7YnxxFgHg2HR3930/jbpF2j/B/f3hpmfMpVyApmhSSK4pU4AWJpVsrm9Wgo85PZqLSjaoWLb+IEQKdiwt8yv/DQYlANNacOQgSZch6IArc8U0ERGi0ZvDH29fU4knXfL7XSlC3nxvlCu73kOkJjdNe5NYYqnZmC6gWpTxmpolEIapVPnhcrX/uyo4guCGbWpPiv71OyErsHwa9LlHLl7EIZzrTO0KKE3KuFs3Lkya5sfgzjIXxPH4WOR6XGppgBScW5aM5Nm1R5tKfvYvO5Wb877942+Xlg75JuZtcmzYEVN28/tmPX7edLV+cDwXpCNQ5bpGwcy+QDhs9slr/xepznbJQKmqa8jWMQy7nn2MSHvI2HvI2HvI3V3yFv40fL2+gNEyvnmwjJWmjaVlihPAEgMue7BgzvRJKbOqo5EH3Z9V7imzL+NeQwnCHWHPr2VPkuiHaGf2vVq45Hl75URzAj09lAlloCtpzPUVa/VlvJKcyBoAalAqyehgbDOTKghOZdDJuTtrmoJCfZ8ZyYBDONYzKpY4AoNyrtKqWeORO5+bxnfNo0dxoX89afhca0qOTJgd0mkdQ3Bp01xAAZHnJHHnJHHnJHHnJHHnJHHnJHhoh8h9yRv5PckSspkilX+x3ljqxkFdQzEZBzTeGS62e3zigYmutN91vyqy5dfT5irjclImvRbx/J3lQHKo+DK9mb/d6d7K20ZPzJ3laRK9eb/fGnz/VmD9aR680sSPXe9GqSjPQAAAAAEINI7ujCEO0AL88hHbQlOb5aG+m01FmvOFYS0UkOkkFCHjDEeJHQtQixOQK2iqRp6JB77pB77pB7bqPcc4vlfULYzDtJ1+p9YXAU48v3Jqc6ykTsR7n49S6u+pKrrD1DIEM8lJmAxrG9c5tuS6lsPWpLLTQ+L3DUg2rDtQISCtwNvmEX0g2T+hleg+p5/Xz8VwmQlXqrlsqk/JmrHm5Xr6CwIFqvrbfdytti3/2MzIQdDYRuf6pK0EB+DdIzZVSZyzTGmXSEqGsOrCkeBtvwmCVaTZrteLYUNoEZUpha/gXKQqJzkp0VkM8SROauevhaXpD8t1O6K+fh7cT69HpsK0eUAm2Che6kc+IMGUQhBdnWWNfxRHWTybOKAoIFwREuyQEyWa6MYBUPHSDBxIeq9rpUsULOkh0EbwOkZD0pax9/R515zgAA1EWPMXXJ1eLSMpqBUlELNiLvwsM5SskEMz4scnn99PLnoRPkt1R4wiGllyeKyvnpK4mt1JWSEDk8nYC+5w0A0gN+lAPl6AED1QNdYin0jaAvM2wXKP6XkO3/2+208UwtzL5o0leI5QKW7ducQ9ORbUYP6M2ChsVp/BxoJsaeUgtAqt2n8wDAGsI/vfzZg22ZTkDSGH+Al8oEIL1N4ufCq0RAX6ccfZAbakYZTmVItBtbCjO0wkq384iTJI9Ef0RrpfFQ06V8RUSMVVl0dUK9uzq/GimsxBKapiY8aEJSpFxiykFIbCmhAacQzVA6dRt55UgxTJZC8Bl2TrZTlTEbZM0qY/hEElvQsNKQhD1PL6313DispjwDdIWzFcGPx480eyDpdCAW4kCtBHYsb9HHT+R/NhqFvLiGDUU2/RjjEf2w46ebpvUJPZWe3mrJrvqlDJmU8XT6RllwT7eHMYoVy0Xpeu/LVhBSmh2i9UDr3AYojcW/GWFcPO9MuSUJ2KRvx+cfZzEvSecdGSJa3copDpWuVOtyUJFaJFq6Ezvif7MULW5nlKfIYUoQkc5IhzoPQZ0SJnhyQpIE67QZZKKDORFEibwnaLXcMNg0ThMSrVvSo4omxXjUIPLLTX59UbCk7hFlU+xI8ZrGeb2ODtLiIji/hAZ+OpF657XTBJvfoWxFpJsbMEixumDeY4gJU3sOx4CijDKrYku5QkT4yMAEed2S3/CoFxraJVrnmXaVK6ICA0y+SWG+TLjI7tAUxPbVyUu4X3PMLKBGzmFWGJaEqXev+3ZS/O7MV/KjKFesKClBLNoIW4CKakXakdzv/8ooEKsmqZxXxPOLtF720rKnfHV1HHSBTlOssIbDgDD4En6QZBnCabqGBX1UFTZfyluORbWGqPqTl19rIECYEux9NGvy+ChvXv9VG6oLP2gp7WizdN0wjmIjfudkkUkoNk4/qsAApwua0Om6kVY+63G7a2+FKp2zKqnPA4ymsp0QI2zVQbnSgqXh8rDNCvk3YD8tuTnB5bz4WyUhJ0qmNCN8Ns+fYgbZMmVAJx6YAL/hjHqzcLSGBvy2osmPNHvAWVj47/hKt7apXZTP5TNhQdehvFhlZ/CvSqVYhvEV2wz9kBWkQpUnNCAtV/kDEHmfSmdTod+hk6oxxtEzE8lApDeTaguE2c5bQFMt4UqRR3USABWwyF3wdIuUSNWBVvN01EfrS+nQztXato2KZ2+Oyq7lmL9Eqtca1la9wQZKluipyMk2z9nQzAgBBvkIN0n50BQWrKTD9pRbslkwh5TnihtVCQhM9No23BJ1OWCvaVwcrqWUYSYjrMZMJg7zQMz79GdjakNatZHaTByfL8WK0EmoSDodq3wy6rHKS9NwFDiPA1MOM1NXFNUVcJq/EANkDRBBNBZ2Gc2AGeKETVSiF0Nxkb9RnzeWOq4R6P3aUlsdwf2SA+HSdBjNKGVYG4NUnytCE9TE9AFAOcVnMKdZLhFbaCm5swBJGMwp440Q83nJ/VNTVdhT/jGV+nXGhYO06PARk+mMs6NGkNIUJ2RakQfRQmeOMZfpXw3qLF8EhhkIdXIzBZ6Z3WNMqEc5I6muLOfUNaMuLIfPj/JEyUiO+34NhMvMOdJsnNHlVFEHJwEI04m1CFR0XyzivaGviNk3GmTRlVZ9pzTGjUBnSOpvmUmdZGXVi2iWYbYQ/lXpFBYFC7jD2fxfmzeB7voZe14sAeElb1aAYRtiVRUrZ9iY6cl7tHq3s15kUm9cLBY1xhh+lG9xfF0eWWMX2vKi8pShOMYxLHA2UEuTwoSksWNcLSA1pVp2Vztn1C3Lw2lrXpNMTBrbUwGpWPkcZ/MjQIzRiLQkJjG/fObLK0nNyrAVQPiQAUDV6fGVCQoY+KmCAL8ucbYGoaIDBAxz24+5Zc9X7AWEQYSYOjKGQR92GzGY/JUXBVMK/a4y+CoYIAyQ9Dpruvd4JkGXRaJZiRcPdbBYIHcu/1CG4fTyvJlDdOYXAavBNZQOMEERModi8itp7aP0hToC1AmiSHKquH9aJFSTgJUIyVoSLzohSoDaN6vDt90XbN5jtw8q0/NQJP9U82SSf3YEqk6qfHqK6hHDjoBa7yi+X3v+zQByUH9Wzo5goZbFM2eCenWojJ2dwZYzfI7TI7ikXPynSPbZGeY5xeyScgnho02YIsNW06VASNaQqoulqojfGZcCH2eKxXEKNNN03mhjKNgaRZP9M6XpQCZoHHaG6U0DCTQrzeYO0VWoSrtTZ6AKknKnTYRrM8TyZqDcQUWqVxJ1BjrH2RTDQqZ07vhx5zNty7XerPzw/9oUMu7fQHCsjl+YpdTrOiSvumdXdJAi1WtZPDKcDN3qgexopuuSoEJbMiiYowXQCfxXnlv9v7vIB4hkwvokIwwSbEMxPjVWhx0AC6xqCZ5Rai7SAuOqTNuFpzwK5yWxHAtHof4DXvePqvyrA8z+OO0faQ+kCq/KJUKvt5QHpITSr4vWmwjMnfdFxw8Kp5YNbmfFx5ZhV6ksile98KOidFu05D54ZjQgaCpmhD//10CoImwLaKbOG3V1Unmo+8ZH7ikrcO33ds7vO+/9bjzNGCt/wOsN5u9O08AuA0wHCY2kzvuZ0TI8Dxyrirgtg0g5GeRwCt1Dq8K2wrJNREd5hWhmFaatda35I20UsVCWPorK5RFJI2MaOnMANDUqX8WqJCMwCi6JuqJA+Ilqza+lBUTa6XKtOjMGs0CY4js6se5Y0rMmlieLHq+pvGB1HwhcO3aaImC9ne+RLlLMoNMAOnBOpSzrqMRTH1nKOrUYSuu7pJPrBRzlZbXikdkSmXCHgZOBKMvTCibERaSLs0jXqRpUh9HaXpGyt4PZDGG3PgPElqYtfe8y3LEsKuQ8zViZGgerjQ86o8RcuS5Z5hfhtWQKCcXKiubOjVD87gu7Www0NW7wpoyWF/NGoLVRacwjjBjWbj0GezrHKutaI0R99RPDwmYG4BkeTocQL7GOrKCx5r4q/u75UauZRCf8FrY8msn/CMLyTLBE5c6/lIlTVAGJNtWeQEA6dxHONAl/1NlaMiynzfjT5Tano1agibqiTyRAVuYcEksUzaobS/TVCFQGjTJ5JhwpQ5/MjIuzOcvv7sp6SnZrGDovYtqwrsQWM3gmjupELBXKcM4pey0MqiYnKO3ZChtTnHL0ysWAZ+z581aYsoRRmLjkTz1i/1rEJKdo0ms7bqqCS4BI0nbUaYEF/mydpX/ZWhhRuzIXQ4opJ2FCV7iOu6MJaj/mpw1MT+EjhI1NTuHmpqC1Yn6BpqZWblT8OtyaO13Pgs1LXbQK7aal3FwUDrPBrLShqWgTM1FHE1GYeaizaWxL09BG+rhNTEJ7MQftwRQUYAba2KyzEbG7m3P2Y8rZhxknwITjqxjbBHLX5psQ001nEwS0mG02NcNsYILZaF1uYnLobnbpZnLpbG7ZUKUcOubOJpZtzCudZ3FPZpW9mFT2aE4JNqUoA0mwZrHNjJIbRwIhbmtC6bTWOzTuYjbZi8lk9+aSXZtKgnlypz0czos6mUb2ZRbZvUmkgznENnSEOIYGmUIKjUIvlM00mEFcBo6g1e/ROniNG70w0cRr/uhs2Ahe1eFa8lBjxs5U362A0PLDTZ5RMCRk5dT6IHc3N+74uUbFnbDK/ukqvTImZ1BEuQAjMYYIZUWKFbZNgcyEiGxuo94uhZeW8kb5ZAebWQJLHQHA51g9BwA2q6HjXF9PX8sZqyTTn6MPZL6cWzWM9JJr2Zj5QtWbvmtKiVIs2fEcpWiKB7rrQQ5+kK9VZ16RTrva5B08LNnfz5K90XNWXbQk3WrRZnnMyHgCeR+EAZ0TLpOq0czOttUS9MVNwgMGnILeZmSSR6LhDyJslPBkbQUiNoIsyoWra40s2zE32VHzxFNSerC3XiPQz3FbtjZJlpuFmjOsg3w9geYow6Cz2XhHoLLyrbCwcXgXbUhR4/bdP6cpadCglnPQq7aAU5F2ngHNICZM/duKbMUfFjTjnv4AQAoEEco2TOYApSK9o94uGGrXqoSXFgYl/bmeZJO2R9Rra8oZosRhLbuLGQVbbtKmfiHgm2CzhhSBAWt6kREqStmeJYixpjj2SmGcylelwGb7jtvoE2GNbDyBBE+4utYeQUpzzCASnQBhGmy8cbIIuXXO8cKd+LE2yH/Pmxc7WIJQqXAQB3J7djv2ZTkyP3XhUSKqDu2dUjGB4ytdOEj6HDiyFHcYms4GIBD/FkWhu/em+pVt9DDSWM60GqQZCcbiZloSAy6TKKY6R2DBEPbKv7JOV50u9xy499/oavec/Fw63Gz+J4mJh5vN4WZzuNkcbja/q5sNpwnOEG/ySCqr3ov2R9vktLH67W1knqhlrRCypJA5LRSBMF0ZQEkkutNGDTZK1yBoz3XOFCuzB8+kB+ifc9PhkSru/RdYsmZOkpsXpX7eGO/+bP71l2FD2p4QPqnw6ODNeSE/MDVy8yubGLaCBZwqjNsU6UoRrwxR8gPlkGqBYtqZNnBvgjnzCislhkuqvbrxEVzL/CDFkwA3rUuqvL/xMEAv33outPpYlUj9Q+FVpahie1VZ+6CNKIUnVSPNH/A694dpAan9vfTFK1+Wxo9EOan8qykAPb/X6WhboCq0OLUwMxOpK+tLFHcxEWEuX6XZuPI7eZUct1ptl4UdXTt0VZy0LM+si1+XKBm2QDy3jjj1gQFR8zB4JEkcoaxt0cusppJNAaP6hia5ZITSnBUWK4e15yxR+eRJJIpegeAbU5qtdzKTxTa4xaI0XaeURdVvaxn2cEakL3PbpJqikAU28Exd4s2eoBPDH3OW0sbNLFmjlEKfqOCU58WpVPCItsXyam1EoiOd/lkWKsX8yCSk0ptQQQ7gBbJbsWTwCmfwLKYSooyeeD6E/8AZlUs5xVPlqK+2dKtrutJ8cV0lBDF4Ac8kUCDzOY4J4jhZPzfWQhXU0Tz4EE2BvfTa7wRyKB3W2zvRvszSJYjq0gmzlnNquLXDQ/Co4DKWr4Ni2MHL7j/F2kWQYVmsTu/FHezaYPHTZ8FuranueOVNBe4u311lBTKPIkrK5Ze0OC6WlrNIcFGyp1yFqZSbeIEzlUI/T0mtL8Q1kPdrWDKcDS0sCykPUIbF3lhyoUrvUnbYU+YJOl27G6/bYdfsgOv1Z3at3uA63VoCyiw0My1F5t2WxPPq5mxcb806lan9FX8QAooSr4i8JKpAPFMWzlOHpVwtTRZxrpZfFOUI2IzyI9VbrPtmww3zF58J3UFoGmzZ2Em/ctkzP+WkQl2chiwPn7QK+sn6ixnQtNi0LEKJeH8c00dPOetFcdWVvpHCcif/UbwxYR26SGcDtdy7xu9cNKhWi7Vf2TQOY52+mv3OTga6KoSvgn9ribxaGXdXCfcKbzb1160MzscKUF5Fup7it8TIj2BBF0u1zI0DUl5ZeHiolffZ1MoLK5DXuRSeqWk96gWpkpqL5p4ZaIWDtCmIr6rk+YqZ04lap75M3ovGM1hUob/O6L23ur2jvr26uTzKOs/3ODYLX6HvlvRCitm3yoEC17sMpYyYQtCdEC6hCTwHpOsJAk1xuVh19SeVt1LQ3eMgG4ryV0b2vajEP6hX4ldKNrGgywtIkM83spwYw01w9hfu95buR3CfETwpqt5q6EpxkNsHen6t6QSRZJltRGP5uh3bM31CFFuzstf0OSBvVA2FNgEAwCGxEwb3WGXDZrLsMtdJAKwe9Z3WA1PXT3l6IwHccpRxHD8FzKPuZPGLBgADs7qc79T0e4+ZXgdxzneDkwXom+9gooXvjJefVyrDK7bboW6Vuz5GrehCtVREjoMpvMBKlRf8lVacBRc8Z0trvbEqSZ1zoOueniu24ZWn3pWaKTEt1vokw3KECLVcTDNUigvwnUVoyelb1bqRvuMJnBZNnRWEVM03dciLBhqJuvZS9CnEvkgm7hABCIJJCs4yR6kQFRjHC9YLdRDTtVAb0T9XbYwyR5Nbu21heERK4apRBprZMmX9YE9QmgMJXcZ1UdknLoukGApP9Y3KcSBMiyQhuviVqXQqVnTqVGZossh7i9kJZtwmPw2r1/xoVD0oMKNeIPuuUNtK1dC19mCMFziNxa7WK7C9FOF57ROLsGazPM6wkCqkclxeljPsuSJOAOXYS/qZ5Q2c5rTOR+pLO+JfxUbMeivvsT4RqyZgFc1zRaWRuPTieURMAgZzRe5tJj+JqDQh7nhVJY0T2CBclb1NVTslqMyEnAV1OWuiVCE4y2gGNIqWGeteBtQnOHnEptJNRj40d1WFhu+S0IiF/KgVCcEW8umVn9Q5wk4qYToe12WTgbTv2n+WJfdB5TDrOWHrNiNYnRR/aZYzUJVz1SsAAIazFY5LC0/IgmI9qWcFbxUlsxccx7IU7aiXVxeGvoqTXCTLDCX6z/xOwkbw0889BRXH7ww24mFvMBj0rCrmgBYEf+A4lU1MwdbVyT3m6KSn+jpbMk7nxu1QJlSS3fSM8k5imqaU2w4ZhQZjMMXpUNy+75ckiXEme8gJ9mL4cvgvAntdCVFsfsbRfDGSe7SnCw8reVmJOqZc51A+G9IFTvG9QLwn5lJ0P83ocjGC2nsFrERJS5K7LpRHIkz1B/f714TxEvEdmPUAANiMZtyaOYABRKuFekdSadaofy36lzJftsJv04eUPqbfyiIkI5ighOEeAIuoWH15feK4B5YuT3UmBn16PX73hXA3mKNRz7ETa0Mr7kuAKkaGLHfepllFn5XLn+XyVK6j110/v+zcJbBWbXSUaOnYxcqnfI7yAjm5iFznHKUK4nArSJoxq4D9Cmdccp5pSn7LIee12WUinPK5J/OYpSgxFmqUxjKLW4ZFH7BMLWgmyceeiyPndH/aa+GW1cLkjoLkqoAFV0p8gX9BXhMbfHNxe5dPdt1dPi+/rz9jBeEFoUgqa2xJk3JG5xIiTmOZkE/+4XArZ8v7uSqApl3yhPHxLK/RqgWBIYzTQg3wqUqpOwlvM8rGQ8vwL/fBucBRviWq+1MMVK73qunBEfDKqVUXsdq+vImbZOgwm2b3aq1OJAAAYNuyxr6RQFs5421KGfscOLYoYywcB/01mXdawnhP5Ys3Ll0sShQ7IQaULW7LR9ts7QgoVfypa3oHlyjuVp646ZrXZAhsLkns1d55ShHvpwyxj9c0Kg6aSw9vVXbYX1648erVUlZ4PyWFG8sJd7+vtZYQ3r58cFEquIbxhqWDW8oEh5jwupcH9pnowMtJmpZzW0ngLcoBN3I2aCoFvIWJwVv+dwN7gbvk79blfn1RhW2lfpumEYUeTN3K+zaX8W1CCHZXvnfD0r15ed6GIMhOZXsrpXm9YMNK9jrK8nohtpfr9ZfkbRi9v1RvczleL8j2Mr1dSvG21ZYul+gNL8PbSBGTKr1TCV4vRKs07x7K7+649G7X4KVdl9zdpNxua0HdsFikToV091JEd4sCuoes5eXfIWt54I4OmO1D1vLNI+oayHvIWn7IWh74O2QtP2QtP2QtD219yFp+yFp+yFq+/Vo/ZC0/ZC0/ZC0/ZC3/H5K1PKT06l7Lrm5dcjW83GpYioGQqehSYrW1vOrWkfm7Kqm6j3Kqm5VS9YfswGZlVAOKWncooeosk+oF3Fo+1V8i1b9m/KVTm8ujeiH6y6a2lUb1gvSVTG0ti+qfo4ByqV2U+Tsqk7pRiVR5fjUC7VQe1XrZADS4NGq3qu0dKrWXC5/2WmScppKo3qKnvZDjfUu7SQeDwgbGBCBpawXdcENC2IhgIwNCV+NBrrZthQwBhoPQW2DwNaSLKcBS8YddQ1vMADX1fhDU7UwAXdX/HVT/4Wr/bmSEcnq9femIuqr6Q9X8XfLWFT+vir+utg+GuRv1fmfCdlPrB6v0O9q9oE2db+mwwpelX5Vf7SsYpkeN71bNB0N1qfCdavlgiFup7zuq7juvua7q627q+nBVfSc1/QZqy5DxdVLNb6qW7zRDXdXxWtHea0cjUBVfV7L3wlhysxq+qmAPUz81qeCdyvUAsG71+1YiVfDqDGwYqkzvrkgP8CzooEQ3KvIWiLtQoAdxxeCdFsYhghXm+1CWd1SUh1zNwXk9b1KSq9twr21DhSrI7Rtx2/BDleP2nbgFZphivK72DrerdFKKB63WMA1siCJ8n0lP28t1blOq01fCZpsynW2XveYiNt1EgdZKIOFVQAIrgHyG1T82rPyxl0I1n1GRmtZd2Vac5rAUP81S3FkBmn0Un9ms8EyRebvXLOiHFp35rLZS4+vGMpl7KpHZWh6zLWF8805tKIm5TTnMhrKXzbmg2spdhjOyFr6zTYlLLQo6u91BecvGNRhU1nKLkpZNdQx3UM6ycVaay1huUcJSCfMNItwm5StbIk8DylZuUbLSH6gOAAVn2aBc5Xb8JAsW7zvK9l6dRHhpyoM0f5DmD9L8YSkepPmDNN9eGnKbspAt5R8b1NT7KPu4ecnHBkNA93KP7Z4TbWUeNy3x2Kqg7VbesVLAsQFuSGnHkOKNAVy30c9ko4KN5mbQaPsIL9ZYLscYUPWwtVBjpRRj0zx0LdIYQPB2d5eNCjNq+bytnltgUUa77GIDyMCCjI6Si01EbynG2LXcYsCcdCizuGGJRfCW2VC/7uUVA05g6FJa0S6e2AAxsKxivXBiA8yQkoqOookNELcqpxhWSjFE9m0pobin8on7KJ24cdnE1t0XJIq5rIbhWblbC2/VEhXr0gJE1YeYoTSWMRRFgYEz5srq3CywOGuDeOjTPbf4IWP4J8wYnqf982YMt+/eMirjTHxYzMi/3V5dXiM+G8FQEXtoiq4NOeUo6Xnk/OLyGBP2ANK1QoyULedK/4VAEIRhnoswRi2i6z1oyGpMp0k5tKG2PBsxFXrudkQVjuZ6qZPbJusS1vLg0oiXEHzLuuEmN50Lp7EYfJEL19T8yAsG8Fmt5I3C4NZO4N+Mg1mOw9pScmF0Oq2W/rkpZTfUMzTFpb5jVUhhs4z0dgfOlPS6QVNO+hKR3DnpM39O+izHYIOk9Gx5XyiO4b/+e/s89Xq85UT1rgTWENXlkEiypWrVlkOe+kOe+kOeevnbTZ76+naVyVFNxRfxb6X99HJS9XNUV/KJbt7k7Vsmbhepxx2FsDdO2t6QFX2DhO3V5Ow1mN2TtVcSs9cgdkvU3paUvSli3X9/81W7rZpd7Yu+rnVkl7V2Fm0NLX/WkAK8zK3OlFDLcktoPQm4kMp0+SXCNB6uKn5IqOrZCGj6NzqZ/G36G1nI/xtc/i357cu/Jb/95/3ffkvw09Ax6KOdxCGZ1sfnQBgsU/LrMvd54RSIktLWtjgW2r/SrI+vA7Irj69LRbZZxIj+HMbXJqmrVna4sQCIlpKTKqXy+Ep65yQUxUqvJi/QZqdYdbJLNcad+ZpV0c2c6YeOviFje4kA/1G02yZfuzdXe/gFt8s13TD+tnt6tNqqwLWve1Ox2ZSWtAhnQJmKKT4Xjw6Zt+UFMKgSQPWSVbtSBaRNb74mBut2BNfZCGdzMeznN8O+1DowRu4TT1mS8oVRnT36cw1V6T4Vm9S6VDqpMk0vPSK6IJhZk3QEU7JS6fR+XaKUKz8myhTX4vmwfNURBaZqpGiR++8wYBgPAb6nj0JregSEQ0wxA5JGyTLGaiieMv/FRBspq9uMNRXasDUQ9nPrvt+qzmsvil2r1lhuDtIIll9KVG1vU0lVXvO1ZMM8UoCvXGNjqUYvxZqrT9ZWuWhuJHSXMWA/SHrqSFaC732FI30FupUF2FeJ24vMAstEJrf6sKsxujD3lDpLNgBdxS3MyWoXuHCWtcjrJ1i2Je+x3FKqXjGeG7FccRr5wmvKy736TcVXxMUke/7IxhrrlBNr2KQZl45JLjFKH9AQ9ulhlR6QYQzUyyw9UCUHHXNACaOGbzLHaZir5nqbmYDa3BmbCojUFkiXSiJlH93KliriT00uEL3p8jln7qVj8katMQe0QkTyGlXAiTCPuOBFsr0Iel2SU2XRCQNuKu3EZDLBRVV0Fi5LeTkQO7CeA+s5sJ6dsJ4GnpNvszyMnmSNs2J06TSFs3c3W9h5y7WUvRfJd6VmqhJXXKl1Li6Uumh5wAUSFdX+GxnfeAKnRVNnzT6l6dBqZcIMErEjKpZTwVbkbpNhzHTJpdfeHKVCG8U4XrBeaBiNrsreiP65alMtVa+CXTA8IuX4o1EGXfJbG17rvCxBaQ4kmIfXdATgqgC+ZFb9+kIfUC4DDjwj0ykWIxI3ifpekOtBDVkyGG0kNOM2pyarVwNrrO+nwLTejs90dxVqWwnMul7K41qV/1YkzmufWIQ1m+VxhqV+S+gNohlJ4gynvqtvUX9V0s8sb+A0p3U+0syTm7E5GKz5aua8ahbNDbXl/dJaPI+IScBgbnENMTC+S1zrRa51Aj0XutqY3qh2yo7lu99NVKZNVZGfRtEyY93jlRALqFJ5I5sBKSnL1bdaM23QENTuTBX5USsSgi3k05vb+cscYSeFJg/OO78r5529eO30Gvi5sZeVmyq8zsoP9+LjMsMo4bOy+fBTubjsz7fF79TS5M3S5Mbi92L5XNxXOvmtaH+VXsHLD34rB7+Vg9/KR/BbaXdY6eKd4rNeeiI42+I2G6M1yyPSHVdVX0aOt71UCleIWofagaX3mUaDdooBjWjKCOM4jdbuAP4yAautgbA8LrQwwVdKgdcIKDO7/3Lz7fFL+Cc4+QVmigfeYxO9rwP+inBDsd6Em4/tizCswX2FmNYuyBLCUj3x1Yt/sPIIeLuS3QyDfW70irlpS3/g0kbsKgNCcPaDoZWLg2bAIpTYdAweNPk1DfBN0Rkp/n2JEpV5Q8gQQzueTRcMeIUY7uib41CUgcdBp3B1KFlhsyVT+1+0aVB1N+lDpMCku2EfL3vLD3a3VRZmVolaFXrXRMit1ZBUAE7lHRsabe8N1eEdr7JO+6FxI/i4iREI67oilZhYt5Y6UkaozLkYkhPEv+y7+WWNr80JUsrMImRtEuFuvmDXNGuuta+6UH2DaC0gahZ4d3YNC5pxBl+8/NOL7h2jpFvXIrJSeA6of0MlhpVOYHw9EvjUJu6ZD+fnH8U1a98+WXuXajZ07fy7kV+M1qdRcNGqhDPTXE5scSGWEYgWiRY4U4IO90h/QBjcyzxXyt8xLowhRR8mBlTbTJ7eyKbaCP502AtKl+D16cu7yQ1aZbcXozLseZIJOT18Wy22iPHrjN57VdUOZbX2fZJM+V5Rqpi3Zqtik2a69fRsd+FqRLiEpuVAhHUVJZoaZbs3TBtQKh1p9zjIBg17ZWTfC7X6INxtSpDPNzKvN1UQzn4tfAXlt9K/+kjb4vPwXwRS4XUEcxTNSKqZG+MolUPzIK3QUQJSYRKytPr5ZD/VppO2YcpaEUQqH+Sh1S9t8L7qSgebl1lw0wZUvyp/2YjQbuOj+2Knhq5PQ/EhZkBMfmj5d0Egf50KfRRtgqx8HYCqgwXerRfadiQVkkWZKVfjoWjdHcFmRwUvI1DneK+zdF0PIf8f7JFaMXn6xiEg6pnwWj1Nub107b8Cf3Ln1g5c+nHmzhlYiClEe8XJPRzu/drFKa7qDQcZZstEZaKPOjndHi76v6eLvjot6mMOEWXLITHSWFv3fvGovppl1GiGowep4cfx+OoW/xpwqliXqPFVXfuWXwhi5WTqTaRH2MMm80/SSSJqwd5gFOIDeaVTuKpIp/HxlTZuqNXAqUF7G1Ru12nUARW2TqM9YfJjRniIZGAS20pF8a7JMqdxCA5vaIztLat7NItaar6/l/bs9RGcY4+rXAA+vy5ptpwHYPT032VLK4Pc4wwjcSuRDAEeMy7DZw3nbKIRqAwYjAPNAMsEPUPov+grIXekAOZxuaLhM5iLKYR7DHgxI3OcOSJz1C9CDD+XFk55b85wRBnPlhFXYNWdS+KtCVjwQm9VTKQWw0CWNxJTCCcGVx01rIATpocDNDVgn252tZHfjkN2sYoh1V+YWFJifCCyTbpfLgKvuVJoYsSEVBskHEaRnfv01tizs5XNERsbCD7V2ECyD2eLubtO/UBvLuerfIKdbxX9t5f2D97CB29hD5YHb+GDt/DBW/jgLXzwFt63t3DdWXjBjlfGL/gcLxK6nuOUlzyBjbvuIhpYJV3zMoIj0A6hPV1cUmPq/gygcB8VLmQLmuKUDwIa50R+OXwxfDHAOPcRZqWyM46irz7onZAxovkITnp5DWBtG1Xr4QYrS34PgOP5IskXedV7LSlh14RfR3K1kgwqLnLaiH8ayboplxKsBjCYozWqgi+q7tk7owkdADJHU3y9TBKVp1MIVZeUX9fU6rLdSPg4SmKJAdsQB2ge/+nLUWko6ofTVZmZGIyuri8uL17dvr88fXNxe316dlFqpbPDfptRx6VTpuy9wc7yC/Kd8r7Ona/z7dCIyO3Fzbvx2cXp2dnV28s7idfOURLTO6zPqxOvs9vrs/dX1xc3p3dXN++vr873g1KJSqWmT3LSjK/evzq9vXh/Pr6RUjTL3b9kwQEM94hhiEkmt/paHkN6pQJNYUYZhwXilTrcT0QK+AzD1QKnF69uIUKp4os5h4cJSTDL822rbgSg3NZ9vELZccHj/LNrDcFFwhH0bVB9PyHObtV0XL1+f3t9eqNAStztUlYyKZlUlcvRC5wrIDnNPxDkYmhlXJDZDImxi+2MUw73mD9ifZcxFTZZBZhxL4AFpQksaKwjiC8u3wFhKubW4GY0MzoHmyNo90lenHWBMjGxWKxXVp09O4WZnBTZA2GlSTlWMNrmxknVgInS4Pvt8K/H79989+bu/fjN6XcXHsgl9ibYmiDnQFXSqPK4fochde6zpRdPNxf/5/rq5u7iJrS/+cBUlmvp7+bi9q+XZ+/Hl3cXN+9OX3sgf/Giv5UQs9pIhll1EGFW+5RgVp0EmNVHll9WHcWX1Wclvax2LbysNpJdDiei/0S8O7357uJu08NQWhe9J2IkSBAv54vioDOOtzTe/7FUDG1XJ9JB6J13o32nE5QwPuWdj9B3V6/fvrnYQEhQS7GTmGD3bvq9uhwLNLY5u6unb37w3irqt5y6g8JtvPX0DTw7BmwV5SepwLeieUELoscqXo7gq3/+6p/Ng4xyGtFkJPy09TPLUd00LR/PzvOCqSw4pxMZTL0ewSVN8TaiijnH1PyjeE5kB5J+OEh4QQsTsmt9/ojvZ5Q+tNI37DO/RNNJ2gAQgeUknSq1bh4o3ioWtQyxmxTTSq8taPZJRRs/Sm6lixiGbukXXEIFI5RNWVU7MxCXxYROOWU8xllWfb365mXl0cu//OPJ70rjc3r+Znx7O766fP/jxavvr65+8J57I+g37/V+YAffno5fv725eH999Xp89ldPV98ikvT3ljTC2gj3KHpYLpgrp4POJmC9KanQUbKYoRNPxL6Vb6CUO0B350oQoF5VUxS8Kp46cw3cPyxKfzDrL6sv60HHbBVCYlHHuiWpaFuB7UZdsomoN0CNF7PqGRY4E7as0MIsomv1Za1r9fjYlD134fCq3AQspV5Isgx/ngtHL2Uv5BL4j7GIBYtPMMfxR17N1X5dy7rSprq+z8zrgIUeVZdyDvuwpkP6XWR4JbLx1XoWBmjxoucxYwNbygyNk2Vihl3JO1nq/SOs+Awz6bf6sVa66c+1wvW76sq+sR47V3TGeOkPi1OXurOfbLDQ9Zee5eaacvVGNtNrnTAADafDOt96i+kuu+6xLvxbz1IDAxer+YmyIcxRSiaYcYjllUiZ/I2GSkTlapck7YKUS97sqPdEelIBYoxGRObVObth8I9w8+r0DLKl1GSV+8i9OVc4k/H8vScgvSkloCLpbULS5QeYJGhFl5msIdF7oh/GhPGMsmHvSe8JfEszYMuFuFyqnKYKRIo5XN1q/04+Q8q6gu7pCgusT57Dd1glbMmzdiqjjM7/IrVwllKOZqosBornIIKQs7WKg0CyG70Ph70n8PI5nAmHRD3XCo7KqiB8StYmokl9jvW9JWJkEGdkhbPiLmG3jBiROQ6UOuwJfPEc3oirifZ/01hKyTWd6k5JKo1JxqdMwpQ02+BnfwRNP/9HYiFdiiGgNIZzNdazm3PW+NEGPQX/envh5u2J14iYSBFAwoYRI0PtfqK7rLJ7Rwtn2q+xIK1Iw1xh8kVnxdFwliwZx1l4ui2Xe17EiJpEv+Poa72zxMyrxc0gW6YyhYKKeoDULIhqktxhrzH6w+8wqDpqiRURGuQCK1PFm7Ai+5OO/Rl6XG+dDk5iMOPzgK5FwyJ9lEZCpZCiE1gR/NipX04X4j6//gGv24JkzJSIMt6GbZryKAaTet/ewvsNSDV7IpundQHm95itsZZSEXGOotlcpg/zJlf0p/Ure7R1Eehq3ftz9hWN3PkDT8vvnbKeF5p8u0JKytj1SdPQ3GTlNicLdD9dtjhXentcXYrM5n4SJYgxzIbm75ZTxNfMcZToJCb6gzPRTyWTpKNFLaOkE9vKWnS0cZ1RTypV3AuuRVIoMnvAyfDkq3+S7U26vNXJ4OSrYUwjNizaiUkQj445Yg/sWOXotzOEoAU5VpkhB3lqwuqDQZxPFTsWfbZkv9wkraWDzFYpxAXK0BxzeUrJvF12NgA9yz2zpFXFd1gyFayVaiFFistqFooVAqeml8i7FsTP2MTv17q97JlwJpcUkBRQ5fs8kaUDrBUirsIE0kFq8zs4JOTcSULOrfJvxjjB4jNtc/ARvNwMYrFK55LseeBDdQloRzhXKi5TfFDQz7kl8iwesl8dnCIX4j1dpnHlozLxmfloCLc5W1Fkluuwf4PFvakvp6V/Ltv2h8VjGfpXQRdxF656hAKOQG0xWzNV+SPfHKkvRaTezWYXC7we8IIPc4TylCBNXZdABqLh6jonmMkBOCxZhqohpANQSFYeKgK2LriaLF9eaPK1iVGQbMe4NWq8bfleZuKSe7+SbMSxqtyjOySl3THr6ZKDtjjwbGq3J0xwAqtMUwFbzdUDXg/URM3RQimmKivKHJGlriwwMv2POWTN5pK6MMywzWHoAv2qMoj8UIgo3aNjyjzXfiHxbQ2cUUKpP3rGvIfPIITm4wnZinN+BCnbYtFeOVu1aZO0NcrNonbeXU3YLqVkV0DUZKr07AdZ3JqKaiYVHajXp+lApO3o14uYaTauNb/ec/Yg734e8u7haP84R3tj1njxMl/HxZaQS7PDNeJ+3bzn3BKfT83svwltcBuSk9sus7fK7Y3j05u2BjH03tR4V3KW0wy6O3W5tGx5cSnfmRyx4u3oNHZfgxh4jxqnEK9TNCdRAc0YkXqunDZH9iFsggImJEnUMSzFVTGE/nlp6fXVFw77gNhbsTmVXHfs+nPLQiIssI6EWnggE+CU5N8jWDLx1Zu3t3dW0EAxGFs35cwv4Z4Wc0C5trD7Wuq9mnqup43GDrfJKfCqapmh8hgTyb+cWcG6b3xtf5ckv3cDZQIdZKGWYb7M0sIuJHD8DvPrZDklqZDlnz0HkUdFZyNw1EPVliTvjHhpqY7JRlqqJpYuVB6MjzKuBhWEMTtDnwbDGo53JVGWzOdLLjM+oAnHWb4F/WNoSlpisPheKh1aky2Um1tDM9TvmxbvSdyXZ58DpGfbhS4RN3sQP5s8w65mQHX3CCSE3dhFBvXeEKFWvqv80+uCRDN7XVgKQ8V2ZUIgjh5wKr/YJzW8eZtWda4rvc6b9oHNRZxcm+rBlyoPOySAhKZTFrxDy6g6XLYdE2q1rsyq00QAnNbQtIbSdAgA0fJL1cwwFDWHhs5PL5FjASnGMF8yfdbhNMLqXuPD4Kk2fSimWId4T1S0oFRXwT1RhclVTJy8lMqjFFB5F4fJRWrwRwoBIaXk1hP3CtEf5MeCTupVX/BiMBaqM7RY4HS4V97pvuM6V9fp9dhO9KScoeRk8c58qogyaOv16Xiiusk0VRAsCI5w6boMJGUco1g9dIAEwCknGc7nTm1TiZx1xRaCSJ6HWrgDHn9HG5iT0qGohD3CGn8EbCn4HzPpomRen6FxxxsWkSs/vfx56AT5rczNiIRP8hEQRWXbT0buDMIUIXJ4Ug715FFEsKCxHvCjHKhgv0D1QJcYEvKAR9CX/pYFiv8lVvV/950wnykBoC+a9CvJe21OWSCoZH2T08wJVHyAxbX2OdBMjD2lFgAJlhRW2biG8E8vf/ZgW6YTkDTGH+Blnlt5QePnepexdcrRByAMohllOJXekm5sxf5cYZWn6hEnycDI9o9orbI3qelSlt8FynhZw+OEend1fjVSWIklNE2BMEgphwkRfEw5b5WVgoaJ6/TfTrD6ujFZ8mWGh0+77taqbqZBR1NlDB9H0xE0ihQFZFx7emkt38ZRODSrEU0jvODsmK5ETBt+PH6k2QNJpwOx7gZq4tmxPC6On8j/bDQKFQsZNJTSsbTP8Yh+2PHT7lna1A0j9BB6emv8JSpfViQwm1k6t8QcxYrDonS961Uq6CYTJEbrgXbnHqA0Hlj1+joTakkCtuDb8fnHWbtL0nkDesRxd7pZr5EN6oY289CRd3JQF4m3rD2lXletEZFJh1lkqpfMvrBShdSgspyZG8V8u6GdE1J6QFtFIShJ+YCkMt2j/0pCmL6PtWtt96c9M1DeC1z7Si5x8AqtKZF3TXlFPRPfWdMrdfB9mN5cn0mliUvK9+oPmrDdCtX8Ri0MigZZZqFpJD1j8FYq3zrjIlzWukopL+SgIyDcyg8ul6NGUa4KIAyWyjonTeKuW8cc8dxClYsWSFTw+QApSinDwl7MFDycRjRWRZ1RCiTlf/qyLsxcpfLrI4UPnc+F6PKLsIXAP/0D+4fLXzSJyvtH4ZvafdZni6QRhpN/+ecXgxcngxcn8OLFSP4P3t6dDT3FQCSWwUUDZb7Pxi0oW5i9pyxvQO+VsV6/1CUNaxvlqD6r6hDqcGsLzbX6dG4nW9XXHZUZ11jMxGwuU44zg7g7/bhnMAL3fCUO4fLq7mJksJMmtXsMCZ1OcXzkhKt0/OYGlgrI+hbGsCx+s8I2U+0uuvKQNLu8mZGq+XxEzCbWcMMku5sorGQs0x1921LTpWhm8QNiH0MqZb0IjCqyA/UcNxsTNGc8VptZf43V1/VJDaxf4vOe0/dLZthpndPXIG7E+Suc3qOG8nL+dkxdmqhNGD8QfqTC6R4Jw0cO60ztnNLJ2vt32RL3PadEUc1MDIWkmNVpQKvrxZwdwdna9eK5Jb+1Lde8XU2e0kHZpaLefglGyC5Clu22SisT7QJbzL1A5L3sxbtGfWuyBrjLGg1DNRxPjyjix9G/QocivVqqp7Hk3y56cq9Ze3Dl2dWfSh3p5ZXUk7I5ShIrotR1ecxXEJkA4SUFkbWD9JoXrVFR3HOCSFJn463yFeIF8v790SR56KLrI3gRLJI027ia7FukybDVaFNvuw20E6oG0r87Z4gBJmLC5LzgGGhmZpSL2SIpLDI6zTALNZ/sMtn5wWfT4bO5f2fNRi/NFvfMZr9MT6zfwSczwCdTXSeWDGdPc8c3wfDMBrYinkpKiF6VmykeVNRr1nN0BGVDmOeEHB58Nw++mwffTUPnkvOmXR8omqEMRRxnYv9ErHLw68HXXTjVDu+sP9Ys/ljn8TT9sCcu7mx+uen6aYCidBO3pTaPJeWN8lHdlgq2p2scJ4jML0OMVN4vK/4elinWARLg2gWn4jgv/dUKBwfk8iRxAtdgFOHt+1ugU5ATaH0qNnSRcnilBPpLOb70kL1F7Qz1cLiKi6Vn9X1KP6inno9c3pZNEc3ml3MfY34of+S6ZXArI7VXJyAj3PNNrnJWT4CmOK98WQesU6NkWBndXc67pU5VJ0cNl5rimgkX8wWvexKoCVP9cUBJQh91AqRCzhxKr4BnHwhKp78SlD4fAQKdtNQS5mqwaQpYdKo72RUjH+gEA0/DLmE++17NWreJNe7ppuY4pal2VO8z2dbnNJarQixLhSqz81upO7DJ1ZXgjGkJbVkR47QooavbxbBc6LpiSBQ1Q9F6CGcFEMMaZY6uJcPe0te5iNPEQPQpJXN/rZWUpXUXWrauxmcrnLhyajM6uKAjWXoBvgvlrOU5bP62OreawXR2WLf9AzwOei7TqHZvDFUKhcGdIaYXBk6tvIjJWnWnrjxNg6lBXGOzpuHW4NgyKYASmmKIKWbOUoZCWWwWSkEP7Ttpr1mpskPJI1ozvdbK3pcO30wF1JTicR2Fcgm6SeDwV0UrSmJYUMaIkM4YjpYZ4WsgjImL0dNQd9uDCf5ggt+FCV5zg7IdvtWM0apL9tkVveu5syF7B2ZsW2qNjKA/w8lCZH6VFXgXOINE3Ift8/OZqK5eB4oWMrm+MuDkrZ+rkqwRibEV2YfXtisvSZfYpdV+REQswby4Zz4yZRI198F7xHBsojkEgcX8uQ3kGVY6wIMF/2DBP1jwDxb8gwX/YME/WPB/9xb8g1W5nMxVG4tV+pobmuBXWotgm5tFCZIhWvIZzchv6sSv2Jzb6j9FjOS6Jo6zgdFV6EgXOZ4BlApx6dJBVsmqOshCeBwwlLesVJXKaIJ1kGVttIHoZqopWpDvlO3bSxFF1TpZ90xPiaDMFq8oaTAVM93v/9xTC9qYwOGnfmFt0BYc3WiFs3vZYIp5/wj6CWHyv4+IR7P+z5sBj4QJYuMeyu4Frv50C63D3OVIZOhaDWIZ1JG+u2LxL2VeFP9adOmH4SjDvBF1F2XcHhiuDpzpZDeekY371cm1qh0X9HOiUKKrSvuxF7SOFbetYqf73mWPYaQvxr3TvjsPM6I0i0lqcypXNwlG/kWVj8gMUc9jaW71OnCg4PJ4cuGg3GjMI8uJxr/kajQvMNO455T56Nm4C5WsON+nJP3I2bidJ3KlfGbIWVU9qB3HtFsc2cO5KSP1BcBNzs3NGHXYCXwEfV02MZg9FKvYtZ0WH+nQbuRT4X0186Wm8XQWEQTpVGWPz15c2M8ZsxERa7UR9rxMdy5ntFBgq3Pujy6If3SeUc46E2pN5m9Y/8j+U1VNd3bquXHs7yJnM9DfwUWuxu87XOT0QDjieLJMbjF3lkPudgKG1D4OgtJYzbfLt/4yyGEVjFv7AhCEdzQsFWMLALP5gPXJqioRWxVrSb9W7XmDsssBqLfRoAMVtpt46FrLeZERKizvhTOZtlwPIrUBB1FGuPD4cxZ/DtvvzSWgxTcZFrq9rF78+dclWouRPHzNIkaOrbaj1Yvhl8MXLeWc+4PB6puv+vWnAhKK4wwz9s0fnp2en99c3N4+dzRMMIpxNpCbhdC0H1LqWYNz1zk+XqHsOCH3YjDHjEYPmLNjdQNYZPTD+lhWNaPRwwYVrdWBIovfMTdqqsNBTKpWx7n4SFVTbMXQOYeWzipoHq32o5VYoCftc9k+a4c5yaHm/n91HuGbF8c3wXPj3meV7fONDGrpdyajvdCsQz9ooVntR6uT4Z+GL6qfbVgrvj+wYX+japWJlVKw4G04j5ugE4z4MsODKeKYfXOnq9t9IywKjtb4A8/QQMnoA3Pc1Rp7N82bv3prw++uUv3H2a6fkjWqq0DgcjWN1cbb4Vrd9CD8eCz1U86RkXeU1XWQ4SlhPENhc+b7WPCbMvPcyxzm3UqzJhsUV08fXzjM+sgqLqya1Wd6jtaSTQlRvFKJeFQSqbvNrZe0V9cXlxevbt+fXV3e3Vy9fn1x8/78Zvzu4sZNbOfB7oB2O35/cXl+fTW+vHMDWqbkw+j4eJPJc3d3ej1+//bmtbs3E8tQqupp/57k9bXzy7QVlqQ/U9Mn/sqQ+EdReLsGLa+qTVLGUc2dozoAceLdXp+eXbjRL2719l4Fx2Y14Yzf/OGZay5cZ/4ySyqtNSldjdXU2O2r6+Z5/5PtM9Wb477n7EQGvpyTTIZtS6VXs/lCeNQM4FQfleA10BRNZLLiewwyHJBTENnylBfP9Tt2JKsOM8uJ/dSqEvtRTBfm2P9Y9v7Oiv2tNOr1St1u7WlRkXt/LgUe9WwHk0HNbvgRNbT5OvkdqGfLa/pzc7IxQlsurTVvveAlrKu5+63SuZvDR1fv14f8O1hFnnnqspx2YtFvaHhJY7yBFX8j+32vaqR3TlbIahAMr9lm/1H2YeD+CzfKtpsqd2RHlyeHOjbCDfzeHvdtUOxClsKWa9D96Lxq5zyqWO675E4bcSVLZpBz9QYtNnG9ISxiBMVz9w42X5tWI/hbT3Kd/+/4nqTHbCb/IhP4CQYf4HhGGT9m4o35AH7+V+AzbC7G0SyjlKuGUGnZ/8P/VlcNnJQBLll2nNAIJR1A+77xdhIMuSvOG6Ds64Jh14f1lhNirY5zhOc03cY2LRZ9iFXaY+rUn7fC72i2dH21e0t13sumNmoLwCbD24mx2cJhczNzCchmM7VD07JkxJ3tyjb7BhC75xJzcYsvhVi0WZollI10vM4vP5KCN9hmJPKDJJjnCIpDZ7BAfPbNH54pldD7m4vv3t9enf3w/vr07vsSjIRMcLSOqukqFxm+5XRRfgiAP+Co+gxM6nAhb+hTRggRg0j8fzaHQTaBYxu5Y5fVLKCJGKHURWopZyultZLcm9WbLuq16MD1ZGjwzDmO5k5/ePvq4v3l1fnFfsxwsiCPWNeXPjOc7LtJB+3nRSEKR0WZFoVjTTtffF9a5s1Q7KZdTAEmN4XMZvGBV7cGWZEETytxXuoXoQW6JwlxBZSjWG6Rs9Pr929+uLw6F9tD/HH719v3p+dvxpfiQfHHz6XPZdqha9P1BYtQgnRYdRmH/ZoxPBxMavBiSyst19D4/Hn/M9CQW+s5zNpVGcQn2ILdzTgh7MxBEDfQkgBwsPcUvVykwpRwfE4YkhkGl1wcSpLlgHbSOAKZ0UBf0msQMhyJ4j1MZjHIg8QHMpkU44i76X5z8ebqrW8J9Gv+NftnwjEWklvL9zFeuTunMRvIhoFmJ3Oo1qiZYhzjGBhVAcMoXavvGTDMlwuxGEis8pQWUiKgDNcgLTK6QFOdVSR6MElf5YVtjqIZSWsp/RSm+jvJivuv7JxKfefgBcSBuA02jFu0ae/se8r4HT0zw3J3p26ettbA12np3l1px5b3qlXlvdfg51wfYlj1epIL92JRAdnnkpw0W/c6iAGN/XgEtfca3vq4CYurTCUf6AXtp03wcAuMm+Hk22ad8Oo0Kf7VFhmd16jnSNf4hsZ4BC+++uqr0tsAHVj75mocbePohE7G1sGULUHVBO2343NV8ayuonHNaa7peAJ3FGLMcTYnKQbEIVumnMyxToU3p3GRIgaWDLMjMbUyYY+pvy/BOKxVx3gxw3OcoaQPOOXZGlCGNdcc9kDAGacTepW+UTdwLUsqrbPJl6sfV6mRWyHy3OyOkQsxVKDk/7rVhjGnKCprs0pAN7dW+PrKjRFPlAOB0pXnvUKUxToPRkwxS59yla704wW5qqdPTC1mFEPEiGZBcllsYiHvHD9XwuD4MSM2jQSoAn4dH8tb1oGLAbOJdd4VnlZCV7WroKpCFbfCsz0Muzxn5mNYUJowz7yF9S8hbOAB4qWJQmk7okgYHYlSXUaa6W29kDZzkum8lHQ3WxJOQ2kjnUYEf9A1CCy3dPhHsNyPP4I7kScmtJOhtQv3aRj7p42O/ntLbvLxopU/cmzwrlegf7/tMQ57J6H0td49jMd0sRPfN5/s1cncL78AcMuGQaZ9DaGb+f4cLxK6FqQKtNA2SLBlk2CDlS4fa5D1tBwAG2ZLzXsIwWMDM6cFP2ykna2PVXuiBanNRLjT+DKvme/lNma+wCCwTxgM2beNBp/CC323gVgv9zmph2mywJa2fM2EdSxei9DvL5oj405levuuAcVttqeca3/zh2dv/vr++uq8MB642i9oxr/58sWLF453OmXz4H7JvkkRZ/Umq6A10m4W2i6spzrO/caCKt3Rx1+GxWdizmpd5UfGNc34CMSU1nOHcxrRZAR3Z9eVdwrRfoYZH6AF6W8XIWMnL1GHXLvrYIPU4Xaysk9piyID5zgXVaJwlE0xt2hVU/kZAanq5lZFvYptTZfoFJqOcTolablZs5zhabetT1oJbigGSpK/5RnieLoe9Qp19A1NRBb5t7KBfJ7ZT0zXc/ThbYpWiCTCMqnkvjlJb0Qy7VtVpX8EJy82FN3KQwona2cRzufiJZXk4tqSrLCR83QRwUKRV+ElccrM0aBl/29JxviPhM++V93ohuLed1uaW/Erl11CWTQbAZrHeaHgJzoP/wWKZgWzUIqhGVphmOP5cbRYQkLmhDNd9S8GkgLNYpwVNVKewD1OqCqj+d0SZSjlGMfw7/TWVK+a4TTCEKEUUrzCGUwxB2Fh00VNI8SsejvCmIkZW2YYlmmCGVNlF/CHSEDlM8qwxmmo/yvNCHmBRR01l0MzFeaGAbJ0bfYdp7lussmJ3pi04BO6TOkDc3y9+77lXXwoDDXXzq7Hb66vbu7eX178n7e3F7ehHgJu0ejyD89sQjrEm2kuBI2vHa9Tt1SzVoex9JU203+sLILDNZon/R34XrVLDcomvYG/Qsxm8+bPjqstzKdqkGLkjuI/NWmlTKAGgN0AWZS2PqyUUDY/xRFGlR6ixXIE/ZN+tWM8p5m4Unz14sUbUn07W07xAk0xG7x8Q8Tn31WaGJbzMXoLkfFOXpy8/LKzkFfheS0+Efvyh6gs0kJ0LFMwJsv5CN5ISpZeiQRbr8XcV0mX29Y1fYM7+X45xddiQnrBu6LdM6Fhi4T6RdS2UNde3fvJIPAtSXDe9xZaQ8FKt9Uaahh71BrmPYTgsYHoacEPG2lnkbNJnClBzUUZ8XT0cngyQMmCpPiL4cnJRvqWoGvny5cvu1871TG8waWxYdE1XBr1V6GXxmJIpUujeFy/M9ZdKKqON9J74kafZue590SDN4o0Tbt8cabKCmC9KZUCQcliJmt9sIiKrZ57vsSGZnYhjze6t2tKFZ8Q9psfaq9eE8ZLFT9KWPYUb06nywRl5Xfq1YxmslSkMiGzhTTboFiVC0HJdSaL1J+JQ6lau+SycPN11FEpVRESTe0y0cqqJP0EgDCQrDx3uf2326tLJY0Mc9G51PGt5eja1rNsmxcnsvp1dKZk5cKNNtepTsu9xUX31ue5UsouHCmLlPWkB2RFYFK9yeQlYJX4LXjy6fX43Re30QzP0ajUfakujasAnbUDupb1dzaqclrwlvJtKl6vi5ZGea1eMSMll5FhG3x/qWGHy3lM2AMLqGsrPm0sgXVp1bt++JpBGrSYh6EFHCWejQicE/agxT8Gz4RgwYBm8PZmzJ4rz+Wi3Losg23qHlaJWuCBsgxVS1UTjuc1RDyItxRtvpWvYYEy4+WSF/+WZCpyPwTMuW/i5EZtJJzgj6pZ8GxkGLHyvqlBvZFNSkRWnaj7sizmp0pa3ifhHXdaBnLu1dSjDAOK+FIW2P9Isw86VCkifN2I8pluZLaP2iOmEpwTN3HeTHHmKY9KUv6n6hVrTlIyFzeGsoJd0KIRue/pI8xRulbYSEKKbwR+zeTbCsV9qbV1IF2QanszjbTu4feha9bIhqmHDxrcnWtwK3GdPi2uaLaJJvcJPCpHdEunB/c4QkuGxSsdU0USrALI1QjFy/lDSuMdKAw/V13yzdvbu/evTs9+uLvxxqf1T9qVuaqonDQrfnOs/RtU9LQ7flNepL9p1QCv+h9H6crWrOGr6lvzVbZMB8sqyPKn2TI9Xvp63Sg6q1NQXXlbFR+bKP5OEXFbRKRtov598WLeoJN90027Gw7M9ubZbRqHL0JcfGx/kbaN5M/F4A34MpzUlSBg15kanqhQSMJM5GR+lAmZU1Ss5SoWKeJFCVYTWMJgSlPcOftDNfkDoflIBWXzg8Qkd4AyjX/efVyrbwtum1ngd7SpPpGRosy2G0GXm7aDdnD+RvjOY6Ctk93GF3ZYf/sLad1jKKuLs21ksXGfjV1waur2/x8AHvF/zBBQAwA=",
+	"openebs-operator-2.0.0.yaml":     "H4sIAAAAAAAC/+y9fXvbNpYo/r8+xfkps5tk1pLitJ3teqdzr2O7rbaJ7bWd9M729qYwCUlYUwRLgHLUnf3uvwdvfAVIUC9JOis980xjEjw4OAAODs7rEzhLMeIY3qA1glucrkiA4TQIaBbzAUrIO5wyQuMTWB0PHkgcnphGps0ScxQijk4GADFa4hOgCY7xPRst0RqNaIJTxGmq37IEBUWTwWg0GjyBczwjMYYbGmHgC8QBRRF9ZKC+JTRmQGP44WsGCQ3ZJMRJRNdLHHOmUTqLMsZxKgBUcE7vUTBGGV/QlPwmIY0fvmZjQier43vM0bE39mkWYSYajQAl5LuUZgk7gZ+Gfxz+PAAASDGjWRpg+TCmIWbDI1D/mCQp/bDW7VY4vS996AXNkE2CZIr68t+CGua/E/wBB+KPEnVqf05mJEYR+Q2n8k2Kk4gEkioBjXlKo6j6hmEFAq8MMByHCSX6j4DGMzJfokTjFaS6/X/Se9UgpbH898ZDZxxxPMsig0mI8JLG8q+NYZo/fs0oRxJqRJaEpyie4wbYiDAumjwiHiy8uyDxPMWMqUmSa4/GHEUJDVHGKQuQIfQKp5wEtjeBeDMT04MZmccS4q8ZZnxHKDJOUzTHQYQMnonYNIzjmK9olC3FK7K0vvEjvm4bo4QtKB/jDxynMYpGume9EW24Vb9UhKo8Ehu2gcQcczWZZXLIVSj4m/hXloT6X4l5GeIIc2xDHyVEoBwLRsJakA0yxunSPAoFJyOSZdXwgzqCNrw0OjmGFrw0e7JjA8M/1rod/nEIFiiBmIXxjmBRmoYkLrNXG6EijBh2zVo+W4Y6BSUK6miK2SYrXBImZirFc8J42oXLCkUkRJzE80d8v6D0QfGyTH0oV9wy4y0N7KMoprRtGOWpjWl8oxF7e/NawposMU9JYO3Dd3cnNAwJS7NEYHufhXPMrfCsy7HA2bAUdUq/InEIfIHrUgI8Er6QL+QBfp2SFYnwHLPx4AncXZ1fncDZAgcPQGYQ4hnKIg5If4oiRiHGOGTAKdyL4x+nuHmqi75JPN/T4c6y+//EAdfnu1XKAWgHYd7X5JuURvgGzwTgpqTSBdHMc8tQ5dSUiYKShE1yUe08P/wttJDdoYQIiQI75DOACN3jSFLG+R1AwUgmAV0mNMYxH/m0Xhm8X45fjF8MWIID0RXDEQ44TVW3S7EMX5fwaMGkHy5G0DmBY9GrYBx4vlad8HWCT+AGq40hHwkRicTzt3Irn0CcRdEAgONlEokHCtkSnQGq9OvAvC8d22kJAGDoKf9dWdCXHWsZQMiEiMQ4LSE/akcfgCzRHF9nUXRNIxKsT2A6u6T8OsUM601Uapf3P1kWwE4K7NUvoSkvoaDQyJG7pik/ga/+9NWfSi1wvKp+8ASuri8uL17dvp9evf/h7auL92dXl99OvwMco/sIMzkgsd8MlYBT0UeMAy7++cPXrAbvHjEcAo2BLwgDdTqM4U78If43j2mKQyAzwMuEr8e1r007liVieDiEGU2rSOAU9ITCi/FX45dA40eUhjU8zITYh1frFVYoyvAJDCcLusST7D6LeTYZP2T3eKJGMGyh2te379+c3t5d3OyKaCgMU8zYZ0K1fHguoi04T04mk+N/fjl++fX4+OXX4y9Ovn7x9QsXzS5P31zcXp+eXUCS0hUJMZNnZM5kgc4UHYobGiAGKK7Bw/GKpDSW71coJYL2td1QHU3ecaWVHsi3KV2e1F4AzAiOQn1OWd9dI744yXnbOB+EY+y3FzfvpmcX70/Pzq7eXt5VKWBWi5EBDB0SGgJi246+1vXOaSBY6rjJSx2EeHP619P311fnclKaC2HHY6/0tt/Zr6E6nZU309nNxendxfvzi29P376+e397d3Vz+l3OcwmDGYqYlPViuEpwfPHq1kiGNbjlayqgODQPEkojeCRRBDHlcI9BndLhuJU+XajZSHYCQ55muIUzTi9v705fv85hngmg72+vT29uL95fX129hhAHctofF1jIt2asIG9hwBKUMgxySGxBsyiE+zp9zfUDh5JHQIJSuXH0AQokZhxFkRINm3OjhgCopWdJzHtc6ukIyAyGcqqGQHiV3HkrK5OWlAOOHjADPJuJA4HG0VoA3Ggunki2j+WxIobSNcudU+KYaTXY7qk+uzm37k4gDDKGQ+BUn5KTkDD5RnxSnqVaJ7OULpUO9vR6qo+yMbxa5zMmWMbZzTnLJ0oDw2ENUgs5zm7Oa429F/mr09uL9+fTm/II80UgD3YISSqF9rU8ls3KpDEsKOOQIL6or5UfxWUv5wABiuX2xiBUXUSs9RmJMBvDuaaB7EYAyokwWaF0UlxWWheFGYJj7sugWgihltLd6c13F3cCmkT7HudEEecDma3lfImBC3Rr0DjNPxCUYmhF4rn8gC2QGLaQbsWyusf8EeNYvSIhhgCl9UOCzvRmVioycZI0tyTOl5GknuyasAr1JooZdPLP2vA9iKkhd9JU7EuzRz8nwkr2KMiqeNvF5TsgTGkuDG5COyFUtRJgRAPbDpdHPTY8N8RSi7//qapRdSfz9W/Td6cK8E7myQz438gKwa064K8F0SOKQhzC/drwiE2o9TmeTxUCbsePXl+dnb6+fvf++6vbu+vTu+93OyVGQWC+qgpkO5mfidgv0ec4SzbS+uwfOaCh1xo4u7q8u7l6/fri5v30zel3Fw7wBvJ/khVSChI/8DcX16+nZ6d7he28Zp3A8Ithr/PEB03Jk0eE8Tn3xLbEB/07EEy/P/w3372569nJaDlf9hvJu6vXb99c9O1LiQd9ejP9XF1ORbc+XS1H+INUzaT9aXfxf66vbu4u9tTR9xevrz1hRyTOPowyTiJmA17hvheXp69eX7w/vTx9/de76dktkFmZwwDDccgAxTReL2nGIGNoXr/bKfO6+OQ7SucRhtMYRWtOgk6Jtt679/3VfUe4uHl/99frDhKNcMxxmqSE4VxrPBw4SJRj9/56evnd++nl3cXNu9PXbWdUqO1s8IzEsKBZyp5XoIsTKsEpoSEJIBEynqYhwzG3EXJc+dxcJwiDl18uqu/ekJgss6V4d7wYw19pJhENaCxs9UpZwyk8YvwQrcXJxzAXZkI4/tPXi0G7jtFBiYFV3fjyy0WZpBFZ4Rgzdp3Se1xV1AjHj+oTgIAulygO648BRjDJWKqOqMk9iSdCfRrwyNJQK1Irb6RxG0XnOELrWxzQOGQn8MWLShs1M/nbP5XfphiF5Hc7jrqxre4S1WlkG2nFoY+xzdsWNGKrILeclawlZgWihOhxJXVjSZJSTgMancDd2bV+xlE6x7xiV6ma4xwmIIalBf50Jj0g1idwSWO8jX3SMBupNRVf9zFS2j9uparHJ9uZK10dbIDVJzNcusewEW3hk5kw2zDa2I5pgD58XQHcsGl2GCibpjYNGEpAP421zYbIvi1uL6S1Tfx/q8HNYdrdnnS7se7ukHI7s/Aa4JdX5xf7MRpJc1lMQ9wwkn2GZktpPzMGxKbFrnrkiWVjOJIcylENqvRkLs+2Nu7MaCrmWYLUSlvFqifK8wqMp6fFkBNTAVJyIeGjZhUuhrl2PFfnRJQ+ZMlu1ukXo5uzY7+12qCna7nWBqL/JcZS/aL6F0QYhTgFefoK9AjTuz4c2xF7fXF6fnHz/uL1xdnd9OpSX6DOXWhZbCLVv+A6pQFmTIIvyC0lAHEpIAykc7G64ogJP/4KggVKUcBxQ+OsJxaIUkwn8xQnQDOeZLw+dbcUZlkUyW4DFD/l+XRLk4r0yFPfP/uL7pI9H59CiudZhFLAH0RnrKmllotW4q8XPY45SbERxNVAF4gVVzbSoDacxsGCpvDL//sFTnJgKF4D46nSyCMOjKOUM4XqL9XT+JcavF/Gf/zFDUhgUwcAMypCCJRy8jecUhDTQsVAFijdy72KLSwPR4HlIceMwy9qekYBDP9fTRYZ/3H4C3wjJbtd31g2EL+N03VHJEebEG4D4SUudn64G4Hc3s3GGPYSzrcRwl14b0HbTyeQ5/gUwShuQdvSuCFk+8rwDWH8M5FMdni6eJ8tezpZdnCubHKq1FbJL4Oeh0r9+9/XqVLDfoenyv4kZ71QDOpQbG+b9Fw065Cfdyk771Zu7iEz95OXG2y1RdFh4att2ovfP2Pd/YWvvnDLq8C2dIOIxj73vt3e+nZ95+t14+t73yvftZ64rm4tHne122HZm27Q/xbZ4w7ZcoN8cjjf93G+N6+N/c733+WtsYb9jm+NBasQsyk0eKOQsIfREsVojlNIsbi1hEY5OngCU1636arYWPKb8osTn0sWc4+VL/eMRHIdW01qZxLuG5S0XE3jcDlS/W9pUCsBMj09gSzEq5FEFwgznANoCklKlihdq6HA44IEi8Jx2/AO4BTSLBagJTQ1WNUJ0xehyOiWGdCZIQeM9CYmqWAHS5hRIHEQZSGWgATl8Af5t1pYwHCCUjkZaoWzATQnbKx6OoG/DUBZ/u6x7tysyxE84PVJady1a6d4AdUXMkD/pOxNpYEwjMS+41ZA5qUdmHTCrkFbotQBSrzpQKpC+tpgKVNU0hQdqba1TiiTizcne61RkwhgmgpV/NEE82CyoIyzo8k9pXxYRWGF45Cm9p7VO58O9RI5geHQgsTZ66u356/+enekHfVqKAjnPDsC4s323UeUJkeTEK8ms/CF+gdL9T9StFT/CJcj9Y9lqN/ch8MuDZZMCHGLeTuX6K+yUh91MY39qKMMYI/eVVT5rU3FVDb5bqlnKhDyIkg/HdKTepRD86wxkq3gpzQGFEUgTFppjDlmsj0blMTlNc3gUbLjiDxg4FSJUbnzjnQiZXSpumJHwNA677mApOWEFTZOr4A4F4wrPJJdBKoLQTngC8rU95I/ZzkDeyIf3uoFMB5UxcAngMfzcQ6j6ElioqScEZRyPkzEi7kICh9+Y3J4jMSzYQ5RUCbgkQYq3sGfxf/LSfpLP2Bl3Iv5csA4ASuQvjpCwSgvMX+k6UOFxzyRb+B6WpH5A5lZQHCkTB6i5PbsdppnKHhcaCf/y/M3ORgTYCMaFLK+eS06uZ6eV7puU1s2Vqv7ct1oevJi/HXlZo3SeSPUebT65svaFQ7DDCOepXg0R9wSdKQWM36Et2+n54CiOU0JXyzrwXGjURkM+2b43fXdK2H5Fp8NXX1CvU8pkssu5zfXZ2XyjuHOPG1Eij9R3zFAHP7l+Pgr6ZwE9zkr0OZ20ylhEGRpimMuHMBjOI2SBVInUQWoZVSn19Pb5u3SV4nBcJClhK/PaMzxB16dncTkughP6h7lyrL7Rqx5ZteC5JJr+bcUHygdhhQZJi5BrvYdy+7VV57thSPeVRytT9ye8ELca8EuzeJJo4n5NklpsMwTZ7QMTzTsj1qIV13QXZjdI4Yb8Q/VT8u+/OUzrQxGxcj0ANSI2bH4AOUyCpBY3ysuz98AYUWwX34WJojp/SeanJvEWDWIiPlHEX9CBwwxGMneRQONdI4sZExc20P6KPVPksFofYgYes6cPxvPlidVZ+Y8LPJRalhKQWAyvFGmv9HxN9Yo5idAlAow15scgQydrkDxDobWsWDfTl9vGRB2S37Dz+7XHLPnteA2gVHbiGyo3E7/w+V1fvzin7/45y+Pv375ZcP367ZE6Dhb3uNUoFIhTAWPTjTaolle7MchW6p0LM+Hcbgc7sxao84jiwDTOB4Co3mpItuiemk9N6RAJXZMFc2WQ0RdYM7NvhkU0y2ZK8hDA55xCiiQ+lP1WK46OpNnj3h6LP4QvT8HEqsozwarkIFv4sBRMEYyu6H4TimrhCwvQu1VRreBzynXOtzGaecaastB90QudnG+lVgL0UTAITCqbi6PWF1eNI00x9Rxp7JJBShKsfGJxCEgLi5bnJgUFY244tZh+k5oy5ncCr/tgK71dZWeFW7QnQe4d6/q612414iNtLlnTe3rTiXnfv1oGj30weejec1YsOxHN/hkPjKFcN9AqOW2ado2rpu+d6AdRu2IG5vl8YQvk4nBc8TCh5Hoc919+n3ZdvgdV4/GGSJRluK7RYrZgkbhCRy3yeOG6D+e3p19/+nckw0anySvjzNfE65sC3E62EWrzyAbU8lGfXMqwk1dVDyBYXPT2B3mz15fnF6+vX7/b1evdh5TuuckM+LOZAa3UYaZfglmnJbwz8H67bZ9B8sQCIM/Q2ED/9jCPx1+Hg7AeXLdkX+mUJQkJ1B8qJPodkkonR84xJSdRMN1yjrOMfUTRlpIsxF5Pp004sanIYzU19DGPmVWDTmKGI3onFPGQ5ymTQX6y9qjl3/5x+PfgaNagcjp+Zvp7a1wN/rx4tX3V1c/tB1iro079AT97en09dsbkSjt9fTsr45OvkUkGv69ejP1d0Wq0drtimT3RKp/7u2JtAPPq8/CmalGgM8rBEZmGUhW20Wi24F4hWp4fLqbC7yroy2w/GjXejfuW9EYPqcI9ZEle9eGoeplqHrshzD1Q5j6IUx9eciu7R79Z6bP+dwTYe01FdnfTUzEIQ5iy8tH7SzvHQdR//73FQdRw36vV4dmTbC82JAOW5AhD6am03leDqxyu0BxTLmqJHUyUIQxYY6jOY7lQXifkSjEaUXmXL0Yvxz/ywCUNwOh8R1ZYsbRMsm1WNqmzzhNE0qjQJUbYuN6wa/8hoDCUCKIouuUCG52JhwF4jyH2L/dXl0q3jxWXpbjBUYRX6ynQjscB7nDbIhZkBJZceoE7ir+GPoLCERyX5HZt/6twvp7O2B1SxC4Ge9KG1bFKgh7YFb6qgu7a3cHfhiGmJG0F3b6iy7Mzu2AO7DKxY3GYrIhdjrHAqMzg4muY1XB43SOK11rz/O5qmHVWIHqM73Q9O6xgY8I4z9YX78mTB1bSZSlKLKse/mWLWjKL4u+AEYQsEQxF+FbJhh68+MBqNMkXeG38UNMH+NvhaDCiggVFlAxzksjr4UD6YhZKsX2X/89ADDV5mhcBCKdXk/ffXEbLPASndjoXR+qfitDp5ovVaBVXgNuXKT3S3DKCa6on3N+lj+r9f1UIKfagCxoqI8yzYdwCEwinsuWKdbHbtPoRGeAYqCyvtpY+mXjlJloKZNVM8UBncfktxxy7q4ilAGM11i5KiSp5Ikj6aOzRGtIsegDsrgETTZhY3hDUwwkntETELc1djKZzAk3HFwcS5nIWTiRfJjcZ5ymoszsCkcTRuYjlAYLwnEgvIonKCEjiXgsGfh4GT7JJ/xpCVO1B9RZmz+Wy9xJd7HKgTBA+jOFf0Fek83+5uL2Lp9sOQVVmktqF5+xgvCCUCSe4VR+pQyCSkpRhV11bDLBNd9alt0vCWd5iDJwOoYzeY5JuUmaUsIxTGM4Q0scnSGG9052QWE2EiTtJnxdl1Q0VNTKH5e1OZ27UrgfmhBJ8aGUW5G1WYWcZu5KD217VfxQ9uEm5yeVN3UOXWoISDuaCm1XJkPLzZu8tsa99JqsQQQoMlPLK6OpjlDSVI1r3wjJQ1wNLP7a7mEpxi7WVPN5VShxfy9bxuurmf0VwMhyBrpb1VZM+ZcgLpjOCfy/Z//3n/42ev6/nj376cXoX37+p2f/dyz/8cfn/+v538wf//T8+bNnP/3w5ru764ufyfO//RRnywf119+e/YQvfvYE8vz5//qDFZ0PoyL8aURiPqLpSGFvnYMmo3ktKV86U1S2gw8y9TFaGsVBY/XYp0DdFazbvcBT7PiQBkxs9gAnnE0qJVUnKlphpLsc5V2O8oU3eWrp3rqHi22muNVhiX38JXajaV9fZCTeaJEZtjWG6Qxy2IQBXRJudJeo4FJHQLjRbNghcgp6G5CZugsTJm71EQmICDIqedtTvsDpI2FYfIRiIMskwksj74yUjBRae5En+Ge3NZyvBN9vP2bEscYaZ578Tv5LqBFpxkwEo1SmkObWLOLlrRECAITjpWVDNnCxHsECHaCxRCLHQfdpoZQbi/YDDMQVB90gEup6zJYWNZTPKx8YxFNEQnVPgsrUi8FYYYL8bC7UL3KwY2srBw0taOUomR3EtLe4ylIgpPgSkg6IIGmvnMVp5GiUdHBb8buPaPBwrjzV3a06xtcmvpmr8quio8rAW0FCmSwS1YpXvQDEOOEZx6xCtXErVB+6NKgjDYwdzWs0eFX92qzAoholVh10QgU96nFny86zr/gFKEGByB7fb1Bn+jMzGgNmwxHBVB4GbM04XsIcxzhtBA85lv8ScSkM/OlLT7K0Cw4lxF6T+KEnWc7VV4YqIV5BJP4W27SycHc3heac7sJ0VF/Gg+7uHVJevRlKU7QebIpeBTE2aOuoBZ92TGxh9ZtKpx5zU1kTl6W+zcJQjhU6bKicRYxT7UHi6BrJoRRHbq5OJfF8DDea2MrAN9iAkAKoSgTkcbRe542LBa9rjwnc1MGqBomSJCLu2aX5IWaEiPFgM57ddnvfzT2+ZTuoG/4GN3rPu33fw8t9399s4Xtd0JqXMD+O63Vp+2yvb1td5HajNejAq1jJn1R/0PuMadMpHJbx72EZ+2omukRVJ0tu01F0rQGHBqNLW9EBtrcuQ+stOsD+DjasRyOBgXbl8Dyin54VnxRONmVAcJWo7Q+j0aBtYRbTS2czuKaMEQFKkp/BCUS/HYk3TzsG2Lq5K0qKO9Heb5jn9e+MXKUutaLn8TZ4JSmhMrVOhBhrv8pWBb36d2JP5EvcCHdKjCNtd5tcJprOIMIzrvwkj0yBQEs/TEMP27bGs1uMrTagsQvwc4HBPeWLFqgoxQY/lQ9YwQBVitcHM09hzmPm0p4y7d4E2pxXHETYw9nvwbgPIuxBhD2IsGXJ5yDC/h2IsCnNk1pIEvvKsTdX1e+UgKefPEtwGgiqzrGMNHnedS4WekCZUUmmWV0K5ZlcTLWunv3Dc71OW8DSmYJn0qJKXzOZglVlQJMvpcZSF90WHYt+26b98uru4gSOrViJHjSo4xcvVCfag1vLiy2ADZYVFWqR0RJIoV3VfXz91T+M4WUbsg0E5arO4byAP3/TbPPnb1ogHr94MX66GxmxnX/zBQkecq9ZzyV5V/moktKUBA8VBXN+x2oZ7GhU4T/l1OKuQd1TGmEUuwZFI5yWXbe7R1R8cVS5qBwVWuaEhk9ZGfZ4sLHNs+FPnNBQXoRKmMjrgk5mLO9J6k2HIQjFa+BIeQnWohx4SpIIw58f8PpI+WXi2QwH/C8qeWM7E1zgItYjzyjzZ/Ovv7RtD1+rqcLmpNPKViLdhfwESBySQJBGjVMOX0EDThXe3RbQC3FvgyVGMVOfCImxAoyN4UeV09Gsjk6gWug0ugKUYrikwqU4zCJ8BNcpnuG0eAIo7rZgXtKLDzjIeOe56S2Qifzyfcj+A86tuIo+D3it1pt8lC/hbvLkaoBxK/0f8JrJs6Db7i9HA4SZe3i+WJcZ43CP4eIDYZz9q4nHXN6T2A9ZhRqnJezMtMah/FOiuatJMYj3mpkr/VHJsRiQwOspU9VABONakKRzsNq+puQzeCe843OM1DpWdJRjv/g1Q1H3DjsvcXn1iQEipuvXjKxQhGO5Zx9JFAYo9TLnGydzk1QRSW4aoDhnmcVKYt0CNwBS+SQDGUMm+MqcpuudzWuxPUwYU58Jvqt/XZ5peVbJCKnOMdIZyMyR1Q0Lz1RGY7NX6Mzw0ZzpdPO8kugv/deFYbkU6/y8OMkKDtK9eIogSHljIUyl28X8SGdhNttTwfbiFLJrsYTwCqfwLKQSpvAq4M/H8B84pXJ5x3iOOFkZjW8nWCP4ceWvBojBC3gmwQJZLnFIEMfR+rnRlSkflvFgd44r/ld3OaReK/Cd+KLK/iWQvrw/F02o4ewFryZMc4ajggu5XQOrv/tcBVssxP8U6xnlcaFql+5oR3vdD3uJ7G3OMY8p4fhMCIV9LQU/Nr80syiBQiDe7cRs0OXBM2qaOzbxPClo0cu980fLZ05KtHgFHjw3D56bB8/Ng+fmwXPTH73deG56nKZtyLahWTuamLVJ2Td00AN7F1KdRvbaunNZvh0Wdnn7sbBdo9ZieSYO8YDJYA21syuIAeJFq0hEgDYgGqncljSjdaGnXuGTeuQ9QyibM+SyTxs5tACDeM1ltVrjwwJa5WsScgTHMXAKZ7fX0xKQQ2TmITLToiE8RGYeltghMrP++51FZrYYnnzNTeZEs5ucGnhOZ+XXzeNKa0L6H0s+oZ19DVeCF9govZG5qmKSskD1MlK1XwvbDFKbmqGUmWPQZmHwNT7VjEsOmD4mpy6TUgf7dZqPNjIadQb++JuKHKYgF/U7DETtBiAX+VvNQh2EbTcBbWT4qRp2HEh7mXvc5hwHVKeRx8+I00Lg3LTTx3TTQXtPM80mxpmy8aXThcHHJFMzuThg+hli7IYWB8hW80u7ecUB0WV08TaqOOB6mFq69UtdwmqLMWUTE0rZROIYVg/Dic0w4oDaw1zSuotaZKjWa69dT2KFpvL2+SeDks3r21JlMAO0QkQiBfSe4XRlkbkkizm7vT57mtc6lihUWKlLqhAsgXRLh2dluCqh3/XZJvJZffBnpv/arSLvSA3Ndt3jgCDAqVirIJOQ9ZWlIsT4XYpiRkwaQ4998hoxnnPKgnzAc0A4VPnRaIz1UnAyVECxXPftmz9EHI9El5ucGWKQqmKN5wDv5OIrBklYaZSPiOVJ2/aH8xIzJpLOdyN7CotsiWLpvyl3if7UyL2yALC23aB7mvGWrCjFDG50OKcYMRp74Hy3wLpxbj3LCfyUadpvh0uTAzlwuc0L4FfQOJKLl87gLhUC0LcoYvgIdDbLzUQXp1G4Spt1krOXAp0NemzXpzu3pVLr7PnQAKindO3QMFcb5wa8PN+ruDc/Y891hmdzstq4oclEOx7Yty2J+Rcv+4/QJn7U0/22jrGewrdjjCjFBv5HGIktRXDraGwpf3PRqjEqk3t8YDnh5K50nel7Ga51qesMrueKkzolm3eVZtX6AoYLC5aXJfMUhX5ZLDl9q1q3Unw6g9OiackbX4xcueOXjSiigUaieUkWfS4RJwGKorXMlE4zLp2YlyjOUASM44YRzu0Drve6zxY3a0STO6/H/IjU5VSjDMWpwVOL20USoTgH4mvtsh8ZtsNCZA8rhDR1eqTibhmQiCAtDJH5HIsRPS5wbDV2abLIe5VZ4WbcYntLo1bTStwm0WkwJ12abCPM1qhNZ/n6GPQ8bUKc4DgUsrtegWEnEueNT0qENZvlcYGFeCgVCsGCRGGK7U5syl1HYy/pZ5Y3cJrTOh9p6rg/t0cydIuSDUm5aJ7zPyM668UjJEoBGIxcOdhMqOy0v7dOYIvEWRnTGyNeMkBO0XMGjC4x4DSlKdAgyFI26C2+uITJWny0aGZIiwIu2FNNtlRoyMOjLxbyo04kBFvIpze/t1U5Qr+eHeKW5XFTzBtJbfTA+kVREOG4+CuvWKCS0ctXoEty4bCynHSgln5WcExRKj/hOLys56UfDitp5uWfpRs3/PTzQEHF4TuDjXj4d1BBghjBZzclJEQWhAVl5ao+VQGSMi5R0Gk7czwgRwSYNi+E1eoRVaiNZWkryWC8zcYiVM9Vj6EwiOp1AzLZv5Qoys4teT2Ve4xjSBZrpkSPfEkLm0qAeA3v09pT4xdTVCfvN5RZinH3UDJVD0g01sMpNFSlQVUw/baA3A8lTjmKrDiJF02sFEIkbqJg3AX7oCEY+1UcrW0ITMXJLUxerJjHavBqpfubKqjmMdtRmeRGF0McWM+AXG8Y07HkvKpxo6ZRnSjXzg78CpPoG1gv5PQ3/kh+b+2kC0HBLookgGOXa7cLVymoaFWEOtXUBm5gV4K14f5LFohhjyVmZGRFQINYA6HbsmajHYHtCrmYi+2+KrlU4FtKuZj37loupFLXxlHMhbiKuZCi/8+gmosZbaWci8QVKofcoZTLoZQLHEq57LGUSz1vPJlJO4PSH+RevGUO0tiTbXqM9sTwW6aEtxr33cngfex5/cKInEg4cmS02++6g4b8w6G8A4XM0BxAYUchQj2DprrCgjYKCPII9/GKEvEJ8ekf3OOBHfQP6/EN6PEL5fEI4tl5+I5XGr5eMTEtwTpbJTRvt9G1BuVsENBitAftdijdqL4nCtfecm0QZ+BjZ5US5yS1pXj3c3Bvmf99JHTfIpV7azUZe/r2LRO3a5nSHnXkSNnexom70rRvWWjNvqM2Ts3eqSLvOnTas1j2S/zXmfLPP9mfZ5q/zzDB34ap/faSm/IzykrZeap0ZaI8LMVPsxT7hgh5LMbeYUItGdC8c0paQ4WcgD2ySX6uW6n1dUeC8y1Sm3fGcfVOat5unvdJZL6LFOatWHilLd8iYbk7B8eGqco3SUhu7V8nKd8qFfl29v3UW0rbRS1cqNbDbU02fhDKDkLZQSg7LMWDUHYQynxe+6Ts3iZZtzpPXLEdG6TpNsm4rSD7J+gup+G2guyfmruSgNsKs09S7gYC7iwCnem4nUm3PaUhF2/sTrG9n+Tanmm1291QO1Jp7yeJdoslaR+JszdPmd0SwNs/WXa3FaorQfZechLAnvISwI5yE4CfGaY1xfU+8hTAVrkKWoB6J7Su5Stom4e+qaw9CN6dvnqb/AUtg+mTsrqcw6AFpGeyaksegzaid6Sp7puG2mNOeqSe3iavQduO6J1u2kP8hD6Jpsv5DVqTn3qlmG7mOGiB6ZNc2pLnoAXiVmml/czvPhe/jiTS2+Q+6OCuG+U/aGOum6aM7tx9HfcQTyHTbtz3TAq983TQLYN2+xt0J392kson4fOOUz0f/LIOflkHv6zNj4aDX9an8MvaIFGCHZHWdMjORMibZOrRAc/EBPloe6T6CrPdePy6dqF951V1uEHxuMTXi7iAHq5MIqLrZGDVRLj08D4a+E7de9VFyhnRlWtr0IzjFPCHIMpCO8TC01sKs5KnJyglVnXCZ6X0763ulwFyn3zWbrNlacryNUlik+Vbblg5EfPmjv17mAehHP6MNo+JIVV0fyZ2AeEs3xbPTc5Vl/dFaaMJhdKaxPOyJ8cRYB6Mx0d/d9P426xbo/wf394aZnzKVcgKZoUkisKVOAFCaVZKl81oKPOT2ai0o2qNi2/iBEDnYsLfMrfw0GFQ9TWnjnwEmWoeiAK3PFNBERotGbwx9g31OKL10C210phlS+N8od1ech2hMbrr3Bpjlc5MQXWC1KeMVNEoBLXKJ8+Lla992VFAE4JZaVLc1/c5WYndg+DXDMVcObtQhlOtMyxRQu7Vwlk5cGRXNj+G8Ri+p49Cx6NSY1PMgMTiXDTnZplUebSn62LzuVm/e+/eLvl5VN4l/cyubZqDUtx8+XE5dr38PLN1PhKsx1fjsEXKxqlMPmD4zGb5G6+nec5GqaBpy9s4BbGcB5ZNfMjbeMjbeMjbWP8d8jZ+tLyNzjCxar4Jn6yFpm2NFcoTAAJzvmvA8E4kuWmimgPRl13nJb4t419LDsMFYu2hb0+V74JoZ/i3Vr3qeHTpS3UECzJfjGSpJWDZconS5rW6lJzCHAhqUCrA6qlvMJwlA4pv3kW/Oemai1pykh3PiUkw0zomkzoGiHKj0q5S6pk1kZvLe8alTbOncTFv3VloTItanhzYbRJJfWPQWUMMkPEhd+Qhd+Qhd+Qhd+Qhd+Qhd6SPyHfIHfk7yR25kiKZcrXfUe7IWlZBPRMeOdcULrl+duuMgr653nS/Fb/qytXnI+Z6UyKyFv32kexNdaDyONiSvZXf25O9VZaMO9nbKrDleit//OlzvZUHa8n1Zhakem96NUlGBgAAAACIQSB3dGGItoCX55AO2pIcX62NeF7pbFAcK5HoJAfJICIPGEKcRHQtQmyOgK0CaRo65J475J475J7bKPdckt1HhC2ck3St3hcGRzG+fG9yqqNMxH6Ui1/v4rovucraMwYyxmOZCWgalndu220plq1PulILTc8LHPWgunCtgYQCd4Ov34V0w6R+htegZl4/F/9VAmSt3mpJZVL9zFYPt69XkF8QrdPW223l7bDvfkZmwp4GQrs/VS1oIL8G6ZkyqswsDnEqHSGamoPSFI+9bXisJFrN2u14ZSlsBgukMC35FygLic5JdlZAPosQWdrq4Wt5QfLfXumurIe3FevT62lZOaIUaDMsdCe9E2fIIAopyHbGuk5nqptUnlUUECQEB7giB8hkuTKCVTy0gAQTH6ra61LFCrmS7CB4GyAl60lZe/IdteY5AwBQFz3G1CVXi0tZsAClohZsRN6Fx0sUkxlmfFzk8vrp5c9jK8hvaQr4A1J6eaKonJ++kthKXSkJkcPTCegHzgAgPeBHOVCOHjBQPdAMS6HvBIYyw3aB4n8J2f6/7U4bz9TCHIomQ4VYLmCVfZtzaDqyzegBnVnQsDiNnwNNxdhjWgIQa/fpPACwgfBPL392YFulE5A4xB/gpTIBSG+T8LnwKhHQ1zFHH+SGWlCGYxkSbceWwgKtsNLtPOIoyiPRH9FaaTzUdClfERFjVRVdrVDvrs6vThRWYgnNYxMeNCMxUi4x1SAklklowCkECxTP7UZeOVIMs0wIPuPeyXbqMmaLrFlnDJ9IYvMaVuyTsOfpZWk9tw6rLc8AXeF0RfDj5JGmDySej8RCHKmVwCbyFj15Iv+z0SjkxdVvKLLpxxiP6IdNnm6a1sf3VHp6qyW7+pcyZFLG0+kbZcE97R7GKFQsF8XrvS9bQUhpdgjWI61zG6E4FP9mhHHxvDflMuKxSd9Ozz/OYs5I7x3pI1rdyin2la5U62pQkVokWroTO+J/sxgltwvKY2QxJYhIZ6RDncegTgkTPDkjUYR12gwy08GcCIJI3hO0Wm7sbRqnEQnWHelRRZNiPGoQ+eUmv74oWFL3iNI5tqR4jcO8XkcPaTHxzi+hgZ/OpN55bTXB5neosiLSzg0YxFhdMO8xhISpPYdDQEFKWaliS7VChP/IwAR53ZLf8MnAN7RLtM4z7SpXRAUGmHwTwzKLuMju0BbE9tXxS7hfc8xKQI2cw0phWBKm3r3220nxuzNfyY+CXLGipASxaANcAlRUK9KO5G7/V0aBlGqSynlFPL9I62UvLXvKV1fHQRfotMUKazgMCIMv4QdJljGcxmtI6KOqsPlS3nJKVGuJqj9++bUGAoQpwd5FszaPj+rmdV+1ob7wvZbSjjZL3w1jKTbidk4WmYRC4/SjCgxwmtCIztettHJZj7tde2tU6Z1VSX3uYTSV7YQYUVYdVCstlDRcDrZZI/8G7KcjNyfYnBd/qyXkRNGcpoQvlvlTzCDNYgZ05oAJ8BtOqTMLR2dowG8rGv1I0wec+oX/Tq906zK1i/K5fCEs6DqUF6vsDO5VqRTLML1im6Hvs4JUqPKMeqTlqn4AIu9T5Wwq9Dt0VjfGWHpmIhmI9GZSbYGwsvMW0FhLuFLkUZ14QAUschc83SIlUn2g9TwdzdG6Ujp0c7WubaPi2dujshs55i+R6rWBdaneYAslK/RU5GSb52xoZ4QAo3yEm6R8aAsLVtJhd8ot2cybQ8pzxY6qBAQmem0bbon6HLDXNCwO10rKMJMRVmMmE4c5IOZ9urMxdSGt2khtJg7PM7EidBIqEs+nKp+Meqzy0rQcBdbjwJTDTNUVRXUFnOYvxABZC0QQjYVdRjNghjhhM5XoxVAc8Adz3pTUca1A79cltdUR3GccCJemw2BBKcPaGKT6XBEaoTamDwDKKT6FJU1zibiElpI7C5CEwZIy3goxn5fcPzVWhT3lH3OpX2dcOEiLDh8xmS84O2oFKU1xQqYVeRBL6Cwx5jL9q0Gd5YvAMAOhTm6nwDOze4wJ9ShnJPWVZZ26dtSF5fD5UZ4oGclx36+BcJk5R5qNU5rNFXVw5IEwnZUWgYruC0W8NwwVMYdGgyy60qrvmIa4FegCSf0tM6mTSln1ApqmmCXCvyqeQ1KwgDucLv+1fRPorp+x58USEF7yZgUYtiFWVbFyxq2ZnpxHq3M760Um9cbFYlFjDOFH+RaH19WRtXahLS8qTxkKQxxCgtORWpoUZiQOLePqAKkp1bG7ujmjblkdTlfzhmRi0tieCkjFyuc4XR4BYowGpCMxifnlM19dSWpWxp0A/IcMAKpOj6tMkMfATxUE+DXD6RqEig4QMMzLfswde75mLyAMAsTUkTH2+rDfiMHkr7womJLvd7XB18EAYYCk11nbvccxCbosEk0rvHisg8U8uXP1h1IMp5fn7RyiN7/wWA22ofSACYqQORSTX0lrH6Uv1BGgXhBFklPF/eMioZoErERI1pF40QpRAtS+WT2+7b9g8x77fVCbnoci+aeaJ5P8sydQdVLl01NUjxj3BNR5R3H9uvNvepCDurNy9gQLjSyeORPUq0Nl7OwNtprhcxofwSXl4j9Fss/eMM8pZpeUSwgfbcIUGbaaLgVCsoZYXSxVRfzeuBT4WFMsTmOgqabzRhtDwdYomuyfMY1HMkHjuDdMZxpIoGllNneIrkJV2p16A1WQlDttJFybIZQ3A+UOKlK9kqA30CVO5xgSmdK558e9z7Qt13q78sP961LI2H8jwbF6fmGW0qDvkJzqnl3RQYpUr2XxSH8y9KsHsqOZbkqCCm3JoGCJEqAz+K88t/p/95EPEEmF9UlGGES4DMX41JQ67AFYYNVI8Ixic5EWGNdl2j485VE4L4nlWDgKDR/wenhU5189YA6n8fBIeyDVeFUuETq9pRwgJZRhU7TeRGDuvS96flA4tWxwOys+Lhl2lcqieDXwPyoqt8WS3AfPjAYEzcWM8Of/6glVhG0BTdV5o65OKg/10PjIPWUFrsPBzvl9773fj6cZY+UPeL3B/N1pGpTLANNRRAOp835mtAzPPceqIm6rIGJORjmcQvfQqbCtsWwT0VFdIZpZ+WlrbWv+SBtFSihLH0Xl8oikkTH2nTkAGhuVr2JVkhEYBZdEXVHA/0QtzW9JC4i00+VadWYMZp4wxXd0VrpjSc+aUJ4serym8kKpe0/g2rHTFAEb7HyP9JFiRr0G0INzKmVZTyWe+qikrFOLobK+Kzq5gcdRXlUrHpktkQp3GDgeibI8nWB8XET6OIv0napRfRid7RUpBzuYTR926zJAbGna0vcuwx2rokLO04yVqXWw2vigM0osletSyfwivJZMIaFQWdHsuRGK331hdwuBxsYN3pTRcmLeCrQxKo15gBHD2q3HYE+XWGVda4Wor35iWNjMADzD4/kYwgzryAoaau6r4u+eH3WaSXTCb2HLo6n8jyAsTwVLVO78mUycogpIdKn2BALSuYtwpkn4o87WkmI5bcafLrc5HXUCjdQVfSYBsirnkFiiYFHfWKKvVqAyaJTJM+FIGfpkZlycLll+d1fWU7Jbw9B5EdOGdSW2kMEzcVRHYqlQhnNOOehgUA05QWnPVtiY4pSjVy4GPGPPn3fClCWM/MQld+qR8q9DTLKKJoOu46YuuHiIJF1HnRZY4M+ls/QvWwsjalfmYkgx5cRP6PLXcfc0Qe3H/LSB6cl/hLCxycnf3OS1VszP09TUyY2KX49bc6/rmbd5qY9Wodu0lJuL/GG2mJU2NBVtYibqaSLyMw/1No1taRraSB+3iUloL+agPZiCPMxAG5t1NiJ2f3POfkw5+zDjeJhwXBVj20Du2nzjY7rpbYKADrPNpmaYDUwwG63LTUwO/c0u/Uwuvc0tG6qUfcfc28SyjXml9yzuyayyF5PKHs0p3qYUZSDx1ix2mVFy44gnxG1NKL3Weo/GfcwmezGZ7N5csmtTiTdP7rWH/XlRL9PIvswiuzeJ9DCHlA0dPo6hXqaQQqMw8GUzLWYQm4HDa/U7tA5O48bATzRxmj96Gza8V7W/ltzXmLEz1XcnIJR9uMkzCvqErJyWPsjdzY07fq5RsSesKv90lV4ZkzMqolyAkRBDgNIixQrbpkBmREQ2t5PBLoWXjvJG+WR7m1k8Sx0BwOdYPQcANquhY11fT1/LGasl01+iD2SZLUs1jPSS69iY+ULVm75vSolKLNlkiWI0xyPd9SgHP8rXqjWvSK9dbfIOHpbs72fJ3ug5qy9aEm+1aNM8ZmQ6g7wPwoAuCZdJ1WhazrbVEfTFTcIDBpyC3mZklkei4Q8ibJTwaF0KRGwFWZQLV9caWbZjabKj5omnpPRQ3nqtQD/HbdnZJMo2CzVnWAf5OgLNUYpBZ7NxjkBl5VthYeNwLlqfosbdu39JY9KiQa3moFdtAcci7TwDmkJImPp3KbIVf0hoyh39AYAUCAKUbpjMASpFek8Gu2CofasSXpYwqOjP9SSbtD2iXltbzhAlDmvZXcwolOUmbeoXAr4JNmtJEeixppOUUFHK9ixCjLXFsdcK49S+qgQ2l++4rT4RpZFNZxDhGVfX2iOIaY4ZBKITIEyDDTdOFiG3zjlO7IkfG4P897x5sYMlCJUKB3Egt2e3U1eWI/NTFx4lourQ3jkVEzi90oWDpM+BJUtxj6HpbAAC8W9R4Lt7b+pflY0eRhrLmVaLNCPBlLiZlsSAyySKsc4RWDCEvfKvtNdVp889B+7dN7rGPSc/lw43m/9JYuLhZnO42RxuNoebze/qZsNphFPE2zySqqr3ov3RNjltSv0ONjJPNLJWCFlSyJwlFIEwXRlASSS601YNNorXIGjPdc6UUmYPnkoP0D/npsMjVdz7L5Cxdk6Smxelft4Y7/5s/vWXcUvaHh8+qfDo4c15IT8wNXLzK5sYtoIFnCqMuxTpShGvDFHyA+WQWgLFtDOt594Ec+YVVkoMl1R7deMjuJb5QYonHm5al1R5f+Oxh16+81zo9LGqkPqHwqtKUaXsVVXaB11EKTypWmn+gNe5P0wHSO3vpS9e+bI0fiTKSeVfTQHo5b1OR9sBVaHFaQkzM5G6sr5EcRcT4efyVZmNK7eTV8Vxq9N2WdjRtUNXzUmr5Jl18WuGonEHxPPSEac+MCAaHgaPJAoDlHYtepnVVLIpYFTf0CSXDFCcs8Ji5bDunCUqnzwJRNErEHxjTtP1Tmay2Aa3WJSm65WyqP5tI8MeTon0Ze6aVFMUssAGnqlLvNkTdGb4Y85SurhZSdaopNAnKjjleXEqFTyia7G8WhuR6Einf5aFSjE/Mgmp9CZUkD14gexWLBm8wik8C6mEKKMnno/hP3BK5VKO8Vw56qst3emarjRfXFcJQQxewDMJFMhyiUOCOI7Wz421UAV1tA/eR1NQXnrddwI5lB7r7Z1oX2XpEkR96fhZyzk13NriIXhUcJmSr4Ni2N7L7j/F2kWQYlmsTu/FHexab/HTZcHurKlueeVMBW4v311nBTKPIoqq5Ze0OC6WlrVIcFGyp1qFqZKbOMGpSqGfp6TWF+IGyPs1ZAyn4xKWhZQHKMVib2RcqNL7lB12lHmCXtfu1uu23zXb43r9mV2rN7hOd5aAMgvNTEuRebcj8by6ORvXW7NOZWp/xR+EgKLEKyIviSoQz5SFc9RhqVZLk0Wc6+UXRTkCtqD8SPUW6r7ZeMP8xWdCd+CbBls2ttKvWvbMTTmpUBenIcvDJ0sF/WT9xRRoXGxaFqBIvJ+E9NFRzjoprrrSN1JY7uQ/ijcmrEMX6Wyhln3XuJ2LRvVqseVXZRr7sU5XzX5rJyNdFcJVwb+zRF6jjLuthHuNN5v666UMzhMFKK8i3UzxW2HkR5DQJFPL3Dgg5ZWFx4daeZ9NrTy/Anm9S+GZmtYnAy9VUnvR3DMDrXCQNgXxVZU8VzFzOlPr1JXJO2k9g0UV+uuU3jur21vq26uby6Os83yPQ7PwFfp2Sc+nmH2nHChwvUtRzIgpBN0L4QqawHNAup4g0BhXi1XXf1J5KwXdPQ6ypSh/bWTfi0r8o2YlfqVkEwu6uoAE+Vwjy4kx3gRnd+F+Z+l+BPcpwbOi6q2GrhQHuX1g4NaazhCJsnQjGsvX3die6ROi2Jq1vabPAXmjaim0CQAAFomdMLjHKhs2k2WXuU4CUOpR32kdMHX9lKc3EsAtRynH4VPAPOhPFrdoADAyq8v6Tk2/85gZ9BDnXDc4WYC+/Q4mWrjOePl5rTK8Yrs96lbZ62M0ii7US0XkOJjCC6xSecFdacVacMFxtnTWG6uT1DoHuu7puWIbTnnqXaWZEtNCrU8yLEeIUFkyT1ElLsB1FqGM07eqdSt9pzM4LZpaKwipmm/qkBcNNBJN7aXoU4h9gUzcIQIQBJMUnGWJYiEqMI4TNvB1ENO1UFvRP1dtjDJHk1u7bWF4RErhqlEGmpZlyubBHqE4B+K7jJuisktcFkkxFJ7qG5XjQJgWSUR08StT6VSs6NiqzNBkkfcWsxPMuE1+Gtas+dGqelBgTgae7LtG7VKqhr61B0Oc4DgUu1qvwO5ShOeNT0qENZvlcYGFVCGV4/KynGLHFXEGKMde0s8sb+A0p3U+UlfaEfcqNmLWW3mPdYlYDQGraJ4rKo3EpRfPI2ISMJgr8mAz+UlEpQlxx6kqaZ3AFuGq6m2q2ilBZSHkLGjKWTOlCsFpSlOgQZClrH8ZUJfg5BCbKjcZ+dDcVRUarktCKxbyo04kBFvIp1d+0uQIO6mEaXnclE1G0r5b/rMquY9qh9nAClu3OYHVcfGXZjkjVTlXvQIAYDhd4bCy8IQsKNaTelbwVlEyO+E4lKVoTwZ5dWEYqjjJJMpSFOk/8zsJO4Gffh4oqDh8Z7ARDwej0WhQqmIOKCH4A8exbGIKtq6O7zFHxwPV11nGOF0at0OZUEl2MzDKO4lpHFNedsgoNBijOY7H4vZ9n5EoxKnsISfYi/HL8b8I7HUlRLH5GUfL5ETu0YEuPKzkZSXqmHKdY/lsTBMc43uB+EDMpeh+ntIsOYHGewWsQsmSJHddKI9EmOoP9vevCeMV4lswGwAAsAVNeWnmAEYQrBL1jsTSrNH8WvQvZb50hd/GDzF9jL+VRUhOYIYihgcALKBi9eX1icMBlHR5qjMx6NPr6bsvhLvBEp0MLDuxMbTivgSoZmRIc+dtmtb0Wbn8WS1PZTt67fXzq85dAmvVRkeJVo5drHzKlygvkJOLyE3OUakgDreCpCkrFbBf4ZRLzjOPyW855Lw2u0yEUz33ZB6zGEXGQo3iUGZxS7HoA7K4BM0k+dhzceSc7k8HHdyyXpjcUpBcFbDgSokv8C/Ia2KDby5u7/LJbrrL5+X39WesILwgFIlljS1pUk7pUkLEcSgT8sk/LG7lLLtfqgJo2iVPGB/P8hqtWhAYwzQu1ACfqpS6lfBlRtl6aBn+ZT84ExzkW6K+P8VA5Xqvmx4sAa+cluoi1ttXN3GbDO1n0+xfrdWKBAAAbFvW2DUS6CpnvE0pY5cDxxZljIXjoLsm805LGO+pfPHGpYtFiWIrRI+yxV35aNutHR6lij91TW/vEsX9yhO3XfPaDIHtJYmd2jtHKeL9lCF28ZpWxUF76eGtyg67ywu3Xr06ygrvp6Rwaznh/ve1zhLC25cPLkoFNzDesHRwR5lgHxNe//LALhMdODlJ23LuKgm8RTngVs4GbaWAtzAxOMv/bmAvsJf83brcryuqsKvUb9s0It+DqV953/Yyvm0Iwe7K925Yujcvz9sSBNmrbG+tNK8TrF/JXktZXifE7nK97pK8LaN3l+ptL8frBNldprdPKd6u2tLVEr3+ZXhbKWJSpfcqweuEWCrNu4fyuzsuvds3eGnXJXc3KbfbWVDXLxapVyHdvRTR3aKA7iFrefV3yFruuaM9ZvuQtXzziLoW8h6ylh+ylnv+DlnLD1nLD1nLfVsfspYfspYfspZvv9YPWcsPWcsPWcsPWcv/h2Qt9ym9uteyq1uXXPUvt+qXYsBnKvqUWO0sr7p1ZP6uSqruo5zqZqVU3SE7sFkZVY+i1j1KqFrLpDoBd5ZPdZdIda8Zd+nU9vKoTojusqldpVGdIF0lUzvLorrnyKNcah9l/o7KpG5UIlWeX61Ae5VHLb1sAepdGrVf1fYeldqrhU8HHTJOW0lUZ9HTgc/xvqXdpIdBYQNjApC4s4KuvyHBb0SwkQGhr/EgV9t2QgYPw4HvLdD7GtLHFFBS8ftdQzvMAA31vhfU7UwAfdX/PVT//mr/fmSEanq9femI+qr6fdX8ffLWFT+nir+ptveGuRv1fm/C9lPre6v0e9q9oEudX9Jh+S9Ltyq/3pc3TIca366a94ZqU+Fb1fLeELdS3/dU3fdec33V1/3U9f6q+l5q+g3Ulj7j66Wa31Qt32uG+qrjtaJ90I2Gpyq+qWQf+LHkdjV8XcHup35qU8FbleseYO3q961EKu/V6dnQV5neX5Hu4VnQQ4luVOQdEHehQPfiit47zY9DeCvM96Es76ko97mag/V63qYkV7fhQdeG8lWQl2/EXcP3VY6X78QdMP0U4021t79dpZdS3Gu1+mlgfRTh+0x62l2uc5tSna4SNtuU6ey67LUXseknCnRWAvGvAuJZAeQzrP6xYeWPvRSq+YyK1HTuyq7iNIel+GmW4s4K0Oyj+MxmhWeKzNuDdkHft+jMZ7WVWl+3lsncU4nMzvKYXQnj23dqS0nMbcphtpS9bM8F1VXu0p+RdfCdbUpcalHQ2u0Oylu2rkGvspZblLRsq2O4g3KWrbPSXsZyixKWSphvEeE2KV/ZEXnqUbZyi5KV7kB1ACg4ywblKrfjJ6m3eN9TtnfqJPxLUx6k+YM0f5DmD0vxIM0fpPnu0pDblIXsKP/YoqbeR9nHzUs+thgC+pd77Pac6CrzuGmJx04Fbb/yjrUCji1wfUo7+hRv9OC6rX4mGxVsNDeDVtuHf7HGajlGj6qHnYUaa6UY2+ahb5FGD4J3u7tsVJhRy+dd9dw8izKWyy62gPQsyGgpudhG9I5ijH3LLXrMSY8yixuWWARnmQ31619e0eMEhj6lFcvFE1sgepZVbBZObIHpU1LRUjSxBeJW5RT9Sin6yL4dJRT3VD5xH6UTNy6b2Ln7vEQxm9XQPyt3Z+GtRqJiXVqAqPoQCxSHMoaiKDBwxmxZndsFFmttEAd9+ucWP2QM/4QZw/O0f86M4eW7t4zKOBMfFjPyb7dXl9eIL05grIg9NkXXxpxyFA0ccn5xeQwJewDpWiFGyrKl0n8hEARhmOcijFGL6HoPGrIa02lUDW1oLM9WTIWeuxtRhaO5XurkttG6grU8uDTiFQTfsn64yU1nw2kqBl/kwjU1P/KCAXzRKHmjMLgtJ/Bvx8Esx3FjKdkwOp3XS//cVLIb6hma40rfoSqksFlG+nIH1pT0ukFbTvoKkew56VN3Tvo0x2CDpPQsuy8Ux/Bf/719nno93mqielsCawiackgg2VK9asshT/0hT/0hT7387SZPfXO7yuSopuKL+LfSfjo5qfpZqiu5RDdn8vYtE7eL1OOWQtgbJ21vyYq+QcL2enL2Bsz+ydpridkbEPslau9Kyt4Wse6+v7mq3dbNruWLvq51VC5rbS3a6lv+rCUFeJVbnSmhluWW0GYScCGV6fJLhGk8bFX8kFDVsxOg8d/obPa3+W8kkf83uvxb9NuXf4t++8/7v/0W4ae+Y9BHOwl9Mq1Pz4EwyGLya5b7vHAKRElp67I45tu/0qxPrz2yK0+vK0W2WcCI/hym1yapq1Z22LEACDLJSZVSeXolvXMiikKlV5MXaLNTSnWyKzXGrfmaVdHNnOn7jr4lY3uFAP9RtNsmX7szV7v/BbfPNd0w/q57erDaqsC1q3tTsdmUliwRzoAyFVNcLh49Mm/LC6BXJYD6JatxpfJIm95+TfTW7QiusxHO5mI4zG+GQ6l1YIzcR46yJNULozp79OcaqtJ9Kjapdal0VmeaTnoENCGYlSbpCOZkpdLp/ZqhmCs/JsoU1+L5sFzVEQWmaqQoyf13GDCMxwDf00ehNT0CwiGkmAGJgygLsRqKo8x/MdFGyuo3Y22FNsoaiPLz0n2/U53XXRS7Ua2x2hykESy/lKja3qaSqrzma8mGOaQAV7nG1lKNToq1V59srHLR3EjoNmPAfpB01JGsBd+7Cke6CnQrC7CrErcTmQTLRCa3+rBrMDo/95QmSzYAbcUtzMlaLnBhLWuR108o2Zacx3JHqXrFeG7EcsVx4AqvqS73+jc1XxEbkxy4IxsbrFNOrGGTZlw6JrnCKF1Afding1U6QPoxUCezdECVHHTKAUWMGr7JLKdhrpobbGYC6nJnbCsg0lggfSqJVH10a1uqiD81uUD0psvnnNmXjskbtcYc0AoRyWtUASfCHOKCE8nuIuhNSU6VRScMuKm0E5LZDBdV0Zm/LOXkQOzAeg6s58B6dsJ6WnhOvs3yMHqSts6K0aXTGM7e3Wxh563WUnZeJN9VmqlKXGGt1rm4UOqi5R4XSFRU+29lfNMZnBZNrTX7lKZDq5UJM0iElqhYTgVbkbtNhjHTjEuvvSWKhTaKcZywgW8Yja7K3or+uWpTL1Wvgl0wPCLl+KNRBl3yWxtem7wsQnEOxJuHN3QEYKsAnrFS/fpCH1AtAw48JfM5FiMSN4nmXpDrQQ1ZMhhtJDTjNqcma1YDa63vp8B03o7PdHc1apcSmPW9lIeNKv+dSJw3PikR1myWxwWW+i2hNwgWJApTHLuuvkX9VUk/s7yB05zW+UhTR27G9mCw9quZ9apZNDfUlvfL0uJ5REwCBnOLa4mBcV3iOi9ynRPouNA1xvRGtVN2LNf9bqYybaqK/DQIspT1j1dCzKNK5Y1sBqSiLFffas20QUNQuzdV5EedSAi2kE9vbuevcoSdFJo8OO/8rpx39uK1M2jh58ZeVm2q8DqrPtyLj8sCo4gvqubDT+Xisj/fFrdTS5s3S5sbi9uL5XNxX+nlt6L9VQYFLz/4rRz8Vg5+Kx/Bb6XbYaWPd4rLeumI4OyK22yN1qyOSHdcV30ZOb7spVK4QjQ61A4sg880GrRXDGhAY0YYx3GwtgfwVwlYbw2E5XGhhQm+Vgq8QUCZ2f2Xm28nL+Gf4PgXWCgeeI9N9L4O+CvCDcV6E24+ZV+EcQPuK8S0dkGWEJbqia9e/EMpj4CzK9nN2NvnRq+Ym670BzZtxK4yIHhnPxiXcnHQFFiAojIdvQdNfo09fFN0Rop/z1CkMm8IGWJcjmfTBQNeIYZ7+uZYFGXgcNApXB0qVtg0Y2r/izYtqu42fYgUmHQ37ONlb/mh3G2dhZlVolaF3jUBsms1JBWAU3nHhlbbe0t1eMurtNd+aN0ILm5iBMKmrkglJtatpY6UESpzLvrkBHEv+35+WdNrc4JUMrMIWZsEuJ8v2DVN22vtqy5U3yBaC4iaBd6dXUNCU87gi5d/etG/YxT161pEVgrPAfVvqMWw0hlMr08EPo2Je+bC+flHcc3at0/W3qWaDV07/27kF6P1aRVctCrhzDSXE1tciGUEYolECU6VoMMd0h8QBvcyz5XydwwLY0jRh4kB1TaTpzeyqTaCPx0PvNIlOH368m5yg1bV7cWoDAeOZEJWD99Oiy1i/Dql905VtUVZrX2fJFO+V5Qq5q3dqtimme48PbtduFoRrqBZciDCuooSjY2y3RmmDSiWjrR7HGSLhr02su+FWn3k7zYlyOcamdObygtntxa+hvJb6V99pG3xefgvAqnwOoIlChYk1syNcRTLoTmQVugoAakwCZW0+vlkP9Wmk65hyloRRCof5KE1rGzwoepKB5tXWXDbBlS/On/ZiNB246P9YqeGrk9D8SFmQEx+aPl3QSB3nQp9FG2CrHztgaqFBd6tE207kgrJosyUrfFYtO6PYLujgpMRqHN80Fu6boaQ/w/2SK2ZPF3jEBD1TDitnqbcXrx2X4E/uXNrDy79uLDnDCzEFKK94uQe9vd+7eMUV/eGgxSzLFKZ6INeTreHi/7v6aKvTovmmH1E2WpIjDTWNr1fHKqvdhk1WODgQWr4cTi9usW/epwqpUvU9KqpfcsvBKFyMnUm0iPsYZP5J/EsErVgbzDy8YG80ilcVaTTdHKljRtqNXBq0N4Gldt1HPRAha3jYE+Y/JgS7iMZmMS2UlG8a7IsaeiDwxsa4vKW1T2aRS01399Le/b6CM6xw1XOA59fM5pmSw+Mnv67bFnKIPe4wEjcSiRDgMeUy/BZwznbaAQqAwbjQFPAMkHPGIYvhkrIPVEA87hc0fAZLMUUwj0GnCzIEqeWyBz1CxDDz6WFU96bUxxQxtMs4AqsunNJvDUBC17orIqJ1GIYyfJGYgrh2OCqo4YVcML0cIDGBuzTza428tupzy5WMaT6CxNLSowPRLpJ91niec2VQhMjJqTaIGExiuzcp7fBnq2tyhyxtYHgU60NJPuwtlja69SP9Oayvson2PpW0X97af/gLXzwFnZgefAWPngLH7yFD97CB2/hfXsLN52FEzZZGb/gc5xEdL3EMa94Aht33SQYlUq65mUET0A7hA50cUmNqf0zgMJ9VLiQJTTGMR95NM6J/HL8YvwidxBmlZozloqvLtC9MDFy+QkcD/ICwNowqhbDDVZm/AEAx8skyld43XUtqmDXhl9PWrXTC2rOcdp8fxrIiimXEqb+erREa1SHXdTbK++JNlwAyBLN8XUWRSpDpxCnLim/bijUZbu8+0kF3Agtwz99eVIMQv1wvKoyEIPL1fXF5cWr2/eXp28ubq9Pzy4qrXRG2G9TarloyjS9N9hackG+Ux7XucN1vgVaEbm9uHk3Pbs4PTu7ent5J/HaOUpiYsfNGbXidXZ7ffb+6vri5vTu6ub99dX5flCqUKnS9ElOmunV+1entxfvz6c3UnJmucuXLDKA4R4xDCFJ5Q5fy6NHLxKgMSwo45AgXqu9/USkfU8xXCU4vnh1CwGKFS/MuTrMSIRZnmNbdSMA5fbtyQqlk4KvuWe3NAQbCU9gWAY1dBPi7FZNx9Xr97fXpzcKpMS9XL5KJiKT6nE5eoFzDSSn+QeCXAytjNsxWyAxdrGRcczhHvNHrO8vpqomqwEzLgWQUBpBQkMdNXxx+Q4IU3G2BjejjdF51yyBuk/ygqwJSsXEYrFeWX32ymnL5KTIHgirTMpEweiaGytVPSZKgx92w7+evn/z3Zu799M3p99dOCAXjE0wNEHLkSqdUeFuwx6D6ddbG3xHBxf/5/rq5u7ixqun5ciUj2vr6ebi9q+XZ++nl3cXN+9OXztgfvFiuJWYstpISln1EFJWe5NRVr1ElNVHllBWPQWU1ecjn6x2Kp6s+ksnhzPPfebdnd58d3G36XEnbYbOMy8QJAizZVIcZcadlob7P3iKoe3qzDmItct+tPc/KQnjc97vqHx39frtm4u+AoBagf4iQLlf0+PV5VQgsNnpXD9f86P1VtG641wdFa7fneer5wExYqsgPy4FsjXtCUqIHqV4eQJf/fNX/2wepJTTgEYnwtdaPys5m5um1TPYei4wlcnmdCYDotcncEljvI0wYs4rNe0oXBLZgaQf9hJPUGLCbkufP+L7BaUPnfT1+8whs/SSJwBEZDiJ50ovm0d6dwo+HePrJ6d0EmsLgn064cWNj0VxIgagmzlEE1+hB6VzVtewjMSFL6JzThkPcZrWX6++eVl79PIv/3j8u9LanJ6/md7eTq8u3/948er7q6sfnCdbwWEd+3vo2cG3p9PXb28u3l9fvZ6e/dXR1beIRMO9JXsorf97FDxkCbPlYtBZAEpvKqpvFCULdOyItC/lCajE/OvubIH96lU9tcCr4qk1R8D9Q1L5g5X+KvVVetAzy4SQSdQJXpJFtI6/7P5csWWoN0CN97HqGRKcChuUb0EV0bX6stG1ejwx5cptOLyqNoGSYs4nyYU7P4Wll6r3cAX8x1jEgrNHmOPwI6/mer+2ZV1rU1/fZ+a1x0IP6ks5h31Y0z79JileiSx6jZ6F4Vi8GDjMz8AymVlxlkVm2LV8kZXeP8KKTzGT/qYfa6Wb/mwrXL+rr+yb0mPrik4Zr/xR4tSV7spPNljo+kvHcrNNuXojm+m1ThiAhtNjnW+9xXSXffdYH/6tZ6mFgYvV/ETZAZYoJjPMOITyGqRM9UYHJaJptSuRdh3KBW52NHgiPaAAMUYDIvPhnN0w+Ee4eXV6BmkmdVXVPnIvzBVOZRz+4AlIL0gJqEhWG5E4+wCzCK1olsraD4Mn+mFIGE8pGw+eDJ7AtzQFliXiQqlykSoQMeZwdav9MvkCKQsJuqcrLLA+fg7fYZVoJc+2qQwrOm+L1LOV1G40VeUsULgEETycrlX8ApLd6H04HjyBl8/hTDgS6rlWcFQ2BOELsjaRSOpzrK8rASOjMCUrnBa3iHLLgBGZm0ApvJ7AF8/hjbiUaL81jaWUXOO57pTE0iBkfMEkTEmzDX7lj6Dt5/5ILKRLMQQUh3Cuxnp2c85aP9qgJ+/fYC/cvDthGhETKQI/2DhgZKzdRnSXdXZvaWFN1zUVpBXpk2tMvuisOBrOooxxnPqnybK51QWMqEl0O3y+1jtLzLxa3AzSLJapD1S0AsRmQdST244HrVEbbkc/1VFHjIfQERdYmerbhBVZm3TMztjhMmt1TBKDmZ57dC0aFmmfNBIq9ROdwYrgx179cpqI+/z6B7zuCm4xUyLKbxu2acqaGEyafTsL5rcg1e5BbJ42BZjfY5bFRipExDkKFkuZ9suZFNGdjq/qidZHoGt07861VzSy5/07rb63ynpOaPLtCikpY9cnTUtzk03bnCzQ/3TZ4lwZ7HF1KTKb+0kQIcYwG5u/O04RVzPLUaKTj+gPzkQ/tQyQlhaNTJBWbGtr0dLGdkY9qVVfL7gWiaHIyAHH4+Ov/km2N2nuVsej46/GIQ3YuGgnJkE8mnDEHthE5dYvZ/ZACZmojI6jPKVg/cEozKeKTUSfHVkrN0lHaSFzqYRhglK0xFyeUjLfVjmKX8/ywCxpVakdMqaCrGItpEhxWc1CsULg1PQSONeC+Bmr9/1at5c9E87kkgISA6p9nyegtIAthXYr9/54FJf5HRwSae4kkeZWeTNDHGHxmbY5uAhebQahWKVLSfY8YKG+BLQzmy2FlikaKOhn3RJ59g3Zrw4qkQvxnmZxWPuoSnxmPhrDbc5WFJnlOhzeYHFvGsppGZ7LtsNx8ViG7NXQRdyGqx6hgCNQSxZrpip25JsjdqV21LvZ7GKB1wNO+DhHKE/l0dZ1BaQnGrauc4KZ3H3jimWoHvo5AoVk7aEiYOeCa8jy1YUmX5vYAsl2jGuixrss38sMWnLv15KEWFaVfXSHZLI7Zj19cscWB16Z2t2JDqzAatNUwFZz9YDXIzVRS5QoxVRtRZkjstJVCYxM22MOWbO5pC4MM1zmMDRBv6rMHz8UIkr/qJYqzy2/kPh2BrwoodQd9WLew2cQ+vLxhGzFOT+ClF1i0U45W7XpkrQ1yu2idt5dQ9iupFJXQNRkqrTqB1m8NBX1DCg6wG5I45FItzFsFh/TbFxrfp3n7EHe/Tzk3cPR/nGO9tZs7+Jlvo6LLSGXZo9rxP26fc/ZJT6Xmtl9E9rgNiQnt1tm75TbW8enN20Dou+9qfWuZC2D6XV36nNp2fLiUr0zWWK8u9Fp7b4B0fMeNY0hXMdoSYICmjEiDWy5aI7Kh7Bx+5+RKFLHsBRXxRCG55WlN1RfWOwDYm+F5lSy3bGbz0sWEmGBtSTCwiOZuKYi/x5BxsRXb97e3pXCAorBlHVT1rwQ9mkxB5RtC9uvpc6rqeN62mrssJucPK+qJTNUHkUi+Zc1m1f/ja/t75Lk93agTKCDSqilmGdpXNiFBI7fYX4dZXMSC1n+2XMQ+U90FgFLHVNtSXLOiJOW6phspaVqUtKFyoPxUUbOoIIwZmfo02DcwPGuIsqS5TLjMlMDmnGc5lvQPYa2ZCMGi++l0qEzSUK1eWlohvpD0+I9CYfy7LOAdGw73yViZw/iVybPuK8ZUN09PAlRbmwjg3pviNAou1X96XVBgkV5XZQUhortykQ+HD3gWH6xT2o48y2tmlxX+pu37YMyF7FybaoHX6kYbJEAIhrPmfcOraJqcdm2TGipdW1WrSYC4LSBZmkobYcAEC2/1M0MY1EraGz99BJZFpBiDMuM6bMOxwFW9xoXBk+16UMxxSbEe6LiAaW6Cu6JKiiuot7kpVQepYCqu9hPLlKDP1IICCklt57YV4j+ID8WdDKu5oIXgymhukBJguPxXnmn/Y5rXV2n19NygiblDCUni/fmU0WUQVevT6cz1U2qqYIgITjAlesykJhxjEL10AISAMecpDifO7VNJXKlK7YQRPL80cIdcPIdbWFOSoeiEu0Ia/wRsEzwP2bSPMl8PGPjjjcuYlZ+evnz2AryW5lTEQmf5CMgisplPxm5MwhThMjhSTnUkf8QQUJDPeBHOVDBfoHqgWYYIvKAT2Ao/S0LFP9LrOr/HlphPlMCwFA0GdaS7pY5ZYGgkvVNLjIrUPEBFtfa50BTMfaYlgBIsKSwyoYNhH96+bMD2yqdgMQh/gAv85zICQ2f613G1jFHH4AwCBaU4Vh6S9qxFftzhVV+qUccRSMj2z+itcq6pKZLWX4TlPKqhscK9e7q/OpEYSWW0DwGwiCmHGZE8DHlvFVVChomrtN2W8Hq68Ys41mKx0/77ta6bqZFR1NnDB9H0+E1ihh5ZEp7ellavq2jsGhWAxoHOOFsQlcimg0/Th5p+kDi+Uisu5GaeDaRx8XkifzPRqNQ8Y9eQ6kcS/scj+iHTZ72z66mbhi+h9DTW+MvUfuyJoGVmaV1SyxRqDgsite7XqWCbjKxYbAeaXfuEYrDUanOXm9CZcRjC76dnn+ctZuR3hvQIY7b08Q6jWzQNLSZh5Z8kaOmSLxlzSj1um6NCEwayyLDvGT2hZXKp3ZUyZm5VcwvNyzncpQe0KViDpTEfERimabRfSUhTN/HurW2+9OeGSjvBa5DJZdYeIXWlMi7pryinonvStMrdfBDmN9cn0mliU3Kd+oP2rDdCtX8Ri0MigZZVkLTSHrG4K1Uvk3GRbisURVTXshBR0B4Ka+3XI4aRbkqgDDIlHVOmsRtt44l4rmFKhctkKi88wFiFFOGhb2YKXg4DmioijGjGEjM//RlU5i5iuXXRwofulwK0eUXYQuBf/oH9g+Xv2gSVfePwjcu99mcLRIHGI7/5Z9fjF4cj14cw4sXJ/J/8PbubOwo4iGx9C72J/N0tm5B2cLsPWV5A3qvjPX6pS5F2NgoR81ZVYdQj1ubb47Up8tyklR93VEZbY3FTMxmFnOcGsTtacMdgxG45ytxDJdXdxcnBjtpUrvHENH5HIdHVrhKx29uYLGArG9hDMuiNStcZqr9RVfukx6XtzNSNZ+PiJWJNd4wOe4mCisZy3RH33bUYimalfgBKR9DKtW8CIwq8v8MLDcbEzRnPFbbWX+D1Tf1SS2sX+LzntP3GTPstMnpGxA34vw1Tu9QQzk5fzemNk3UJowfCD9S4XSPhOEji3WmcU7pJOvDuzTDQ8cpUVQhE0MhMWZNGtD6ejFnh3eWdb14bslvXcs1b9eQp3RQdqUYt1uCEbKLkGX7rdLaRNvAFnMvEHkve3GuUdeabADus0b9UPXH0yGKuHF0r9CxSKAW62ms+LeLnuxrtjy46uzqT6WO9PJK6knZEkVRKaLUdnnMVxCZAeEVBVFpB+k1L1qjoijnDJGoycY75SvEC+Td+6NN8tDF0k/ghbdI0m7jarNvkTbDVqtNves20E2oBkj37lwgBpiICZPzgkOgqZlRLmaLxJCkdJ5i5ms+2WWS8oPPpsVnc//Omq1emh3ume1+mY5Yv4NPpodPprpOZAynT3PHN8HwzAYuRTxVlBCDOjdTPKios6zn6AiqhjDHCTk++G4efDcPvpuGzhXnzXJdn2CBUhRwnIr9E7Dawa8H33ThVDu8t/5Ys/iJTtlp+mFPbNzZ/HLT9VMPRekmbktdHkvKG+Wjui0VbE/XJo4QWV76GKmcX9b8PUqmWAtIgGsbnJrjvPRXKxwckM2TxApcg1GEL9/fPJ2CrECbU7Ghi5TFK8XTX8rypYPsHWpnaIbD1VwsHavvU/pBPXV8ZPO2bItoNr+c+xjzQ/Uj2y2Dl3JOO3UCMsI93+QqK/UMaIzzipVNwDo1SoqV0d3mvFvpVHVy1HKpKa6ZcLFMeNOTQE2Y6o8DiiL6qBMgFXLmWHoFPPtAUDz/laD4+Qkg0BlLS8JcAzaNAYtOdSe7YuQjnWDgqd8lzGXfa1jrNrHGPd3UHKc01Zaqeyaf+pKGclWIZalQZeX8VuoObHJ1RThlWkLLamKcFiV0VboQskTXA0OiGBkK1mM4K4AY1ihzdGUMO0tW5yJOGwPRp5TM/bVWUpbWXWjZuh6frXDiyqnN6OC8jmTpBfjOl7NW57D92/rcagbT22G97B/gcNCzmUa1e6OvUsgP7gIxvTBwXMqLGK1Vd+rK0zaYBsQ1Nmsabg2OHZMCKKIxhpBiZi1BKJTFZqEU9NC+k+U1K1V2KHpEa6bXWtX70uKbqYCacjq2o1AuQTsJLP6qaEVJCAlljAjpjOEgSwlfA2FMXIye+rrbHkzwBxP8LkzwmhtU7fCdZoxOXbLLruhcz70N2TswY5el1sAI+gscJSLzq6ycm+AUInEfLp+fz0RV9CZQlMic+sqAk7d+rkqpBiTEpcg+vC678pI4wzat9iMiYgnmRTnzkSmTqLkP3iOW1/mXBBbzZzeQp1jpAA8W/IMF/2DBP1jwDxb8gwX/YMH/3VvwD1blajJXbSxW6WtuaIRfaS1C2dwsSpCMUcYXNCW/qRO/ZnPuqvkUMJLrmjhOR0ZXoSNd5HhGUCm+pYsGlcpUNUEWwuOIobxlrZJUSiOsgywbo/VEN1VNUUK+U7ZvJ0UUVZtk3TM9JYIyW7yipMFUzPRw+PNALWhjAoefhoW1QVtwdKMVTu9lgznmwyMYRoTJ/z4iHiyGP28GPBAmiI17qLoX2PrTLbQOc5cjkaFrDYhVUEf67orFv5R5Ufwr6dMPw0GKeSvqNsrYPTBsHVjTyW48Ixv3q5Nr1Tsu6GdFoUJXlfZjL2hNFLetY6f73mWPfqQvxr3TvnsPM6A0DUlc5lS2biKM3IsqH5EZop7HytzqdWBBwebxZMNBudGYRyUnGveSa9C8wEzjnlPmo2fjLlSy4nyfk/gjZ+O2nsi1kpk+Z1X9oLYc03ZxZA/npozUFwA3OTc3Y9R+J/ARDHXBRG/2UKxi23ZKPtKh3cqn/Ptq50tt4+ktIgjSqcoen724sJ8zZiMiNmoj7HmZ7lzO6KDAVufcH20Q/2g9o6x1JtSazN+w4VH5T1UX3dqp48axv4tcmYH+Di5yDX7f4yKnB8IRx7MsusXcWgK53wnoU+/YC0prEd8+3zpKH/tVLe7sCEBQ3dKwUonNA8zmo9XHqipAXCpXS4aNCs8blFr2QL2LBj2osN2sQ6/6zUlKqLC5F25k2mY9CtTWGwUp4cLXz1rw2W+nt5d9Ft+kWGj10mbB518ztBbDePiaBYxMSm1PVi/GX45fdBRyHo5Gq2++GjafCkgoDFPM2Dd/eHZ6fn5zcXv73NIwwijE6UjuFELjoU+RZw3OXuF4skLpJCL3YjATRoMHzNlEyf5JSj+sJ7KeGQ0eNqhlrY4SWfaO2VFTHY5CUrc3LsVHqo5iJ4bWOSxpq7zmsdT+ZCVW53H3XHbP2mFOcqi551+TQbjmxfKN99zY91lt+3wjw1mGvclYXmil495roZXan6yOx3/aWZX44agM+xtVpUyslIL/bsN57ASdYcSzFI/miGP2zZ2ua/eNsCVYWuMPPEUjJZ2PzFnXaOzcNG/+6qwKv7sa9R9nu35K1qguAZ7L1TRWG2+Ha3XTg/DjsdRPOUdG3lH21lGK54TxFPnNmetjwW+qzHMvc5h3Kw2abFRcOl184TDrJ6WywqpZc6Y1H5/UCxCfbLoznUS9ur64vHh1+/7s6vLu5ur164ub9+c303cXN3YyW490C7Tb6fuLy/Prq+nlnR1QFpMPJ5PJJtNm7+70evr+7c1re28mfqFSybP8e5LX1M4v0KVQJP2ZmjjxV4rEP4pi2w1oeSVtEjOOGi4c9QGIs+72+vTswo5+cZPv2KUmgvGbPzyzTYXtsM/SqNZaU9LWWM1MuX192TwffrINpnqzXPSsnchYl3OSykhtqedqt1gIJ5oRnOozEpw2maKJzE98j0FGAHIKIkGecty5fseOZKFhVvJbPy0Vhv0o1gpz3n8sE39vXf5WSvRmcW67wrQowr0/LwKHRraHlaBhKvyIStl8nfwONLLVNf25+dUYaS0X09q3nvcS1gXc3Ybo3LPho2v0m0P+Hawixzz1WU47MeK3NLykId7AcL+RyX5Qt8tbJ8tnNQiG126m/yj70HP/+dthu62TOzKdy5NDHRv+Nn1nj/u2IfYhS2G+Neh+dF61cx5VLPddcqeNuFJJZpBz9QYlm3jbEBYwgsKlfQebr02rE/jbQHKd/29yT+IJW8i/yAx+gtEHmCwo4xMm3pgP4Od/Bb7A5kYcLFJKuWoItZbDP/xvddXAURVgxtJJRAMU9QDt+sbZiTfkvjhvgLKrC4ZtHzZbzkhpdZwjvKTxNuZoseh9DNEOA6f+vBN+T2Ol7asdG6fzLjY1S5cAbDK2ndiXSzhsblmuANlsmnZlTZYsuLcpucy4AcS+ucRc3N8r8RRdxmUJZSO1rvXLj6TT9TYTiWQgEeY5guK4GSWIL775wzOlDHp/c/Hd+9ursx/eX5/efV+BEZEZDtZBPTdlkuJbTpPqQwD8AQf1Z2DyhAtJQ58vQnwYBeL/0yWM0hlMyshNbIYyjyZihFIJqeWbrfTUSmZv12vaqNeh9taTocEz6zjaO/3h7auL95dX5xf7sbzJ6jtiXV+6LG+y7zbls5sR+agaFWU6VI0NhXzxfWWZt0MpN+2j/TeJKGTqig+8vjXIikR4XgvqUr8AJeieRMQWPY5CuUXOTq/fv/nh8upcbA/xx+1fb9+fnr+ZXooHxR8/Vz6XOYauTdcXLEAR0jHUVRz2Zblw8C6ptQtLmmi5eqbnz4efgVa8tJL9TFu1QXyCzdffcuPDyCwEsQOtnPsHE0/Ry0UszAeTc8KQTCSYcXEcSWYD2iPjCGTiAn0xb0BIcSBq9DCZrCCPBR/JnFGMI26n+83Fm6u3riUwbDjT7J/9hljIbB3fh3hl75yGbCQbepqazHHaoGaMcYhDYFTFBaN4rb5nwDDPErEYSKjSkRbyIaAUNyAlKU3QXCcPCR5Mbld5SVuiYEHiRuY+han+TjLh4aty6qShdfAC4kjcAFvGLdp0d/Y9ZfyOnplh2btTt82ypsDVaeWuXWvHsnvVqvbeaeSzrg8xrGbZyMS+WFTc9bkkJ03Xgx4CQGs/DhHtvYa3nrRhcZWqHAMDr/20CR52UXEznFzbrBdevSbFvdoCo+c6GViyMr6hIT6BF1999VXlrYfeq3tztY62dXRCD1PWu1StP/U87LfTc1XYrKmWsc1pruB4AncUQsxxuiQxBsQhzWJOllhnvFvSsMgEAxnD7EhMrczLY8rsSzAWC9UEJwu8xCmKhoBjnq4BpVhzzfEABJxpPKNX8Rt199ZSpNI0m7S4+nGdGrnlIU/Bbhn5Eq2RQMn9dafdYklRUNVgVYBubqFw9ZUbIJ4opwGlH897hSANdbqLkGIWP+UqK+nHi2VVT5+YkssohIARzYLkstjEKt47TK6CweQxJWUaCVAF/CY+JddYCy4GzCYWeVsUWgVd1a6GqopI3ArP7mjr6pyZjyGhNGKOefPrX0LYwOvDSROF0nZEkTB6EqW+jDTT23ohbeYY03sp6W62JJyG0kU6jQj+oEsNlHzQ4R+h5Gv8EVyIHKGfvYyrfbhPy9g/bRD031sOk48XlPyRQ4B3vQLd+22P4dY7iZhv9O5gPKaLnfi7uWSvXiZ++QWAXTb0MudrCP1M9uc4iehakMrTKtsiwVYtgS3GuXys3RbTapyrn/00B++DxAamzRJ8v2H2szjWbYglMF1mwZ2GkTlNey+3Me15xnp9wpjHYdlc8Cl8zncbb/Vyn5N6mKYS2Mp+z6dHsEfBUibitYjw/qLdWHUq89f3jRvusjrl/PqbPzx789f311fnhdnA1j6hKf/myxcvXlje6ZzMo/uMfRMjzppNVl5rpNsgtF0MT32c+w35VFqjj78Mi8/EnDW6yo+Ma5ryExBT2kwOzmlAoxO4O7uuvVOIDlPM+AglZLhdPEw5O4k65LodBVvkDbtXVfmILlFkZB1nUicKR+kc8xKtGso+IxrVndrqqNexbWgRreLSBMdzElebtQsZjnZbeaBVgPp2rwT4W54ijufrk0Ghhb6hkcgR/1Y2kM/T8hPT9RJ9eBujFSKRMEgqiW9J4huRKvtW1eA/geMXGwpt1SH507Sf8OZy6JKKcXFViVbYSHi6PmChvKtxkTBm5lDQ8v63JGX8R8IX36tudENx17utTKz4VSsqoTRYnABahnkN4Cc6xf4FChYFm1DKoAVaYVji5SRIMojIknCmC/qFQGKgaYjTovzJE7jHEVUVMr/LUIpijnEI/05vTWGqBY4DDAGKIcYrnMIccxBWNV2vNECsVEpHGDAxY1mKIYsjzJiqqIA/BAIqX1CGNU5j/V9pOshrJ+rouByaKR439pCiG1NvOcd1k03O8tasBJ/QQUofldPr3fct799jYZy5tnY9fXN9dXP3/vLi/7y9vbj19QqwC0WXf3hWJqRFsJnn4s/02vI6tssza3UMS59oM/0TZQUcr9EyGu7A06pbXlB26A18FEK2WLZ/Nqm3MJ+qQYqRW+r6NOSUKoFaAPYDVKJ06cNadWTzUxzhpNZDkGQnMDwe1jvGS5qKy8RXL168IfW3i2yOEzTHbPTyDRGff1drYljOx+jNR7o7fnH88sve4l2N53X4QezLB6K2SAuhsUrBkGTLE3gjKVl5JTJovRZzXyddbk/X9PXu5Ptsjq/FhAy8d0W3N0LLFvH1hWhsob692veTQeBbEuG87y00hYKVbqsp1DD2pSnMwfsgsYHQWYLvN8x+wmabIFMBmQsx4unJy/HxCEUJifEX4+PjjXQsXlfNly9f9r9qqgN4g4tiy3JruSjqr3wvisWQKhdF8bh5T2w6TNTdbKSvxI0+x85zX4kW3xNpiLZ53syVzr/0plLfA0XJQhbwYAEVmzz3cwkNzcrVOd7o3q4pVRxCWGt+aLx6TRivlPGoYDlQXDmeZxFKq+/UqwVNZf1HZTBmiTTSoFDVAEHRdSorz5+J46hekOSycOq1FEeplAYSTcu1n5UNSXoFAGEgmXjuYPtvt1eXSg4Z50JzpePbkltrV8+ybV5xqNSvpTMlJRdOs7kedV7tLSy6L32eK6LK1SBl5bGB9HesiUqqN5meBEp1ewtufHo9fffFbbDAS3RS6b5SbMZWVa60A/rW6rc2qrNZcNbnbatIryuRBnkBXjEjFQeRcRd8d/1gi4N5SNgD8yhWKz5trWt1WSpi/fA1g9hrMY99qzJKPFsROCfsQQt+DJ4JkYIBTeHtzZQ9V37KRQ11WdvaFDOsE7XAA6UpqtefJhwvG4g4EO+oxHwrX0OCUuPTklf0lmQqsjt4zLlr4uRGbSWc4I+qmfdspBix6r5pQL2RTSpEVp2om7Ks0KfqVN5H/h33WgZy7tXUoxQDCngmq+Z/pNkHHZIUEL5uRflMNzLbR+0RU97Nips4b+Y4ddQ8JTH/U/1ytSQxWYq7QlWpLmjRitz39BGWKF4rbCQhxTcCv3bybYXivlTZOmDOS529mSJa9/D7UDFrZP0Uwwfd7c51t7X4TZf+VjTbRIf7BB6V23lJmwf3OEAZw+KVjqAiEVaB4mqE4uXyIabhDlSFn6sW+ebt7d37V6dnP9zdOKPRhsfdalxVKU6aEr+ZaJ8GFSVtj9aUt+hvOnW/q+HHUbeyNWv5qv7WfJVm8Sirg6x+mmbxJHP1ulEsVq8Quuq2Kj420fq94t+2iD/bRPH74sWyRRv7pp9e1x9Y2YNnt+kavvBx6yn7iHRtJHfOBWd4l+GktkQAu87I8EQFPhJm4iTzo0zInKIMLVeRRwEv6qqaMBIGcxrj3lke6kkeCM1HKiibHyQmiQNUafzz7qNYXVtw2wwCv6NN9YnME1W23Qq62rQbtIXzt8K3HgNdnew2mrDH+ttfAOseA1dtnG0jW439bOyDU1u3//8A3ri/BeZOAwA=",
+	"openebs-operator-2.1.0-ee.yaml":  "H4sIAAAAAAAC/+y9e3cbN5Io/j8/Rf3o2bU9K1KWk8xmNZO5V5aUhBtb0kqyc7O5uQrUDZIYNRudBpoys7Pf/Xfw6ifQjebDj1nyzJlY3ehCoQAUCvV8AqcpRhzDG7RCcIPTJQkwnAQBzWI+QAl5h1NGaHwMy6PBA4nDY9PItFlgjkLE0fEAIEYLfAw0wTG+Z6MFWqERTXCKOE31W5agoGgyGI1GgydwhqckxnBNIwx8jjigKKKPDNS3hMYMaAw/fM0goSE7DHES0dUCx5xplE6jjHGcCgAVnNN7FIxRxuc0Jb9LSOOHr9mY0MPl0T3m6Mgb+zSLMBONRoAS8l1Ks4Qdw8/DPw5/GQAApJjRLA2wfBjTELPhAah/HCYpfb/S7ZY4vS996AXNkE2CZIr68t+CGua/h/g9DsQfJerU/jyckhhF5HecyjcpTiISSKoENOYpjaLqG4YVCLw0wHAcJpToPwIaT8lsgRKNV5Dq9n+j96pBSmP577WHzjjieJpFBpMQ4QWN5V9rwzR//JZRjiTUiCwIT1E8ww2wEWFcNHlEPJh7d0HiWYoZU5Mk1x6NOYoSGqKMUxYgQ+glTjkJbG8C8WYqpgczMoslxN8yzPiWUGScpmiGgwgZPBOxaRjHMV/SKFuIV2RhfeNHfN02RgmbUz7G7zlOYxSNdM96I9pwq36pCFV5JDZsA4kZ5moyy+SQq1DwN/GvLAn1vxLzMsQR5tiGPkqIQDkWjIS1IBtkjNOFeRQKTkYky6rhB3UEbXhpdHIMLXhp9mTHBoZ/rHU7/OMQLFACMQvjLcGiNA1JXGavNkJFGDHsmrV8tgx1CkoU1NEUs01WuCBMzFSKZ4TxtAuXJYpIiDiJZ4/4fk7pg+JlmfpQrrhFxlsa2EdRTGnbMMpTG9P4WiP29vq1hHW4wDwlgbUP392d0DAkLM0Sge19Fs4wt8KzLscCZ8NS1Cn9isQh8DmuSwnwSPhcvpAH+FVKliTCM8zGgydwe3l2eQyncxw8AJlCiKcoizgg/SmKGIUY45ABp3Avjn+c4uapLvom8WxHhzvL7v+GA67Pd6uUA9AOwryvyTcpjfA1ngrATUmlC6KZ55ahyqkpEwUlCTvMRbWz/PC30EJ2hxIiJArskM8AInSPI0kZ53cABSM5DOgioTGO+cin9dLg/XJ8NH4xwnjAEhyI3hiOcMBpqnpeiJX4uoRKCzL90DGyzjEciV4F78CzleqErxJ8DNdY7Q35SEhJJJ69lbv5GOIsigYAHC+SSDxQyJZIDVAlYQfmfUnZSU4AAENS+e/Ksr7oWNEAQjJEJMZpCf9R+wgAyALN8FUWRVc0IsHqGCbTC8qvUsyw3kqldgqOoBehh4sC3nFlDOqX0JSXEFHI5Che0ZQfw1d/+upPpRY4XlY/eAKXV+cX569u7iaXdz+8fXV+d3p58e3kO8Axuo8wk+iIvWdoBZyKPmIccPHPH75mNXj3iOEQaAx8Thiok2IMt+IP8b9ZTFMcApkCXiR8Na59bdqxLBHDwyFMaVpFAqegZxZejL8avwQaP6I0rOFhpsU+vFqvsERRho9heDinC3yY3Wcxzw7HD9k9PlQjGLZQ7eubuzcnN7fn19siGgrDFDP2iVAtH56LaHPOk+PDw6N/fTl++fX46OXX4y+Ov37x9QsXzS5O3pzfXJ2cnkOS0iUJMZPnZc5wgU4VHYrbGiAGKK7Bw/GSpDSW75coJYL2td1QHU3ecaWVHsi3KV0c114ATAmOQn1mWd9dIT4/zpncOB+EY+w359fvJqfndyenp5dvL26rFDCrxcgDhg4JDQGxTUdf63rrNBCMddzkqA5CvDn56eTu6vJMTkpzIWx57JXedjv7NVQn0/JmOr0+P7k9vzs7//bk7evbu5vby+uT73KeSxhMUcSk3BfDZYLj81c3RkqswS1fWQHFoXmQUBrBI4kiiCmHewzquA7HrfTpQs1GsmMY8jTDLZxxcnFze/L6dQ7zVAC9u7k6ub45v7u6vHwNIQ7ktD/OsZB1zVhB3siAJShlGOSQ2JxmUQj3dfqaqwgOJY+ABKVy4+hjHEjMOIoiJSY250YNAVBLz5KY97jU0wGQKQzlVA2B8Cq581ZWJi0pBxw9YAZ4OhUHAo2jlQC41lw8kWwfy2NFDKVrljunxDHTarDdU316fWbdnUAYZAyHwKk+JQ9DwuQb8Ul5lmqdTFO6UPrYk6uJPsrG8GqVz5hgGafXZyyfKA0MhzVILeQ4vT6rNfZe5K9Obs7vzibX5RHmi0Ae7BCSVErvK3ksm5VJY5hTxiFBfF5fKz+Ki1/OAQIUy+2NQai9iFjrUxJhNoYzTQPZjQCUE+FwidLD4uLSuijMEBxzXwbVQgi1lG5Prr87vxXQJNr3OCeKOB/IdCXnSwxcoFuDxmn+gaAUQ0sSz+QHbI7EsIV0K5bVPeaPGMfqFQkxBCitHxJ0qjezUpeJk6S5JXG+jCT1ZNeEVah3qJhBJ/+sDd+DmBpyJ03FvjR79FMirGSPgqyKt51fvAPClBbD4CY0FUJtKwFGNLDtcHnUY8NzQyw1+rufqhpVtzJf/z55d6IAb2WezID/nSwR3KgD/koQPaIoxCHcrwyPWIdan+L5VCHgZvzo9eXpyeurd3ffX97cXp3cfr/dKTFqAvNVVSDbyvwciv0SfYqzZCOtz/6RAxp6rYHTy4vb68vXr8+v7yZvTr47d4A3kP9GlijXkfj1cH1+9XpyerJr8M7L1jEMvxj2OlV8MJWceUQYn3F/hEsM0b8Pwf3X6uLNd29ue/YzWswWvcfz7vL12zfnfbtT0kLPDk1XlxcT0bNPb4sRfi+VNelaRDz/P1eX17fnu+vr+/PXV57gIxJn70cZJxFzwK8w5vOLk1evz+9OLk5e/3Q7Ob0BMi0zH2A4DhmgmMarBc0YZAzN6tc+ZYUXn3xH6SzCcBKjaMVJ0Cns1nv3vtq6rw/n13e3P111UGmEY47TJCUM52rl4cBBohy7u6vJxXd3k4vb8+t3J6/bjq9Qm+PgGYlhTrOUPa9AF4dXglNCQxJAIsQ/TUOGY24j5LjyublpEAYvv5xX370hMVlkC/HuaD6Gn2gmEQ1oLEz6So/DKTxi/BCtxKHIMBfWRDj609fzQbv60UGJgVUT+fLLeZmkEVniGDN2ldJ7XNXhCP+Q6hOAgC4WKA7rjwFGcJixVJ1eh/ckPhSa1YBHloZax1p5I23gKDrDEVrd4IDGITuGL15U2qiZyd/+qfw2xSgkn+046ja5uudUpy1upHWKPjY5b3vRiC2D3LpWMqSYFYgSoseV1O0oSUo5DWh0DLenV/oZR+kM84rJpWqyc9iIGJaG+pOpdJRYHcMFjfEmZkzDbKRCVXzdx5Zp/7iVqh6fbGzVdPWxBmIfzb7pHsNa5IWPaelsQ2oTc6eB+/B1BbbN9Nlhx2xa5DRsKMH9OEY5GyK7Nsy9kEY58f+tdjmHBXhz0m3HCLxFym3NEGyAX1yene/GtiStajENccOW9glaN6WZzdgZm4a96vEnlo3hS3IoBzWo0vm5PNvaBjSlqZhnCVLrdhXPPlTOWmCcQy32npgKkJIXCbc2q6AxzJXoudYnovQhS7azTr8YXZ8e+a3VBj1dy7U2EP0vMZbqF9W/IMIoxCnIY1igR5je9eHYjtjr85Oz8+u789fnp7eTywt9mTpzoWUxnVT/gquUBpgxCb4gtxQFxAWBMJD+yOq6Iyb86CsI5ihFAccNxbSeWCBKf53MUpwAzXiS8frU3VCYZlEkuw1Q/JTn0y0tL9KJT33/7K+6S/Z8fAIpnmURSgG/F52xpjJbLlqJv170OOYkxUYoVwOdI1Zc30iD2nASB3Oawq//71c4zoGheAWMp0pxjzgwjlLOFKq/Vs/kX2vwfh3/8Vc3IIFNHQBMqYg6UDrM33FKQUwLFQOZo3Qndyw2tzwcBZaHHDMOv6rpGQUw/H81iWT8x+Gv8I0U8bZ9e1lDFDd+2h3BH20CuQ2El9zY+eHWhHN7T2sj2UtQ30Qgd+G9AXk/qnCeo1REsbQK3Zb2NoHbV6pvCOafiJSyxZPG+5zZ0SmzhTNmnROmtlB+HfQ8YOrff14nTA37LZ4wu5Oi9UIxqEOxw22SdNGsQ5bephy9XRm6h/zcT3ZuMNcW1Yedu3boMz5/9rr9K2B9+ZbXgm0BBxGNfW6C270HbvsW2OsO2PcGWL59PXFd5lpc9Wr3xbIb3qD/vbLHrbLlTvlkf8rv4pRvXiT7nfKf5T2yhv2W75EFqxCzKXR6o5Cwh9ECxWiGU0ixuMSERl06eAITXrf4qgBb8rtyqBOfSxZzj5UT+JREch1bDW6nEu4blLRcVuNwMVL9b2huKwEyPT2BLMTLkUQXCDOcA2gKSUoWKF2pocDjnATzwuPb8A7gFNIsFqAlNDVY1QnTl6LIaJsZ0KkhB4z0JiapYAcLmFIgcRBlIZaABOXwe/m3WljAcIJSORlqhbMBNCdsrHo6hr8PQNkF77Hu3KzLETzg1XFp3LVbqHgB1Rcyyv+47IalgTCMxL7jVkDmpR2Y9N6uQVug1AFKvOlAqkL62mApU1TSFB2ptrVOKJOLNyd7rVGTCGCaCuX8wSHmweGcMs4ODu8p5cMqCkschzS196ze+XSol8gxDIcWJE5fX749e/XT7YH28KuhILz67AiIN5t3H1GaHByGeHk4DV+of7BU/yNFC/WPcDFS/1iE+s19OOzSacmsEjeYt3OJ/kos9VEX09iZgsrA9kBARaff2JROZYPwhpqnAiEvmvTWKj2pB0k0Txwj3wquSmNAUQTC1JXGmGMm27NBSWhe0QweJVOOyAMGTpUwlTv4SB9URheqK3YADK3yngtIWlpYYuMzC4hzwb7CA9lFoLoQxAM+p0x9L7l0lrOxJ/LhjV4D40FVGHwCeDwb5zCKniQmStYZQSl9xKF4MRPx5cNvTDqQkXg2zCEKygQ80kDFO/iL+H85T3/tB6yMezFfDhjHYAXSV2so2OUF5o80fahwmifyDVxNKpJ/IJMUCL6UyaOU3JzeTPJkB49zHSNwcfYmB2Pic0SDQuI3r0UnV5OzStdtiszGam29aDdaH78Yfz1+Wb1lo3TWiJceLb/50uKcPcWIZykezRC3xC6pRY0f4e3byRmgaEZTwueLeozdaFQGw74Zfnd1+0pYxsVnw4GjT6j3KQV02eXs+uq0TOYx3JqnjbDzJ+o7BojDvx0dfSUdmeA+ZwnaHG86JQyCLE1xzIUfeQwnUTJH6lyqALWM6uRqctO8a/qqNBgOspTw1SmNOX7Pq/OTmPQZ4XHdMV1Zft+Itc/sOpFcji3/FuIDpdGQAsShS6yrfceye/WVZ3vhtHcZR6tjt0O9EP5asEuz+LDRxHybpDRY5Lk4WoYnGvZHLcTLLuguzITrRyOMovppOSSgfLyVwahQmx6AGqE/Fh+hXGIBEutbxsXZGyCsiBnMz8QEMb3/RJMzk2urBhEx/2Dkj+igIQYj2bxooJHOkYWMiUt8SB+lNkoyGK0dEUPPmfQn4/nypOr4nEdXPkp9SymWTEZJyow6OozHGgz9BIhSCOZalAOQEdgVKN4x1Tqk7NvJ6w3jym7I7/jZ/Ypj9rwWIycwahuRDZWbyX+6PNSPXvzrF//65dHXL79s+IbdlAgdZ4t7nApUKoSp4NGJRls4zIvdOG9LBY/l+TAOF8OtWXDUeWQRZBrHQ2D0MFVkWxQxreeGFKzEjqmi2XKIqLvMmdk3g2K6JXMFeWjAM04BBVKbqh7LVUen8uwRT4/EH6L350BiFSzaYBUyfk4cOArGSCZMFN8p1ZWQ6UXEvkoSN/A55VqH2zjtXENtOeieyMUuzrcSayGaCDgERtUN5hGrS4ymkeaYOnxVNqkARSk2PpM4BMTFpYsTk+miEZ7cOkzfCW05k1vhtx3Qtb4u09PCX7rzAPfuVX29DfcbsZHW97ypfd2p8ty5n02jkz4ofTCvGguW/UgHH9OHphDxGzi13z1Nc9vl0/c+tMVoH3F7szw+5Ivk0KA6YuHDSPS56j4Jv2w7CI+qx+QUkShL8e08xWxOo/AYjtpkc0P6H09uT7//eK7MBo2PkirImQIKV/aHOCnsYtYnkOCpZL2+PhHBqi4qHsOwuW/szvWnr89PLt5e3f375atdhKPuOHWNuEKZ8a2Vt6Zf2hqnmfxTMI27DePBIgTC4C9QGMg/9F2ADj8Nf+E8fe/IPxcpSpJjKD7UaXq7BJbOD9xSy1YC6TpFH+ew+skmLdRZi0IfVThxo2STTeqLaRPnM6v6HEWMRnTGKeMhTtOmdv1l7dHLv/7z0Wfg0VYgcnL2ZnJzI/ySfjx/9f3l5Q9tZ5prEw89QX97Mnn99lqkYns9Of3J0cm3iETDf1S3p/4+SzVau32W7C5L9c+9XZa24KL1SXg91QjwaUXPyGQFyXKzgHY7EK8QD49Pt3a3d/W1AaIf7Mbvxn0jMsMnFug+smQJWz/ivQxYU2Af7b6Pdt9Hu38W0e7/w3N5f0a5tXad4OwfJpZiHz+x4V2kdqL3jp+of/95xU/UsN/pTaJZkCyvdKTDHWSohCkodZbXIqtcNlAcU67KWB0PFGFMkORohmN5Ft5nJApxWpE/ly/GL8f/NgDl90BofEsWmHG0SHLtlrb+M07ThNIoULWO2LhebSy/LaAwlAii6ColgqGdCpeCOM9M9u83lxeKPY+VX+Z4jlHE56uJUBzHQe5iG2IWpESWuzqG24rnhv4CApFNWKQSrn+rsP7eDljdGARuxh/ThlWxCsIemJW+6sLuyt2BH4YhZiTthZ3+oguzMzvgDqxyiaOxmGyIncywwOjUYKKLaFXwOJnhStfaXX2mCmg1VqD6TC80vXts4CPC+A/W168JU8dWEmUpiizrXr5lc5ryi6IvgBEELFHMRXihCYbe/HgA6jRJl/ht/BDTx/hbIauwIrKFBVSM88KIbOFAumyW6sD9138PAEypOxoXAUwnV5N3X9wEc7xAxzZ614eq38qQq+ZLFaCVF6AbF0kDE5xygitq6Zyf5c9qfT8VyKk2IKsp6qNM8yEcApOI5+JlivWx27RH0SmgGKgs7jaWntw4ZSbKyuTqTHFAZzH5PYecO7YIxQDjNVauqlgqeeJAevMs0ApSLPqALC5Bk03YGN7QFAOJp/QYxIWNHR8ezgg3HFwcS5nIhHgo+TC5zzhNRY3bJY4OGZmNUBrMCceB8D8+RAkZScRjycDHi/BJPuFPS5iqPaDO2vyxXOZOuotVDoQB0p8p/AvymvT51+c3t/lkyymo0lxSu/iMFYQXhCLxFKfyK2UrVFKKqiqrY5oJrnnhsux+QTjLQ5uB0zGcynNMyk3SxBKOYRLDKVrg6BQxvHOyCwqzkSBpN+HreqWioaJW/ris1unclcJR0YRWig+l3IqszSrkNHNXemjbq+KHsvfXOT+pvKlz6FJDQNolVWi+MhmSbt7kxTzupX9lDSJAkf5a3hpNOYaSympc+0ZIHuJqYPHsdg9LMXaxpprPq0KJ+3vZMl5dTu2vAEaWM9DdqrZiyr8EccF0juH/Pfu///L30fP/9ezZzy9G//bLvzz7v2P5jz8+/1/P/27++Jfnz589+/mHN9/dXp3/Qp7//ec4Wzyov/7+7Gd8/osnkOfP/9cfrOi8HxUBUyMS8xFNRwp76xw0Gc1rSfnSmaKyJLyXCZXRwugOGqvHPgXqrmDd7gWeYseHNGBiswc44eywUs/1UMU1jHSXo7zLUb7wDp9aurfu4WKbKW61X2Iffolda9rXFxmJ11pkhm2NYTKFHDZhQBeEG/UlKrjUARBuNBt2iJyC3gZkqu7ChIlbfUQCIsKRSn75lM9x+kgYFh+hGMgiifDCyDsjJSOF1l7kCf7JbQ3nK8H3248Zcayxxpknv5P/EppEmjET8yiVKaS5NYs4e2ssAQDheGHZkA1crEewQAdoLJHIcdB9WijlxqL9AANxxUHXiIS6GLSlRQ3ls8oHBvEUkVDdk6Ay9WIwVpggP5sJ9Ysc7NjaykFDC1o5SmYHMe1XrrIbCCm+hKQDIkjaK7dyGjkaJR3cVvzuIxo8nCmfdnerjvG1iW/mqvyq6Kgy8FaQUCaLRLXify8AMU54xjGrUG3cCtWHLg3qSEtjR/MaDV5VvzYrsCh/iVUHnVBBj3rc2bLz7Ct+AUpQIHLS9xvUqf7MjMaAWXNEMJGHAVsxjhcwwzFOG2FGjuW/QFwKA3/60pMs7YJDCbHXJH7oSZYz9ZWhSoiXEIm/xTatLNztTaE5p7swHdWX8aC7e4eUV2+G0hStBuuiV0GMDdo6asGnHRNbIP660qnH3FTWxEWpb7MwlJOFDjAqZx/jVHuTOLpGcijFkZurU0k8G8O1Jray8Q3WIKQAqhIIeRytV3njYsHrYmcCN3WwqkGiJImIe3ZpfogZIWI8WI9nt93et3OPb9kO6oa/xo3e827f9/By3/fXW/heF7TmJcyP43pd2j7Z69tGF7ntaA068CpW8kfVH/Q+Y9p0Cvtl/DksY1/NRJeo6mTJbTqKrjXg0GB0aSs6wPbWZWi9RQfYz2DDejQSGGhXDs8j+ulp8UnhZFMGBJeJ2v4wGg3aFmYxvXQ6hSvKGBGgJPkZHEP0+4F487RjgK2bu6KkuBXt/YZ5Vv/OyFXqUit6Hm+CV5ISKpPwRIix9qtsVdCrfyf2RL7EjXCnxDjSdrfJZaLJFCI85cpV8sCUHbT0wzT0sG1rPLvB2GoDGrsAPxcY3FM+b4GKUmzwU3mEFQxQtX99MPMU5jxmLu0p0+5MoM15xV6E3Z/9Hox7L8LuRdi9CFuWfPYi7D+ACJvSPOWFJLGvHHt9Wf1OCXj6ybMEp4Gg6gzLYJPnXedioQeUuZdkYtaFUJ7JxVTr6tk/PdfrtAUsnSp4JpGq9DWTSVtVrjT5UmosdSlv0bHot23aLy5vz4/hyIqV6EGDOnrxQnWiPbi1vNgC2GBZUaEWuS+BFNpV3cfXX/3TGF62IdtAUK7qHM4L+Ms3zTZ/+aYF4tGLF+On25ER2/k3n5PgIfea9VySt5WPKslPSfBQUTDnd6yWwY5GFf5TTknuGtQ9pRFGsWtQNMJp2XW7e0TFFweVi8pBoWVOaPiUlWGPB2vbPBv+xAkN5UWohIm8Luj0x/KepN50GIJQvAKOlJdgLcqBpySJMPzlAa8OlF8mnk5xwP+q0jy2M8E5LmI98nwzfzH/+mvb9vC1mipsjjutbCXSnctPgMQhCQRp1Djl8BU04FTh3W0BPRf3NlhgFDP1iZAYK8DYGH5U2R/N6ugEqoVOoytAKYYLKlyKwyzCB3CV4ilOiyeA4m4L5gU9f4+DjHeem94CmchL34fsP+Dciqvo84BXar3JR/kS7iZPrgYYt9L/Aa+YPAu67f5yNECYuYfni3WRMekHe/6eMM7+bEIyF/ck9kNWocZpCTszrXEo/5RobmtSDOK9ZuZSf1RyLAYk8HrKVBURwbjmJOkcrLavKfkM3gnv+BwjtY4VHeXYz3/LUNS9w85KXF59YoCI6fotI0sU4Vju2UcShQFKvcz5xsncpF9EkpsGKM5ZZrGSWLfADYBU5slAxpAJvjKj6Wpr81psDxPG1GeCb+tfl2danlUyQqpzjHQKMsdkdcPCM5X72OwVOjV8NGc63TyvJPpL/3VhWC6FOz8vTrKCg3QvniIIUt5YCFOJeTE/0PmazfZUsL04hexaLCG8xCk8C6mEKbwK+PMx/CdOqVzeMZ4hTpZG49sJ1gh+XPmrAWLwAp5JsEAWCxwSxHG0em50ZcqHZTzYnuOK/9VdDqnXCnwnvqiyfwmkL+/PRRNqOHvBqwnTnOGg4EJu18Dq7z5XwRYL8W9iPaM8LlTt0i3taK/7YS+Rvc055jElHJ8KobCvpeDH5pdmFiVQCMS7rZgNujx4Rk1zxzqeJwUterl3/mj5zEmJFq/Avefm3nNz77m599zce276o7cdz02P07QN2TY0a0cTszYp+4YOemDvQqrTyF5bdy7Lt8PCLm8/FrZr1Fosz8QhHjAZrKF2dgUxQLxoFYkI0AZEI5Xbkma0LvTUK3xSj7xnCGVzhlz2aSOHFmAQr7msVquBWECrlE1CjuA4Bk7h9OZqUgKyj8zcR2ZaNIT7yMz9EttHZtZ/n1lkZovhydfcZE40u8mpgedkWn7dPK60JqT/seQT2tnXcCV4gY3Sa5mrKiYpC1QvI1X7tbDNILWuGUqZOQZtFgZf41PNuOSA6WNy6jIpdbBfp/loLaNRZ+CPv6nIYQpyUb/DQNRuAHKRv9Us1EHYdhPQWoafqmHHgbSXucdtznFAdRp5/Iw4LQTOTTt9TDcdtPc006xjnCkbXzpdGHxMMjWTiwOmnyHGbmhxgGw1v7SbVxwQXUYXb6OKA66HqaVbv9QlrLYYU9YxoZRNJI5h9TCc2AwjDqg9zCWtu6hFhmq99tr1JFZoKm+ffzIo2by+LVUGM0BLRCRSQO8ZTpcWmUuymNObq9OneVVkiUKFlbqkCsESSLd0eFqGqxL6XZ2uI5/VB39q+q/dKvKO1NBs1z0OCAKcirUKMglZX1kqQozfpihmxKQx9NgnrxHjOacsyAc8B4RDlR+NxlgvBSdDBRTLdd+++UPE8Uh0uc6ZIQapKtl4DvBWLr5ikISVRvmIWJ60bXc4LzBjIvt8N7InMM8WKJb+m3KX6E+N3CtLBWvbDbqnGW/JilLM4FqHc4oRo7EHzrdzrBvn1rOcwE+Zpv1muDQ5kAOXm7xkfgWNA7l46RRuUyEAfYsihg9AZ7NcT3RxGoWrtFklOXsp0Fmjx3Z9unNbKrXOjg8NgHpK1w4Nc7VxbsDL872Ke/Mz9lxneDYnq40bmky044F925KYf/Gy/wht4kc93W/rGOspfDvGiFJs4H+AkdhSBLeOxpbyNxetGqMyuccHlhNO7krXmb6T4VqXus7geqY4qVOyeVdpVi0xYLiwYHlZMktR6JfFktO3qnUrxSdTOCmalrzxxciVO37ZiCIaaCSal2TR5wJxEqAoWslM6TTj0ol5geIMRcA4bhjh3D7geq/7bHGzRjS588rNj0hdTjXKUJwaPLW4XSQRinMgvtYu+5FhOyxE9rBCSFOnRyrulgGJCNLCEJnNsBjR4xzHVmOXJou8V5kVbsYttrc0ajWtxG0SnQZz3KXJNsJsjdp0mq+PQc/TJsQJjkMhu+sVGHYicdb4pERYs1ke51iIh1KhEMxJFKbY7sSm3HU09pJ+ZnkDpzmt85GmjvtzeyRDtyjZkJSL5jn/M6KzXjxCohSAwciVg/WEyk77e+sEtkiclTG9MeIlA+QUPafA6AIDTlOaAg2CLGWD3uKLS5isxUeLZoa0KOCCPdVkS4WGPDz6YiE/6kRCsIV8evN7W5Uj9OvZIW5ZHjfFvJHURg+sXxQFEY6Kv/KKBSoZvXwFujYXDivLSQdq6WcFxxRF9ROOw4t6XvrhsJJmXv5ZunHDz78MFFQcvjPYiIeffwEJYuSe7VSQEEkQ5pSV6/pU5UfKuERBZ+3M8YAcEWDauhBWi0dUoTZWpa0ig3E2G4tIPVc5hsIeqpcNyFz/UqAo+7bk5VTuMY4hma+YkjzyFS1MKgHiNbxPak+NW0xRurzfUKYpxt1DyVQ5INFYD6dQUJUGVcH02wJyP5Q45Siy4iReNLFSCJG4iYLxFuyDhuDrl3G0siEwEQe3sHixYh6rsauV7q+roJqnbEdhkmtdF3FgPQJytWFMx5LxqsaNkkZ1olw5O/CrS6IvYL2Q09/4I/m9tZMuBAW7KHIAjl2e3S5cpZyiNRHqUFMbuIFdCdaa+y+ZI4Y9lpgRkRUBDWINhG7Kio12BDar42Lutbsq5FKBb6nkYt67S7mQSlkbRy0X4qrlQor+P4FiLma0lWouEleoHHL7Si77Si6wr+Syw0ou9bTxZCrNDEp9kDvxljlIY0+2qTHa88JvmBHeatt354L3Mef1iyJyIuFIkdFuvuuOGfKPhvKOEzJDcwCFLUUI9YyZ6ooKWiseyCPaxytIxCfCp39sjwd20D+qxzeexy+SxyOGZ+vRO15Z+HqFxLTE6myUz7zdRNcak7NGPIvRHrSboXSj+p4oPHvLpUGccY+dRUqck9SW4d3Pv71l/neRz32DTO6txWTs2ds3zNuuZUp70JEjY3sbJ+7K0r5hnTX7jlo7M3unhrzr0GlPYtkv719nxj//XH+eWf4+wfx+a2b220lqyk8oKWXnqdKViHK/FD/OUuwbIeSxGHtHCbUkQPNOKWmNFHIC9kgm+alupdbXHfnNN8hs3hnG1Tunebt13ieP+TYymLdi4ZW1fIN85e4UHGtmKl8nH7m1f52jfKNM5JuZ91NvKW0bpXChWg63Ndf4XijbC2V7oWy/FPdC2V4o83ntk7F7k1zd6jxxhXaskaXb5OK2guyfn7uchdsKsn9m7kr+bSvMPjm5Gwi4kwh0ZuN25tz2lIZcvLE7w/Zucmt7ZtVu90LtyKS9mxzaLZakXeTNXj9jdkv8bv9c2d1WqK782DtJSQA7SksAW0pNAH5mmNYM17tIUwAbpSpoAeqdz7qWrqBtHvpmsvYgeHf26k3SF7QMpk/G6nIKgxaQnrmqLWkM2ojekaW6bxZqjznpkXl6k7QGbTuid7ZpD/ET+uSZLqc3aM196pVhupnioAWmT25pS5qDFogbZZX2M7/7XPw6ckhvkvqgg7uulf6gjbmumzG6c/d13EM8hUy7cd8zJ/TWs0G3DNrtb9Cd+9lJKp98z1vO9Lz3y9r7Ze39stY/GvZ+WR/DL2uNPAl2RFqzITvzIK+TqEfHOxMT5KPtkeorzLbj8evahfadV9XhBsXjEl8v4gJ6uDKJiK7jgVUT4dLD+2jgO3XvVRcpZ0RXrq1BU45TwO+DKAvtEAtPbynMSp6eoJRY1QmflNK/t7pfBsh99Fm7yRalKcvXJIlNkm+5YeVEzJo79h9hHoRy+BPaPCaGVNH9mdgFhLN8Wzw3KVdd3heljSYUSisSz8qeHAeAeTAeH/zDTePv026N8n9+e2OY8QlXISuYFZIoCpfiBAilWSldNKOhzE8mo9KOqjUuvo4TAJ2JCX/L3MJDh0HV15w68hFkqmkgCtzyRAVFaLRk8MbYN9TjiFZDt9RKY5YtjPOFdnvJdYTG6K5Ta4xVNjMF1QlSnzJSRaMQ1CqfPC1WvvZlRwFNCGalSXFf32dkKXYPgt8yFHPl7EIZTrXOsEQJuVcLZ+XAkVzZ/BjGY/iePgodj8qMTTEDEotz0ZybZVLl0Z6ui82nZv3uvXu75OdReZf0M7u2aQ5KcfPlx+XY9fLzzNb5SLAeX43DBhkbJzL5gOEz66VvvJrkKRulgqYtbeMExHIeWDbxPm3jPm3jPm1j/bdP2/jB0jY6w8Sq+SZ8khaatjVWKE8ACMz5rgHDO5HkpolqDkRfdp2X+LaEfy0pDOeItYe+PVW+C6Kd4d9a9arj0aUv1QHMyWw+kpWWgGWLBUqb1+pScgpzIKhBqQCrp77BcJYMKL5pF/3mpGsuaslJtjwnJsFM65hM6hggyo1Ku0qpZ9Y8bi7vGZc2zZ7Gxbx1Z6ExLWp5cmC7OST1jUFnDTFAxvvUkfvUkfvUkfvUkfvUkfvUkT4i3/+c1JGfee7IpRTJlKv9lnJH1rIK6pnwyLmmcMn1sxtnFPTN9ab7rfhVV64+HzDXmxKRtei3i2RvqgOVx8GW7K383p7srbJk3MneloEt11v544+f6608WEuuN7Mg1XvTq0kyMgAAAABADAK5owtDtAW8PId00Jbk+GptxLNKZ4PiWIlEJzlIBhF5wBDiJKIrEWJzAGwZSNPQPvfcPvfcPvfcWrnnkuw+ImzunKQr9b4wOIrx5XuTUx1lIvajXPx6F9d9yVXWnjGQMR7LTECTsLxz225LsWx93JVaaHJW4KgH1YVrDSQUuBt8/S6kayb1M7wGNfP6ufivEiBr5VZLKpPqZ7ZyuH29gvyCaJ223m4rb4d99xMyE/Y0ENr9qWpBA/k1SM+UUWVmcYhT6QjR1ByUpnjsbcNjJdFq2m7HK0thU5gjhWnJv0BZSHROstMC8mmEyMJWDl/LC5L/9kp3ZT28rVifXE3KyhGlQJtioTvpnThDBlFIQbYz1nUyVd2k8qyigCAhOMAVOUAmy5URrOKhBSSY+FDVXlcqVsiVZAfB2wApWU/K2offUWueMwAAddFjTF1ytbiUBXNQKmrBRuRdeLxAMZlixsdFLq+fX/4ytoL8lqaA3yOllyeKyvnpK4mt1JWSEDk8nYB+4AwA0gN+lAPl6AED1QPNsBT6jmEoM2wXKP6XkO3/2+608UwtzKFoMlSI5QJW2bc5h6Yj24we0JkFDYvT+DnQVIw9piUAsXafzgMAGwj//PIXB7ZVOgGJQ/weXioTgPQ2CZ8LrxIBfRVz9F5uqDllOJYh0XZsKczREivdziOOojwS/RGtlMZDTZfyFRExVlXR1Qr19vLs8lhhJZbQLDbhQVMSI+USUw1CYpmEBpxCMEfxzG7klSPFMM2E4DPunWynLmO2yJp1xvCRJDavYcU+CXueXpTWc+uw2vIM0CVOlwQ/Hj7S9IHEs5FYiCO1EtihvEUfPpH/WWsU8uLqNxTZ9EOMR/TDDp+um9bH91R6eqMlu/qXMmRSxtPpG2XBPe0exihULBfFq50vW0FIaXYIViOtcxuhOBT/ZoRx8bw35TLisUnfTs4+zGLOSO8d6SNa3cgp9pWuVOtqUJFaJFq6Ezvif7MYJTdzymNkMSWISGekQ53HoE4JEzw5JVGEddoMMtXBnAiCSN4TtFpu7G0apxEJVh3pUUWTYjxqEPnlJr++KFhS94jSGbakeI3DvF5HD2kx8c4voYGfTKXeeWU1weZ3qLIi0s4NGMRYXTDvMYSEqT2HQ0BBSlmpYku1QoT/yMAEed2Q3/HxwDe0S7TOM+0qV0QFBph8E8Mii7jI7tAWxPbV0Uu4X3HMSkCNnMNKYVgSpt699ttJ8bs1X8mPglyxoqQEsWgDXAJUVCvSjuRu/1dGgZRKksp5RTy/SOtlLy17yldXx0EX6LTFCms4DAiDL+EHSZYxnMQrSOijKrD5Ut5ySlRriao/evm1BgKEKcHeRbM2j4/q5nVftaG+8L2W0pY2S98NYyk24nZOFpmEQuP0owoMcJrQiM5WrbRyWY+7XXtrVOmdVUl97mE0le2EGFFWHVQrLZQ0XA62WSP/GuynIzcn2JwXf68l5ETRjKaEzxf5U8wgzWIGdOqACfA7TqkzC0dnaMDvSxr9SNMHnPqF/04udesytYvquXwuLOg6lBer7AzuVakUyzC5ZOuh77OCVKjylHqk5ap+ACLvU+VsKvQ7dFo3xlh6ZiIZiPRmUm2BsLLzFtBYS7hS5FGdeEAFLHIXPN0gJVJ9oPU8Hc3RulI6dHO1rm2j4tnbo7IbOeYvkOq1gXWp3mALJSv0VORk6+dsaGeEAKN8hOukfGgLC1bSYXfKLdnMm0PKc8WOqgQEJnptE26J+hywVzQsDtdKyjCTEVZjJhOHOSDmfbqzMXUhrdpIbSYOzzKxInQSKhLPJiqfjHqs8tK0HAXW48CUw0zVFUV1BZzmL8QAWQtEEI2FXUYzYIY4YVOV6MVQHPB7c96U1HGtQO9XJbXVAdxnHAiXpsNgTinD2hik+lwSGqE2pg8Ayik+hQVNc4m4hJaSOwuQhMGCMt4KMZ+X3D81VoU95R8zqV9nXDhIiw4fMZnNOTtoBSlNcUKmFXkQS+gsMOYy/atBneWLwDADoU5up8Azs3uMCfUgZyT1lWWdunbUheXw+UGeKBnJcd+vgHCZOUeajVOazRR1cOSBMJ2WFoGK7gtFvDcMFTGHRoMsutKq75iGuBXoHEn9LTOpk0pZ9QKappglwr8qnkFSsIBbnC7+3L4JdNfP2PNiCQgvebMCDNsQq6pYOePWTE/Oo9W5nfUik3rjYrGoMYbwo3yLw6vqyFq70JYXlacMhSEOIcHpSC1NClMSh5ZxdYDUlOrYXd2cUbesDqereUMyMWlsTwSkYuVznC4OADFGA9KRmMT88pmvriQ1K+NOAP5DBgBVp8dVJshj4CcKAvyW4XQFQkUHCBjmZT/mjj1fsxcQBgFi6sgYe33Yb8Rg8leeF0zJ97va4OtggDBA0uus7d7jmARdFommFV481sFinty5+kMphpOLs3YO0ZtfeKwG21B6wARFyByKya+ktY/SF+oAUC+IIsmp4v5xkVBNAlYiJOtIvGiFKAFq36we3/ZfsHmP/T6oTc9DkfxTzZNJ/tkTqDqp8ukpqkeMewLqvKO4ft35Nz3IQd1ZOXuChUYWz5wJ6tWhMnb2BlvN8DmJD+CCcvGfItlnb5hnFLMLyiWEDzZhigwbTZcCIVlDrC6WqiJ+b1wKfKwpFicx0FTTea2NoWBrFE32z5jGI5mgcdwbpjMNJNC0MptbRFehKu1OvYEqSMqdNhKuzRDKm4FyBxWpXknQG+gCpzMMiUzp3PPj3mfahmu9Xfnh/nUpZOy/keBYPb8wS2nQd0hOdc+26CBFqteyeKQ/GfrVA9nSTDclQYW2ZFCwQAnQKfxXnlv9v/vIB4ikwvokIwwiXIZifGpKHfYALLBqJHhGsblIC4zrMm0fnvIonJfEciwchYYPeDU8qPOvHjCHk3h4oD2Qarwqlwid3lIOkBLKsClaryMw994XPT8onFrWuJ0VH5cMu0plUbwa+B8VldtiSe6DZ0YDgmZiRvjzP3tCFWFbQFN13qirk8pDPTQ+ck9ZgetwsHV+33vv9+Npxlj5A16tMX+3mgblMsB0FNFA6ryfGS3Dc8+xqojbKoiYk1EOp9A9dCpsayzbRHRUV4hmVn7aWtuaP9BGkRLK0kdRuTwiaWSMfWcOgMZG5atYlWQERsElUVcU8D9RS/Nb0gIi7XS5Up0Zg5knTPEdnZbuWNKzJpQnix6vqbxQ6t4TuHbsNEXABlvfI32kmFGvAfTgnEpZ1lOJpz4qKevUYqis74pObuBxlFfVigdmS6TCHQaORqIsTycYHxeRPs4ifadqVB9GZ3tFysEWZtOH3boMEBuatvS9y3DHqqiQ8zRjZWodrDY+6IwSC+W6VDK/CK8lU0goVFY0e26E4ndf2N1CoLFxgzdltJyYtwJtjEpjHmDEsHbrMdjTBVZZ11oh6qufGBY2MwDP8Hg2hjDDOrKChpr7qvi75wedZhKd8FvY8mgq/yMIy1PBEpU7fyYTp6gCEl2qPYGAdO4inGkS/qiztaRYTpvxp8ttTgedQCN1RZ9KgKzKOSSWKJjXN5boqxWoDBpl8kw4UIY+mRkXpwuW392V9ZRs1zB0VsS0YV2JLWTwTBzVkVgqlOGcUw46GFRDTlDasyU2pjjl6JWLAc/Y8+edMGUJIz9xyZ16pPzrEJOsosmg67ipCy4eIknXUacFFvhL6Sz968bCiNqVuRhSTDnxE7r8ddw9TVC7MT+tYXryHyGsbXLyNzd5rRXz8zQ1dXKj4tfj1tzreuZtXuqjVeg2LeXmIn+YLWalNU1F65iJepqI/MxDvU1jG5qG1tLHrWMS2ok5aAemIA8z0NpmnbWI3d+csxtTzi7MOB4mHFfF2DaQ2zbf+JhuepsgoMNss64ZZg0TzFrrch2TQ3+zSz+TS29zy5oqZd8x9zaxbGJe6T2LOzKr7MSkskNzircpRRlIvDWLXWaU3DjiCXFTE0qvtd6jcR+zyU5MJts3l2zbVOLNk3vtYX9e1Ms0siuzyPZNIj3MIWVDh49jqJcppNAoDHzZTIsZxGbg8Fr9Dq2D07gx8BNNnOaP3oYN71XtryX3NWZsTfXdCQhl76/zjII+ISsnpQ9yd3Pjjp9rVOwJq8o/XaVXxuSMiigXYCTEEKC0SLHCNimQGRGRze14sE3hpaO8UT7Z3mYWz1JHAPApVs8BgPVq6FjX19PXcsZqyfQX6D1ZZItSDSO95Do2Zr5Q9abvm1KiEkt2uEAxmuGR7nqUgx/la9WaV6TXrjZ5B/dL9vNZstd6zuqLlsQbLdo0jxmZTCHvgzCgC8JlUjWalrNtdQR9cZPwgAGnoLcZmeaRaPi9CBslPFqVAhFbQRblwtW1RpbtWJjsqHniKSk9lLdeK9BPcVt2Nomy9ULNGdZBvo5Ac5Ri0NlsnCNQWfmWWNg4nIvWp6hx9+5f0Ji0aFCrOehVW8CxSDvPgKYQEqb+XYpsxe8TmnJHfwAgBYIApWsmc4BKkd7jwTYYat+qhBclDCr6cz3JJm2PqNfWljNEicNadhczCmW5SZv6hYBvgs1aUgR6rOkkJVSUsj2NEGNtcey1wji1ryqBzeU7bqtPRGlkkylEeMrVtfYAYppjBoHoBAjTYMO1k0XIrXOGE3vix8Yg/yNvXuxgCUKlwkEcyM3pzcSV5cj81IVHiag6tHdGxQROLnXhIOlzYMlS3GNoOhuAQPxbFPju3uv6V2Wjh5HGcqbVIs1IMCVupiUx4DKJYqxzBBYMYaf8K+111elzz4F7942ucc/Jz6X9zeZ/kpi4v9nsbzb7m83+ZvNZ3Ww4jXCKeJtHUlX1XrQ/2CSnTanfwVrmiUbWCiFLCpmzhCIQpisDKIlEd9qqwUbxCgTtuc6ZUsrswVPpAfqX3HR4oIp7/xUy1s5JcvOi1M8b491fzL/+Om5J2+PDJxUePbw5z+UHpkZufmUTw1awgFOFcZciXSnilSFKfqAcUkugmHam9dybYM68wkqJ4YJqr258AFcyP0jxxMNN64Iq72889tDLd54LnT5WFVL/UHhVKaqUvapK+6CLKIUnVSvNH/Aq94fpAKn9vfTFK1+Wxo9EOan82RSAXtzrdLQdUBVanJYwMxOpK+tLFLcxEX4uX5XZuHQ7eVUctzptl4UdXTt01Zy0Sp5Z579lKBp3QDwrHXHqAwOi4WHwSKIwQGnXopdZTSWbAkb1DU1yyQDFOSssVg7rzlmi8smTQBS9AsE3ZjRdbWUmi21wg0Vpul4pi+rfNjLs4ZRIX+auSTVFIQts4Jm6xJs9QaeGP+YspYublWSNSgp9ooJTnhenUsEjuhbLq5URiQ50+mdZqBTzA5OQSm9CBdmDF8huxZLBS5zCs5BKiDJ64vkY/hOnVC7lGM+Uo77a0p2u6UrzxXWVEMTgBTyTQIEsFjgkiONo9dxYC1VQR/vgfTQF5aXXfSeQQ+mx3t6J9lWWLkHUl46ftZxTw60tHoIHBZcp+Toohu297P4m1i6CFMtidXovbmHXeoufLgt2Z011yytnKnB7+e46K5B5FFFULb+kxXGxtKxFgouSPdUqTJXcxAlOVQr9PCW1vhA3QN6vIGM4HZewLKQ8QCkWeyPjQpXep+ywo8wT9Lp2t163/a7ZHtfrT+xavcZ1urMElFloZlqKzLsdiefVzdm43pp1KlP7K/4gBBQlXhF5SVSBeKYsnKMOS7VamiziXC+/KMoRsDnlB6q3UPfNxmvmLz4VugPfNNiysZV+1bJnbspJhbo4DVkePlkq6CfrL6ZA42LTsgBF4v1hSB8d5ayT4qorfSOF5U7+o3hjwjp0kc4Watl3jdu5aFSvFlt+VaaxH+t01ey3djLSVSFcFfw7S+Q1yrjbSrjXeLOpv17K4HyoAOVVpJspfiuM/AASmmRqmRsHpLyy8HhfK++TqZXnVyCvdyk8U9P6eOClSmovmntqoBUO0qYgvqqS5ypmTqdqnboyeSetZ7CoQn+V0ntndXtLfXt1c3mUdZ7vcWgWvkLfLun5FLPvlAMFrrcpihkxhaB7IVxBE3gOSNcTBBrjarHq+k8qb6Wgu8NBthTlr43se1GJf9SsxK+UbGJBVxeQIJ9rZDkxxuvg7C7c7yzdj+A+JXhaVL3V0JXiILcPDNxa0ykiUZauRWP5uhvbU31CFFuzttf0OSBvVC2FNgEAwCKxEwb3WGXDZrLsMtdJAEo96jutA6aun/L0WgK44SjlOHwKmAf9yeIWDQBGZnVZ36npdx4zgx7inOsGJwvQt9/BRAvXGS8/r1WGV2y3R90qe32MRtGFeqmIHAdTeIFVKi+4K61YCy44zpbOemN1klrnQNc9PVNswylPvas0U2JaqPVJhuUIESpLZimqxAW4ziKUcfpWtW6l72QKJ0VTawUhVfNNHfKigUaiqb0UfQqxL5CJO0QAgmCSgrMsUCxEBcZxwga+DmK6Fmor+meqjVHmaHJrty0Mj0gpXDXKQNOyTNk82CMU50B8l3FTVHaJyyIphsJTfaNyHAjTIomILn5lKp2KFR1blRmaLPLeYnaCGbfJT8OaNT9aVQ8KzPHAk33XqF1K1dC39mCIExyHYlfrFdhdivCs8UmJsGazPM6xkCqkclxellPsuCJOAeXYS/qZ5Q2c5rTOR+pKO+JexUbMeivvsS4RqyFgFc1zRaWRuPTieURMAgZzRR6sJz+JqDQh7jhVJa0T2CJcVb1NVTslqMyFnAVNOWuqVCE4TWkKNAiylPUvA+oSnBxiU+UmIx+au6pCw3VJaMVCftSJhGAL+fTKT5ocYSuVMC2Pm7LJSNp3y39WJfdR7TAbWGHrNsewPCr+0ixnpCrnqlcAAAynSxxWFp6QBcV6Us8K3ipKZicch7IU7fEgry4MQxUnmURZiiL9Z34nYcfw8y8DBRWH7ww24uFgNBoNSlXMASUEv+c4lk1Mwdbl0T3m6Gig+jrNGKcL43YoEyrJbgZGeScxjWPKyw4ZhQZjNMPxWNy+7zMShTiVPeQEezF+Of43gb2uhCg2P+NokRzLPTrQhYeVvKxEHVOucyyfjWmCY3wvEB+IuRTdz1KaJcfQeK+AVShZkuSuCuWRCFP9wf7+NWG8QnwLZgMAADanKS/NHMAIgmWi3pFYmjWaX4v+pcyXLvHb+CGmj/G3sgjJMUxRxPAAgAVUrL68PnE4gJIuT3UmBn1yNXn3hXA3WKDjgWUnNoZW3JcA1YwMae68TdOaPiuXP6vlqWxHr71+ftW5S2Ct2ugo0cqxi5VP+QLlBXJyEbnJOSoVxOFGkDRlpQL2S5xyyXlmMfk9h5zXZpeJcKrnnsxjFqPIWKhRHMosbikWfUAWl6CZJB87Lo6c0/3poINb1guTWwqSqwIWXCnxBf4FeU1s8PX5zW0+2U13+bz8vv6MFYQXhCKxrLElTcopXUiIOA5lQj75h8WtnGX3C1UATbvkCePjaV6jVQsCY5jEhRrgY5VStxK+zChbDy3Dv+wHZ4KDfEvU96cYqFzvddODJeCV01JdxHr76iZuk6H9bJr9q7VakQAAgE3LGrtGAl3ljDcpZexy4NigjLFwHHTXZN5qCeMdlS9eu3SxKFFshehRtrgrH227tcOjVPHHruntXaK4X3nitmtemyGwvSSxU3vnKEW8mzLELl7TqjhoLz28Udlhd3nh1qtXR1nh3ZQUbi0n3P++1llCePPywUWp4AbGa5YO7igT7GPC618e2GWiAycnaVvOXSWBNygH3MrZoK0U8AYmBmf53zXsBfaSvxuX+3VFFXaV+m2bRuR7MPUr79texrcNIdhe+d41S/fm5XlbgiB7le2tleZ1gvUr2Wspy+uE2F2u112St2X07lK97eV4nSC7y/T2KcXbVVu6WqLXvwxvK0VMqvReJXidEEuleXdQfnfLpXf7Bi9tu+TuOuV2Owvq+sUi9Sqku5MiuhsU0N1nLa/+9lnLPXe0x2zvs5avH1HXQt591vJ91nLP3z5r+T5r+T5ruW/rfdbyfdbyfdbyzdf6Pmv5Pmv5Pmv5Pmv5/5Cs5T6lV3dadnXjkqv+5Vb9Ugz4TEWfEqud5VU3jszfVknVXZRTXa+UqjtkB9Yro+pR1LpHCVVrmVQn4M7yqe4Sqe414y6d2l4e1QnRXTa1qzSqE6SrZGpnWVT3HHmUS+2jzN9SmdS1SqTK86sVaK/yqKWXLUC9S6P2q9reo1J7tfDpoEPGaSuJ6ix6OvA53je0m/QwKKxhTAASd1bQ9Tck+I0I1jIg9DUe5GrbTsjgYTjwvQV6X0P6mAJKKn6/a2iHGaCh3veCupkJoK/6v4fq31/t34+MUE2vtysdUV9Vv6+av0/euuLnVPE31fbeMLej3u9N2H5qfW+Vfk+7F3Sp80s6LP9l6Vbl1/vyhulQ49tV895QbSp8q1reG+JG6vueqvvea66v+rqfut5fVd9LTb+G2tJnfL1U8+uq5XvNUF91vFa0D7rR8FTFN5XsAz+W3K6GryvY/dRPbSp4q3LdA6xd/b6RSOW9Oj0b+irT+yvSPTwLeijRjYq8A+I2FOheXNF7p/lxCG+F+S6U5T0V5T5Xc7Bez9uU5Oo2POjaUL4K8vKNuGv4vsrx8p24A6afYryp9va3q/RSinutVj8NrI8ifJdJT7vLdW5SqtNVwmaTMp1dl732Ijb9RIHOSiD+VUA8K4B8gtU/1qz8sZNCNZ9QkZrOXdlVnGa/FD/OUtxaAZpdFJ9Zr/BMkXl70C7o+xad+aS2Uuvr1jKZOyqR2VkesythfPtObSmJuUk5zJayl+25oLrKXfozsg6+s0mJSy0KWrvdQnnL1jXoVdZyg5KWbXUMt1DOsnVW2stYblDCUgnzLSLcOuUrOyJPPcpWblCy0h2oDgAFZ1mjXOVm/CT1Fu97yvZOnYR/acq9NL+X5vfS/H4p7qX5vTTfXRpyk7KQHeUfW9TUuyj7uH7JxxZDQP9yj92eE11lHtct8dipoO1X3rFWwLEFrk9pR5/ijR5ct9XPZK2CjeZm0Gr78C/WWC3H6FH1sLNQY60UY9s89C3S6EHwbneXtQozavm8q56bZ1HGctnFFpCeBRktJRfbiN5RjLFvuUWPOelRZnHNEovgLLOhfv3LK3qcwNCntGK5eGILRM+yis3CiS0wfUoqWoomtkDcqJyiXylFH9m3o4Tijson7qJ04tplEzt3n5coZrMa+mfl7iy81UhUrEsLEFUfYo7iUMZQFAUGTpktq3O7wGKtDeKgT//c4vuM4R8xY3ie9s+ZMbx895ZRGafiw2JG/v3m8uIK8fkxjBWxx6bo2phTjqKBQ84vLo8hYQ8gXSvESFm2UPovBIIgDPNchDFqEV3vQUNWYzqJqqENjeXZiqnQc3cjqnA010ud3DZaVbCWB5dGvILgW9YPN7npbDhNxOCLXLim5kdeMIDPGyVvFAY35QT+7TiY5ThuLCUbRiezeumf60p2Qz1DM1zpO1SFFNbLSF/uwJqSXjdoy0lfIZI9J33qzkmf5hiskZSeZfeF4hj+6783z1Ovx1tNVG9LYA1BUw4JJFuqV23Z56nf56nf56mXv+3kqW9uV5kc1VR8Ef9W2k8nJ1U/S3Ull+jmTN6+YeJ2kXrcUgh77aTtLVnR10jYXk/O3oDZP1l7LTF7A2K/RO1dSdnbItbd9zdXtdu62bV80de1jsplra1FW33Ln7WkAK9yq1Ml1LLcEtpMAi6kMl1+iTCNh62KHxKqenYMNP47nU7/PvudJPL/Rhd/j37/8u/R73+7//vvEX7qOwZ9tJPQJ9P65AwIgywmv2W5zwunQJSUtiqLY779K8365Moju/LkqlJkmwWM6M9hcmWSumplhx0LgCCTnFQplSeX0jsnoihUejV5gTY7pVQnu1Jj3JqvWRXdzJm+7+hbMrZXCPCfRbtN8rU7c7X7X3D7XNMN4++6pwfLjQpcu7o3FZtNackS4QwoUzHF5eLRI/O2vAB6VQKoX7IaVyqPtOnt10Rv3Y7gOmvhbC6Gw/xmOJRaB8bIfeQoS1K9MKqzR3+uoSrdp2KTWpdKp3Wm6aRHQBOCWWmSDmBGliqd3m8ZirnyY6JMcS2eD8tVHVFgqkaKktx/hwHDeAzwPX0UWtMDIBxCihmQOIiyEKuhOMr8FxNtpKx+M9ZWaKOsgSg/L933O9V53UWxG9Uaq81BGsHyS4mq7W0qqcprvpZsmEMKcJVrbC3V6KRYe/XJxioXzY2EbjMG7AZJRx3JWvC9q3Ckq0C3sgC7KnE7kUmwTGRyow+7BqPzc09psmQD0Fbcwpys5QIX1rIWef2Ekm3JeSx3lKpXjOdaLFccB67wmupyr39T8xWxMcmBO7KxwTrlxBo2acalY5IrjNIF1Id9OlilA6QfA3UySwdUyUEnHFDEqOGbzHIa5qq5wXomoC53xrYCIo0F0qeSSNVHt7alivhTkwtEb7p8zpl96Zi8USvMAS0RkbxGFXAizCEuOJHsLoLelORUWXTCgJtKOyGZTnFRFZ35y1JODsT2rGfPevasZyusp4Xn5NssD6MnaeusGF06jeH03fUGdt5qLWXnRfJdpZmqxBXWap2LC6UuWu5xgURFtf9WxjeZwknR1FqzT2k6tFqZMINEaImK5VSwFbnbZBgzzbj02lugWGijGMcJG/iG0eiq7K3on6k29VL1KtgFwyNSjj8aZdAlv7XhtcnLIhTnQLx5eENHALYK4Bkr1a8v9AHVMuDAUzKbYTEicZNo7gW5HtSQJYPRRkIzbnNqsmY1sNb6fgpM5+34VHdXo3YpgVnfS3nYqPLficRZ45MSYc1meZxjqd8SeoNgTqIwxbHr6lvUX5X0M8sbOM1pnY80deRmbA8Ga7+aWa+aRXNDbXm/LC2eR8QkYDC3uJYYGNclrvMi1zmBjgtdY0xvVDtlx3Ld76Yq06aqyE+DIEtZ/3glxDyqVF7LZkAqynL1rdZMGzQEtXtTRX7UiYRgC/n05nb+KkfYSqHJvfPOZ+W8sxOvnUELPzf2smpThddp9eFOfFzmGEV8XjUffiwXl935tridWtq8WdrcWNxeLJ+K+0ovvxXtrzIoePneb2Xvt7L3W/kAfivdDit9vFNc1ktHBGdX3GZrtGZ1RLrjuurLyPFlL5XCFaLRoXZgGXyi0aC9YkADGjPCOI6DlT2Av0rAemsgLI8LLUzwtVLgDQLKzO6/Xn97+BL+BY5+hbnigffYRO/rgL8i3FCsN+HmU/ZFGDfgvkJMaxdkCWGpnvjqxT+V8gg4u5LdjL19bvSKue5Kf2DTRmwrA4J39oNxKRcHTYEFKCrT0XvQ5LfYwzdFZ6T4jwxFKvOGkCHG5Xg2XTDgFWK4p2+ORVEGDgedwtWhYoVNM6b2v2jToupu04dIgUl3wz5c9pYfyt3WWZhZJWpV6F0TILtWQ1IBOJV3bGi1vbdUh7e8Snvth9aN4OImRiBs6opUYmLdWupIGaEy56JPThD3su/nlzW5MidIJTOLkLVJgPv5gl3RtL3WvupC9Q2itYCoWeDt6RUkNOUMvnj5pxf9O0ZRv65FZKXwHFD/hloMK53C5OpY4NOYuGcunJ9/ENesXftk7VyqWdO18x9GfjFan1bBRasSTk1zObHFhVhGIJZIlOBUCTrcIf0BYXAv81wpf8ewMIYUfZgYUG0zeXotm2oj+NPxwCtdgtOnL+8mN2hV3V6MynDgSCZk9fDttNgixq9Seu9UVVuU1dr3STLle0WpYt7arYptmunO07PbhasV4QqaJQcirKso0dgo251h2oBi6Ui7w0G2aNhrI/teqNVH/m5TgnyukTm9qbxwdmvhayi/lf7VB9oWn4f/IpAKrwNYoGBOYs3cGEexHJoDaYWOEpAKk1BJq59P9lNtOukapqwVQaTyQR5aw8oGH6qudLB5lQW3bUD1q/OXtQhtNz7aL3Zq6Po0FB9iBsTkh5Z/FwRy16nQR9E6yMrXHqhaWODtKtG2I6mQLMpM2RqPRev+CLY7KjgZgTrHB72l62YI+f9gj9SaydM1DgFRz4TT6mnK7cUr9xX4ozu39uDSj3N7zsBCTCHaK07uYX/v1z5OcXVvOEgxyyKViT7o5XS7v+h/Thd9dVo0x+wjylZDYqSxtun94lB9tcuowRwHD1LDj8PJ5Q3+zeNUKV2iJpdN7Vt+IQiVk6kzkR5hD+vMP4mnkagFe42Rjw/kpU7hqiKdJoeX2rihVgOnBu1NULlZxUEPVNgqDnaEyY8p4T6SgUlsKxXF2ybLgoY+OLyhIS5vWd2jWdRS8/29tGevDuAMO1zlPPD5LaNptvDA6Ol/yJalDHKPc4zErUQyBHhMuQyfNZyzjUagMmAwDjQFLBP0jGH4YqiE3GMFMI/LFQ2fwUJMIdxjwMmcLHBqicxRvwAx/FxaOOW9OcUBZTzNAq7AqjuXxFsTsOCFzqqYSC2GkSxvJKYQjgyuOmpYASdMDwdobMA+Xe9qI7+d+OxiFUOqvzCxpMT4QKTrdJ8lntdcKTQxYkKqDRIWo8jWfXob7NnaqswRWxsIPtXaQLIPa4uFvU79SG8u66t8gq1vFf03l/b33sJ7b2EHlntv4b238N5beO8tvPcW3rW38K6chUsevfcoeMgSZvPl1V6kpTcV0qEomaMjh6dmyc+04jOqu7M5hqpXddfUV8VTq4/p/UNS+YOV/ir1VXrQ00s5wcFYqbIuUM37tqI+r5aDlm+K6oaqZ0hwKniYb0I+0bX6stG1enxoyt3YcHhVbSI/7OEk7fZvtvRS1T5XwH+IRSziGiPMcfiBV3O9X9uyrrWpr+9T89pjoQf1pZzD3q9pn36TFC9FFGajZyF4iBcDh/gCLJORudMsMsOuxRtXev8AKz7FTOorP9RKN/3ZVrh+V1/Z16XH1hWdMl75o8SpK92Vn6yx0PWXjuVmm3L1RjbTa50wAA2nxzrfeIvpLvvusT78W89SCwNvruaEHS7Nwj3DSURXCxxz61JNglGprnxeyzhfnQNd4VrPnv0zKK1l4cee0BjHfOTROF/2L8dH4xcjjPNNwiq17yyV513QeyFj9IPHIDccTxHHM+2gpYh8jZU74QCA40US5ZJ23YU+qmDXhl9PcnWSDGp++tqT8CSQxdsuJFgNYLRAK1QHX5T+LYvnbegAkAWa4assilSycKHZuaD8qmHbl+2ORaCFJJYYcBniCC3CP315XBmK+uF4Wb3RGIwur84vzl/d3F2cvDm/uTo5Pa+00inqv02pRfMt6wZcY2sNKPlObdA8AizfDq2I3Jxfv5ucnp+cnl6+vbiVeG0dJcmumvNqxev05ur07vLq/Prk9vL67urybDcoVahUafokJ83k8u7Vyc353dnkGgjLq0aaqkcY7hHDEJJUbvWVvAvrlQo0hjllHBLE5+Ma/B/nOMVwmeD4/NUNBChWl7P8mglTEmGWF/1Q3QhAucPd4RKlhwWPc89uaQg2Eh7DsAxq6CbE6Y2ajsvXdzdXJ9cKpMS9XE9TZkaV9no5eoFzDSSn+QeCXAwtTRwUmyMxdrGdcczhHvNHrBWqpsw3qwEzPo6QUBpBQkOdxuT84h0QphJ/GNyMeUgngrVkDnmSV4hPUComFov1yuqzV86jKidF9kBYZVIOFYyuubFS1WOiNPhhN/yryd2b797c3k3enHx37oBsgKoSjIKWI1XLq87ghj3G06/Dji4cfZz/n6vL69vza6/OFiNT1bajs+vzm58uTu8mF7fn1+9OXjvAfvFiuJHsslxLdFn2kFyWuxRclr3kluUHFluWPaWW5ScltCy3LbMs1xJZ9geh+yC8Pbn+7vx23TNQejY5D8JAkCDMFklxvpmgHxru/jQqhratg2gv6y760d7/7CSMz3jvw/Pd5eu3b877CgZqEfYSDcpdm04vLyYCh7WP7Pqhm5+3N4roHYftqIhU6zx0PY+MEVsG+QEq8K0Ze1BC9EDFy2P46l+/+lfzIKWcBjQ6FqFh+lkpNs40rZ7K1mOCqcR7J1Op21sdwwWN8SYSijm+1OSjcEFkB5J+2EtmQYnJElL6/BHfzyl96KSv32duQaaXkAEgctmQeKYsyXlumk5pqGOI/YSXTnptQLOPKtG4UbKrWMQwdEu3vOIrD6F0xuq6mJG4GkZ0xinjIU7T+uvlNy9rj17+9Z+PPiv9zsnZm8nNzeTy4u7H81ffX17+4DzuCobr2OtDzw6+PZm8fnt9fnd1+Xpy+pOjq28RiRQTf6Iu6wsUkylmHELJjZSB38iEIgZXOyBph6N80bODwRPpNwWIMRoQmUXn9JrBP8P1q5NTSDMpO1b7yH03lziV0fuDJyB9JyWgIsVtROLsPUwjtKRZKitGDJ7ohyFhPKVsPHgyeALf0hRYlgi+rjKYKhAx5nB5o705+RwpNQa6p0sssD56Dt9hlZ4lz9GptB8624uUe0tiME1VEQwULkCEHKcrFfWAZDd6M48HT+DlczgV7odaw6/gqBwKwoNkZeKX1OdYs4yAkVGYkiVOi21cbhkwIjMaKAH0CXzxHN4IrqC93TSWctHEM90piaXWxniQSZiSZmv8yh9B28/9kVhIF2IIKA7hTI319PqMtX60Rk/ev8FObHjdadaImEgRLsLGASNj7Wyiu6wb+SwtrEm+JoK0IulyzbRXdFYYBE+jjHEpQHgm17I54wWMqEl0u4m+1jtLzLxa3AzSLJYJE1SMA8RmQdRT4o4HrbEebvdA1VFHZIi4sxVYmZrdhBW5nnSkz9jhaGt1ZxKDmZx5dC0aFsmiNBIqYRSdwpLgx179cpqIo3T1A151hcSYKRFFuw3bNMVQDCbNvp1l9luQavc7Nk+bZuvPMTdjI4Ei4hwF84VMFuZMpehO4lf1X+tjxm90787QVzSyZws8qb63Wvid0OTbJVJSxrZPmpbmJge3OVmg/+mywbky2OHqUmQ2XilBhBjDbGz+7jhFXM0sR4lOWaI/OBX91PJGWlo08kdasa2tRUsb2xn1pFazveBaJIYijwccjY+++hfZ3iTHWx6Njr4ahzRg46KdmATx6JAj9sAOVUb+cj4QlJBDlQdylCcirD8YhflUsUPRZ0euy3WSWFrIXCp8mKAULTCXp5TM0lWO/dezPDBLWtV3h4yp0KxYCylSXFazUKwQODG9BM61IH5GC32/0u1lz4QzuaSAxIBq3+dpKy1gSwHhKiggHsVlfgf79JtbSb+5UbbNEEdYfKav+y6CV5tBKFbpQpI9D3OoLwFtcbYl3jKlBgX9rFsiz9kh+9WhKHIh3tMsDmsfVYnPzEdjuMnZiiKzXIfDayzuTUM5LcMz2XY4Lh7LQL8auojbcNUjFHAEasl8xVSdj3xzxK6EkHo3m10s8HrACR/nCOUJQNq6roD0RMPWdU4wk/FvXFHK1ANGR6CQrD1UBOxccA1ZvrrQ5GsTkSDZjvEf0HiX5XuZd0vu/VpqEcuqso9un4J2y6ynT8bZ4sArU7s7PYIVWG2aCthqrh7waqQmaoESpZiqrShzRFa6KoGRyX7MIWs2l9SFYYbLHIYm6DeVL+SHQkTpHwtT5bnlFxLfzjAZJZS6Y2XMe/gEAmY+nJCtOOcHkLJLLNopZ6s2XZK2Rrld1M67awjblQTsCoiaTJWMfS+Ll6ainjdFh+UNaTwSSTqGzZJlmo1rza/znN3Lu5+GvLs/2j/M0d6aI168zNdxsSXk0uxxjbhfte85u8TnUjO7b0Jr3Ibk5HbL7J1ye+v49KZtQPS9N7XelazFM73uTn0uLRteXKp3JktkeDc6rd03IHreoyYxhKsYLUhQQDNGpIEtg81B+RA2bnhTEkXqGJbiqhjC8Kyy9IbqC4t9QOyt0JxKtjt283nJQiIssJb0WXgk091U5N8DyJj46s3bm9uSm14xmLJuyppNwj4t5oCybWH7tdR5NXVcT1uNHXaTk+dVtWSGyr06Jf+y5gDrv/G1/V2S/N4OlAl0UAm1FPMsjQu7kMDxO8yvomxGYiHLP3sOImuKzj1gqX6qLUnOGXHSUh2TrbRUTUq6UHkwPkpPVlQQxuwMfRqMGzjeVkRZslhkXOZ3QFOO03wLusfQlqLEYPG9VDp0plaoNi8NzVB/aFrckXAozz4LSMe2810idvYgfmXyjPuaAdXdw5MQ5cY2Mqj3hgiNYl3Vn14XJJiX10VJYajYrkz/w9EDjuUXu6SGM0vTssl1pcNX2z4ocxEr16Z68JU6wxYJIKLxjHnv0CqqFm8py4SWWtdm1WoiAE4baJaG0nYIANHyS93MMBYVhsbWTy+QZQEpxrDImD7rcBxgda9xYfBUmz4UU2xCvCfKP1+qq+CeqDLkygtdXkrlUQqouov95CI1+AOFgJBScuuJfYXoD/JjQafwai54MZgSqnOUJDge75R32u+41tV1cjUpp3VSzlBysnhvPlU4+HX1+nQyVd2kmioIEoIDXLkuA4kZxyhUDy0gAXDMSYrzuVPbVCJXumILQSTPOi2CwA+/oy3MSelQVHoeYY0/AJYJ/sdMciiZxWds3PHGhdPozy9/GVtBfiszMSKRieIAiKJy2U9G7gzCFCFyeFIOdWRNRJDQUA/4UQ5UsF+geqAZhog84GMYSlf+AsX/Eqv6v4dWmM+UADAUTYa1VL1lTlkgqGR9k8HMClR8gMW19jnQVIw9piUAEiwprLJhA+GfX/7iwLZKJyBxiN/DyzyTckLD53qXsVXM0XsgDII5ZTiW3pJ2bMX+XGKVleoRR9HIyPaPaKVyNanpUpbfBKW8quGxQr29PLs8VliJJTSLgTCIKYcpEXxMOW9VlYKGietk31aw+roxzXiW4vHTvru1rptp0dHUGcOH0XR4jSJGHvnVnl6Ulm/rKCya1YDGAU44O6RL4U6OHw8fafpA4tlIrLuRmnh2KI+LwyfyP2uNQoUheA2lciztcjyiH3b4tH9ONnXD8D2Ent4Yf4nalzUJrMwsrVtigULFYVG82vYqFXST6RCD1Ui7c49QHI5K1fl6EyojHlvw7eTsw6zdjPTegA5x3J5c1mlkg6ahzTy0ZJkcNUXiDStNqdd1a0Rgkl8Weeklsy+sVD4Vp0rOzK1ifrlhOQOk9IAulYCgJOYjEsvkju4rCWH6Ptattd2d9sxAuRO4DpVcYuEVWlMi75ryinoqvitNr9TBD2F2fXUqlSY2Kd+pP2jDdiNU8xu1MCgaZFkJTSPpGYO3Uvk2GRfhsrJVTHkhBx0A4aVs4HI5ahTlqgDCIFPWOWkSt906FojnFqpctECiXs97iFFMGRb2Yqbg4TigoSrhjGIgMf/Tl01h5jKWXx8ofOhiIUSXX4UtBP7ln9g/XfyqSVTdPwrfuNxnc7ZIHGA4+rd/fTF6cTR6cQQvXhzL/8Hb29Oxo/SHxNK7RKDM7tm6BWULs/eU5Q3ovTLW65e6gGFjoxw0Z1UdQj1ubb6ZVZ8uyqlV9XVH5cE1FjMxm1nMcWoQtycbdwxG4J6vxDFcXN6eHxvspEntHkNEZzMcHljhKh2/uYHFArK+hTEsS90scZmp9hdduU9SXd7OSNV8PiJWJtZ4zZS66yisZCzTLX3bUcGlaFbiB6R8DKkE9SIwqojHH1huNiZVmvFYbWf9DVbf1Ce1sH6Jzx2ndxkz7LTJ6RsQ1+L8NU7vUEM5OX83pjZN1DqMHwg/UOF0j4ThA4t1pnFO6dTsw9s0w0PHKVHULhNDITFmTRrQ+noxZ4d3bna9eG7I713LNW/XkKd0Ks5KCW+3BCNkFyHL9lultYm2gS3mXiByJ3txrlHXmmwA7rNG/VD1x9MhirhxdK/QsUhoEutprPi3i57sa7Y8uOrs6k+ljvTiUupJ2QJFUSmi1HZ5zFcQmQLhFQVRaQfpNS9ao6KU5xSRqMnGO+UrxAvk3fujTfLQJdaP4YW3SNJu42qzb5E2w1arTb3rNtBNqAZI9+6cIwaYiAmT84JDoKmZUS5mi8SQpHSWYuZrPtlmavO9z6bFZ3P3zpqtXpod7pntfpmOWL+9T6aHT6a6TmQMp09zxzfB8MwGLkU8VZQQgzo3UzyoqM6s5+gAqoYwxwk53vtu7n03976bhs4V581yNaBgjlIUcJyK/ROw2sGvB9904VQ7vLf+WLP4Q50/y/TDnti4s/nlpuunHorSddyWujyWlDfKB3VbKtiermgcIbK48DFSOb+s+XuUTLEWkABXNjg1x3npr1Y4OCCbJ4kVuAajCF++v3k6BVmBNqdiTRcpi1eKp7+U5UsH2TvUztAMh6u5WDpW38f0g3rq+MjmbdkW0Wx+Ofcx5ofqR7ZbBi/lgHTqBGSEe77JVZbIKdAY53Uum4B1apQUK6O7zXm30qnq5KDlUlNcM+F8kfCmJ4GaMNUfBxRF9FEnQCrkzLH0Cnj2nqB49htB8fNjQKDzhZWEuQZsGgMWnepOtsXIRzrBwFO/S5jLvtew1q1jjXu6rjlOaaottfpMftMFDeWqEMtSocrK+a3UHdjk6opwyrSEltXEOC1K6Fp2IWSJriKGRAkzFKzGcFoAMaxR5ujKGHYWus5FnDYGok8pmftrpaQsrbvQsnU9PlvhxJVTm9HBeR3J0gvwnS9nrc5h+7f1udUMprfDetk/wOGgZzONavdGX6WQH9w5Ynph4LhUDSdaqe7UladtMA2IK2zWNNwYHDsmBVBEYwwhxcxauFAoi81CKeihfSfLa1aq7FD0iFZMr7Wq96XFN1MBNTnvbUehXIJ2Elj8VdGSkhASyhgR0hnDQZYSvgLCmLgYPfV1t92b4Pcm+G2Y4DU3qNrhO80Ynbpkl13RuZ57G7K3YMYuS62BEfTnOEpEvS9ZbzfBKUTiPlw+P5+JWupNoCiReW2VASdv/VwVYA1IiEuRfXhVduUlcYZtWu1HRMQSzEt55iNTJlFzH7xHDIcmmkMQWMyf3UCeYqUD3Fvw9xb8vQV/b8HfW/D3Fvy9Bf+zt+DvrcrVZK7aWKzS11zTCL/SWoSyuVkUnh6jjM9pSn5XJ37N5txVeiFgJNc1cZyOjK5CR7rI8YygUgNDZ+0vVYtogiyExxFDectaQYeURlgHWTZG64luqpqihHynbN9OiiiqNsm6Y3pKBGW2eEVJg6mY6eHwl4Fa0MYEDj8PC2uDtuDoRkuc3ssGM8yHBzCMCJP/fUQ8mA9/WQ94IEwQa/dQdS+w9adbaB3mNkciQ9caEKugDvTdFYt/KfOi+FfSpx+GgxTzVtRtlLF7YNg6sKaTXXtG1u5XJ9eqd1zQz4pCha4q7cdO0DpU3LaOne57mz36kb4Y91b77j3MgNI0JHGZU9m6iTByL6p8RGaIeh4rc6vXgQUFm8eTDQflRmMelZxo3EuuQfMCM417TpkPno27UMmK831G4g+cjdt6ItcqV/mcVfWD2nJM28WRHZybMlJfAFzn3DSM+gCG+Rj6sm2/8/gAhrp+kTezKNa0bXMlH+gIb+Va/n21c6m28fQWGATpVJ2PT1542M2JsxYRG5USdrxMty51dFBgo1PvjzaIf7SeWNaqE2pN5m/Y8KD8p6paau30Q9/qytz0M7jVNZh/j1udHghHHE+z6AZza1nCfsehf93kDiitVfX6fLtBXWVZ/K+zLwBBeEvDSnVmDzDrD1gfrKoiYKlyHBk2qi6uUf7QA/UuGvSgwmYTD31rKiYpocIMX3iWaTP2KFAbcBSkhAv3P2sRRr/93lFcmpFRioWiL20WYfwtQysxkoevWcDIYant8fLF+Mvxi46yisPRaPnNV8PmUwEJhWGKGfvmD89Ozs6uz29unlsaRhiFOB3JzUJoPPQpuajB2esNymLFEbkXgzlkNHjAnB2q60CS0verQ1nijAYPa1SWVOeJrITH7KipDkchqZsgF+IjVc+xE0PrHJYUWF7zWGp/vHw5fjE+6p7L7lnbz0kONXcGbPII17xYvvGeG/s+q22fb2SEy7A3GcsLrXToey20Uvvj5dH4T+MX9c/WrNk6HJVhf6MKl4mVUrDgTTiPnaBTjHiW4tEMccy+udWl7r4R5gVLa/yep2ikRPSROe4ajZ2b5s1PuylJXqkY+2G268dkjeom4LlcTWO18ba4Vtc9CD8cS/2Yc2TkHWWCHaV4RhhPkd+cuT4W/KbKPHcyh3m30sbJRsXN08UX9rN+XKo0rJp5lAAvyhJvUv+7s1T36eXF7fXl69fn13dn15N359d2YlsPdgu0m8nd+cXZ1eXk4tYOKIvJ++PDw3Umz97dydXk7u31a3tvJrChUuKz/HuSF9vOL9OlGCX9mZo+MDXfS1W4G9DyEtskZhw1fDt6FUov3+rdICaXd5OLm9sTOYW3P111VTnHMcdpkhKG8yL2w64Ozi9OXr0+vzu5OHn90+3k1LEhh3UG4GAsJg7zmz88s60bm3ySpVGttZ52W2O1jMrt62v8+fCj8QTVm+Vuau1ERuyckVTGm0v/gHa7i3AFGsGJPtbBaVkqmsgsy/cYZBwjpyDS/Cn3o6t37ECWS2Yl7/uTUnnbD2JzMSLKh3JU6G2D2Ej53ywxblf0FqXEd+cL4dAk97BuNAyeH1CbnK+Tz0CVXF3Tn5p3kBEwc8myfet5L2Fdht5tTs/9Mxxj3d3iaQ75M1hFjnnqs5y24orQ0vCChngN94O1HA8Gde8C62T5rAbB8NqdDT7IPvTcf/72426r6pZM/srJgYa9fBGcPe7a9tmHLIXZ2aD7wXnV1nlUsdy3yZ3W4kolmUHO1RuUrOMzRFjACAoX9h1svjatjuHvA8l1/r/DexIfsrn8i0zhZxi9h8M5ZfyQiTfmA/jlz8Dn2Fzig3lKKVcNodZy+If/ra4aOKoCzFh6GNEART1Au75xduINuS/Oa6Ds6oJh24fNllNSWh1nCC9ovIkdXSx6Hwu6wyyrP++E39PEavtq+1b1vJd17eklAOsMbyuG8RIO65vEK0DWm6ktmsElI+5tAy+zbwCxey4wF7f4SmxIl1VcQllLH2398gMpo73tWyKxSYR5jqA4dEYJ4vNv/vBMqYTurs+/u7u5PP3h7urk9vsKjIhMcbAK6nk2kxTfcJpUHwLg9zioPwOT81zIG/qUEULEKBD/ny5glE7hsIzcoc3C59FEjFDqTbWUs5GCXUnu7apYG/U69PV6MjR4Zh1He6c/vH11fndxeXa+G5OhrCQk1vWFy2Qo+27Tl7t5kY/CUVGmQ+HYsCQU31eWeTuUctM+ZguTVEOm4XjP61uDLEmEZ7UANfULUILuSURskfAolFvk9OTq7s0PF5dnYnuIP25+urk7OXszuRAPij9+qXwu8yVdma7PWYAipOPBqzjs1uTi4GBSgxeWtNJyDU3Ong8/AQ15aT37WeZqg/gIW7C/ycmHnVkIYgdaEQD2tqmil/NYmBIOzwhDMjVixsWhJFkOaIeSA5CpGPQlvQEhxYGoOsRk+oU8un0ks2Axjrid7tfnby7fupbAsOELtHsmHGIhuXV8H+KlvXMaspFs6Gl2Modqg5oxxiEOgVEV6YzilfqeAcM8S8RiIKFKsFpIiYBS3ICUpDRBM50OJXgw2WrlhW2BgjmJG7kIFab6O8mKh6/KyaDslkcBcSRugy3jFm26O/ueMn5LT82w7N2pm2dZa+DqtHLvrrVj2b1qVXvvNPhZ14cYVrMQZmJfLCqS/EySk6arQQ8xoLUfh6B2p+GtDtuwuExV1oSB135aBw+7wLgeTq5t1guvXpPiXm2B0XkdDyx5Jt/QEB/Di6+++qry1kMH1r25WkfbOjqhkynrYKqWoHpm+ZvJmSrV1lTR2OY013Q8gVsKIeY4XZAYA+KQZjEnC6xz+C1oWOS2gYxhdiCmVmYa0imJmARjsVYd4mSOFzhF0RBwzNMVoBRrrjkegIAziaf0Mn6jbuBallRaZ5PoVz+uUyO3QuRJ5S0jF2KoQMn9dacNY0FRUNVmVYCub61w9ZUbI54oBwKlK897hSANdQKPkGIWP+Uqz+qHi85VT5+YItIohIARzYLksljHQt471K+CweFjSso0EqAK+E18Sp69FlwMmHWs87ZIugq6ql0NVRVVuRGe3fHj1TkzH0NCacQc8+bXv4SwhgeIkyYKpc2IImH0JEp9GWmmt/FCWs9JpvdS0t1sSDgNpYt0GhH8XhdPKLnQwz9DyVX6A7gTOcJXexla+3CflrF/3EDuf7SsLB8usPoDhzFvewW699sOQ8a3EvXf6N3BeEwXW/F9c8levcz98gsAu2zoZdrXEPqZ789wEtGVIJWnhbZFgq2aBFusdPlYvayn1WBdP1tq3oMPHmuYOUvw/Uba2/pYtyeWIHWZCLcaC+c0873cxMznGbD2EQM3h2WjwcfwQt9u0NjLXU7qfppKYCtb3mbCEg10oHqH3epEJufvGwHdZYDKWfc3f3j25qe7q8uzwoJga5/QlH/z5YsXLyzvdMLp0X3GvokRZ80mS6+F0m0b2iwOqT7O3QavKgXSh1+LxWdizhpd5efGFU35MYgpbWY+5zSg0THcnl7V3ilEhylmfIQSMtwsTKacbUWddN3+gy2ih93TqnxUlygyso4zqROFo3SGeYlWDb2fkZLqvm511OvYNhSKVsnpEMczElebtQsbjnabOqZV4PpioMT5G54ijmer40Ghk76mkciB/1Y2kM/T8hPT9QK9fxujJSKRME8q4W9B4muRCvwGi+y3QiJ8sab8Vh2SP1l7y3EuPy+pKRd3l2iJjbCnSyAW2rwaLwljZo4GfQH4lqSM/0j4/HvVjW4oLn83lbkVv2rRKJQG82NAizAvc/xEVxE4R8G8YBZKOzRHSwwLvDgMkgwisiCc6ZqFIZAYaBritKjw8gTucURVEdDvMpSimGMcwn/QG1N7a47jAEOAYojxEqcwwxyEmU2XZA0QK1ULEhZNzFiWYsjiCDOmikbg94GAyueUYY3TWP9X2hLy8pA6dC6HZurjGSOoUFGftgjVpVNOCMv3FheV+4yt7un740ZF0MKf7CmbPz2Ap6NA/L+wikQQBzBa/g7i5IQvX758+WcIKeBgTmH4Y6nche5fdDIej4d/BhZhnMCRaB7jPz/9xeNi0FjFVtFEN1pTPGlNGfERncD06T+52n7fUrswFqanK2vXkzdXl9e3dxfn/+ftzfmNr8+DXc67+MOzMiEtstosl+gmV5bXsV1EWynJQnp/mxVwqGyc4xVaRMMteJN1i0DKyr6GB0bI5ov2zw7rLcynapBi5JY6TA3Rq0qgFoD9AJUoXfqwVs3a/BR7O671ECTZMQyPhvWO8YKm4pL01YsXb0j97Tyb4QTNMBu9fEPE59/Vmhj++SF68xFYj14cvfyyt8RaY3wdXh678vCoLdJCDq5SMCTZ4hjeSEpWXon0Zq/F3NdJZzow9PXu5Ptshq/EhAy8d0W3r0XLFvH19Ghsob692veTQeBbEuG87w30oIKVbqoH1TB2qAfNe/DBYw05ugTfb6S95ec2maYCNZdnxFPhFjxCUUJi/MX46GgtDZLXHfrly5f979DqGF7jBtyy6FpuwPor3xtwMaTKDVg8bl6Am04hdVci6Q9yrU+zs9wfpMW/Rhrbbd5FM2XXKL2pVGVBUTKXZVdYQMVWz315QkOzck2VN7q3K0oVnxAWqR8ar14TxivFVypYDhRvjmdZhNLqO/VqTlNZtVMZxVkiDVEoVJVbUHSVkpjj9FQcSvUyMheF47KlpE2loJNoWq7Yrexk0vMBCAPJynMn4n+/ubxQ0sg4F50rHd+UXHe7epZt8zpRpX4tnSlZuXAMzrXEs2pvYdF96fNcw1au4SnrxQ2kT2dNYFK9yXQsUKq2XPDkk6vJuy9ugjleoONK95USQbZagKUd0FX5Xy61rkZ1TgvOqspJNWKpOhOqbFKQl00WM1Jxghl3wXdXfbY40YeEPTCPEsPi09ZqZBel0uMPXzOIvRbz2LeWpsSzFYEzwh60+MfgmRAsGNAU3l5P2HPli11UvpcVyU0JyjpRCzxQmqJ61XDC8aKBiAPxjvrZN/I1JCg1fjt5HXZJpiKbhcecuyZObtRWwgn+qJp5z0aKEavumwbUa9mkQmTVibovy7qKqrrofeTfca9lIOdeTT1KMaCAZyiKVh9q9kEHXwWEr1pRPtWNzPZRe8QU5bPiJs6bGU4dlWpJzP9Uv2ItSEwW4sZQtRYIWrQi9z19hAWKVwobSUjxjcCvnXwbobgrHb0ODfTS06+nXtc9fB6Kc42sn657r47upY72UePWIlXdqlzRcE117hN4VP71JcUe3OMAZQyLVzpUjERYxcWrYYqXi4eYhlvQGn6qCuXrtze3d69OTn+4vXaG3Q2PujW6qsifNJR+c6jdNlRQuD0sVd6mv+lUAy+HH0bzylas5av6W/NVmsWjrA6y+mmaxYeZq9e1gs56xQpW91bxsUlO0CvQb4NAu3V0wC9eLFoUs2/6qXj9gZWdlLabneILH8+lsgdM10Zyp5hwxrEZZmrLe7DtBBRPVIQnYSYgND/PhOApKghzFWIV8KIkromXYTCjMe6d1KKe04LQfKSCsvlZYnJWQJXGv2w/XNe1BTdNmPAZbaqPZKmosu1W0NWm3aAtnL8VvvUY6Opku2GTPdbf7iJ1dxiha+Nsa5lt7GdjH5xaKfD/DwAflTdBVFEDAA==",
+	"openebs-operator-2.1.0.yaml":     "H4sIAAAAAAAC/+y9e3sbt5Uw/j8/xfnR3bXdipTlJG1Wbfq+sqQkbGxJK8nOZvNmFWgGJFENB5MBhjKz3e/+e3CbKzCD4cV2uuTTp7FmMAcHB8DBwbk+gdMUI47hDVohuMHpkgQYToKAZjEfoIS8wykjND6G5dHggcThsWlk2iwwRyHi6HgAEKMFPgaa4Bjfs9ECrdCIJjhFnKb6LUtQUDQZjEajwRM4w1MSY7imEQY+RxxQFNFHBupbQmMGNIbvvmSQ0JAdhjiJ6GqBY840SqdRxjhOBYAKzuk9CsYo43Oakl8lpPHDl2xM6OHy6B5zdOSNfZpFmIlGI0AJ+SalWcKO4cfh74c/DQAAUsxolgZYPoxpiNnwANQ/DpOUvl/pdkuc3pc+9IJmyCZBMkV9+W9BDfPfQ/weB+KPEnVqfx5OSYwi8itO5ZsUJxEJJFUCGvOURlH1DcMKBF4aYDgOE0r0HwGNp2S2QInGK0h1+7/Te9UgpbH899pDZxxxPM0ig0mI8ILG8q+1YZo/fskoRxJqRBaEpyie4QbYiDAumjwiHsy9uyDxLMWMqUmSa4/GHEUJDVHGKQuQIfQSp5wEtjeBeDMV04MZmcUS4i8ZZnxLKDJOUzTDQYQMnonYNIzjmC9plC3EK7KwvvEjvm4bo4TNKR/j9xynMYpGume9EW24Vb9UhKo8Ehu2gcQMczWZZXLIVSj4m/hXloT6X4l5GeIIc2xDHyVEoBwLRsJakA0yxunCPAoFJyOSZdXwgzqCNrw0OjmGFrw0e7JjA8Pf17od/n4IFiiBmIXxlmBRmoYkLrNXG6EijBh2zVo+W4Y6BSUK6miK2SYrXBAmZirFM8J42oXLEkUkRJzEs0d8P6f0QfGyTH0oV9wi4y0N7KMoprRtGOWpjWl8rRF7e/1awjpcYJ6SwNqH7+5OaBgSlmaJwPY+C2eYW+FZl2OBs2Ep6pR+ReIQ+BzXpQR4JHwuX8gD/ColSxLhGWbjwRO4vTy7PIbTOQ4egEwhxFOURRyQ/hRFjEKMcciAU7gXxz9OcfNUF32TeLajw51l93/HAdfnu1XKAWgHYd7X5JuURvgaTwXgpqTSBdHMc8tQ5dSUiYKShB3motpZfvhbaCG7QwkREgV2yGcAEbrHkaSM8zuAgpEcBnSR0BjHfOTTemnwfjk+Gr8YsAQHoiuGIxxwmqpuF2IZvi7h0YJJP1yMoHMMR6JXwTjwbKU64asEH8M1VhtDPhIiEolnb+VWPoY4i6IBAMeLJBIPFLIlOgNU6deBeV86ttMSAMDQU/67sqAvOtYygJAJEYlxWkJ+1I4+AFmgGb7KouiKRiRYHcNkekH5VYoZ1puo1C7v/3BRADsusFe/hKa8hIJCI0fuiqb8GL744xd/LLXA8bL6wRO4vDq/OH91cze5vPvu7avzu9PLi68n3wCO0X2EmRyQ2G+GSsCp6CPGARf//O5LVoN3jxgOgcbA54SBOh3GcCv+EP+bxTTFIZAp4EXCV+Pa16YdyxIxPBzClKZVJHAKekLhxfiL8Uug8SNKwxoeZkLsw6v1CksUZfgYhodzusCH2X0W8+xw/JDd40M1gmEL1b68uXtzcnN7fr0toqEwTDFjnwjV8uG5iDbnPDk+PDz608vxyy/HRy+/HH92/OWLL1+4aHZx8ub85urk9BySlC5JiJk8I3MmC3Sq6FDc0AAxQHENHo6XJKWxfL9EKRG0r+2G6mjyjiut9EC+TuniuPYCYEpwFOpzyvruCvH5cc7bxvkgHGO/Ob9+Nzk9vzs5Pb18e3FbpYBZLUYGMHRIaAiIbTr6Wtdbp4FgqeMmL3UQ4s3JDyd3V5dnclKaC2HLY6/0ttvZr6E6mZY30+n1+cnt+d3Z+dcnb1/f3t3cXl6ffJPzXMJgiiImZb0YLhMcn7+6MZJhDW75mgooDs2DhNIIHkkUQUw53GNQp3Q4bqVPF2o2kh3DkKcZbuGMk4ub25PXr3OYpwLo3c3VyfXN+d3V5eVrCHEgp/1xjoV8a8YK8hYGLEEpwyCHxOY0i0K4r9PXXD9wKHkEJCiVG0cfoEBixlEUKdGwOTdqCIBaepbEvMelng6ATGEop2oIhFfJnbeyMmlJOeDoATPA06k4EGgcrQTAtebiiWT7WB4rYihds9w5JY6ZVoPtnurT6zPr7gTCIGM4BE71KXkYEibfiE/Ks1TrZJrShdLBnlxN9FE2hlerfMYEyzi9PmP5RGlgOKxBaiHH6fVZrbH3In91cnN+dza5Lo8wXwTyYIeQpFJoX8lj2axMGsOcMg4J4vP6WvleXPZyDhCgWG5vDELVRcRan5IIszGcaRrIbgSgnAiHS5QeFpeV1kVhhuCY+zKoFkKopXR7cv3N+a2AJtG+xzlRxPlApis5X2LgAt0aNE7zDwSlGFqSeCY/YHMkhi2kW7Gs7jF/xDhWr0iIIUBp/ZCgU72ZlYpMnCTNLYnzZSSpJ7smrEK9Q8UMOvlnbfgexNSQO2kq9qXZo58SYSV7FGRVvO384h0QpjQXBjehnRCqWgkwooFth8ujHhueG2Kpxd/9VNWoupX5+tvk3YkCvJV5MgP+G1kiuFEH/JUgekRRiEO4XxkesQ61PsXzqULAzfjR68vTk9dX7+6+vby5vTq5/Xa7U2IUBOarqkC2lfk5FPsl+hRnyUZan/0jBzT0WgOnlxe315evX59f303enHxz7gBvIP+dLJFSkPiBvz6/ej05PdkpbOc16xiGnw17nSc+aEqePCKMz7gntiU+6N+BYPr94b/55s1tz05Gi9mi30jeXb5+++a8b19KPOjTm+nn8mIiuvXpajHC76VqJu1Pu/P/uLq8vj3fUUffnr++8oQdkTh7P8o4iZgNeIX7nl+cvHp9fndycfL6h9vJ6Q2QaZnDAMNxyADFNF4taMYgY2hWv9sp87r45BtKZxGGkxhFK06CTom23rv3/dV9Rzi/vrv94aqDRCMcc5wmKWE41xoPBw4S5djdXU0uvrmbXNyeX787ed12RoXazgbPSAxzmqXseQW6OKESnBIakgASIeNpGjIccxshx5XPzXWCMHj5+bz67g2JySJbiHdH8zH8QDOJaEBjYatXyhpO4RHjh2glTj6GuTATwtEfv5wP2nWMDkoMrOrGl5/PyySNyBLHmLGrlN7jqqJGOH5UnwAEdLFAcVh/DDCCw4yl6og6vCfxoVCfBjyyNNSK1MobadxG0RmO0OoGBzQO2TF89qLSRs1M/vaP5bcpRiH5zY6jbmyru0R1GtlGWnHoY2zztgWN2DLILWcla4lZgSghelxJ3ViSpJTTgEbHcHt6pZ9xlM4wr9hVquY4hwmIYWmBP5lKD4jVMVzQGG9inzTMRmpNxdd9jJT2j1up6vHJZuZKVwdrYPXRDJfuMaxFW/hoJsw2jNa2YxqgD19WADdsmh0GyqapTQOGEtCPY22zIbJri9sLaW0T/99qcHOYdjcn3Xasu1uk3NYsvAb4xeXZ+W6MRtJcFtMQN4xkn6DZUtrPjAGxabGrHnli2RiOJIdyUIMqPZnLs62NO1OainmWILXSVrHqQ+V5BcbT02LIiakAKbmQ8FGzChfDXDueq3MiSh+yZDvr9LPR9emR31pt0NO1XGsD0f8SY6l+Uf0LIoxCnII8fQV6hOldH47tiL0+Pzk7v747f31+eju5vNAXqDMXWhabSPUvuEppgBmT4AtySwlAXAoIA+lcrK44YsKPvoBgjlIUcNzQOOuJBaIU08ksxQnQjCcZr0/dDYVpFkWy2wDFT3k+3dKkIj3y1PfP/qq7ZM/HJ5DiWRahFPB70RlraqnlopX460WPY05SbARxNdA5YsWVjTSoDSdxMKcp/PxfP8NxDgzFK2A8VRp5xIFxlHKmUP25ehr/XIP38/j3P7sBCWzqAGBKRQiBUk7+ilMKYlqoGMgcpTu5V7G55eEosDzkmHH4WU3PKIDhf9VkkfHvhz/DV1Ky2/aNZQ3x2zhdd0RytAnhNhBe4mLnh9sRyO3drI1hL+F8EyHchfcGtP14AnmOTxGM4ha0LY0bQravDN8Qxj8RyWSLp4v32bKjk2UL58o6p0ptlfw86Hmo1L//bZ0qNey3eKrsTnLWC8WgDsX2tknPRbMO+XmbsvN25eYeMnM/ebnBVlsUHRa+2qa9+O0z1u1f+OoLt7wKbEs3iGjsc+/b7q1v23e+Xje+vve98l3rievq1uJxV7sdlr3pBv1vkT3ukC03yCf7830X53vz2tjvfP9N3hpr2G/51liwCjGbQoM3Cgl7GC1QjGY4hRSLW0tolKODJzDhdZuuio0lvyq/OPG5ZDH3WPlyT0kk17HVpHYq4b5BScvVNA4XI9X/hga1EiDT0xPIQrwcSXSBMMM5gKaQpGSB0pUaCjzOSTAvHLcN7wBOIc1iAVpCU4NVnTB9EYqMbpkBnRpywEhvYpIKdrCAKQUSB1EWYglIUA6/l3+rhQUMJyiVk6FWOBtAc8LGqqdj+McAlOXvHuvOzbocwQNeHZfGXbt2ihdQfSED9I/L3lQaCMNI7DtuBWRe2oFJJ+watAVKHaDEmw6kKqSvDZYyRSVN0ZFqW+uEMrl4c7LXGjWJAKapUMUfHGIeHM4p4+zg8J5SPqyisMRxSFN7z+qdT4d6iRzDcGhB4vT15duzVz/cHmhHvRoKwjnPjoB4s3n3EaXJwWGIl4fT8IX6B0v1P1K0UP8IFyP1j0Wo39yHwy4NlkwIcYN5O5for7JSH3Uxjd2oowxgj95VVPmNTcVUNvluqGcqEPIiSD8d0pN6lEPzrDGSreCnNAYURSBMWmmMOWayPRuUxOUVzeBRsuOIPGDgVIlRufOOdCJldKG6YgfA0CrvuYCk5YQlNk6vgDgXjCs8kF0EqgtBOeBzytT3kj9nOQN7Ih/e6AUwHlTFwCeAx7NxDqPoSWKipJwRlHI+HIoXMxEUPvzK5PAYiWfDHKKgTMAjDVS8g7+I/5eT9Nd+wMq4F/PlgHEMViB9dYSCUV5g/kjThwqPeSLfwNWkIvMHMrOA4EiZPETJzenNJM9Q8DjXTv4XZ29yMCbARjQoZH3zWnRyNTmrdN2mtmysVvflutH0+MX4y/HL0gconTVCnUfLrz6vXeEwTDHiWYpHM8QtQUdqMeNHePt2cgYomtGU8PmiHhw3GpXBsK+G31zdvhKWb/HZ0NUn1PuUIrnscnZ9dVom7xhuzdNGpPgT9R0DxOHfjo6+kM5JcJ+zAm1uN50SBkGWpjjmwgE8hpMomSN1ElWAWkZ1cjW5ad4ufZUYDAdZSvjqlMYcv+fV2UlMrovwuO5Rriy7b8SaZ3YtSC65ln8L8YHSYUiR4dAlyNW+Y9m9+sqzvXDEu4yj1bHbE16Iey3YpVl82Ghivk1SGizyxBktwxMN+6MW4mUXdBdm94jhRvxD9dOyL3/5TCuDUTEyPQA1YnYsPkC5jAIk1veKi7M3QFgR7JefhQliev+JJmcmMVYNImL+UcQf0QFDDEayd9FAI50jCxkT1/aQPkr9k2QwWh8ihp4z50/Gs+VJ1Zk5D4t8lBqWUhCYDG+U6W90/I01ivkJEKUCzPUmByBDpytQvIOhdSzY15PXGwaE3ZBf8bP7FcfseS24TWDUNiIbKjeT/3R5nR+9+NNnf/r86MuXnzd8v25KhI6zxT1OBSoVwlTw6ESjLZrlxW4csqVKx/J8GIeL4dasNeo8sggwjeMhMJqXKrItqpfWc0MKVGLHVNFsOUTUBebM7JtBMd2SuYI8NOAZp4ACqT9Vj+Wqo1N59oinR+IP0ftzILGK8mywChn4Jg4cBWMksxuK75SySsjyItReZXQb+JxyrcNtnHauobYcdE/kYhfnW4m1EE0EHAKj6ubyiNXlRdNIc0wddyqbVICiFBufSBwC4uKyxYlJUdGIK24dpu+EtpzJrfDbDuhaX5fpaeEG3XmAe/eqvt6Ge43YSOt71tS+7lRy7taPptFDH3w+mNeMBct+dIOP5iNTCPcNhFpum6Zt47rpewfaYtSOuLFZHh/yRXJo8Byx8GEk+lx1n36ftx1+R9WjcYpIlKX4dp5iNqdReAxHbfK4Ifr3J7en334892SDxkfJ6+PM14Qr20KcDnbR6hPIxlSyUV+fiHBTFxWPYdjcNHaH+dPX5ycXb6/u/nb5ausxpTtOMiPuTGZwa2WY6ZdgxmkJ/xSs327bd7AIgTD4CxQ28A8t/NPhp+EAnCfXHflnCkVJcgzFhzqJbpeE0vmBQ0zZSjRcp6zjHFM/YaSFNGuR5+NJI258GsJIfQ2t7VNm1ZCjiNGIzjhlPMRp2lSgv6w9evnXfz36DTiqFYicnL2Z3NwId6Pvz199e3n5Xdsh5tq4Q0/QX59MXr+9FonSXk9Of3B08jUi0fCf1ZupvytSjdZuVyS7J1L9c29PpC14Xn0Szkw1AnxaITAyy0Cy3CwS3Q7EK1TD49PtXOBdHW2A5Qe71rtx34jG8ClFqI8s2bvWDFUvQ9Vj34ep78PU92Hqi312bffoPzF9zqeeCGunqcj+aWIi9nEQG14+amd57ziI+ve/rTiIGvY7vTo0a4LlxYZ02IIMeTA1nc7ycmCV2wWKY8pVJanjgSKMCXMczXAsD8L7jEQhTisy5/LF+OX43wagvBkIjW/JAjOOFkmuxdI2fcZpmlAaBarcEBvXC37lNwQUhhJBFF2lRHCzU+EoEOc5xP52c3mhePNYeVmO5xhFfL6aCO1wHOQOsyFmQUpkxaljuK34Y+gvIBDJfUVm3/q3Cutv7YDVLUHgZrwrbVgVqyDsgVnpqy7srtwd+GEYYkbSXtjpL7owO7MD7sAqFzcai8mG2MkMC4xODSa6jlUFj5MZrnStPc9nqoZVYwWqz/RC07vHBj4ijH9nff2aMHVsJVGWosiy7uVbNqcpvyj6AhhBwBLFXIRvmWDozY8HoE6TdInfxg8xfYy/FoIKKyJUWEDFOC+MvBYOpCNmqRTbf//PAMBUm6NxEYh0cjV599lNMMcLdGyjd32o+q0MnWq+VIFWeQ24cZHeL8EpJ7iifs75Wf6s1vdTgZxqA7KgoT7KNB/CITCJeC5bplgfu02jE50CioHK+mpj6ZeNU2aipUxWzRQHdBaTX3PIubuKUAYwXmPlqpCkkicOpI/OAq0gxaIPyOISNNmEjeENTTGQeEqPQdzW2PHh4Yxww8HFsZSJnIWHkg+T+4zTVJSZXeLokJHZCKXBnHAcCK/iQ5SQkUQ8lgx8vAif5BP+tISp2gPqrM0fy2XupLtY5UAYIP2Zwr8gr8lmf31+c5tPtpyCKs0ltYvPWEF4QSgST3Eqv1IGQSWlqMKuOjaZ4JpvLcvuF4SzPEQZOB3DqTzHpNwkTSnhGCYxnKIFjk4Rwzsnu6AwGwmSdhO+rksqGipq5Y/L2pzOXSncD02IpPhQyq3I2qxCTjN3pYe2vSp+KHt/nfOTyps6hy41BKQdTYW2K5Oh5eZNXlvjXnpN1iACFJmp5ZXRVEcoaarGtW+E5CGuBhZ/bfewFGMXa6r5vCqUuL+XLePV5dT+CmBkOQPdrWorpvxLEBdM5xj+69n/+8M/Rs//z7NnP74Y/dtPf3j2/8byH79//n+e/8P88Yfnz589+/G7N9/cXp3/RJ7/48c4Wzyov/7x7Ed8/pMnkOfP/8/vrOi8HxXhTyMS8xFNRwp76xw0Gc1rSfnSmaKyHbyXqY/RwigOGqvHPgXqrmDd7gWeYseHNGBiswc44eywUlL1UEUrjHSXo7zLUb7wDp9aurfu4WKbKW61X2Iffolda9rXFxmJ11pkhm2NYTKFHDZhQBeEG90lKrjUARBuNBt2iJyC3gZkqu7ChIlbfUQCIoKMSt72lM9x+kgYFh+hGMgiifDCyDsjJSOF1l7kCf7JbQ3nK8H3248Zcayxxpknv5P/EmpEmjETwSiVKaS5NYt4eWuEAADheGHZkA1crEewQAdoLJHIcdB9WijlxqL9AANxxUHXiIS6HrOlRQ3ls8oHBvEUkVDdk6Ay9WIwVpggP5sJ9Ysc7NjaykFDC1o5SmYHMe0trrIUCCm+hKQDIkjaK2dxGjkaJR3cVvzuIxo8nClPdXerjvG1iW/mqvyq6Kgy8FaQUCaLRLXiVS8AMU54xjGrUG3cCtWHLg3qSANjR/MaDV5VvzYrsKhGiVUHnVBBj3rc2bLz7Ct+AUpQILLH9xvUqf7MjMaAWXNEMJGHAVsxjhcwwzFOG8FDjuW/QFwKA3/83JMs7YJDCbHXJH7oSZYz9ZWhSoiXEIm/xTatLNztTaE5p7swHdWX8aC7e4eUV2+G0hStBuuiV0GMDdo6asGnHRNbWP260qnH3FTWxEWpb7MwlGOFDhsqZxHjVHuQOLpGcijFkZurU0k8G8O1JrYy8A3WIKQAqhIBeRytV3njYsHr2mMCN3WwqkGiJImIe3ZpfogZIWI8WI9nt93et3OPb9kO6oa/xo3e827f9/By3/fXW/heF7TmJcyP43pd2j7Z69tGF7ntaA068CpW8kfVH/Q+Y9p0Cvtl/FtYxr6aiS5R1cmS23QUXWvAocHo0lZ0gO2ty9B6iw6wv4EN69FIYKBdOTyP6KenxSeFk00ZEFwmavvDaDRoW5jF9NLpFK4oY0SAkuRncAzRrwfizdOOAbZu7oqS4la09xvmWf07I1epS63oebwJXklKqEytEyHG2q+yVUGv/p3YE/kSN8KdEuNI290ml4kmU4jwlCs/yQNTINDSD9PQw7at8ewGY6sNaOwC/FxgcE/5vAUqSrHBT+UDVjBAleL1wcxTmPOYubSnTLszgTbnFXsRdn/2ezDuvQi7F2H3ImxZ8tmLsP8EImxK86QWksS+cuz1ZfU7JeDpJ88SnAaCqjMsI02ed52LhR5QZlSSaVYXQnkmF1Otq2f/8lyv0xawdKrgmbSo0tdMpmBVGdDkS6mx1EW3Rcei37Zpv7i8PT+GIytWogcN6ujFC9WJ9uDW8mILYINlRYVaZLQEUmhXdR9ffvEvY3jZhmwDQbmqczgv4C9fNdv85asWiEcvXoyfbkdGbOfffE6Ch9xr1nNJ3lY+qqQ0JcFDRcGc37FaBjsaVfhPObW4a1D3lEYYxa5B0QinZdft7hEVXxxULioHhZY5oeFTVoY9Hqxt82z4Eyc0lBehEibyuqCTGct7knrTYQhC8Qo4Ul6CtSgHnpIkwvCXB7w6UH6ZeDrFAf+rSt7YzgTnuIj1yDPK/MX8669t28PXaqqwOe60spVIdy4/ARKHJBCkUeOUw1fQgFOFd7cF9Fzc22CBUczUJ0JirABjY/he5XQ0q6MTqBY6ja4ApRguqHApDrMIH8BViqc4LZ4AirstmBf0/D0OMt55bnoLZCK/fB+yf4dzK66izwNeqfUmH+VLuJs8uRpg3Er/B7xi8izotvvL0QBh5h6eL9ZFxjjcYzh/TxhnfzbxmIt7Evshq1DjtISdmdY4lH9KNLc1KQbxXjNzqT8qORYDEng9ZaoaiGBcc5J0Dlbb15R8Bu+Ed3yOkVrHio5y7Oe/ZCjq3mFnJS6vPjFAxHT9kpElinAs9+wjicIApV7mfONkbpIqIslNAxTnLLNYSaxb4AZAKp9kIGPIBF+Z0XS1tXkttocJY+ozwbf1r8szLc8qGSHVOUY6BZk5srph4ZnKaGz2Cp0aPpoznW6eVxL9pf+6MCyXYp2fFydZwUG6F08RBClvLISpdLuYH+gszGZ7KthenEJ2LZYQXuIUnoVUwhReBfz5GP4Tp1Qu7xjPECdLo/HtBGsEP6781QAxeAHPJFggiwUOCeI4Wj03ujLlwzIebM9xxf/qLofUawW+E19U2b8E0pf356IJNZy94NWEac5wUHAht2tg9Xefq2CLhfh3sZ5RHheqdumWdrTX/bCXyN7mHPOYEo5PhVDY11LwffNLM4sSKATi3VbMBl0ePKOmuWMdz5OCFr3cO7+3fOakRItX4N5zc++5uffc3Htu7j03/dHbjuemx2nahmwbmrWjiVmblH1DBz2wdyHVaWSvrTuX5dthYZe3HwvbNWotlmfiEA+YDNZQO7uCGCBetIpEBGgDopHKbUkzWhd66hU+qUfeM4SyOUMu+7SRQwswiNdcVqs1PiygVb4mIUdwHAOncHpzNSkB2Udm7iMzLRrCfWTmfontIzPrv99YZGaL4cnX3GRONLvJqYHnZFp+3TyutCak/7HkE9rZ13AleIGN0muZqyomKQtULyNV+7WwzSC1rhlKmTkGbRYGX+NTzbjkgOljcuoyKXWwX6f5aC2jUWfgj7+pyGEKclG/w0DUbgBykb/VLNRB2HYT0FqGn6phx4G0l7nHbc5xQHUaefyMOC0Ezk07fUw3HbT3NNOsY5wpG186XRh8TDI1k4sDpp8hxm5ocYBsNb+0m1ccEF1GF2+jigOuh6mlW7/UJay2GFPWMaGUTSSOYfUwnNgMIw6oPcwlrbuoRYZqvfba9SRWaCpvn38yKNm8vi1VBjNAS0QkUkDvGU6XFplLspjTm6vTp3mtY4lChZW6pArBEki3dHhahqsS+l2driOf1Qd/avqv3SryjtTQbNc9DggCnIq1CjIJWV9ZKkKM36YoZsSkMfTYJ68R4zmnLMgHPAeEQ5UfjcZYLwUnQwUUy3XfvvlDxPFIdLnOmSEGqSrWeA7wVi6+YpCElUb5iFietG13OC8wYyLpfDeyJzDPFiiW/ptyl+hPjdwrCwBr2w26pxlvyYpSzOBah3OKEaOxB863c6wb59aznMBPmab9Zrg0OZADl5u8AH4FjQO5eOkUblMhAH2NIoYPQGezXE90cRqFq7RZJTl7KdBZo8d2fbpzWyq1zo4PDYB6StcODXO1cW7Ay/O9invzM/ZcZ3g2J6uNG5pMtOOBfduSmH/2sv8IbeJHPd1v6xjrKXw7xohSbOB/gJHYUgS3jsaW8jcXrRqjMrnHB5YTTu5K15m+k+Fal7rO4HqmOKlTsnlXaVatL2C4sGB5WTJLUeiXxZLTt6p1K8UnUzgpmpa88cXIlTt+2YgiGmgkmpdk0ecCcRKgKFrJTOk049KJeYHiDEXAOG4Y4dw+4Hqv+2xxs0Y0ufN6zI9IXU41ylCcGjy1uF0kEYpzIL7WLvuRYTssRPawQkhTp0cq7pYBiQjSwhCZzbAY0eMcx1ZjlyaLvFeZFW7GLba3NGo1rcRtEp0Gc9ylyTbCbI3adJqvj0HP0ybECY5DIbvrFRh2InHW+KREWLNZHudYiIdSoRDMSRSm2O7Eptx1NPaSfmZ5A6c5rfORpo77c3skQ7co2ZCUi+Y5/zOis148QqIUgMHIlYP1hMpO+3vrBLZInJUxvTHiJQPkFD2nwOgCA05TmgINgixlg97ii0uYrMVHi2aGtCjggj3VZEuFhjw8+mIhP+pEQrCFfHrze1uVI/Tr2SFuWR43xbyR1EYPrF8UBRGOir/yigUqGb18BbokFw4ry0kHaulnBccUpfITjsOLel764bCSZl7+Wbpxw48/DRRUHL4z2IiHv/0CEsTIPdupICGSIMwpKxf1qcqPlHGJgs7ameMBOSLAtHUhrBaPqEJtrEpbRQbjbDYWkXqucgyFPVQvG5C5/qVAUfZtycup3GMcQzJfMSV55CtamFQCxGt4n9SeGreYojh5v6FMU4y7h5KpckCisR5OoaAqDaqC6dcF5H4occpRZMVJvGhipRAicRMF4y3YBw3B1y/jaGVDYCIObmHxYsU8VmNXK91fV0E1T9mOwiTXuhbiwHoE5GrDmI4l41WNGyWN6kS5cnbgV5dEX8B6Iae/8UfyW2snXQgKdlHkABy7PLtduEo5RWsi1KGmNnADuxKsNfdfMkcMeywxIyIrAhrEGgjdlBUb7QhsVsfF3Gt3VcilAt9SycW8d5dyIZWyNo5aLsRVy4UU/X8CxVzMaCvVXCSuUDnk9pVc9pVcYF/JZYeVXOpp48lUmhmU+iB34i1zkMaebFNjtOeF3zAjvNW2784F72PO6xdF5ETCkSKj3XzXHTPkHw3lHSdkhuYACluKEOoZM9UVFbRWPJBHtI9XkIhPhE//2B4P7KB/VI9vPI9fJI9HDM/Wo3e8svD1ColpidXZKJ95u4muNSZnjXgWoz1oN0PpRvU9UXj2lkuDOOMeO4uUOCepLcO7n397y/zvIp/7BpncW4vJ2LO3b5i3XcuU9qAjR8b2Nk7claV9wzpr9h21dmb2Tg1516HTnsSyX96/zox//rn+PLP8fYL5/dbM7LeT1JSfUFLKzlOlKxHlfil+nKXYN0LIYzH2jhJqSYDmnVLSGinkBOyRTPJT3Uqtrzvym2+Q2bwzjKt3TvN267xPHvNtZDBvxcIra/kG+crdKTjWzFS+Tj5ya/86R/lGmcg3M++n3lLaNkrhQrUcbmuu8b1QthfK9kLZfinuhbK9UObz2idj9ya5utV54grtWCNLt8nFbQXZPz93OQu3FWT/zNyV/NtWmH1ycjcQcCcR6MzG7cy57SkNuXhjd4bt3eTW9syq3e6F2pFJezc5tFssSbvIm71+xuyW+N3+ubK7rVBd+bF3kpIAdpSWALaUmgD8zDCtGa53kaYANkpV0ALUO591LV1B2zz0zWTtQfDu7NWbpC9oGUyfjNXlFAYtID1zVVvSGLQRvSNLdd8s1B5z0iPz9CZpDdp2RO9s0x7iJ/TJM11Ob9Ca+9Qrw3QzxUELTJ/c0pY0By0QN8oq7Wd+97n4deSQ3iT1QQd3XSv9QRtzXTdjdOfu67iHeAqZduO+Z07orWeDbhm029+gO/ezk1Q++Z63nOl575e198va+2WtfzTs/bI+hl/WGnkS7Ii0ZkN25kFeJ1GPjncmJshH2yPVV5htx+PXtQvtO6+qww2KxyW+XsQF9HBlEhFdxwOrJsKlh/fRwHfq3qsuUs6Irlxbg6Ycp4DfB1EW2iEWnt5SmJU8PUEpsaoTPimlf291vwyQ++izdpMtSlOWr0kSmyTfcsPKiZg1d+w/wzwI5fAntHlMDKmi+zOxCwhn+bZ4blKuurwvShtNKJRWJJ6VPTkOAPNgPD74p5vGX6fdGuX//PrGMOMTrkJWMCskURQuxQkQSrNSumhGQ5mfTEalHVVrXHwdJwA6ExP+lrmFhw6Dqq85deQjyFTTQBS45YkKitBoyeCNsW+oxxGthm6plcYsWxjnC+32kusIjdFdp9YYq2xmCqoTpD5lpIpGIahVPnlarHzty44CmhDMSpPivr7PyFLsHgS/ZCjmytmFMpxqnWGJEnKvFs7KgSO5svkxjMfwLX0UOh6VGZtiBiQW56I5N8ukyqM9XRebT8363Xv3dsnPo/Iu6Wd2bdMclOLmy4/Lsevl55mt85FgPb4ahw0yNk5k8gHDZ9ZL33g1yVM2SgVNW9rGCYjlPLBs4n3axn3axn3axvpvn7bxg6VtdIaJVfNN+CQtNG1rrFCeABCY810DhnciyU0T1RyIvuw6L/FtCf9aUhjOEWsPfXuqfBdEO8O/tepVx6NLX6oDmJPZfCQrLQHLFguUNq/VpeQU5kBQg1IBVk99g+EsGVB80y76zUnXXNSSk2x5TkyCmdYxmdQxQJQblXaVUs+sedxc3jMubZo9jYt5685CY1rU8uTAdnNI6huDzhpigIz3qSP3qSP3qSP3qSP3qSP3qSN9RL7/Pakjf+O5I5dSJFOu9lvKHVnLKqhnwiPnmsIl189unFHQN9eb7rfiV125+nzAXG9KRNai3y6SvakOVB4HW7K38nt7srfKknEne1sGtlxv5Y8/fq638mAtud7MglTvTa8mycgAAAAAADEI5I4uDNEW8PIc0kFbkuOrtRHPKp0NimMlEp3kIBlE5AFDiJOIrkSIzQGwZSBNQ/vcc/vcc/vcc2vlnkuy+4iwuXOSrtT7wuAoxpfvTU51lInYj3Lx611c9yVXWXvGQMZ4LDMBTcLyzm27LcWy9XFXaqHJWYGjHlQXrjWQUOBu8PW7kK6Z1M/wGtTM6+fiv0qArJVbLalMqp/ZyuH29QryC6J12nq7rbwd9t1PyEzY00Bo96eqBQ3k1yA9U0aVmcUhTqUjRFNzUJrisbcNj5VEq2m7Ha8shU1hjhSmJf8CZSHROclOC8inESILWzl8LS9I/tsr3ZX18LZifXI1KStHlAJtioXupHfiDBlEIQXZzljXyVR1k8qzigKChOAAV+QAmSxXRrCKhxaQYOJDVXtdqVghV5IdBG8DpGQ9KWsffkOtec4AANRFjzF1ydXiUhbMQamoBRuRd+HxAsVkihkfF7m8fnz509gK8muaAn6PlF6eKCrnp68ktlJXSkLk8HQC+oEzAEgP+FEOlKMHDFQPNMNS6DuGocywXaD430K2/x+708YztTCHoslQIZYLWGXf5hyajmwzekBnFjQsTuPnQFMx9piWAMTafToPAGwg/OPLnxzYVukEJA7xe3ipTADS2yR8LrxKBPRVzNF7uaHmlOFYhkTbsaUwR0usdDuPOIrySPRHtFIaDzVdyldExFhVRVcr1NvLs8tjhZVYQrPYhAdNSYyUS0w1CIllEhpwCsEcxTO7kVeOFMM0E4LPuHeynbqM2SJr1hnDR5LYvIYV+yTseXpRWs+tw2rLM0CXOF0S/Hj4SNMHEs9GYiGO1Epgh/IWffhE/metUciLq99QZNMPMR7RDzt8um5aH99T6emNluzqX8qQSRlPp2+UBfe0exijULFcFK92vmwFIaXZIViNtM5thOJQ/JsRxsXz3pTLiMcmfTs5+zCLOSO9d6SPaHUjp9hXulKtq0FFapFo6U7siP/LYpTczCmPkcWUICKdkQ51HoM6JUzw5JREEdZpM8hUB3MiCCJ5T9BqubG3aZxGJFh1pEcVTYrxqEHkl5v8+qJgSd0jSmfYkuI1DvN6HT2kxcQ7v4QGfjKVeueV1QSb36HKikg7N2AQY3XBvMcQEqb2HA4BBSllpYot1QoR/iMDE+R1Q37FxwPf0C7ROs+0q1wRFRhg8k0MiyziIrtDWxDbF0cv4X7FMSsBNXIOK4VhSZh699pvJ8Xv1nwlPwpyxYqSEsSiDXAJUFGtSDuSu/1fGQVSKkkq5xXx/CKtl7207ClfXR0HXaDTFius4TAgDD6H7yRZxnASryChj6rA5kt5yylRrSWq/ujllxoIEKYEexfN2jw+qpvXfdWG+sL3Wkpb2ix9N4yl2IjbOVlkEgqN048qMMBpQiM6W7XSymU97nbtrVGld1Yl9bmH0VS2E2JEWXVQrbRQ0nA52GaN/Guwn47cnGBzXvy1lpATRTOaEj5f5E8xgzSLGdCpAybArzilziwcnaEBvy5p9D1NH3DqF/47udSty9QuqufyubCg61BerLIzuFelUizD5JKth77PClKhylPqkZar+gGIvE+Vs6nQ79Bp3Rhj6ZmJZCDSm0m1BcLKzltAYy3hSpFHdeIBFbDIXfB0g5RI9YHW83Q0R+tK6dDN1bq2jYpnb4/KbuSYv0Cq1wbWpXqDLZSs0FORk62fs6GdEQKM8hGuk/KhLSxYSYfdKbdkM28OKc8VO6oSEJjotU24JepzwF7RsDhcKynDTEZYjZlMHOaAmPfpzsbUhbRqI7WZODzLxIrQSahIPJuofDLqscpL03IUWI8DUw4zVVcU1RVwmr8QA2QtEEE0FnYZzYAZ4oRNVaIXQ3HA7815U1LHtQK9X5XUVgdwn3EgXJoOgzmlDGtjkOpzSWiE2pg+ACin+BQWNM0l4hJaSu4sQBIGC8p4K8R8XnL/1FgV9pR/zKR+nXHhIC06fMRkNufsoBWkNMUJmVbkQSyhs8CYy/SvBnWWLwLDDIQ6uZ0Cz8zuMSbUg5yR1FeWderaUReWw+cHeaJkJMd9vwLCZeYcaTZOaTZT1MGRB8J0WloEKrovFPHeMFTEHBoNsuhKq75jGuJWoHMk9bfMpE4qZdULaJpilgj/qngGScECbnG6+HP7JtBdP2PPiyUgvOTNCjBsQ6yqYuWMWzM9OY9W53bWi0zqjYvFosYYwvfyLQ6vqiNr7UJbXlSeMhSGOIQEpyO1NClMSRxaxtUBUlOqY3d1c0bdsjqcruYNycSksT0RkIqVz3G6OADEGA1IR2IS88tnvrqS1KyMOwH4DxkAVJ0eV5kgj4GfKAjwS4bTFQgVHSBgmJf9mDv2fM1eQBgEiKkjY+z1Yb8Rg8lfeV4wJd/vaoOvgwHCAEmvs7Z7j2MSdFkkmlZ48VgHi3ly5+oPpRhOLs7aOURvfuGxGmxD6QETFCFzKCa/ktY+Sl+oA0C9IIokp4r7x0VCNQlYiZCsI/GiFaIEqH2zenzbf8HmPfb7oDY9D0XyTzVPJvlnT6DqpMqnp6geMe4JqPOO4vp159/0IAd1Z+XsCRYaWTxzJqhXh8rY2RtsNcPnJD6AC8rFf4pkn71hnlHMLiiXED7YhCkybDRdCoRkDbG6WKqK+L1xKfCxplicxEBTTee1NoaCrVE02T9jGo9kgsZxb5jONJBA08psbhFdhaq0O/UGqiApd9pIuDZDKG8Gyh1UpHolQW+gC5zOMCQypXPPj3ufaRuu9Xblh/vXpZCx/0aCY/X8wiylQd8hOdU926KDFKley+KR/mToVw9kSzPdlAQV2pJBwQIlQKfw33lu9f/pIx8gkgrrk4wwiHAZivGpKXXYA7DAqpHgGcXmIi0wrsu0fXjKo3BeEsuxcBQaPuDV8KDOv3rAHE7i4YH2QKrxqlwidHpLOUBKKMOmaL2OwNx7X/T8oHBqWeN2VnxcMuwqlUXxauB/VFRuiyW5D54ZDQiaiRnhz//sCVWEbQFN1Xmjrk4qD/XQ+Mg9ZQWuw8HW+X3vvd+Ppxlj5Xd4tcb83WoalMsA01FEA6nzfma0DM89x6oibqsgYk5GOZxC99CpsK2xbBPRUV0hmln5aWtta/5AG0VKKEsfReXyiKSRMfadOQAaG5WvYlWSERgFl0RdUcD/RC3Nb0kLiLTT5Up1ZgxmnjDFd3RaumNJz5pQnix6vKbyQql7T+DasdMUARtsfY/0kWJGvQbQg3MqZVlPJZ76qKSsU4uhsr4rOrmBx1FeVSsemC2RCncYOBqJsjydYHxcRPo4i/SdqlF9GJ3tFSkHW5hNH3brMkBsaNrS9y7DHauiQs7TjJWpdbDa+KAzSiyU61LJ/CK8lkwhoVBZ0ey5EYrffWF3C4HGxg3elNFyYt4KtDEqjXmAEcParcdgTxdYZV1rhaivfmJY2MwAPMPj2RjCDOvIChpq7qvi754fdJpJdMJvYcujqfyPICxPBUtU7vyZTJyiCkh0qfYEAtK5i3CmSfi9ztaSYjltxp8utzkddAKN1BV9KgGyKueQWKJgXt9Yoq9WoDJolMkz4UAZ+mRmXJwuWH53V9ZTsl3D0FkR04Z1JbaQwTNxVEdiqVCGc0456GBQDTlBac+W2JjilKNXLgY8Y8+fd8KUJYz8xCV36pHyr0NMsoomg67jpi64eIgkXUedFljgL6Wz9K8bCyNqV+ZiSDHlxE/o8tdx9zRB7cb8tIbpyX+EsLbJyd/c5LVWzM/T1NTJjYpfj1tzr+uZt3mpj1ah27SUm4v8YbaYldY0Fa1jJuppIvIzD/U2jW1oGlpLH7eOSWgn5qAdmII8zEBrm3XWInZ/c85uTDm7MON4mHBcFWPbQG7bfONjuultgoAOs826Zpg1TDBrrct1TA79zS79TC69zS1rqpR9x9zbxLKJeaX3LO7IrLITk8oOzSnephRlIPHWLHaZUXLjiCfETU0ovdZ6j8Z9zCY7MZls31yybVOJN0/utYf9eVEv08iuzCLbN4n0MIeUDR0+jqFeppBCozDwZTMtZhCbgcNr9Tu0Dk7jxsBPNHGaP3obNrxXtb+W3NeYsTXVdycglL2/zjMK+oSsnJQ+yN3NjTt+rlGxJ6wq/3SVXhmTMyqiXICREEOA0iLFCtukQGZERDa348E2hZeO8kb5ZHubWTxLHQHAp1g9BwDWq6FjXV9PX8sZqyXTX6D3ZJEtSjWM9JLr2Jj5QtWbvm9KiUos2eECxWiGR7rrUQ5+lK9Va16RXrva5B3cL9nfzpK91nNWX7Qk3mjRpnnMyGQKeR+EAV0QLpOq0bScbasj6IubhAcMOAW9zcg0j0TD70XYKOHRqhSI2AqyKBeurjWybMfCZEfNE09J6aG89VqBforbsrNJlK0Xas6wDvJ1BJqjFIPOZuMcgcrKt8TCxuFctD5Fjbt3/4LGpEWDWs1Br9oCjkXaeQY0hZAw9e9SZCt+n9CUO/oDACkQBChdM5kDVIr0Hg+2wVD7ViW8KGFQ0Z/rSTZpe0S9tracIUoc1rK7mFEoy03a1C8EfBNs1pIi0GNNJymhopTtaYQYa4tjrxXGqX1VCWwu33FbfSJKI5tMIcJTrq61BxDTHDMIRCdAmAYbrp0sQm6dM5zYEz82BvnvefNiB0sQKhUO4kBuTm8mrixH5qcuPEpE1aG9MyomcHKpCwdJnwNLluIeQ9PZAATiX6PAd/de178qGz2MNJYzrRZpRoIpcTMtiQGXSRRjnSOwYAg75V9pr6tOn3sO3LtvdI17Tn4u7W82/5vExP3NZn+z2d9s9jeb39TNhtMIp4i3eSRVVe9F+4NNctqU+h2sZZ5oZK0QsqSQOUsoAmG6MoCSSHSnrRpsFK9A0J7rnCmlzB48lR6gf8lNhwequPdfIWPtnCQ3L0r9vDHe/cX866/jlrQ9PnxS4dHDm/NcfmBq5OZXNjFsBQs4VRh3KdKVIl4ZouQHyiG1BIppZ1rPvQnmzCuslBguqPbqxgdwJfODFE883LQuqPL+xmMPvXznudDpY1Uh9XeFV5WiStmrqrQPuohSeFK10vwBr3J/mA6Q2t9LX7zyZWn8SJSTyp9NAejFvU5H2wFVocVpCTMzkbqyvkRxGxPh5/JVmY1Lt5NXxXGr03ZZ2NG1Q1fNSavkmXX+S4aicQfEs9IRpz4wIBoeBo8kCgOUdi16mdVUsilgVN/QJJcMUJyzwmLlsO6cJSqfPAlE0SsQfGNG09VWZrLYBjdYlKbrlbKo/m0jwx5OifRl7ppUUxSywAaeqUu82RN0avhjzlK6uFlJ1qik0CcqOOV5cSoVPKJrsbxaGZHoQKd/loVKMT8wCan0JlSQPXiB7FYsGbzEKTwLqYQooyeej+E/cUrlUo7xTDnqqy3d6ZquNF9cVwlBDF7AMwkUyGKBQ4I4jlbPjbVQBXW0D95HU1Beet13AjmUHuvtnWhfZekSRH3p+FnLOTXc2uIheFBwmZKvg2LY3svu72LtIkixLFan9+IWdq23+OmyYHfWVLe8cqYCt5fvrrMCmUcRRdXyS1ocF0vLWiS4KNlTrcJUyU2c4FSl0M9TUusLcQPk/QoyhtNxCctCygOUYrE3Mi5U6X3KDjvKPEGva3frddvvmu1xvf7ErtVrXKc7S0CZhWampci825F4Xt2cjeutWacytb/iD0JAUeIVkZdEFYhnysI56rBUq6XJIs718ouiHAGbU36gegt132y8Zv7iU6E78E2DLRtb6Vcte+amnFSoi9OQ5eGTpYJ+sv5iCjQuNi0LUCTeH4b00VHOOimuutI3Ulju5D+KNyasQxfpbKGWfde4nYtG9Wqx5VdlGvuxTlfNfmsnI10VwlXBv7NEXqOMu62Ee403m/rrpQzOhwpQXkW6meK3wsgPIKFJppa5cUDKKwuP97XyPplaeX4F8nqXwjM1rY8HXqqk9qK5pwZa4SBtCuKrKnmuYuZ0qtapK5N30noGiyr0Vym9d1a3t9S3VzeXR1nn+R6HZuEr9O2Snk8x+045UOB6m6KYEVMIuhfCFTSB54B0PUGgMa4Wq67/pPJWCro7HGRLUf7ayL4VlfhHzUr8SskmFnR1AQnyuUaWE2O8Ds7uwv3O0v0I7lOCp0XVWw1dKQ5y+8DArTWdIhJl6Vo0lq+7sT3VJ0SxNWt7TZ8D8kbVUmgTAAAsEjthcI9VNmwmyy5znQSg1KO+0zpg6vopT68lgBuOUo7Dp4B50J8sbtEAYGRWl/Wdmn7nMTPoIc65bnCyAH37HUy0cJ3x8vNaZXjFdnvUrbLXx2gUXaiXishxMIUXWKXygrvSirXgguNs6aw3ViepdQ503dMzxTac8tS7SjMlpoVan2RYjhChsmSWokpcgOssQhmnb1XrVvpOpnBSNLVWEFI139QhLxpoJJraS9GnEPsCmbhDBCAIJik4ywLFQlRgHCds4OsgpmuhtqJ/ptoYZY4mt3bbwvCIlMJVoww0LcuUzYM9QnEOxHcZN0Vll7gskmIoPNU3KseBMC2SiOjiV6bSqVjRsVWZocki7y1mJ5hxm/w0rFnzo1X1oMAcDzzZd43apVQNfWsPhjjBcSh2tV6B3aUIzxqflAhrNsvjHAupQirH5WU5xY4r4hRQjr2kn1newGlO63ykrrQj7lVsxKy38h7rErEaAlbRPFdUGolLL55HxCRgMFfkwXryk4hKE+KOU1XSOoEtwlXV21S1U4LKXMhZ0JSzpkoVgtOUpkCDIEtZ/zKgLsHJITZVbjLyobmrKjRcl4RWLORHnUgItpBPr/ykyRG2UgnT8rgpm4ykfbf8Z1VyH9UOs4EVtm5zDMuj4i/Nckaqcq56BQDAcLrEYWXhCVlQrCf1rOCtomR2wnEoS9EeD/LqwjBUcZJJlKUo0n/mdxJ2DD/+NFBQcfjOYCMeDkaj0aBUxRxQQvB7jmPZxBRsXR7dY46OBqqv04xxujBuhzKhkuxmYJR3EtM4przskFFoMEYzHI/F7fs+I1GIU9lDTrAX45fjfxPY60qIYvMzjhbJsdyjA114WMnLStQx5TrH8tmYJjjG9wLxgZhL0f0spVlyDI33CliFkiVJ7qpQHokw1e/s718TxivEt2A2AABgc5ry0swBjCBYJuodiaVZo/m16F/KfOkSv40fYvoYfy2LkBzDFEUMDwBYQMXqy+sThwMo6fJUZ2LQJ1eTd58Jd4MFOh5YdmJjaMV9CVDNyJDmzts0remzcvmzWp7KdvTa6+dXnbsE1qqNjhKtHLtY+ZQvUF4gJxeRm5yjUkEcbgRJU1YqYL/EKZecZxaTX3PIeW12mQineu7JPGYxioyFGsWhzOKWYtEHZHEJmknysePiyDndnw46uGW9MLmlILkqYMGVEl/gX5DXxAZfn9/c5pPddJfPy+/rz1hBeEEoEssaW9KknNKFhIjjUCbkk39Y3MpZdr9QBdC0S54wPp7mNVq1IDCGSVyoAT5WKXUr4cuMsvXQMvzLfnAmOMi3RH1/ioHK9V43PVgCXjkt1UWst69u4jYZ2s+m2b9aqxUJAADYtKyxayTQVc54k1LGLgeODcoYC8dBd03mrZYw3lH54rVLF4sSxVaIHmWLu/LRtls7PEoVf+ya3t4livuVJ2675rUZAttLEju1d45SxLspQ+ziNa2Kg/bSwxuVHXaXF269enWUFd5NSeHWcsL972udJYQ3Lx9clApuYLxm6eCOMsE+Jrz+5YFdJjpwcpK25dxVEniDcsCtnA3aSgFvYGJwlv9dw15gL/m7cblfV1RhV6nftmlEvgdTv/K+7WV82xCC7ZXvXbN0b16etyUIslfZ3lppXidYv5K9lrK8Tojd5XrdJXlbRu8u1dtejtcJsrtMb59SvF21paslev3L8LZSxKRK71WC1wmxVJp3B+V3t1x6t2/w0rZL7q5TbrezoK5fLFKvQro7KaK7QQHdfdby6m+ftdxzR3vM9j5r+foRdS3k3Wct32ct9/zts5bvs5bvs5b7tt5nLd9nLd9nLd98re+zlu+zlu+zlu+zlv8vyVruU3p1p2VXNy656l9u1S/FgM9U9Cmx2lledePI/G2VVN1FOdX1Sqm6Q3ZgvTKqHkWte5RQtZZJdQLuLJ/qLpHqXjPu0qnt5VGdEN1lU7tKozpBukqmdpZFdc+RR7nUPsr8LZVJXatEqjy/WoH2Ko9aetkC1Ls0ar+q7T0qtVcLnw46ZJy2kqjOoqcDn+N9Q7tJD4PCGsYEIHFnBV1/Q4LfiGAtA0Jf40Gutu2EDB6GA99boPc1pI8poKTi97uGdpgBGup9L6ibmQD6qv97qP791f79yAjV9Hq70hH1VfX7qvn75K0rfk4Vf1Nt7w1zO+r93oTtp9b3Vun3tHtBlzq/pMPyX5ZuVX69L2+YDjW+XTXvDdWmwreq5b0hbqS+76m6773m+qqv+6nr/VX1vdT0a6gtfcbXSzW/rlq+1wz1VcdrRfugGw1PVXxTyT7wY8ntavi6gt1P/dSmgrcq1z3A2tXvG4lU3qvTs6GvMr2/It3Ds6CHEt2oyDsgbkOB7sUVvXeaH4fwVpjvQlneU1HuczUH6/W8TUmubsODrg3lqyAv34i7hu+rHC/fiTtg+inGm2pvf7tKL6W412r108D6KMJ3mfS0u1znJqU6XSVsNinT2XXZay9i008U6KwE4l8FxLMCyCdY/WPNyh87KVTzCRWp6dyVXcVp9kvx4yzFrRWg2UXxmfUKzxSZtwftgr5v0ZlPaiu1vm4tk7mjEpmd5TG7Esa379SWkpiblMNsKXvZnguqq9ylPyPr4DublLjUoqC12y2Ut2xdg15lLTcoadlWx3AL5SxbZ6W9jOUGJSyVMN8iwq1TvrIj8tSjbOUGJSvdgeoAUHCWNcpVbsZPUm/xvqds79RJ+Jem3Evze2l+L83vl+Jemt9L892lITcpC9lR/rFFTb2Lso/rl3xsMQT0L/fY7TnRVeZx3RKPnQrafuUdawUcW+D6lHb0Kd7owXVb/UzWKthobgattg//Yo3VcoweVQ87CzXWSjG2zUPfIo0eBO92d1mrMKOWz7vquXkWZSyXXWwB6VmQ0VJysY3oHcUY+5Zb9JiTHmUW1yyxCM4yG+rXv7yixwkMfUorlosntkD0LKvYLJzYAtOnpKKlaGILxI3KKfqVUvSRfTtKKO6ofOIuSieuXTaxc/d5iWI2q6F/Vu7OwluNRMW6tABR9SHmKA5lDEVRYOCU2bI6twss1togDvr0zy2+zxj+ETOG52n/nBnDy3dvGZVxKj4sZuRvN5cXV4jPj2GsiD02RdfGnHIUDRxyfnF5DAl7AOlaIUbKsoXSfyEQBGGY5yKMUYvoeg8ashrTSVQNbWgsz1ZMhZ67G1GFo7le6uS20aqCtTy4NOIVBN+yfrjJTWfDaSIGX+TCNTU/8oIBfN4oeaMwuCkn8G/HwSzHcWMp2TA6mdVL/1xXshvqGZrhSt+hKqSwXkb6cgfWlPS6QVtO+gqR7DnpU3dO+jTHYI2k9Cy7LxTH8N//s3meej3eaqJ6WwJrCJpySCDZUr1qyz5P/T5P/T5PvfxtJ099c7vK5Kim4ov4t9J+Ojmp+lmqK7lEN2fy9g0Tt4vU45ZC2GsnbW/Jir5GwvZ6cvYGzP7J2muJ2RsQ+yVq70rK3hax7r6/uard1s2u5Yu+rnVULmttLdrqW/6sJQV4lVudKqGW5ZbQZhJwIZXp8kuEaTxsVfyQUNWzY6DxP+h0+o/ZrySR/ze6+Ef06+f/iH79+/0/fo3wU98x6KOdhD6Z1idnQBhkMfkly31eOAWipLRVWRzz7V9p1idXHtmVJ1eVItssYER/DpMrk9RVKzvsWAAEmeSkSqk8uZTeORFFodKryQu02SmlOtmVGuPWfM2q6GbO9H1H35KxvUKA/yzabZKv3Zmr3f+C2+eabhh/1z09WG5U4NrVvanYbEpLlghnQJmKKS4Xjx6Zt+UF0KsSQP2S1bhSeaRNb78meut2BNdZC2dzMRzmN8Oh1DowRu4jR1mS6oVRnT36cw1V6T4Vm9S6VDqtM00nPQKaEMxKk3QAM7JU6fR+yVDMlR8TZYpr8XxYruqIAlM1UpTk/jsMGMZjgG/po9CaHgDhEFLMgMRBlIVYDcVR5r+YaCNl9ZuxtkIbZQ1E+Xnpvt+pzusuit2o1lhtDtIIll9KVG1vU0lVXvO1ZMMcUoCrXGNrqUYnxdqrTzZWuWhuJHSbMWA3SDrqSNaC712FI10FupUF2FWJ24lMgmUikxt92DUYnZ97SpMlG4C24hbmZC0XuLCWtcjrJ5RsS85juaNUvWI812K54jhwhddUl3v9m5qviI1JDtyRjQ3WKSfWsEkzLh2TXGGULqA+7NPBKh0g/Riok1k6oEoOOuGAIkYN32SW0zBXzQ3WMwF1uTO2FRBpLJA+lUSqPrq1LVXEn5pcIHrT5XPO7EvH5I1aYQ5oiYjkNaqAE2EOccGJZHcR9KYkp8qiEwbcVNoJyXSKi6rozF+WcnIgtmc9e9azZz1bYT0tPCffZnkYPUlbZ8Xo0mkMp++uN7DzVmspOy+S7yrNVCWusFbrXFwoddFyjwskKqr9tzK+yRROiqbWmn1K06HVyoQZJEJLVCyngq3I3SbDmGnGpdfeAsVCG8U4TtjAN4xGV2VvRf9MtamXqlfBLhgekXL80SiDLvmtDa9NXhahOAfizcMbOgKwVQDPWKl+faEPqJYBB56S2QyLEYmbRHMvyPWghiwZjDYSmnGbU5M1q4G11vdTYDpvx6e6uxq1SwnM+l7Kw0aV/04kzhqflAhrNsvjHEv9ltAbBHMShSmOXVffov6qpJ9Z3sBpTut8pKkjN2N7MFj71cx61SyaG2rL+2Vp8TwiJgGDucW1xMC4LnGdF7nOCXRc6BpjeqPaKTuW6343VZk2VUV+GgRZyvrHKyHmUaXyWjYDUlGWq2+1ZtqgIajdmyryo04kBFvIpze381c5wlYKTe6dd35Tzjs78doZtPBzYy+rNlV4nVYf7sTHZY5RxOdV8+HHcnHZnW+L26mlzZulzY3F7cXyqbiv9PJb0f4qg4KX7/1W9n4re7+VD+C30u2w0sc7xWW9dERwdsVttkZrVkekO66rvowcX/ZSKVwhGh1qB5bBJxoN2isGNKAxI4zjOFjZA/irBKy3BsLyuNDCBF8rBd4goMzs/vP114cv4Q9w9DPMFQ+8xyZ6Xwf8FeGGYr0JN5+yL8K4AfcVYlq7IEsIS/XEFy/+pZRHwNmV7Gbs7XOjV8x1V/oDmzZiWxkQvLMfjEu5OGgKLEBRmY7egya/xB6+KTojxb9nKFKZN4QMMS7Hs+mCAa8Qwz19cyyKMnA46BSuDhUrbJoxtf9FmxZVd5s+RApMuhv24bK3fFfuts7CzCpRq0LvmgDZtRqSCsCpvGNDq+29pTq85VXaaz+0bgQXNzECYVNXpBIT69ZSR8oIlTkXfXKCuJd9P7+syZU5QSqZWYSsTQLczxfsiqbttfZVF6pvEK0FRM0Cb0+vIKEpZ/DZyz++6N8xivp1LSIrheeA+jfUYljpFCZXxwKfxsQ9c+H8/IO4Zu3aJ2vnUs2arp3/NPKL0fq0Ci5alXBqmsuJLS7EMgKxRKIEp0rQ4Q7pDwiDe5nnSvk7hoUxpOjDxIBqm8nTa9lUG8Gfjgde6RKcPn15N7lBq+r2YlSGA0cyIauHb6fFFjF+ldJ7p6raoqzWvk+SKd8rShXz1m5VbNNMd56e3S5crQhX0Cw5EGFdRYnGRtnuDNMGFEtH2h0OskXDXhvZt0KtPvJ3mxLkc43M6U3lhbNbC19D+a30rz7Qtvg8/BeBVHgdwAIFcxJr5sY4iuXQHEgrdJSAVJiESlr9fLKfatNJ1zBlrQgilQ/y0BpWNvhQdaWDzassuG0Dql+dv6xFaLvx0X6xU0PXp6H4EDMgJj+0/LsgkLtOhT6K1kFWvvZA1cICb1eJth1JhWRRZsrWeCxa90ew3VHByQjUOT7oLV03Q8j/F3uk1kyernEIiHomnFZPU24vXrmvwB/dubUHl36c23MGFmIK0V5xcg/7e7/2cYqre8NBilkWqUz0QS+n2/1F/7d00VenRXPMPqJsNSRGGmub3i8O1Ve7jBrMcfAgNfw4nFze4F88TpXSJWpy2dS+5ReCUDmZOhPpEfawzvyTeBqJWrDXGPn4QF7qFK4q0mlyeKmNG2o1cGrQ3gSVm1Uc9ECFreJgR5h8nxLuIxmYxLZSUbxtsixo6IPDGxri8pbVPZpFLTXf30p79uoAzrDDVc4Dn18ymmYLD4ye/rtsWcog9zjHSNxKJEOAx5TL8FnDOdtoBCoDBuNAU8AyQc8Yhi+GSsg9VgDzuFzR8BksxBTCPQaczMkCp5bIHPULEMPPpYVT3ptTHFDG0yzgCqy6c0m8NQELXuisionUYhjJ8kZiCuHI4KqjhhVwwvRwgMYG7NP1rjby24nPLlYxpPoLE0tKjA9Euk73WeJ5zZVCEyMmpNogYTGKbN2nt8Gera3KHLG1geBTrQ0k+7C2WNjr1I/05rK+yifY+lbRf3Npf+8tvPcWdmC59xbeewvvvYX33sJ7b+Fdewvvylm45NF7j4KHLGE2X17tRVp6UyEdipI5OnJ4apb8TCs+o7o7m2OoelV3TX1VPLX6mN4/JJU/WOmvUl+lBz29lBMcjJUq6wLVvG8r6vNqOWj5pqhuqHqGBKeCh/km5BNdqy8bXavHh6bcjQ2HV9Um8sMeTtJu/2ZLL1XtcwX8h1jEIq4xwhyHH3g11/u1Letam/r6PjWvPRZ6UF/KOez9mvbpN0nxUkRhNnoWgod4MXCIL8AyGZk7zSIz7Fq8caX3D7DiU8ykvvJDrXTTn22F63f1lX1demxd0SnjlT9KnLrSXfnJGgtdf+lYbrYpV29kM73WCQPQcHqs8423mO6y7x7rw7/1LLUw8OZqTtjh0izcM5xEdLXAMbcu1SQYlerK57WM89U50BWu9ezZP4PSWhZ+7AmNccxHHo3zZf9yfDR+ke8QVil8Zyk77wLdCxOjHDwGudt4ijieae8sReFrrHwJBwAcL5IoF7Pr/vNRBbs2/HrSqp1eUPPQ1z6EJ4Es23YhYeqvRwu0QnXYRdHfsmDehgsAWaAZvsqiSKUJFzqdC8qvGlZ92S7v/rACboQW4R8/Py4GoX44XlZvMQaXy6vzi/NXN3cXJ2/Ob65OTs8rrXRa+q9TatF2y1oB19ha90m+U5syj/rKt0ArIjfn1+8mp+cnp6eXby9uJV5bR0myqOaMWvE6vbk6vbu8Or8+ub28vru6PNsNShUqVZo+yUkzubx7dXJzfnc2uQbC8kqRptIRhnvEMIQklTt8Je+/epEAjWFOGYcE8fm4Bv/7OU4xXCY4Pn91AwGK1YUsv1rClESY5YU+VDcCUO5kd7hE6WHB19yzWxqCjYTHMCyDGroJcXqjpuPy9d3N1cm1AilxL9fQlNlQpY1ejl7gXAPJaf6BIBdDSxP7xOZIjF1sZBxzuMf8EWslqintzWrAjF8jJJRGkNBQpy45v3gHhKlkHwY3YxLSyV8t2UKe5FXhE5SKicVivbL67JVzp8pJkT0QVpmUQwWja26sVPWYKA1+2A3/anL35ps3t3eTNyffnDsgF4xNMDRBy5Gq31XhbsMeg+nXWxt8Rwfn/3F1eX17fu3V02Jkati29XR9fvPDxend5OL2/PrdyWsHzM9eDDcSU5ZrSSnLHkLKcmcyyrKXiLL8wBLKsqeAsvx05JPlVsWTZX/pZH/muc+825Prb85v1z3upOOS88wLBAnCbJEUR5mJ6aHh7g+eYmjbOnP2Yu2iH+39T0rC+Iz3OyrfXb5+++a8rwCgVqC/CFDu1/R4eTERCKx3OtfP1/xovVG07jhXR0X8Wef56nlAjNgyyI9LgWzNhIMSokcpXh7DF3/64k/mQUo5DWh0LAK+9LNSxJtpWj2DrecCU+n0TqZSY7c6hgsa402EEXNeqWlH4YLIDiT9sJd4ghKT+6P0+SO+n1P60Elfv88cMksveQJApKch8UwZh/N0M52CT8f4+skpncTagGAfT3hx42NRnIgB6GYO0cRX6EHpjNU1LCNx4YvojFPGQ5ym9dfLr17WHr38678e/aa0NidnbyY3N5PLi7vvz199e3n5nfNkKzisY38PPTv4+mTy+u31+d3V5evJ6Q+Orr5GJFKM+4m6gi9QTKaYcQglB1KmeiP+iWha7UqkXYfytc4OBk+kBxQgxmhAZD6c02sG/wrXr05OIc2kmFjtI/fCXOJUxuEPnoD0gpSAimS1EYmz9zCN0JJmqaz9MHiiH4aE8ZSy8eDJ4Al8TVNgWSJ4ucpFqkDEmMPljfbL5HOklBPoni6xwProOXyDVaKVPNum0mnovC1SxC1JvDRV5SxQuAARPJyuVPwCkt3oPTwePIGXz+FUOBJqXb2Co7IhCF+QlYlEUp9jzSkCRkZhSpY4LTZwuWXAiMxNoGTNJ/DZc3gj+IH2W9NYykUTz3SnJJa6GOMLJmFKmq3xK38EbT/3R2IhXYghoDiEMzXW0+sz1vrRGj15/wY7scZ1J0wjYiJF4AcbB4yMtduI7rJurrO0sKbrmgjSivTJNSNd0Vlh2juNMsal0OCZJsvmVhcwoibR7fD5Wu8sMfNqcTNIs1imPlDRChCbBVFPbjsetEZtuB39VEcdMR7ielZgZapvE1ZkbdIxO2OHy6zVMUkMZnLm0bVoWKR90kio1E90CkuCH3v1y2kijtLVd3jVFdxipkSU3zZs05Q1MZg0+3YWzG9Bqt2D2DxtGqB/i1kWG6kQEecomC9k2i9nUkR3Or6qJ1ofg3yje3euvaKRPe/fSfW91VbvhCbfLpGSMrZ90rQ0N9m0zckC/U+XDc6VwQ5XlyKz8S8JIsQYZmPzd8cp4mpmOUp08hH9wanop5YB0tKikQnSim1tLVra2M6oJ7Xq6wXXIjEUGTngaHz0xR9ke5Pmbnk0OvpiHNKAjYt2YhLEo0OO2AM7VLn1y5k9UEIOVUbHUZ5SsP5gFOZTxQ5Fnx1ZK9dJR2khc6mEYYJStMBcnlIy31Y5il/P8sAsaVWpHTKmgqxiLaRIcVnNQrFC4MT0EjjXgvgZhfP9SreXPRPO5JICEgOqfZ8noLSALYV2K/f+eBSX+R3sE2luJZHmRnkzQxxh8Zm+7rsIXm0GoVilC0n2PGChvgS0HdmWQssUDRT0s26JPPuG7FcHlciFeE+zOKx9VCU+Mx+N4SZnK4rMch0Or7G4Nw3ltAzPZNvhuHgsQ/Zq6CJuw1WPUMARqCXzFVMVO/LNEbtSO+rdbHaxwOsBJ3ycI5Sn8mjrugLSEw1b1znBTO6+cUUpUw/9HIFCsvZQEbBzwTVk+epCk69NbIFkO8YrQONdlu9lBi2592tJQiyryj66fTLZLbOePrljiwOvTO3uRAdWYLVpKmCruXrAq5GaqAVKlGKqtqLMEVnpqgRGpu0xh6zZXFIXhhkucxiaoF9U5o/vChGlf1RLleeWX0h8OwNelFDqjnox7+ETCH35cEK24pwfQMousWinnK3adEnaGuV2UTvvriFsV1KpKyBqMlVa9b0sXpqKegYUHWA3pPFIpNsYNouPaTauNb/Oc3Yv734a8u7+aP8wR3trtnfxMl/HxZaQS7PHNeJ+1b7n7BKfS83svgmtcRuSk9sts3fK7a3j05u2AdH33tR6V7KWwfS6O/W5tGx4canemSwx3t3otHbfgOh5j5rEEK5itCBBAc0YkQa2XDQH5UPYeNxNSRSpY1iKq2IIw7PK0huqLyz2AbG3QnMq2e7YzeclC4mwwFoSYeGRTFxTkX8PIGPiqzdvb25LHnnFYMq6KWteCPu0mAPKtoXt11Ln1dRxPW01dthNTp5X1ZIZKnfglPzLms2r/8bX9ndJ8ns7UCbQQSXUUsyzNC7sQgLHbzC/irIZiYUs/+w5iPwnOouApY6ptiQ5Z8RJS3VMttJSNSnpQuXB+CidVlFBGLMz9GkwbuB4WxFlyWKRcZmpAU05TvMt6B5DW7IRg8W3UunQmSSh2rw0NEP9oWlxR8KhPPssIB3bzneJ2NmD+JXJM+5rBlR3D09ClBvbyKDeGyI0ym5Vf3pdkGBeXhclhaFiuzKRD0cPOJZf7JIaznxLyybXla5ebfugzEWsXJvqwVcqBlskgIjGM+a9Q6uoWrylLBNaal2bVauJADhtoFkaStshAETLL3Uzw1jUChpbP71AlgWkGMMiY/qsw3GA1b3GhcFTbfpQTLEJ8Z4oV3yproJ7ogqKK4dzeSmVRymg6i72k4vU4A8UAkJKya0n9hWiP8iPBZ2Mq7ngxWBKqM5RkuB4vFPeab/jWlfXydWknKBJOUPJyeK9+VTh4NfV69PJVHWTaqogSAgOcOW6DCRmHKNQPbSABMAxJynO505tU4lc6YotBJE8f7QI5z78hrYwJ6VDUYl2hDX+AFgm+B8zaZ5kPp6xcccbF+6iP778aWwF+bXMqYhETokDIIrKZT8ZuTMIU4TI4Uk51JH/EEFCQz3gRzlQwX6B6oFmGCLygI9hKL32CxT/W6zq/xlaYT5TAsBQNBnWku6WOWWBoJL1TS4yK1DxARbX2udAUzH2mJYASLCksMqGDYR/fPmTA9sqnYDEIX4PL/OcyAkNn+tdxlYxR++BMAjmlOFYekvasRX7c4lVfqlHHEUjI9s/opXKuqSmS1l+E5TyqobHCvX28uzyWGElltAsBsIgphymRPAx5bxVVQoaJq7TdlvB6uvGNONZisdP++7Wum6mRUdTZwwfRtPhNYoYeWRKe3pRWr6to7BoVgMaBzjh7JAuhSM5fjx8pOkDiWcjse5GauLZoTwuDp/I/6w1ChV64DWUyrG0y/GIftjh0/7Z1dQNw/cQenpj/CVqX9YksDKztG6JBQoVh0XxaturVNBNJjYMViPtzj1CcTgq1dnrTaiMeGzBt5OzD7N2M9J7AzrEcXuaWKeRDZqGNvPQki9y1BSJN6wZpV7XrRGBSWNZZJiXzL6wUvnUjio5M7eK+eWG5VyO0gO6VMyBkpiPSCzTNLqvJITp+1i31nZ32jMD5U7gOlRyiYVXaE2JvGvKK+qp+K40vVIHP4TZ9dWpVJrYpHyn/qAN241QzW/UwqBokGUlNI2kZwzeSuXbZFyEyxpVMeWFHHQAhJfyesvlqFGUqwIIg0xZ56RJ3HbrWCCeW6hy0QKJyjvvIUYxZVjYi5mCh+OAhqoYM4qBxPyPnzeFmctYfn2g8KGLhRBdfha2EPjDv7B/ufhZk6i6fxS+cbnP5myROMBw9G9/ejF6cTR6cQQvXhzL/8Hb29Oxo4iHxNK72J/M09m6BWULs/eU5Q3ovTLW65e6FGFjoxw0Z1UdQj1ubb45Up8uyklS9XVHZbQ1FjMxm1nMcWoQt6cNdwxG4J6vxDFcXN6eHxvspEntHkNEZzMcHljhKh2/uYHFArK+hTEsi9YscZmp9hdduU96XN7OSNV8PiJWJtZ4zeS46yisZCzTLX3bUYulaFbiB6R8DKlU8yIwqgi9H1huNibpmfFYbWf9DVbf1Ce1sH6Jzx2ndxkz7LTJ6RsQ1+L8NU7vUEM5OX83pjZN1DqMHwg/UOF0j4ThA4t1pnFO6STrw9s0w0PHKVFUIRNDITFmTRrQ+noxZ4d3lnW9eG7Ir13LNW/XkKd0Us1KMW63BCNkFyHL9lultYm2gS3mXiByJ3txrlHXmmwA7rNG/VD1x9MhirhxdK/QschdEutprPi3i57sa7Y8uOrs6k+ljvTiUupJ2QJFUSmi1HZ5zFcQmQLhFQVRaQfpNS9ao6Io5xSRqMnGO+UrxAvk3fujTfLQxdKP4YW3SNJu42qzb5E2w1arTb3rNtBNqAZI9+6cIwaYiAmT84JDoKmZUS5mi8SQpHSWYuZrPtlmkvK9z6bFZ3P3zpqtXpod7pntfpmOWL+9T6aHT6a6TmQMp09zxzfB8MwGLkU8VZQQgzo3UzyoqLOs5+gAqoYwxwk53vtu7n03976bhs4V581yXZ9gjlIUcJyK/ROw2sGvB9904VQ7vLf+WLP4Q50ty/TDnti4s/nlpuunHorSddyWujyWlDfKB3VbKtierk0cIbK48DFSOb+s+XuUTLEWkABXNjg1x3npr1Y4OCCbJ4kVuAajCF++v3k6BVmBNqdiTRcpi1eKp7+U5UsH2TvUztAMh6u5WDpW38f0g3rq+MjmbdkW0Wx+Ofcx5ofqR7ZbBi+le3TqBGSEe77JVULIKdAY5xUrm4B1apQUK6O7zXm30qnq5KDlUlNcM+F8kfCmJ4GaMNUfBxRF9FEnQCrkzLH0Cnj2nqB49gtB8fNjQKCThZWEuQZsGgMWnepOtsXIRzrBwFO/S5jLvtew1q1jjXu6rjlOaaotVfdMKtMFDeWqEMtSocrK+a3UHdjk6opwyrSEltXEOC1K6Kp0IWSJrgeGRDEyFKzGcFoAMaxR5ujKGHaWrM5FnDYGok8pmftrpaQsrbvQsnU9PlvhxJVTm9HBeR3J0gvwnS9nrc5h+7f1udUMprfDetk/wOGgZzONavdGX6WQH9w5Ynph4LhU1yZaqe7UladtMA2IK2zWNNwYHDsmBVBEYwwhxcxaglAoi81CKeihfSfLa1aq7FD0iFZMr7Wq96XFN1MBNZnsbUehXIJ2Elj8VdGSkhASyhgR0hnDQZYSvgLCmLgYPfV1t92b4Pcm+G2Y4DU3qNrhO80Ynbpkl13RuZ57G7K3YMYuS62BEfTnOEpE5S5ZOTfBKUTiPlw+P5+JquhNoCiR6WyVASdv/VyVUg1IiEuRfXhVduUlcYZtWu1HRMQSzIty5iNTJlFzH7xHLK/zLwks5s9uIE+x0gHuLfh7C/7egr+34O8t+HsL/t6C/5u34O+tytVkrtpYrNLXXNMIv9JahLK5WZSQHqOMz2lKflUnfs3m3FVuIWAk1zVxnI6MrkJHusjxjKBS90Ln6y9ViGiCLITHEUN5y1oRh5RGWAdZNkbriW6qmqKEfKNs306KKKo2ybpjekoEZbZ4RUmDqZjp4fCngVrQxgQOPw4La4O24OhGS5zeywYzzIcHMIwIk/99RDyYD39aD3ggTBBr91B1L7D1p1toHeY2RyJD1xoQq6AO9N0Vi38p86L4V9KnH4aDFPNW1G2UsXtg2DqwppNde0bW7lcn16p3XNDPikKFrirtx07QOlTcto6d7nubPfqRvhj3VvvuPcyA0jQkcZlT2bqJMHIvqnxEZoh6Hitzq9eBBQWbx5MNB+VGYx6VnGjcS65B8wIzjXtOmQ+ejbtQyYrzfUbiD5yN23oi16pV+ZxV9YPackzbxZEdnJsyUl8AXOfcNIz6AIb5GPqybb/z+ACGunKRN7Mo1rRtcyUf6Ahv5Vr+fbVzqbbx9BYYBOlUnY9PXnjYzYmzFhEblRJ2vEy3LnV0UGCjU+/3Noi/t55Y1qoTak3mb9jwoPynKlBq7fRD3+rK3PQ3cKtrMP8etzo9EI44nmbRDebWUoT9jkP/ssgdUFqL6fX5dt2yybLgX2dHAILqloaV4sseYNYfrT5VVSHAUtk4MmxUWlyj5KEH6l006EGFzWYdetVRTFJChQG+8CnTBuxRoLbeKEgJF45/1sKLfju9o3Y0I6MUCxVf2iy8+EuGVmIYD1+ygJHDUtvj5Yvx5+MXHQUVh6PR8qsvhs2nAhIKwxQz9tXvnp2cnV2f39w8tzSMMApxOpI7hdB46FNsUYOzVxqUFYkjci8Gc8ho8IA5O1QXgSSl71eHsrgZDR7WqCmpThJZA4/ZUVMdjkJSNz4uxEeqkmMnhtY5LKmuvOax1P54+XL8YnzUPZfds7afkxxq7gbYZBCuebF84z039n1W2z5fydiWYW8ylhda6bj3Wmil9sfLo/Eft1atdTgqw/5KlSwTK6Xgv5twHjtBpxjxLMWjGeKYfXWri9x9JQwLltb4PU/RSAnnI3PWNRo7N82bH3ZTd7xSK/bDbNePyRrVHcBzuZrGauNtca2uexB+OJb6MefIyDvK+DpK8YwwniK/OXN9LPhNlXnuZA7zbqV1k42KO6eLL+xn/bhUY1g16yr7XVQjXrvmd2d57tPLi9vry9evz6/vzq4n786v7WS2HukWaDeTu/OLs6vLycWtHVAWk/fHh4frTJu9u5Oryd3b69f23kwwQ6WsZ/n3JC+wnV+gS3FJ+jM1cWAqvJcqbzeg5WW1Scw4avhz9CqOXr7Ju0FMLu8mFze3J3IKb3+46qpsjmOO0yQlDOcl64ddHZxfnLx6fX53cnHy+ofbyaljKw7rW9/BUkzs5Ve/e2ZbNzbJJEujWms97bbGahmV29fX+PPhR+MGqjfLrdTaiYzSOSOpjDGXPgHtthbh/jOCE32gg9OaVDSRmZXvMcjYRU5BpPZTLkdX79iBLJHMSh73J6WSth/EzmKEkw/lnNDb7rCRwr9ZVtyu3C3Kh+/O/8GhPe5h0WgYOT+gBjlfJ78B9XF1TX9qHkFGtMxlyvat572Edel5twk998lwjHV3i6c55N/AKnLMU5/ltBX3g5aGFzTEa7gcrOVsMKh7FFgny2c1CIbX7mDwQfah5/7ztxl3W1K3ZOZXjg007OV/4Oxx1/bOPmQpTM0G3Q/Oq7bOo4rlvk3utBZXKskMcq7eoGQdPyHCAkZQuLDvYPO1aXUM/xhIrvP/Hd6T+JDN5V9kCj/C6D0czinjh0y8MR/AT38GPsfm+h7MU0q5agi1lsPf/V911cBRFWDG0sOIBijqAdr1jbMTb8h9cV4DZVcXDNs+bLacktLqOEN4QeNNbOdi0ftYzR3WWP15J/yellXbV1u2pOddrGtDLwFYZ2xbMYaXcFjfDF4Bst40bcv0LVlwb7t3mXEDiH1zgbm4v1ciQbos4RLKWjpo65cfSAHtbdMSaUwizHMExXEzShCff/W7Z0oZdHd9/s3dzeXpd3dXJ7ffVmBEZIqDVVDPqpmk+IbTpPoQAL/HQf0ZmAznQtLQ54sQH0aB+P90AaN0Codl5A5tVj2PJmKEUmOq5ZuNlOpKZm9Xwtqo16Gj15OhwTPrONo7/e7tq/O7i8uz892YCWXdILGuL1xmQtl3m6bczYh8VI2KMh2qxob1oPi+sszboZSb9jFVmBQaMunGe17fGmRJIjyrhaOpX4ASdE8iYot7R6HcIqcnV3dvvru4PBPbQ/xx88PN3cnZm8mFeFD88VPlc5kd6cp0fc4CFCEd/V3FYVdmFgfvklq7sKSJlqtncvZ8+AloxUsr2c8OVxvER9h8/c1MPozMQhA70Mq5v7dHFb2cx8J8cHhGGJIpEDMujiPJbEC7jxyATLmgL+YNCCkORHUhJtMs5FHsI5ntinHE7XS/Pn9z+da1BIYNz5/ds98QC5mt4/sQL+2d05CNZENPU5M5ThvUjDEOcQiMqohmFK/U9wwY5lkiFgMJVSLVQj4ElOIGpCSlCZrptCfBg8lKKy9pCxTMSdzIOagw1d9JJjx8VU76ZLc2CogjcQNsGbdo093Zt5TxW3pqhmXvTt02y5oCV6eVu3atHcvuVavae6eRz7o+xLCaBS8T+2JREeNnkpw0XQ16CACt/ThEtDsNb3XYhsVlqrIjDLz20zp42EXF9XBybbNeePWaFPdqC4ye63hgySf5hob4GF588cUXlbceeq/uzdU62tbRCT1MWe9Stf7UM8jfTM5USbamWsY2p7mC4wncUggxx+mCxBgQhzSLOVlgnatvQcMihw1kDLMDMbUyo5BOPcQkGIuF6hAnc7zAKYqGgGOergClWHPN8QAEnEk8pZfxG3X31lKk0jSbhL76cZ0aueUhTx5vGfkCrZBAyf11p91iQVFQ1WBVgK5voXD1lRsgniinAaUfz3uFIA11oo6QYhY/5Sqf6oeLwlVPn5hi0SiEgBHNguSyWMcq3jukr4LB4WNKyjQSoAr4TXxKfrwWXAyYdSzytoi5CrqqXQ1VFT25EZ7dceLVOTMfQ0JpxBzz5te/hLCG14eTJgqlzYgiYfQkSn0Zaaa38UJazzGm91LS3WxIOA2li3QaEfxeF0koOczDv0LJMfoDuBA5wlR7GVf7cJ+WsX/cgO1/tuwrHy6A+gOHK297Bbr32w5Dw7cS3d/o3cF4TBdb8XdzyV69TPzyCwC7bOhlztcQ+pnsz3AS0ZUgladVtkWCrVoCW4xz+Vi7LabVoFw/+2kO3geJNUybJfh+w+xncazbEEtgusyCW415c5r2Xm5i2vMMTPuIAZrDsrngY/icbzc47OUuJ3U/TSWwlf2eT49gj4KlHIrXzXD0xrhOZOb9vkHOXVannF9/9btnb364u7o8K8wGtvYJTflXn7948cLyTmeTHt1n7KsYcdZssvRaI90Goc0Cjurj3G18qtIaffhlWHwm5qzRVX5kXNGUH4OY0mZac04DGh3D7elV7Z1CdJhixkcoIcPN4mHKqVTUIdftKNgib9i9qspHdIkiI+s4kzpROEpnmJdo1VD2GdGo7tRWR72ObUOLaBWXDnE8I3G1WbuQ4Wi3kQdaBahv90qAv+Ep4ni2Oh4UWuhrGons9m9lA/k8LT8xXS/Q+7cxWiISCYOkkvgWJL4WSb5vsMhrK8TAF2sKbdUh+dO0n/DmcuiSinFxVYmW2Eh4urJhobyrcZEwZuZQ0PL+1yRl/HvC59+qbnRDcde7qUys+FVrQaE0mB8DWoR59eInujjAOQrmBZtQyqA5WmJY4MVhkGQQkQXhTJciDIHEQNMQp0XhlidwjyOqant+k6EUxRzjEP6d3piSWnMcBxgCFEOMlziFGeYgrGq60mqAWKkIkDBgYsayFEMWR5gxVQsCvw8EVD6nDGucxvq/0nSQV33U0XE5NFP2ztg8hUb6tEWSLp1vQkK+t/ii3GdsdU/fHzcKfRaOY0/Z/OkBPB0F4v+FESSCOIDR8lcQZyZ8/vLlyz9DSAEHcwrD70tVLHT/opPxeDz8M7AI4wSORPMY//npTx63gcYStsgjusk6MklrKoiP6Oilj/zJ1fb7lnqEsTAyXVm7nry5ury+vbs4/4+3N+c3vt4NduHu4nfPyoS0CGizXIybXFlex3a5bKXECenbbab/UFkzxyu0iIZb8BjrlnuUPX0NX4uQzRftnx3WW5hP1SDFyC2VlRryVpVALQD7ASpRuvRhrT61+SnOdlzrIUiyYxgeDesd4wVNxaXoixcv3pD623k2wwmaYTZ6+YaIz7+pNTGs80P05iOlHr04evl5bzG1xvM6/Dl25ctRW6SF8FulYEiyxTG8kZSsvBJpy16Lua+TznRg6OvdybfZDF+JCRl474pur4qWLeLr09HYQn17te8ng8DXJMJ53xtoPAUr3VTjqWHsSuOZg/dBYg3huQTfb5j9hOY2QaYCMhdixFPh9ztCUUJi/Nn46GgtXZHXlfnly5f9r8zqAF7jwtuy3FouvPor3wtvMaTKhVc8bt53m44fdXch6fNxrc+xs9zno8WHRhrUbR5EM2W7KL2pVFhBUTKXJVRYQMUmz/11QkOzcn2UN7q3K0oVhxBWp+8ar14TxiuFVCpYDhRXjmdZhNLqO/VqTlNZgVMZvlkijU0oVFVYUHSVytr/p+I4qpeEuSicky3laSrFmUTTcvVtZQuT3g1AGEgmnjsK/+3m8kLJIeNcaK50fFNyz+3qWbbNaz6V+rV0pqTkwvk31wfPqr2FRfelz3OFWrkep6z9NpB+mzVRSfUm06xAqXJywY1PribvPrsJ5niBjivdV8r92Or6lXZAVxV/udS6GtXZLDgrJCfVeKTqTKgSSEFeAlnMSMXRZdwF313B2eIoHxL2wDzKBYtPWyuLXZTKiD98ySD2Wsxj37qYEs9WBM4Ie9CCH4NnQqRgQFN4ez1hz5W/dVHFXlYXN+Uk60Qt8EBpiuoVwAnHiwYiDsQ7amHfyNeQoNT45uQ11SWZiiwVHnPumji5UVsJJ/ijauY9GylGrLpvGlCvZZMKkVUn6qYsaySqSqH3kX/HvZaBnHs19SjFgAKeoShafajZBx1aFRC+akX5VDcy20ftEVNgz4qbOG9mOHVUnSUx/2P9crUgMVmIu0LVOCBo0Yrct/QRFiheKWwkIcU3Ar928m2E4q5U8jrwz0stv55CXffw21CVa2T9FNx7HXQvHbSP7rYWh+rS34pm6+hwn8Cjcp8vafPgHgcoY1i80pFgJMIq4F2NULxcPMQ03IKq8FPVIl+/vbm9e3Vy+t3ttTOqbnjUrcZVtfqkSfSrQ+2boaK97VGn8hb9Vafudzn8MOpWtmItX9Xfmq/SLB5ldZDVT9MsPsxcva4VU9YrFLC6rYqPTdaBXnF8G8TRraP4ffFi0aKNfdNPr+sPrOyJtN20E5/5uCeVfV26NpI7d4QzTM1wUltCg21nlniiAjgJM/Ge+VEmZE5RCJirCKqAF5VtTTgMgxmNce9sFfVkFYTmIxWUzQ8Sk4wCqjT+afvRuK4tuGkmhN/QpvpI5okq224FXW3aDdrC+VvhW4+Brk62GxXZY/3tLhB3hwG4Ns62lq3Gfjb2wamVAv//AA+6ygpoUAMA",
+	"openebs-operator-2.2.0-ee.yaml":  "H4sIAAAAAAAC/+y9+38bt5Uo/jv/ivOld6/tVqRsN2lTbdN7ZUlJuLElrSQ7N5ubdaAZkEQ1HDADjGRmu//794PXDGYGmMHw4UdLfvppLBI4OHgdnPd5BCcZRhzDa7RCcI2zexJhOI4imqd8gJbkLc4YoekR3D8f3JE0PjKNTJsF5ihGHB0NAFK0wEdAlzjFt2y0QCs0okucIU4z/StboqhsMhiNRoNHcIqnJMVwRRMMfI44oCShDwxUX0JTBjSF779isKQxO4zxMqGrBU450yidJDnjOBMAKjhntygao5zPaUZ+k5DGd1+xMaGH989vMUfPg7HP8gQz0WgEaEm+zWi+ZEfw0/B3w58HAAAZZjTPIiy/TGmM2fAA1D8Olxl9v9Lt7nF2a3UMgmaWTYJkavXlv8VqmP8e4vc4En9Yq1P783BKUpSQ33Amf8nwMiGRXJWIpjyjSVL9hWEFAt8bYDiNl5ToPyKaTslsgZYaryjT7f9Gb1WDjKby32tPnXHE8TRPDCYxwguayr/Whmn++DWnHEmoCVkQnqF0hhtgE8K4aPKAeDQPHoKkswwzpjZJnj2acpQsaYxyTlmEzELf44yTyPVLJH6Ziu3BjMxSCfHXHDO+JRQZpxma4ShBBs+luDSM45Tf0yRfiJ/IwvlL2OLrtilasjnlY/ye4yxFyUiPrC+iC7dqT7VQla/EhW0gMcNcbaa9HPIUCvom/pUvY/2vpfkxxgnm2IU+WhKBcioICWtBNsoZpwvzVSwoGZEkq4Yf1BF04aXRKTB04KXJkxsbGP6uNuzwd0NwQInELoy3BIvSLCapTV5dC5VgxLBv14rdMqtTrkS5OnrFXJsVLwgTO5XhGWE868LlHiUkRpykswd8O6f0TtGyXHWUJ26R85YG7lmUW9o2DXtrU5peacTeXL2SsA4XmGckco4ReruXNI4Jy/KlwPY2j2eYO+E5j2OJsyEp6pV+SdIY+BzXuQR4IHwuf5AP+GVG7kmCZ5iNB4/g5uL04ghO5ji6AzKFGE9RnnBAuitKGIUU45gBp3Arnn+c4earLsYm6WxHjzvLb/+GI67fdyeXA9AOwvxe428ymuArPBWAm5xKF0Szzy1TlVtjLwpaLtlhwaqdFo+/Yy3kcGhJBEeBPfwZQIJucSJXxtsPoCQkhxFdLGmKUz4KaX1v8H4xfjF+NsJ4wJY4EqMxnOCI00yNvBAn8ZWFSgsy/dAxvM4RPBejCtqBZys1CF8t8RFcYXU35FeCSyLp7I28zUeQ5kkyAOB4sUzEFwpZa6kBqkvYgXnfpexcTgAAs6Ty35Vjfd5xogEEZ4hIijML/1H7DADIAs3wZZ4klzQh0eoIJtNzyi8zzLC+SlY7BUesF6GHixLeUWUO6rOkGbcQUcgUKF7SjB/Bl3/88o9WC5zeVzs8govLs/Ozl9fvJhfvvn/z8uzdycX5N5NvAafoNsFMoiPunlkr4FSMkeKIi39+/xWrwbtFDMdAU+BzwkC9FGO4EX+I/81SmuEYyBTwYslX41pv047lSzE9HMOUZlUkcAZ6Z+HZ+MvxC6DpA8riGh5mW9zTq40K9yjJ8REMD+d0gQ/z2zzl+eH4Lr/Fh2oGw5ZV++r63evj65uzq20tGopjwTF/IqtWTM+3aHPOl0eHh8//9GL84qvx8xdfjf9w9NWzr5751uz8+PXZ9eXxyRksM3pPYszke1kQXKBTtQ6ltAaIAUpr8HB6TzKayt/vUUbE2tduQ3U2xcCVVnoi32R0cVT7AWBKcBLrN8v52yXi86OCyI2LSXjmfn129XZycvbu+OTk4s35TXUFzGkx/IBZhyWNAbFNZ18beutrIAjruElRPQvx+vjH43eXF6dyU5oHYctzr4y2292voTqZ2pfp5Ors+Obs3enZN8dvXt28u765uDr+tqC5hMEUJUzyfSlcLHF69vLacIk1uLbICiiNzRdLShN4IEkCKeVwi0E91/G4dX26UHMt2REMeZbjFso4Ob++OX71qoB5IoC+u748vro+e3d5cfEKYhzJbX+YY8HrmrmClMiALVHGMMgpsTnNkxhu6+trRBEcSxoBS5TJi6OfcSAp4yhJFJvY3Bs1BUAtI8vFvMXWSAdApjCUWzUEwqvLXbRyEmm5csDRHWaAp1PxINA0WQmAa+3FI0n2sXxWxFS6drlzSzw7rSbbvdUnV6fO2ynQzMUbx6l+JQ9jwuQvoou9S7VBphldKH3s8eVEP2VjeLkqdkyQjJOrU1ZslAaG4xqkluU4uTqtNQ4+5C+Pr8/enU6u7BkWh0A+7BCTTHLvK/ksm5NJU5hTxmGJ+Lx+Vn4Qgl9BASKUyuuNQai9iDjrU5JgNoZTvQZyGAGoWITDe5QdloJL66EwU/DsvQ2qZSHUUbo5vvr27EZAk2jf4mJRxPtApiu5X2LiAt0aNE6LDmKlGLon6Ux2YHMkpi24W3GsbjF/wDhVP5EYQ4Sy+iNBp/oyK3WZeEmaVxIXx0iunhyasMrqHSpi0Ek/a9MPWEwNuXNNxb00d/RTWlhJHsWyKtp2dv4WCFNaDIOb0FQIta0EmNDIdcPlU48NzY2x1Ojvfqtqq7qV/fr3ydtjBXgr+2Qm/O/kHsG1euAvxaInFMU4htuVoRHrrNan+D5VFnAzevTq4uT41eXbd99dXN9cHt98t90tMWoC06vKkG1lfw7FfUk+xV1yLW3I/ZETGgadgZOL85uri1evzq7eTV4ff3vmAW9pS/5G7lGhJgkb5Ors8tXk5PgDjOAVuY5g+Idhr7clEFlJokeE8RkPx9mijL2GES/BWqO8/vb1Tf+hRovZoves3l68evP6bI0RFf/Qc0wz2sX5RAweOOBihN9LDU621mqe/d/Li6ubs50O993Zq8vwERKS5u9HOScJ8wxRodln58cvX529Oz4/fvXjzeTkGsjUpkvAcBozQClNVwuaC4YbzeoSoTLQiy7fUjpLMBynKFlxEnXywfXRg6Vev2RxdvXu5sdL30KZRwSnHGfLjDBcaJyHA88SFdi9u5ycf/tucn5zdvX2+FXbyxZrSx08ISnMaZ6xpxXo4l1b4ozQmESwFJyhXkOhoXYt5LjS3QghhMGLL+bV316TlCzyhfjt+XwMP9JcIhrRVFj7lYqHU3jA+C5ZifeSYS4MjfD8j1/NB+2aSc9KDJxKyhdfzO0lTcg9TjFjlxm9xVX1jnAdqX4DENHFAqVx/WuAERzmLFMP2+EtSQ/F2Y944mio1a+VX6R5HCWnOEGraxzRNGZH8IdnlTZqZ4pf/2j/mmEUk892HnVzXd2pqtNMN9LqxhBzXbApacTuo8LwZtlYzAlES6LntaybWJYZ5TSiyRHcnFzq7zjKZphXrDFVa57HfMSwtOEfT6UPxeoIzmmKN7FwGmIjda2idx8zp7tz66oGdNnY4OkbYw3EPprp0z+HtZYXPqYRtA2pTSyhBu7dVxXYLqtoh4mzaazTsMGC+3HsdS5Edm2zeybtdeL/W012HuPw5ku3HfvwFlduazZiA/z84vRsN2YnaXBLaYwbZrZP0PApLXDGBNm0+VWfP3FsDF2SUzmoQZV+0fZua/PQlGZinyVIrfZVNPtQ+XGB8Rt1mIJSKkBKWnQAwypChtEYFvr1QiGUUHqXL7dzTv8wujp5HnZWG+vpO661ieh/iblUe1T/ggSjGGcgn2GBHmH61sdjN2Kvzo5Pz67enb06O7mZXJxrYerUh5bDqlL9Cy4zGmHGJPhyuSUrIAQEwkC6KitxR2z48y8hmqMMRRw3dNZ6Y4Eo1fZyluEl0Jwvc17fumsK0zxJ5LARSh/zYrulUUb696n+T/6qh2RPx8eQ4VmeoAzwezEYa+q55aGV+OtDj1NOMmyYcjXROWKl+EYaqw3HaTSnGfzyX7/AUQEMpStgPFM6fcSBcZRxplD9pfom/1KD98v4d7/4AQls6gBgSkVAglJv/oYzCmJbqJjIHGU7kbHY3PHlKHJ8yTHj8IvanlEEw/+qcSTj3w1/ga8li7dt6WUNVty4cHfEhbQx5C4QQXxjZ8etMefukdZGshejvglD7sN7g+X9qMx5gVIZ4NLKdDvauxjuUK6+wZh/IlzKFl+a4HdmR6/MFt6YdV6Y2kH5ZdDzgan3/7xemBr2W3xhdsdF64NiUIfyhrs46bJZBy+9TT56uzx0D/65H+/cIK4tqg83de3QZ3z+5HX7ImD9+NpnwXWAo4SmIZLgduXAbUuBvWTAvhKgLX098glzLV58NXnR9tAb9Jcre0iVLTLlo/0rv4tXvilI9nvlP0s5sob9luXIklSI3RQ6vVFM2N1ogVI0wxlkWAgxsVGXDh7BhNctvir2lvymfO1Ed0librHyD5+SRJ5jp8HtRMJ9jZYtwmoaL0Zq/A3NbRYgM9IjyGN8P5LoAmGGcgDNYJmRBcpWairwMCfRvHQGN7QDOIUsTwVoCU1NVg3CtFCUGG0zAzo1ywEjfYlJJsjBAqYUSBoleYwlILFy+L38Wx0sYHiJMrkZ6oSLiTc2bKxGOoK/D0DZBW+xHtycyxHc4dWRNe+aFCp+gOoPMgHAke2hpYEwjMS9405A5kc3MOnYXYO2QJkHlPilA6nK0tcmS5laJb2iI9W2Nghl8vAWy15r1FwEME2Fcv7gEPPoUHjlsYPDW0r5sIrCPU5jmrlHVr+FDKiPyBEMhw4kTl5dvDl9+ePNgXb+q6EgHP7cCIhfNh8+oXR5cBjj+8Np/Ez9g2X6HxlaqH/Ei5H6xyLWv9zqf/wWD7t0WzLxxDXm7dSivzJLdeoiHjtTVBnYAQioAPZrl/LJNgxvqIEqEQpak97apUf1OIrmy2P4XEFdaQooSUCYvLIUc8xkezawmOcVzeFBEueE3GHgVDFVhaOPdFNldKGGYgfA0KoYuYSkuYZ7bNxqAXEuyFh8IIeI1BBi8YDPKVP9JbXOC3L2SH55rc/AeFBlCh8BHs/GBYxyJImJ4nlGYGWYOBQ/zEQI+vBrkzFkJL4bFhDFykQ80UDFb/AX8f9yn/7aD5iNe7lfHhhH4ATSV3soyOY55g80u6tQnEfyF7icVCSASOYxEPQpl08quT65nhT5EB7mOozg/PR1AcaE8IgGJedvfhaDXE5OK0O3KTQbp7VV4G60HqFF/Mcvjp6N/zx+XpW5UTZrBFaP7r/+wuHFPcWI5xkezRB3BDmpo40f4M2bySmgZEYzwueLejDeaGSDYV8Pv728eSns5KLbcOAZE+pjSnZdDjm7ujyxF3sMN+bbRnz6I8PmIw5/fv78S+nWBLcFYdDGeTMoYRDlWYZTLhzOUzhOlnOkXqkKUMesji8n103JM1TBwXCUZ4SvTmjK8Xte3Z+lybMRH9U92JUd+LW4AcytISm4WvuzEB2UfkOyE4c+Jq/Wj+W3qldg+wyj+CJNVkd+z3vBCrZgl+XpYaOJ6bvMaLQokna0TE807I9ajO+7oPswE44gjXiLalc7dsB+5GwwKianB6BGjJDDY6jgW4CkWuY4P30NhJXBhcXLuERM3z/R5NQk5apBRCw8avkjumuIyUhiLxpopAtkIWdCpI/pg9RNSQKjdSVi6gWp/mT8YB5V3aCLMMwHqX2xgs5kOKVMvaPjfZxR04+AKPVgoVM5ABmqXYESHHytY8++mbzaMADtmvyGn9yuOGZPa8F0AqO2GblQuZ78p89f/fmzP/3hT188/+rFFw1PsWtrodN8cYszgUplYSp4dKLRFjHzbDeu3FLd4/h+mMaL4dbsOTp7W5OdaTwPkdHKVJFtUcu0vhuSvRI3popmyyOiJJpTc28G5XZL4gry0YAnnAKKpG5VfS1PHZ3Kt0d8+1z8IUZ/KsinjCptkAoZaCceHAVjJDMrin5KkYXSWIb2q2xyg5BXrnW6jdfON9WWh+6RPOzifbNIC9GLgGNgVMkxD1iJMnqNNMXUca6ySQUoyrDxoMQxIC5EL05MSoxGHHPrNEM3tOVNboXf9kDXxrrITkrv6c4HPHhU1XsbzjjiIq3vh1Pr3akA3bnXTWOQPih9MB8bB5b9lg4+pkdNyeI3cGqXQE1zvwgaKhVtMQJIyHCOrw/5YnlYIMziu5EYc9X9Hn7R9hw+rz6WU0SSPMM38wyzOU3iI3jexqGbDfjh+Obku4/n3mzQ+CiZhbwZo3Dlloj3ws1sfQL5oCyL9tWxCGP1reIRDJu3x+1wf/Lq7Pj8zeW7f794uaMo1R0nuxGylJniWplu+iW68VrPPwWLud9eHi1igcFfoLSbf2ihgA4/DTfiIuHvKDx7KVouj6DsqBP7dnEunR387MtW4us6eSDvtPoxKS2rs9YKfVQuxY+Si0mpH6ZNfNKcenSUMJrQGaeMxzjLmmr2F7WvXvz1fz3/DBzdSkSOT19Prq+Fu9IPZy+/u7j4vu1Z813iYSDob44nr95cieRtryYnP3oG+QaRZPiP6g3V35WpttZ+Vya3J1O9e7An0xY8tz4JZ6jaAnxaQTUyh8HyfrM4dzeQoMiPgK5bE/J9Y22A6AcT/f24b7TM8InFv48cecXWD4S3AesV2AfB74Pg90Hwn0UQ/D959u/PKOXWB0h99g8TZbGPrNhQHKk96r0jK+r9P6/Iihr2OxUmmlXMivJIOhBCBlGYKlSnRQGziryB0pRyVfvqaKAWxoRPjmY4lc/hbU6SGGcVFvT+2fjF+M8DUD4QhKY3ZIEZR4tloeDSngCM02xJaRKpAklsXC9RVggMKI4lgii5zIigaSfCvSAtcpb9+/XFuaLQY+WpOZ5jlPD5aiJ0x2lUON3GmEUZkTWyjuCm4sWhe0AkUhCL/MP1vgrr79yAldAgcDMemi6sylMQ98DM6tWF3aV/gDAMY8xI1gs73aMLs1M34A6sCqajcZhciB3PsMDoxGCiK29V8Die4crQ2oF9pqpuNU6g6qYPmr49LvCiptr3zp9fEaaerWWSZyhxnHv5K5vTjJ+XYwGMIGJLRVyER5og6M3OA1CvSXaP36R3KX1IvxHsCitjXlhExTzPDdcWD6T7plU87r//ZwBg6uPRtAxtOr6cvP3DdTTHC3TkWu/6VPWvMhir+aMK3Sqq1o3LdIJLnHGCK5rpgp4V39XGfiyQU21AlmDUT5mmQzgGJhEvOMwM62e3aZKiU0ApUFkRbix9u3HGTPyVyeKZ4YjOUvJbAblwchG6AcZrpFyVvlT8xAGIV3WBVpBhMQbkqQVNNmFjeE0zDCSd0iMQMhs7OjycEW4ouHiWcpEj8VDSYXKbc5qJwrj3ODlkZDZCWTQnHEfCF/kQLclIIp5KAj5exI+KDX9sYarugHpri6/lMfeuuzjlQBgg3U3hXy6vybl/dXZ9U2y23ILqmsvVLruxcuHFQpF0ijPZS5kLFZeiStHqaGeCax65LL9dEM6KoGfgdAwn8h2TfJO0ssRjmKRwghY4OUEM73zZxQqzkVjS7oWvq5bKhmq1iq9tzU7nrRROiyboUnSUfCtyNqssp9k760vXXRUflL+/KuhJ5Zc6hbYaAtLuqUL5lctgdfNLUQHkVvpa1iAClEmypeBoajhYWqtxrY/gPIRo4PDy9k9LEXZxpprfV5kSf3/xQenqYur+CWDkeAP9rWonxv4sERdE5wj+68n/+/3fR0//95MnPz0b/fnn3z/5f2P5j989/d9P/27++P3Tp0+e/PT9629vLs9+Jk///lOaL+7UX39/8hM++zkQyNOn//tfnOi8H5UhVCOS8pHwq5HYO/egSWheyZW33hSVP+G9TLWMFkZ90Dg97i1QsoLzupd4ihsf04iJyx7hJWeHlSKwhyrGYaSHHBVDjoqDd/jYMbzzDpfXTFGr/RH78EfsSq99/ZCRdK1DZsjWGCZTKGATBnRBuNFgopJKHQDhRrPhhsgp6GtApkoWJkxI9QmJiAhNsnz0KZ/j7IEwLDqhFMhimeCF4XdGikeKnaPIF/yTuxrenwTdb39mxLPGGm+e7Cf/JZSJNGcmClIqU0jzapYR+M64AgDC8cJxIRu4OJ9ggQ7QVCJR4KDHdKyUH4v2BwxAsBRXiMS6grSjRQ3l00oHg3iGSKzkJKhsvZiMEybIbjOhfpGTHTtbedbQgVaBkrlBTPuYq7wHdArIQtIDEeTaKxdzmngaLTuorfjcJjS6O1X+7f5WHfNrY9+MqPyyHKgy8VaQYC+LRLXiiy8AMU54zjGrrNq4FWrIujRWRxobO5rX1uBltbc5gWXNTKwG6IQKetbjzpadb1/5idASRSJbfb9JnehuZjYGzJozgol8DNiKcbyAGU5x1gg58hz/BeKSGfjjF4HL0s44WIi9Iuldz2U5Vb3MqsT4HhLxt7imlYO7vS0073QXpqP6MR50D+/h8urNUJah1WBd9CqIsUHbQC34tGPiCs1flzsN2JvKmTi3xjYHQ/lZ6GAjOy8Zp9qhxDM0klMpn9xCnUrS2Riu9GIrM99gjYUUQFVqoYCn9bJoXB54XSFN4KYeVjVJtFwmxL+7tHjEDBMxHqxHs9uk9+3I8S3XQUn4a0j0gbJ938fLL++vd/CDBLSmEBZGcYOEtk9WfNtIkNuO1qADr/Ikf1T9Qe83pk2nsD/Gn8MxDtVMdLGqXpLcpqPoOgMeDUaXtqIDbG9dhtZbdID9DC5sQCOBgXblCHyiH5+UXUonGxsQXCzV9YfRaNB2MMvtpdMpXFLGiAClDFdwBMlvB+KXxx0TbL3cFSXFjWgfNs3Tej/DVymhVow83gSvZUaoTMiTIMbaRdkqo1fvJ+5EccQNc6fYONIm2xQ80WQKCZ5y5S15YAoSOsZhGnrcdjWeXGPstAGNfYCfCgxuKZ+3QEUZNvipDMMKBqiCwSGYBTJzATuX9eRpd8bQFrRiz8Lu3/4Awr1nYfcs7J6FtTmfPQv7D8DCZrRIfCGXOJSPvbqo9lMMnv7myRJnkVjVGZbxJk+73sVSDyjzMMlUrQuhPJOHqTbUk399qs9pC1g6VfBMalXpaybTuKq8afJHqbHURb7FwGLctm0/v7g5O4LnTqzECBrU82fP1CDag1vziy2ADZYVFWqZBxNIqV3VY3z15b+O4UUbsg0E5aku4DyDv3zdbPOXr1sgPn/2bPx4OzxiO/3mcxLdFV6zgUfyptKpkgiVRHcVBXMhY7VMdjSq0B87WblvUreUJhilvknRBGe263b3jMoeBxVB5aDUMi9p/JjZsMeDtW2eDX/iJY2lIGRhIsUFnRBZyknqlw5DEEpXwJHyEqxFOfCMLBMMf7nDqwPll4mnUxzxv6qUj+1EcI7LWI8i68xfzL/+2nY9Qq2mCpujTiubtXRnsguQNCaRWBo1Tzl9BQ04VXh3W0DPhNwGC4xSproIjrECjI3hB5UJ0pyOTqCa6TS6ApRhOKfCpTjOE3wAlxme4qz8BlDabcE8p2fvcZTzznczmCETGev7LPv3uLDiqvW5wyt13uRXxRHuXp5CDTBuXf87vGLyLei2+8vZCOy0HF4c1kXOpB/s2XvCOPs3E5W5uCVpGLIKNU4t7My2prH8U6K5rU0xiPfamQvdyXIsBiTwesxUfRFBuOZk2TlZbV9T/Bm8Fd7xBUbqHKt1lHM/+zVHSfcNO7WovOpigIjt+jUn9yjBqbyzDySJI5QFmfONk7lJxYgkNY1QWpDM8iSxboYbAKkslJGMIRN0ZUaz1db2tbweJoypzwbf1HvbOy3fKhkh1TlHOgWZb7J6YeGJyoNs7gqdGjpaEJ1ummex/tJ/XRiWrYjnp+VLVlKQ7sNTBkFKiYUwlaQX8wOdu9lcTwU7iFLIocURwvc4gycxlTCFVwF/Oob/xBmVxzvFM8TJvdH4doI1jB9X/mqAGDyDJxIskMUCxwRxnKyeGl2Z8mEZD7bnuBIuussp9TqBb0WPKvmXQPrS/oI1oYayl7SaME0ZDkoq5HcNrH5uCxVseRD/Js4zKuJC1S3d0o0Okg97sextzjEPGeH4RDCFfS0FPzR7ml2UQCESv23FbNDlwTNqmjvW8Twp16KXe+cPjm7elWjxCtx7bu49N/eem3vPzb3nZjh62/HcDHhN25BtQ7P2NDFnE9s3dNADex9SnUb22rnzWb49FnYp/TjIrlFrsSITh/iCyWANdbMriAHiZatERIA2IBqu3JU0o/WgZ0Hhk3rmPUMomzvks08bPrQEg3jNZbVaGcQBWmVtEnwExylwCifXlxMLyD4ycx+Z6dAQ7iMz90dsH5lZ/3xmkZkthqdQc5N50dwmpwaek6n9c/O50pqQ/s9SSGhnX8OVoAWulV7LXFUxSTmgBhmp2sXCNoPUumYoZeYYtFkYQo1PNeOSB2aIyanLpNRBfr3mo7WMRp2BP+GmIo8pyLf6HQaidgOQb/lbzUIdC9tuAlrL8FM17HiQDjL3+M05HqheI0+YEadlgQvTTh/TTcfaB5pp1jHO2MaXTheGEJNMzeTigRlmiHEbWjwgW80v7eYVD0Sf0SXYqOKBG2Bq6dYvdTGrLcaUdUwotonEM60ehhOXYcQDtYe5pPUWtfBQrWKvW0/ihKby9oUng5LN69dSZTADdI+IRAroLcPZvYPnkiTm5Pry5HFRIVmiUCGlPq5CkATSzR2e2HBVQr/Lk3X4s/rkT8z4NamiGEhNzSXucUAQ4UycVZBJyPryUgli/CZDKSMmjWHAPXmFGC8oZbl8wAtAOFb50WiK9VHwElRAqTz37Zc/RhyPxJDrvBlikqqYTeAEb+ThKydJmDXLB8SKpG27w3mBGUOzEGSPYZ4vUCr9N+Ut0V0N3yvLBmvbDbqlOW/JilLu4FqPc4YRo2kAzjdzrBsX1rNigR8zvfab4dKkQB5crosi+hU0DuThpVO4yQQD9A1KGD4Anc1yPdbFaxSurs1qWZCXEp01RmzXp3uvpVLr7PjRAKindO3QMFcbFwa8It+rkJufsKc6w7N5WV3U0GSiHQ/c15ak/A8v+s/QxX7U0/22zrGewrdjjijDBv4HmIkrRXDrbFwpfwvWqjErk3t84Hjh5K30vek7ma7zqOsMrqeKkno5m7eVZtUqA4YKC5KXL2cZisOyWHL6RrVuXfHJFI7LppY3vpi5cse3jSiigUaiKSSLMReIkwglyUpmSqc5l07MC5TmKAHGccMI5/cB13c95IqbM6KXu6ji/ICUcKpRhvLV4JnD7WKZoLQAEmrtcj8ZrsdCZA8rmTT1emRCtoxIQpBmhshshsWMHuY4dRq79LJIucqccDNvlGFl1Gpaids4Og3mqEuTbZjZ2mrTaXE+Bj1fmxgvcRoL3l2fwLgTidNGF2thzWV5mGPBHkqFQjQnSZxhtxObctfR2Mv1M8cbOC3Wuphp5pGf2yMZulnJBqdcNi/on2Gd9eERHKUADIavHKzHVHba31s3sIXjrMzptWEvGSAv6zkFRhcYcJbRDGgU5Rkb9GZffMxkLT5aNDNLiyIuyFONt1RoyMejLxayUycSgiwU21vIbVWK0G9kD7vl+LrJ5o2kNnrg7FEWRHhe/lVULFDJ6OVPoMtz4bhynHSglv6upJgoEkYeHJ/X89IPh5U08/JPS+KGn34eKKg4fmuwEV9+/gUkiOF7tlNBQiRBmFNml/ap8o+UcYmCztpZ4AEFIsC0dSGuFo+oQm2cSldFBuNsNhaRer5yDKU9VB8bkLn+JUNh+7YU5VRuMU5hOV8xxXkUJ1qYVCLEa3gf1741bjFlAfN+U5lmGHdPJVflgERjPZ1SQWVNqoLpNyXkfihxylHixEn80MRKIUTSJgrGW7APGoKuX6TJyoXARDzcwuLFyn2sxq5Whr+qgmq+sh2FSa50acSB8wko1IYpHUvCqxo3ShrVF+XSO0BYXRItgPVCTvcJR/I75yBdCApyUeYAHPs8u324Sj5FayLUo6YucAM7C9aa9285RwwHHDHDIqsFNIg1ELq2FRvtCGxWx8XItbsq5FKB76jkYn73l3IhlbI2nlouxFfLhZTjfwLFXMxsK9VcJK5QeeT2lVz2lVxgX8llh5Vc6mnjyVSaGZT6oHDitSlI4062qTHa88JvmBHeadv354IPMef1iyLyIuFJkdFuvuuOGQqPhgqOEzJT8wCFLUUI9YyZ6ooKWiseKCDaJyhIJCTCp39sTwB20D+qJzSeJyySJyCGZ+vRO0FZ+HqFxLTE6myUz7zdRNcak7NGPIvRHrSboXSj+p0oPXvt0iDeuMfOIiXeTWrL8B7m396y/7vI575BJvfWYjLu7O0b5m3XPKU76MiTsb2NEndlad+wzpr7Rq2dmb1TQ9716LQnseyX968z4194rr/ALH+fYH6/NTP77SQ15SeUlLLzVelKRLk/ih/nKPaNEAo4jL2jhFoSoAWnlHRGCnkBByST/FSvUuvPHfnNN8hs3hnG1Tunebt1PiSP+TYymLdiEZS1fIN85f4UHGtmKl8nH7lzfJ2jfKNM5JuZ97NgLm0bpXChWg63Ndf4ninbM2V7pmx/FPdM2Z4pC/k5JGP3Jrm61XviC+1YI0u3ycXtBNk/P7edhdsJsn9m7kr+bSfMPjm5Gwj4kwh0ZuP25twO5IZ8tLE7w/ZucmsHZtVu90LtyKS9mxzaLZakXeTNXj9jdkv8bv9c2d1WqK782DtJSQA7SksAW0pNAGFmmNYM17tIUwAbpSpoARqcz7qWrqBtH/pmsg5Y8O7s1ZukL2iZTJ+M1XYKgxaQgbmqHWkM2ha9I0t13yzUAXvSI/P0JmkN2m5E72zTAewn9Mkzbac3aM19GpRhupnioAVmSG5pR5qDFogbZZUOM7+HCH4dOaQ3SX3QQV3XSn/QRlzXzRjdefs65JBAJtNt3A/MCb31bNAtk/b7G3TnfvYuVUi+5y1net77Ze39svZ+Wes/DXu/rI/hl7VGngQ3Iq3ZkL15kNdJ1KPjnYkJ8tH2SNULs+14/PpuofvmVXW4Ufm1RdfLuIAerkwiouto4NRE+PTwIRr4Tt171UXKG9FVaGvQlOMM8PsoyWM3xNLTWzKzkqYvUUac6oRPSunfW90vA+Q++q5d5wtry4ozSVKT5FteWLkRs+aN/UfYB6Ec/oQuj4khVev+RNwCwllxLZ6alKs+7wvrogmF0oqkM9uT4wAwj8bjg3+4bfxt2q1R/s9vrg0xPuYqZAWzkhNF8b14AWJpVsoWzWgo85HJqLSjao2Kr+MEQGdiw98wP/PQYVANNaeOQhiZahqIErciUUEZGi0JvDH2DfU8ktXQz7XSlOUL43yh3V4KHaExuuvUGmOVzUxB9YLUr4xU0SgEtcqnSItVnH05UESXBDNrU/zi+4zci9uD4NccpVw5u1CGM60ztFZC3tXSWTnyJFc2H4bxGL6jD0LHozJjU8yApOJdNO+mvVRFtKdPsPnUrN+9b28X/zyyb0k/s2ub5sCKm7e/tmPX7e9z1+AjQXpCNQ4bZGycyOQDhs6sl77xclKkbJQKmra0jRMQx3nguMT7tI37tI37tI31zz5t4wdL2+gNE6vmmwhJWmja1kihfAEgMu+7BgxvRZKbJqoFEC3seoX4toR/LSkM54i1h749Vr4Lop2h31r1quPRpS/VAczJbD6SlZaA5YsFyppitZWcwjwIalIqwOpxaDCcIwNKaNrFsD3p2otacpIt74lJMNM6J5M6Bohyo9KuUuo7Zx43n/eMT5vmTuNifvVnoTEtanlyYLs5JLXEoLOGGCDjferIferIferIferIferIferIEJbvnyd15GeeO/JesmTK1X5LuSNrWQX1TgTkXFO4FPrZjTMKhuZ60+NW/Koros8HzPWmWGTN+u0i2ZsaQOVxcCV7s393J3urHBl/srf7yJXrze788XO92ZN15HozB1L9bkY1SUYGAAAAAIhBJG90aYh2gJfvkA7akhRfnY10VhlsUD4riRikAMkgIXcYYrxM6EqE2BwAu4+kaWife26fe26fe26t3HPL/DYhbO7dpEv1e2lwFPMr7ianOspE3Ed5+PUtrvuSq6w9YyBjPJaZgCaxfXPbpKVUtj7qSi00OS1x1JPqwrUGEkrcDb5hAumaSf0MrUHNvH4++qsYyFq5VUtlUu3mKofb1ysoLIjWa+vttvJ22Hc/ITNhTwOh25+qFjRQiEF6p4wqM09jnElHiKbmwNricbANj1ms1bTdjmdzYVOYI4Wp5V+gLCQ6J9lJCfkkQWThKoev+QVJf3ulu3I+3k6sjy8ntnJEKdCmWOhOeifOkEEUkpHtjHWdTNUwmXyrKCBYEhzhCh8gk+XKCFbxpQMkmPhQ1V5XKlbIWbyDoG2AFK8nee3Db6kzzxkAgBL0GFNCrmaX8mgOSkUtyIiUhccLlJIpZnxc5vL66cXPYyfIb6jwhENKL0/UKhevr1xspa6UC1HA0wnoB94AID3hBzlRju4wUD3RHEum7wiGMsN2ieJ/C97+f9xOG0/UwRyKJkOFWMFg2b7NBTQd2Wb0gN4saFi8xk+BZmLuKbUApNp9uggAbCD804ufPdhW1wlIGuP38EKZAKS3SfxUeJUI6KuUo/fyQs0pw6kMiXZjS2GO7rHS7TzgJCki0R/QSmk81HYpXxERY1VlXZ1Qby5OL44UVuIIzVITHjQlKVIuMdUgJJZLaMApRHOUztxGXjlTDNNcMD7j3sl26jxmC69ZJwwfiWMLmlYakrDn8bl1nlun1ZZngN7j7J7gh8MHmt2RdDYSB3GkTgI7lFL04SP5n7VmIQXXsKnIph9iPmIcdvh43bQ+oa/S42vN2dV7ypBJGU+nJcqSero9jFGsSC5KVzs/tmIhpdkhWo20zm2E0lj8mxHGxfe9Vy4nAZf0zeT0wxzmnPS+kSGs1bXc4lDuSrWuBhWpQ6K5O3Ej/g9L0fJ6TnmKHKYEEemMdKjzGNQrYYInpyRJsE6bQaY6mBNBlEg5QavlxsGmcZqQaNWRHlU0KeejJlEIN4X4omBJ3SPKZtiR4jWNi3odPbjFZXB+CQ38eCr1ziunCbaQoWxFpJsaMEixEjBvMcSEqTuHY0BRRplVsaVaISJ8ZmCCvK7Jb/hoEBraJVoXmXaVK6ICA0z+ksIiT7jI7tAWxPbl8xdwu+KYWUANn8OsMCwJU99et3RSfm5ML9kpKhQriksQhzbCFqCyWpF2JPf7vzIKxCpJKvcV8UKQ1sdeWvaUr66Ogy7RaYsV1nAYEAZfwPdyWcZwnK5gSR9Ugc0XUsqxVq0lqv75i680ECBMMfa+NWvz+KheXr+oDfWDH3SUtnRZ+l4YR7ERv3OyyCQUG6cfVWCA0yVN6GzVulY+63G3a29tVXpnVVLdA4ymsp1gI2zVQbXSgqXh8pDN2vKvQX46cnOCy3nxt1pCTpTMaEb4fFF8ixlkecqATj0wAX7DGfVm4egMDfjtniY/0OwOZ2Hhv5ML3dpe7bJ6Lp8LC7oO5cUqO4P/VCrFMkwu2Hroh5wgFao8pQFpuaodQOR9qrxNpX6HTuvGGMfITCQDkd5Mqi0QZjtvAU01hytZHjVIAFTAInfB4w1SItUnWs/T0ZytL6VDN1XrujYqnr09KruRY/4cqVEbWFv1BltWsrKeajnZ+jkb2gkhwKiY4TopH9rCghV32J1ySzYLppDyXXGjKgGBiV7bhFqiPg/sJY3Lx7WSMsxkhNWYycRhHojFmP5sTF1IqzZSm4nj01ycCJ2EiqSzicono75WeWlangLnc2DKYWZKRFFDAafFD2KCrAUiiMbCLqMJMEOcsKlK9GJWXORv1O+NpY5rBXq7stRWB3CbcyBcmg6jOaUMa2OQGvOe0AS1EX0AUE7xGSxoVnDEFlqK7yxBEgYLyngrxGJfCv/UVBX2lH/MpH6dceEgLQZ8wGQ25+ygFaQ0xQmeVuRBtNBZYMxl+leDOisOgSEGQp3cvgJPzO0xJtSDgpDUT5Zz69pRF5bDpwdFomQk5327AsJl5hxpNs5oPlOrg5MAhOnUOgQqui8W8d4wVIs5NBpkMZRWfac0xq1A50jqb5lJnWRl1YtolmG2FP5V6QyWJQm4wdni39ovgR76CXtaHgHhJW9OgCEb4lSVJ2fcmunJ+7R6r7M+ZFJvXB4WNccYfpC/4viyOrPWIbTlReUpQ3GMY1jibKSOJoUpSWPHvDpA6pXquF3dlFG3rE6nq3mDMzFpbI8FpPLkc5wtDgAxRiPSkZjEfIqdr54ktSvjTgDhUwYAVafHVyYoYOLHCgL8muNsBUJFBwgY5rYfc8edr9kLCIMIMfVkjIM69psxmPyVZyVRCu1Xm3wdDBAGSHqdtck9nk3QZZFoVqHFYx0sFkidqx+UYTg+P22nEL3pRcBpcE2lB0xQC1lAMfmVtPZR+kIdAOoFUSQ5VdQ/LROqScCKhWQdiRedECVA7ZvVo2//A1uM2K9DbXvuyuSfap9M8s+eQNVLVWxPWT1i3BNQp4zi+3Tn3wxYDurPytkTLDSyeBZEUJ8OlbGzN9hqhs9JegDnlIv/lMk+e8M8pZidUy4hfLANU8uw0XYpEJI0pEqwVBXxe+NS4uNMsThJgWZ6nde6GAq2RtFk/0xpOpIJGse9YXrTQALNKru5RXQVqtLu1BuogqTcaRPh2gyxlAyUO6hI9Uqi3kAXOJthWMqUzj07937TNjzr7coP/6dLIeP+jATF6tnDHKVB3yl51T3bWgfJUr2SxSPDl6FfPZAt7XSTE1RoSwIFC7QEOoX/LnKr/08f/gCRTFifZIRBgm0oxqfGGrAHYIFVI8EzSo0gLTCu87R9aMqDcF4Sx7F0FBre4dXwoE6/esAcTtLhgfZAqtGqgiP0ekt5QEoowyZrvQ7D3Pte9OxQOrWsIZ2VnS3DrlJZlD8Nwp+KirRo8X3wxGhA0EzsCH/6b4FQRdgW0Ey9N0p0Unmoh8ZH7jErcR0Otk7ve9/9fjTNGCu/x6s19u9Gr4FdBpiOEhpJnfcTo2V4GjhXFXFbBZFyMirglLqHToVtjWSbiI7qCdHEKkxb6zrzB9ooYqEsfRSVyyOSRsY0dOcAaGpUvopUSUJgFFwSdbUC4S+qtb+WFhBpp8uVGswYzAJhin50aslY0rMmli+Lnq+pvGANHwhcO3aaImCDrd+RPlzMqNcEelBOpSzrqcRTnSxlnToMlfNd0ckNAp7yqlrxwFyJTLjDwPORKMvTCSbERaSPs0jfrRrVp9HZXi3lYAu7GUJufQaIDU1bWu4y1LHKKhQ0zViZWierjQ86o8RCuS5Z5hfhtWQKCcXKiubOjVB+bku7Www0NW7wpoyWF/NWoI1ZacwjjBjWbj0Ge7rAKutaK0Qt+olpYbMD8ASPZ2OIc6wjK2isqa+Kv3t60Gkm0Qm/hS2PZvI/YmF5JkiicufPZeIUVUCiS7UnEJDOXYQzvYQ/6GwtGZbbZvzpCpvTQSfQRInoUwmQVSmHxBJF8/rFEmO1ApVBo0y+CQfK0Ccz4+JswQrZXVlPyXYNQ6dlTBvWldhiBk/EU52Io0IZLijloINANfgEpT27x8YUpxy9CjbgCXv6tBOmLGEUxi75U4/Ynw42ycmaDLqemzrjEsCSdD11mmGBv1hv6V83ZkbUrSzYkHLLSRjTFa7j7mmC2o35aQ3TU/gMYW2TU7i5KeismE+gqamTGpWfHlJzL/Es2LzUR6vQbVoqzEXhMFvMSmuaitYxE/U0EYWZh3qbxjY0Da2lj1vHJLQTc9AOTEEBZqC1zTprLXZ/c85uTDm7MOMEmHB8FWPbQG7bfBNiuultgoAOs826Zpg1TDBrnct1TA79zS79TC69zS1rqpRD59zbxLKJeaX3Lu7IrLITk8oOzSnBphRlIAnWLHaZUQrjSCDETU0ovc56j8Z9zCY7MZls31yybVNJME3udYfDaVEv08iuzCLbN4n0MIfYho4Qx9AgU0ipURiEkpkWM4jLwBF0+j1aB69xYxDGmnjNH70NG8GnOlxLHmrM2JrquxMQyt9fFRkFQ0JWjq0Ohbu5cccvNCruhFX2R1fplTE5ozLKBRiJMUQoK1OssE0KZCZEZHM7GmyTeekob1RsdrCZJbDUEQB8itVzAGC9GjrO8/X4ldyxWjL9BXpPFvnCqmGkj1zHxSwOqr70fVNKVGLJDhcoRTM80kOPCvCj4qw684r0utUm7+D+yH4+R/ZK71n90JJ0o0ObFTEjkykUYxAGdEG4TKpGMzvbVkfQFzcJDxhwCvqakWkRiYbfi7BRwpOVFYjYCrIsF67EGlm2Y2GyoxaJpyT3YF+9VqCf4rXsbJLk64WaM6yDfD2B5ijDoLPZeGegsvLdY2Hj8B7akKLG3bd/QVPSokGt5qBXbQGnIu08A5pBTJj6txXZit8vacY94wGAZAgilK2ZzAEqRXqPBtsgqH2rEp5bGFT053qTTdoeUa+tLWeIYoc17y52FGy+SZv6BYNvgs1aUgQGnOllRqgoZXuSIMba4thrhXFqvSqBzbaM2+oTYc1sMoUET7kSaw8gpQVmEIlBgDANNl47WYS8Oqd46U782JjkfxTNyxssQahUOIgDuT65nviyHJmPEngUi6pDe2dUbODkQhcOkj4HjizFPaamswEIxL9BUejtvar3so0ehhsriFYLNyPBWNRMc2LAZRLFVOcILAnCTulX1kvU6SPnwK1fomvIOcW7tJds/pnYxL1ks5ds9pLNXrL5rCQbThOcId7mkVRVvZftDzbJaWONO1jLPNHIWiF4ScFzWigCYboygOJI9KCtGmyUrkCsPdc5U6zMHjyTHqB/KUyHB6q4918hZ+2UpDAvSv28Md79xfzrr+OWtD0hdFLh0cOb80x2MDVyC5FNTFvBAk4Vxl2KdKWIV4Yo2UE5pFqgmHamDbybYN680kqJ4Zxqr258AJcyP0j5TYCb1jlV3t94HKCX73wXOn2sKkv9felVpVbF9qqy7kHXopSeVK1rfodXhT9MB0jt76UFr+JYGj8S5aTyb6YA9OJWp6PtgKrQ4tTCzGykrqwvUdzGRoS5fFV248Lv5FVx3Oq0XZZ2dO3QVXPSsjyzzn7NUTLugHhqPXGqgwHR8DB4IEkcoazr0MusppJMAaNaQpNUMkJpQQrLk8O6c5aofPIkEkWvQNCNGc1WW9nJ8hpcY1GarlfKonrfRoY9nBHpy9y1qaYoZIkNPFFCvLkTdGroY0FSuqiZxWtUUugTFZzytHyVShrRdVhergxLdKDTP8tCpZgfmIRU+hIqyAG0QA4rjgy+xxk8iamEKKMnno7hP3FG5VFO8Uw56qsr3emarjRfXFcJQQyewRMJFMhigWOCOE5WT421UAV1tE8+RFNgH71umUBOpcd5eyvaV0m6BFE/OmHWck4NtXZ4CB6UVMbydVAEO/jY/U2cXQQZlsXq9F3cwq0NZj99FuzOmuqOn7ypwN3lu+ukQOZRREm1/JJmx8XRchYJLkv2VKswVXITL3GmUugXKam1QNwAebuCnOFsbGFZcnmAMizuRs6FKr1P2WFPmSfoJXa3itthYnaAeP2JidVriNOdJaDMQTPbUmbe7Ug8ryRn43przqlM7a/og2BQFHtFpJCoAvFMWThPHZZqtTRZxLleflGUI2Bzyg/UaLEem43XzF98InQHoWmwZWPn+lXLnvlXTirUxWvIivBJq6CfrL+YAU3LS8silIjfD2P64ClnvSxFXekbKSx38h/lLyasQxfpbFkt963xOxeN6tVi7Z/sNQ4jnb6a/c5BRroqhK+Cf2eJvEYZd1cJ9xptNvXXrQzOhwpQUUW6meK3QsgPYEmXuTrmxgGpqCw83tfK+2Rq5YUVyOtdCs/UtD4aBKmS2ovmnhhopYO0KYivquT5ipnTqTqnvkzey9Y3WFShv8zorbe6vaO+vZJcHmSd51scm4Ov0HdzeiHF7Dv5QIHrTYZSRkwh6F4IV9AEXgDS9QSBprharLr+kcpbyejucJItRflrM/tOVOIfNSvxKyWbONDVAySWzzezYjHG6+DsL9zvLd2P4DYjeFpWvdXQleKgsA8M/FrTKSJJnq21xvLnbmxP9AtRXs3aXdPvgJSoWgptAgCAg2MnDG6xyobNZNllrpMAWCNqmdYDU9dPeXwlAVxzlHEcPwbMo/7L4mcNAEbmdDl/U9vvfWYGPdg5nwQnC9C3y2Cihe+Nl91rleEV2e1Rt8pdH6NRdKFeKqLAwRReYJXKC/5KK86CC563pbPeWH1JnXug656eKrLh5afeVpopNi3W+iRDcgQLlS9nGarEBfjeIpRz+ka1bl3fyRSOy6bOCkKq5pt65EUDjURTeynGFGxfJBN3iAAEQSQFZVmgVLAKjOMlG4Q6iOlaqK3on6o2Rpmjl1u7bWF4QErhqlEGmtk8ZfNhT1BaAAk9xk1W2ccui6QYCk/VR+U4EKZFkhBd/MpUOhUnOnUqM/SySLnF3AQzb5OfhjVrfrSqHhSYo0Eg+a6ttpWqoW/twRgvcRqLW61PYHcpwtNGF2thzWV5mGPBVUjluBSWM+wREaeACuzl+pnjDZwWa13M1Jd2xH+KDZv1RsqxPharwWCVzQtFpeG49OF5QEwCBiMiD9bjn0RUmmB3vKqS1g1sYa6q3qaqnWJU5oLPgiafNVWqEJxlNAMaRXnG+pcB9TFOHrapIsnIL42sqtDwCQmtWMhOnUgIslBsr+zSpAhbqYTp+LrJm4ykfdf+s8q5j2qP2cAJW7c5gvvn5V+a5IxU5Vz1EwAAw9k9jisHT/CC4jyp70raKkpmLzmOZSnao0FRXRiGKk5ymeQZSvSfhUzCjuCnnwcKKo7fGmzEl4PRaDSwqpgDWhL8nuNUNjEFW++f32KOng/UWCc543Rh3A5lQiU5zMAo7ySmaUq57ZBRajBGM5yOhfR9m5MkxpkcoViwZ+MX4z8L7HUlRHH5GUeL5ZG8owNdeFjxy4rVMeU6x/K7MV3iFN8KxAdiL8Xws4zmyyNo/K6AVVbS4uQuS+WRCFP93v37K8J4ZfEdmA0AANicZtzaOYARRPdL9RtJpVmj2VuML3m+7B6/Se9S+pB+I4uQHMEUJQwPAFhExekr6hPHA7B0eWowMenjy8nbPwh3gwU6GjhuYmNqpbwEqGZkyArnbZrV9FkF/1ktT+V6et3186vOXQJr1UZHiVaeXax8yheoKJBTsMhNylGpIA7XYkkzZhWwv8cZl5RnlpLfCshFbXaZCKf67sk8ZilKjIUapbHM4pZhMQbkqQXNJPnYcXHkYt0fDzqoZb0wuaMguSpgwZUSX+BfLq+JDb46u74pNrvpLl+U39fdWLnwYqFIKmtsSZNyRhcSIk5jmZBP/uFwK2f57UIVQNMuecL4eFLUaNWMwBgmaakG+Fil1J0LbxPK1kfL0C/3w7nEUXEl6vdTTFSe97rpwRHwyqlVF7HevnqJ23joMJtm/2qtTiQAAGDTssa+mUBXOeNNShn7HDg2KGMsHAf9NZm3WsJ4R+WL1y5dLEoUOyEGlC3uykfbbu0IKFX8sWt6B5co7leeuE3MazMEtpck9mrvPKWId1OG2EdrWhUH7aWHNyo77C8v3Cp6dZQV3k1J4dZywv3ltc4SwpuXDy5LBTcwXrN0cEeZ4BATXv/ywD4THXgpSdtx7ioJvEE54FbKBm2lgDcwMXjL/65hL3CX/N243K8vqrCr1G/bNqLQh6lfed/2Mr5tCMH2yveuWbq3KM/bEgTZq2xvrTSvF2xYyV5HWV4vxO5yvf6SvC2z95fqbS/H6wXZXaa3TynertrS1RK94WV4W1fEpErvVYLXC9EqzbuD8rtbLr3bN3hp2yV31ym321lQNywWqVch3Z0U0d2ggO4+a3n1s89aHnijA3Z7n7V8/Yi6luXdZy3fZy0P/Oyzlu+zlu+zloe23mct32ct32ct3/ys77OW77OW77OW77OW/5NkLQ8pvbrTsqsbl1wNL7calmIgZCv6lFjtLK+6cWT+tkqq7qKc6nqlVP0hO7BeGdWAotY9Sqg6y6R6AXeWT/WXSPWfGX/p1PbyqF6I/rKpXaVRvSB9JVM7y6L69yigXGofZf6WyqSuVSJVvl+tQHuVR7V+bAEaXBq1X9X2HpXaq4VPBx08TltJVG/R00HI876h3aSHQWENYwKQtLOCbrghIWxGsJYBoa/xoFDbdkKGAMNBqBQYLIb0MQVYKv4wMbTDDNBQ7wdB3cwE0Ff930P1H67277eMUE2vtysdUV9Vf6iav0/euvLjVfE31fbBMLej3u+9sP3U+sEq/Z52L+hS51s6rPBj6Vfl18cKhulR47tV88FQXSp8p1o+GOJG6vueqvveZ66v+rqfuj5cVd9LTb+G2jJkfr1U8+uq5XvtUF91vFa0D7rRCFTFN5XsgzCS3K6GryvYw9RPbSp4p3I9AKxb/b4RSxV8OgMbhirT+yvSAzwLeijRjYq8A+I2FOhBVDH4poVRiGCF+S6U5T0V5SGiOTjF8zYluZKGB10XKlRBbkvEXdMPVY7bMnEHzDDFeFPtHW5X6aUUDzqtYRrYEEX4LpOedpfr3KRUp6+EzSZlOruEvfYiNv1Ygc5KIOFVQAIrgHyC1T/WrPyxk0I1n1CRms5b2VWcZn8UP85R3FoBml0Un1mv8EyZeXvQzuiHFp35pK5S68+tZTJ3VCKzszxmV8L49pvaUhJzk3KYLWUv23NBdZW7DCdkHXRnkxKXmhV0DruF8patZzCorOUGJS3b6hhuoZxl6660l7HcoISlYuZbWLh1yld2RJ4GlK3coGSlP1AdAErKska5ys3oSRbM3vfk7b06ifDSlHtufs/N77n5/VHcc/N7br67NOQmZSE7yj+2qKl3UfZx/ZKPLYaA/uUeuz0nuso8rlvisVNB26+8Y62AYwvckNKOIcUbA6huq5/JWgUbjWTQavsIL9ZYLccYUPWws1BjrRRj2z70LdIYsODd7i5rFWbU/HlXPbfAoox22cUWkIEFGR0lF9sWvaMYY99yiwF70qPM4polFsFbZkN9+pdXDHiBoU9pRbt4YgvEwLKKzcKJLTBDSio6iia2QNyonGJYKcUQ3rejhOKOyifuonTi2mUTO29fECvmshqGZ+XuLLzVSFSsSwsQVR9ijtJYxlCUBQZOmCurczvD4qwN4lmf/rnF9xnDP2LG8CLtnzdjuC17y6iME9Gx3JF/v744v0R8fgRjtdhjU3RtzClHycDD55fCY0zYHUjXCjFTli+U/guBWBCGecHCGLWIrvegIas5HSfV0IbG8WzFVOi5uxFVOBrxUie3TVYVrOXDpRGvIPiG9cNNXjoXThMx+TIXrqn5URQM4PNGyRuFwbWdwL8dB3Mcx42j5MLoeFYv/XNVyW6od2iGK2PHqpDCehnp7QGcKel1g7ac9JVFcuekz/w56bMCgzWS0rP8tlQcw3//z+Z56vV8q4nqXQmsIWryIZEkS/WqLfs89fs89fs89fKznTz1zesqk6Oaii/i30r76aWk6uOoruRj3bzJ2zdM3C5SjzsKYa+dtL0lK/oaCdvrydkbMPsna68lZm9A7JeovSspe1vEul9+81W7rZtdbUFf1zqyy1o7i7aGlj9rSQFepVYniqllhSW0mQRccGW6/BJhGg9XFT8kVPXsCGj6dzqd/n32G1nK/xud/z357Yu/J7/97fbvvyX4cegc9NNO4pBM65NTIAzylPyaFz4vnAJRXNrKZsdCx1ea9cllQHblyWWlyDaLGNHdYXJpkrpqZYcbC4Aol5RUKZUnF9I7J6EoVno1KUCbm2LVya7UGHfma1ZFNwuiHzr7loztlQX4z7LdJvnavbnawwXcPmK6Ifxdcnp0v1GBa9/wpmKzKS1pLZwBZSqm+Fw8emTelgJgUCWAupDVEKkC0qa3i4nBuh1BddbC2QiGw0IyHEqtA2PkNvGUJakKjOrt0d01VKX7VGRS61LptE40vesR0SXBzNqkA5iRe5VO79ccpVz5MVGmqBYvpuWrjigwVTNFy8J/hwHDeAzwHX0QWtMDIBxiihmQNEryGKupeMr8lxttuKx+O9ZWaMPWQNjfW/J+pzqvuyh2o1pjtTlII1ghlKja3qaSqhTzNWfDPFyAr1xja6lG74q1V59snHLR3HDoLmPAbpD01JGsBd/7Ckf6CnTL5fdW4vYis8Qykcm1fuwahC7MPaVJkg1AV3EL87LaBS6cZS2K+gmWbcn7LHeUqleE50ocV5xGvvCa6nGv96n5iriI5MAf2dggnXJjDZk089IxyRVC6QMaQj49pNIDMoyAeomlB6qkoBMOKGHU0E3meA0L1dxgPRNQlztjWwGRxgHpU0mk6qNbu1Jl/KnJBaIvXbHnzH10TN6oFeaA7hGRtEYVcCLMwy54kewugt7k5FRZdMKAm0o7MZlOcVkVnYXzUl4KxPakZ0969qRnK6SnheYU16wIoydZ664YXTpN4eTt1QZ23motZa8g+bbSTFXiimu1zqc0M0XLAwRIVFb7byV8kykcl02dNfuUpkOrlQkzSMSOqFhOBVmRt02GMdOcS6+9BUqFNopxvGSD0DAaXZW9Ff1T1aZeql4Fu2B4QMrxR6MMuuS3Nrw2aVmC0gJIMA1v6AjAVQE8Z1b9+lIfUC0DDjwjsxkWMxKSRPMuyPOgpiwJjDYSmnmbV5M1q4G11vdTYDql4xM9XG21rQRmfYXyuFHlvxOJ00YXa2HNZXmYY6nfEnqDaE6SOMOpT/Qt66/K9TPHGzgt1rqYaebJzdgeDNYumjlFzbK5WW0pX1qH5wExCRiMFNcSA+MT4joFuc4N9Ah0jTm9Vu2UHcsn301Vpk1VkZ9GUZ6x/vFKiAVUqbySzYBUlOWqr9ZMGzTEavdeFdmpEwlBFortLez8VYqwlUKTe+edz8p5ZydeO4MWem7sZdWmCq+T6pc78XGZY5TwedV8+LFcXHbn2+J3amnzZmlzY/F7sXwq7iu9/Fa0v8qgpOV7v5W938reb+UD+K10O6z08U7xWS89EZxdcZut0ZrVGemB66ovw8fbXiqlK0RjQO3AMvhEo0F7xYBGNGWEcZxGK3cAf3UB662BsCIutDTB10qBNxZQZnb/5eqbwxfwe3j+C8wVDbzFJnpfB/yV4YbivAk3H9sXYdyA+xIxrV1Y0EyrJ7589q9WHgHvUHKYcbDPjT4xV13pD1zaiG1lQAjOfjC2cnHQDFiEEnsdgydNfk0DfFN0Ror/yFGiMm8IHmJsx7PpggEvEcM9fXMcijLwOOiUrg4VK2yWM3X/RZsWVXebPkQyTHoY9uGyt3xvD1snYeaUqFOhb02E3FoNuQrAqZSxodX23lId3vFT1us+tF4EHzUxDGFTV6QSE+vWUkfKCJU5F0NygviPfT+/rMmleUEqmVkEr00i3M8X7JJm7bX21RBqbBCtBURNAm9OLmFJM87gDy/++Kz/wCjpN7SIrBSeA+rfUIthpVOYXB4JfBob98SH89MP4pq1a5+snXM1a7p2/sPwL0br08q4aFXCiWkuN7YUiGUEorVES5wpRod7uD8gDG5lnivl7xiXxpByDBMDqm0mj69kU20EfzweBKVL8Pr0FcMUBq2q24tRGQ48yYScHr6dFlvE+GVGb72qaoeyWvs+SaJ8q1aq3Ld2q2KbZrrz9ex24WpFuIKm5UCEdRUlmhpluzdMG1AqHWl3OMkWDXttZt8Jtfoo3G1KLJ9vZl5vqiCc/Vr4GspvpH/1gbbFF+G/CKTC6wAWKJqTVBM3xlEqp+ZBWqGjGKTSJGRp9YvNfqxNJ13TlLUiiFQ+yEdrWLngQzWUDjavkuC2C6g+dfqy1kK7jY9uwU5NXb+GoiNmQEx+aPl3uUD+OhX6KVoHWflzAKoOEnizWmrbkVRIlmWmXI3HonV/BNsdFbyEQL3jg97cdTOE/J/YI7Vm8vTNQ0DUO+G1eppye+nKLwJ/dOfWHlT6Ye7OGViyKUR7xck7HO792scpru4NBxlmeaIy0Ue9nG73gv7nJOir16I55xBWthoSI421Te8Xj+qrnUeN5ji6kxp+HE8urvGvAa+KJURNLprat0IgiJWTqTeRHmF36+w/SaeJqAV7hVGID+SFTuGqIp0mhxfauKFOA6cG7U1QuV6lUQ9U2CqNdoTJDxnhIZyBSWwrFcXbXpYFjUNweE1jbF9ZPaI51FLz/Z20Z68O4BR7XOUC8Pk1p1m+CMDo8X/IllYGuYc5RkIqkQQBHjIuw2cN5WxbI1AZMBgHmgGWCXrGMHw2VEzukQJYxOWKhk9gIbYQbjHg5ZwscOaIzFGfCDH8VFo4pdyc4YgynuURV2CVzCXx1gtY0kJvVUykDsNIljcSWwjPDa46algBJ0xPB2hqwD5eT7RR4aEht1jFkOoeJpaUGB+IbJ3h82WgmCuZJkZMSLVBwmEU2bpPb4M8O1vZFLG1gaBTrQ0k+XC2WLjr1I/05XL+VGyw81e1/ptz+3tv4b23sAfLvbfw3lt47y289xbeewvv2lt4V87ClkfvLYru8uWWPHqXOBortc95SbKrBWDQopaGR3nWSB2vVBIphFTtQsZJWlAChXTFCbbD2Vego8CFoKMHpllRRCbW31UQeCm/OjSZpXu4HQc4HCsdaAWhYD/jvk6/L0vwNadf9Yvb6VefF7/Tr4V1zfO3+OUje/6q6Tk8fxWCTs9ftPf93fv+7n1/P7Dvr7qpbb6/L6tXNiQznexxilm7Y9PLopkZOsMLyrF4DzPMlACrYCkr7bShhvByNuWbFICCaGZQSPs8V51oJDRCiYhKbrd8mVYGiWmCZsCprlCnoJSx+0LPFSwTLzN8L0B3LsWl1bCsG8e4jGhPMMfxSM39cRkxL1erT6KDTizqGNjbYWRXvS2edATe8UuuqRWDt0UzFw5tDFXTBbrBYHUg6dapjaDBYZkfzJrWvm4wiLCJC50mEYULXUHQBUSlOamnmWgayl0Ml2cl/oEljUcbfGRnWOPj7iy39sTcbbXHcHJ1GtS578jrfnYtmhW07QPLaIrIaMpi/G9KsWyJM6GI+YeQywQm4h25TpEXF+mPx3KZmmWaC12wm8YrNF5paNuR0WpXwCWs1Zq4pbb6UfKLb7WWLjmu2eQjC3S1JXBIdnU2ockwNqI89xLeXsLbS3i7lvBqV3cXot4GctYWZCzrcel0MPTJF12STrO4dx/JRxbiehWK53WjeR1bBVAh/dh+N5vuDOFz2p3gtFuh6Z/LVvGpSRBXWCLqkBz+OSSITM1/i5KDhhjCt5ubrK+VZG3UuXHw7eHsepDsUogtesjtyC2hVpxaSSSJwc7MOFcW/JpooH9yiwTmbPhFARvzmghQ/vSRWX89RQfLb3Z+b83Z8/p7Xv9T4PX1XW3j8a9qlzaAyZf2B92v25BR3EvjUmU9EHJTJbhDZesJtmMs0PsM82wV0TxtNyu9Ru81rleiw4noYBajmXkDcY4XS0d5WRPXW7xpyrfcfmmqWLscZa23vCPMpWinM03rnr3kDN3nOotCBrvOIohQKuZIljJqH0gqHbS1f55opHbMuVcARSYvibfVWe5w62I5sQ/aXmtTa1YHO6BDIa83t8mYlB/ju9V3Z5mj8NbOswBcq6I7lVmbfEao5Aa9B/XzDP/X8tVJgliX12zZ0F4mI4dWfi+PiSesbqfisax/Ve6TckVFHInvIsn8LakMMN4IOZ89sSli1H65zqKdmhQNCdqKTbG5jf9cRsWqhLtkh/dGmD3Fy4SuFoJjcomvy2hkinHrb6UAcQRaJhoAJOgWmzAEdzeAUoISfNOSpjjlo4DGxSK/GL8YPxthXIjMDCe4zCwkq4u/shDxo9ILGRNZdATP5eJmiOOZTu2izsMVVhLNAEA8JEnho1tn2ZIKdm349VyuziWr84U6B9FxJF9SSZUMgNECrVAdvM4rUqnINmrFH4As0Axf5kmiyoyLmJBzyi8bWQFkuyPB2MvFEhO2IY7QIv7jF0eVqagPTu/rJEthdHF5dn728vrd+fHrs+vL45OzQY04CqHkm4w6YuamQmy+wo73Wf+mVBBF7tjiOrQicn129XZycnZ8cnLx5vxG4rV1lKROprmvTrxOri9P3l1cnl0d31xcvbu8ON0NSpVVqjR9VCzN5OLdy+Prs3enkyv7xYtoOiWzPMNwixiGmGTyqq8kJ6ZPKtAU5pRxWCI+H9fg/zDHGYaLJU7PXl5LDtYINCb/HUkwG8OpqfMphhGACpb+8B5lhyWN8++uNQXXEh7B0AY19C/EybXajotX764vj68USMN9m5VRNVVVWUkxe4FzDSSnRQexXAzdG7mbzZGYu7jO0kcK8wesQ7EYiTFEqBYa8ajIjgRLShNY0lgXQDk7fyuf5ITRAjcTWKpLyDpqjjwqlJJLlImNxeK8svru2RVY5abIEQirbMqhgtG1N85VDdgoDX7YDf9y8u71t69v3k1eH3975oFcIW+CrInlHC1Qima4TuOGPabUe8yOUTzDnP3fy4urm7Or0PEWI/xeCIs46xjv6uz6x/OTd5Pzm7Ort8evPJD/8Gy4ERNzvxYPc9+DhbnfJQdz34uBuf/A/Mt9T/bl/pPiXu63zbzcr8W77F9E/4t4c3z17dnNuo+hTI7ifREjabTIF8vyoTN5Q2m8+2epnNq2XqQ907vot/a9XlDC+Iz3fkLfXrx68/psDSZBHcVebII9uhn34nwi0Njk7a6/vsXDe61Wv+PVHZVZbztf38C3Y8Tuo+IlFfjWNC9oSfRcxY9H8OWfvvxTaVbkNKLJkUgzq7+z8uyaptXn2fleMFXE73gqPQBWR3BOU7wJq2LeMbX/KF4QOYBcPxzEvKClsVpb3R/w7ZzSu871Devm52h6cRsAoi4OSWcqKr2oc9PJFnVMsR8X07leG6zZR2Vt/Ci5lS5iGrqln3EJZYxQNmN17cxICIsJnXHKeIyzrP7z/dcval+9+Ov/ev5ZaXyOT19Prq8nF+fvfjh7+d3Fxffed+8Ihu13fRg4wDfHk1dvrs7eXV68mpz86BnqG0QSRcQfKfF9gVIyxYxDLKmR0p4b5lDk89bJTHTykuLQs4PBI5mDBRBjNCLSk+PkisH/gquXxyeQ5ZKJrI5R5IG6x5msBDB4JOPTFKCyXG5C0vy9iGu7p3nGAKWiofoyJoxnlI0HjwaP4BuaAcuXgq6raqgKRIo5XFzrzFB8jpRiA93Seyywfv4UvsXKQaCo96n0IdrfQDLAFj9MMyAsYgTFCxDpy7OVyqCI5DD6Mo8Hj+DFUzgRqYy0r5OCo+oxZBjFK5MLVXXHmmREjIzijNzjrLzGdsuIEVkdQXGij+APT+G1oAranKGxlIcmnelBSSr1OCYbjYQp12xdR0D9gbaPv5M4SOdiCiiN4VTN9eTqlLV2WmOk4M9gJ55+3SXbiNhI4UgiXADJWFt+9JDF61m4nDVaOJ3OJmJpRQHnmlNZOVjp+HWS5IzjLNzFy+XmETGiNtFvuHulb5bYeXW4GWR5KosvqHyJkJoDUS+vOx605o30pxpSA3VkmRTCW4mVlgvnhJXeRjpr6NiTtMtpeReTmZwGDC0alu5KGgnlsiScnwl+6DUup0vxlK6+x6uu9JpmS+7wihmyqeo5l5g0x/Yk7mxFqj2HmfnWMpuiZDlHzz/LOo+NYoyIcxTNF9Jdzevm63cqrRqTXe6aFgVwlP+zhvdX+ysbuSsPHld/d3qjeqHJX+/RGg7oAfS/pbmp521eFuj/umzwrgx2eLrUMhuPnShBjGE2Nn93vCK+Zo6nRJc/0R2k50vNjdnRouHN7MS2dhYdbVxv1CPt3Sn4byA21SIplE5B8Hz8/Mvfy/bGPfP++ej5l+OYRmxcthObIL465IjdsUNV3d92LkJLcqg8kUeFK2z9i1FcbBU7FGN2eFyv40rtWGat4ySYwRJlaIG5fKVkxS+7joDe5dLBZsU4XkDOlI9lqpkUyS6rXShPCBybUSLvWRAfo46+Xen2cmRinKdICqjWv3CcdoC1ksurBIPpKLXpHewdwLfiAL6Rv3eMEyy6aXHft+DVZhCLU7qo+PfWT8aJtkG7injxeUbz2Vyun/NKFPU/5Lg6raU8iLc0T+Nap0Ejckx2GsN1QVbUMstzOLzCQm4aym0Znsq2w3H5tUwaXEMXcReueoYCjkBtOV/JooR2jhZfcUl9m80tFnjd4SUfFwgVxUTahq6ADETDNXSxYKZ64LiilKknnx6BQrL2pVrAzgPX4OWrB03+7Eq6YvC2+XtZwwuzZoSB41S5Z7cPgtgy6ekT81A+ePZqd5dacAKrbVMJW+3VHV6N1EYt0FIppmonyjyRlaEsMLJwkHlkzeWSujDMsE1h6BL9qmqPfF+yKP1jVas01/5B4tvps6qYUr/jqvkdPgHv1Q/HZCvK+QG4bItEe/ls1aaL09Yot7PaxXANZrsSBqiAqM1UIYF7Xtzairq/uU7xO6TpSBT8GDbD5jUZ15pf7zu753c/DX53/7R/mKe9NUpR/Fic4/JKyKPZQ4y4XbXfOTfH51Mz+yWhNaQhubndPHsn3946P31pGxBD5aZWWamJZ6js1Edo2VBwqcpMjizz3ei0Dt+AGChHTVKIVylakKiEZoxIDZgRYvjAfoSNP96UJIl6hiW7KqYwPK0cvaHq4bAPiLsVm1fJJWM3v7csJMIC6yjFhUeydE6F/z2AnIler99c31j+euVkbN2UszKFe1vMA+W6wm6x1CuaesTTVmOH2+QUKKpaZqjCvVPSL2c9sf4XX9vf5ZLfuoEygQ6yUMswz7O0tAsJHL/F/DLJZyQVvPyTpyAqsOhYWNS8p9qS5N0R71qqZ7J1LVUTSxcqH8YH6dJqxbSam6Ffg3EDx5sKK0sWi5zL5LloynFWXEH/HNrKnRgsvpNKh84yDdXm1tTM6g9Ni3ckHsq3zwHSc+1Cj4ibPIiPvTzjvmZAJXsELoTd2LUM6nezCGV+XSfa+lyQaG6fC0thqMiuLCXE0R1OZY9droa34tN9k+p2xgfbVMRJtamdPMubl+wWJzSdsXG/6GUzlMNbyrGhVuvarjpNBMBpM7tCOZW2RwCI5l/qZoaxSHExdnY9R44DpAjDImf6rcNphJVc48PgsTZ9KKLYhHhLlKO+VFfBLZHR2todXQql8ikFVL3FYXyRmvyBQkBwKYX1xH1CdIfiWdDlwNzZDixU52i5xOl4p7TTLeM6T9fx5cQuEaWcoeRm8d50qnTw6xr18WSqhsn0qiBYEhzhirgMJGUco1h96QAJgFNOMlzsnbqmEjlLxBaMSFHBWiS+OvyWthAnpUNR2a6ENf4AWC7oHzOFpmRFoLFxxxuXTqM/vfh57AT5jazqiERWwAMgapVtPxl5MwhTC1HAk3yopwIjgiWN9YQf5EQF+QWqJ5pjSMgdPoKh9OkvUfxvcar/Z+iE+UQxAEPRZFgr+1vJCV8gqHh9Uw3NCVR0wEKsfQo0E3NPqQUg1akZDEWLGwj/9OJnD7bVdQKSxvg9vCiqMi9p/FTfMrZKOXoPhEE0pwynrmz+6iPv5z1WFa4ecJKMDG//gFYqfYXaLmX5XaKMVzU8Tqg3F6cXRworcYRmKRAGKeUwJYKOKeetqlLQEHFdONwJVosb05znGR4/7ntb67qZFh1NnTB8GE1H0CxSFFCr7bGdwK91Fg7NakTTCC85O6T3wp0cPxw+0OyOpLOROHcjtfHsUD4Xh4/kf9aahQpDCJpK5Vna5XzEOOzwcf/6bkrCCH2EHl8bf4lazxoHZhNL55VYoFhRWJSutn1KxbrJahjRaqTduUcojcW/GWFcfN97oXIScAXfTE4/zNnNSe8L6GHHfUlwPEY2aBrazJeOipWjJku8YX4c9XPdGhGZQppljftq0qcQPaftzNzK5tsN7WqS0gNa6XXEV1JtPSKpLBTpF0kI0/JYt9Z2d9ozA+WdwHWo+BIHrdCaEilrShH1RPSztlfq4Icwu7o8kUoTF5fv1R+0YbsRqoVELQyKBllmoWk4PWPwVirfJuEifAyTqSoJa/igAyDcqiwuj6NGUZ4KIAxyZZ2TJnGX1LFAvLBQFawFgjcpeQ8pSqnKaM0UPJxGNNZpRFMgKf/jF01m5iKVvQ8UPnSxEKzLL8IWAr//V/av57/oJareH4Vvao/Z3C2SRhie//lPz0bPno+ePYdnz47k/+DNzcl44JrbkcIyOHucrBTaegVli7IOE8eMA71Vxnr9Y5xnFcWQ2ZRmLkP9CPWQ2kKrtD5e2GVatbijauoai5nYzTzlODOIuwuXeyYjcC9O4hjOL27Ojgx20qR2iyGhsxmOD5xwlY7fSGCpgKylMIZTRji5xzZR7c+68pACvbydkKr9fEDMXqzxmuV511FYyVimG/qGtb8LZTOLHhD7GVI5qEVgVBmYP3BINkWGRv1+tpP+Bqn3Zut0kX6JzztO3+XMkNMmpW9AXIvy1yi9Rw3lpfzdmLo0UesQfiD8QIXTPRCGDxzWmcY7pcu8D2+yHA89r4Qxu2F5CEiKWXMNaP28mLcjOBesSVJIfus6rkW7Bj+lyyIU6TPtdOoDh3R7uxK8bL9TWttoF9hy7wUi7+Qo3jPqO5MNwH3OaBiq4Xh6WBE/jv4TOhaZTVK9jRX/djGS+8zak6vuru4qdaTnF1JPyhYoSayIUpfwWJwgMgXCKwoi6wZZiSjVWZDrOUUkaZLxTv4K8RJ5//1o4zwWJBV5jo/gWXhC21YbV5t9i7QZtlpt6l3SQPdCNUD6b+ccMcBEbJjcFxwDzcyOcrFbJIVlRmcZZqHmk22WHtn7bDp8NnfvrNnqpdnhntnulxlSmmHvk+n2yVTiRM5w9rhwfBMEz1xgK+KpooQY1KmZokFLnCm1GNd7dABVQ5jnhRzvfTf3vpt7302zzhXnTcmyKSMkRHOUoYjjTNyfiNUefj35pgunuuG99ceaxB/qFFpmHPbIRZ3NpzBdPw5QlK7jttTlsaS8UT6o21JJ9hR1PUkQWZyHGKm8PWv+HpYp1gES4NIFp+Y4L/3VSgcH5PIkcQLXYNTC2/JboFOQE2hzK9Z0kXJ4pQT6Szl6epa9Q+0MzXC4moul5/R9TD+ox55OLm/Ltohm8ymojzE/VDu5pAxuJYP06gRkhHtxyVW6yCnQFIPcD+YCrFOjqFo4KXU571YGVYMctAg1pZgJZ4slb3oSqA1T43FASUIfdAKkks8cS6+AJ+8JSme/EpQ+PQIEOl+Yxcw1YNMUsBhUD7ItQj7SCQYeb1bkomGtW8ca93hdc5zSVNcifyUoneh0QWN5KsSxVKgyO7+VkoFNrq4EZ0xzaHmNjdOsRIyXOI1xDPmSpnJ/URTlGYpWYzgpgRjSKHN05ayi+66ALVicNgKiXymZ+2uluCytu9C8dT0+W+HElVOb0cEFPcnSC/BtKGWt7mF73/reagLT22Hd9g/wOOi5TKPavTFUKRQGd46YPhg4rRVWF8MpkadtMg2IK2zONFwbHDs2BVBCUwwxxRIZZ4yGOSjlemjfSfvMSpUdSh7QiumzVvW+dPhmKqAmC77rKZRH0L0EDn9VdE9JDEvKGBHcGcNRnhG+AsKYEIweh7rb7k3wexP8NkzwmhpU7fCdZoxOXbLPrug9z70N2VswY9tca2QY/TlOltM8AU4hXy5xBomQh+338wkZ43ETKFrKvLbKgFO0fgqcQowjEmMrsg+vbFdekubYpdV+QEQcwaK0nF2XzZIHbxHDsYnmEAss9s9tIM+w0gHuLfh7C/7egr+34O8t+HsL/t6C/9lb8PdW5WoyV20sVulrrmiCX2otgm1uzm5RNEY5n9OM/KZe/JrNuav0QsRIoWviOBsZXYWOdJHzGUGlBobO2m9Vi2iCLJnHEUNFy1pBh4wmWAdZNmYbiG6mmqIl+VbZvr0rola1uaw7Xk+JoMwWr1bSYCp2ejj8eaAOtDGBw0/D0tqgLTi60T3ObmWDGebDAxgmhMn/PiAezYc/rwc8EiaItUeouhe4xtMttA5zmzORoWsNiFVQB1p2xeJfyrwo/rXsMw7DUYZ5K+qulXF7YLgGcKaTXXtH1h5XJ9eqD1yunxOFyrqqtB87QetQUds6dnrsbY4YtvTlvLc6du9pRpRmMUltSuUaJsHIf6iKGZkp6n2s7K0+Bw4UXB5PLhyUG435ynKi8R+5xpqXmGnci5X54Nm4S5WseN9nJP3A2bidL3KtclXIW1V/qB3PtJsd2cG7KSP1BcB13k1DqA9gWMyhL9kOe48PYKjrFwUTi/JMuy7X8gM94a1UK3ysdirVNp/eDINYOlXn45NnHnbz4qy1iI1KCTs+plvnOjpWYKNX73cuiL9zvljOqhPqTBa/sOGB/acqX+oc9ENLdTY1/Qykugbx7yHV6YlwxPE0T64xd5Yl7PcchhdQ7oDSWlWvT98NCizL4n+dYwGIhXc0rJRpDgCz/oT1w6oqAlqV48iwUXVxjfKHAah3rUGPVdhs46FvTcVlRqgww5eeZdYglbsbZYQLN0BnMcawe99RbZqRUYaFwi9rFmP8NUcrMaO7r1jEyKHV9uj+2fiL8bOO8orD0ej+6y+HzW8FJBTHGWbs6395cnx6enV2ff3U0TDBKMbZSF4aQtNhSOlFDc5dd1BWL07IrZjMIaPRHebsUIkFy4y+Xx3KUmc0ulujwqR6V2RFPOZGTQ04ikndFLkQnVRdx04MnXtoKbKC9tFqf3T/Yvxs/Lx7L7t3bb8nBdTCKbBJK3z74ugTvDfue1a7Pl/LSJdh72W0D5r1+AcdNKv90f3z8R/Hz+rd1qzdOhzZsL9WBczESSlJ8SaUx72gU4x4nuHRDHHMvr7RJe++FmYGR2v8nmdopFj1kXn2Go29l+b1j7upUV6pHPthruvHJI1KIgg8rqaxunhbPKvrPoQfjqR+zD2KlOChq8+OMjwjjGcobM98nQW9qRLPnexhMay0dbJRKYH66MJ+14+sisOqWUAp8LI88SZ1wDtLdp9cnN9cXbx6dXb17vRq8vbsyr3YzofdAe168u7s/PTyYnJ+4waUp+T90eHhOpvnHu74cvLuzdUr92gmwKFS6tP+PCqKbhdCtRWrpLup7QNT+92qxt2AVpTaJinjqOHj0atgui3d+0FMLt5Nzq9vjuUW3vx42VXtHKccZ8uMMFwUsx92DXB2fvzy1dm74/PjVz/eTE48F3JYJwAewmLiMb/+lyeuc+PiT/IsqbXW2+5qrI6R3b5+xp8OPxpNUKM5ZFPnIDJy55RkMu5c+gm021+ES9AIjvWzDl4LU9lEZlu+xSDjGUVwBM3ulBvS5Vt2IMsmM8sL/9gqc/tBbC+GRflQDgu9bREbGQGapcbdCt+ypPjufCI8GuUeVo6G4fMDapWLc/IZqJSrZ/pT8xIyDGbBWbZfveAjrMvR+83qhZ+GZ667OzzNKX8Gp8izT32O01ZcEloantMYr+GGsJYDwqDuZeDcrJDTIAheu9PBB7mHgfcv3I7cbV3dkulfOTvQuJdPgnfEXdtA+yxLaX426H5wWrV1GlUe921Sp7WoksUzyL16jZbr+A4RFjGC4oX7BpveptUR/H0AAPDo/zu8Jekhm8u/yBR+gtF7OJxTxg+Z+MV0gJ//TUiHRoiP5hmlXDWEWsvhv/wfJWrgpAowZ9lhQiOU9ADt6+MdJBhyX5zXQNk3BMOujs2WU2KdjlOEFzTdxJ4uDn2IJd1jntXdO+H3NLW6em3ful6Msq5d3QKwzvS2YiC3cFjfNF4Bst5ObdscLqlxb0O4TcMBxBU6x1yI8pVAkS7TuISyllLa2fMDaaSDjVwiy0mCeYGgeHlGS8TnX//LE6UXend19u2764uT799dHt98V4GRkCmOVlE96eYyw9ecLqtfAuD3OKp/ByYBumA69FMjOIlRJP4/W8Aom8Khjdyhy8wX0ETMUCpPNauzkZZdse/t+ljX6nUo7fVmaPDMOY/2Qb9/8/Ls3fnF6dlu7IayrJA41+c+u6Ecu01p7idIIVpHtTIdWseGOaHsXznm7VDspn1sFybDhszJ8Z7Xrwa5Jwme1aLV1CdCS3RLEuIKi0exvCInx5fvXn9/fnEqrof44/rH63fHp68n5+KL8o+fK91l8qRLM/QZi1CCdHB4FYfd2l08FEyq8WJLNS3P0OT06fATUJNb5znMPFebxEe4gv3tTiHkzLEgbqAVLmBvoCpHOUuFPeHwlDAk8yTmXDxKkuSA9io5AJmXQUvqDQgZjqhgpmQuhiLUfSRTYslKe060r85eX7zxHYFhwyFo90Q4xoJz6+gf43v34DRmI9kw0PZkHtXGaqYYxzgGRlXYM0pXqj8Dhnm+FIeBxCrbasklAspwA5LIZoJmOjdKdGdS10qpbYGiOUkbiQkVprqfJMXDl3ZmKLf5UUAcCZGwZd6iTfdg31HGb+iJmZZ7OCV+2qoD36AV4bvWjuW3qlXtd6/Vz3k+xLSaVTGX7sOiwspP5XLSbDXowQa0juNh1N5peKvDNiwuMpVCYRB0n9bBw80wroeT75r1wqvXpvhPW2QUX0cDR9LJ1zTGR/Dsyy+/rPwaoAjrvlyts22dnVDM2IqYqjmonmb+enKq6rY19TSuPS3UHY/ghkKMOc4WJMWAOGR5KjP+qIR+CxqXiW4gZ5gdiK2VaYd0fiImwThMVod4OccLnKFkCDjl2QpQhjXVHA9AwJmkU3qRvlYSuOYllerZZP3VXzdWI5rjOBd5msoFGVQSqLxhOtunySepxX95MhXFVkWR89Tk49XGnwO4zblOVjkAWNB7Vc4epSpLpWBQZA1VHGHGULYa6124tLUQrRozt4e+flL//Ex/djhrnTtTzc3kPiGZSe8pUKYxXmdiVV2LPaXnYkr//wDtejTd+jkDAA==",
+	"openebs-operator-2.2.0.yaml":     "H4sIAAAAAAAC/+y9e3cbN5Yg/j8/xf3RM2u7R6Rsd9KT1nR6V5aUhBNb0kqys5lsVgGrQBKjYqFSQFFmpue7/w5e9QSqUHzYTjd5+nQsEri4eF3c930CZylGHMNbtEZwi9MVCTCcBgHNYj5ACXmPU0ZofAKrl4MHEocnppFps8QchYijkwFAjJb4BGiCYzxloyVaoxFNcIo4TfWvLEFB0WQwGo0GT+Acz0iM4YZGGPgCcUBRRB8ZqL6ExgxoDN9/xSChITsOcRLR9RLHnGmUzqKMcZwKABWc0ykKxijjC5qS3ySk8cNXbEzo8erlFHP00hv7NIswE41GgBLybUqzhJ3AT8M/DH8eAACkmNEsDbD8MqYhZsMjUP84TlL6Ya3brXA6LXX0gmaWTYJkavXlv8VqmP8e4w84EH+UVqf25/GMxCgiv+FU/pLiJCKBXJWAxjylUVT9hWEFAq8MMByHCSX6j4DGMzJfokTjFaS6/X/SqWqQ0lj+e+OpM444nmWRwSREeElj+dfGMM0fv2aUIwk1IkvCUxTPcQNsRBgXTR4RDxbeQ5B4nmLG1CbJs0djjqKEhijjlAXILPQKp5wEtl8C8ctMbA9mZB5LiL9mmPEdocg4TdEcBxEyeCbi0jCOY76iUbYUP5Gl9Re/xddtY5SwBeVj/IHjNEbRSI+sL6INt2pPtVCVr8SFbSAxx1xtZnk55CkU9E38K0tC/a/E/BjiCHNsQx8lRKAcC0LCWpANMsbp0nwVCkpGJMmq4Qd1BG14aXRyDC14afJkxwaGf6gNO/zDECxQArEL4x3BojQNSVwmr7aFijBi2LVr+W6Z1SlWolgdvWK2zQqXhImdSvGcMJ524bJCEQkRJ/H8EU8XlD4oWpapjvLELTPe0sA+i2JL26ZR3tqYxjcasXc3bySs4yXmKQmsY/je7oSGIWFplghsp1k4x9wKz3ocC5wNSVGv9GsSh8AXuM4lwCPhC/mDfMCvU7IiEZ5jNh48gbur86sTOFvg4AHIDEI8Q1nEAemuKGIUYoxDBpzCVDz/OMXNV12MTeL5nh53lk3/Ewdcv+9WLgegHYT5vcbfpDTCN3gmADc5lS6IZp9bpiq3prwoKEnYcc6qneePv2Ut5HAoIYKjwA7+DCBCUxzJlXH2AygIyXFAlwmNccxHPq1XBu9X41fjFwOW4EAMxXCEA05TNexSHMM3JTxaMOmHi2F0TuClGFUQDjxfq0H4OsEncIPVxZBfCRaJxPN38iqfQJxF0QCA42USiS8UsqV1BqiuXwfmfdexfS0BAMx6yn9XDvRlx1kGEDwhIjFOS8iP2tEHIEs0x9dZFF3TiATrE5jMLim/TjHD+hKV2uXjHy8LYCcF9uqT0JSXUFBo5Mhd05SfwJd/+vJPpRY4XlU7PIGr64vLi9e395Or++/fvb64P7u6/GbyLeAYTSPM5ITEfTOrBJyKMWIccPHP779iNXhTxHAINAa+IAzU6zCGO/GH+N88pikOgcwALxO+Htd6m3YsS8T0cAgzmlaRwCnoDYUX4y/Hr4DGjygNa3iYDbFPrzYqrFCU4RMYHi/oEh9n0yzm2fH4IZviYzWDYcuqfXV7//b09u7iZleLhsIwxYx9JquWT8+1aAvOk5Pj45f/+mr86qvxy1dfjf948tWLr1641uzy9O3F7fXp2QUkKV2REDP5RuZEFuhMrUMhoQFigOIaPByvSEpj+fsKpUSsfe02VGeTD1xppSfyTUqXJ7UfAGYER6F+p6y/XSO+OMlp2zifhGPutxc37ydnF/enZ2dX7y7vqitgTovhAcw6JDQExLadfW3ona+BIKnjJi11LMTb0x9P76+vzuWmNA/CjudeGW2/u19DdTIrX6azm4vTu4v784tvTt+9ubu/vbu6Of02p7mEwQxFTPJ6MVwlOL54fWs4wxrcspgKKA7NFwmlETySKIKYcphiUK90OG5dny7UbEt2AkOeZriFMk4ub+9O37zJYZ4JoPe316c3txf311dXbyDEgdz2xwUW/K2ZK0gpDFiCUoZBToktaBaFMK2vrxE/cChpBCQolRdHP6BAYsZRFCnWsLk3agqAWkaWiznFpZGOgMxgKLdqCIRXlztvZSXScuWAowfMAM9m4kGgcbQWADfaiyeS7GP5rIipdO1y55Y4dlpNtnurz27OrbcTCIOM4RA41a/kcUiY/EV0Ke9SbZBZSpdKB3t6PdFP2Rher/MdEyTj7Oac5RulgeGwBqllOc5uzmuNvQ/569Pbi/vzyU15hvkhkA87hCSVTPtaPsvmZNIYFpRxSBBf1M/KD0LYyylAgGJ5vTEIVRcRZ31GIszGcK7XQA4jAOWLcLxC6XEhrLQeCjMFx96XQbUshDpKd6c3317cCWgS7SnOF0W8D2S2lvslJi7QrUHjNO8gVoqhFYnnsgNbIDFtwd2KYzXF/BHjWP1EQgwBSuuPBJ3py6xUZOIlaV5JnB8juXpyaMIqq3esiEEn/axN32MxNeTONRX30tzRz2lhJXkUy6po28XleyBMaS4MbkI7IVS1EmBEA9sNl089NjQ3xFKLv/+tqq3qTvbr3yfvTxXgneyTmfC/kxWCW/XAX4tFjygKcQjTtaERm6zW5/g+VRZwO3r05urs9M31+/vvrm7vrk/vvtvtlhgFgelVZch2sj/H4r5En+Mu2ZbW5/7ICQ29zsDZ1eXdzdWbNxc395O3p99eOMAbyP9JVkgpSPzA31xcv5mcne4VtlPMOoHhH4e93hMfNCVNHhHG59wT2xId9B9AEP3+8N9++/au5yCj5XzZbybvr968e3vRdyzFHvQZzYxzdTkRw/oMtRzhD1I1k/Zfu4v/c311c3exp4G+u3hz7Qk7InH2YZRxEjEb8Ar1vbg8ff3m4v708vTNj3eTs1sgszKFAYbjkAGKabxe0oxBxtC8Ltsp87ro8i2l8wjDaYyiNSdBJ0dbH91bfnXLCBc393c/Xncs0QjHHKdJShjOtcbDgWOJcuzuryeX395PLu8ubt6fvml7o0JtZ4NnJIYFzVL2vAJdvFAJTgkNSQCJ4PH0GjIcc9tCjivdjThBGLz6YlH97S2JyTJbit9eLsbwI80kogGNha1eKWs4hUeMH6K1ePkY5sJMCC//9NVi0K5jdKzEwKpufPXForykEVnhGDN2ndIpripqhONH9RuAgC6XKA7rXwOM4DhjqXqijqckPhbq04BHloZakVr5RRq3UXSOI7S+xQGNQ3YCf3xRaaN2Jv/1T+VfU4xC8rudR93YVneJ6jSyjbTi0MfY5m0LGrFVkFvOStYScwJRQvS8krqxJEkppwGNTuDu7Fp/x1E6x7xiV6ma4xwmIIalBf50Jj0g1idwSWO8jX3SEBupNRW9+xgp7Z1bV9Wjy3bmStcAG2D1yQyX7jlstLbwyUyYbRhtbMc0QB++qgBu2DQ7DJRNU5sGDCWgn8baZkNk3xa3F9LaJv6/1eDmMO1uv3S7se7ucOV2ZuE1wC+vzi/2YzSS5rKYhrhhJPsMzZbSfmYMiE2LXfXJE8fGUCQ5laMaVOnJXN5tbdyZ0VTsswSplbaKVB8rzyswnp4WQ05MBUhJhYSPmpW5GOba8VydE1H6kCW7Oad/HN2cvfQ7q431dB3X2kT0v8Rcqj2qf0GEUYhTkK+vQI8wfevDsR2xNxen5xc39xdvLs7uJleXWoA6d6FlsYlU/4LrlAaYMQm+WG7JAQihgDCQzsVKxBEb/vJLCBYoRQHHDY2z3lggSjGdzFOcAM14kvH61t1SmGVRJIcNUPyU59stTSrSI0/1f/ZXPSR7Pj6FFM+zCKWAP4jBWFNLLQ+txF8fehxzkmLDiKuJLhArRDbSWG04jYMFTeGX//cLnOTAULwGxlOlkUccGEcpZwrVX6qv8S81eL+M//CLG5DApg4AZlSEECjl5G84pSC2hYqJLFC6F7mKLSxfjgLLlxwzDr+o7RkFMPx/NV5k/IfhL/C15Ox2LbFswH4bp+uOSI42JtwGwotd7Oy4G4bcPszGGPZizrdhwl14b7G2n44hz/EpglHcjLalcYPJ9uXhG8z4Z8KZ7PB18X5b9vSy7OBd2eRVqZ2SXwY9H5V6/9/Xq1LDfoevyv44Z31QDOpQXG8b91w06+Cfd8k775Zv7sEz9+OXG2S1RdFhoatt2ovfP2HdvcBXP7jlU2A7ukFEYx+5b7dS365lvl4SX195ryxrPXGJbi0edzXpsOxNN+gvRfaQIVskyCeH930f73tTbOz3vv8upcYa9juWGgtSIXZTaPBGIWEPoyWK0RynkGIhtYRGOTp4AhNet+mq2Fjym/KLE90liZli5cs9I5E8x1aT2pmE+xYlLaJpHC5HavwtDWolQGakJ5CFeDWS6AJhhnIATSFJyRKlazUVeFyQYFE4bhvaAZxCmsUCtISmJqsGYVoQioxumQGdmeWAkb7EJBXkYAkzCiQOoizEEpBYOfxB/q0OFjCcoFRuhjrhbADNDRurkU7gbwNQlr8p1oObczmCB7w+Kc27JnaKH6D6gwzQPyl7U2kgDCNx77gVkPnRDkw6YdegLVHqACV+6UCqsvS1yVKmVkmv6Ei1rQ1CmTy8+bLXGjUXAUxToYo/OsY8OF5QxtnR8ZRSPqyisMJxSFP7yOo3nwH1ETmB4dCCxNmbq3fnr3+8O9KOejUUhHOeHQHxy/bDR5QmR8chXh3PwhfqHyzV/0jRUv0jXI7UP5ah/mWq//FbOOzSZMnEELeYt1OL/qor1amLeOxHLWUAe4yuostvbaqmsul3S31TgZDXgvTTJT2pRzs03xzD4Qq6SmNAUQTCtJXGmGMm27NBiW1e0wweJVmOyAMGThU7lTvxSGdSRpdqKHYEDK3zkQtIml9YYeP8CohzQcDCIzlEoIYQKwd8QZnqL+l0lhOyJ/LLW30AxoMqO/gE8Hg+zmEUI0lMFLczglLuh2Pxw1wEhw+/Nrk8RuK7YQ5RrEzAIw1U/AZ/Ef8vN+mv/YCVcS/2ywHjBKxA+uoKBcG8xPyRpg8VWvNE/gLXkwrvH8gMA4IyZfIxJbdnt5M8U8HjQjv7X56/zcGYQBvRoOD5zc9ikOvJeWXoNvVl47S6hexG05MX4z+Py4wbSueNkOfR6usvLP7VM4x4luLRHHFL+JE6zvgR3r2bnAOK5jQlfLGsh8mNRmUw7Ovht9d3r4UNXHQbDhxjQn1MyZzLIec312flBR7Dnfm2ETP+RPVjgDj8+eXLL6WbEkxzYqAN72ZQwiDI0hTHXLiCx3AaJQuk3qQKUMusTq8nt00501edwXCQpYSvz2jM8Qde3Z/EZL0IT+q+5crG+1acembXh+Q8bPmzFB2UNkMyD8culq7Wj2VT1cuzvXDJu4qj9YnbJ14wfi3YpVl83Ghi+iYpDZZ5Co2W6YmG/VEL8aoLuguzKWK4EQlR7Vr26i+/amUwKlqmB6BG9I7FGyjnUoDEWsK4PH8LhBVhf/lrmCCm759ocm5SZNUgIuYfT/wJXTHEZCSBFw000jmykDEhwIf0UWqiJIHRmhEx9Zw8fzY+Lk+qbs15gOSj1LWUwsFkoKNMhKMjcazxzE+AKGVgrkE5AhlEXYHiHRato8K+mbzZMjTslvyGn03XHLPntTA3gVHbjGyo3E7+w+V//vLFv/7xX794+dWrLxpeYLelhY6z5RSnApXKwlTw6ESjLa7lxX5cs6Vyx/L9MA6Xw53ZbdR7ZGFhGs9DYHQwVWRblDCt74ZkqcSNqaLZ8ogoEebc3JtBsd2SuIJ8NOAZp4ACqUlVX8tTR2fy7RHfvhR/iNGfA4lVvGeDVMgQOPHgKBgjmedQ9FNqK8HNi6B7ldtt4PPKtU638dq5ptry0D2Rh128byXSQvQi4BAYVbLLI1bii14jTTF1BKpsUgGKUmy8I3EIiAtxixOTrKIRYdw6Td8NbXmTW+G3PdC1sa7Ss8IhuvMB9x5V9d6Fo424SJv72NR6d6o79+tR0xihDz4fzX/GgmW/dYNP5i1TMPcNhFrkTdO2IXD6ykA7jN8REpvl62O+TI4NniMWPozEmOvu1++LtsfvZfVpnCESZSm+W6SYLWgUnsDLNn7cLPoPp3dn3306R2WDxifJ8OPM3IQr10K8DnbW6jPIy1SyVt+cisBT1yqewLB5aeyu82dvLk4v313f//vV651Hl+453YyQmczkNso10y/VjNMm/jnYwd1W8GAZAmHwFyis4R+b+afDz8MVOE+zO/LPGYqS5ASKjjqdbheH0tnBwabsJC6uk9dxzqkfM9KyNBstz6fjRtz4NJiR+hna2LvMqiNHEaMRnXPKeIjTtKlCf1X76tVf/8fL34HLWoHI6fnbye2tcDz64eL1d1dX37c9Yq6LO/QE/c3p5M27G5Ey7c3k7EfHIN8gEg3/Xv2a+jsl1dba7ZRk90mqd/f2SdqBD9Zn4dZUW4DPKxhG5htIVtvFpNuBeAVteHTdjQDvGmgLLD+aWO/Gfas1hs8pVn1kyeO1YdB6Gaqe+yFg/RCwfghYXx7ybLtn/5npcz73lFh7TUr2dxMdcYiI2FL4qL3lvSMi6v1/XxERNez3Kjo0q4PlZYd0AIMMfjDVnc7zwmAV6QLFMeWqptTJQC2MCXgczXEsH8JpRqIQpxWec/Vi/Gr85wEobwZC4zuyxIyjZZJrsbRNn3GaJpRGgSo8xMb10l+5hIDCUCKIouuUCGp2JhwF4jyb2L/fXl0q2jxWfpbjBUYRX6wnQjscB7nLbIhZkBJZe+oE7ir+GLoHBCLNr8jxW++rsP7ODlhJCQI3419pw6o4BWEPzEq9urC7dg/gh2GIGUl7Yad7dGF2bgfcgVXObjQOkw2x0zkWGJ0ZTHRFqwoep3NcGVr7ns9VNavGCVTd9EHTt8cGPiKMf2/9+Q1h6tlKoixFkeXcy1/Zgqb8shgLYAQBSxRxEb5lgqA3Ow9AvSbpCr+LH2L6GH8jGBVWxKqwgIp5Xhp+LRxIR8xSUbb/+u8BgKk7R+MiJOn0evL+j7fBAi/RiW2961PVv8ogquaPKuQqrwY3LhL9JTjlBFfUzzk9y7+rjf1UIKfagCxtqJ8yTYdwCEwinvOWKdbPbtPoRGeAYqCy0tpYembjlJm4KZNfM8UBncfktxxy7q4ilAGM10i5Kimp+Ikj6aOzRGtIsRgDsrgETTZhY3hLUwwkntETENIaOzk+nhNuKLh4ljKRvfBY0mEyzThNRcHZFY6OGZmPUBosCMeB8Co+RgkZScRjScDHy/BJvuFPS5iqO6De2vxrecyd6y5OORAGSHdT+BfLa/La31zc3uWbLbeguuZytYturFh4sVAknuFU9lIGQcWlqBKvOkqZ4JpvLcumS8JZHqwMnI7hTL5jkm+SppRwDJMYztASR2eI4b0vu1hhNhJL2r3wdV1S0VCtVv51WZvTeSuF+6EJlhQdJd+KrM0qy2n2rvSl7a6KD8o+3OT0pPJLnUKXGgLSjqZC25XJIHPzS15lYyq9JmsQAYoc1VJkNHUSSpqqca2P4DyEaGDx13ZPSxF2caaa31eZEnd/2TJeX83sPwGMLG+gu1XtxJQ/CeKC6JzA/3v2f//lb6Pn//PZs59ejP788788+79j+Y8/PP+fz/9m/viX58+fPfvp+7ff3l1f/Eye/+2nOFs+qL/+9uwnfPGzJ5Dnz//nP1nR+TAqAqBGJOYjmo4U9tY9aBKaN3LlS2+KynvwQSZBRkujOGicHvsWKFnBet0LPMWND2nAxGUPcMLZcaW46rGKVhjpIUf5kKP84B0/tQxvvcPFNVPU6nDEPv4Ru9FrXz9kJN7okBmyNYbJDHLYhAFdEm50l6igUkdAuNFs2CFyCvoakJmShQkTUn1EAiKCjEre9pQvcPpIGBadUAxkmUR4afidkeKRQuso8gX/7K6G8ydB99ufGfGsscabJ/vJfwk1Is2YiWGUyhTSvJpF5Lw1QgCAcLy0XMgGLtYnWKADNJZI5DjoMS0r5cai/QEDIeKgG0RCXZnZ0qKG8nmlg0E8RSRUchJUtl5MxgoTZLe5UL/IyY6trRxraEErR8ncIKa9xVW+AsHFl5B0QAS59spZnEaORkkHtRWfaUSDh3Plqe5u1TG/NvbNiMqvi4EqE28FCeVlkahWvOoFIMYJzzhmlVUbt0L1WZfG6kgDY0fz2hq8rvY2J7CoS4nVAJ1QQc963Nmy8+0rPgFKUCDyyPeb1JnuZmZjwGw4I5jIx4CtGcdLmOMYp43gIcfxXyIumYE/feG5LO2MQwmxNyR+6Lks56qXWZUQryASf4trWjm4u9tC8053YTqqH+NB9/AOLq/eDKUpWg82Ra+CGBu0DdSCTzsmtsD6TblTj72pnInL0tjmYCjHCh02VM4nxqn2IHEMjeRUiic3V6eSeD6GG73YysA32GAhBVCVEsjjab3OGxcHXlchE7iph1VNEiVJRNy7S/NHzDAR48FmNLtNet+NHN9yHZSEv4FE7ynb93283PL+ZgffS0BrCmF+FNdLaPtsxbetBLndaA068CpO8ifVH/R+Y9p0Codj/Hs4xr6aiS5W1UmS23QUXWfAocHo0lZ0gO2ty9B6iw6wv4ML69FIYKBdOTyf6KdnRZfCyaYMCK4Sdf1hNBq0Hcxie+lsBteUMSJAyeVncALRb0fil6cdE2y93BUlxZ1o7zfN83o/w1cpoVaMPN4GryQlVKbWiRBj7aJsldGr9xN3Ij/ihrlTbBxpk21ynmgygwjPuPKTPDKlAi3jMA09bLsaz24xttqAxi7AzwUGU8oXLVBRig1+KjOwggGqKK8PZp7MnMfOpT152r0xtDmtOLCwh7ffg3AfWNgDC3tgYcucz4GF/TtgYVOaJ7WQS+zLx95cVfspBk9/8yzBaSBWdY5lpMnzrnex0APKjEoy0epSKM/kYaoN9eyfn+tz2gKWzhQ8kxhV+prJJKwqA5r8UWosdfltMbAYt23bL6/uLk7gpRUrMYIG9fLFCzWI9uDW/GILYINlRYVaZLQEUmhX9RhfffnPY3jVhmwDQXmqczgv4C9fN9v85esWiC9fvBg/3Q2P2E6/+YIED7nXrOeRvKt0qqQ0JcFDRcGcy1gtkx2NKvSnnGTcNakppRFGsWtSNMJp2XW7e0ZFj6OKoHJUaJkTGj5lZdjjwcY2z4Y/cUJDKQiVMJHigk5nLOUk9UuHIQjFa+BIeQnWohx4SpIIw18e8PpI+WXi2QwH/K8qeWM7EVzgItYjzyjzF/Ovv7ZdD1+rqcLmpNPKVlq6C9kFSBySQCyNmqecvoIGnCq8uy2gF0JugyVGMVNdBMdYAcbG8IPK6WhORydQzXQaXQFKMVxS4VIcZhE+gusUz3BafAMo7rZgXtKLDzjIeOe76c2QiUzzfZb9e5xbcdX6POC1Om/yq/wIdy9PrgYYt67/A14z+RZ02/3lbIAwI4fnh3WZMQ5TDBcfCOPs30w85nJKYj9kFWqclrAz2xqH8k+J5q42xSDea2eudKeSYzEggddTpuqCCMK1IEnnZLV9TfFn8F54x+cYqXOs1lHO/eLXDEXdN+y8ROVVFwNEbNevGVmhCMfyzj6SKAxQ6mXON07mJqkiktQ0QHFOMouTxLoZbgCk8kkGMoZM0JU5Tdc729fiepgwpj4bfFfvXd5p+VbJCKnOOdIZyMyR1QsLz1RGY3NX6MzQ0ZzodNO8Eusv/deFYbkU6/y8eMkKCtJ9eIogSCmxEKbS7WJ+pLMwm+upYHtRCjm0OEJ4hVN4FlIJU3gV8Odj+A+cUnm8YzxHnKyMxrcTrGH8uPJXA8TgBTyTYIEslzgkiONo/dzoypQPy3iwO8cVf9FdTqnXCXwvelTJvwTSl/bnrAk1lL2g1YRpynBUUCG3a2D1M81VsMVB/E9xnlEeF6pu6Y5utJd82Itlb3OOeUwJx2eCKexrKfih2dPsogQKgfhtJ2aDLg+eUdPcsYnnSbEWvdw7f7B0c65Ei1fgwXPz4Ll58Nw8eG4ePDf90duN56bHa9qGbBuataeJWZuUfUMHPbB3IdVpZK+dO5fl22Fhl9KPhewatRbLM3GIL5gM1lA3u4IYIF60ikQEaAOi4cptSTNaD3rqFT6pZ94zhLK5Qy77tOFDCzCI11xWqzU+LKBVvibBR3AcA6dwdns9KQE5RGYeIjMtGsJDZObhiB0iM+uf31lkZovhydfcZF40u8mpgedkVv65+VxpTUj/Z8kntLOv4UrQAttKb2SuqpikLFC9jFTtYmGbQWpTM5QycwzaLAy+xqeacckB08fk1GVS6iC/TvPRRkajzsAff1ORwxTkWv0OA1G7Aci1/K1moY6FbTcBbWT4qRp2HEh7mXvc5hwHVKeRx8+I07LAuWmnj+mmY+09zTSbGGfKxpdOFwYfk0zN5OKA6WeIsRtaHCBbzS/t5hUHRJfRxduo4oDrYWrp1i91MastxpRNTChlE4ljWj0MJzbDiANqD3NJ6y1q4aFaxV67nsQKTeXt808GJZvXr6XKYAZohYhECuiU4XRl4bkkiTm7vT57mtc6lihUSKmLqxAkgXRzh2dluCqh3/XZJvxZffJnZvyaVJEPpKZmE/c4IAhwKs4qyCRkfXmpCDF+l6KYEZPG0OOevEGM55SyWD7gOSAcqvxoNMb6KDgJKqBYnvv2yx8ijkdiyE3eDDFJVbHGc4J38vAVkySsNMtHxPKkbfvDeYkZE0nnu5E9hUW2RLH035S3RHc1fK8sAKxtN2hKM96SFaXYwY0e5xQjRmMPnO8WWDfOrWf5Aj9leu23w6VJgRy43OYl8CtoHMnDS2dwlwoG6BsUMXwEOpvlZqyL0yhcXZt1kpOXAp0NRmzXpzuvpVLr7PnRAKindO3QMFcb5wa8PN+rkJufsec6w7N5WW3U0GSiHQ/s15bE/I+v+s/Qxn7U0/22zrGewrdjjijFBv5HmIktRXDrbGwpf3PWqjErk3t8YHnh5K10vel7ma71qOsMrueKkjo5m/eVZtX6AoYKC5KXJfMUhX5ZLDl9p1q3rvhkBqdF05I3vpi5cscvG1FEA41EU0gWYy4RJwGKorXMlE4zLp2YlyjOUASM44YRzu0Dru+6zxU3Z0Qvd16P+REp4VSjDMWrwVOL20USoTgH4mvtsj8ZtsdCZA8rmDT1eqRCtgxIRJBmhsh8jsWMHhc4thq79LJIucqccDNvcb2lUatpJW7j6DSYky5NtmFma6tNZ/n5GPR8bUKc4DgUvLs+gWEnEueNLqWFNZflcYEFeygVCsGCRGGK7U5syl1HYy/Xzxxv4DRf63ymqUN+bo9k6GYlG5xy0Tynf4Z11odHcJQCMBi+crAZU9lpf2/dwBaOszKnt4a9ZICcrOcMGF1iwGlKU6BBkKVs0Jt9cTGTtfho0cwsLQq4IE813lKhIR+PvljITp1ICLKQb28ut1UpQr+RHeyW5esmmzeS2uiBtUdREOFl8VdesUAlo5c/gS7JhcPKcdKBWvq7gmKKUvkJx+FlPS/9cFhJMy//LEnc8NPPAwUVh+8NNuLL338BCWL4nt1UkBBJEBaUlYv6VPlHyrhEQWftzPGAHBFg2roQVotHVKE2TqWtIoNxNhuLSD1XOYbCHqqPDchc/5KhKPu25OVUphjHkCzWTHEe+YkWJpUA8Rrep7VvjVtMUZy831RmKcbdU8lUOSDRWE+nUFCVJlXB9JsCcj+UOOUosuIkfmhipRAicRMF4y3YBw1B16/iaG1DYCIebmHxYsU+VmNXK8PfVEE1X9mOwiQ3uhbiwPoE5GrDmI4l4VWNGyWN6oty7RzAry6JFsB6Iaf7+CP5nXWQLgQFuShyAI5dnt0uXCWfojUR6lFTF7iBXQnWhvcvWSCGPY6YYZHVAhrEGgjdlhUb7QhsV8fFyLX7KuRSgW+p5GJ+d5dyIZWyNo5aLsRVy4UU438GxVzMbCvVXCSuUHnkDpVcDpVc4FDJZY+VXOpp48lMmhmU+iB34i1TkMadbFNjtOeF3zIjvNW2784F72PO6xdF5ETCkSKj3XzXHTPkHw3lHSdkpuYACjuKEOoZM9UVFbRRPJBHtI9XkIhPhE//2B4P7KB/VI9vPI9fJI9HDM/Oo3e8svD1ColpidXZKp95u4muNSZng3gWoz1oN0PpRvU7UXj2lkuDOOMeO4uUODepLcO7n397y/7vI5/7FpncW4vJ2LO3b5m3XfOU9qAjR8b2NkrclaV9yzpr9hu1cWb2Tg1516PTnsSyX96/zox//rn+PLP8fYb5/TbM7LeX1JSfUVLKzlelKxHl4Sh+mqPYN0LI4zD2jhJqSYDmnVLSGinkBOyRTPJzvUqtP3fkN98is3lnGFfvnObt1nmfPOa7yGDeioVX1vIt8pW7U3BsmKl8k3zk1vF1jvKtMpFvZ95Pvbm0XZTChWo53NZc4wem7MCUHZiyw1E8MGUHpsznZ5+M3dvk6lbviSu0Y4Ms3SYXtxVk//zc5SzcVpD9M3NX8m9bYfbJyd1AwJ1EoDMbtzPntic35KKN3Rm295Nb2zOrdrsXakcm7f3k0G6xJO0jb/bmGbNb4nf758rutkJ15cfeS0oC2FNaAthRagLwM8O0ZrjeR5oC2CpVQQtQ73zWtXQFbfvQN5O1x4J3Z6/eJn1By2T6ZKwupzBoAemZq9qSxqBt0TuyVPfNQu2xJz0yT2+T1qDtRvTONu3BfkKfPNPl9AatuU+9Mkw3Uxy0wPTJLW1Jc9ACcaus0n7mdx/BryOH9DapDzqo60bpD9qI66YZoztvX4cc4slk2o37njmhd54NumXSbn+D7tzPzqXyyfe840zPB7+sg1/WwS9r86fh4Jf1KfyyNsiTYEekNRuyMw/yJol6dLwzMUE+2h6pemG2G49f1y2037yqDjcovi7R9SIuoIcrk4joOhlYNREuPbyPBr5T9151kXJGdOXaGjTjOAX8IYiy0A6x8PSWzKyk6QlKiVWd8Fkp/Xur+2WA3CfftdtsWdqy/EyS2CT5lhdWbsS8eWP/HvZBKIc/o8tjYkjVuj8Tt4Bwll+L5yblqsv7onTRhEJpTeJ52ZPjCDAPxuOjv7tt/G3WrVH+j29uDTE+5SpkBbOCE0XhSrwAoTQrpctmNJT5yGRU2lG1RsU3cQKgc7Hh75ibeegwqPqaU0c+jEw1DUSBW56ooAiNlgTeGPuGeh7ReujmWmnMsqVxvtBuL7mO0BjddWqNscpmpqA6QepXRqpoFIJa5ZOnxcrPvhwooAnBrLQpbvF9Tlbi9iD4NUMxV84ulOFU6wxLKyHvauGsHDiSK5sPw3gM39FHoeNRmbEpZkBi8S6ad7O8VHm0p0uw+dys371vbxf/PCrfkn5m1zbNQSluvvx1OXa9/H1mG3wkSI+vxmGLjI0TmXzA0JnN0jdeT/KUjVJB05a2cQLiOA8sl/iQtvGQtvGQtrH+OaRt/GhpG51hYtV8Ez5JC03bGimULwAE5n3XgOG9SHLTRDUHooVdpxDflvCvJYXhArH20LenyndBtDP0W6tedTy69KU6ggWZL0ay0hKwbLlEaVOsLiWnMA+CmpQKsHrqGwxnyYDim3bRb0+69qKWnGTHe2ISzLTOyaSOAaLcqLSrlPrOmsfN5T3j0qbZ07iYX91ZaEyLWp4c2G0OSS0x6KwhBsj4kDrykDrykDrykDrykDrykDrSh+X7x0kd+TvPHbmSLJlytd9R7shaVkG9Ex451xQuuX5264yCvrne9LgVv+qK6PMRc70pFlmzfvtI9qYGUHkcbMneyr/bk71Vjow72dsqsOV6K3f+9LneypO15HozB1L9bkY1SUYGAAAAAIhBIG90YYi2gJfvkA7akhRfnY14XhlsUDwrkRgkB8kgIg8YQpxEdC1CbI6ArQJpGjrknjvknjvkntso91ySTSPCFs5Nula/FwZHMb/8bnKqo0zEfZSHX9/iui+5ytozBjLGY5kJaBKWb26btBTL1iddqYUm5wWOelJduNZAQoG7wddPIN0wqZ+hNaiZ189FfxUDWSu3WlKZVLvZyuH29QryC6J12nq7rbwd9t3PyEzY00Bo96eqBQ3kYpDeKaPKzOIQp9IRoqk5KG3x2NuGx0qs1azdjlfmwmawQArTkn+BspDonGRnBeSzCJGlrRy+5hck/e2V7sr6eFuxPr2elJUjSoE2w0J30jtxhgyikIxsZ6zrZKaGSeVbRQFBQnCAK3yATJYrI1jFlxaQYOJDVXtdqVghV+IdBG0DpHg9yWsff0utec4AAJSgx5gScjW7lAULUCpqQUakLDxeopjMMOPjIpfXT69+HltBfkNTwB+Q0ssTtcr56ysXW6kr5ULk8HQC+oEzAEhP+FFOlKMHDFRPNMOS6TuBocywXaD4X4K3/2+708YzdTCHoslQIZYzWGXf5hyajmwzekBnFjQsXuPnQFMx95iWAMTafToPAGwg/NOrnx3YVtcJSBziD/BKmQCkt0n4XHiVCOjrmKMP8kItKMOxDIm2Y0thgVZY6XYecRTlkeiPaK00Hmq7lK+IiLGqsq5WqHdX51cnCitxhOaxCQ+akRgpl5hqEBLLJDTgFIIFiud2I6+cKYZZJhifce9kO3Ues4XXrBOGT8SxeU0r9knY8/SydJ5bp9WWZ4CucLoi+PH4kaYPJJ6PxEEcqZPAjqUUffxE/mejWUjB1W8qsunHmI8Yhx0/3TStj++r9PRWc3b1njJkUsbTaYmyoJ52D2MUKpKL4vXej61YSGl2CNYjrXMboTgU/2aEcfF975XLiMclfTc5/ziHOSO9b6QPa3Urt9iXu1Ktq0FF6pBo7k7ciP/FYpTcLiiPkcWUICKdkQ51HoN6JUzw5IxEEdZpM8hMB3MiCCIpJ2i13NjbNE4jEqw70qOKJsV81CRy4SYXXxQsqXtE6RxbUrzGYV6vowe3mHjnl9DAT2dS77y2mmBzGaqsiLRTAwYxVgLmFENImLpzOAQUpJSVKrZUK0T4zwxMkNct+Q2fDHxDu0TrPNOuckVUYIDJX2JYZhEX2R3agti+fPkKpmuOWQmo4XNYKQxLwtS31y6dFJ8700t2CnLFiuISxKENcAlQUa1IO5K7/V8ZBVIqSSr3FfFckNbHXlr2lK+ujoMu0GmLFdZwGBAGX8D3clnGcBqvIaGPqsDmKynllFatJar+5auvNBAgTDH2rjVr8/ioXl63qA31g+91lHZ0WfpeGEuxEbdzssgkFBqnH1VggNOERnS+bl0rl/W427W3tiq9syqp7h5GU9lOsBFl1UG10kJJw+Ugm7Xl34D8dOTmBJvz4m+1hJwomtOU8MUy/xYzSLOYAZ05YAL8hlPqzMLRGRrw24pGP9D0Aad+4b+TK926vNpF9Vy+EBZ0HcqLVXYG96lUimWYXLHN0Pc5QSpUeUY90nJVO4DI+1R5mwr9Dp3VjTGWkZlIBiK9mVRbIKzsvAU01hyuZHnUIB5QAYvcBU+3SIlUn2g9T0dztq6UDt1UrevaqHj29qjsRo75S6RGbWBdqjfYspKV9VTLyTbP2dBOCAFG+Qw3SfnQFhasuMPulFuymTeFlO+KHVUJCEz02jbUEvV5YK9pWDyulZRhJiOsxkwmDnNAzMd0Z2PqQlq1kdpMHJ5n4kToJFQknk9UPhn1tcpL0/IUWJ8DUw4zVSKKGgo4zX8QE2QtEEE0FnYZTYAZ4oTNVKIXs+KAP5j3pqSOawU6XZfUVkcwzTgQLk2HwYJShrUxSI25IjRCbUQfAJRTfApLmuYccQktxXcWIAmDJWW8FWK+L7l/aqwKe8o/5lK/zrhwkBYDPmIyX3B21ApSmuIETyvyIJbQWWLMZfpXgzrLD4EhBkKd3L4Cz8ztMSbUo5yQ1E+WdevaUReWw+dHeaJkJOc9XQPhMnOONBunNJur1cGRB8J0VjoEKrovFPHeMFSLOTQaZDGUVn3HNMStQBdI6m+ZSZ1UyqoX0DTFLBH+VfEckoIE3OF0+W/tl0AP/Yw9L46A8JI3J8CQDXGqipMzbs305HxanddZHzKpNy4Oi5pjCD/IX3F4XZ1Z6xDa8qLylKEwxCEkOB2po0lhRuLQMq8OkHqlOm5XN2XULavT6Wre4ExMGttTAak4+RynyyNAjNGAdCQmMZ9856snSe3KuBOA/5QBQNXpcZUJ8pj4qYIAv2Y4XYNQ0QEChnnZj7njztfsBYRBgJh6MsZeHfvNGEz+youCKPn2q02+DgYIAyS9ztrkHscm6LJINK3Q4rEOFvOkztUPSjGcXp63U4je9MLjNNim0gMmqIXMoZj8Slr7KH2hjgD1giiSnCrqHxcJ1SRgxUKyjsSLVogSoPbN6tG3/4HNR+zXobY9D0XyT7VPJvlnT6Dqpcq3p6geMe4JqFNGcX268296LAd1Z+XsCRYaWTxzIqhPh8rY2RtsNcPnJD6CS8rFf4pkn71hnlPMLimXED7ahqll2Gq7FAhJGmIlWKqK+L1xKfCxplicxEBTvc4bXQwFW6Nosn/GNB7JBI3j3jCdaSCBppXd3CG6ClVpd+oNVEFS7rSRcG2GUEoGyh1UpHolQW+gS5zOMSQypXPPzr3ftC3Pervyw/3pUsjYPyNBsXr2MEdp0HdKTnXPrtZBslRvZPFI/2XoVw9kRzvd5AQV2pJAwRIlQGfwX3lu9f/uwx8gkgrrk4wwiHAZivGpKQ3YA7DAqpHgGcVGkBYY13naPjTlUTgvieNYOAoNH/B6eFSnXz1gDifx8Eh7INVoVc4ROr2lHCAllGGTtd6EYe59L3p2KJxaNpDOis4lw65SWRQ/Dfyfioq0WOL74JnRgKC52BH+/N88oYqwLaCpem+U6KTyUA+Nj9xTVuA6HOyc3ve++/1omjFWfo/XG+zfnV6DchlgOopoIHXez4yW4bnnXFXEbRVEzMkoh1PoHjoVtjWSbSI6qidEEys/ba3tzB9po0gJZemjqFwekTQyxr47B0Bjo/JVpEoSAqPgkqirFfB/UUv7W9ICIu10uVaDGYOZJ0zRj85KMpb0rAnly6LnayovlIb3BK4dO00RsMHO70gfLmbUawI9KKdSlvVU4qlOJWWdOgyV813RyQ08nvKqWvHIXIlUuMPAy5Eoy9MJxsdFpI+zSN+tGtWn0dleLeVgB7vpQ25dBogtTVta7jLUscoq5DTNWJlaJ6uNDzqjxFK5LpXML8JryRQSCpUVzZ4bofhMC7tbCDQ2bvCmjJYT81agjVlpzAOMGNZuPQZ7usQq61orRC36iWlhswPwDI/nYwgzrCMraKipr4q/e37UaSbRCb+FLY+m8j9iYXkqSKJy589k4hRVQKJLtScQkM5dhDO9hD/obC0plttm/Olym9NRJ9BIiegzCZBVKYfEEgWL+sUSY7UClUGjTL4JR8rQJzPj4nTJctldWU/Jbg1D50VMG9aV2EIGz8RTHYmjQhnOKeWgg0A1+ASlPVthY4pTjl45G/CMPX/eCVOWMPJjl9ypR8qfDjbJypoMup6bOuPiwZJ0PXWaYYG/lN7Sv27NjKhbmbMhxZYTP6bLX8fd0wS1H/PTBqYn/xnCxiYnf3OT11kxH09TUyc1Kj49pOZe4pm3eamPVqHbtJSbi/xhtpiVNjQVbWIm6mki8jMP9TaNbWka2kgft4lJaC/moD2YgjzMQBubdTZa7P7mnP2YcvZhxvEw4bgqxraB3LX5xsd009sEAR1mm03NMBuYYDY6l5uYHPqbXfqZXHqbWzZUKfvOubeJZRvzSu9d3JNZZS8mlT2aU7xNKcpA4q1Z7DKj5MYRT4jbmlB6nfUejfuYTfZiMtm9uWTXphJvmtzrDvvTol6mkX2ZRXZvEulhDikbOnwcQ71MIYVGYeBLZlrMIDYDh9fpd2gdnMaNgR9r4jR/9DZseJ9qfy25rzFjZ6rvTkAo+3CTZxT0CVk5LXXI3c2NO36uUbEnrCp/dJVeGZMzKqJcgJEQQ4DSIsUK26ZAZkRENreTwS6Zl47yRvlme5tZPEsdAcDnWD0HADaroWM9X0/fyB2rJdNfog9kmS1LNYz0keu4mPlB1Ze+b0qJSizZ8RLFaI5HeuhRDn6Un1VrXpFet9rkHTwc2d/Pkb3Re1Y/tCTe6tCmeczIZAb5GIQBXRIuk6rRtJxtqyPoi5uEBww4BX3NyCyPRMMfRNgo4dG6FIjYCrIoF67EGlm2Y2myo+aJpyT3UL56rUA/x2vZ2STKNgs1Z1gH+ToCzVGKQWezcc5AZeVbYWHjcB5an6LG3bd/SWPSokGt5qBXbQHHIu08A5pCSJj6dymyFX9IaMod4wGAZAgClG6YzAEqRXpPBrsgqH2rEl6WMKjoz/Umm7Q9ol5bW84QxQ5r3l3sKJT5Jm3qFwy+CTZrSRHocaaTlFBRyvYsQoy1xbHXCuPUelUCm8sybqtPRGlmkxlEeMaVWHsEMc0xg0AMAoRpsOHGySLk1TnHiT3xY2OS/ztvXtxgCUKlwkEcyO3Z7cSV5ch8lMCjWFQd2junYgMnV7pwkPQ5sGQp7jE1nQ1AIP4NCnxv7029V9noYbixnGi1cDMSTImaaU4MuEyiGOscgQVB2Cv9SnuJOn3kHJi6JbqGnJO/SwfJ5h+JTTxINgfJ5iDZHCSb35Vkw2mEU8TbPJKqqvei/dE2OW1K4w42Mk80slYIXlLwnCUUgTBdGUBxJHrQVg02itcg1p7rnCmlzB48lR6gf8lNh0equPdfIWPtlCQ3L0r9vDHe/cX866/jlrQ9PnRS4dHDm/NCdjA1cnORTUxbwQJOFcZdinSliFeGKNlBOaSWQDHtTOt5N8G8eYWVEsMl1V7d+AiuZX6Q4hsPN61Lqry/8dhDL9/5LnT6WFWW+vvCq0qtStmrqnQPuhal8KRqXfMHvM79YTpAan8vLXjlx9L4kSgnlX8zBaCXU52OtgOqQovTEmZmI3VlfYniLjbCz+WrshtXbieviuNWp+2ysKNrh66ak1bJM+vi1wxF4w6I56UnTnUwIBoeBo8kCgOUdh16mdVUkilgVEtokkoGKM5JYXFyWHfOEpVPngSi6BUIujGn6XonO1lcg1ssStP1SllU79vIsIdTIn2ZuzbVFIUssIFnSog3d4LODH3MSUoXNSvxGpUU+kQFpzwvXqWCRnQdltdrwxId6fTPslAp5kcmIZW+hAqyBy2Qw4ojg1c4hWchlRBl9MTzMfwHTqk8yjGeK0d9daU7XdOV5ovrKiGIwQt4JoECWS5xSBDH0fq5sRaqoI72yftoCspHr1smkFPpcd7ei/ZVki5B1I+On7WcU0OtLR6CRwWVKfk6KILtfez+U5xdBCmWxer0XdzBrfVmP10W7M6a6pafnKnA7eW766RA5lFEUbX8kmbHxdGyFgkuSvZUqzBVchMnOFUp9POU1FogboCcriFjOB2XsCy4PEApFncj40KV3qfssKPME/QSu1vFbT8x20O8/szE6g3E6c4SUOagmW0pMu92JJ5XkrNxvTXnVKb2V/RBMCiKvSJSSFSBeKYsnKMOS7VamiziXC+/KMoRsAXlR2q0UI/NxhvmLz4TugPfNNiysXX9qmXP3CsnFeriNWR5+GSpoJ+sv5gCjYtLywIUid+PQ/roKGedFKKu9I0Uljv5j+IXE9ahi3S2rJb91ridi0b1arHln8pr7Ec6XTX7rYOMdFUIVwX/zhJ5jTLuthLuNdps6q+XMjgfK0B5Felmit8KIT+ChCaZOubGASmvLDw+1Mr7bGrl+RXI610Kz9S0Phl4qZLai+aeGWiFg7QpiK+q5LmKmdOZOqeuTN5J6xssqtBfp3TqrG5vqW+vJJdHWed5ikNz8BX6dk7Pp5h9Jx8ocL1LUcyIKQTdC+EKmsBzQLqeINAYV4tV1z9SeSsZ3T1OsqUof21m34lK/KNmJX6lZBMHunqAxPK5ZpYvxngTnN2F+52l+xFMU4JnRdVbDV0pDnL7wMCtNZ0hEmXpRmssf+7G9ky/EMXVrN01/Q5Iiaql0CYAAFg4dsJgilU2bCbLLnOdBKA0opZpHTB1/ZSnNxLALUcpx+FTwDzovyxu1gBgZE6X9Te1/c5nZtCDnXNJcLIAfbsMJlq43njZvVYZXpHdHnWr7PUxGkUX6qUichxM4QVWqbzgrrRiLbjgeFs6643Vl9S6B7ru6bkiG05+6n2lmWLTQq1PMiRHsFBZMk9RJS7A9RahjNN3qnXr+k5mcFo0tVYQUjXf1CMvGmgkmtpLMaZg+wKZuEMEIAgiKSjLEsWCVWAcJ2zg6yCma6G2on+u2hhljl5u7baF4REphatGGWha5imbD3uE4hyI7zFussoudlkkxVB4qj4qx4EwLZKI6OJXptKpONGxVZmhl0XKLeYmmHmb/DSsWfOjVfWgwJwMPMl3bbVLqRr61h4McYLjUNxqfQK7SxGeN7qUFtZclscFFlyFVI5LYTnFDhFxBijHXq6fOd7Aab7W+UxdaUfcp9iwWe+kHOtisRoMVtE8V1QajksfnkfEJGAwIvJgM/5JRKUJdsepKmndwBbmquptqtopRmUh+Cxo8lkzpQrBaUpToEGQpax/GVAX4+RgmyqSjPzSyKoKDZeQ0IqF7NSJhCAL+fbKLk2KsJNKmJavm7zJSNp3y39WOfdR7TEbWGHrNieweln8pUnOSFXOVT8BADCcrnBYOXiCFxTnSX1X0FZRMjvhOJSlaE8GeXVhGKo4ySTKUhTpP3OZhJ3ATz8PFFQcvjfYiC8Ho9FoUKpiDigh+APHsWxiCrauXk4xRy8HaqyzjHG6NG6HMqGSHGZglHcS0zimvOyQUWgwRnMcj4X0Pc1IFOJUjpAv2Ivxq/GfBfa6EqK4/IyjZXIi7+hAFx5W/LJidUy5zrH8bkwTHOOpQHwg9lIMP09plpxA43cFrLKSJU7uulAeiTDV7+2/vyGMVxbfgtkAAIAtaMpLOwcwgmCVqN9ILM0azd5ifMnzpSv8Ln6I6WP8jSxCcgIzFDE8AGABFacvr08cDqCky1ODiUmfXk/e/1G4GyzRycByExtTK+QlQDUjQ5o7b9O0ps/K+c9qeSrb02uvn1917hJYqzY6SrTy7GLlU75EeYGcnEVuUo5KBXG4FUuaslIB+xVOuaQ885j8lkPOa7PLRDjVd0/mMYtRZCzUKA5lFrcUizEgi0vQTJKPPRdHztf96aCDWtYLk1sKkqsCFlwp8QX+xfKa2OCbi9u7fLOb7vJ5+X3djRULLxaKxLLGljQpp3QpIeI4lAn55B8Wt3KWTZeqAJp2yRPGx7O8RqtmBMYwiQs1wKcqpW5d+DKhbH20DP2yP5wJDvIrUb+fYqLyvNdND5aAV05LdRHr7auXuI2H9rNp9q/WakUCAAC2LWvsmgl0lTPeppSxy4FjizLGwnHQXZN5pyWM91S+eOPSxaJEsRWiR9nirny07dYOj1LFn7qmt3eJ4n7lidvEvDZDYHtJYqf2zlGKeD9liF20plVx0F56eKuyw+7ywq2iV0dZ4f2UFG4tJ9xfXussIbx9+eCiVHAD4w1LB3eUCfYx4fUvD+wy0YGTkrQd566SwFuUA26lbNBWCngLE4Oz/O8G9gJ7yd+ty/26ogq7Sv22bSPyfZj6lfdtL+PbhhDsrnzvhqV78/K8LUGQvcr21krzOsH6ley1lOV1Quwu1+suydsye3ep3vZyvE6Q3WV6+5Ti7aotXS3R61+Gt3VFTKr0XiV4nRBLpXn3UH53x6V3+wYv7brk7ibldjsL6vrFIvUqpLuXIrpbFNA9ZC2vfg5Zyz1vtMduH7KWbx5R17K8h6zlh6zlnp9D1vJD1vJD1nLf1oes5Yes5Yes5duf9UPW8kPW8kPW8kPW8n+QrOU+pVf3WnZ165Kr/uVW/VIM+GxFnxKrneVVt47M31VJ1X2UU92slKo7ZAc2K6PqUdS6RwlVa5lUJ+DO8qnuEqnuM+MundpeHtUJ0V02tas0qhOkq2RqZ1lU9x55lEvto8zfUZnUjUqkyverFWiv8qilH1uAepdG7Ve1vUel9mrh00EHj9NWEtVZ9HTg87xvaTfpYVDYwJgAJO6soOtvSPCbEWxkQOhrPMjVtp2QwcNw4CsFeoshfUwBJRW/nxjaYQZoqPe9oG5nAuir/u+h+vdX+/dbRqim19uXjqivqt9Xzd8nb13xcar4m2p7b5i7Ue/3Xth+an1vlX5Puxd0qfNLOiz/Y+lW5dfH8obpUOPbVfPeUG0qfKta3hviVur7nqr73meur/q6n7reX1XfS02/gdrSZ369VPObquV77VBfdbxWtA+60fBUxTeV7AM/ktyuhq8r2P3UT20qeKty3QOsXf2+FUvlfTo9G/oq0/sr0j08C3oo0Y2KvAPiLhToXlTR+6b5UQhvhfk+lOU9FeU+ojlYxfM2JbmShgddF8pXQV6WiLum76scL8vEHTD9FONNtbe/XaWXUtzrtPppYH0U4ftMetpdrnObUp2uEjbblOnsEvbai9j0YwU6K4H4VwHxrADyGVb/2LDyx14K1XxGRWo6b2VXcZrDUfw0R3FnBWj2UXxms8IzRebtQTuj71t05rO6Sq0/t5bJ3FOJzM7ymF0J49tvaktJzG3KYbaUvWzPBdVV7tKfkHXQnW1KXGpW0DrsDspbtp5Br7KWW5S0bKtjuINylq270l7GcosSloqZb2HhNilf2RF56lG2couSle5AdQAoKMsG5Sq3oyepN3vfk7d36iT8S1MeuPkDN3/g5g9H8cDNH7j57tKQ25SF7Cj/2KKm3kfZx81LPrYYAvqXe+z2nOgq87hpicdOBW2/8o61Ao4tcH1KO/oUb/Sguq1+JhsVbDSSQavtw79YY7Uco0fVw85CjbVSjG370LdIo8eCd7u7bFSYUfPnXfXcPIsylssutoD0LMhoKbnYtugdxRj7llv02JMeZRY3LLEIzjIb6tO/vKLHCwx9SiuWiye2QPQsq9gsnNgC06ekoqVoYgvErcop+pVS9OF9O0oo7ql84j5KJ25cNrHz9nmxYjaroX9W7s7CW41Exbq0AFH1IRYoDmUMRVFg4IzZsjq3MyzW2iCO9emfW/yQMfwTZgzP0/45M4aXZW8ZlXEmOhY78u+3V5fXiC9OYKwWe2yKro055SgaOPj8QngMCXsA6VohZsqypdJ/IRALwjDPWRijFtH1HjRkNafTqBra0DierZgKPXc3ogpHI17q5LbRuoK1fLg04hUE37F+uMlLZ8NpIiZf5MI1NT/yggF80Sh5ozC4LSfwb8fBHMdx4yjZMDqd10v/3FSyG+odmuPK2KEqpLBZRvryANaU9LpBW076yiLZc9Kn7pz0aY7BBknpWTYtFMfwX/+9fZ56Pd9qonpbAmsImnxIIMlSvWrLIU/9IU/9IU+9/OwmT33zusrkqKbii/i30n46Kan6WKoruVg3Z/L2LRO3i9TjlkLYGydtb8mKvkHC9npy9gbM/snaa4nZGxD7JWrvSsreFrHult9c1W7rZteyoK9rHZXLWluLtvqWP2tJAV6lVmeKqWW5JbSZBFxwZbr8EmEaD1sVPyRU9ewEaPw3Opv9bf4bSeT/jS7/Fv32xd+i3/5z+rffIvzUdw76aSehT6b1yTkQBllMfs1ynxdOgSgubV1mx3zHV5r1ybVHduXJdaXINgsY0d1hcm2Sumplhx0LgCCTlFQplSdX0jsnoihUejUpQJubUqqTXakxbs3XrIpu5kTfd/YtGdsrC/AfRbtt8rU7c7X7C7h9xHRD+Lvk9GC1VYFr1/CmYrMpLVlaOAPKVExxuXj0yLwtBUCvSgB1IashUnmkTW8XE711O4LqbISzEQyHuWQ4lFoHxsg0cpQlqQqM6u3R3TVUpftUZFLrUumsTjSd6xHQhGBW2qQjmJOVSqf3a4ZirvyYKFNUi+fTclVHFJiqmaIk999hwDAeA3xHH4XW9AgIh5BiBiQOoizEaiqOMv/FRhsuq9+OtRXaKGsgyt+X5P1OdV53UexGtcZqc5BGsFwoUbW9TSVVKeZrzoY5uABXucbWUo3OFWuvPtk45aK54dBtxoD9IOmoI1kLvncVjnQV6JbL76zE7UQmwTKRya1+7BqEzs89pUmSDUBbcQvzspYLXFjLWuT1E0q2Jeez3FGqXhGeG3FccRy4wmuqx73ep+YrYiOSA3dkY4N0yo01ZNLMS8ckVwilC6gP+XSQSgdIPwLqJJYOqJKCTjigiFFDN5nlNcxVc4PNTEBd7oxtBUQaB6RPJZGqj27tShXxpyYXiL50+Z4z+9ExeaPWmANaISJpjSrgRJiDXXAi2V0EvcnJqbLohAE3lXZCMpvhoio68+elnBSIHUjPgfQcSM9OSE8LzcmvWR5GT9LWXTG6dBrD2fubLey81VrKTkHyfaWZqsQV1mqdz2hqipZ7CJCoqPbfSvgmMzgtmlpr9ilNh1YrE2aQCC1RsZwKsiJvmwxjphmXXntLFAttFOM4YQPfMBpdlb0V/XPVpl6qXgW7YHhEyvFHowy65Lc2vDZpWYTiHIg3DW/oCMBWATxjpfr1hT6gWgYceErmcyxmJCSJ5l2Q50FNWRIYbSQ08zavJmtWA2ut76fAdErHZ3q42mqXEpj1FcrDRpX/TiTOG11KC2suy+MCS/2W0BsECxKFKY5dom9Rf1WunznewGm+1vlMU0duxvZgsHbRzCpqFs3Nakv5snR4HhGTgMFIcS0xMC4hrlOQ69xAh0DXmNNb1U7ZsVzy3Uxl2lQV+WkQZCnrH6+EmEeVyhvZDEhFWa76as20QUOsdu9VkZ06kRBkId/e3M5fpQg7KTR5cN75XTnv7MVrZ9BCz429rNpU4XVW/XIvPi4LjCK+qJoPP5WLy/58W9xOLW3eLG1uLG4vls/FfaWX34r2VxkUtPzgt3LwWzn4rXwEv5Vuh5U+3iku66UjgrMrbrM1WrM6Iz1wXfVl+Piyl0rhCtEYUDuwDD7TaNBeMaABjRlhHMfB2h7AX13AemsgLI8LLUzwtVLgjQWUmd1/ufnm+BX8C7z8BRaKBk6xid7XAX9FuKE4b8LNp+yLMG7AfY2Y1i4saarVE1+++OdSHgHnUHKYsbfPjT4xN13pD2zaiF1lQPDOfjAu5eKgKbAAReV19J40+TX28E3RGSn+d4YilXlD8BDjcjybLhjwGjHc0zfHoigDh4NO4epQscKmGVP3X7RpUXW36UMkw6SHYR8ve8v35WHrJMycEnUq9K0JkF2rIVcBOJUyNrTa3luqw1t+Snvdh9aL4KImhiFs6opUYmLdWupIGaEy56JPThD3se/nlzW5Ni9IJTOL4LVJgPv5gl3TtL3WvhpCjQ2itYCoSeDd2TUkNOUM/vjqTy/6D4yifkOLyErhOaD+DbUYVjqDyfWJwKexcc9cOD//KK5Z+/bJ2jtXs6Fr598N/2K0Pq2Mi1YlnJnmcmMLgVhGIJaWKMGpYnS4g/sDwmAq81wpf8ewMIYUY5gYUG0zeXojm2oj+NPxwCtdgtOnLx8mN2hV3V6MynDgSCZk9fDttNgixq9TOnWqqi3Kau37JInyVK1UsW/tVsU2zXTn69ntwtWKcAXNkgMR1lWUaGyU7c4wbUCxdKTd4yRbNOy1mX0n1Oojf7cpsXyumTm9qbxwdmvhayi/k/7VR9oWn4f/IpAKryNYomBBYk3cGEexnJoDaYWOYpAKk1BJq59v9lNtOumapqwVQaTyQT5aw8oFH6qhdLB5lQS3XUD1qdOXjRbabny0C3Zq6vo1FB0xA2LyQ8u/iwVy16nQT9EmyMqfPVC1kMC7daJtR1IhWZSZsjUei9b9EWx3VHASAvWOD3pz180Q8n9gj9SaydM1DwFR74TT6mnK7cVrtwj8yZ1be1Dpx4U9Z2DBphDtFSfvsL/3ax+nuLo3HKSYZZHKRB/0cro9CPq/J0FfvRbNOfuwstWQGGmsbXq/OFRf7TxqsMDBg9Tw43BydYt/9XhVSkLU5KqpfcsFglA5mToT6RH2sMn+k3gWiVqwNxj5+EBe6RSuKtJpcnyljRvqNHBq0N4Gldt1HPRAha3jYE+Y/JAS7sMZmMS2UlG862VZ0tAHh7c0xOUrq0c0h1pqvr+T9uz1EZxjh6ucBz6/ZjTNlh4YPf3fsmUpg9zjAiMhlUiCAI8pl+GzhnK2rRGoDBiMA00BywQ9Yxi+GCom90QBzONyRcNnsBRbCFMMOFmQJU4tkTnqEyCGn0sLp5SbUxxQxtMs4Aqskrkk3noBC1rorIqJ1GEYyfJGYgvhpcFVRw0r4ITp6QCNDdinm4k2KjzU5xarGFLdw8SSEuMDkW4yfJZ4irmSaWLEhFQbJCxGkZ379DbIs7VVmSK2NhB0qrWBJB/WFkt7nfqRvlzWn/INtv6q1n97bv/gLXzwFnZgefAWPngLH7yFD97CB2/hfXsL78tZuOTRO0XBQ5bsyKM3wcFYqX0uC5JdLQCDlrU0PMqzRup4pZJIIaRqFzJO4pwSKKQrTrAdzr4CHQXOBx09ME3zIjKh/q6CwGv51bHJLN3D7djD4VjpQCsIefsZ93X6fV2Arzn9ql/sTr/6vLidfktY1zx/818+seevmp7F81chaPX8RQff34Pv78H39yP7/qqb2ub7+7p6ZX0y08ke55i1Oza9zpuZoVO8pByL9zDFTAmwCpay0s4aaggnZ1O8SR4oiGYGhbjPc9WJRkQDFImo5HbLl2llkJhFaA6c6gp1CkoRuy/0XN4ycZLilQDduRTXpYZF3TjGZUR7hDkOR2ruT4uIeblafRIddGJRx6C8HUZ21dviSEfgHL/gmloxeJ83s+HQxlA1XaAbDFYHknad2ggaHJb5waxp7esGgwjbuNBpEpG70OUEXUBUmpN6mommodzGcDlW4u9Y0niyxUd2hg0+9s5ya8/M3VZ7DGc3516d+4686WffollO2z6yjKaIjKYsxv+mEMsSnApFzN+FXCYwEe/IbYycuEh/PJbJ1CyzTOiC7TReofFGQ9uNjFa7AjZhrdbELrXVj5JbfKu1tMlxzSafWKCrLYFFsquzCU2GsRHleZDwDhLeQcLbt4RXu7r7EPW2kLN2IGOVHpdOB0OXfNEl6TSLe/eRfGQhrje+eN42mtexVQAV0k/L72bTncF/TvsTnPYrNP1j2So+NwniBktELZLDP4YEkar571By0BB9+HZzk/W1kqyNOjcWvt2fXfeSXXKxRQ+5G7nF14pTK4kkMdibGeemBL8mGuif7CKBORtuUaCMeU0EKH76xKy/nqKF5Tc7f7DmHHj9A6//OfD6+q628fg3tUvrweRL+4Pu123IyO+lcakqPRByUyW4Y2Xr8bZjLNGHFPN0HdAsbjcrvUUfNK43osOZ6GAWo5l5A3GOl4mlvKyJ683fNOVbXn5pqljbHGVLb3lHmEveTmea1j17yRm6z20a+Ax2mwYQoFjMkSQyah9ILB20tX+eaKR2zLpXAHkmL4l3qbPc4dbFsmLvtb2lTa1ZHcoBHQp5vblNxqT4GN+tvjvLLIW39p4F4FYV3anM2uQzQgU36Dyov8/wfy1fnUWIdXnNFg3Ly2Tk0MrvxTFxhNXtVTyW9a+KfVKuqIgj8V0gmb+EygDjrZBz2RObIkbtl9s02KtJ0ZCgndgUm9v4j2VUrEq4CTteGWH2HCcRXS8Fx2QTX5NgZIpx62+lAHECWiYaAERoik0Ygr0bQCFBCb4poTGO+cijcb7Ir8avxi9yeZnhCBdphWRp8TclLNx49MLEhBWdwEu5sinieK7zuqjDcIOVODMAEK9IlDvo1vm1qIJdG34916p9veococ4+dBrIN1TSI9N7tERrVIetM4pUarGNWpEHIEs0x9dZFKkC4yIa5JLy60Y+ANkuH/64Am6EluGfvjgpJqE+OF7VyZTC5er64vLi9e395enbi9vr07OLQY0gCkHkm5Ra4uRmQlS+wZY3Wf+m1A55vtj8CrQicntx835ydnF6dnb17vJO4rVzlKQeprmjVrzObq/P7q+uL25O765u7q+vzveDUmWVKk2f5Eszubp/fXp7cX8+uSm/cgGNZ2SepRimiGEISSpv+FpyX/qQAI1hQRmHBPHFuAb/hwVOMVwlOL54fSu5ViPEmJx3JMJsDOemtqcYRgDK2fjjFUqPC7rm3t3SFGxLeALDMqiheyHObtV2XL25v70+vVEgDcdtVkbVUVWlJMXsBc41kJzmHcRyMbQysjZbIDF3cZGlXxTmj1iHXzESYghQLRziSZ4RCRJKI0hoqIueXFy+l89wxGiOmwkm1WVjLXVGnuSKyASlYmOxOK+svnvlqqtyU+QIhFU25VjB6Nob66p6bJQGP+yGfz25f/vt27v7ydvTby8ckAvCJgiaWMvREsVojivUbdhjMv1Ga4PvGODi/1xf3dxd3HiNtBzhD0IixGnbSDcXtz9ent1PLu8ubt6fvnHA/OOL4VZsymojLmXVg0lZ7Y1HWfViUVYfmUNZ9WRQVp8Pf7LaKXuy6s+dHN4895t3d3rz7cXdps+dTHnifPMCaYrIlknxlJlsoDTc/8NTTG1Xb86BrV32W3v/l5IwPuf9nsr3V2/evb3oywCoE+jPApTHNSNeXU4EApu9zvX3NX9ab9Vad7yroyJzbef76vlAjNgqyJ9LgWxNe4ISomcpfjyBL//1y38tTIOcBjQ6Eali9XelXLmmafUNtr4LTBXiO51JK/76BC5pjLdhRsx7pbYdhUsiB5Drh73YE5QYy3Op+yOeLih96Fxfv24OnqUXPwEgCtuQeK7CyvNCNZ2MT8f8+vEpnYu1xYJ9OubFjY9FcSImoJs5WBNfpgelc1bXsIyEwBfROaeMhzhN6z+vvn5V++rVX//Hy9+V1ub0/O3k9nZydXn/w8Xr766uvne+bAWFddzvoecA35xO3ry7ubi/vnozOfvRMdQ3iESKcD9RIvgSxWSGGYdQUiCl9Tbsn8jDrZOQ6KQj+VlnR4MnMncKIMZoQKQHxtkNg/8BN69PzyDNJJtYHSPP37TCqczgP3gi48oUoKLMbUTi7IOIR1vRLGWAYtFQfRkSxlPKxoMngyfwDU2BZYmg5aqKqQIRYw5XtzqjE18gpZxAU7rCAuuXz+FbrAz7eZ1OpdPQfgKSxS1xvDQFwgJGULgEkXY8XavMh0gOo+/wePAEXj2HM5GCSPsoKTiqjkKKUbg2OUxVd6wpRcDIKEzJCqfFBS63DBiRVQ0Ur/kE/vgc3gp6oM0QGkt5aOK5HpTEUhdjsshImHLNNnXg0x9o+7g7iYN0KaaA4hDO1VzPbs5Za6cNRvL+DPbiodddao2IjRQOIMJ1j4y1xUYPmT+auatYo4XVWWwillYUXq45gxWDFQ5bZ1HGOE79XbNs7hkBI2oT3Qa3N/pmiZ1Xh5tBmsWyaILKcwixORD1srjjQWu+R3eKIDVQR3ZIIZ4VWGnJb0FY4SWks32OHcm2rBZzMZnJucfQomHhZqSRUK5GwmmZ4Mde43KaiKd0/T1ed6XFNFvygNfMkE1Vh7nApDm2I+FmK1LtucfMtyVzJ4qSBXr5u6zP2CiiiDhHwWIp3cyc7rluZ9CqEdjmZlmiAJayfaXh3VX6ikb2ioGn1d+tXqROaPLXFdrAcdyD/rc0N3W4zcsC/V+XLd6VwR5Pl1pm42kTRIgxzMbm745XxNXM8pTosiW6g/RYqbkfW1o0vJCt2NbOoqWN7Y16or0yBf8NpEy1SAyFMw+8HL/88l9ke+NWuXo5evnlOKQBGxftxCaIr445Yg/sWFXlLzsFoYQcKw/iUe7CWv9iFOZbxY7FmB2e0pu4QFuWWWsxCWaQoBQtMZevlKzUVc7/r3e5cIxZM46XkDHlGxlrJkWyy2oXihMCp2aUwHkWxMconKdr3V6OTIzTE4kB1frnDs8WsKWk8CoxYDyKy/QODo7bO3Hc3spPO8QRFt20uO9a8GozCMUpXVb8cusn40zbkW3Ft/gipdl8IdfPeiXyuh1yXJ2OUh7EKc3isNZp0Ij4kp3GcJuTFbXM8hwOb7CQm4ZyW4bnsu1wXHwtk/3W0EXchqueoYAjUEsWa1lMsJxbxVUUUt9mc4sFXg844eMcobwISNvQFZCeaNiGzhfMVP0bV5Qy9aTRI1BI1r5UC9h54Bq8fPWgyZ9tyVIM3mX+XtbewqwZGWA5VfbZHYIXdkx6+sQqFA9eebW7SyRYgdW2qYCt9uoBr0dqo5YoUYqp2okyT2RlqBIYWfDHPLLmckldGGa4TGFogn5VNUO+L1iU/jGmVZpb/kHi2+lrqphSt8Op+R0+A6/Tj8dkK8r5EbjsEol28tmqTRenrVFuZ7Xz4RrMdiV8TwFRm6lC+Q68eGkr6n7iOjXvkMYjUahj2Ax312Rca36d7+yB3/08+N3D0/5xnvbW6ELxY36Oiyshj2YPMWK6br9zdo7PpWZ2S0IbSENyc7t59k6+vXV++tI2IPrKTa2yUhNPX9mpj9CypeBSlZks2eG70WkdvgHRU46axBCuY7QkQQHNGJEaMAPE8FH5ETYedzMSReoZluyqmMLwvHL0hqqHxT4g7lZoXiWbjN38vmQhERZYSwktPJIlbyr87xFkTPR6++72ruSRV0ymrJuyVpSwb4t5oGxX2C6WOkVTh3jaauywm5w8RdWSGSp34JT0y1oHrP/F1/Z3ueRTO1Am0EEl1FLMszQu7EICx28xv46yOYkFL//sOYjKKTqGFTXvqbYkOXfEuZbqmWxdS9WkpAuVD+OjdFotxaKam6Ffg3EDx7sKK0uWy4zLpLdoxnGaX0H3HNrKlBgsvpNKh87yCtXmpamZ1R+aFvckHMq3zwLSce18j4idPIhPeXnGfc2ASvbwXIhyY9syqN/NIhR5ca1o63NBgkX5XJQUhorsyhJAHD3gWPbY52o4KzWtmlS3M663TEWsVJuWk14584lNcUTjORv3izo2Q1m8pSwbWmpd21WriQA4bWZFKKbS9ggA0fxL3cwwFqkpxtaul8hygBRhWGZMv3U4DrCSa1wYPNWmDx3Y31xoolzxpboKpkRGWWuHcymUyqcUUPUW+/FFavJHCgHBpeTWE/sJ0R3yZ0GX8bJnKSihukBJguPxXmmnXca1nq7T60m5tJNyhpKbxXvTqcLBr2vUp5OZGibVq4IgITjAFXEZSMw4RqH60gISAMecpDjfO3VNJXIlEVswInnlaZGw6vhb2kKclA5FZakS1vgjYJmgf8wUiJKVfMbGHW9cuIv+9OrnsRXkN7IaIxLZ/I6AqFUu+8nIm0GYWogcnuRDHZUTESQ01BN+lBMV5BeonmiGISIP+ASG0mu/QPG/xKn+76EV5jPFAAxFk2GtXG8ll3uOoOL1TRUzK1DRAQux9jnQVMw9piUAsU6pYCha2ED4p1c/O7CtrhOQOMQf4FVeTTmh4XN9y9g65ugDEAbBgjIc27Lwq4+8nyusKlM94igaGd7+Ea1V2gm1Xcrym6CUVzU8Vqh3V+dXJworcYTmMRAGMeUwI4KOKeetqlLQEHFd8NsKVosbs4xnKR4/7Xtb67qZFh1NnTB8HE2H1yxi5FFj7Wk58V7rLCya1YDGAU44O6Yr4UiOH48fafpA4vlInLuR2nh2LJ+L4yfyPxvNQoUeeE2l8iztcz5iHHb8tH9dNiVh+D5CT2+Nv0StZ40DKxNL65VYolBRWBSvd31KxbrJKhbBeqTduUcoDsW/GWFcfN97oTLicQXfTc4/ztnNSO8L6GDHXclrHEY2aBrazJeWSpOjJku8ZV4b9XPdGhGYAphFbfpqsiYfPWfZmbmVzS83LFeBlB7QSq8jvpJq6xGJZYFHt0hCmJbHurW2+9OeGSj3Ateh4ksstEJrSqSsKUXUM9GvtL1SBz+E+c31mVSa2Lh8p/6gDdutUM0lamFQNMiyEpqG0zMGb6XybRIuwscwmalSroYPOgLCSxXB5XHUKMpTAYRBpqxz0iRukzqWiOcWqpy1QPAuJh8gRjFVmaiZgofjgIY6/WcMJOZ/+qLJzFzFsveRwocul4J1+UXYQuBf/pn98+Uveomq90fhG5fHbO4WiQMML//8ry9GL16OXryEFy9O5P/g3d3ZeGCb24nC0jvrm6zw2XoFZYuifhLHjAOdKmO9/jHM0opiyGxKMwehfoR6SG2+1VWfLsvlVbW4o2rhGouZ2M0s5jg1iNsLjjsmI3DPT+IYLq/uLk4MdtKkNsUQ0fkch0dWuErHbySwWEDWUhjDMSOcrHCZqPZnXblPYV3eTkjVfj4iVl6s8YZldTdRWMlYpjv6jrW/C0WzEj0g5WdI5Y4WgVFF6P3AItnkmRX1+9lO+huk3pll00b6JT73nN5nzJDTJqVvQNyI8tcovUMN5aT83ZjaNFGbEH4g/EiF0z0Sho8s1pnGO6XLsw/v0gwPHa+EMbtheQhIjFlzDWj9vJi3wzuHq0kuSH7rOq55uwY/pcsZ5Gkvy2nQBxbpdroWvGy/U1rbaBvYYu8FIvdyFOcZdZ3JBuA+Z9QPVX88HayIG0f3CR2L3CWx3saKf7sYyX5my5Or7q7uKnWkl1dST8qWKIpKEaU24TE/QWQGhFcURKUbVEogqc6CXM8ZIlGTjHfyV4gXyLvvRxvnsSSxyE98Ai/8E9G22rja7FukzbDValPvkga6F6oB0n07F4gBJmLD5L7gEGhqdpSL3SIxJCmdp5j5mk92WTLk4LNp8dncv7Nmq5dmh3tmu1+mT0mFg0+m3SdTiRMZw+nT3PFNEDxzgUsRTxUlxKBOzRQNSnCq1GJc79ERVA1hjhdyfPDdPPhuHnw3zTpXnDcly6aMkBAsUIoCjlNxfwJWe/j15JsunOqG99YfaxJ/rLNlmXHYExt1Np/cdP3UQ1G6idtSl8eS8kb5qG5LBdlT1PUsQmR56WOkcvas+XuUTLEWkADXNjg1x3npr1Y4OCCbJ4kVuAajFr4sv3k6BVmBNrdiQxcpi1eKp7+Upadj2TvUztAMh6u5WDpO36f0g3rq6GTztmyLaDafnPoY80O1k03K4KV0j06dgIxwzy+5Sgg5AxpjkPvBbIB1ahRVwyamNufdyqBqkKMWoaYQM+FimfCmJ4HaMDUeBxRF9FEnQCr4zLH0Cnj2gaB4/itB8fMTQKCThZWYuQZsGgMWg+pBdkXIRzrBwNPtilM0rHWbWOOebmqOU5rqWuSvBKVTmS5pKE+FOJYKVVbOb6VkYJOrK8Ip0xxaVmPjNCsR4gTHIQ4hS2gs9xcFQZaiYD2GswKIIY0yR1fGKrrvCticxWkjIPqVkrm/1orL0roLzVvX47MVTlw5tRkdnNeTLL0A3/tS1uoetvet760mML0d1sv+AQ4HPZtpVLs3+iqF/OAuENMHA8e1guhiOCXytE2mAXGNzZmGW4Njx6YAimiMIaRYImON0TAHpVgP7TtZPrNSZYeiR7Rm+qxVvS8tvpkKqMlkb3sK5RG0L4HFXxWtKAkhoYwRwZ0xHGQp4WsgjAnB6Kmvu+3BBH8wwe/CBK+pQdUO32nG6NQlu+yKzvPc25C9AzN2mWsNDKO/wFEyyyLgFLIkwSlEQh4uv5/PyBiPm0BRItPZKgNO3vo5cAohDkiIS5F9eF125SVxhm1a7UdExBHMS8KV66mV5MEpYjg00RxigcX+2Q3kKVY6wIMF/2DBP1jwDxb8gwX/YME/WPB/9xb8g1W5msxVG4tV+pobGuHXWotQNjenUxSMUcYXNCW/qRe/ZnPuKrcQMJLrmjhOR0ZXoSNd5HxGUKl7ofP1lypENEEWzOOIobxlrYhDSiOsgywbs/VEN1VNUUK+VbZv54qoVW0u657XUyIos8WrlTSYip0eDn8eqANtTODw07CwNmgLjm60wulUNphjPjyCYUSY/O8j4sFi+PNmwANhgth4hKp7gW083ULrMHc5Exm61oBYBXWkZVcs/qXMi+JfSZ9xGA5SzFtRt62M3QPDNoA1nezGO7LxuDq5Vn3gYv2sKFTWVaX92Atax4ra1rHTY+9yRL+lL+a907F7TzOgNA1JXKZUtmEijNyHKp+RmaLex8re6nNgQcHm8WTDQbnRmK9KTjTuI9dY8wIzjXu+Mh89G3ehkhXv+5zEHzkbt/VFrlWr8nmr6g+15Zm2syN7eDdlpL4AuMm7aQj1EQzzOfQl237v8REMdeUib2JRnGnb5Uo+0hPeSrX8x2qnUm3z6c0wiKVTdT4+e+ZhPy/ORovYqJSw52O6c66jYwW2evX+YIP4B+uLZa06oc5k/gsbHpX/VAVKrYN+bKmuTE1/B1Jdg/j3kOr0RDjieJZFt5hbSxH2ew79yyJ3QGktpten76Zlk2XBv86BAMSqWxpWii97gNl8tvpVVYUAS2XjyLBRaXGDkoceqHetQY9V2G7XoVcdxSQlVBjgC5+y0giVWxukhAsHQGsBRr8b31FDmpFRioWqL20WYPw1Q2sxnYevWMDIcantyerF+Ivxi47CisPRaPX1l8PmtwISCsMUM/b1Pz07PT+/ubi9fW5pGGEU4nQkbwyh8dCn6KIGZ684KCsTR2QqJnPMaPCAOTtWAkGS0g/rY1nkjAYPG9SWVC+KrIXH7KipAUchqRshl6KTqujYiaF1D0sqLK99LLU/Wb0avxi/7N7L7l077EkONXcHbBIK175Y+njvjf2e1a7P1zLGZdh7GcsHrfTsex20UvuT1cvxn3ZWtXU4KsP+WpUuEyeloMPbUB77gs4w4lmKR3PEMfv6The7+1oYGCyt8QeeopFi0kfmzWs0dl6atz/up/54pWbsx7mun5I0KlnA87iaxuri7fCsbvoQfjyS+in3KFAih647O0rxnDCeIr89c3UW9KZKPPeyh/mw0srJRoXs6aILh10/KdUaVs26yn8XVYk3rv3dWab77Ory7ubqzZuLm/vzm8n7ixv7MlufdAu028n9xeX59dXk8s4OKIvJh5Pj4022zT7c6fXk/t3NG/toJqihUt6z/HmSF9rOBelSfJLupjYOTKX3UgXuBrS8vDaJGUcNv45eRdLLEr0bxOTqfnJ5e3cqt/Dux+uuCuc45jhNUsJwXrp+2DXAxeXp6zcX96eXp29+vJucOa7isH71HSTFxGB+/U/PbOfGxplkaVRrrbfd1lgdo3L7+hl/Pvxk1ECNZpFKrYPIaJ1zkspYc+kb0G5zEW5AIzjVDzo4rUpFE5lheYpBxjCKgAiaPijXo+v37EiWSmYlz/vTUmnbj2JvMczJx3JS6G1/2Erx3ywvblfyFmXE9+cH4dAi97BsNIydH1GTnJ+T34EauXqmPzfPIMNa5jxl+9XzPsK6BL3blJ77Zjjmur/D05zy7+AUOfapz3HaiRtCS8NLGuINXA82cjoY1D0LrJvlcxoEwWt3NPgo99Dz/vnbjrstqjsy9ysHBxr28kNwjrhvu2efZSlMzgbdj06rdk6jiuO+S+q0EVUq8Qxyr96iZBN/IcICRlC4tN9g09u0OoG/DQAAnvx/x1MSH7OF/IvM4CcYfYDjBWX8mIlfTAf4+d+EdGjE92CRUspVQ6i1HP7T/1KiBo6qADOWHkc0QFEP0K4+zkG8IffFeQOUXUMwbOvYbDkjpdNxjvCSxtvY0MWh97GeO6yyunsn/J4WVluvHVvU8yE2taWXAGwyt50YxUs4bG4OrwDZbJt2agKXdLi38btMvQHE5bnEXAjxlbCQLnO4hLKRItra8yNpob0NWyKnSYR5jqB4c0YJ4ouv/+mZ0gjd31x8e397dfb9/fXp3XcVGBGZ4WAd1FNsJim+5TSpfgmAP+Cg/h2YdOeC3dCPjOAhRoH4/3QJo3QGx2Xkjm2mPY8mYoZSbaqZnK0064pxb9fE2lavQ1GvN0ODZ9Z5tA/6/bvXF/eXV+cX+7EVyiJC4lxfumyFcuw2dbmbGvnoG9XKdOgbGyaEon/lmLdDKTftY68w+TRkBo4PvH41yIpEeF6LTVOfACVoSiJiC4JHobwiZ6fX92+/v7w6F9dD/HH74+396fnbyaX4ovjj50p3mSrp2gx9wQIUIR0KXsVhX7YWB+2SqruwpI6Wp2dy/nz4GajGSyfZzxhXm8QnuHz9bU0+hMyyIHaglcf/YJQqRrmIhQ3h+JwwJPMhZlw8R5LYgPYhOQKZf0FL5w0IKQ6o4KFkzoU8pH0kU1/JinpWtG8u3l69cx2BYcP9Z//kN8SCZ+voH+KVfXAaspFs6GlvMs9pYzVjjEMcAqMqvBnFa9WfAcM8S8RhIKHKqlrwh4BS3IAkspaguc6BEjyYFLVSUluiYEHiRgJChanuJ4nw8HU5A5Td5CggjoQY2DJv0aZ7sO8o43f0zEzLPpwSOcvqAtegFYG71o5lU9Wq9rvT0mc9H2JazeqXif2wqPDxc7mcNF0PejAAreM4WLR7DW993IbFVapSJQy87tMmeNhZxc1wcl2zXnj12hT3aQuMsutkYEku+ZaG+ARefPnll5VfPZRf3ZerdbatsxPKmLLypWoCqqeTv52cq/psTd2MbU9zLccTuKMQYo7TJYkxIA5pFsvMPipx35KGRUIbyBhmR2JrZXohnYeISTAWM9UxThZ4iVMUDQHHPF0DSrGmmuMBCDiTeEav4rdK9tZcpFI3m+y++uvGagQLHGYiH1OxIINKopR3TGf1NHkjteAvT6ai2Kr4cRabvLva4HME04zrpJQDgCVdqbL1KFbZKAWDImul4gAzhtL1WO/CdVn/0Kols/vj6yf1zy/0Z4+z1jky1dxMjhOSmjSeAmUa4k0mVtWylKf0Ukzp/x8AnjDuavI4AwA=",
+	"openebs-operator-2.4.0.yaml":     "H4sIAAAAAAAC/+z9/XvbNpYojv+uv+J8ld2bZNaSk0w72/VO517HdlttE9vXdtLb7e1NIRKSMKYIlQDlqDPzv38fvJEgCZIgJSXufORnn51GBA4O3g7O+3kCZwlGHMNbtEFwi5M1CTCcBgFNYz5AK/IeJ4zQ+ATWLwf3JA5PTCPTZok5ChFHJwOAGC3xCdAVjvGUjZZog0Z0hRPEaaK/shUK8iaD0Wg0eALneEZiDDc0wsAXiAOKIvrAQPUlNGZAY/j+KwYrGrLjEK8iulnimDON0lmUMo4TAaCAczJFwRilfEET8puENL7/io0JPV6/nGKOXnpjn6QRZqLRCNCKfJvQdMVO4KfhH4Y/DwAAEsxomgRY/hjTELPhEaj/OF4l9ONGt1vjZGp19IJmlk2CZGr15X+L1TD/e4w/4kD8w1qd0j+PZyRGEfkNJ/JLglcRCeSqBDTmCY2i4heGFQi8NsBwHK4o0f8IaDwj8yVaabyCRLf/K52qBgmN5X/3njrjiONZGhlMQoSXNJb/6g3T/OPXlHIkoUZkSXiC4jmugI0I46LJA+LBwnsIEs8TzJjaJHn2aMxRtKIhSjllATILvcYJJ4HrSyC+zMT2YEbmsYT4a4oZ3xGKjNMEzXEQIYPnSlwaxnHM1zRKl+ITWTq/+C2+bhujFVtQPsYfOU5iFI30yPoiunAr9lQLVfhJXNgKEnPM1WbayyFPoaBv4r/SVaj/a2U+hjjCHLvQRysiUI4FIWENyAYp43RpfgoFJSOSZJXwgzKCLrw0OhmGDrw0eXJjA8M/lIYd/mEIDiiB2IXxjmBRmoQktsmra6EijBiu27Vst8zq5CuRr45eMddmhUvCxE4leE4YT9pwWaOIhIiTeP6ApwtK7xUtS1VHeeKWKW9o4J5FvqVN07C3NqbxjUbs3c0bCet4iXlCAucYvrd7RcOQsCRdCWynaTjH3AnPeRxznA1JUa/0axKHwBe4zCXAA+EL+UE+4NcJWZMIzzEbD57A3dX51QmcLXBwD2QGIZ6hNOKAdFcUMQoxxiEDTmEqnn+c4OqrLsYm8XxPjztLp3/FAdfvu5PLAWgGYb6X+JuERvgGzwTgKqfSBtHsc8NU5dbYi4JWK3acsWrn2ePvWAs5HFoRwVHgGv4MIEJTHMmVqe0HkBOS44AuVzTGMR/5tF4bvF+Nvxi/GLAVDsRQDEc44DRRwy7FMXxj4dGASTdcDKNzAi/FqIJw4PlGDcI3K3wCN1hdDPmTYJFIPH8nr/IJxGkUDQA4Xq4i8YNC1lpngOL6tWDedR2b1xIAwKyn/O/Cgb5sOcsAgidEJMaJhfyoGX0AskRzfJ1G0TWNSLA5gcnskvLrBDOsL5HVLhv/eJkDO8mxV38rmnALBYVGhtw1TfgJfPmnL/9ktcDxutjhCVxdX1xevL79MLn68P271xcfzq4uv5l8CzhG0wgzOSFx38wqAadijBgHXPzn91+xErwpYjgEGgNfEAbqdRjDnfiH+L95TBMcApkBXq74ZlzqbdqxdCWmh0OY0aSIBE5Abyi8GH85fgU0fkBJWMLDbIh7eqVRYY2iFJ/A8HhBl/g4naYxT4/H9+kUH6sZDBtW7avbD29Pb+8ubna1aCgME8zYI1m1bHp1i7bgfHVyfPzy31+NX301fvnqq/EfT7568dWLujW7PH17cXt9enYBq4SuSYiZfCMzIgt0ptYhl9AAMUBxCR6O1yShsfy+RgkRa1+6DcXZZAMXWumJfJPQ5UnpA8CM4CjU75Tz2zXii5OMto2zSdTM/fbi5v3k7OLD6dnZ1bvLu+IKmNNieACzDisaAmLbzr409M7XQJDUcZWW1izE29MfTz9cX53LTakehB3PvTDafne/hOpkZl+ms5uL07uLD+cX35y+e3P34fbu6ub024zmEgYzFDHJ68VwtcLxxetbwxmW4NpiKqA4ND+sKI3ggUQRxJTDFIN6pcNx4/q0oeZashMY8iTFDZRxcnl7d/rmTQbzTAD9cHt9enN78eH66uoNhDiQ2/6wwIK/NXMFKYUBW6GEYZBTYguaRiFMy+trxA8cShoBK5TIi6MfUCAx4yiKFGtY3Rs1BUANI8vFnGJrpCMgMxjKrRoC4cXlzlo5ibRcOeDoHjPAs5l4EGgcbQTAXnvxRJJ9LJ8VMZW2XW7dkpqdVpNt3+qzm3Pn7QTCIGU4BE71K3kcEia/iC72LpUGmSV0qXSwp9cT/ZSN4fUm2zFBMs5uzlm2URoYDkuQGpbj7Oa81Nj7kL8+vb34cD65sWeYHQL5sENIEsm0b+SzbE4mjWFBGYcV4ovyWflBCHsZBQhQLK83BqHqIuKsz0iE2RjO9RrIYQSgbBGO1yg5zoWVxkNhplCz9zaohoVQR+nu9ObbizsBTaI9xdmiiPeBzDZyv8TEBbolaJxmHcRKMbQm8Vx2YAskpi24W3Gsppg/YByrTyTEEKCk/EjQmb7MSkUmXpLqlcTZMZKrJ4cmrLB6x4oYtNLP0vQ9FlNDbl1TcS/NHX1MCyvJo1hWRdsuLt8DYUpzYXAT2gmhqpUAIxq4brh86rGhuSGWWvz9b1VpVXeyX/81eX+qAO9kn8yE/4usEdyqB/5aLHpEUYhDmG4MjeizWo/xfSos4Hb06M3V2emb6/cfvru6vbs+vftut1tiFASmV5Eh28n+HIv7Ej3GXXItrc/9kRMaep2Bs6vLu5urN28ubj5M3p5+e1ED3kD+K1kjpSDxA39zcf1mcna6V9i1YtYJDP847PSe+KApafKIMD7nnthadNB/AEH0u8N/++3bu46DjJbzZbeZvL968+7tRdexFHvQZTQzztXlRAzrM9RyhD9K1UzSfe0u/s/11c3dxZ4G+u7izbUn7IjE6cdRyknEXMAL1Pfi8vT1m4sPp5enb368m5zdApnZFAYYjkMGKKbxZklTBilD87Jsp8zrosu3lM4jDKcxijacBK0cbXl0b/m1Xka4uPlw9+N1yxKNcMxxskoIw5nWeDioWaIMuw/Xk8tvP0wu7y5u3p++aXqjQm1ng2ckhgVNE/a8AF28UCucEBqSAFaCx9NryHDMXQs5LnQ34gRh8OqLRfHbWxKTZboU314uxvAjTSWiAY2FrV4paziFB4zvo414+RjmwkwIL//01WLQrGOsWYmBU9346ouFvaQRWeMYM3ad0CkuKmqE40fxF4CALpcoDss/A4zgOGWJeqKOpyQ+FurTgEeOhlqRWvgijdsoOscR2tzigMYhO4E/vii0UTuTff2T/TXBKCS/23mUjW1ll6hWI9tIKw59jG3etqARWweZ5cyylpgTiFZEz2tVNpasEsppQKMTuDu71r9xlMwxL9hViua4GhMQw9ICfzqTHhCbE7ikMd7GPmmIjdSait5djJTuzo2r6tFlO3Nl3QA9sPpshsv6OfRaW/hsJswmjHrbMQ3Q+68KgCs2zRYDZdXUpgGDBfTzWNtciOzb4vZCWtvE/280uNWYdrdfut1Yd3e4cjuz8Brgl1fnF/sxGklzWUxDXDGSPUKzpbSfGQNi1WJXfPLEsTEUSU7lqARVejLbu62NOzOaiH2WILXSVpHqY+V5BcbT02HIiakAKamQ8FFzMhfDTDueqXMiSu/T1W7O6R9HN2cv/c5qZT3rjmtpIvq/xFyKPYr/ggijECcgX1+BHmH61odjN2JvLk7PL24+XLy5OLubXF1qAeq8Di2HTaRNKXp6d/bdB3mdTr/5ZnI5ufuxwSZkZAdxPwBpxqmqmBMHRWhnzKtveQxYxhHC4Bc9+1/GUvUmvRzVZBigpHzqs9bSsPqLRir8ZdxKgOqmWreOeqDmpbxOaIAZk6PlJ1cyU2KNCAPpp41DsyQvv4RggRIUcFxR3us7AkTp+FfzBK+ApnyV8tL04JbCLI0iOWyA4qc8uznSOiWdG1X/Z3/RQ7Ln41NI8DyNUAL4oxiMVRX+8v5L/DX9wDEnCTYyjZroArFc+iWVgwuncbCgCfzy/36BkwwYijfAeKKMG4gD4yjhTKH6S5Gx+aW86eM//FIPSGBTBgAzKqIxlJ73N5xQENtCxUQWKNmLiMoWjh9HgeNHjhmHX9T2jAIY/r8SWzf+w/AX+FoyybsW/npIMsZ/vSUopkmecYHw4rxbO+5GtnEP0xvDTnLONvJMHd5brO3nk20yfPK4nnqZxdG4Iq/4ikMVueaRMHk7fF2835Y9vSw7eFf6vCqlU/LLoOOjUu7/+3pVStjv8FXZnxCiD4pBHfLr7RJE8mYtosguxZDdiiAdxI9uokeFrDbojBx0tUkR9PsnrLuXncsH1z4FrqMbRDT2EaF3K0DvWnzuJDx3FZ1tWetJnRTcIKiWBG3bMXHQXSDvII43CONPDu/7Pt73qtjY7X3/XUqNJex3LDXmpELsplD2jELC7kdLFKM5TiDBQmoJjZ558AQmvGweV2HG5DflYii6SxIzxcotfkYieY6d1skzCfctWjWIpnG4HKnxt7RNWoDMSE8gDfF6JNEFwgzlAJrAKiFLlGzUVOBhQYJF7gNvaAdwCkkaC9ASmpqsGoRpQSgyanoGdGaWA0b6EpNEkIMlzCiQOIjSEEtAYuXwR/lvdbCA4RVK5GaoE84GUN2wsRrpBP4+AGVEnWI9uDmXI7jHmxNr3iWxU3yA4geZ6+DEdkzTQBhG4t5xJyDz0Q1M+rOXoC1RUgNKfGlBqrD0pclSplZJr+hItS0NQpk8vNmylxpVFwFMU2HVODrGPDheUMbZ0fGUUj4sorDGcUgT98jqm8+A+oicwHDoQOLszdW789c/3h1pn8cSCsLP0Y2A+LL98MchXh9HlK6O5H/NwhfqP1ii/yNBS/Uf4XKk/mMZ6i9T/R+/hcM2dZZMtHGLeTPJ6K6/Up3aKMh+dFMGsMfoKlr/1qVvsk3pWyqdcoS8FqSbQulJOXqk+vAYNlcQVxoDiiIQpsIkxhwz2Z4NLN55Q1N4kLQ5IvcYOFU8VeYUJZ1zGV2qodgRMLTJRs4haaZhjY0zMSDOBRULj+QQgRpCrBzwBWVY20fiENKMmj2RP97qAzAeFHnCJ4DH83EGIx9JYqJYnhFYuTSOxYe5CLYffm1yo4zEb8MMoliZgEcaqPgGfxb/X27SX7oBs3HP96sGxgk4gXRVGAqqeYn5A03uCwTnifwC15OCABDIjA2CPKXyRSW3Z7eTLPPDw0IHT1yev83AmMAl0SBn/M1nMcj15LwwdJMOs3Ja6yXtStOTl8JbwOqAknklhHy0/voLh7/6DCOeJng0R9wRzqWOM36Ad+8m54CiOU0IXyzLYYejkQ2GfT389vruNWI4FN2Gg5oxoTym5NDlkPOb6zN7gZWBT/5aicF/ovoxQBz+4+XLL6XbF0wzYqAdGcyghEGQJgmOuXCtj+E0Wi2QepgKQB2zOr2e3FaFTV+dBsNBmhC+OaMxxx95cX9WJotIeFL21Vc287fi1DO3UiRjZO2/peigVBqSgziu4+tK/Vg6Vb082wsXx6s42pzUxxgI7q8BuySNjytNTN9VQoNllpKkYXqiYXfUQrxug16H2RQxXIksKXa1oyTsV80Go6KPOgCqREM5vKsyLgVIrMWMy/O3QFgeRpm9hivE9P0TTc5NyrESRMT847M/o2uLmIwk8KKBRjpDFlImpPiQPkh1lCQwWj0ipp6R50fjM/Sk6CaeBZw+SIWLFV4nA0dlYiEd2eSMD38CRGkEMzXKEcig9AIU7zBzHWX3zeTNlqF2t+Q3/Gy64Zg9L4UNCoyaZuRC5Xby33X+/C9f/Psf//2Ll1+9+qLiVXdrLXScLqc4EagUFqaARysaTXFCL/bj6i41PI7fh3G4HO7MeKPeIwcLU3keAqOIKSLboIlpfDckSyVuTBHNhkdEiTDn5t4M8u2WxBXkowHPOAUUSHWq+lmeOjqTb4/49aX4hxj9OZBYxc9WSIX0XBIPjoIxknkjRT+luxLc/AolXOXKG/i8co3Trbx2dVNteOieyMMu3jeLtBC9CDgERpXs8oCV+KLXSFNMHdErmxSAogQbb1McAuJC3OLEJP+oRGw3TtN3Qxve5Eb4TQ90aayr5Cx3MG99wL1HVb134W0jLlJ/R5tS71ad537daiojdMHnkznROLDstm7w2Vxmcua+glCDvGnaVgROXxloh/FQQmJz/HzMl6tjg+eIhfcjMeam/fX7ounxe1l8GmeIRGmC7xYJZgsahSfwsokfN4v+g3Ih/VzcsUHjs2RMqs2EhQvXQrwObtbqEeS5skzWN6cikLduFU9gWL007lCEszcXp5fvrj/819XrnUfr7jl9j5CZzOR65e7plrqn1jD+GIzh9abwYBkCYfBnyE3in5r5p8PH4Q+cpS0e+edgRavVCeQddXriNg6ltUMNm7KTOMNWXqd2Tt2YkYal6bU8n48bqcenwoyUz1BvFzOnjhxFjEZ0zinjIU6Sqgr9VemnV3/5Hy9/B35rOSKn528nt7fC++iHi9ffXV193/SI1V3coSfob04nb97diBR0byZnP9YM8g0i0fCf1bmpu2dSaa3rPZPcjknl7t6OSTtwxHoUvk2lBXhcETEyf8NqvV2MvxuIV+SGR9fdCPB1A22B5ScT6+tx32qN4THF/o8cedF6JgGwoeq5HxIAHBIAHBIALA95y+tn/8j0OY89xdhek7z904RIHMIithQ+Sm9557CIcv/fV1hECfu9ig7Vamu5KHEmgx9MoazzrMZaQbBAcUy5Ks91MjAcoAp4HM1xLN/AaUqiECcFdnP9QvNmklcmNL4jS8w4Wq4yBZY25zNOkxWlUaBqOLFxuYpaJhzMVdmmyncFSuOn5yYSHotsx7oy1EDtPuPfOz+/IUzRk1WUJihyYCW/sgVN+GU+FsAIArZSuy6cfsRNq3YeALCArgRDYF7KcACGS9GwRoKVlOuPouuECDp9Jlwg4gKnHWIWJEQWIDuBu4ITyQKjiC82EJi5TYQ6PA6wTXD+ymisXq6x8kId626uxmqDvqtvoOQhgavtSdqIZX74w66YWl3rsb1ubtQD4xAzknTHVnerx/S8voEXlqdzLNBznvQKUhmvVrmOFbxO57iCSph7JKhGa0OuWLDAS3RSED1Pryfv/3hb+gBF7Mto668y8qr6UcVpZdX47PdnlQhGhhNcUrNadLDwewmPpwJZ1Q5kmUn9DOrLiUM9w4wvTbB+sqsGKxCNUAxU1r0bS79unDATemWynSY4oPOY/JbBzpxdhCqBlc3NkhTEKFL8yJH08VmiDSRYjAJpbMGTTdgY3tIEA4ln9ASEtMdOjo/nhJsXQDxrqUiKdCyJOZmmnCaiAPAaR8eMzEcoCRaE40B4JR+jFRlJ1GP5CoyX4ROzD+xpCVd1WtR7XfgkqXLjPgjCDIQB0t3VXPLlNjUHbi5u77KDILekvAdy9fOOLN8IsWwknuFE9lPmRcXzqAK8OvCZ4IqnLkunS8JZFgENnI7hTD6Okg+TpplwDJMYztASR2eI4b1vg1htNhJL678RZR1VsYNau8InW1vkdZOFi6OJyhSdJW+MnM1KYPNym6UPdXdc/KH0o2FhHF9LuJ5ajQFp51ahYUtldLv5klVKmUpPTQdUgDzXuBRVTb0LS0M2dvQTrI8QSxy+4u1TVTyMOIfub1BgIZrhyNbx5mpW/xlgVPMS1bd0nDj7b4W4IGYn8P+e/d9/+/vo+f989uynF6P/+Pnfnv3fsfyPPzz/n8//bv7xb8+fP3v20/dvv727vviZPP/7T3G6vFf/+vuzn/DFz55Anj//n/9Si9LHUR6cNSIxH9FkpGZRu0dV4vVG7or1hqnkDB9l0mu0NIqNykmr20YlzTgJSI6toCEhDZggHwFecXZcKKd7rOIpRnrQUTboKDuix09rEKilBvlFVXTwcBAf10G80ftSPookdh/FGqBVUjiGyQwy6IQBXRJudLAop3pHNRAJN3obBpyCvjBkpqR6woR+IiIBEeFSVtwA5QucPBBWhyingGIgy1WEl4YnGyk+TnNDj/YSNX4W70r7cyaeU1Z5a2Vf+V9CRUpTZuIzpaKIuC90nh7AGQFhNhEva65xBS8nGyBQAxpLhDJ89Ng1q9iMUfuDCUJ4QTeIhLq6d02r0hTOC53MRBJEQqWGgMIxqYUJctqi61yonuQCjGtbN6yvA8UMPXP7mPaYV4kbhCxiIdwANUdSoNfQcOVBy8XfNKLB/bny3G9u6THnJpbTCM+v8wELi9EKFuzlkmgXIg4EMMYJTzlmhdUct0L2XavKikkjrEeX0pq8LkIwJzavh4q9YEJhFcZefbxe3PwvQCsUiFoG3Sd5prua2RlQeoYKd8956hnCRD4/bMM4XsIcxzipBF41XJsl4pIt+dMXHZaqnY2xkHxD4vseS3WuepqVCvEaIvFvcdULB333W2x4Bh+sR+WjP/BDpYE3LTdFSYI2g23RLSDKBm2DtuDXjpkrmcG2fLbnPhbO0aWFhzlMyrFFh23ZSd04bRhb+fYAklPL2YFMrUvi+Rhu9GYoI+tgi0UWwFWOJs8n/zrrkF8aXWVP4KkefDVptFpFpPkU0OxBNQzPeLDdW9Gm6diNzqPlSimNSE/tRwc9SJ+HtFk30v/SeIupVTHUn9J7i66PWojdWpzdTsPSCrR67j+7rqXXy9amfzkc9t/zYffS4rTCdCkcW/U5rVDL+p5WzU4rxBbNT6OOpxX4Z9YB9bjcng0FNtqhpwMz8PQs75a7W9nA4GqlyEUzNzwqbDqdzeCaMkYEMLnkDE4g+u1IfHnqMd1WMlBQ4tyJPv6TPi/3NfydEugFBuNd4LhKCJWplyLEWLsYX2Q+y33FDcqug2E4FVvZeqcyvmwygwjPuPKnPTJFbRxjMT1C2CbxP7vF2GnLG9cBfw6Ttnd5SvlCsqYaT5VOWsEBVRTbF8MOjKXnriY9eO69MtwZ1Tmw2Aeu48BiH1jsw2E/sNgABxZ71yx2QrPUK3LJu/DZN1fFvorl1L88W+EkEOs8xzIm6vmg9c3N9aUy+5dMCrwUSkV5yEqDPfvXZogZMVcQTRpf6d0oUwarfH3yo9TvquL7g2ZyiUKB1Rgur+4uTuClEzMxhgIGL1+8kMM0QtXRCABFTAtK5zwHKxClj24EOcUGg6++/NcxvBpXkZQnOhvkBfz560aIlf5//lrMbvx0t5xqO+3nCxLcZx7QHY7rXaFjITEvCe4LKvqWW2ikx7KEaOfMb5rklNIIo6ZDwWmEEzsiwW+Gea+jgmh1lOvpVzR8ymz448HWluuKY/mKhlKEszCSwo1O1i0lPPXFwzSH4g1wpDxXS3E8PCGrCMOf7/HmSHkO49kMB/wvrUBV+lLF/OmIpixv0p/Nf/2lTVDsYv9WmJ142UOt5byQ3YDEIQnEcql5y+VQENtolvmT8xzDhZA+YYlRzNRPgnMtAGRj+KGNZJk/64RpBtgqGguXVDjIh2mEj+A6wTOc5L94gUdxCJf04iMOUu5lp+/EDIqiC12343uc2eXVmt3jjTqX8qfsuPtNL1N4NO/LPd6wcbuGwdBHDPcKS61pyA72MmUcphguPhLG2X/6aFnUX0CXUxLLiWkUObWwNFseh/KfEt1db5aZROcdu9IdLWd5QALHp0yVzxFEcEFWfourLJ6aG3wvqyQbzNSZV2sr1+Li1xRFYy+459YroroZQGIbf03JGkU4lg/6A4nCACXezhsmpMKkIEWSOgcozkhwftL8ToN0upIZWAMZdSno0pwmm53veX6dTABg182/K0OwT4F8E2V8oe+0Ze7V4kWHZyonuLlbdKbJqBfMjLgVBBUZtyFcA6yMAc87vJiGDMg9tcOJpZRFmEpcjfmRzmcur7Tn+Vc45ODFEcNrnMCzkEq4wneEPx/Df+OEehP5GM8RJ+tMr27YUq68IgExeAHPJGgvmGS5xCFBHEeb50a7qLyfxoPduzx1U1vIKXY+ye9Fr+LTIwH1eXcyFopK+UVAyN4IwjTlOcopnRdQq/aWfnayA/1XcTdQFqWtbv+OqYW3XNxZJGlzrXpICMdngrHtY7f5odrb7LIEDIH4Jo04HiuwAxOPj6fYqGqq2saLKV/Bzi7MPzi61q1fm1frwTPZb+YHz+Tqmhw8kw+eyQfP5D7P5+fwTPbkAdom0IZ66Zlktc1sP+hBj1k1IerlsFE6u3UeFDXeGqhGG20rHVmW/Uf8wGTglC5iYY8DiGetnDAjETmeyTCuZD1elybxDqfWq9ExpLp2PZw+DoYLz0EhXnLVjqn7OBd0vCp3nOB7OI6BUzi7vZ5YgA7R2r8He/AhWrsPXT9Eax+itQ/R2vuL1m4xSfoaIs3r6TZGOvGezOwm1adR65v6PX++4d5dzZqCgtTtQkdjpsNYWQPZ24TZLh63mSs7GykLBshasOBjmqw3OzbAbTNI+hobPYh5o2GxlznRKxjPz4hYNRA2AG00HbaYBRvAdjIYeix4u3Gwl0lQm/vaJuJpCHQY+Rogt5n/ak17DTBrjH49DHoee9LBeNfHZGfMcY2eQl6GOqcRrhFss3mu3vTWALTGKOdhcGsAapvidmhm62Nc89PV+bDULeazPkYz8wy3UFcfU1nFDNYAs7eBrPX2tfB5rSJ/vT6pFrLKCNotiZ7sUr7eKj8koDUiEkmgU4aTdQ1/KEnX2e312dOsFr1EpfKAN3E8gswQP872zB5DpSa9PtuGrywvypnBpSQvZQOimmMhEAH5CAQ4EWcdZLrHvvxfhBi/S1DMiMmk6nnn3iDGc0eJbGmBZ8BwqLJS0hjrY9N48QDF8qa0E5cQcTxqfBQ83i0xcVWFrMOk7+TBzSdOmDXzB8Sy5JmfZg5LzJgoLOKH/Cks0iWKpXezvHG6u+HnZaF3badDU5rytjc32+mtGIgEI0ZjzzncLbDukFlTsw14yvTe7AYvN6WrwUvTODoronQkDz+dwV0iGLhvUMTwEbyL72P6sB12vNH9oLhmm1VGwnLUthi93R7SeN2VouwTP2gA5STWHhaBYofM2Jul0xZ6h2fsua4KUJ/5bYrtXOHjQT1ZIDH/46v+M69jqcqJ0VvnXk6U3jJ3lGAzxmeYnSuZeusMXcnVMxayMlNT28K5vVqMb+RL9r4MtVdHZ/8+V5S9kXN7X2harG1jXgZBdtPVPEFht8zGnL5TvVp3ZjKD07y5FWUjVkVF89hGNNFAI+S+fWLsJeIkQFG0kVU7aMpluMESxSmKgHHsNNo2R3FoeuJLRszZ0tuhE05heEBK4NdTAPWqOSeiy1XAKkJxBqirRbT+WXM9aCLTY86YqhcuEfJ6QCKCNMNH5nMsZvhQF06gzo9aBiGfmpti1gIlWInFbs+ENi5WgzvxsVMYxr60G3SWnadBz1cxxCsch0K+0Sc39ELovNLNWnRz6R4WWLDGjeq2YEGiMMGxdknTs5Fra64HcJrtQzbzpEG70B7H5MdGVySHvEtGc40ooQ+a4KYFcDA8dQ1oX4bayxekdZNbOO7CPN8a9poBqmW9Z8DoEgNOElpvXKRBkCZs0Jtda2KwS7keRFOzJSjggjyW+G2Jqnrk+mIkO3shJMhQdkQy2bhIgfph0cBq1nxys70jaaUYNPaWZVXDwsnT0Z/F39Jp7q8Cf/vHIKfVKBAmQhxelosDDYeFWj/yn5aeA376eaAGw+F7U6NH/Pi7Lq9EDMO22/pKhg+sK7BkvtdXWCKFAjg1JZZIXYklko+/lxpL31HG5fR1uupsYMjwBqbNZGFNQSCRHWdBWbkyoC6vVP3gvIWOGkW5f4C+GSDnLdk126MsK5w2xTiG1WLDFF9XuJPCWBggXjsJVdXIuLWOU1ZoqeZy6oBhXNhO4OVWU0xVQcBZgs00czWoNVk/7AWUCvbfFH/0QpFyFFWRVPiRuANGXECqoGS8ijuiNRG8kTACs/wQFNMGNCIlml7F0aaCzk31g5vbGZWfyEyNHdOxfIxUkeVK+cO29bLE1hsNo6kEmKONV22tCsJaUO+PuAZQi/R3td+7IiwZQ62+UhyAog0NKAoClafLHTcFsChsSz/2v+Olk2qEHLVaZgbtp2KBWBXJ27JCzQehclW1wvtWGf/xllUzeBfqqsnHCwqv16Gm2qGm2qGm2mOrqVYunkJm0tiklC6Zq7zN/zrvcZsiqL0yyhY1UYzw64AKLdVQfA3E3eIMBUJ1cm9TWEW7QdgvqrBbDKV3JKGZbgNg2GEMYY9IS5+4wY4Rg57xdH6xgt4hZL7xgd0jAz0xhX4xgV2iAf3jAD0jAPcS++edu7VzAF1LpN9Oqnu0G4NbI/q2iHozqoh2g6ZuWL5/uT++XcirkYZ6lRdr3NS2eij+MSwtZ2eb6ie6xolz2C3rnrSWi6uvdbJllZO6LFo+9U3aXgufmiZbVnCtv6Vb1THxso34PJbtKZW755f1yizbLadsh2yyjzSP7BYZZLeJH/RJLf44UiR7vW0+aZEPB/ZxHdgdxRnCXmINYX/xhvDIYw49L11rE49aIH2rgNh5XAe1K9yr/ke7d4hvzY9dVftoxci7wscWtT2aUx31rOoh63bUAu1cz6NcsaMWcq9KHrtxO0k68ZUdmcrG2LcutToObOSBjTywkYcDe2AjD2zk52YjfetdbFPpQr1T9X6pXWpclOpY1MYk+VW3cFSwqIHoV9eipnZFXZ7P5ooWHlUrmmKCC7UsOter6MCPNdFUv+oUfetStNct7FCRot2H26MKxf7qT7RYEvdVc6JPtQmVoKUlKrJfnQk/S6RPbYm+CVsagYJ/PQlH0pYW0J6VJLpUifBkc1orQ+wriQvsK5ELbJHMpQVsrwoQnhvhV/Whb3KXto3oWOkhS/DSusG+NR6sJC8tML2qO5QTvbTA9Kvr4LmTHWs59EwJ07byHeo3WGlh2u6Vd+UGKzVMC0y/mg2O9DDtXhK11RpqU8S0wHQnkOldg8HfkcNXxPWouLCntDGwp9QxsE36GO9b6yFvdWCb611FOlRQ6Fs7YRuVeLMni18thMal9K1/sIfKBwcvxIMX4sELEeDghbjllv1/1gtxi9wz9ci1Vg5orBmwTZI2FVBkTs3K2LtVT8xqffNrM+x3SsTWcOvdN72opQ/yn613KY8I6uG8J6IoTwa1uqImS4yvDcbL+lJ0EKwNxjTPK6AZxwngj0GUhvVQ87gOyfzLd2mFElKboffRmX96GX5kIOqj29XbdGltaXaWSaxEeEHExUaJTZo31RP559gjYSR4xBfPRJirPXkmbg/hLLtOz00a8vqXi9oXVagGNySe2/5JR4B5MB4f/VNv828zP9vCf39za4j+KVdBb5jl3DkK1+IVCqVpMlm6YizzP5nEULuCl16MbZxT6FwciHesmQlC7eb7LsZ7b9N9MdVOjmuW1CU72uoxMebkoZ5XtBk2c+w0ZunSOA5pF69MI2ycQHRKo7HKmKkgN4LVL5tUpSlEtXouS6mY3Rc5WEBXBDNrw5rVKHOyFrcOwa8pirly4KIMJyayNl8Vec/zkIGgoWCB+WMYj+E7+iA0blKNF1LMgMTiTTZvtr1sWaR1k1D42G5975vvIzOM7FvVz+Tfpr0ppcmwP5XzVdjf0jqERoKkddUAbZmBeCLToRgatl064utJloJYKtTq0hDLpoDqRLNDGuJDGuJDGuJDGmKPNfunSUPcGJBazH3jm2jXtC+RYfkyQWB4Ew3cifN7kfwLQwZIKwkalSJtCWlb0u4uEGsPvH2qvHZEW/OeaDW8zuYh/RCPYEHmi5Gq+sjS5RIlrmlaSXTME6WmqYI2n3YNxHWkYOqSONhv19TOOMBCvlulFEx73DWTEKt1niZBFhDlkqidDtVvtVlDm7zNmvSg9TmlTIvmdFmmlSPfF+wnO7KWp3RuQQNofEiKfEiKfEiKnA9zSIp8SIp8SIp8SIp8SIq8x6TIa8liqiCcHSZFVpyryrDiSopsf3cnRS4gVp8UeR24ciLbnfeVE7mUtFMNmZkL/PLdVqNhdpnxVqNUiA+pCLafLI+o2nLNgP+eEonaZ9WRSNRsvPpuKI7J8GRBBkAMAnn1s2ZjxxDygdORofKZUKsRzwsDDopvUiQGy8AyiMg9hhCvIroRkXpHwNaBtBYeEpweEpweEpx+kgSnq3QaEbZo3Lxr1Sa3U4s5Z/eZUx2eJu6wvCL69rtiU1RqtTGQMR7LlG2TsHzj28S/WPY68ckHNznPcdYTbcbdrUzI52Pw7yaBb5ld1tAtVE0wW0ffFdfqqH5v6ZmKXbM7vyNHN/+MAI2uBH5OBB7uA4/M4tzD1ux2HSzFEGXym95No1NO4xAn0jfHrU6xjsK4s9mXWZzTrN30azNaM1gghbnl8qIMYzpZ5VkO/SxCZOnEnzDDy0iq3yu/YS1D4ZzF6fXE1iQp7eQMC0VT75xFMuZKsple4fyTmRoykW8nBQQrggNc4FJk9ngZoj+r9UIz4e+qx5F6PBWaFm8jqCgg8WyTEP7r9ury+Nu60EM5Dym/MqYkeM3epcEClD1BkCcp6I+XKCYzzPg4T+T406uf69bwGyrcQZEyqhC17hlHIJdfaYflcmQQdZ2VuhWAFQ31tB/kdDm6x0D1dFMsGdUTGMrqCzmafxMM/D/q/ImeqeM7FI2GCrmMEbQDEzJ4OtjWqFYbIk+w4BKeA02AzCCmFohYRz9kUcoVpH969XMtxsX1AhKH+CO8UlYb6QoVPhcuTwL+Juboo7x2C8pq/QNlXghOYYHWWCm9HnAUZck4HtBGqXbUxikXJhHY2XhaDft9d3V+daIwEwdqHpv4whmJkfLZKkQy1kBkqYQHnEKwQPE8c0CepYIxG/fOiebiiRt44zIR+YzcpfcUY9+8ak8vrRPfOMWmNCx0jZM1wQ/HDzS5J/F8JI7pSJ0HdiyQYcdP5P9sNSOpfvGflmz+KeYmxmHHT7fNstbllXt6qznOcm8Z3y1DeLXUrGlvDVAihLNQkWwUb/Z+uMWySqtPsBlpleQIxaH4b0YYF7/3XseUeF7rd5PzT3PkU9L7Dvsydrdy87vwdqpHMfJQHSHNZ4q7879YjFa3C8rjuigqkdAB6YwOY1Cvj0mRMyNRhHX2ITLTEeYIgkhKN1o9N+7sNUEjEmw8snOLZvn81KQyES0TwhQ8qe5EyRzXZByPw6xgVQ/eddUpVY8e6HQmVfobp7U9kwrLmlA3TWEQYyVOTzGEhKk7iwUTmFBm1TYrlhDsPlMwkaK35Dd8MugSHyp6ZInhlT+uAgVMfolhmUZcJMdpi4z98uUrmG44ZhZgw3ExK0ZTwtUUoF62yv/uTE/ZMcjUT4ovEQc9wBawrFBgI1AdwXEEjALhVni+2HPEDSxzXaRRVjq2t8RyqqRXObqzTIBkQBh8Ad/LJRrDabyBFX2Q1cYbYb6Sspu1uiJF16uvNCAgTAkfTWvY5jxUJABtkQmli+J93HZ4wbpeMkfdsGbvfpHqLTR+ZqrWD6crGtH5pnX9mpwE/PziSyvVOx2eAuNp75ZtBWNjK0+KBZEs/WADWS5tzxYkzSMtNLi8dH8r5YJG0ZwmhC+W2a+YQZLGrcH+v+GENiY78orP+W1Nox9oco8T/7wFkyvdw96NOF1OJcGQ+QlRqPMPYJXKpnk2SsEPkyu23XR8T53KuTCjnjkYi51ApPYrvI+5FozOXGa0yvhMZGCSLnSqNRBmexMCjTWnLpkzNYwXXMAiucvTHWS2K0+6nPioNPNB41H1pJw+107le2xPN1Epy3KJFAaVWVj1hxvXuLTWaqnZ9slu2okuwCib9TY5c9pyFihu1y/jomzqTZHlG1ePugQGJpR1F9QZdWUArmmYP/6FDJImZbnGUuaRbICajd2ccM9nEqqd1Bfj8DwVp0jnHiTxfKKSfKmfVcKwlqfI+RyZ8tqJEtHUcMBp9kFMmLVABdFBWNk00WeIEzZTmbfMToj0wPrNs5SdrYCnG0sleATTlAPh0mwcLChlWJv31LhrQiPU9tgAgIo+SWBJk4zbt9CTvLQFljBYUsZboWZ7lblix6peuPzHXFo7GBfRBGLQB0zmC86OWsFKw6vg1UWCXQutJcZcZiU3U2DZ4WiFqYnOUl7bZ+bGGWP6UUaUyqeuFbBru1U8+fOjLOs/kmsw3QDhMqWZB1y+SGg6VyuJI404nVmHQ4X8hiLpBAzV4g59zq1cuXRpTBNyfRdI6s6ZyXlnJWMNaJJgthLOex5or3KycoeT5X9mQzxjz/OjIUJNzMlACfZYDCxPZH7ixi19Wp7+WvKgD6k0UOUHTa1HCD/Irzi8Ls6ydRhtb1LpLFEY4hBWOBmpo01hRuLQMUcPsHplPW6qH/V1bqJPlwo3ZfKwnwpo+S3hOFkeAWKMBsQjy5P5y05O4TTq3Rp7Aem2BACgSvM1VQn0WIhTBQV+TXGyAaEqBQQMc9u134Mk5n/G0hUgpp6qsXfn7isAAOrsX+TErUvf0mKUQQFhgKRLayeYAHSml5Xp3SnQ97GODs1/6QgeJRhOL8/bqUwvmuNxWlzT6ggX1OJmkExyPK0Jll56R51hyvy+ygEQxXmWTQlcsb+sM0yTXVkA1d6DHWH0O9gA4JOB2mPb7vO81Gr/7vGmB0zIc1ln25aXcxr3gOidBdD155cS2mN5qCtRdA+g4E4unRPUfqcHAACciacn8RFcUi7+56Jd+K37E+f6nGJ2SbmE8sk3Ui3L1tuowEiyEiuhuk1bXvdnu9cyV87eXlAnMdDE7FeW7JdpVEXa6V5gpxhiGo9kpuCd4aqOE9CkcDJstPsdtWyqWXJj7Q1TrwTxeEaEBkiEe0AopSPlIC1ylpMAljiZ416AVz5VD3b2zu7gHrUrlOr/fJRe7r9Rr9dklB3RQZ8pNqrVdrk2kiV8I2tgd1ua7uW8dngaqlytmoIkjrBEK6Az+FtWvuQfXfkZRBJhlZThWBG2IRnPL2vQjsAFdpU6CCg2SgaBeZlP78onPgiHO3FsM8c2GN7jzfCoQDsHnenQcBIPj7TXXIm8G862I1DplzGUkIZVsaGvINDrDvXolLtc9ZROcwCWc4BSAeWfOkw944Bvq/wrPDNaJTQXO8Wf/2cHyCLiEmii3jQpMurCDEPj9/mU5TgPB3t7S3rRjO600Ri4v8ebnnt7p9fFcqcI6CiigbRRPDMamecd5q6C8otgYk5GGaxMT9MBqFQ7ZjWbqidIEzx/Dbrrfhxpg5eFuvTJVU6+qMsZT9JYRoDS2KjiFbmTRMQoDOUUOgCV6yarGeX7bmlhkQpaijdywC47Zgyroi+dWbKl9PoK5YulZ9SFxqmySTay2rHZ1Awd7O0+deWkRjaagz1QYqWE7KEkVR0tZag6BvZd8JxjUStaUt8emSuUSNfxlyNRnc8LsK+rUlenpT5bOSpPy6uPWuLBDnfcl5TXGZR2YMbUMqihukWWJaOTMG0/7bYxSSe7WSqXu9y0Jr3tTF3B0KdMEYB2CtRApIOHCSsxFThrZ9AK3J6hPYMAI4a165mZBV1ilRazFaoWbcUUsdkVeIbH8zGEKdaxSzRUlF1H2z4/8rJc6coWwoZLE/k/Yn14IgioCpRJZQ4oVc3J59YLRKQjIuFML+kPOgFVguVWGj/RzHboh2qk1BIzCZSV6AqnyiLb9SIi6U7OccLku3OkDLwy1TpOlizTVygrOtmPce88j1DFushryOCZYBEicYwoyytHDgC8FqvApyit5BobE6tyVMxYkGfs+fOBtwbYj3VrzpJUfCAaWbYqe+QBs8BAebFFHkDlmubs0J+tt/sv2zBEOSMkFzZjhfKjkDNCO7am9jAj7s+E2NN82G3GsJXZ0NNkmBkBvQGDr7nQyych/+uoIegscu7HROhhHtTmvk5gm0yDtqmvE9Duhp2+JsEe5sBWU6Bcg15m0R2YAXvrOPua/9pNf8qM1xEstJr9lAmvt5mzxuTX+awCwFbmvt4b1s/M127i287s6DKZFUx1vS5GxbRXNNN1hllbNrRiotsdupZ5rjNQBanZNNcZqDTl9TPL9TTJ9T7rfc1N/cxw3U1wvcxvW5gNuqxDL5Pbtua2Xju9RzPb3kxsezav+ZnWurkleJjVioayDrB3YVLrfC86duhqRtubCW0/5rN9mM460fvOd78bTetsKtunmczfROYXZ2KRbD/zmG308nWubjSNVTU7gy6kqmoWcxq7uiyEQwPUaOjyhF02h+3IyNXp9HeziHRZv52bOLwAovTjTZY72jc07dTqlEWjmVCaTONVn0LQ/ptu8pjzUR7RBoyEGAKU5Imp2C4qhEdE5Og8GeyDmfKoZ5gdjE5muA61DQHgsZbGA4D+BfKc5/DpG7mbpdoyS/SRLNOlVbBQH83WMNrsSGvy0TXRTiHa9HiJYjTHIz34KAM/yk50bR6mzjTB5Jw9HOx/joN9o/ezfLRJXDzaLUDrabLU+mSjEAZ0SbhMfdkqEVs5EVUpT5XGhQGnoC8kmWVxqvijCE4nPGp7Aa0AZ1ld8IEoYzGKQda/Wpp82lnqP8m5tL3xv5Mr7NUsSvsnxmBYpxioSYvRZlHRucNAZVZdY2HtajzUlm/Kn77YOo3OksakRdddLKKi2gOORd0UBjSBkDD131bsPP64ognHzUdesCIBSrZMZaNiQX3MjN2JdJ/Cx5cWNgWLiD4QJkFaylo4OE41I6+lD5AVXyxOTjuZiNNjwk6VcmTb+7BKCBUV+s8ixFhbBo5ShbpSz0KKBVuq523aX2umkxlEeMaVIH8EMc0whEAMBIRp0OHWaXLk9TvHq/pEv5VJ/++sS04NJBiVZAxxILdnt5OmHHPmT4lyipnWiQXmVGzw5EpX9ZPeL848+Z2nqvObiEl8g4IuVOCm3NM2cxk+MSOILRyWBJVTS8MlApfJc2Od/zUnLp+MNiadBbi9Sm/Z23iQ1w5s7UFeq8H9IK8d5LXK30Fe+6eS1ziNcGJXtvM4Snd5n6Nt835Z4w+2MhVVsvAIbldwxha6QJiug6N4Ij14q20NxRsQe8N1DikrsxFPpIf0nzPT7xGezXDA/wIpa6c+mYlYiq3G8Ppn819/GbekOfOltwqnjt7OF7KTKeifCaViGRQ84FRhPx74Rvsoo6HspJy3LXBMO6Dnx8kDrH5Xc4szhkuqIyXwEVzLPEj5L56eWJdURVXgsadNxOut8fILLGzB97knoFop4wmofsoOts9C5d5/jXtxjzeZv5UHWO2rqMXJ7Agb/yTlAPWf2l+YLqc6xbkHZIUepxaGZpPjUP5TorrLDfJ3Vyzs0lW9g2LB4dDLDp37T4yd+URyj0K4+DVF0dgD6rn1nKpOBkzFy+SBRGGAEp9LIjNhS5IHjGqZU1LdAMUZac1PFmvnLQBAV0YhgaggCoLuzGmy2eku59fmFot6sp1TvJX7VzKk4oRQnxWks7w2dI4VPFOqC3OH6MzQ24ws+VBGi+Oxy8MAUcFjz/PXL6cvYw+4rzeGPTvSpQhkfXPMj0ziP31xFXRPOiKHF0cKr3ECz0Iqocpopudj+G+cUHnkYzxXATLeCU6MjpDrulmIwQt4JgEDWS5xSBDH0ea50ROoQKv2hfDVj9jH00+GkVPreCbfiz7Fp0KC6f5OZMwNHdd7tFpJZrTPiwdk465qM+N/FWccQYJlxWB9f3d42zuxyk3eCi2AGj83lq0o6Xx1yzJJkblxUVQsgJg2bKopaZ4XxCvWQSzkw1/hRJWLycokaOHfCXq6gZThZGxhm3OiKlBvuUy5MGT0qPHRVGwROqsbWtUM/uoFT7XCI1Qn9FQjtBZiNIfSbFmHrOVGZ2Bczs25liVtFL0RTJNi/4gUjFUgrin8Wgu5XPc0WJCoUoxZlONhC8qP1IihHp+Nt8yRfyZ0KF3KM8gOzjUtFi1tXk1pzhCvsa4ZVCznK+sxJ0Dj/NKzAEXi+3FIH+ofhXwfjpTvrrDFyv/Iv5iMiyRU/25ewfpb1uy0NnLVcrc/2+vfjTzXD9ww6EhXT3J9METRuziuLObeXB5XNim/CaryF7crChwrYIb6uytdFR+RI1jRVaquizGVqEpiUdU8cqiS+7ir5PqVxu1dAFfE8NdlmmhQ0TWX8T8zUPNAgRBzRCKm6+OKM133RtFZ4UzXVQ1rPHIAEWL8OqFTfEeaDPSFebxBjCvp7QGLIaY4NJdHTaeeizWig6DOIwFisAWfK3C/S1DM5KC9JlBAG3gGTFcZBhpjTaWaWGgKKJaM/Sea+BIzhua+s/0uXaJ4JB4wwZeazka5KS5F4dA1TDSS65Yt0nibOSQYMRp7TuFGNgbCAME0IXiW1+jXoyglTGbfGTRrtGeIRGmy1R7IJn7Yn+nXKr/upbur3yMpdbaU8QYAAIfUQhhMpQ9PgoW7SKhKkInjnY/akntGF4zjVBjMBJBbjhKOw6eAedB/qZrZG4CROZG139VRaXzyBgAdKXqz5LtaINZeC/NatKrjTSQIoLMq2e9Rr7K+/lSlcFG5/FKGjylexArVi5qrn9UWLWp487zqkbqWvXa/dBX2c0WmGvnF94WmiiUNtZ7PkDnBIqareYIqsThN7yVKOX2nerXuw2QGp3lzZ3VAVTNWMSqigUbIrU0SYws2N5CJjkQAEE25pGRLFAuWh3G8YoOuro+6SnvrdM5VO6Nk09uhHRExPCClQNdTgBoOXPNRgr+GVYTiDFDX6+AWG6BGdBCJhBTeqp/K+yJM1CQiuiCmqcgubkbsPtTy/KhlQHGY3So9hSz/F3PX3GpV/ShwJ4MOz0lpN6x0Nn3rHod4heNQUA19cv3KIJ9XulmLbi7dwwILDglQwxskFRYJlnNB2Wzk2prrAZxm+5DNvCmNU/MNMFzNO6kvaGIhKwxk3iVTPhuOUh+0B8QkcDDqiMF2vKGIRBUsXKPqqnWTW5jHop+2aqsYr4XgI6HKR86UegonSYOzCw2CNGH9C5s3MYs1rGJBIpQ/Gs2wRLVRuGrFSHb2QkiQoeyIyG5VCrTzyt41n9y82Ej6HZR/qko9o9JDPGgcj+FkjcPCWRUsrzh6hd/Sae4hC3/7xyCn7igQXkQ4lCX4xQ8A9yQOT2CooqxXUZqgSP8zk+LYCfz080ANhkPND6gfR6PRAK2I/u0E0IrgjxzHsoWpTL9+OVCjnKWM06VxxpUJ8OQAA6OIlTjGMeW2E1GuQRrNcTwW2o1pSqIQJxK4GXr9YvzF+IXAW1dlFoSEcbRcnch7PlBx9SdKTlCsmikzPpa/jekKx3gqcB6I/RPDzxOark6g8l0BK6yhxZVe5wo9Ed7+vfv7G1NnyCy7A7MBAABb0IRbewYwgmC9Ut9ILM1d1d5ifBZQcYwuTQh2OMh4Pw1rpFdl/dIcn2CBl+hkYClu49Pryfs/3pY+1Oti1PRy2RFQ0ciU3RpJP6qlKTM+ulqGsu7Ztw5hEzP7VMxEtdMR5YUnH4d6+llhu4ztd1EVGe+t7+gYbsX1TJi2YYoZr3HCJV2ax+S3DDYzjhkyBVmZzsi8lDGKjDcEikOZoTPBYhRIYwueSWjk8CGcE26uX0CXy1SE+h/LmyTqX9OEHYd4jaNjRuYjlAQLwnHA0wQfoxUZSdRjpYJfhk+yfXg68KSm8kI07oO4Ejrjm1V7JV9uk1vg5uL2LjsIruAUtfp5R5ZvhFg2EstqmtJpIaFLCRPHoUzBKv/hDNlg6XSpCqFqB1RhwD7Lastr5mMMkzhXm+x9G8Rqs5FYWv+NsAmr10Nn6F7947vCQXZ1yvdaTF7eirLJqSa0glOr6nK5T/Xyt/H9/rbx7tXmaxECAACPKvOt9eWbZgYAMI1ocH9LfvNjkl6b1lmsG50LaVeBURFQJM7T8jb4U3358hVMNxwzC2ieWkbAtmBqA0G9VQAAdBoe2Ut2CrKrFSxETvAQhL+zBQgWiMEU4xgeEsI5bsjSxigQbiVPYSrzsoZjmAMpWqhEm9oBKUenSeWs4TAgDL6A7+WyjOE03sCKPqgw01eK0uSrxim8fPVVLVQFBAhTps/xoH/u83aLVungex2l7pelPXTL48IoctB6bQAAoOiCojIdm2QkPcXYNiNyaVW62r9095OBn23fu9B3o/KxiYa1KlTo0iQI8ytQvsDwW4TtfoCiuQgPXSyzX7FMb9PozvkbTmiLK0CDWPnbmkYtUeQ1EeTWatfEkKu87A2nUjEXMLliO5dHsxN07avKfnpT7AALGoWFtykz/efON4NaEsKE369UzOYGk/wxD/MESFIwUEO0wlTq8adbmITLkyy701Zn6oQJPhSt7cooO0NzoHbF2nCJ1KgVrIWcVjA01MLM1rPe2uB5e9pNTWaGu7YXqaCf1kN9J5t5U8eqrFkcT4pbivPvTylRl8fVqtztEf5kYJNaotOGHNhl8revBVIh+Sb7QSKNo3oo4DT7ADKtGacNQEFXe5BEVhVh2BTrc1i17f0LjOSu60cwTTkQLkXEYEEpwyaZgxxzTagUzkF6EDb7WC9pknG9FlqKt8xBEtalmDoxWQZwnp5vLp3OdZF6OjOV4XX1CsGbimIYrcuqw84w5syuKG/VVymkx3zWYgLJ6QRm/Ki2yoxz65ojDzAPxs+PshhRJOc93QDh0l9QKggSms7V6piyIm2BIfkhUKE/oawkMlSLOTSqGTEUmWUFYmDRUpFLnmkdsWAF9DWWQfrP1mgGXUw/PwILMl+YE2DIQ/FUjXcc+KgPmRTq88Oi5hjCD/IrDq87FWCRq6+cu1EY4hBWOBmpo0lhRuLQMS+zFjuKYSxtRccAiZssSPlUQMrPNpfVrfL6WV4hJXzhOCtq3ceHyh9bzhgOlT9gK4rgcRoOlT92fGAB4FD5w/o7VP44VP44VP44VP7Yz5u25Vk/VP7ovw6Hyh+Hyh8Ah8of2d+h8seh8seh8seh8seh8ofeOM9S9l3L2Be1bQOvHdm+hH238vX+aV58t6pryXqvcvU7y4qyyxL1/crTtye/7l6aXudPaITaqyx9jnl7xmK/kvTOkvONwFvL0bvLzbcYPRpL0deVmm8x+tSXoW8tM98M2V2Cvq3EfLNFsUv5+a5GnR2Wnd9Hyfk+5eb9q8T7lZp3Mx0toJvKzDcWkR9szWZUC8gPOrEXO7KpdTQu7cew1MOo5D9D6G1M2pMhqYMRqYuk20mk8jYJyWl6AgU/c5A28XgDbTUFdTTv9DHtdDTr+Jl0PBMjOpa3nzmnlw6tjxnH14TTNTdq4QV3m2+K5phOMJ2mm96mmF6L3d0Esx/zyz5MLx5mF/+kw9Xz2WBy6QyzzdySmU86QW0wtfQ1nfQwm/Q6l33MBN1NJd3MJJ1NJD3VwL5z7mwW2cYk0nkX92QK2YsZZI8mEG/zhzJqeELtYPoQbF63C9SLGex01js07mLq6G7m8CTUHUwcxnjhAXVX5g1vmtzpDvvTok7mjH2ZMjqaMXyVF+BUYDSZMJSewAOsv/nC1hV4pnhvNF2UtQUDH4LjYbaoGCS8a3RsY7LwPtX++m9fM8WnSvXtV5B8m2LkTUER2xYi9xF/2wvadWdevOp9dav11aHO1yOt8bVFfa89Fa17ZAXrvO60T6G6w4F9XAfWtxhdpyPrU4iuUGqu0UbjXYTOWWauLVNucwG6x37pWpu0lgHvXwLclPmunYFX+W+f8i3td7ul5Pc25b5bSnq3Z/TzKeXdjSx6UK/e5bvzEt21w++odHfr2fUu2b1Fue62+ss7KtXdumPtJbq3KM+thJMWNrNvaW6POHjPktxblONuTt0BADnV6lmKezd0KukkrnSUVRr1Nt3Kbh+kk4N0cpBODgf2IJ0cpJP+l661iUfR620KXnsUtW4xE+yrmPVeCln3L2K98qJ2PsWr91S4uk/RavM2+VT59CtY7VuO2pN+t3oz9SpBbSScVvuUf/npYnFpz9rNraWnS4WlW8D2KjvtuRF+zlW9Sk23JWuCzmWmsyLSrRvsW2LaKiDdAtO3vHSheHQLTL/S0p472bGkdJ9y0u2mwf2Ukt5HGekeJaQV4Ba4XctHtxWDAoBdl472Lxvty7t7lIvuUyraMAce9LxDmei8CLT3setRItrr1nozknW24u6VJ7yKfVaS8usSPkTVbFqgOJRxS3khnzNW6tEl/3Zdja+GNdyurkafKhmHchh15TCyzK47LodxY+VQddbD0A2aCmIUlKPughhJfUGMJMPApyKGrUKRQVxnAkicDQYwqkpVuSAfEnYPErjYLJYulWYTgdhTppOMCl7DKLJ0racMOsBfGY2vEV+cwFgd17GpiDvmlCP7nVG7eBpVI6Cct64Rc4W0kft1xvhoU5iGfLn1TPwwFiaZCsLvWGdcJ2KV8qTzpmBYVgGIL5y196qYSTpVwei2XJ/HB6fTebki4U0lYbCFgLnZ48qtrO7oHFdwCfP6Sruv2qIRL5ZtcZVkUCSlsH0AiEEg6ZmrZtqhasuhakvh71C15dNWbalec5ky29RQE/+tNMaNhAwALC10B8awsXTJlmVLRKENd72UfiVLiqVJBm5xv3O5kkJZkkGNbNi9VIldksQJtXOZEp9yJG15LJqlzaba/mXzua260JUHTffaUvSDjjJcS2GLIgU8U9w3yyzZ1dIWgt3RhREJ0zi5Ngd/RMIwwk6Axn+ns9nf57+Rlfx/o8u/R7998ffot79O//5bhJ92nZFmgkjoW11kcg6EQRqTX9PMW4pTIIrh2ticVVdclC1jcu2ZyX9ybT0KQFjAiAYBk+ss4bdS7NRjBBCkkm4rlf3kSvp5RRSFSgspFQJ6a7SqQdBriMg9BhajFVvUlD8VvVVB8OyZ6boiLRVJCovy33nbbeuRNNYi6S6gd1U/mGenTf8QrJNxh5fFm5jYNcN1H1PS2lpYA85UMmtyBupRGULKbt7Vc8pyWUUK8yy61C5uep9dAJD0rfccjGw5zITLoVTDMEamUb0puSR3qtdQg9CQlQ5ZEWatl6bNMcAF8h0L3n9FMLM28UgkMFHxtb+mIuhIestR1qBC1UJCPm2BsZo1WmXeYAwYxmOA7+iD0DFLxXVIG/SjJA6iNMSOg2B4xX672VbkqqxssL+VxPpWegG6MvRdgmJGjPB7MvCoDF3sAtKsmQlkgj2SZcblv8qyff6neDXWwM80lYtuLRXduNLtFbErN0d0MTIL1CC1f8QbalsX9SC1xayV4VzcIlO4HE1pWp+xgmd7Pe6KrCiaLhKH6QfcSYT93aOqz4cB7CosZbgGu7iUEyzY9YhyC2Mz29H2sIAhhjfiauA4qCfR5etV7lfyUyoR8EbXjTrSLg+EIeFmnjr/gW7cALeFvHuQ8QbgdQTek5A3oS0o/IQDihg19Js1EPBxU9lJDzOfj+NuWzGvyiHqU9Wr6MleupJ5LLvJd6QvbXYuWH2NApN3b4M5oDUikq6poo+ENbBBjQjXGq1aONlr0Q+IfIZUJb2QzOS6cQXT/Zrnft5dqRs7kLUDWTuQtUdB1hroWXZNszzmJGndNW1RARrD2fubHfkHaPOIFnMbBfX3haaqjmeoj6Vh24TAnq7mCQq7qH5Ryuk71auVyE5mcJo3d9YZVpoobVggzCAU1sTWcypIlry1MmECTTmgeANLFKcoAsbxig26BsSFmNWdt1KqTdnOaLL1duhwNQwPSLmw6SmA1BjWiC5K6wirCMUZoM7vh1NfUz3aspmgIHr/c92MMGLFAYmI1ksnZD7HYoYPdQlY1flRyyCJmLbZ6ilkLzpzVxBtrUmswHlpI85U2/JuWAkm+ypEQrzSKhV9cv3UI+eVbtaim0v3sMBSV4kafMuCBYnCBMu55HXq5dqa6wGcZvuQzVwmVukdEtou0oJLlM+7mJ3IXA31QXtATAIHI/22RLM1Cb/gIwB7bXKDIFyZ51vVFggDVCsXz1TmZZwkDTmFaBCkCesftYiYZ7XuG9kUSMHUovprW4ZGVe5S71WUnb0QEmQoOyKZi0eRAu28qPand0bTv6XTPKoS/vaPg49arY/azp3T6r3SmtzRmvzQ6t3Q9uV/Zt42YznWVgwv96yK49FZ9cMW3lkLjCK+KNrYP59z1u/PK8vfHSt3wRoUH4GDO9bBHevgjvVI3bHa/bD6OFw1mcUbwr19grxbQ7uLM9WIlPWKRhixHbByTx7nwNo/a/DIQ8g7B44LrRlhHMfBpj63SHFRyz2AsCygPPcNKboH1PiHibIkv9x8c/wK/g1e/gILRVmn2CQW0fHAeVSyOJ/Cq812nBk7Yb9GTKteljTR+psvX/yrpQKvHU4ONe7sWqZP1Y1PthaXqqZbwpYm5xDmn6xlbKUaqhEFWYAie707Lwz5NfZ0wNJJd/53iiIZgiw51bEd8qqr5Lx229l9HNNqNJJQ452W++kUzP5JyhRdEW1a7BNtiqT7mD7Eejj2eRJcfW+jUCaXxVPVku9JxrSK1QFOpeIBWh1EarXejZ+Tzves8YLVUa5GjbnOgK97SMW1YELFb76pkZqvTncHxsm1edEKCayELoAEuJ8D5TVNeCsGajiFB4geArImwXdn1yCSwTH446s/veiPBIq6oyECuIVLi/pvKIXQ0xlMrk8Ebg7AAM/q5vD8s/gxfkoHxk/KqfX0tv6n5MeMEq2VEdNKjjPTRR6AXN8jw5mtJVvhRDFuvIHDBcJgKlMQKkfjMLd85eOYdIfaQPb0RjbV3hZPx4NOWWJqHWWz4TIrZ9GHy+hlBzUPUUHz1f1VVkaG64ROG20MDiuDdgqUD8JUrWC+r+0m6TaTgtfr7ufr2DiBAtqWZxzWJRBpbKwmbUmYpMf7J5p4i6mkNNvvhH1k5O032DDRSK5bo/ug9xyaTSelKbyTgRNH2hEky02AlH74CJZI5DPSxJNxFMupNsxEoaaYvdwmaJljskPxlPlPGwQ1IlLNIx/RYYFoDNVwOltGkdS3XWQAAKjQra02oN5a7aJZZjn06yw6YwbElDSQ/84XrXkO+hncBnnZxBN1B7m926y08VCGYOW1JF2Nx6J1f2TbvWcaCYziMfr40zTlwzi4iXvPOW9eO18BVe9io0nd1PGNN82qhkfnde7/esDDYlPrqJKzX6aKqKQb3d3Qu3qUll1JIcEsjVS516CXJ/xB0fLPqGhRr5t7LXzZ+2IcnvQPqLp5NSiMV607EixwcC9tQzicXN3iXz1fQksAnVxV02JnwlOoPL4bM7ASdr/NeSHxLBKF728w8nU4vtJZx1U45uT4SpvK5AlS56M5Sq8DWrebOOiIFtvEwZ6x+kEYBTzRMjnapSFhX8u1pKEvPm9piG1SoEc3l0JaSb6TngybIzjHDT6nnrj9mtIkXXpi9/R/y9ZWEtKHBUZCopMEBx4SLrMMaIrdkD5ak3AGEWUcaAJY5lYbw/DFULH+JwpklsBANHxWC3EpNh6mGPBqQZY4kRojhp8DikOloxCWdsaTNOAKsJJbBe61QPVC57SYxIDUURnJCohiY+GlwVenWGiivaDSGBNZ0jU2gJ9uJyCqyHlf+qBC7HUvE2pPjM9Msg0q6aqDckGyhYyYfBUGIYf5ba8u+ZVHoralTYtbGwnK2NpIEqraVuJw1X5U17b2c3YoaluovdqtvHQIADgEAPhQi0MAwCEA4BAAcAgAADgEABwCAH5/AQBTFNynqx0GALyWAF0BAOqLOwBAo1EfADDNwZaiALIve4kCENCKjvbKWU+aQKQ+U42vqlIzTmIcuh3ixYKOFYjL4sOstqPiTO/jjW+jpxGhSVZyLwRr2dwIqQZOhNR+HZtCF9uFLkj9bRHTpoiFXrEKuwwUUJN3BAroVXYFCqBDqID6O4QKHEIFHn2ogLrhTaECr4tXvUtuVtnzHLN2/8LXWVODRoKXlGPxVCWYKQ2BgqccEmZO/VAje5YTeU90LlEuScRdnxgvlCIaoEhkfGg3yJqWBqFZhObAqa7TqyDlOVWEMrOz8mGV4LUYwmuJrq3GedVcxmV2kQhzHI7UmjxlzZlaYtQ9sSrzxbKMob2NRmGgt7MByUZccmamFZv3WVMXPk2MVV0GXgez5YF0vRJ1BE5WyHw06+745OTpYBfesZpEZd6x2UMjICuVVzm1kNuvpI7valitg3j2GMSzjKTsXE47M5DrBbZSE7fkVsawXoQrtXTJctUmexHqFDXSJMj4s+Vy3AonQg12EORyz1mWyuxcs1To/OufjTJS4lm8jZEbrTf646cT6koH2iHdlZ9wN/NXCQ4/SHkHKe8g5T1GKa905fcp7m0pX+1QtrKorpe/a52s8OmkGllY800XvG8rXcrYK6BqEk/tB+xpjTm4yzz3Lxh9OqHoYKB5xBJAgsX/32WKphsF0cXx609uTt9gUs/hJxbkEmeff9q7mcbcWn1tJGukTkQDp6qbNPDPHdlmdbFz8UIP8Lnki5I5plTfL9+2KjaPwx6jT6aDZTcL67TIFNn2A69+4NUPvPqj5dX1HW/i0W9Kl70Dky7tBLq/n9Ehu8vGX8yi4XLbJchjZa/pbHNYoo8J5skmoGncbiZ6iz5q3G9EpzPRySxSJYkOIM7xcsXdebFNmHv2DKnQgPIbUJxFnWey9W56RERlbXV2f927l8yg+94mge/At0kAAYrF3MlKJscAEkvfeu2wKBqpna3dU4AsWaBi2nMA8jS0LmTtbLyPgnUAStp/O75HTUYfhJqwo+wAGGe0vqeA1ZSh/KTJNm5VAbnCiphUaCjnChsP++87y4YWss4ixHxckvPG9rIZ+bPwPT9SDUk2PpmYLKtB5vuofHsRR+K3QMoaKyrj73eGbJPd0C06lL7eJsEnNR0akrcz26F7+w/Gw8epOliSuYo154jdswatQbO+4K0Bc4fYfUlhUPhW0RgUEahVGSy5AZzrCwpdPRUGRtRE0WqBthU4CzOzbwmKc+Qgw/wgQh5EyIMI+dlFSCU2jvHYFCU3ZUH1A1alZX5yo9QyXVPanqNPjYDPbnWHPMOXHXyk0ZFgYUVpVFPVVuynojZOfX5bFFeCY+QZQTSZSfH6+kxG80nsGDL+EYiB+IIiEWyz0QG/9WGsCxzDA5ZilsJA9tYJJXPimQpyDze4RvADr7CXVXDpO8Xb6zPRtrgjtguaZjLkhkAQIbKsn2Pb1sBW4TL6xCmOt9OZU13aT10DV7z9uVutvTfl+n3znqzWgBijARGoyLPZdO6Kifz3vEnb8Oe3Wehnb5JkTJVeGY3O7Nb5sJ+p9q2ZsoqKxmFT/hU3V1buWTxAWck4/bWuYCRGMocZXm2R96UepZJopRiqvL5mzXmkUyH+5M8WKp6QzG+3NoWSb/pHFS8anvIO2RN1H3OIDG741r2G/gfK81h5vgpdUyV2TndVCxZEIiylkDW7Jtamkvtqm7nVpsFykVfR1spvQmw+CBvUHhARc2wqfClDVHEItn/cp0sEmQfRIpgmBM9yzraQ0zGzDDbMBMUbmCESpQmW8gthMuGJSsBvMkquUCK5E9GAk3ADIWGrCG0a4BKdSvbNZMv0jAnvkHbk1rR/3PdRENktMo1tkfLQL2mc454gWBYIr7q6CcfhkXGFrbMN6MvSR/9PMPNS/hOBZFnjLzZT/fZXOjV6f6UmzeZSm02iIVlDi+K/6cjWH9bPx4F8el+nT6qZXLFcE3mOVxHdLHHMC7pH41u0CkYqIyVN9K9Su5apBAcAEZpik+PG3Q0sBaJQJKxojGM+8mic6TRfSZWmEe8ZjnBe9WCJeLB4Y2FRj0cnTEwWrBN4KVdWHMa5Thev9vgGKzeSAYC4SVGWYaGsuIgK2DXh13GtmtcLSioRXQjhNJA2RCmCmd6jJdqgMmzNM+Ok4FPVhAsAWaI5vk6j6JpGJNgIxcEl5deV1L6yXTb8cQHcCC3DP31xkk9C/eF4Xb56Cper64vLi9e3Hy5P317cXp+eXRRagdLOfZNQR9q3GcFReIOd9kf5TXk4mQ0dZ1egEZHbi5v3k7OL07Ozq3eXdxKvnaMkPcCqO+rES+htPlxdX9yc3l3dfLi+Ot8PSoVVKjR9ki3N5OrD69Pbiw/nkxvbShfQeEbmaYJhihiGkCTyhm8k06UPCdAYFpRxWCG+GJfg/7DACYarFY4vXt9K1ZJxAjElfkiE2RjO8QylEVfDCECZq8PxGiXHOV2r311rCq4lPIGhDWpYvxBnt2o7rt58uL0+vVEgjfeBWRmlqFQyg5i9wLkEktOsg1guhtZGAc0WSMxdXGQZy4n5A9a5vBgJMQSolOPmSVZIQWm5VjTUhfUvLt9L/jpiNMPN8ENKfqWOGvZPMt2Z4JgxhFicV1bevTuphVI7IzdFjkBYYVOOFYy2vXGuqsdGafDDdvjXkw9vv31792Hy9vTbixrIOWETBE2s5WiJYjTHBeo27DCZbqM1wa8Z4OL/XF/d3F3ceI20HOGPK5pwnDSNdHNx++Pl2YfJ5d3FzfvTNzUw//hiuBWbsu7Fpaw7MCnrvfEo604syvoTcyjrjgzK+vHwJ+udsifr7tzJ4c2rf/PuTm++vbjr+9zJDOG1b14gXcDT5Sp/ykyRMhru/+HJp7arN+fA1i67rb3/S0kYn/NuT+X7qzfv3l50ZQDUCfRnAexxzYhXlxOBQL/Xufy+Zk/rrVrrlnd1lBfRa31fPR+IEVsH2XMpkC056qAV0bMUH0/gy3//8t/NDwnlNKDRiahMp3+zyvWZpsU32PkuMMzEepzOpAvW5gQuaYy3YUbMe6W2HYVLIgeQ64e92BO0MoFaVvcHPF1Qet+6vn7daniWTvwEgPBFI/Fc2Xwy37JWxqdlft34lNbF2mLBPh/zUo+PQ3EiJqCb1bAmvkwPSuasrGEZCYEvonNOGQ9xkpQ/r79+Vfrp1V/+x8vfldbm9Pzt5PZ2cnX54YeL199dXX1f+7LlFLbmfg89B/jmdPLm3c3Fh+urN5OzH2uG+gaRSBHuJ0oEX6KYzDDjEEoKpDT5hv0TpT+L2aqzs86OBk+U247lKnF2w+B/wM3r0zNIUskmFsfIChGscSILEg+eyLxZClBIZjMs7cwRidOPMIvQmqYJAxSLhurHkDCeUDYePBk8gW9oAixdCVouDWcaRIw5XN2qwgTAF0gpJ9CUrrHA+uVz+BbzotFe6TS065xkcS2OlyZAWMAICpcgKp0mG2V4Q3IYfYfHgyfw6jmciVz4OvRSwVFlo5UXkzbOq+7ac2QUMDIKE7LGSX6B7ZYBI7JIs+I1n8Afn8NbQQ+0BUVjKQ9NPNeDkljqYnTNQQVTrlmPP7sTNP3VdxIH6VJMAcUhnKu5nt2cs8ZOPUby/hv4eFZPMUed3KvbA6uJ2EjhEcnGASNjbbHRQ1YjqystnLHVE7G0k3hGS7HT+WC5N/NZlDIumQZZjk6im7NYDi9ll2NHwIjaRFbry/xG3yyx8+pwM0jSWNZyViV/IDYHgi8wSayBxoNGl5h6VxM10Ikrx79V2nmBLay05CfIVOY4qwtmVV0NGux7YjKTc4+hRcPc81Yjobxv6QzWBD90GpfTlXhKN9/jDWsZ3WzJPd4wQzZxCNONhUl17FqfpOYa007rePHXPLrAuM/v0WTpG03R+c5bPJ8SyBDnKFgsped1j9wJ1qrUxCBYFKCQJ6EyvCsfQrlROUmD8uU8LX53Jl2ohSa/rpHiMnb90jQ0vzWRdvplge6vyxbvymCPp0sts4kkDCLEGGZj8++WV6SumeMp0VXRdQcZgVcKvnG0qITgOLEtnUVHG9cb9UQHKgj+G4hNtUgMeZAivBy//PLfZHsTZ7B+OXr55TikARvn7cQmiJ+OZXDQMZIONXawI1qRY5WtYZTFeJR/GIXZVrFjMaZk1ZI1fhfLgojfCGTZCcxQxLD/O+sIOywss4kuwAxWKEFLzOUrJV4vZJf31bs8MEeabRjHS5DeobKCqGJSJLusdiE/IXBqRglqz4L4Mwrn6Ua3lyMTE8RJYkCl/llMkAOs5TmnKsjEo9imd3VvvjvCaW/RTbuPbfrEkU2+gUzOhz3EERbdtLhft+DFZhCKU7os5DIon4wzbUfW2VMKC8QXCU3nKjLEeSWy8txyXF27SB7EKU3jsNRpUMlaJTuN4TYjK2qZ5Tkc3mAhNw3ltgzPZdvhOP9ZVqwroYu4C1c9QwFHoLZabBgp5Y62bnDJrV/eZnOLBV73eMXHGUJZje+moQsgPdFwDZ0t2I2WOscFpUy5BuIIFJKlH9UCth64Ci9fPGjysyups8Hb5u8XKA4jzKrhco5T5Z6dE8dyVN9eIvr2EM/3yaP5/IP3nOucP3j2ardXFnYCK21TDlvt1T3ejNRGLdFKKaZKJ8o8kYWhLDCypr95ZM3lkrowzLBNYegK/arKdH+fsyjNXp9Vf9FRiebaHyS+AycwS8xQTGl9bLP5Do/A6/TTMdmKcn4CLtsi0bV8tmrTxmlrlJtZ7Wy4CrNdyG2ggKjNFHkODrx4YSvKgVa6xtqQxiNRr3pYTeGpybjW/Na+swd+93Hwu4en/dM87eXw+sI6i4/ZOc6vhDyaHcSI6ab5zrk5vjo1c70k1EMakpvbzrO38u2N89OXtgLRV25qlJWqePrKTl2Eli0Fl6LMVIHsg07j8BWInnLUJIZwE6MlCXJoxohUgRkgho/sR9h43M1IFKlnOMujMDwvHL2h6uGwD4i7FZpXySVjV3+3LCTCAusqajSS6RoK/O8RpEz0evvu9s7yyMsnY+umKjDrt8U8UK4r7BZLa0XTGvG00djhNjl5iqqWGSpz4JT0y1k7uvvF1/Z3ueRTN1Cmc21kqCWYp0mc24UEjt9ifh2lcxILXv7ZcxAluHXuPlS9p9qSVLsjtWupnsnGtVRNLF2ofBgfpNOqlWvP3Az9GowrON4VWFmyXKZcBmGjGcdJdgXr59AU7m6w+E4qHVoDFIvNramZ1R+aFh9IOJRvX00EvuPa+R4RN3kQf/byjPtl2vNcCLuxaxnUd7MIef0uJ9r6XJBgYZ8LS2GoyK6sJc/RPY5lj32uRn1l/yrVdaUyKayVTUWcVJvayftr6wlMcUTjOfO+oUVUHd5Sjg21Wpd21WkiAE4raFpTaXoEgGj+pWxmGIscTWNnV2f2REUYlinTbx2OA6zkmjoMnmrTx6ym7v+UKFd8qa6CKZFZIrXDuRRK5VMKqHiL/fgiNfkjhYDgUjLrifuE6A7Zs7BK6JqEjtBxMRkL1QVarXA83ivtrM9aVzldp9cTu+6/coaSm8U706ncwa9t1KeTmRom0auCYEVwgAviMpCYcYxC9aMDJIAQlxKc7Z26phI5S8QWjAggdUDgv26vLo+/pQ3ESelQVLIPYY2XOQMWgJiYAklUmhg8Nu5449xd9KdXP4+dIMWhxB+RSL5xZBJ52H4y8mYQphYigyf5UOKeOIIVDfWEH+REBfkFqieaYojIPT6BofTaz1H8mzjV/xg6YT5TDMBQNBnm7Gm1/mSGoOL1EzKf46QmTZPogIVY+xxoIuYeUwtArFPCGooWVhD+6dXPNdgW1wlIHOKP8CpL1rKi4XN9y9gm5ugjEAbBgjIc11UZBXU/1xgYXWJ4wFE0Mrz9A9qoNLpqu5Tld4USXtTwOKHeXZ1fnSisxBGax0AYxJTDjAg6ppy3ikpBQ8QhWKB47n6ctbgxS3ma4PHTrrfVlUyxRkdTJgyfRtPhNYvYJ//wU7vASOMsHJrVgMZCBc+O6Vo4kuOH4wea3JN4PhLnbqQ2nh3L5+L4ifyfXrNQoQdeUyk8S/ucjxiHHT/tkdxdShi+j9DTW+MvUepZ4sBsYum8EksUKgqL4s2uT6lYN5nBK9iMtDv3CMWh+G9GGBe/d16olHhcwXeT809zdlPS+QJ2TMhSY2SDqqHN/OjIbDOqssSDFoyqef2KwqP8XLZGqN3mcpWTpWTHisnoffSctjNzI5tvNzRaDm48oJVeR/wk1dYjEsvsOvUiCWFaHmvX2u5Pe2agfBC4DhVf4qAVWlMiZU0pop6Jftb2Sh38EOY312dSaeLi8mv1B03YboVqJlELg6JBllloGk7PGLyVyrdKuAgfw0QwRjzng46AcCuplTyOGkV5KoAwSJV1TprEXVLHEvHMQpWxFgjexeQjxCimqsIeU/BwHNBQl12KgcT8T19UmZmrWPY+UvjQ5VKwLr8IWwj827+yf738RS9R8f4ofGN7zOpukTjA8PI//v3F6MXL0YuX8OLFifw/eHd3Nh645naisHQSJFfCK5naq/EKyhZ5TXiOGddJHXGoP+pUuJWLUk3Apx+hDlJbQ+bD4oOgGxZMZyrJobGYid1MY44Tg7g7G2bNZATu2Ukcw+XV3cWJwU6a1KYYIjqf4/DICVfp+I0EFgvIWgpjOGaEkzW2iWp31pX7pCzjzYRU7ecDYvZijXvmNOujsJKxTHf0HWt+F/Jm5SR3zDIsyVZW6P3AIdlkVWX0+9lM+iukvqpPaiD9Ep8PnH5ImSGnVUpfgdiL8pcofY0aqpbyt2Pq0kT1IfxA+JEKp3sgDB85rDOVd4phDpzC8C5J8bDmlTBmNywPAYld2Q1p+byYt2PgW/PKFEIhv7Ud16xdhZ/S2Uezsj52uceBQ7qdbgQv2+2UljbaBTbfe4HIBzlK7RmtO5MVwF3OqB+q/njWsCL1ONaf0LHIXRLrbSz4t4uR3GfWnlxxd3VXqSO9vJJ6UrZEUaR0R7HzatkniMyA8IKCyLpBVmEbdRbkeooEsVUy3spfIZ4jX38/mjiPJYlFHbcTeOHNkjTbuJrsW6TJsNVoU2+TBtoXqgKy/nYuEANMxIbJfVFZiPWOcrFbJIZVQucJZr7mE093Tiv158Fns5PP5v6dNRu9NFvcM5v9Mmti/Q4+mR4+mUqcSBlOnmaOb4LgmQtsRTwVlBCDMjVTNGiFE6UW43qPjqBoCKt5IccH382D7+bBd9Osc8F5U5WEkUZICBYoQQHHibg/ASs9/HryVRdOdcM76481iT/W2bLMOOyJizqbv8x0/dRDUdrHbanNY0l5o3xSt6Wc7CnqeiaKEXkVyaztWfL3sEyxDpAA1y44Jcf5rECUfCKN51pDnF/pvqqFt+U3T6cgJ9DqVvR0kXJ4pXj6Szl61ix7i9oZquFwJRfLmtP3Of2gntZ0cnlbNkU0m7+M+hjzQ7GTS8rgVrrHWp2AjHDPLrlKCDkDGmNd2MwFWKdGUXW/Y+py3i0MqgY5ahBqcjETLpYrXvUkUBumxuOAoog+ZFWazd6PpVfAs48ExfNfCYqfnwACnSzMYuYqsGkMWAyqB9kVIR/pBANPt6wQW7bW9bHGPe1rjlOaakdBL5PKdElDeSrEsVSoMju/lZKBrWquTHNoaYmN06xEiFc4DnEI6YrGcn9REKQJCjZjOMuBGNIoc3SlrKD7LoDNWJwmAqJfKZn7a5OVzxG9NG9djs9WOHHl1GZ0cF5PsvQCfO9LWYt72Ny3vLeawHR2WLf9A2oc9FymUe3e6KsU8oO7QEwfDBybMjOzVDyCcjgl8jRNpgJxg82ZhluDY8umAIpojCGkWCLjjNEwByVfD+07aZ9ZqbJD0QPaMH3Wit6XDt9MBdRksnc9hfIIupfA4a+K1pSEsKKMEcGdMRykCeEbIIwJweipr7vtwQR/MMHvwgSvqUHRDt9qxmjVJdfZFWvPc2dD9g7M2DbXGhhGf4Gj1SyNgFNIVyucQCTkYfv9fCYq+h45/JNlOltlwMlaPwdOIcQBCbEV2Yc3tisviVPs0mrrOnjGczyfmTKJGnlwihgOTTSHWGCxf24DeYKVDvBgwT9Y8A8W/IMF/2DBP1jwDxb8370F/2BVLiZz1cZilb7mhkb4tdYi2ObmZIqCMUr5gibkN/Xil2zObeUWAkYyXRPHycjoKnSki5zPCAp1L3S+fqtCRBVkzjyOGMpaloo4JDTCOsiyMltPdBPVFK3It8r2XbsialWry7rn9ZQIymzxaiUNpmKnh8OfB+pAGxM4/DTMrQ3agqMbrXEylQ3mmA+PYBgRJv/3AfFgMfy5H/BAmCB6j1B0L3CNp1toHeYuZyJD1yoQi6COtOyKxX8p86L4r1WXcRgOEswbUXetjNsDwzWAM51s7x3pPa5OrlUeOF8/JwqFdVVpP/aC1rGitmXs9Ni7HNFv6fN573TsztMMKE1CEtuUyjVMhFH9ocpmZKao97Gwt/ocOFBweTy5cFBuNOYny4mm/shV1jzHTOOercwnz8adq2TF+z4n8SfOxu18kUvVqnzeqvJD7Xim3ezIHt5NGakvAPZ5Nw2hPoJhNoeuZNvvPT6Coa5c5E0s8jPtulyrT/SEN1It/7GaqVTTfDozDGLpVJ2PR8887OfF6bWIlUoJez6mO+c6WlZgq1fvDy6If3C+WM6qE+pMZl/Y8Mj+pypQ6hz0U0t1NjX9HUh1FeLfQarTE+GI41ka3WLuLEXY7Tn0L4vcAqWxmF6Xvn3LJsuCf60DAYhVdzQsFF/2ANN/tvpVVYUArbJxZFiptNij5KEH6m1r0GEVttt16FRHcZUQKgzwuU+ZNULh1gYJ4cIB0FmA0e/Gt9SQZmSUYKHqS6oFGH9N0UZM5/4rFjBybLU9Wb8oFWB0FVYcjkbrr78cVn8VkFAYJpixr//l2en5+c3F7e1zR8MIoxAnI3ljCI2HPkUXNTh3xUFZmTgiUzGZY0aDe8zZsRIIVgn9uDmWRc5ocN+jtqR6UWQtPOZGTQ04CknZCLkUnVRFx1YMnXtoqbC89tFqf7J+NX4xftm+l+27dtiTDGrmDlglFHX74ujjvTfue1a6Pl/LGJdh52W0D5r17HsdNKv9yfrl+E87q9o6HNmwv1aly8RJyenwNpTHvaAzjHia4NEcccy+vtPF7r4WBgZHa/yRJ2ikmPSRefMqjWsvzdsf91N/vFAz9tNc189JGpUs4HlcTWN18XZ4Vvs+hJ+OpH7OPQqUyKHrzo4SPCeMJ8hvz+o6C3pTJJ572cNsWGnlZKNc9qyjC4ddP7FqDatmbeW/86rEvWt/t5bpPru6vLu5evPm4ubD+c3k/cWNe5mdT7oD2u3kw8Xl+fXV5PLODSiNyceT4+M+2+Ye7vR68uHdzRv3aCaooVDe0/57khXazgRpKz5Jd1MbB6bSu1WBuwItK69NYsZRxa+jU5F0W6KvBzG5+jC5vL07lVt49+N1W4VzHHOcrBLCcFa6ftg2wMXl6es3Fx9OL0/f/Hg3Oau5isPy1a8hKSYG8+t/eeY6Ny7OJE2iUmu97a7G6hjZ7ctn/Pnws1EDNZpDKnUOIqN1zkkiY82lb0CzzUW4AY3gVD/oUGtVypvIDMtTDDKGkVMQKf6U69H1e3YkSyUzy/P+1Cpt+0nsLYY5+VROCp3tD1sp/qvlxd1K3ryM+P78IGq0yB0sGxVj5yfUJGfn5HegRi6e6cfmGWRYy4ynbL563kdYl6CvN6Vnvhk1c93f4alO+Xdwimr2qctx2okbQkPDSxriHq4HvZwOBmXPAudm+ZwGQfCaHQ0+yT30vH/+tuN2i+qOzP3KwYGGnfwQakfct92zy7LkJmeD7ienVTunUflx3yV16kWVLJ5B7tVbtOrjL0RYwAgKl+4bbHqbVifw9wEAwJP/3/GUxMdsIf9FZvATjD7C8YIyfszEF9MBfv5P4AtsxPdgkVDKVUMotRz+y/9SogaOigBTlhxHNEBRB9B1fWoH8YbcFeceKNcNwbCrY7XljFin4xzhJY23saGLQ+9jPa+xyururfA7WlhdvXZsUc+G6GtLtwD0mdtOjOIWDv3N4QUg/bZppyZwSYc7G79t6g0gLs8l5kKIL4SFtJnDJZReimhnz0+khfY2bImcJhHmGYLizRmtEF98/S/PlEbow83Ftx9ur86+/3B9evddAUZEZjjYBOUUm6sE33K6Kv4IgD/ioPwbmHTngt3Qj4zgIUaB+P/JEkbJDI5t5I5dpj2PJmKGUm2qmZytNOuKcW/WxLpWr0VRrzdDg2fOeTQP+v271xcfLq/OL/ZjK5RFhMS5vqyzFcqxm9Tl9dTIR9+oVqZF31gxIeT9C8e8GYrdtIu9wuTTkBk4PvLy1SBrEuF5KTZN/QVohaYkIq4geBTKK3J2ev3h7feXV+fieoh/3P54++H0/O3kUvyQ/+PnQneZKunaDH3BAhQhHQpexGFftpYa2iVVd6GljpanZ3L+fPgIVOPWSfYzxpUm8RkuX3dbkw8hcyyIG2jh8T8YpfJRLmJhQzg+JwzJfIgpF8+RJDagfUiOQOZf0NJ5BUKCAyp4KJlzIQtpH8nUV7KinhPtm4u3V+/qjsCw4v6zf/IbYsGztfQP8do9OA3ZSDb0tDeZ57SymjHGIQ6BURXejOKN6s+AYZ6ugMSMhCqras4fAkpwBZLIWoLmOgdKcG9S1EpJbYmCBYkrCQgVprqfJMLD13YGKLfJUUAcJZRyJzQ1b9GmfbDvKON39MxMyz2cEjltdUHdoAWBu9SOpVPVqvS91tLnPB9iWtXqlyv3YVHh4+dyOWmyGXRgABrHqWHRPmh4m+MmLK4SlSph4HWf+uDhZhX74VR3zTrh1WlT6k9bYJRdJwNHcsm3NMQn8OLLL78sfPVQfrVfrsbZNs5OKGNs5UvRBFROJ387OVf12aq6GdeeZlqOJ3BHIcQcJ0sSY0AckjTmZIl14r4lDfOENpAyzI7E1sr0QjoPEZNgHGaqY7xa4CVOUDQEHPNkAyjBmmqOByDgTOIZvYrfKtlbc5FK3Wyy++qfK6sRLHCYinxM+YIMColS3jGd1dPkjdSCvzyZimKr4sdpbPLuaoPPEUxTrpNSDgCWdK3K1qNYZaMUDAqQGcQ4wIyhZDPWu3Bt6x8atWRuf3z9pP7HC/23x1nrHJlqbibHCUlMGk+BMg1xn4kVtSz2lF6KKf3/BwA/UP5Ti3UDAA==",
+	"openebs-operator-2.5.0.yaml":     "H4sIAAAAAAAC/+y9e3sbN3Yw/j8/xfnR7WtrV6RsJ9mm6mb7ypKSsLElVZKdN01TB5wBSayGgwmAocw0/e6/B7e5XzBDylbS8bPPxuYABwe3g3M/T+CUYSQwvEFbBDeYbYiH4cTzaByKEYrIO8w4oeExbF6M7kjoH9tGts0aC+QjgY5HACFa42OgEQ7xnE/WaIsmNMIMCcrMVx4hL20ymkwmoydwhhckxHBNAwxihQSgIKD3HHRfQkMONITvvuQQUZ8f+TgK6HaNQ8ENSqdBzAVmEkAOZzZH3hTFYkUZ+VVBmt59yaeEHm1ezLFAL5yxZ3GAuWw0ARSRbxiNI34MP47/NP5pBADAMKcx87D6MaQ+5uND0H85ihj9sDXtNpjNMx2doNllUyC5Xn31d7ka9r9H+AP25D8yq1P459GChCggv2KmvjAcBcRTq+LRUDAaBPkvHGsQeGOB4dCPKDH/8Gi4IMs1igxeHjPt/07nugGjofp776lzgQRexIHFxEd4TUP1r94w7T9+ialACmpA1kQwFC5xCWxAuJBN7pHwVs5DkHDJMOd6k9TZo6FAQUR9FAvKPWQXeoOZIF7VF09+WcjtwZwsQwXxlxhzsScUuaAMLbEXIItnJC8NFzgUGxrEa/mJrCu/uC2+aRuiiK+omOIPArMQBRMzsrmIVbjle+qFyv0kL2wJiSUWejOzy6FOoaRv8m9x5Ju/RfajjwMscBX6KCIS5VASEt6ArBdzQdf2J19SMqJIVgE/KCJYhZdBJ8GwAi9DnqqxgfGfCsOO/zSGCiie3IXpnmBRynwSZslr1UIFGHFct2vJbtnVSVciXR2zYlWb5a8JlzvF8JJwwdpw2aCA+EiQcHmP5ytK7zQti3VHdeLWsWhoUD2LdEubppHd2pCG1waxt9evFayjNRaMeJVjuN7uiPo+4SyOJLbz2F9iUQmv8jimOFuSol/pVyT0QaxwkUuAeyJW6oN6wK8Y2ZAALzGfjp7A7eXZ5TGcrrB3B2QBPl6gOBCATFcUcAohxj4HQWEun3/McPlVl2OTcPlAjzuP53/HnjDveyWXA9AMwn4v8DeMBvgaLyTgMqfSBtHuc8NU1dZkFwVFET9KWLWz5PGvWAs1HIqI5ChwDX8GEKA5DtTK1PYDSAnJkUfXEQ1xKCYurTcW75fTL6bPRzzCnhyK4wB7gjI97Foew9cZPBow6YaLZXSO4YUcVRIOvNzqQcQ2wsdwjfXFUD9JFomEy7fqKh9DGAfBCEDgdRTIHzSymXUGyK9fC+Zd17F5LQEA7Hqqv+cO9EXLWQaQPCEiIWYZ5CfN6AOQNVriqzgIrmhAvO0xzBYXVFwxzLG5RJl2yfhH6xTYcYq9/hNRJjIoaDQS5K4oE8fwxV+++EumBQ43+Q5P4PLq/OL81c372eX7796+On9/ennx9ewbwCGaB5irCcn7ZlcJBJVjhNgT8q/ffckL8OaIYx9oCGJFOOjXYQq38h/yf8uQMuwDWQBeR2I7LfS27XgcyelhHxaU5ZHADMyGwvPpF9OXQMN7xPwCHnZDqqdXGBU2KIjxMYyPVnSNj+J5HIr4aHoXz/GRnsG4YdW+vHn/5uTm9vx6X4uGfJ9hzh/JqiXTq1u0lRDR8dHRi396OX355fTFyy+nnx1/+fzL53VrdnHy5vzm6uT0HCJGN8THXL2RCZEFutDrkEpogDigsAAPhxvCaKi+bxAjcu0LtyE/m2TgXCszka8ZXR8XPgAsCA58805VfrtCYnWc0LZpMomaud+cX7+bnZ6/Pzk9vXx7cZtfAXtaLA9g1yGiPiC+6+wLQ+99DSRJnZZpac1CvDn54eT91eWZ2pTyQdjz3HOjPezuF1CdLbKX6fT6/OT2/P3Z+dcnb1/fvr+5vbw++SahuYTDAgVc8XohXEY4PH91YznDAtysmAoo9O0PEaUB3JMggJAKmGPQr7Q/bVyfNtSqluwYxoLFuIEyzi5ubk9ev05gnkqg72+uTq5vzt9fXV6+Bh97atvvV1jyt3auoKQw4BFiHIOaEl/ROPBhXlxfK35gX9EIiBBTF8c8oEBCLlAQaNawvDd6CoAaRlaLOceZkQ6BLGCstmoMROSXO2lVSaTVyoFAd5gDXizkg0DDYCsB9tqLJ4rsY/WsyKm07XLrltTstJ5s+1afXp9V3k4gHGKOfRDUvJJHPuHqi+yS3aXCIAtG11oHe3I1M0/ZFF5tkx2TJOP0+ownG2WAYb8AqWE5Tq/PCo2dD/mrk5vz92ez6+wMk0OgHnbwCVNM+1Y9y/Zk0hBWlAuIkFgVz8r3UthLKICHQnW9MUhVF5FnfUECzKdwZtZADSMBJYtwtEHsKBVWGg+FnULN3mdBNSyEPkq3J9ffnN9KaArtOU4WRb4PZLFV+yUnLtEtQBM06SBXiqMNCZeqA18hOW3J3cpjNcfiHuNQfyI+Bg+x4iNBF+YyaxWZfEnKVxInx0itnhqa8NzqHWli0Eo/C9N3WEwDuXVN5b20d/QxLawij3JZNW07v3gHhGvNhcVNaiekqlYBDKhXdcPVU48tzfWx0uI//FYVVnUv+/Vvs3cnGvBe9slO+N/IBsGNfuCv5KIHFPnYh/nW0og+q/UY36fcAu5Gj15fnp68vnr3/tvLm9urk9tv97slVkFge+UZsr3sz5G8L8Fj3KWqpXW5P2pCY6czcHp5cXt9+fr1+fX72ZuTb85rwFvIfycbpBUkbuCvz69ez05PHhR2rZh1DOPPxp3eExc0FU2eEC6WwhHbDB10H0AS/e7w33zz5rbjIJP1ct1tJu8uX799c951LM0edBnNjnN5MZPDugy1nuAPSjXDuq/d+f+7ury+PX+ggb49f33lCDsgYfxhEgsS8CrgOep7fnHy6vX5+5OLk9c/3M5Ob4AsshQGOA59Diik4XZNYw4xR8uibKfN67LLN5QuAwwnIQq2gnitHG1xdGf5tV5GOL9+f/vDVcsSTXAoMIsY4TjRGo9HNUuUYPf+anbxzfvZxe359buT101vlG/sbPCMhLCiMeMHOejyhYowI9QnHkSSxzNryHEoqhZymutuxQnC4eXnq/y3NyQk63gtv71YTeEHGitEPRpKW71W1ggK9xjfBVv58nEspJkQXvzly9WoWcdYsxKjSnXjy89X2SUNyAaHmPMrRuc4r6iRjh/5XwA8ul6j0C/+DDCBo5gz/UQdzUl4JNWnnggqGhpFau6LMm6j4AwHaHuDPRr6/Bg+e55ro3cm+fqX7FeGkU9+t/MoGtuKLlGtRraJURy6GNucbUETvvESy1nGWmJPIIqImVdUNJZEjArq0eAYbk+vzG8CsSUWObtK3hxXYwLiWFngTxbKA2J7DBc0xLvYJy2xUVpT2buLkbK6c+OqOnTZzVxZN0APrD6Z4bJ+Dr3WFj6ZCbMJo952TAv07ssc4JJNs8VAWTa1GcCQAfpprG1ViDy0xe25srbJ/280uNWYdndfuv1Yd/e4cnuz8FrgF5dn5w9jNFLmspD6uGQke4RmS2U/swbEssUu/+TJY2MpkprKYQGq8mTO7rYx7iwok/usQBqlrSbVR9rzCqynZ4UhJ6QSpKJC0ketkrkYJ9rxRJ0TUHoXR/s5p59Nrk9fuJ3V0nrWHdfCRMzf5FzyPfL/ggAjHzNQr69Ej3Bz6/1pNWKvz0/Ozq/fn78+P72dXV4YAeqsDq0Km0ibUvTk9vTb9+o6nXz99exidvtDg03Iyg7yfgAyjFNZMScPitTO2Fc/4zGQMY4QDj+b2f88Vao35eWoJ8MBseKpT1orw+rPBin/52krAaqbat06moGal/KKUQ9zrkZLT65ipuQaEQ7KTxv7dklefAHeCjHkCVxS3ps7AkTr+KMlwxHQWESxKEwPbigs4iBQw3oofCqSm6OsU8q5Ufd/9jczJD+YngDDyzhADPAHORgvK/zV/Vf4G/qBQ0EYtjKNnugK8VT6JaWDCyeht6IMfv6vn+E4AYbCLXDBtHEDCeACMcE1qj/nGZufi5s+/dPP9YAkNkUAsKAyGkPreX/FjILcFionskLsQURUvqr4ceJV/CgwF/Cz3p6JB+P/KrB10z+Nf4avFJO8b+GvhyRj/ddbgmKa5JkqEE6cd2vH/cg21cP0xrCTnLOLPFOH9w5r++lkmwSfNK6nXmapaFySV1zFoZJc80iYvD2+Ls5vywO9LHt4V/q8KoVT8vOo46NS7P/7elUK2O/xVXk4IcQcFIs6pNe7ShBJm7WIIvsUQ/YrgnQQP7qJHiWy2qAzqqCrTYqg3z9h3b/sXDy42VNQdXS9gIYuIvR+Beh9i8+dhOeuonNW1npSJwU3CKoFQTvrmDjqLpB3EMcbhPEnw/v+EO97WWzs9r7/LqXGAvZ7lhpTUiF3Uyp7Jj7hd5M1CtESM2BYSi2+1TOPnsBMFM3jOsyY/KpdDGV3RWLmWLvFL0igznGldfJUwX2DogbRNPTXEz3+jrbJDCA70hOIfbyZKHSBcEs5gDKIGFkjttVTgfsV8VapD7ylHSAosDiUoBU0PVk9CDeCUGDV9Bzowi4HTMwlJkySgzUsKJDQC2IfK0By5fAH9W99sIDjCDG1GfqE8xGUN2yqRzqG30agjahzbAa353ICd3h7nJl3QeyUHyD/QeU6OM46phkgHCN570QlIPuxGpjyZy9AWyNWA0p+aUEqt/SFyVKuV8ms6ES3LQxCuTq8ybIXGpUXAWxTadU4PMLCO1pRLvjh0ZxSMc6jsMGhT1n1yPqby4DmiBzDeFyBxOnry7dnr364PTQ+jwUUpJ9jNQLyy+7DH/l4cxRQGh2qvy385/ovnJm/MLTWf/HXE/2XtW++zM1ffvXHbeoslWjjBotmktFdf6U7tVGQh9FNWcAOo+to/ZsqfVPWlL6j0ilFyGlBuimUnhSjR8oPj2VzJXGlIaAgAGkqZCEWmKv2fJThnbc0hntFmwNyh0FQzVMlTlHKOZfTtR6KHwJH22TkFJJhGjbYOhMDEkJSMf9QDeHpIeTKgVhRjo19JPQhTqjZE/XjjTkA01GeJ3wCeLqcJjDSkRQmmuWZQCaXxpH8sJTB9uOvbG6UifxtnECUK+OJwACV3+Cv8v/VJv2tG7As7ul+1cA4hkogXRWGkmpeYHFP2V2O4DxRX+BqlhMAPJWxQZKnWL2o5Ob0ZpZkfrhfmeCJi7M3CRgbuCQbpIy//SwHuZqd5YZu0mGWTmu9pF1qevxi+iInZiO2LIWQTzZffV7hr77ASMQMT5ZIVIRz6eOM7+Ht29kZoGBJGRGrdTHscDLJguFfjb+5un2FOPZlt/GoZkwojqk4dDXk8vrqNLvA2sCnfi3F4D/R/TggAf/84sUXyu0L5gkxMI4MdlDCwYsZw6GQrvUhnATRCumHKQe0YlYnV7ObsrDpqtPg2IsZEdtTGgr8QeT3J7JZRPzjoq++tpm/kaeeVytFEkY2+2ctO2iVhuIgjur4ukI/Hs91L8f20sXxMgy2x/UxBpL7a8COxeFRqYntGzHqrZOUJA3Tkw27o+bjTRv0OszmiONSZEm+azZKIvuqZcHo6KMOgErRUBXeVQmXAiQ0YsbF2RsgPA2jTF7DCHFz/2STM5tyrAARcff47E/o2iInowi8bGCQTpCFmEsp3qf3Sh2lCIxRj8ipJ+T50fgMPcm7iScBp/dK4ZIJr1OBoyqxkIlsqowPfwJEawQTNcohqKD0HBTnMHMTZff17PWOoXY35Ff8bL4VmB8UwgYlRk0zqkLlZvYfdf78L57/02f/9PmLL19+XvKqu8ksdBiv55hJVHILk8OjFY2mOKHnD+PqrjQ8Fb+PQ3893pvxRr9HFSxM6XnwrCImj2yDJqbx3VAslbwxeTQbHhEtwpzZezNKt1sRV1CPBjwTFJCn1Kn6Z3Xq6EK9PfLXF/IfcvQDIKGOny2RCuW5JB8cDWOi8kbKflp3Jbn5CDGhc+WNXF65xumWXru6qTY8dE/UYZfvW4a0ELMI2AdOtexyj7X4YtbIUEwT0aua5IAihq23KfYBCSluCWKTf5Qithun6bqhDW9yI/ymB7ow1iU7TR3MWx9w51F1731428iL1N/RptC7Vef5sG41pRG64PPRnGgqsOy2bvDJXGZS5r6EUIO8aduWBE5XGWiP8VBSYqv4+UisoyOL54T7dxM55rb99fu86fF7kX8aF4gEMcO3K4b5igb+Mbxo4sfton+vXUg/FXds0fgkGZNqM2Hh3LWQr0M1a/UI8lxlTNbXJzKQt24Vj2FcvjTVoQinr89PLt5evf+3y1d7j9Z94PQ9Umayk+uVu6db6h7X9FRyEd9fvX39+v3N+en1+e1N69yV0CiRVmQMojgIwOT3rvZO9wKMwjiShxUCFIdSmQvzbW5F2qdaQrRuxuPHZvqvN/x7ax8Ih79C6gDwsUUdOn4c3s9JkuaJe8ZZFEXHkHY0yZjb+LHWDjVM2V6iKls5u9o5dWO9Gpam1/J8Ot6rHp8S61U8Q70d6iotAijgNKBLQbnwMWNlg8HLwk8v//Z/XvwOvPRSRE7O3sxubqSv1ffnr769vPyu6cmuu7hjR9Bfn8xev72WCfdez05/qBnka0SC8R/Vlau7H1Zhrev9sKrdsIrdnd2w9uB29ig8uQoL8Ljif1S2imizW0aDaiBOcSoOXfejrqgbaAcsP5oSox73ndYYHlOmg0lFFrieKQ+yUM3ch3QHQ7qDId3BesjSXj/7R6a9euwJ1R40pd0fJiDko2i9Ep3XCgcRZiWVl3oDJ9EmSbQK1dFinTVgj1n/9bsOfSlwMJ1DX4r9f1+hLwXsH1RgKlfUSwWoUxXgYouhnSV19HLiFApDKnQJtuOR5Xt1UOtkiUP18s9jEviY5ZjszfPp54ojVRICoeEtWWMu0DpK1HbGZYMLyiJKA0/X6eLTYqW8RCRa6tJcpe8alMHPzE0mtZYZrU31r5HefS6+q/z8mnBNRaMgZiiowEp95SvKxEU6FsAEPB7pXZeOXfKmlTuPALhHI8kGWf7AH4HlzQysiWSg1fqj4IoR+TqdSjeXMCdf+Jh7jKgic8dwm3MUWmEUiNUWPDu3mTR5hB7OEpy/cxrq93qqPY2npltVY71B39Y30FKgxDXrLdyIZXr4/a6YZrrWY3vV3KgHxj7mhHXH1nSrx/SsvoETlidLLNGrPOklpBIOtXQdS3idLHEJFT/1OtGNNpZccW+F1+g4J3CfXM3efXZT+AB57Itom68quq78UcfiJRUXs+9PxCT7JgguKJczdDD3ewGPpxJZ3Q5UKVHzDJrLiX0zw4QbZ9g82WWjJMhGKASqahtOle8+ZtyG19mMtgx7dBmSXxPYiUOTVKDwokuBIgUhCjQvcqj8uNZoCwzLUSAOM/BUEz6FN5RhIOGCHoOUcfnx0dGSCPsCyGctlomvjhQxJ/NYUCaLPG9wcMTJcoKYtyICe9Lz/AhFZKJQD9UrMF37T+w+8KcFXPVp0e917pOiyo37IAkzEA7IdNdzSZfb1pW4Pr+5TQ6C2pLiHqjVTzvydCPkspFwgZnqp03ImufRRZaNZZXgkjc2j+drIngS5Q6CTuFUPY6KD1MGKX8KsxBO0RoHp4jjB98Gudp8IpfWfSOKmrl8B712uU9ZHZnTTZZurDbyVnZWvDGqbFYAm5ZULXyou+PyD4o/XCeFWEtfC7ieZBoDMg7MUq8YC5wMztNqOHPljVsBFSDNJ68EElvTJKMXnFb0k6yPlIIq4gHap6p5GHkOq79BjoVohqNah9vLRf1ngEnNS1TfsuLEZf9ESEhidgz/9ew///zb5OBfnz378fnkn3/687P/nKq//OngXw9+s//488HBs2c/fvfmm9ur85/IwW8/hvH6Tv/rt2c/4vOfHIEcHPzrP9Si9GGSBuBNSCgmlE30LGr3qEy8XqtdybxhOgHHB5XYHK2tOqd00uq2UUszlQQkxVbSEJ96XJIPD0eCH+VKJh/pmJmJGXSSDDpJjujR0xoEaqlBelE1HRwO4uM6iNdmX4pHkYTVR7EGaJkUTmG2gAQ64UDXRFjNM0qp3mENRCKstoqDoGAuDFloqZ5wqZ8IiEdkSFwmNoSKFWb3hNchKiigEMg6CvDa8mQTzccZbujRXqLGz/JdaX/O5HPKS2+t6qv+JnVuNOY2Blcpikj1hU5TQFRGudhNxOuaa1zCq5INkKgBDRVCCT5m7JpVbMao/cEEKbyga0R8U8G9plVhCme5TnYiDBFfqyEgd0xqYYKatuy6lKontQDT2tYN61uBYoKevX3cREXo5BxSFskg3AA1RVKi19AwcqDl8s88oN7dmY7OaG7pMOcmltMKz6/SAXOL0QoWssul0M5FlUhgXBARC8xzqzlthey6VqUVU6Znhy6FNXmVh2BPbFrzFjvBhNwqTJ36OL246R8PRciT9Sq6T/LUdLWzs6DMDDXujvM0M4SZen74lgu8hiUOMSsF1zVcmzUSii35y+cdlqqdjckg+ZqEdz2W6kz3tCvl4w0E8t/yqucO+v632PIMLlhPikd/5IZKA29abIoYQ9vRrujmEOWjtkFb8GvHrCphxa58tuM+5s7RRQYPe5i0O48Jzcsm7hO0YWzt0QRITS1lBxK1LgmXU7g2m6FNy6MdFlkC13m4HJ/8q6RDemlMJUWJp37w9aRRFAWk+RTQ5EG1DM90tNtb0abp2I/Oo+VKaY1IT+1HBz1In4e0WTfS/9I4i6llMdSd0juLro9aiN1ZnN1Nw9IKtHzuP7mupdfL1qZ/GQ777/mwO2lxWmFWKRxb9TmtUIv6nlbNTivEFs1Po46nFfgn1gH1uNyODSU2xqGnAzPw9DTtljqZZYHBZaTJRTM3PMltOl0s4IpyTiQwteQcjiH49VB+eeow3VYykFPi3Mo+7pM+K/a1/J0W6CUG033gGDFCVXqtAHHeLsbnmc9iX3mDkutgGU7NVrbeqYQvmy0gwAuhvYgPbeGiirG4GcFvk/if3WBcacub1gE/gFnbuzynYqVYU4OnThmu4YAufO6KYQfG0nFXWQ+e+0EZ7oTqDCz2wHUMLPbAYg+HfWCxAQYWe98sNqNJeh215F347OvLfF/NcppfnkWYeXKdl1hFgh2MWt/cVF+qMrypxM9rqVRUh6ww2LN/bIaYEHMN0aZqVt6NKi20zsmoPir9LseiWber8ypJrKZwcXl7fgwvKjGTY2hg8OL5czVMI1QTjQCQxzSndE7z7ALR+uhGkHNsMfjyi3+cwstpGUl1opNBnsNfv2qEWOr/16/k7KZP98upttN+sSLeXeIB3eG43uY65pIvE+8up6JvuYVWeixKiNm6CE2TnFMaYNR0KAQNMMtGJLjNMO11mBOtDlM9fUT9pzwLfzra2XJdciyPqK9EuAxGSrgxCdmVhKe/OJjmULgFgbTnaiGORzASBRj+eoe3h9pzGC8W2BN/awWqU9Rq5s9ENCW5sf5q//a3NkGxi/1bY3bsZA/NLOe56gYk9Iknl0vPWy2HhthGs+wfNc8pnEvpE9YYhVz/JDnXHEA+he/bSJb9kzlhhgHOFAaGCyod5P04wIdwxfACs/QXJ/Ao9OGCnn/AXiyc7PSdmEFZWKPrdnyHE7u8XrM7vNXnUv2UHHe36SUKj+Z9ucNbPm3XMFj6iOFOY2k0DcnBXsdcwBzD+QfCBf8XFy2L/uPR9ZyEamIGRUEzWNotD331T4XuvjfLTqLzjl2ajhlneUASx6dcl0iSRHBFIrfF1RZPww2+U5WwLWb6zOu1VWtx/kuMgqkT3LPMK6K7WUByG3+JyQYFOFQP+j0JfA8xZ+cNG1Jh08wiRZ09FCYkOD1pbqdBOV2pLLueirqUdGlJ2Xbve55eJxsA2HXzb4sQsqdAvYkqvtB12iq/bv6iwzOd993eLbowZNQJZkLccoKKituQrgGZPAkHHV5MSwbUnmaDqJWURbhOTo7FoclZr6604/nXOKTg5RHDG8zgmU8VXOk7Ig6m8B+YUWciH+IlEmST6NUtWyq0VyQgDs/hmQLtBJOs19gnSOBge2C1i9r7aTrav8tTN7WFmmLnk/xO9so/PQpQn3cnYaGokl8khOSNINxQnsOU0jkBzdRXM89OcqD/Lu8GSqK09e3fM7Vwlos7iyRtrlX3jAh8KhnbPnab78u97S4rwODJb8qI47ACezDxuHiKTcqmql28mNIV7OzC/H1F17r1a/NqHTyT3WY+eCaX12TwTB48kwfP5D7P56fwTHbkAdom0IZ64Znktc2yftCjHrNqQtTJYaNwdus8KGq8NVCNNjqrdORJ9h/5A1eBU6ZQSXYcQCJpVQkzkJHjiQxTlazH6dIw53BqsxodQ6pr16PSx8Fy4SkoJAqu2iGtPs45Ha/OmCf5HoFDEBROb65mGUBDtPbvwR48RGv3oetDtPYQrT1Eaz9ctHaLSdLVEGlfz2pjZCXes0W2SflpNPqmfs+fa7h3V7OmpCB1u9DRmFlhrKyB7GzCbBeP28yVnY2UOQNkLVhwMU3Wmx0b4LYZJF2NjQ7EvNGw2Muc6BSM52ZELBsIG4A2mg5bzIINYDsZDB0WvN042MskaMx9bRNxNARWGPkaILeZ/2pNew0wa4x+PQx6DnvSwXjXx2RnzXGNnkJOhrpKI1wj2GbzXL3prQFojVHOweDWADRritujma2Pcc1NV+fCUreYz/oYzewz3EJdXUxlJTNYA8zeBrLW29fC57WK/PX6pFrIOiNotyR6qkvxeuv8kIA2iCgkgc45Zpsa/lCRrtObq9OnHLyYMRyqNMzlB7yJ45FkhrhxtqfZMXRq0qvTXfjK4qKcWlwK8lIyIKo5FhIRUI+Ah5k866DSPfbl/wLExS1DISc2k6rjnXuNuEgdJZKlBZEAw77OSklDbI5N48UDFKqb0k5cfCTwpPFRcHi35MR17bUOk75VBzedOOGZmd8jniTP/DhzWGPOZTkVN+RPYBWvUai8m9WNM90tP6+K+Rs7HZrTWLS9uclO78RAMIw4DR3ncLvCpkNiTU024Ck3e7MfvKopXQ1ehsbRRR6lQ3X46QJumWTgvkYBx4fwNrwL6f1u2IlG94P8mm2jhISlqO0wers9pPG6a0XZR37QAIpJrB0sAvkOibE3Sact9Q7P+IGpClCf+W2Os7nCp6N6skBC8dnL/jOvY6mKidFb515MlN4yd8SwHeMTzK4qmXrrDKuSqycsZGmmtrZF5fYaMb6RL3nwZai9Oib795mm7I2c27tc03xFH/sySLIbR0uG/G6ZjQV9q3u17sxsASdp80yUjVwVHc2TNaLJBgah6tsnx14jQTwUBFtVtYPGQoUbrFEYowC4wJVG2+YoDkNPXMmIPVtmO0zCKQz3SAv8ZgqgX7XKiZhyFRAFKEwAdbWI1j9rVQ+azPSYMqb6hWNSXvdIQJBh+MhyieUM7+vCCfT50csg5VN7U+xaIIa1WFztmdDGxRpwxy52CsvYF3aDLpLzNOr5Kvo4wqEv5Rtzcn0nhM5K3TKLbi/d/QpL1rhR3eatSOAzHBqXNDMbtbb2eoCgyT4kM2cN2oX2OCY3NrokOaRdEpprRQlz0CQ3LYGD5alrQLsy1E6+IK2b3MJx5+b5xrLXHFAt670ATtcYMGO03rhIPS9mfNSbXWtisAu5HmRTuyXIE5I8Fvhthap+5PpipDo7ISTJUHJEEtk4T4H6YdHAatZ8qmZ7J8pKMWrsrYrJ+rmTZ6I/87/F89RfBf77f0YprUaeNBFi/6JYHGg8ztX6Uf/M6Dngx59GejDsv7M1euSPv+vySsQybPutr2T5wLoCS/Z7fYUlkiuAU1NiidSVWCLp+A9SY+lbyoWavklXnQwMCd7AjZnMrykIJLPjrCgv1kM05ZXKHypvYUWNotQ/wNwMUPNW7FrWoywpnDbHOIRoteWar8vdSWks9JConYSuamTdWqcxz7XUczmpgGFd2I7hxU5TjHUZxAXDdpqpGjQzWTfsJZQS9l/nf3RCkQoUlJHU+JGwA0ZCQiqhZL2KO6I1k7yRNALz9BDk0wY0IiWbXobBtoTOdflDNbczKT6RiRo7pFP1GOnS0qXyh23rlRFbrw2MphJgFW2camuVEDaCen/EDYBapL+t/d4VYcUYGvWV5gA0bWhAURKoNF3utCmARWNb+LH/HS+cVCvk6NWyM2g/FSvEy0jeFBVqLggVq6rl3rfS+I+3rJrFO1dXTT1ekHu9hppqQ021oabaY6upViyeQhbK2KSVLomrfJb/rbzHbYqg9sooO9REscJvBVRoqYbiaiDuFmcoEaqTe5vCKtoNwm5Rhd1iKJ0jCe10GwDDHmMIe0RausQNdowYdIync4sVdA4hc40P7B4Z6Igp9IsJ7BIN6B4H6BgB+CCxf865WzsH0LVE+u2luke7Mbg1om+HqDerimg3aJqGxfuX+uNnC3k10lCn8mKNm9pWD8U9hqXl7OxS/cTUOKkcdse6J63l4uprnexY5aQui5ZLfZO218KlpsmOFVzrb+lOdUycbCMuj2V7SuXu+WWdMst2yynbIZvsI80ju0MG2V3iB11Siz+OFMlOb5tLWuThwD6uA7unOEN4kFhDeLh4Q3jkMYeOl661iUMtkL5VQLJ5XEe1K9yr/ke7d4hrzY99Vftoxci5wscOtT2aUx31rOqh6nbUAu1cz6NYsaMWcq9KHvtxO2Gd+MqOTGVj7FuXWh0DGzmwkQMbORzYgY0c2MhPzUa61rvYpdKFfqfq/VK71Lgo1LGojUlyq25RUcGiBqJbXYua2hV1eT6bK1o4VK1oignO1bLoXK+iAz/WRFPdqlP0rUvRXrewQ0WKdh9uhyoUD1d/osWS+FA1J/pUm9AJWlqiIvvVmXCzRLrUluibsKURKLjXk6hI2tIC2rGSRJcqEY5sTmtliIdK4gIPlcgFdkjm0gK2VwUIx41wq/rQN7lL20Z0rPSQJHhp3WDXGg+ZJC8tMJ2qOxQTvbTAdKvr4LiTHWs59EwJ07byHeo3ZNLCtN0r58oNmdQwLTDdajZUpIdp95KordZQmyKmBWZ1ApneNRjcHTlcRVyHigsPlDYGHih1DOySPsb51jrIWx3Y5npXkQ4VFPrWTthFJd7syeJWC6FxKV3rHzxA5YPBC3HwQhy8EAEGL8Qdt+x/rRfiDrln6pFrrRzQWDNglyRtOqDInprI2rt1T8xrffNrM+x3SsTWcOurb3peS++lP2fepTQiqIfznoyiPB7V6oqaLDGuNhgn60veQbA2GNM+r4AWAjPAH7wg9uuhpnEdivlX71KEGKnN0PvozD+9DD8qEPXR7epNvM5saXKWSahFeEnE5UbJTVo21RP5Y+yRNBI84otnI8z1njyTt4cInlynA5uGvP7lotmLKlWDWxIus/5Jh4CFN50e/qG3+deFm23hP76+sUT/ROigN8xT7hz5G/kK+co0ydZVMZbpH5XE0LiCF16MXZxT6FIeiLe8mQlC7eb7LsZ7Z9N9PtVOimuS1CU52voxsebksZlXsB03c+w05PHaOg4ZF69EI2ydQExKo6nOmKkhN4I1L5tSpWlEjXouSamY3Bc1mEcjgnlmw5rVKEuykbcOwS8xCoV24KIcMxtZm66KuudpyIDXULDA/uEYT+Fbei81bkqN51PMgYTyTbZvdnbZkkjrJqHwsd363jffRWaYZG9VP5N/m/amkCYj+6mYryL7La5DaCJJWlcN0I4ZiGcqHYqlYbulI76aJSmIlUKtLg2xagqoTjQb0hAPaYiHNMRDGmKHNfvDpCFuDEjN575xTbRr2xfIsHqZwLO8iQFeifM7mfwLQwLIKAkalSJtCWlb0u6uEG8PvH2qvXZkW/ueGDW8yeah/BAPYUWWq4mu+sjj9RqxqmlmkujYJ0pPUwdtPu0aiFuRgqlL4mC3XdM7UwEW0t0qpGB6wF2zCbFa52kTZAHRLonG6VD/Vps1tMnbrEkPWp9TyrZoTpdlW1Xk+4KHyY5s5CmTW9ACmg5JkYekyENS5HSYISnykBR5SIo8JEUekiI/YFLkjWIxdRDOHpMia85VZ1ipSoqc/V6dFDmHWH1S5I1XlRM52/mhciIXknbqIRNzgVu+23I0zD4z3hqUcvEhJcH2o+UR1VtuGPDfUyLR7FmtSCRqN15/txTHZnjKQAZAHDx19ZNm04oh1ANnIkPVM6FXI1zmBhzl36RADpaA5RCQOww+jgK6lZF6h8A3nrIWDglOhwSnQ4LTj5LgNIrnAeGrxs270m1SO7Wcc3KfBTXhafIOqytibn9VbIpOrTYFMsVTlbJt5hdvfJv4F6pexy754GZnKc5mos24VysT0vlY/LtJ4Dtml7V0C5UTzNbRd821VlS/z+iZ8l2TO78nRzf3jACNrgRuTgQO7gOPzOLcw9Zc7TpYiCFK5Dezm1anHIc+Zso3p1qdkjkK085mX57hnBbtpt8so7WAFdKYZ1xetGHMJKs8TaGfBoisK/En3PIyiur3ym9Yy1BUzuLkapbVJGnt5AJLRVPvnEUq5kqxmU7h/LOFHpKpt5MCgohgD+e4FJU9XoXoL2q90Gz4u+5xqB9PjWaGt5FUFJB8tokP/3ZzeXH0TV3ooZqHkl851xK8Ye9ibwXaniDJkxL0p2sUkgXmYpomcvzx5U91a/g1le6gSBtViF73hCNQy6+1w2o5EoimzkrdCkBEfTPtezVdge4wUDPdGCtG9RjGqvpCiuZ/Swb+f+r8iZ7p4zuWjcYauYQRzAYmJPBMsK1VrTZEnmDJJRwAZUAWENIMiNBEPyRRyiWkf3z5Uy3G+fUCEvr4A7zUVhvlCuUfSJcnCX8bCvRBXbsV5bX+gSovhKCwQhuslV73OAiSZBz3aKtVO3rjtAuTDOxsPK2W/b69PLs81pjJA7UMbXzhgoRI+2zlIhlrIPJYwQNBwVuhcJk4IC9iyZhNe+dEq+KJG3jjIhH5hNyl8xRD17xqTy8yJ75xik1pWOgGsw3B90f3lN2RcDmRx3SizwM/ksjwoyfqPzvNSKlf3Kelmn+Muclx+NHTXbOsdXnlnt4YjrPYW8V3qxBeIzUb2lsDlEjhzNckG4XbBz/cclmV1cfbToxKcoJCX/6dEy7k773XMSaO1/rt7OzjHPmY9L7Drozdjdr8Lryd7pGPPNRHyPCZ8u78Xx6i6GZFRVgXRSUTOiCT0WEK+vWxKXIWJAiwyT5EFibCHIEXKOnGqOemnb0maEC8rUN2btksnZ+eVCKiJUKYhqfUnYgtcU3G8dBPClb14F2jTql6zEAnC6XS31Za2xOpsKgJraYpHEKsxek5Bp9wfWexZAIZ5ZnaZvkSgt1nCjZS9Ib8io9HXeJDZY8kMbz2x9WggKsvIazjQMjkOG2RsV+8eAnzrcA8A9hyXDwTo6ngGgpQL1ulf25tT9XRS9RPmi+RB93DGWBJocBGoCaC4xA4BSIy4flyz5GwsOx1UUZZ5djeEsupk16l6C4SAZID4fA5fKeWaAon4RYieq+qjTfCfKlkt8zqyhRdL780gIBwLXw0rWGb81CeALRFJhQuivNx2+MF63rJKuqGNXv3y1RvvvUz07V+BI1oQJfb1vVrchJw84svrFTvdHgajKO9W7WVjE1WeZIviJTRDzaQ5cL27EDSHNJCQ5WX7q+FXNAoWFJGxGqd/Io5sDhsDfb/FTPamOzIKT7n1w0NvqfsDjP3vAWzS9MjuxthvJ4rgqHyEyLf5B/AOpVN82y0gh9ml3y36bieOp1zYUEdczDmO4FM7Zd7H1MtGF1UmdFK43OZgUm50OnWQHjWmxBoaDh1xZzpYZzgApbJXZ7uIbNdcdLFxEeFmY8aj6oj5XS5djrfY3u6iVJZlgukMSjNIlN/uHGNC2utl5rvnuymnegCTJJZ75Izpy1ngeZ23TIuqqbOFFm9cfWoK2BgQ1n3QZ1RVwbgivrp45/LIGlTlhssVR7JBqjJ2M0J91wmodspfTH2z2J5ikzuQRIuZzrJl/5ZJwxreYoqnyNbXptpEU0PB4ImH+SEeQtUkB2klc0QfY4E4QudecvuhEwPbN68jLKzFfB8m1EJHsI8FkCEMht7K0o5NuY9Pe6G0AC1PTYAoKNPGKwpS7j9DHqKl86AJRzWlItWqMleJa7Yoa4Xrv6xVNYOLmQ0gRz0HpPlSvDDVrDK8Cp5dZlgN4PWGmOhspLbKfDkcLTCNERnra7tM3vjrDH9MCFKxVPXCrhqu3U8+cFhkvUfqTWYb4EIldLMAa5YMRov9UriwCBOF5nDoUN+fZl0AsZ6cccu51atXLy2pgm1viukdOfc5rzLJGP1KGOYR9J5zwHtKCUrt5it/yUZ4hk/SI+GDDWxJwMx7LAYWJ3I9MRNW/q0PP215MEcUmWgSg+aXg8fvldfsX+Vn2XrMMbepNNZIt/HPkSYTfTRprAgoV8xRwewZmUdbqob9a3cRJcuJW7K5mE/kdDSWyIwWx8C4px6xCHLk/2TnJzcaTS7NXUC0m0JAECX5muqEuiwECcaCvwSY7YFqSoFBByLrGu/A0lM/1hLl4e4fqqmzp27rwAA6LN/nhK3Ln0Li1EEBYQDUi6tnWAC0IVZVm52J0ffpyY6NP2lI3jEMJxcnLVTmV40x+G0VE2rI1zQi5tAssnxjCZYeekddoap8vtqB0AUplk2FXDN/vLOMG12ZQnUeA92hNHvYAOASwZqh227S/NS6/27w9seMCHNZZ1sW1rOadoDonMWwKo/bimhHZaHViWK7gEUqpNLpwS13+kBAIDKxNOz8BAuqJD/OW8Xfuv+yHN9RjG/oEJB+egbqZdl523UYBRZCbVQ3aYtr/uTda/lVTl7e0GdhUCZ3a8k2S83qMq0073AzjGENJyoTMF7w1UfJ6AsdzKyaPc7aslUk+TGxhumXgni8IxIDZAM9wBfSUfaQVrmLCcerDFb4l6AI5eqB3t7Z/dwj9oVSvV/XJRe1X8mvV6TSXJER32m2KhW2+faKJbwtaqB3W1pupfz2uNpKHO1egqKOMIaRUAX8N9J+ZL/6crPIMKkVVKFYwU4C8l6fmUG7QhcYleqg4BCq2SQmBf59K584r10uJPHNnFsg/Ed3o4Pc7Rz1JkOjWfh+NB4zRXIu+VsOwJVfhljBWlcFhv6CgK97lCPTqnLVU/pNAWQcQ7QKqD0U4epJxzwTZl/hWdWq4SWcqfEwb90gCwjLoEy/aYpkdEUZhhbv8+nPMV5PHqwt6QXzehOG62B+zu87bm3t2ZdMu4UHp0E1FM2imdWI3PQYe46KD8PJhRkksBK9DQdgCq1Y1KzqXyCDMFz16BX3Y9DY/DKoK58crWTL+pyxlkcqghQGlpVvCZ3iohYhaGaQgegat1UNaN03zNaWKSDlsKtGrDLjlnDquxLFxnZUnl9+erFMjPqQuN02aQsssax2dYMHT3YferKSU2yaI4egBJrJWQPJanumFGG6mOQvQuOc8xrRQvq20N7hZhyHX8xkdX5nAC7uip1dVrqs5WT4rSc+uglHu1xx11JeZ1BaQ9mTCODWqqbZ1kSOgnz9tOeNSaZZDdr7XKXmtaUt52tK+i7lCkCME6BBohy8LBhJbYCZ+0MWoFnZ5idgYcRx8b1zM6CrrFOi9kK1Yi2corY7go8w9PlFPwYm9gl6mvKbqJtDw6dLFemsoW04VKm/iPXRzBJQHWgTKxyQOlqTi63XiKiHBGJ4GZJvzcJqBhWW2n9RBPboRuqgVZLLBRQXqArgmqLbNeLiJQ7ucCMq3fnUBt4Vap1zNY80VdoKzp5GOPeWRqhik2RV5/DM8kiBPIYUZ5WjhwBOC1Wjk/RWskNtiZW7aiYsCDP+MHByFkD7Ma6NWdJyj8QjSxbmT1ygJljoJzYIgegak1Tduivmbf7b7swRCkjpBY2YYXSo5AyQnu2pvYwIz6cCbGn+bDbjGEns6GjyTAxAjoDBldzoZNPQvqno4ags8j5MCZCB/OgMfd1AttkGsya+joB7W7Y6WsS7GEObDUFqjXoZRbdgxmwt46zr/mv3fSnzXgdwUKr2U+b8HqbOWtMfp3PKgDsZO7rvWH9zHztJr7dzI5VJrOcqa7XxSiZ9vJmus4wa8uGlkx0+0M3Y57rDFRDajbNdQaqTHn9zHI9TXK9z3pfc1M/M1x3E1wv89sOZoMu69DL5Larua3XTj+gme3BTGwPbF5zM611c0twMKvlDWUdYO/DpNb5XnTs0NWM9mAmtIcxnz2E6awTve9897vRtM6msoc0k7mbyNziTDIk2808ljV6uTpXN5rGypqdURdSVTaLVRq7uixEhQao0dDlCLtoDtuTkavT6e9mEemyfns3cTgBRPGH6yR3tGto2kmmUxKNZkNpEo1XfQrB7J/5No05n6QRbcCJj8FDLE1MxfdRITwgMkfn8eghmCmHeobJwehkhutQ2xAAHmtpPADoXyCv8hw+fa12s1BbZo0+kHW8zhQsNEezNYw2OdKGfHRNtJOLNj1aoxAt8cQMPknAT5ITXZuHqTNNsDlnh4P9xzjY12Y/i0ebhPmj3QK0niYrrU8yCuFA10So1JetEnEmJ6Iu5anTuHAQFMyFJIskThV/kMHpRARtL2AmwFlVF7wn2liMQlD1r9Y2n3aS+k9xLm1v/O/kCjs1C+L+iTE4NikGatJitFlUTO4w0JlVN1hauxoPdcY35S+f75xGZ01D0qLrzhdR0e0Bh7JuCgfKwCdc/z0TO48/RJQJ3HzkJSviIbZjKhsdC+piZuxOpPsUPr7IYJOziJgDYROkxbyFgxPUMPJG+gBV8SXDyRknE3l6bNipVo7seh8iRqis0H8aIM7bMnAUKtQVeuZSLGSletGm/c3MdLaAAC+EFuQPIaQJhuDJgYBwA9rfOU2Oun5nOKpP9Fua9L8nXVJqoMDoJGNIALk5vZk15Zizf7Qop5lpk1hgSeUGzy5NVT/l/VKZJ7/zVE1+EzmJr5HXhQpcF3tmzVyWT0wIYguHpUCl1NJyiSBU8tzQ5H9NictHo42sswD3oNJb8jYO8trA1g7yWg3ug7w2yGulP4O89oeS1wQNMMtWtnM4Srdpn8Nd835lxh/tZCoqZeGR3K7kjDPoAuGmDo7miczgrbY1FG5B7o0wOaQymY0EUx7Sf01Mv4d4scCe+BvEvJ36JCZiJbZaw+tf7d/+Nm1Jc+ZKbzVOHb2dz1UnW9A/EUrlMmh4IKjGfjpyjfbRRkPVSTtvZ8Bx44CeHicHsOZdTS3OGC6oiZTAh3Cl8iClvzh6Yl1QHVWBp442Eae3xskvMLcF36WegHqlrCeg/ik52C4LlXr/Ne7FHd4m/lYOYI2vohEnkyNs/ZO0A9S/GH9hup6bFOcOkDV6gmYwtJsc+uqfCtV9bpC7u2July7rHRRzDodOdujUf2JamU8k9SiE819iFEwdoJ5lnlPdyYIpeZnck8D3EHO5JCoTtiJ5wKmRORXV9VCYkNb0ZPF23gIATGUU4skKoiDpzpKy7V53Ob02N1jWk+2c4q3Yv5QhFTNCXVaQLtLa0ClW8EyrLuwdogtLbxOy5EIZMxxPtjwMEB08dpC+fil9mTrAfbW17NmhKUWg6ptjcWgT/5mLq6E70hE1vDxSeIMZPPOpgqqimQ6m8B+YUXXkQ7zUATLOCU6sjlCYulmIw3N4pgADWa+xT5DAwfbA6gl0oFX7QrjqR7LH002GUVPreCbfyT75p0KB6f5OJMwNndZ7tGaSzBifFwfI1l01y4z/XZ5xBAyrisHm/u7xtndilZu8FVoANX5uLFtR0PmalkWSonLjoiBfADFu2FRb0jwtiJevg5jLhx9hpsvFJGUSjPBfCXq+hZhjNs1gm3KiOlBvvY6FNGT0qPHRVGwROqsbWtUM7uoFR7XCI1Qn9FQjtBZitIfSblmHrOVWZ2Bdzu25ViVtNL2RTJNm/4gSjHUgri38Wgu5WPfUW5GgVIxZluPhKyoO9Yi+GZ9Pd8yRfyp1KF3KM6gOlWuaL1ravJrKnCFfY1MzKF/OV9VjZkDD9NJzDwXy+5FP7+sfhXQfDrXvrrTFqr+kX2zGReLrfzevYP0ta3Zam1TVcs9+zq5/N/JcP3DDoBNTPanqgyWKzsVxVTH35vK4qknxTdCVv0S2osCRBmapf3Wlq/wjcggRjWJ9XaypRFcSC8rmkaFK7uOukutWGrd3AVwZw1+XaaJBRddcxv/UQk0DBXwsEAm4qY8rz3TdG0UXuTNdVzWs8cgBBIiLK0bn+JY0Gehz83iNuNDS2z2WQ8yxby+Pnk49F2tFB0mdJxLEaAc+V+J+y1DI1aC9JpBDG0QCzFQZBhpiQ6WaWGgKKFSM/Uea+Bpzjpaus/02XqNwIh8wyZfazla5KS9F7tA1TDRQ65Ys0nSXOTCMOA0dp3CtGgPhgGDOCF6kNfrNKFoJk9h3Rs0a7QUiQcx22gPVxA37U/Napde9cHfNe6SkzpYy3gAAUCG1EA5z5cPDsHQX8XUJMnm801Fbcs+YgnGCSoOZBHIjEBPYfwpYeP2Xqpm9AZjYE1n7XR+VxidvBNCRojdLvtEK8fZamFeyVR1vokAAXZTJfo96lfX1p0qFi4rllxJ8bPEinqte1Fz9rLZoUcOb51SPtGrZa/fLVGE/02SqkV98l2uqWVLf6PksmZMsYhwtGSrF4jS9lygW9K3u1boPswWcpM0rqwPqmrGaUZENDELV2iQ5tmRzPZXoSAYA0VgoSrZGoWR5uMARH3V1fTRV2lunc6bbWSWb2Q7jiIjhHmkFupkC1HDgho+S/DVEAQoTQF2vQ7XYADWig0wkpPHW/XTeF2miJgExBTFtRXZ5M8LqQ63Oj14GFPrJrTJTSPJ/8eqaW62qHw3ueNThOSnsRiadTd+6xz6OcOhLqmFOrlsZ5LNSt8yi20t3v8KSQwLU8AYphQXDai4omY1aW3s9QNBkH5KZN6Vxar4Blqt5q/QFTSxkiYFMuyTKZ8tRmoN2j7gCDlYdMdqNN5SRqJKFa1RdtW5yC/OY99PWbTXjtZJ8JJT5yIVWT2HGGpxdqOfFjPcvbN7ELNawijmJUP1oNcMK1UbhqhUj1dkJIUmGkiOiupUp0N4re9d8qubFJsrvoPhTWeqZFB7iUeN4HLMN9nNnVbK88ujlfovnqYcs/Pf/jFLqjjzpRYR9VYJf/gBwR0L/GMY6yjoKYoYC889EiuPH8ONPIz0Y9g0/oH+cTCYjFBHz2zGgiOAPAoeqha1Mv3kx0qOcxlzQtXXGVQnw1AAjq4hVOIYhFVknolSDNFnicCq1G/OYBD5mCrgdevN8+vn0ucTbVGWWhIQLtI6O1T0f6bj6Yy0naFbNlhmfqt+mNMIhnkucR3L/5PBLRuPoGErfNbDcGma40qtUoSfD27+r/v7a1hmyy16B2QgAgK8oE5k9A5iAt4n0NxIqc1e5txyfe1Qeowsbgu2PEt7PwJqYVdm8sMfHW+E1Oh5lFLfhydXs3Wc3hQ/1uhg9vVR2BJQ3MiW3RtGPcmnKhI8ul6Gse/Yzh7CJmX0qZ6LbmYjy3JOPfTP9pLBdwvZXURUV723u6BRu5PVk3Ngw5Yw3mAlFl5Yh+TWBza1jhkpBVqQzKi9liALrDYFCX2XoZFiOAnGYgWcTGlX4EC6JsNfPo+t1LEP9j9RNkvWvKeNHPt7g4IiT5QQxb0UE9kTM8BGKyEShHmoV/Np/kuzD05EjNVUXonEf5JUwGd8ytVfS5ba5Ba7Pb26Tg1AVnKJXP+3I042Qy0ZCVU1TOS0wulYwceirFKzqH5UhGzyer3UhVOOAKg3Yp0ltecN8TGEWpmqTB98Gudp8IpfWfSOyhNXpobN0r/7xjbCXXJ3ivZaTV7eiaHKqCa0QNFN1udinfPnb+H5323j3avO1CAEAgEOV+db68k0zAwCYB9S7uyG/ujFJr2zrJNaNLqW0q8HoCCgSpml5G/ypvnjxEuZbgXkGaJpaRsLOwDQGgnqrAACYNDyql+rkJVfLW8mc4D5If+cMIFghDnOMQ7hnRAjckKWNUyAikzyF68zLBo5lDpRooRNtGgekFJ0mlbOBw4Fw+By+U8syhZNwCxG912GmLzWlSVdNUHjx8staqBoIEK5Nn9NR/9zn7RatwsF3OkrdL0t76JbDhdHkoPXaAABA3gVFZzq2yUh6irFtRuTCqnS1f5nuxyM3275zoe9G5WMTDWtVqNC1TRDmVqB8heHXAGf7AQqWMjx0tU5+xSq9TaM756+Y0RZXgAax8tcNDVqiyGsiyDOrXRNDrvOyN5xKzVzA7JLvXR5NTtCVqyr76XW+A6xo4OfepsT0nzrfjGpJCJd+v0oxmxpM0sfcTxMgKcFAD9EKU6vHn+5gEi5OsuhOW55pJUxwoWhtV0bbGZoDtUvWhgukRy1hLeW0nKGhFmaynvXWBsfb025qsjPct71IB/20Hupb1cyZOpZlzfx4StzSnH9/Som6PK6Zyt0O4U8WNqklOm3IQbZM/u61QEok32Y/YMo4aoYCQZMPoNKaCdoAFEy1B0VkdRGGbb4+R6a2vXuBkdR1/RDmsQAilIjorSjl2CZzUGNuCFXCOSgPwmYf6zVlCdebQUvzlilIwrsUUyc2ywBO0/MtldO5KVJPF7YyvKleIXlTWQyjdVlN2BnGgmcrymfqq+TSYz5rMYGkdAJzcVhbZaZy65ojD7DwpgeHSYwoUvOeb4EI5S+oFASMxku9OrasSFtgSHoIdOiPryqJjPVijq1qRg5FFkmBGFi1VORSZ9pELGQC+hrLIP1LazSDKaafHoEVWa7sCbDkIX+qpnsOfDSHTAn16WHRc/The/UV+1edCrCo1dfO3cj3sQ8RZhN9NCksSOhXzMuuxZ5iGAtb0TFA4joJUj6RkNKzLVR1q7R+llNIiVhVnBW97tOh8seOM4ah8gfsRBEcTsNQ+WPPBxYAhsofmT9D5Y+h8sdQ+WOo/PEwb9qOZ32o/NF/HYbKH0PlD4Ch8kfyZ6j8MVT+GCp/DJU/hsofZuMcS9l3LWOf17aNnHZk9xL23crXu6d5cd2qriXrncrV7y0ryj5L1PcrT9+e/Lp7aXqTP6ERaq+y9Cnm7RmL3UrSV5acbwTeWo6+utx8i9GjsRR9Xan5FqNPfRn61jLzzZCrS9C3lZhvtih2KT/f1aizx7LzD1Fyvk+5efcq8W6l5quZjhbQTWXmG4vIj3ZmM8oF5Eed2Is92dQ6GpcexrDUw6jkPkPobUx6IENSByNSF0m3k0jlbBJS03QECm7mIGPicQbaagrqaN7pY9rpaNZxM+k4JkasWN5+5pxeOrQ+ZhxXE07X3Ki5F7zafJM3x3SCWWm66W2K6bXY3U0wD2N+eQjTi4PZxT3pcPl8NphcOsNsM7ck5pNOUBtMLX1NJz3MJr3OZR8zQXdTSTczSWcTSU81sOucO5tFdjGJdN7FBzKFPIgZ5AFNIM7mD23UcITawfQh2bxuF6gXM9jprHdo3MXU0d3M4UioO5g4rPHCAeq+zBvONLnTHXanRZ3MGQ9lyuhoxnBVXkClAqPJhKH1BA5g3c0XWV2BY4r3RtNFUVswciE4DmaLkkHCuUbHLiYL51Ptrv92NVN8rFTfbgXJdylG3hQUsWshchfxt72gXXfmxaneV7daXx3qfD3SGl871Pd6oKJ1j6xgndOddilUNxzYx3VgXYvRdTqyLoXocqXmGm00zkXoKsvMtWXKbS5A99gvXWuT1jLg/UuA2zLftTNwKv/tUr6l/W63lPzepdx3S0nv9ox+LqW8u5FFB+rVu3x3WqK7dvg9le5uPbvOJbt3KNfdVn95T6W6W3esvUT3DuW5tXDSwmb2Lc3tEAfvWJJ7h3Lczak7ACClWj1Lce+HTrFO4kpHWaVRb9Ot7PYgnQzSySCdDAd2kE4G6aT/pWtt4lD0epeC1w5FrVvMBA9VzPpBCln3L2IdOVE7l+LVD1S4uk/Ravs2uVT5dCtY7VqO2pF+t3oz9SpBbSWcVvuUe/npfHFpx9rNraWnC4WlW8D2KjvtuBFuzlW9Sk23JWuCzmWmkyLSrRvsWmI6U0C6BaZreelc8egWmG6lpR13smNJ6T7lpNtNgw9TSvohykj3KCGtAbfA7Vo+uq0YFADsu3S0e9loV97doVx0n1LRljlwoOcdykSnRaCdj12PEtFOt9aZkayzFXevPOFU7LOUlN+U8CG6ZtMKhb6KW0oL+ZzyQo8u+bfranw1rOFudTX6VMkYymHUlcNIMrvuuRzGdSaHamU9DNOgqSBGTjlaXRCD1RfEYAkGLhUxsioUFcR1KoGEyWAAk7JUlQryPuF3oIDLzeLxWms2Ecg95SbJqOQ1rCLL1HpKoAP8ndPwConVMUz1cZ3airhTQQXKvjN6F0+CcgRU5a1rxFwjbeV+kzE+2OamoV5uMxM3jKVJpoTwW94Z15lcpTTpvC0YllQAEqvK2ntlzBSdKmF0U6zP44LTybJYkfC6lDA4g4C92dPSrSzv6BKXcPHT+kr7r9piEM+XbakqyaBJSm77ABAHT9GzqpppQ9WWoWpL7s9QteXjVm0pX3OVMtvWUJN/1xrjRkIGABktdAfGsLF0yY5lS2Shjep6Kf1KluRLk4yqxf3O5UpyZUlGNbJh91Il2ZIklVA7lylxKUfSlseiWdpsqu1fNJ9nVRem8qDtXluKftRRhmspbJGngKea++aJJbtc2kKyO6YwIuEGp6rNwR+QNIzwY6Dhb3Sx+G35K4nU/00ufgt+/fy34Ne/z3/7NcBPu87IMEHEd60uMjsDwiEOyS9x4i0lKBDNcG2znFVXXLQtY3blmMl/dpV5FIBwjxMDAmZXScJvrdipxwjAixXd1ir72aXy8woo8rUWUikEzNYYVYOk1xCQOww8RBFf1ZQ/lb11QfDkmem6Ii0VSXKL8h9p213rkTTWIukuoHdVP9hnp03/4G3YtMPL4kxMsjXDTR9b0jqzsBacrWTW5AzUozKEkt2cq+cU5bKSFOZYdKld3HQ+uwCg6FvvOVjZcpwIl2OlhuGczIN6U3JB7tSvoQFhIGsdsibMRi9Nm2OAc+Q7lLx/RDDPbOKhTGCi42t/iWXQkfKWo7xBhWqEhHTaEmM9axQl3mAcOMZTgG/pvdQxK8W1Txv0oyT0gtjHFQfB8or9drOtyFVR2ZD9VhDrW+kFmMrQtwyFnFjh93jkUBk63wWUWTMRyCR7pMqMq38VZfv0j+bVeAM/01QuurVUdONKt1fELt0c2cXKLFCD1MMj3lDbOq8HqS1mrQ3n8hbZwuVoTuP6jBUi2etpV2Rl0XSZOMw84JVE2N09qvx8WMBVhaUs15AtLlUJFrL1iFILYzPb0fawgCWG1/Jq4NCrJ9HF61XsV/BTKhDwRteNOtKuDoQl4XaeJv+BadwAt4W8O5DxBuB1BN6RkDehLSn8TAAKOLX0mzcQ8GlT2UkHM5+L425bMa/SIepT1SvvyV64kmksu813ZC5tci54fY0Cm3dviwWgDSKKrumij4Q3sEGNCNcarVo42SvZD4h6hnQlPZ8s1LoJDbP6NU/9vLtSNz6QtYGsDWTtUZC1BnqWXNMkjzlhrbtmLCpAQzh9d70n/wBjHjFibqOg/i7XVNfx9M2xtGybFNjjaMmQ30X1i2JB3+perUR2toCTtHllnWGtiTKGBcItQn5NbL2gkmSpW6sSJtBYAAq3sEZhjALgAkd81DUgzse87rwVUm2qdlaTbbbDhKthuEfahc1MAZTGsEZ00VpHiAIUJoA6vx+V+pry0VbNJAUx+5/qZqQRK/RIQIxempHlEssZ3tclYNXnRy+DImLGZmumkLzovLqCaGtNYg3OSRtxqtsWdyOTYLKvQsTHkVGpmJPrph45K3XLLLq9dPcrrHSVqMG3zFuRwGdYzSWtU6/W1l4PEDTZh2TmKrFK75DQdpEWqkT5tIvdicTV0By0e8QVcLDSb0s0W5PwCy4CsNMmNwjCpXm+0W2BcEC1cvFCZ17GjDXkFKKeFzPeP2oRccdq3deqKZCcqUX3N7YMg6rapd6rqDo7ISTJUHJEEhePPAXae1Htj++MZn6L52lUJfz3/ww+arU+ant3Tqv3SmtyR2vyQ6t3Q3so/zP7tlnLsbFiOLlnlRyPTssfdvDOWmEUiFXexv7pnLN+f15Z7u5YqQvWKP8IDO5YgzvW4I71SN2x2v2w+jhcNZnFG8K9XYK8W0O78zM1iBT1ilYYyTpgpZ48lQMb/6zRIw8h7xw4LrVmhAscetv63CL5RS32AMKTgPLUNyTvHlDjHybLkvx8/fXRS/gzvPgZVpqyzrFNLGLigdOoZHk+pVdb1nFmWgn7FeJG9bKmzOhvvnj+jxkVeO1waqhpZ9cyc6quXbK1VKlquiVsaXIO4e7JWqaZVEM1oiD3UJBd784LQ34JHR2wTNKdf49RoEKQFac6zYa8mio5r6rt7C6OaTUaSajxTkv9dHJmfxZzTVdkmxb7RJsi6S6k96EZjn+aBFffZVEoksv8qWrJ96RiWuXqgKBK8QCtDiK1Wu/Gz6zzPWu8YHWUq1FjbjLgmx5KcS2ZUPmba2qk5qvT3YFxdmVftFwCK6kLIB7u50B5RZloxUAPp/EA2UNCNiT49vQKZDI4Dp+9/Mvz/kigoDsaMoBburTov0MhhJ4uYHZ1LHGrAAzwrG4OB5/Ej/FjOjB+VE6tp7f1H5Ifs0q0VkbMKDlObRd1AFJ9jwpnzixZhJlm3EQDhwuEw1ylINSOxn5q+UrHsekOjYHs6bVqarwtnk5HnbLE1DrKJsMlVs68D5fVy45qHqKc5qv7q6yNDFeMzhttDBVWBuMUqB6EuV7BdF/bTdJtJgWn193N17FxAjm0M55x2JRApKG1mrQlYVIe7x9p4i2mksJsv5X2kYmz32DDRAO1bo3ug85zaDadFKbwVgVOHBpHkCQ3AdL64UNYI5nPyBBPLlCoptowE42aZvZSm2DGHJMciqfcfdogqRFRah71iI5zRGOshzPZMvKkvu0iAwBAiW7ttAH11uoqmmWXw7zOsjPmQGxJA/XvdNGa52CewV2QV00cUa8gt7fbyBgPVQhWWkuyqvFUtu6PbLv3TCOB0TxGH3+apnwYg5u485zT5rXzlVDNLjaa1G0d33DbrGp4dF7n7q8H3K+2tY4qKftlq4gqutHdDb2rR2nRlRQY5nGgy716vTzhB0XLH1HRol+36rVwZe/zcXjKP6Ds5tWgMI5ad8RbYe9O2YawP7u8wb84voQZAXR2WU6LnQhPvvb4bszASvjdLueFhItAFr6/xsjV4fjSZB3X4Zizo0tjKlMnSJ+P5ii9DmjdbEOvI1p8G3oPjNX30ijgiJbN0a4MCQ+1XGvqu+Lzhvo4SwrM6PZSKCvJt8qTYXsIZ7jB59QRt19iyuK1I3ZP/121ziQhvV9hJCU6RXDgngmVZcBQ7Ib00YaEcwgoF0AZYJVbbQrj52PN+h9rkEkCA9nwWS3Etdx4mGPA0YqsMVMaI44PAIW+1lFISzsXLPaEBqzlVol7LVCz0CktJiEgfVQmqgKi3Fh4YfE1KRaaaC/oNMZElXQNLeCnuwmIOnLelT7oEHvTy4baE+szw3ZBJY46KBcUW8iJzVdhEaowvz2oS37pkahtmaXFrY0kZWxtpAhVbSt5uGo/6mtb+zk5FLUt9F7tV14aAgCGAAAXajEEAAwBAEMAwBAAADAEAAwBAL+/AIA58u7iaI8BAK8UwKoAAP2lOgDAoFEfADBPwRaiAJIvDxIFIKHlHe21s54ygSh9ph5fV6XmgoTYr3aIlws61SAu8g+z3o6SM72LN34WPYMIZUnJPR8yy1aNkG5QiZDeryNb6GK30AWlv81j2hSx0CtWYZ+BAnryFYECZpWrAgXQECqg/wyhAkOowKMPFdA3vClU4FX+qnfJzap6nmHe7l/4Kmlq0WB4TQWWTxXDXGsINDztkLCo1A81smcpkXdE5wKlkkTY9YlxQimgHgpkxod2g6xtaRFaBGgJgpo6vRpSmlNFKjM7Kx8ihjdyCKcluso0TqvmcqGyiwRYYH+i1+Qpb87UEqLuiVW5K5ZFDLPbaBUGZjsbkGzEJWVmWrF5lzStwqeJsarLwFvBbDkgXa9EnUAlK2Q/2nWv+FTJ08E+vGMNiUq8Y5OHRkLWKq9iaqFqv5I6vqthtQbx7DGIZwlJ2bucdmoh1wtshSbVklsRw3oRrtCySpYrN3kQoU5TI0OCrD9bKsdFmEk12CDIpZ6zPFbZuRax1PnXPxtFpOSzeBOiarRem48fT6grHOgK6a74hFczf6Xg8EHKG6S8Qcp7jFJe4co/pLi3o3y1R9kqQ3Wd/F3rZIWPJ9Wowpqvu+B9U+pSxF4D1ZN4mn3AntaYg7vM8+EFo48nFA0GmkcsATAs/3+fKZquNcQqjt98qub0LSb1HD7LQC5w9umnBzfT2Ftrro1ijfSJaOBUTZMG/rkj26wvdipemAE+lXxRMMcU6vul21bG5nHYY8zJrGDZ7cJWWmTybPvAqw+8+sCrP1pe3dzxJh79unDZOzDpyk5g+rsZHZK7bP3FMjRcbbsCeaTtNZ1tDmv0gWHBth6Nw3Yz0Rv0weB+LTudyk52kUpJdAAJgdeRqM6LbcPck2dIhwYU34D8LOo8kzPvpkNEVNLWZPc3vXvJDKbvDfNcB75hHngolHMnkUqOASRUvvXGYVE20jtbu6cASbJAzbSnANRpaF3I2tk4H4XMASho/7PxPXoy5iDUhB0lB8A6o/U9BbymDOVHTbZxowvI5VbEpkJDKVfYeNh/31k2jJB1GiDu4pKcNs4um5U/c9/TI9WQZOOjicmqGmS6j9q3Fwkkf/OUrBFRFX+/N2Sb7IbVokPh6w3zPqrp0JK8vdkOq7d/MB4+TtXBmix1rLlA/I43aA2a9QVvLJhbxO8KCoPct5LGII9ArcpgLSzgVF+Q6+qoMLCiJgqiFdpV4MzNLHtLUJgiBwnmgwg5iJCDCPnJRUgtNk7x1BYlt2VBzQNWpmVucqPSMl1R2p6jT4+AT29MhzTDVzb4yKCjwEJEaVBT1Vbup6Y2lfr8tiguhkPkGEE0Wyjx+upURfMp7Diy/hGIg/yCAhlsszUBv/VhrCscwj1WYpbGQPU2CSVT4hlLcg/XuEbwA6ewl8i7cJ3izdWpbJvfkawLmmEy1IaAFyCyrp9j29bATuEy5sRpjrfTmdNd2k9dA1e8+7mLNs6bcvWueU+iDSDOqUckKupsNp27fCL/B96kXfjzmyT0szdJsqZKp4xGp9nW6bCfqPatnbKOisZ+U/6Vaq6s2DN/gJKSceZrXcFIjFQOMxztkPelHqWCaKUZqrS+Zs15pHMp/qTPFsqfkMRvtzaFkmv6Rx0v6p+IDtkTTR97iCxu+KZ6Dd0PlOOxcnwVuqZK7JzuqhYsyERYWiFrd02uTSn31S5zq02DVUVeZdtMfhOS5YOwRe0eETnHpsKXKkQV+5D1j/t4iSDTIFoEc0bwIuVsczkdE8tgw0xQuIUFIkHMsJJfCFcJT3QCfptRMkJMcSeygSD+FnzCowBtG+ASk0r29WzH9IxMdEg7cmPbP+77KInsDpnGdkh56JY0ruKeIFjnCK++ukxg/9C6wtbZBsxl6aP/J5g7Kf+JRLKo8ZebqX/7O51bvb9WkyZzqc0m0ZCsoUXx33Rk6w/rp+NAPr6v00fVTEY81USe4Sig2zUORU73aH2LIm+iM1JSZn5V2rVEJTgCCNAc2xw31d0go0CUioSIhjgUE4fGiU7z5fSL6fNELclxgNOqB2skvNXrDBb1eHTCxGbBOoYXamXlYVyadPF6j6+xdiMZAcibFCQZFoqKiyCHXRN+Hdeqeb2goBIxhRBOPGVDVCKY7T1Zoy0qwjY8M2Y5n6omXADIGi3xVRwEVzQg3lYqDi6ouCql9lXtkuGPcuCOU/T1HxxuipdOY3F5dX5x/urm/cXJm/Obq5PT81wr0Hq5rxmtSPi2IDjwr3Gl5VF9075NdiunyeFvROTm/Prd7PT85PT08u3FrcJr7ygp36/yXlbiJTU27y+vzq9Pbi+v319dnj0MSrlVyjV9kizN7PL9q5Ob8/dns+usfc6j4YIsY4ZhjjgGnzB1t7eK3TLHA2gIK8oFREispgX4368ww3AZ4fD81Y1SKln3D1vchwSYT+EML1AcCD2MBJQ4ORxtEDtKKVr97mamULWExzDOghrXL8Tpjd6Oy9fvb65OrjVI63dgV0arKLW0IGcvcS6AFDTpIJeLo41VPfMVknOXV1hFcWJxj00WL058DB4qZLd5kpRQ0PqtiPqmpP75xTvFWQecJrhZTkhLrrSiev2TRGsmeWUMPpbnlRd371bpn/TOqE1RIxCe25QjDaNtbypX1WGjDPiG/Zq9Ofnm/P3V29ev39+cn16f395kj3B2oxRdgygOAuDyeRA8cZzIL25+sIrZlMfMd0nnMm5fmKvZ+zffvLnVQGuWJKXFgrKJxHOyRiFaYkOQxx3Wv9s4TfBrBjj/f1eX17fn104jrSf4Q0SZaJ7J9fnNDxen72cXt+fX705e18D87Pl4J55q04ul2nTgqDYPxlBtOvFTm4/MTm06clObx8NMbfbKS226sFLDA13/QN+eXH9zftv3bVaJzGsfaE95qsfrKH13bS016j/8K5lO7bE9kCYV9cd8IgfxYd3t2Lg/74SLpej2vr+7fP32zXlXfkVfHheOJTuiHevyYiaH7sdMFNmBhBO40avcwgZM0gKFreyA43s24Rsved0lsgUnKBQRM0v58Ri++Kcv/sn+wKigHg2OZdU/81umFKJtmmcZKp8xjrlcj5OFcm/bHsMFDfEuvJN9XvWGI39N1ABq/bATN4UiGwSX6X6P5ytK71rX161bDYvVif0BkH5+JFxqe1rit9fKp7XMrxtb1bpYOyzYp+O16vGpUErJCZhmJU7KlTtDbMmL2quJFKYDuhSUCx8zVvy8+epl4aeXf/s/L35XGrGTszezm5vZ5cX7789ffXt5+V3ta5bS1pqbPXYc4OuT2eu31+fvry5fz05/qBnqa0QCTbKfaPXGGoVkgbkAX9EebR+x3KosqJrPAZ6ccn44eqKdoTIOKKfXHP4PXL86OQUWK642P0ZS3mGDmSrzPHqispFpQD5ZLLCy3gckjD/AIkAbGjMOKJQN9Y8+4YJRPh09GT2BrykDHkeSiitzpAERYgGXN7rcA4gV0oofNKcbLLF+cQDfYJF3hdD6IuOQqDjyDINOGRDucYL8Ncj6sWyrzZlIDWNu73T0BF4ewKmsMGACWjUcXYxb+4YZlwfd3bB8E4+Tic/IBrP06mZbepyo0teaNX4Cnx3AG0kJjF3KYKkOTbg0g5JQ6blMJUcNU61Zjz/ZTtD0p76TPEgXcgoo9OFMz/X0+ow3duoxkvOfkYu/+hwL1MlpvT1cnciNlH6mfOpxMjV2MDNkOV691KIyYn0ml3YWLmghIj0dLPURPw1iLhS7oIr8KXRT5qrC97vKXcbjRG8ir/UQf21ultx5fbg5sDhUFbJ1ISUI7YEQK0xYZqDpqNHRqN6BRw90XFU5IVMwe4UzWBlBVZKpxB3ZlCErO3A0WE3lZGZnDkPLhqk/s0FC+zTTBWwIvu80rqCRfEq33+Etbxndbskd3nJLNrEP820Gk/LYtZ5ezZW7K30O8r+mMRs2KOEBDcGuMSqd73yG29NCGBICeau18mfvkZEisyo1kR0ZCpDLPlEavirLRLFRMfWF9pA9yX+vTGVRC0193SDNZez7pWlofmPjF83LAt1flx3eldGji4ACicUERbKqDvanaaTklNBjGGfiHFbxfOrR9VHaRDIlR/iDjmeZ2MhQgdmR1F0dff7in8cO750+Hra3FyDOMZ/af7e8fnXNKp5AUyPfdFDxmIVQrIoWpYCsSmwLd6iiTeXb2iNbSyajiKbFpYwiZ8WfXdKanGGB2ToXpI+Ky2HMpDYtiFgxGi91mEPFwmVqTftYuTqCKvhKBIc5jUO/0EmnXSr5RGbnK78SGmpZsjzvus81889ATsS10lEtjXKyLGeM8VMXrD2laqlcTx2NgzlEiKG13DBTCRRly2GbewB8ywVeg/KiVguv56YEIH0+07sDJxa6Vzm0VVPPt6adGonYIGcSlg5LEjNXAc5IOyENJ2H2xWrj3D5OBOB+Yv7+GFF++RvXuOr5puA/KnoCNwkfq9daHcDxNZYi71jtzViRDzyepj/bavNIVOFksJd9JQrRastJIYF6w7WU49/hSEyTgR98uGQxro0yoMjF47Cq6OdEE9aqkHy9UM6HqVLsyh8i1aQqs7mdT1YcW6HQD9TlJrzqxNTP9JOEte4pkPWPE7qaPmPFJXersV0LuLBn6Th64+7wdqJ3bY0irVQsHK/kMcx0XVCWPqH21indJeY4S1ZohH7Rxeq/S3np3bynazkf9bGa33N2rl6ggNemgxgY0z8YY6o1FwN3OnCnA3c6cKcDdzpwpwN3OnCnj5c7HXKVPV5Nvab6H0FVrwdqVtbrNm3qeoNys74+GW4vGvt8iqBiFgGeYQi090fGHTqTmtGyxdOm7OJTBeGWvuXlbOfXVZ+qM+tOiqHSuRwcNnVBkhM0P40Q5luBeQuaalIy0WgFnlXfqqOn22XFqtfXhVmRc6pnWPYuQSp/4z2LkbV5/TNuDUn8guJy1dfuy9E4pz2qArJzqGLBBc0W+EjQn+OAhks+bS0SkIPWUDGgbEHcxxwsA5afRfXRNU2JOW7uU7vGi2L4adXM/lDGM7NmhUxCyBMxCmBMw4lP+N04c9zN4rac+kEl8L8sLeUgIRUWuzWTpGyQnOj0kqgD26JumW+bb1+99NyUuKtZY9RDa/To2ItBl5TVJUnPb38borUEEGzl2dgQS1MNOH5o7izBga+jKVEs6BoJ02mOYUGCAPuat01YqIwQBZz42EMszfs5znNrYwNeXwY/8ezO5livVhLJKUQMT1S+0Jxwfwgxx4zDm7c3t5nowXQqObW6HK6Rm6i/UfXat0YNXIMWDtocQ+tcdJ01cnvncXWMglrrOU5Tu6LM0AyLmIUp8ZI4fIPFVRAvSSgZv2cHII+UieVEwnrTNq584zrp56l1nXSzjI0mpV95CfQZZSn1Oai9O5QV8thqduHvMRdWS8QBZTRMpbdTcS5mUfVxJRzIeh0LlTsQLQRmiTqieYHaMjXa+X2rFLJOCVXzXTLrZrd1bFu8J/5Yv2eVl7Sd1qdlCdvXC+5RaYvMg1m7lNO+WYG14NBh0bIdqpZMf7cLli1Xz+g6WYNk5TKPYOYUCnSHQ9Vj3zNuSY9HQ1xfgSXRjMKP4/zZGf/k0CW7chUdaqTT1lsftsjLmXXPS8z2mJnUAplDdkGFqXmhb2hCFGTmy9qBJHecYWYoA2biOP1iTBfHOsBB8S6aqiAOPPZWh/ZEGD5ZBWDg0MM+RJSLRKeZUo1+ZVQyonLrCpd6FM79/iT8QrOpTNI+rewqj4a5FWtNj806aXmvbsSnxlpu8wzMiU5ioZSbMCeaRdG6SRUTpFmTAt1rmsyhHkBuaWJYb1aF2NdWnTLfxNhlUFmhKMIf6RWpl+UrT8bJ1czK72nhF33Ge1PkNL7UBYOns4UekpnVQhAR7OGcugBIyAVGvvkRh4IwnOyXpgoKoYw6QSASAtKHAP7t5vLi6BtqVh+pjJ46O+8ah0Il+VwB4hI1wnReZzy1kZ7TNAb5x5c/6QOFPyCpXj60+vFsuJU6xYTrySR9FQlRKEXUN0jfK2TlYwHUIBtjCMidjG5VerF06P+Wp/F/xvDsfoWlrCT/OU7ju0qsZTqwlmAYWS4xUy4CGLAUqg+AMol/SDONQ1NzyVIIv4TIjy9/GsOz/LyAhD7+AC+TzMcR9Q/MiefbUKAPQDh4K8pxqINk1b3YYOB0jeEeB8HECh33aKvrT+ml1M42EWKioEG6vTy7PNajyW1bhkA4hFTAgkhaoGPxsjeOxwlh81YoVDmf1WosYqlvmD7te+Kr1DkNap3iRfuEShPnKToXl3iaVR03TjFnGDzyqcflBKWBlB/RDWYyevDonrI7Ei4n8pBN9M7zI0WXj56o/+w0I51gwnlaubfgIecmx+FHvadm9YFdXoKnN9ZcX+hd4ANyFG6NfE0CUbh98GMsF1AlxPe2E2OTnqDQl3/nhAv5e+8Vi4njBX47O/s4hzsmvW9rg4jQVNeuwT8Cqn0k7IeaZNKTMuO5x7IauknRXmJLJpBQZ5a2hofUqt+l7kbGUtTKZGcbW1WPsCYmreWSPyk9+4SEKtl1TqmhZcZ2jfIstKImlIQJVZy0rCnspxu0QN9LVMeJBcJoj5RYpiTmU9ku2eUxLK+vTpUGqZL3tmM2YdkJh0Ryl04VFgueQcPyaNYvSaueORAxhdkCQipSbucQiMjkhldHyq6tMDsbh3chvVcMPTYJzBObV8JsIHgbkg8QopBy7NHQ57o/Dj3qm2rlIZBQ/OXzKVyGqvWhdVxYo9CHn30kMPz5H/k/Xvxsppw/4xqfMDcGJ6GH4cU//9PzyfMXk+cv4PnzY/U/eHt7Om1IwK4w6ZwPXmW/b70aqpW9EwHiwlQ+wb75ZOpFlY5z+ra8jWhoU/EbgUmV0DWnSIPJnyUvwKinFNVSUiT/JJjGOWudriBiFdpyz+NQKO67ecJyvslZnMLF5e35scXG6icCulzKg6otD1l1g5V6OA45EWSDs2SwP3sriCPvJ5pJn5661NpkFqROzHStXLGLuix1Q2o9wmnTYuWIzp5RH4+AK3TeC/o+5p+KfrejsAP5PtRZke5JzZJxLEBQGN+yGI9raL018mG1eSTEnAMt7qul+J2Lvmc8yByOWNIWWB9fto94siQe79Wgn+hctSGwE1Pw/QqHZjtyoT4GMuE5ZPO7YpoqneDFpdIL8jUKAq2HCbO1ldVOkwUQkVO4ZE51prWxrMj1kBWNHDgXJFLkms9wGw+wJiFZx+tjeN69YEyrVavJokUaTVkdrKIui1V/c1aIAyZyU9Taa9uA2TUhd4SEEDG6ZJjz6SOuT1MbQjkqyF3dQioHz+HBc3jwHC7MZfAcHjyHP2Z08+A+PLgPD+7Dg/vw4D48uA8P7sOD+/DgPjy4D3dTtgzuw//b3YcHD97Bg3fw4B08eAcP3sGDd/DgbdCsDB68gwfv4ME7ePAOHryDB+/gwTt48A4evIMH7+DBW3MuBw/ewYN38OAdPHgHD97Bg3fw4N3Ng7cuzXSVA++QdvqTpp1++HzTjYmmWzJMN6eWrqnV+r8yrfRsAQhCfJ/iGmLsc4OosS6aJ8+w7xWVF9TRh6t3pz2MoQ3OrxruNMJMK9CEPgSnASLrSgffG9Xh6t1pR7feWY5cF90imifvZLQr+yaW+AqHhdjUGSprFqIqdbv7ohTiBswjmzATGpnkJdDnebX1GRJVnMj0QSIKmmdwW9iqKqO68eBMPX7yjfbhdv4wDueFA5bRgtfZgYnsYO42CeWOTeEqwIhjCBOHhA1mUsueaCatnVj7Wgfb1DfIW2FPqodhTsWqOCQK/Rp8BQUc8phh3Q8xo3NUgwjAyFtpBnMKr8zY1oCtccM+RIxQBoJCrBQPVrllLG+NJ035Abxzv0g7XaFbZ/3qPXJWsDZOL/uKlyZzWv2x5Mj/+8otrhVbMcfsaXKdYUGZZYkzRc1qFl/7fiWvjCFth5B3k6iRB6dDEMEQRDAEETQvdi6KQKk1tCMLeCvEkCcwk7fKK6q2co+zvuOdrZmGeh7pS52IP/xJQWRI3ZqedjDB7eC8qxj/9AGwVgHNFje7R+7VJauWvXbSqdf2LvjwZfmwq6o+tZyyo4dp3olWyiKEA+I8XmvmdJ640yin79R3DjU4GeZ2IB2LY1GS8XyKtXtP1pu6Qph6KC/fCm6mg8tvRe+aDezgqpjfSxSmQk+puqjzMueFM7XIyl9LrzQqPokkzJZYe6TZimvvUIfExRUbuMcsxk/fVXesCtvoI2VBHXxrU8QLASEJQNBELZqqkH28QHEgoK4YqNS3yAK2S7LBIXi6kJgCrVz81nEgSBSkcDQyeXCYHwMN0zYRZkrve2bCP2bleLiUJNjIHpSHeVjooKX29CmQApYmXguyjBkGGgu4t7NGnFOPqMciRQSIoTzZdanakUxvY7VAIgvHIqHXbraANWVqyUO1DFUg1ZVUnDcyS52Bp7jIHAi19nOMQ1gjdqddDgzGh5C3zaR2BQVniUOs1Awo1J6iUzhfR2Jrbqr1s0RBQO+Nu3Nqs5k+rb3JlRe9ySWp0rHoUzgNwSkN5UvHuPZWV5LyNpFe5ljcYxy6C+kae669UpVTwyIO4Nl8q/2VbTgaEh2Ff80D58T8A5jjBWXypGmglYJ8GyPTLNa307fm/g5krlYbU4oUaFLHuBrNqiGuEDdhHTjM7JuMf5TT00JsI95bLKxXxy0FtKFEhYVwIplOjr2YEbEFwrkKK/H+iIfuadd4lz+0F17aNzFYDR54woj5RT4k74lHy0YgBxt3kz9R4zH8aK5uGR7asxzWCgeRvKyCQhxFmEEgFQ6ZQ8OfkSmeHgKKosBql9OvB7Kjjz3i42xY6zYbLkPCGIOKnyTqlNgIqwTTnJQFc8Sxby3vcuGALswUGdbR9VO4zfbPnfAggDuMI+3EJ4dTVxOFBgT15JvM7Wrlb0oilWedAgdHwMER8FM6AvayR3+cV2RwA3zkboAtR9fVS9DBgqypd5NH20c6k4MD4R/JgTBCQmAWHsN/PfvPP/82OfjXZ89+fD7555/+/Ow/p+ovfzr414Pf7D/+fHDw7NmP37355vbq/Cdy8NuPYby+0//67dmP+PwnRyAHB//6DxXIfJhkXLMkm0/ZRN+tnAdcy8Mw5NCsdSUZ3KQGN6nBTcp9BoOb1OAmNbhJweNzk9p/ItXBV2rwlRp8pQZfqcFXavCVGnylBl+pR+IrNbglDW5Jg1tSpzs1uCUNbkmDW9LgljS4JQ1uSYNb0uCWNLglDW5JXS8uDG5Jg1vS4JY0uCUNbkmDW9Jjdkt6RInBNIRTray7pgE21vdcxjA2R94UxWJFGflVcw9J2jBt1M0mB9PmXhrhEM/5xJMDTzxOspm6JkZ6HZkaAWo6EzMdaU8jHj7xFFMwAqgHmUk7xlHSUqeZt81HjAbYlG0pzdYRXaabooh8o9OA1a5I9aI+8Goq9FgcYLOOFk+5zWMTNpueslyErrGEmUYbzOaqwRKL8SGMA8LVf++R8Fbjn/oB96SJp/cI+TxrVeOZFp7WH+9zJkrrWYKYB3VoZGMs/6bttvJvUZdxOPYYFo2oV61MdSq6qgHy9s4dV6r/uFpTVBo4Xb9KFHLrqqtFPQhaR5rUFrEzY+9zRLelT+e917E7T9OjlPkkzFKqqmGUS1ndzJIZ2SmafcztrTkHFShUpayswsFTySvtT36SvLLhyJXWPMXM4J6sjKLtTzr9yTavFlihoflpyjNHqmJjc/Mu0B3+jEaV73H2Kdu4vVXFZ7rikf5o72ZaM7LHu2kJ9SGMkzl0JNswHkMZMowj6vMx5EHVEET4qe8rfwhjrnfTmQSlN6XqykYfiTFopIXuYzXTvqb5dGZD5NJxElL/8bMkD/OO9VpEDR8JgbzVGofNI+zhmO6dl8mvwJ4f0z9VTeFPlQ+hnE95MQ8h+4WPD7P/9Gi4IMvKQT+ypJil0b8DSbH0pLhJiplVVQUQF3Fwg0UhVXfEjzo/stUvLECA5jhQC+gIBWwD414a0RCHYtK9b5IA/OX0i+nzJKc2xwH2hLWnreUlfZ3BEeT8HQYCkKte0TDnf+4Apv9szauqvCBgnDYm4xEAw8oax4/hxQhA4HUUIGFUwNmdBcjvUZcVaFuDDquw264373zRZViFdBCxTX3kMiPkbq3HiKp+n1GvpVff7cZDWnczF86nZyb7MCzVnOlEAMhaaex+idFWTufuSy6zz2faHm9eTF9Ms5UaEFvyor5wPJlsvvpiXP5VQkK+zzDnX/3Ds5Ozs+vzm5uDioYBRj5mE3VjCA2zLXC4KY5n4jI0uFHBO1HquY/haIPYUUDmcjJHnHp3WPAjLWZEjH7Yyt+n8vfialzFQaCL1skQugsqrrT5YVR0gHwjt4ZXo6YHnPikWMNiLTvpEJRWDCv3MKMYK++jJL1LTxUlkB7x1kG80O9489n0+fSz9j1t371hbxKoZn2rCEbb/lT0dd6j6ntXuE5fqUjhceflzB68DBvgREAy7SUR+cv0ebGbw272PpiNq7PASMQMT5ZIYP7VLY1oQJfbr6TdoqI1/iAYmmgOfGIftLrGaywY8XiC3fHLl89fvKhoKI38NBZfvfziOa/4bHxYJwsujS9f4Q/ic6d79+aH9xcnb86r7t3XjK7LhlllQawp+57W3k4e8mmI1rhy5L3f+E9JZbVU4XjSbWN5Z1/s85j3fVM/HlX+dHuUSCeqWXmfDMd0lLbUNuzjlFPrtke1i3p5dX5x/urm/enlxe315evX59fvz65n786vq5e58n2ogHYze39+cXZ1Obu4rQYUh+TD8dFRn22rHu7kavb+7fXr6tFsQFrK/BbaPYHLCIfnr25S6czEgokVttuhN07+iyH5l4T/5iVoK8S1DzIJuZCuAX7jBCTVu7k6OT2vRj8VE+tBzC7fzy5ubk/UFt7+cFUDKpF/cCgwixjheGInNG4b4Pzi5NXr8/cnFyevf7idndZcxXHxdakhEDb28qt/eFZ1bqpexJgFhdZm26sa62OUbV884wfjT0YN9GgVok7lIFjGdpwRprwOpGqixVwg/WImcGJIO9QaQNImyn19jkGFMQoKsh639sW5escPQelKMw7mJ6ni6qNYBuwr9bHM6Z112jspkxWRa1VyGpW19BN9QIt9jWKyg7a8ae2giEGlvaWEg7UNlGwwkTW6fGQtaHIefwcq0Pzd6aD//Cg328QiThheEi4YarnizldFM0wNxuXEW6Fmrg93eMpT/h2copp96nKc9mKYb2h4QX3cwxjfyww/KtraKzfL5TRIwtpsev8o99Dx/rnbPdutgXsyVWuTP/U72dBrR3xok12XZUnNpRbdj06r9k6j0uO+T+rUiypleAa1V29Q1MeDhnCPE+Svq2+w7W1bHcNvIwCAJ//f0ZyER3yl/kUW8CNMPsDRinJxxOUX2wF++hcQK2y1BN6KUSp0Qyi0HP/D/9USDQ7yAGPOjgLqoaAD6Lo+tYM4Q+6Kcw+U64bguKpjueWCZE7HGcJrGu5i/5WH3sXyW2NRNN1b4Xe0Dlb12rM1OBmirx04A6DP3PZi0M3g0N+UmwPSb5v2ar5VdLiz4TZLvQHk5bnAQuoKcoETbaZcBUUz6Cnl7mQQrIRwvHn5OFTYpqEMcgmwSBCUb88kQmL11T880wqo99fn37y/uTz97v3Vye23ORgBWWBv6wWFcLSI4RtBo/yPAPhDOdUXgMw6hkJVucs8NpKXmHjy/9kaJmwBR1nktL55KmWs9Jw5NJEzVFra8U8uOudmRb5m4JsVv1Wr12IXMJthwPPKeTQP+t3bV+fvLy7Pzh/GSKUSmMpzfVFnpFJjN2nn66mSi3pTr0yLerNksUj75455M5Rs0y7mEZt/Q+W1+CCKV4NsSICXhcgu/cdDEZqTgFQFkiNfXZHTk6v3b767uDyT10P+4+aHm/cnZ29mF/KH9B8/5bqrFDtXduhz7qEAmRDrPA4PZdqpoV1KVehntN/q9MzODsaPQBOfOclutr/CJD7B5etu2nIhZBULUg00xwQMNrB0lPNQmiyOzghHKgNjLORzpIgNGFeJQ1B5DYyUXoLAsEclL6XyGCQB5hOVvo6LbA7eLNrX528u39YdgXHJZeXhya+PJe/W0t/Hm+rBqc8nqqGjecs+p6XVDDH2sQ+c6vBkFG51fw4ciziSh4H4OJfBHTNADJcgRYxGaGlSmXh3Nh2uktjWyFuRsJQgUGNq+ikiPH5FfMK0IxEKqi2cEuKEUSoa5i3btA/2LeXilp7aaVUPp0XPrNqgbtCc4F1ox+O5blX4XmtYrDwfcloKTP4lrT4sOpL6TC0nZdtRBwagcZwaFu29gbc9asLikukcBCOn+9QHj2pWsR9OddesE16dNqX+tHlW6ZUf1DhvvaE+PobnX3zxRe6rgxKs/XI1zrZxdlIpk1XC5E1BiWbTaPVuZjr1YoWOpmpPE23HE7il4GOB2ZqEGJAAFocqY45OabemfiZDR8wxP5Rbq1L4mFw/XIGpMFcd4WiF15ihYAw4FGwLiGFDNacjkHBm4YJehm+0DG64SK12vk5qMevqKaXl8FbYj2Uyo3RFRrnkJG+5SQeps6SB1QCoo6lJtnIFYHFo8/Iay88hzGMBHgphjkcAa7rRCUtQqHJZKA4FyAJC7GHOEdtOzTZcZRURjeqyaqdy86b+83Pz5wFnbVJ867nZJCOE2fSTEmXq4z4Ty6tbslN6Iaf0/w8A+dhqPqniAwA=",
+	"openebs-operator-2.6.0.yaml":     "H4sIAAAAAAAC/+z9+3vbNpYwjv+uv+J8ld038YwlJ5l2tuudzn4c2221TWy/tpO+s91uCpGQhDFFsARoR93u//59cOOdIEhJidOhn3mmsQkcHNwOzv08gdMYI47hDdoguMHxPfEwnHgeTUI+QhF5h2NGaHgM9y9GdyT0j00j02aNOfIRR8cjgBCt8THQCId4ziZrtEETGuEYcRrrryxCXtZkNJlMRk/gDC9IiOGaBhj4CnFAQUAfGKi+hIYMaAjff8Ugoj478nEU0M0ah5xplE6DhHEcCwAFnOM58qYo4Ssak18lpOndV2xK6NH9iznm6IUz9nESYCYaTQBF5NuYJhE7hh/Hfxj/NAIAiDGjSexh+ceQ+piND0H94yiK6YeNbneP43muoxM0s2wSJFOrL/8tVsP89wh/wJ74Jbc6pV+PFiREAfkVx/JLjKOAeHJVPBrymAZB8QvDCgS+N8Bw6EeU6F88Gi7Ico0ijZcX6/Z/p3PVIKah/HfvqTOOOF4kgcHER3hNQ/lbb5jml18SypGEGpA14TEKl7gCNiCMiyYPiHsr5yFIuIwxY2qT5NmjIUdBRH2UcMo8ZBb6HseceHVfPPFlIbYHM7IMJcRfEsz4jlBknMZoib0AGTwjcWkYxyG/p0GyFp/IuvaL2+LrtiGK2IryKf7AcRyiYKJH1hexDrdiT7VQhT+JC1tBYom52sz8cshTKOib+FcS+fpfkfno4wBzXIc+iohAORSEhFmQ9RLG6dr8yReUjEiSVcIPygjW4aXRSTGswUuTp3psYPyH0rDjP4yhBoondmG6I1iUxj4J8+S1bqECjBhu2rV0t8zqZCuRrY5esbrN8teEiZ2K8ZIwHrfhco8C4iNOwuUDnq8ovVO0LFEd5YlbJ9zSoH4W2ZbappHf2pCG1xqxt9evJayjNeYx8WrHcL3dEfV9wuIkEtjOE3+JeS282uOY4WxIinqlX5HQB77CZS4BHghfyQ/yAb+KyT0J8BKz6egJ3F6eXR7D6Qp7d0AW4OMFSgIOSHdFAaMQYuwz4BTm4vnHMa6+6mJsEi739LizZP537HH9vtdyOQB2EOZ7ib+JaYCv8UIArnIqbRDNPlumKrcmvygoithRyqqdpY9/zVrI4VBEBEeBG/gzgADNcSBXprEfQEZIjjy6jmiIQz5xaX1v8H45/fP0+YhF2BNDMRxgj9NYDbsWx/B1Dg8LJt1wMYzOMbwQowrCgZcbNQjfRPgYrrG6GPJPgkUi4fKtvMrHECZBMALgeB0F4g8K2dw6AxTXrwXzrutoX0sAALOe8t+FA33RcpYBBE+ISIjjHPITO/oAZI2W+CoJgisaEG9zDLPFBeVXMWZYX6Jcu3T8o3UG7DjDXv1ENOY5FBQaKXJXNObH8OWfv/xzrgUO74sdnsDl1fnF+aub97PL99+/fXX+/vTy4pvZt4BDNA8wkxMS982sEnAqxgixx8U/v/+KleDNEcM+0BD4ijBQr8MUbsUv4n/LkMbYB7IAvI74ZlrqbdqxJBLTwz4saFxEAsegNxSeT7+cvgQaPqDYL+FhNqR+eqVR4R4FCT6G8dGKrvFRMk9CnhxN75I5PlIzGFtW7aub929Obm7Pr3e1aMj3Y8zYI1m1dHpNi7biPDo+OnrxLy+nL7+avnj51fRPx189/+p505pdnLw5v7k6OT2HKKb3xMdMvpEpkQW6UOuQSWiAGKCwBA+H9ySmofx+j2Ii1r50G4qzSQcutNIT+Sam6+PSB4AFwYGv36nab1eIr45T2jZNJ9Ew95vz63ez0/P3J6enl28vbosrYE6L4QHMOkTUB8S2nX1p6J2vgSCp0yotbViINyd/O3l/dXkmN6V6EHY898Jo+939EqqzRf4ynV6fn9yevz87/+bk7evb9ze3l9cn36Y0lzBYoIBJXi+EywiH569uDGdYgpsXUwGFvvlDRGkADyQIIKQc5hjUK+1PrevThlrdkh3DmMcJtlDG2cXN7cnr1ynMUwH0/c3VyfXN+fury8vX4GNPbvvDCgv+1swVpBQGLEIxwyCnxFY0CXyYl9fXiB/YlzQCIhTLi6MfUCAh4ygIFGtY3Rs1BUCWkeViznFupEMgCxjLrRoD4cXlTlvVEmm5csDRHWaAFwvxINAw2AiAvfbiiST7WD4rYiptu9y6JQ07rSbbvtWn12e1txMIg4RhHzjVr+SRT5j8Irrkd6k0yCKma6WDPbma6adsCq826Y4JknF6fcbSjdLAsF+CZFmO0+uzUmPnQ/7q5Ob8/dnsOj/D9BDIhx18EkumfSOfZXMyaQgryjhEiK/KZ+UHIeylFMBDobzeGISqi4izviABZlM402sghxGA0kU4ukfxUSastKyDmUTjIuTBWRZDHafbk+tvz28FPIn6HKcLI94IstjIPROTFyiXoHGadhCrxdA9CZeyA1shMXXB4YqjNcf8AeNQfSI+Bg/F5anShb7QSk0mXpPqtcTpUZIrKIcmrLCCR4ogtDJL5fk38plV2K2rKm6nuamPaWklkRQLqyjc+cU7IEzpLwxuQkchFLYSYEC9unsuH3xsKK+PpS7/I2xWaVl3s2P/MXt3oiDvZKfMlP+D3CO4UQ/9lVj2gCIf+zDfGFrRZ70++TtVtzmFFXTZFMtuvL48PXl99e79d5c3t1cnt9/tdlOMqsD0KrJmO9mhI3Fngke5T3Vr63SH5JTGbfyKPAanlxe315evX59fv5+9Ofn2vIFHMZD/Tu6RUpa4gb8+v3o9Oz3ZK+xGkesYxn9qBVV4VlzQlJR5Qhhfckdsc8TQfQBB+rvDf/Ptm9uOg0zWy3W3mby7fP32zXnXsRSb0GU0M87lxUwM6zLUeoI/SDVN3H3tzv/f1eX17fmeBvru/PWVI+yAhMmHScJJwOqAF+jv+cXJq9fn708uTl7/7XZ2egNkkacxwHDoM0AhDTdrmjBIGFqW5TxlahddvqV0GWA4CVGw4cRjbZMqj+4syzbLC+fX72//dtWyRKnaeDxqWJcUpfdXs4tv388ubs+v3528tj1Nvja0wTMSwoomMTsoQBcPU4RjQn3iQSTYO71wDIe8bvWmhe5GniAMXn6xKn57Q0KyTtbi24vVFP5GE4moR0NhrFfaGk7hAeO7YCMePIa5sBPCiz9/tRrZX5GGlRjVPiMvv1jllzQg9zjEjF3FdI6Lmhrh+VH8C4BH12sU+uU/A0zgKGGxepeO5iQ8EvpTjwc1DbUmtfBFWrdRcIYDtLnBHg19dgx/el5oo3Ym/frn/NcYI598tvMoW9vKPlGtVraJ1hy6WNucjUETdu+lprOcucScQBQRPa+obC2JYsqpR4NjuD290n/jKF5iXjCsFO1xDTYghqUJ/mQhXSA2x3BBQ7yNgdJQGKk2Fb27WCnrO1tX1aHLdvbKpgF6YPXJLJfNc+i1tvDJbJg2jHobMg3Qu68KgCtGzRYLZdXWpgFDDuinMbfVIbJvk9tzaW4T/2+1uDXYdrdfut2Yd3e4cjsz8RrgF5dn5/uxGkl7WUh9XLGSPUK7pTSgGQti1WRXfPLEsTEUSU7lsARVujLnd1tbdxY0FvssQWqNrSLVR8r1CoyrZ40lJ6QCpKRCwkmtlrkYp+rxVIsTUHqXRLs5p3+aXJ++cDurlfVsOq6lieh/ibmUerw+Pzk7v35//vr89HZ2eaHFnjOL4SXAyMcxyBdaWMdyRpUS7FJLAVPB8qf102xApmmSLSYWpQs8uT397r28jifffDO7mN3+zTI3I3uI+wVIM15VfZ44aEKlY7iGnMtBzrpCGPys5/vzVGrspJukQp8Bisu3Jm0tLbM/a6T8n6eOas/qVJtWTg9UXryrmHqYMQk/O+uS/RKrQhhI127sm0V48SV4KxQjj+OKpl/fKiDKIBAtYxwBTXiU8NKE4IbCIgkCOayHwqc8vWvSoCX9IVX/Z3/VQ7KD6QnEeJkEKAb8QQzGqtYBSTEk/pri4JCTGBspSE10hVgmL5PK4YST0FvRGH7+75/hOAWGwg0wHitLCOLAOIo5U6j+XGSFfi5v8/QPPzcDEtiUAcCCigAOpRD+FccUxLZQMZEVivci1LJVzR8nXs0fOWYcflbbM/Fg/N8lRnD6h/HP8LVkq3ctLvaQfYzLe0scjU0CqgPhxKu3dtyNNFQ/TG8MO0lG20hATXhvsbafThpK8clCgZqlnJrGFQnHVYCqSEKPhC3c4evi/Lbs6WXZwbvS51UpnZKfRx0flXL/z+tVKWG/w1dlf2KLPigGdciud53okjVrEV52KbjsVmjpILB0E1YqZNWiZaqhqzbV0edPWHcvbZcPbv4U1B1dL6Chi9C9W5F71wJ3J3G7q7C9P1HbVdDuIGZbhOzhHd/ZO14VD7u945+ldFjCfsfSYUYSxG4KNc7EJ+xuskYhWuIYYiykE99ooEdPYMbLhnMVgUx+VX6HorskJXOsPOYXJJDnuNZueSrhvkGRRQQN/fVEjb+l1TIHyIz0BBIf308kukCYoRZAY4hiskbxRk0FHlbEW2Xu8YZeAKcQJ6EALaGpyapBmBZ4AqPAZ0AXZjlgoi8xiQU5WMOCAgm9IPGxBCRWDn+Qv6uDBQxHKJaboU44G0F1w6ZqpGP4bQTKvDrHenBzLidwhzfHuXmXxEvxAYofZBqE47ynmgbCMBL3jtcCMh/rgUlX9xK0NYobQIkvLUgVlr40WcrUKukVnai2pUEok4c3XfZSo+oigGkq7B2HR5h7RyvKODs8mlPKx0UU7nHo07h+ZPXNZUB9RI5hPK5B4vT15duzV3+7PdROkCUUhONjPQLiy/bDH/n4/iigNDqU/1r4z9U/WKz/EaO1+oe/nqh/rH39Za7/8as/blNbyRwcN5jbSUZ3PZXq1EZB9qODMoAdRleB/Dd1eqW8kX1L5VKGkNOCdFMcPSkHllQfHsPOCuJKQ0BBAMKIGIeYYybbs1GOR97QBB4kbQ7IHQZOFU+VuktJb11G12oodggMbdKRM0iaabjHxrsYEOeCivmHcghPDSFWDviKMqwtH6EPSUrNnsg/3ugDYLifJ+lXPF1OUxjZSBITxfJMIJdm40h8WIo4/PHXJm3KRPxtnEIUK+PxQAMV3+Av4v/lJv21G7A87tl+NcA4hlogXRWDgmpeYP5A47sCwXkiv8DVrMD0ezKZgyBPiXxRyc3pzSxNCvGw0hEVF2dvUjAmpkk0yJh981kMcjU7Kwxt01VWTmuzRF1pevxi+rIgTqN4WYkun9x//UWNA/sCI57EeLJEvCbSSx1n/ABv387OAAVLGhO+WpcjEieTPBj29fjbq9tXiGFfdBuPGsaE8piSQ5dDLq+vTvMLrEx38q+V8Pwnqh8DxOFfX7z4UjqEwTwlBtrFwQxKGHhJHOOQC1/7EE6CaIXUw1QAWjOrk6vZTVWodNVdMOwlMeGbUxpy/IEX9ycyCUb847LzvrKmvxGnntUrP1JGNv+zFh2U6kJyEEdNfF2pH0vmqpdje+H8eBkGm+PmIEbB/Vmwi5PwqNLE9I1i6q3TbCWW6YmG3VHz8X0b9CbM5ojhSqhJsWs+aCL/quXBqICkDoAqIVI1flcplwIk1GLGxdkbICyLsExfwwgxff9EkzOTjawEETH30O1P6PQiJiMJvGigkU6RhYQJKd6nD1LtJAmMVo+Iqafk+dF4Ez0pOpCnsagPUuGSi7mTMaUy55AOdaoNHX8CRGn+UjXKIch49QIU5wh0HXn3zex1NfyuU/TdDfkVP5tvOGYHpVhCgZFtRnWo3Mz+s8m9/8Xzf/nTv3zx4quXX1T87W5yCx0m6zmOBSqFhSng0YqGLWzo+X6c4KWGp+bv49Bfj3dmpFHvUQ0LU3kePKOIKSJr0cRY3w3JUokbU0TT8ogoEebM3JtRtt2SuIJ8NOAZp4A8qU5Vf5anji7k2yP++kL8IkY/ABKqoNoKqZA+SeLBUTAmMqWk6Kd0V4Kbj1DMVRq9kcsrZ51u5bVrmqrloXsiD7t433KkhehFwD4wqmSXB6zEF71GmmLqMF/ZpAAUxdj4oWIfEBfiFicmL0glkNs6TdcNtbzJVvi2B7o01mV8mrmetz7gzqOq3rvwqhEXqb9DTal3q85zv+4zlRG64PPRnGVqsOy2bvDJXGMy5r6CkEXeNG0rAqerDLTDSCkhsdX8+YivoyOD54T5dxMx5qb99fvC9vi9KD6NC0SCJMa3qxizFQ38Y3hh48fNov+gnEM/FXds0PgkyZQak2ThwrUQr0M9a/UIUmDlTNPXJyKut2kVj2FcvTT1QQqnr89PLt5evf+Py1c7D97dc2YfITOZyfVK69Mtq49r5iqxiO+v3r5+/f7m/PT6/Pamde5SaBRISzIGURIEoFN/1/udewFGYRKJwwoBSkKhzIX5prAi7VOtINo040dn+m82/HtrHwiDv0DmAPCxRR06fhxezmn+5ol7MloURceQddR5mtv4sdYODUzZTuItWzm7xjl1Y70sS9NreT4d79WMT4X1Kp+h3o5ztRYBFDAa0CWnjPs4jqsGg5elP7386/958Rl442WInJy9md3cCP+qH85ffXd5+b3tyW66uGNH0N+czF6/vRa5+F7PTv/WMMg3iAS/W1eu7n5YpbVu9sOqd8Mqd3d2w9qB29mj8OQqLcDjivOReSyi++1yHdQDcYpHcei6G3VF00BbYPnRlBjNuG+1xvCYciBMatLC9UyGkIeq5z4kQhgSIQyJENZDAvfm2T8y7dWjzK+217R2v5/Ij4+h6koVXSscRDiu6LnkwzeJ7tN0q1AfCtZZ7fWYlV6fdbxLiW3pHO9S7v95xbuUsN95vMsnqfVpEbxURtTPo87nUNrzk5f2/Pzrcn6WhS13WNdyKGv52Za1TKJljHzMEbtTkyFLhbn6S2+68VjKZW5d9tL9nXskJS9LWLnXvHRiJLI6MjSG9LAAXaSZAvbKVKQjNnIVBo1p16LI5n2wHNKfdjmcqphSGS+7Bfsa2XlIcSj2f3+yLX1UFyhDq8MN2t2PhAXb/9hhnYqSMJNLvXqwQeugN6yeeO1svUY7hge7+bHCkusv6vHo8ygdn3rC6oPX7n5KxroqP5wZ704lF2yYmbOUCy5QChSGlKs353ikRHMjdE2WOJRa53lCAh/HBQPP/fPpF9IaIokaoeEtWWPG0TpKXUZ0uIC45RGlgaeWnk3LjG5qjluqu1/5rkBp/PTcShs6UkoIxr+v/fyaMEXToiCJUVCDlfzKVjTmF9lYABPwWKSUDyKoSCh8qp1HAMyjkVDBG5Loj8DYBTSsiTDeyPVHwVVMQo7jU/FchAXblo+ZFxPJzB3DbSFIZYVRwFcb8MzcZiHjKPRwXu/1d0ZDpSueqijXqe5W11ht0HfNDZQFUuCaj1S1YpnpYPyumOa6NmN7ZW/UA2MfMxJ3x1Z3a8b0rLmBE5YnSyzQqz3pFaRS60jlOlbwOlniCip+FvGgGt0brRnzVniNjgvG3pOr2bs/3ZQ+QBH7Mtr6q8zsUv2oBPaUmcqrQaNYMB2c4JJjU44OFv5ewuOpQFa1A6kI0NpYfTmxr2eYWoJirDXHVYdYEI1QCFSyT1PJM+GYmdQups5KjD26DMmvKew0mEYY71nZnV2SghAFSiV+KGOI1mgDMRajQBLm4MkmbApvaIyBhAt6DMK+yo6PjpaEmxdAaFcTkU75SBJzMk84jYU+8h4HR4wsJyj2VoRjT0Q9H6GITCTqoXwFpmv/ScrUPi3hqk6LUhsXPkmqbN0HQZiBMEC6u5pLttym0OH1+c1tehDklpT3QK5+1pFlGyGWjYQLHMt+yn1Zqd6VglB79RJciQRmyXxNOEszqQGnUziVj6M0B0hVgT+FWQinaI2DU8Tw3rdBrDabiKV134iyV0ixg1q7wqe8f4bTTRYhlCbrk+gsJVRU26wENpOWSh+a7rj4QcmH61TGqnwt4XqSawxIB88Kn5aE43TwnHA9l5GgNVABstJm0i5mimzmfFKmNf0E6yOMcTWx6O1TVTyMOIf136DAQtjhyNbh5nLR/Blg0vASNbesOXH5nwhxQcyO4b+f/dcff5sc/PuzZz8+n/zrT3989l9T+Y8/HPz7wW/mlz8eHDx79uP3b769vTr/iRz89mOYrO/Ub789+xGf/+QI5ODg3/+pEaUPkyz5y4SEfELjiZpF4x5ViddruSu5N0wlefwgy22htXElqJy0pm1URrVaApJhK2iITz0myIeHI86OCirPI5WvYaIHnaSDTtIjevS0AYFGapBdVEUHh4P4uA7itd6X8lEkYf1RbABaJYVTmC0ghU4Y0DXhxusJZVTvsAEi4cZpggGnoC8MWSj9JmHCTB4Qj4h0LLm8BJSvcPxAWBOinAIKgayjAK8NTzZRfJzmhh7tJbJ+Fu9K+3MmnlNWeWtlX/kv4fpBE2byP0l/BVJ/obP0g7UZFswm4nXDNa7gVcsGCNSAhhKhFB89dsMq2jFqfzBBCC/oGhFfq2wbWpWmcFboZCYSI+IrNQQUjkkjTJDTFl2XwgNCLsC0sbVlfWtQTNEzt4/piHyVGFLIIjmELVAzJAV6loaRAy0XP/OAendnKjOAvaXDnG0spxGeX2UDFhajFSzkl0uiXchoIIAxTnjCMSus5rQVsutaVVZMuj07dCmtyasiBHNihbiuI2ydYEJhFaZOfZxe3OzHQxHyRBXF7pM81V3N7AwoPUOFu+M89QxhJp8ftmEcr2GJQxxXErtYrs0accmW/PmLDkvVzsbkkHxNwrseS3WmepqV8vE9BOJ3cdULB333W2x4BhesJ+WjP3JDxcKblpuiOEab0bboFhBlo7ZBW/Brx6wuWeK2fLbjPhbO0UUOD3OYVCiJTguTTw7PqWVsFU0DSE4tYwdStS4Jl1O41puh3JpHWyyyAK5yQDs++Vdph+zS6LL+Ak/14KtJoygKiP0U0PRBNQzPdLTdW9Gm6diNzqPlSimNSE/tRwc9SJ+H1K4b6X9pnMXUqhjqTumdRddHLcRuLc5up2FpBVo9959c19LrZWvTvwyH/XM+7E5anFaYdQrHVn1OK9SyvqdVs9MKsUXzY9XxtAL/xDqgHpfbsaHARseVdGAGnp5m3bJYpzwwuIwUubBzw5PCptPFAq4oY0QAk0vO4BiCXw/Fl6cO020lAwUlzq3o4z7ps3Jfw98pgV5gMN0FjlFMqEztHCDG2sX4IvNZ7ituUHodDMOp2MrWO5XyZbMFBHjBVQTroSmHWzMW0yP4bRL/sxuMa2150ybgBzBre5fnlK8ka6rxVGWpFByQ/qDOGHZgLB13Ne7Bc++V4U6pzsBiD1zHwGIPLPZw2AcWG2BgsXfNYsc0Te0ql7wLn319WeyrWE79l2cRjj2xzksss5AcjFrf3ExfKrOLy6JDa6FUlIesNNizf7ZDTIm5gmjKBEnvRlmSSNUDkB+lfpdhbtftqpy+AqspXFzenh/Di1rMxBgKGLx4/lwOY4Wqg+IBipgWlM5ZjRcgSh9tBTnHBoOvvvznKbycVpGUJzod5Dn85WsrxEr/v3wtZjd9ultOtZ328xXx7lIP6A7H9bbQsVD4h3h3BRV9yy000mNZQszX5LNNck5pgJHtUHAamCi4LjPMeh0WRKvDTE8fUf8py8Ofjra2XFccyyPqSxEuh5EUbnQxMCnhqS8OpjkUboAj5blaSifBYxIFGP5yhzeHynMYLxbY439tBarKoyjmTyfWSPMy/8X8669tgmIX+7fC7NjJHppbznPZDUQgnAxaV/OWy6EgttEs8yPnOYVzIX3CGqOQqT8JzrUAkE3hhzaSZX5yJ0wzwEYzgmIMF1Q4yPtJgA/hKsYLHGd/cQKPQh8u6PkH7CXcyU7fiRkURR27bsf3OLXLqzW7wxt1LuWf0uPuNr1U4WHflzu8YdN2DYOhjxjuFJZa05Ae7HXCOMwxnH8gjLN/c9GyqB+Pruc6lF6jyGkOS7PloS9/lejuerPMJDrvWBpwmDnLAxI4PmWqPK8ggisSuS2usnhqbvCdiNdPMVNnXq2tXIvzXxIUTJ3gnuVeEdXNABLb+EtC7lGAQ/mgP5DA91Ds7LxhQipMiRMkqbOHwpQEZyfN7TRIpytZ4cWTyX8EXVrSeLPzPc+uk8lD03Xzb8sQ8qdAvokyzY3rtGVtl+JFh2eq5pi5W3ShyagTzJS4FQQVGbchXANyOfoOOryYhgzIPc3n8pJSFmGqMBbmh7pemrzSjudf4ZCBF0cM3+MYnvlUwhW+I/xgCv+JY+pM5EO8RJzcp3p1w5Zy5RUJiMFzeCZBO8Ek6zX2CeI42BwY7aLyfpqOdu/y1E1tIafY+SS/E72KT48E1OfdSVkoKuUXASF9IwjTlOcwo3ROQHO1vfWzkx7ov4u7gdJkYer275haOMvFnUWSNteqh5hwfCoY2z52mx+qvc0uS8DgiW/SiOOwAjsw8bh4ik2qpqptvJiyFezswvxDTdem9Wvzah08k91mPngmV9dk8EwePJMHz+Q+z+en8Ex25AHaJtCGeumZZI3N8n7Qox6zsiHq5LBROrtNHhQN3hqoQRudVzqyNAmt+AOTgVO6SGZ+HEA8bVULMxCR46kMU5cz1unSxM7h1Ho1OoZUN65HrY+D4cIzUIiXXLVDWn+cCzpela1d8D0ch8ApnN5czXKAhmjtz8EePERr96HrQ7T2EK09RGvvL1q7xSTpaog0r2e9MbIW79ki36T6NGp9U7/nzzXcu6tZU1CQpl3oaMysMVY2QHY2YbaLx23mys5GyoIBshEsuJgmm82OFrhtBklXY6MDMbcaFnuZE52C8dyMiFUDoQWo1XTYYha0gO1kMHRY8HbjYC+ToDb3tU3E0RBYY+SzQG4z/zWa9iwwG4x+PQx6DnvSwXjXx2RnzHFWTyEnQ12tEc4K1m6eaza9WYA2GOUcDG4WoHlT3A7NbH2Ma266OheWusV81sdoZp7hFurqYiqrmMEsMHsbyFpvXwuf1yryN+uTGiGrjKDdkujJLuXrrfJDArpHRCIJdM5wfN/AH0rSdXpzdfqUgZfEMQ5lNaDqA27jeASZIW6c7Wl+DJWa9Op0G76yvCinBpeSvJQOiBqOhUAE5CPg4VicdZDpHvvyfwFi/DZGISMmk6rjnXuNGM8cJdKlBZ4Cw77KSklDrI+N9eIBCuVNaScuPuJ4Yn0UHN4tMXFV97vDpG/lwc0mTlhu5g+IpckzP84c1pgxUcrTDfkTWCVrFErvZnnjdHfDzwvhw9jp0JwmvO3NTXd6KwYixojR0HEOtyusO6TW1HQDnjK9N7vBq57SNeClaRxdFFE6lIefLuA2FgzcNyhg+BDehnchfdgOO251Pyiu2SZKSViG2hajt9tDrNddKco+8oMGUE5i7WARKHZIjb1pOm2hd3jGDnRxuubMb3OczxU+HTWTBRLyP73sP/MmlqqcGL117uVE6S1zRzE2Y3yC2dUlU2+dYV1y9ZSFrMzUlFis3V4txlv5kr0vQ+PV0dm/zxRlt3Ju7wpNi9VkzcsgyK4u8NQpszGnb1Wv1p2ZLeAka56LshGroqJ58kY00UAjVH/7xNhrxImHgmAjyzrRhMtwgzUKExQA47jWaGuP4tD0xJWMmLOlt0MnnMLwgJTAr6cA6lWrnYguVwFRgMIUUFeLaPOzVvegiUyPGWOqXrhYyOseCQjSDB9ZLrGY4UNTOIE6P2oZhHxqbopZCxRjJRbXeya0cbEa3LGLncIw9qXdoIv0PI16voo+jnDoC/lGn1zfCaGzSrfcoptL97DCgjW2qtu8FQn8GIfaJU3PRq6tuR7AaboP6cxji3ahPY7JjY2uSA5Zl5TmGlFCHzTBTQvgYHjqBtCuDLWTL0jrJrdw3IV5vjHsNQPUyHovgNE1BhzHtNm4SD0vidmoN7tmY7BLuR5EU7MlyOOCPJb4bYmqeuT6YiQ7OyEkyFB6RFLZuEiB+mFhYTUbPtWzvRNppRhZewvFBvYLJ09Hfxb/lswzfxX4n/8dZbQaecJEiP2LcnGg8bhQ60f+mtNzwI8/jdRg2H9navSIP+64tFhaXwx29dMCsOKc2lLmqhVgfwyfPBlqXlEaEMNF77boldnfpqpX5ntz2StSqErUUPeKNNW9Itn4eyl89R1lXE5f5xBPB4YUb2Daduk3VGkSKYtWlPGSZ6eueVX9UEsaawpHZU4bmlyBnLfkofNufmlR9TnGIUSrDVPMdrGIUSBq7PPGSahSU8bXeJqwQks1l5MaGMav8BhebDXFhMmXeRFjM81MN52brBv2AkoF+2+Kf3RCkXIUVJFU+JGwA0ZcQKqgZFy9O6I1EwyrsMyz7BAUczlYkRJNL8NgU0HnuvqhngWdlPmW1LYQ0qnkEFT9dCO3O+9gTpdwrWHY6rLVtHEqeFZBWGtP+iOuATQi/V3j964IS25d6xQVW6ZogwVFQaCyHMZTW1SRwrb0x/53vHRSjeSpVsvMoP1UrBCrInlT1nK6IFQudVd43yrjP95adwbvQrE7+XhB4fUaCt0Nhe6GQnePrdBduaINWUgLoNKEpfELef639h5Di3auvVzNFoVqjEaiBiq0lKhxtdp3C/4UCDUpI2yxLu1WerdQz26Brc7hnWa6FsCww8DOHuGvLsGcHcM4HYMc3QI4neP6XIM2u4drOmIK/QI1u4RougdnOoZl7iUg0zmhbueoxpbwy52UXGm30LeGWW4RimhUEe1WZt2wfP+yIIl8dTUrDXWq+Wbd1LYiNe6BRdZhtitJowvP1A67ZTGa1hp+zQVotiw905TazKXoTNtr4VJoZsuyus23dKviMk4Gq7bpg1Oe6+5Jf53S/XZL9Nshxe8jTe67RVrfbYI6XfK9P4681U5vm0uu6uHAPq4Du6PgT9hLACjsLwgUHnkgqOOla23iUKClb2mWfHLdUeMK9yrK0u6y41qIZVclWFoxci67skXBFXv+qZ6lVmQxlUagnYuslMuoNELuVV5lN75AcSe+siNTaQ1I7FJAZWAjBzZyYCOHAzuwkQMb+anZSNciJNuUH1HvVLOzcJfCI6XiIo2BYm4lR2rKijRAdCs20lBQpCn5qr3MiEMpEVugdqHASOciIh34MRtNdSsZ0rdYSHsxyQ5lQtod6x1Kg+yvKEiLJXFfhUD6lABRWXNaQlX7Ff9ws0S6FPzom0XHChTci3zUZNJpAe1Y3qNL6Q5HNqe1XMe+MuvAvrLrwBYZdlrA9irL4bgRbqU4+mbcaduIjuU30qw7rRvsWngjl3mnBaZTyY1y9p0WmG7FNhx3smOBjZ55etpWvkNRjVyunrZ75VxOI5evpwWmWyGNmpw97V4SjSU0GvP2tMCsz+rTuzCGuyOHq4jrUAZjT7l8YE/5fGCbnD7Ot9ZB3urANje7inQoa9G3oMU2KnG7J4tbgQrrUroWpdhDOYrBC3HwQhy8EAEGL8Qtt+wf1gtxi4RAzci1lnOwFnLYJnOeCigypyYy9m7VE7NG3/zGsgedsuNZbn39TS9q6b3sz7l3KYsI6uG8J6Ioj0eNuiKbJcbVBuNkfSk6CDYGY5rnFdCC4xjwBy9I/GaoWVyHZP7luxShmDSmTX505p9ehh8ZiProdvUmWee2ND3LJFQivCDiYqPEJi1tRV5+H3skjASP+OKZCHO1J8/E7SGcpdfpwOSGb365aP6iCtXghoTLvH/SIWDuTaeHv+tt/nXhZlv4z29uDNE/4SroDbOMO0f+vXiFfGmajNd1MZbZj8wsqV3BSy/GNs4pdCkOxFtmZ4JQu/m+i/He2XRfzH+U4Zpm2kmPtnpMjDl5rOcVbMZ2jp2GLFkbxyHt4pVqhI0TiM4zNVVpTBVkK1j9sklVmkJUq+fSPJfpfZGDeTQimOU2zK5GWZJ7cesQ/JKgkCsHLspwbCJrs1WR9zwLGfAsVSTMD8N4Ct/RB6Fxk2o8n2IGJBRvsnmz88uWRlrbhMLHdut733wXmWGSv1X9TP5t2ptSmoz8p3K+ivy3pAmhiSBpXTVAW6aFnsl0KIaGbZcj+mqW5oWWCrWm3NCyKaAm0WzIDT3khh5yQw+5oR3W7HeTG9oakFrMfeOa/di0L5Fh+TKBZ3gTDbwW53ci+ReGFJBWEliVIm1ZgltyIYtMNa0TfKq8dkRb855oNbzO5iH9EA9hRZariSrFyZL1GsV108wl0TFPlJqmCtp82jUQtyYFU5dszm67pnamBixku1VKwbTHXTMJsVrnaRJkAVEuidrpUP2tMZWrzdvMpgdtzillWtjTZZlWNfm+YD8pq7U8pXMLGkDTIVP1kKl6yFSdDTNkqh4yVQ+ZqodM1f9wmaolJNjyxwGS5KcVj6lzodSmp94HTv/Aaajv5YKrsKcdpqHO72NdGur89/o01AXEmtNQ33t1WajznfeVhbqUJlUNmRpo3DIMV+OPdpljWKNUiMipqBI+WuZWteVa5PmcUrfmz2pN6laz8eq7ofEmp1YOMgBi4Mmrnzab1gxBGCATiysfZrUa4bIw4KjIBQRisBQsg4DcYfBxFNCNiI08BHbvSfvskFJ2SCk7pJT9KCllo2QeELaybt6VapN5Bog5p/eZUx0QKO6wvCL69tdFA6lkdlMgUzyVSfJmfvnGtwncoex17JKBb3aW4awnase9Xn2Tzcfg303nsWU+X0O3UDWlbxN9V3LCqM4/JtXsFbumd35HroXuORiszhtubhsODhuPzMbfw7pf76xZitpKJWa9m0aLn4Q+jqU3VL0CK3cUpp0N7SzHOS3aje15RmsBK6QwzzkZKVOkTg96mkE/DRBZ1+JPmOFlJNXvlVGykaGoncXJ1Syvu1P64AUWqr3eWaJklJtkM50SKMwWashYvp0UEEQEe7jApch8/TIpwqLR788kHFA9DtXjqdDM8TaCigISzzbx4T9uLi+Ovm0K9pTzkBoDxpTORLN3ibcCZcER5EmqVqZrFJIFZnyapc788eVPTWv4DRUOuEiZsYha95QjkMuv9PFyOVKIurJN0wpARH097Qc5XY7uMFA93QRLRvUYxrLeRYbm/wgG/n+bPLieqeM7Fo3GCrmUEcyHgqTwdHizUWZbYn2w4BIOgMZAFhDSHIhQx5ukceEVpH98+VMjxsX1AhL6+AO8VHYy6XzmHwgnMwF/E3L0QV67FWWNHpkyEwensEL3WKkZH3AQpOlPHtBGKdPUximnMRFKaz2thv2+vTy7PFaYiQO1DE1E54KESHnJFWJHGyCyRMIDTsFboXCZunwvEsGYTXtnoavjiS28cZmIfELu0nmKoWsmu6cXuRNvnaIt8Q29x/E9wQ9HDzS+I+FyIo7pRJ0HdiSQYUdP5H+2mpFUv7hPSzb/GHMT47Cjp9vmtevyyj290RxnubeMqJdB01pq1rS3ASgRwpmvSDYKN3s/3GJZpZ3N20y0SnKCQl/8mxHGxd97r2NCHK/129nZxznyCel9h10Zuxu5+V14O9WjGOupjpDmM8Xd+f9YiKKbFeVhU9yaSKGBdA6NKajXxyQlWpAgwDrfE1nomH4EXiClG62em3b2U6EB8TYO+dBFs2x+alKpiJYKYQqeVHeieIkbcryHfloirAfvGnVKjqQHOllIlf6m1r8hlQrLmtB6msIgxEqcnmPwCVN3FgsmMKYsV02uWLSx+0zBxObekF/x8ahLRK7okabiVx7QChQw+SWEdRJwkY6oLRb5yxcvYb7hmOUAG46L5aJiJVxNAZplq+zn1vSUHb1U/aT4EnHQPZwDlpZmtALVMTOHwCgQnkuIIPYccQPLXBdpBpehBC3RsyrNWIbuIhUgGRAGX8D3commcBJuIKIPsui+FeZLKbvlVlckRXv5lQYEhCnhw7aGbe5aRQLQFgtSuijOx22HF6zrJaup1GaPpxDJ9Xzj2aeqK3Ea0YAuN63rZ3PLcItEKK1U7wSECoyjh4FsKxibvPKkWIIqpx+0kOXS9mxB0hwScUOdX/SvpezbKFjSmPDVOv0rZhAnYWt6hV9xTK3ppZwion69p8EPNL7DsXumiNml7pHfjTBZzyXBkBkhka8zPmCVPMg+G6Xgh9kl2246rqdOZblYUMesl8VOIJIpFt7HTAtGF3VmtMr4TOS8kk6LqjUQlvffBBpqTl0yZ2oYJ7iARTqdpzvIJViedDnVVGnmI+tRdaScLtdOZdhsT/BRKYRzgRQGlVnkKj5b17i01mqp2fbphdqJLsAknfU2WYraskQobtctx6Vs6kyR5RvXjLoEBiZ4eBfUGXVlAK6onz3+hZydJkm8xlJm7rRATce2pzh0mYRqJ/XF2D9LxCnS2R5JuJyptGrqzypFW8tTVPscmYLmsRLR1HDAafpBTJi1QAXRQVjZNNFniBO2ULnOzE6IhMz6zcspO1sBzzc5leAhzBMOhEuzsbeilGFt3lPj3hMaoLbHBgBUvE8Maxqn3H4OPclL58ASBmvKeCvUdK9S5/dQVWiXvyyltYNxEb8hBn3AZLni7LAVrDS8Cl5dpDTOobXGmMs88GYKLD0crTA10VnLa/vM3DhjTD9MiVL51LUCrttuFcF/cJjWWUByDeYbIFwmkXOAy1cxTZZqJXGgEaeL3OFQQda+SPMBY7W4Y5dzK1cuWRvThFxfIaaJc2+yDObS33o0jjGLhLukA9pRRlZucbz+t3SIZ+wgOxoiuMecDBRjh8XA8kRmJ27a0qfl6W8kD/qQSgNVdtDUevjwg/yK/aviLFuH0fYmlUAU+T72IcLxRB1tCgsS+jVzdACrV9bhprpR39pNdOlS4aZM5vsTAS27JRzH60NAjFGPOOTVMj/pySmcRr1bUycg3ZYAAFQxRFtdRoeFOFFQ4JcExxsQqlJAwDDPB1M4kMTsx1i6PMTUUzV17tx9BQBAnf3zjLh16VtajDIoIAyQdGntBBOALvSyMr07Bfo+1fG42V86gkcxhpOLs3Yq04vmOJyWuml1hAtqcVNIJh2h1gRLL73DzjBlRmXlAIjCLK+pBK7YX9YZpslnLYBq78GOMPodbABwyfntsG13WSZwtX93eNMDJmTZw9NtywpoTXtAdM67WPfjloTbYXloXWruHkChPp13RlD7nR4AAKhN9T0LD+GCcvGf83bht+lHnOszitkF5RLKR99ItSxbb6MCI8lKqITqNm1500/evZbVZUnuBXUWAo3NfqXplZlGVST67gV2jiGk4UTmZt4Zruo4AY0LJyOPdr+jlk41TSetvWGalSAOz4jQAIlwD/CldKQcpEWWeOLBGsdL3Atw5FJnYmfv7A7uUbtCqfnHRelV/zPp9ZpM0iM66jNFq1ptl2sjWcLXsup4t6XpXkBth6ehytWqKUjiCGsUAV3A/6QFY/63Kz+DSCyskjIcK8B5SMbzKzdoR+ACu0rlCRQaJYPAvMynd+UTH4TDnTi2qWMbjO/wZnxYoJ2jznRoPAvHh9prrkTeDWfbEaj0yxhLSOOq2NBXEOh1h3p0ylyuekqnGYCcc4BSAWWfOkw95YBvqvwrPDNaJbQUO8UP/q0DZBFxCTRWb5oUGXUpjLHx+3zKMpzHo729Jb1oRnfaaAzc3+NNz7291euSc6fw6CSgnrRRPDMamYMOc1dpEIpgQk4mKaxUT9MBqFQ7plWyqidIEzx3DXrd/TjUBq8c6tInVzn5oi5nPE5CGQFKQ6OKV+ROEhGjMJRT6ABUrpusH5Xte04Li1TQUriRA3bZMWNYFX3pIidbSq8vX75YekZdaJwqVJVHVjs2myqto73dp66c1CSP5mgPlFgpIXsoSVXHnDJUHYP8XXCcY1ErWlLfHporFEvX8RcTUQ/RCbCrq1JXp6U+WzkpT8upj1ri0Q533JWUNxmUdmDG1DKoobpFliWlkzDfONhVMmOSTi+0Vi53mWlNetuZSo6+S2EoAO0UqIFIBw8TVmJqnjbOoBV4fob5GXgYMaxdz8ws6BqrRKStULVoK6aIza7AMzxdTsFPsI5dor6i7Dra9uDQyXKla4kIGy6N5X/E+vBYEFAVKJPIrFuqfpbLrReISEdEwple0h90yq8Yy600fqKp7dAN1UCpJRYSKCvRFU6VRbbrRUTSnZzjmMl351AZeGVyexyvWaqvUFZ0sh/j3lkWoYp1WV2fwTPBIgTiGFGW1eocATgtVoFPUVrJe2xMrMpRMWVBnrGDg5GzBtiNdbPnpSo+EFaWrcoeOcAsMFBObJEDULmmGTv0l9zb/ddtGKKMEZILm7JC2VHIGKEdW1N7mBH3Z0LsaT7sNmPYymzoaDJMjYDOgMHVXOjkk5D9dNQQdBY592MidDAPanNfJ7A202De1NcJaHfDTl+TYA9zYKspUK5BL7PoDsyAvXWcfc1/7aY/ZcbrCBZazX7KhNfbzNlg8ut8VgFgK3Nf7w3rZ+ZrN/FtZ3asM5kVTHW9LkbFtFc003WG2ViotWKi2x26OfNcZ6AKkt001xmoNOX1M8v1NMn1Put9zU39zHDdTXC9zG9bmA26rEMvk9u25rZeO71HM9veTGx7Nq+5mda6uSU4mNWKhrIOsHdhUut8Lzp26GpG25sJbT/ms32YzjrR+853vxtN62wq26eZzN1E5hZnkiPZbuaxvNHL1bnaahqranZGXUhV1SxWa+zqshA1GiCrocsRdtkctiMjV6fT380i0mX9dm7icAKIkg/XabZu19C0k1ynNBrNhNKkGq/mFIL5n/kmizmfZBFtwIiPwUNxlpiK7aIme0BEjs7j0T6YKYcKkunB6GSG61BNEgAeazFCAOhfkrD2HD59LXezVM1njT6QdbLOlYjUR7M1jDY90pp8dE20U4g2PVqjEC3xRA8+ScFP0hPdmIepM00wOWeHg/37ONjXej/LR5uExaPdArSZJkutTzoKYUDXhMvUl60ScS4noiqeqtK4MOAU9IUkizROFX8QwemEB20vYC7AWdZzfCDKWIxCkBXH1iafdpr6T3IubW/8Z3KFnZoFSf/EGAzrFAMNaTHaLCo6dxiozKr3WFi7rIc655vy5y+2TqOzpiFp0XUXy9ao9oBDUamGAY3BJ0z9Oxc7jz9ENObYfuQFK+KheMtUNioW1MXM2J1I9yk1fZHDpmAR0QfCJEhLWAsHx6lm5LX0AbLGTo6T004m4vSYsFOlHNn2PkQxoTHhm9MAMdaWgaNUE7DUs5BiIS/V8zbtb26mswUEeMGVIH8IIU0xBE8MBIRp0P7WaXLk9TvDUXOi38qk/2/aJaMGEoxKMoY4kJvTm5ktx5z5UaKcYqZ1YoElFRs8u9R1FKX3S22e/M5T1flNxCS+QV4XKnBd7pk3cxk+MSWILRyWBJVRS8MlApfJc0Od/zUjLh+NNsadBbi9Sm/p2zjIawNbO8hrDbgP8togr1V+BnntdyWvcRrgOF/ZzuEo3WZ9DrfN+5Ubf7SVqaiShUdwu4IzzqELhOk6OIon0oO32tZQuAGxN1znkMplNuKx9JD+S2r6PcSLBfb4XyFh7dQnNRFLsdUYXv9i/vXXaUuaM1d6q3Dq6O18LjsBCX3Bn6ZCqVgGBQ84VdhPR67RPspoKDsp5+0cOKYd0LPj5ABWv6uZxRnDBdWREvgQrmQepOwvjp5YF1RFVeCpo03E6a1x8gssbMH3mSegWinjCaj+lB5sl4XKvP+se3GHN6m/lQNY7auoxcn0CBv/JOUA9W/aX5iu5zrFuQNkhR6nOQzNJoe+/FWiussNcndXLOzSZbODYsHh0MkOnflPTGvziWQehXD+S4KCqQPUs9xzqjoZMBUvkwcS+B6KXS6JzIQtSR4wqmVOSXU9FKakNTtZrJ23AABdGYV4ooIoCLqzpPFmp7ucXZsbLCr4dk7xVu5fyZCKY0JdVpAusmrcGVbwTKkuzB2iC0NvU7LkQhlzHE++PAwQFTx2kL1+GX2ZOsB9tTHs2aEuRSArymN+aBL/6YuroDvSETm8OFL4HsfwzKcSqoxmOpjCf+KYyiMf4qUKkHFOcGJ0hFzXzUIMnsMzCRjIeo19gjgONgdGT6ACrdoXwlU/kj+ebjKMnFrHM/lO9Ck+FRJM93ciZW7otNmjNZdkRvu8OEA27qp5Zvzv4owjiLGsGKzv7w5veydW2eat0ALI+tlatqKk89UtyyRF5sZFQbEAYmLZVFNEPiuIV6yDWMiHH+FYlYtJyyRo4b8W9HwDCcPxNIdtxomqQL31OuHCkNGjxoet2CJ0Vje0qhnc1QuOaoVHqE7oqUZoLcRoDqXZsg5Zy43OwLicm3MtS9ooeiOYJsX+ESkYq0BcU/i1EXK57qm3IkGlGLMox8NWlB+qEX09PptumSP/VOhQupRnkB1q17RYtNS+mtKcIV5jXTOoWM5X1mOOgYbZpWceCsT3I58+ND8K2T4cKt9dYYuV/8i+mIyLxFe/21ew+ZbZndYmdbXc85/z69+NPDcPbBl0oqsn1X0wRNG5OK4s5m4vjyublN8EVfmL5ysKHClghvrXV7oqPiKHENEoUdfFmEpUJbGgah4ZquQ+7iq5bqVxexfAFTH8TZkmLCo6exn/UwM1CxTwMUckYLo+rjjTTW8UXRTOdFPVMOuRAwgQ41cxneNbYjPQF+bxGjGupLcHLIaYY99cHjWdZi7WiA6COk8EiNEWfK7A/TZGIZOD9ppAAW3gKTBdZRhoiDWVsrHQFFAoGfuPNPE1ZgwtXWf7XbJG4UQ8YIIvNZ2NclNcisKhs0w0kOuWLtJ0mznEGDEaOk7hWjYGwgDBPCZ4kdXo16MoJUxq3xnZNdoLRIIk3moPZBM37E/1a5Vd99Ld1e+RlDpbyngDAECN1EIYzKUPT4yFu4ivSpCJ452N2pJ7RheM41QYzASQG45ijv2ngLnXf6ns7A3AxJzIxu/qqFifvBFAR4pul3yjFWLttTCvRKsm3kSCALqokv0e9Sqb609VCheVyy+l+JjiRaxQvche/ayxaJHlzXOqR1q37I37pauwnykyZeUX3xWaKpbU13o+Q+YEi5hEyxhVYnFs7yVKOH2rerXuw2wBJ1nz2uqAqmasYlREA41QvTZJjC3YXE8mOhIBQDThkpKtUShYHsZxxEZdXR91lfbW6ZypdkbJprdDOyJieEBKga6nAA0cuOajBH8NUYDCFFDX61AvNkCD6CASCSm8VT+V90WYqElAdEFMU5Fd3Iyw/lDL86OWAYV+eqv0FNL8X6y+5lar6keBOx51eE5Ku5FLZ9O37rGPIxz6gmrok+tWBvms0i236ObSPayw4JAAWd4gqbCIsZwLSmcj19ZcD+A03Yd05rY0TvYbYLiat1JfYGMhKwxk1iVVPhuOUh+0B8QkcDDqiNF2vKGIRBUsnFV11brJLcxj0U9btVWM10rwkVDlIxdKPYXj2OLsQj0viVn/wuY2ZrGBVSxIhPKPRjMsUbUKV60Yyc5OCAkylB4R2a1KgXZe2bvhUz0vNpF+B+U/VaWeSekhHlnHYzi+x37hrAqWVxy9wt+SeeYhC//zv6OMuiNPeBFhX5bgF38AuCOhfwxjFWUdBUmMAv1rKsWxY/jxp5EaDPuaH1B/nEwmoydb/kgA0O/HBiDHJV6ZcuKdAHTEYJufEYqIXthjQBHBHzgOVSk8Xd7//sVIbdVpwjhdG49mmUVQ7tLIaLPlRoch5XlPrEwNN1nicCpURPOEBD6OJXAz9P3z6RfT5yNIS1sLasw4WkfHkliOVHKCYyVsKX7X1Gqfyr9NaYRDPBc4j8QlEMMvY5pEx1D5roAVDmJ50zYjAJA5Ar6v//7aFGsyZ7cGsxEAAFvRmOcOPsAEvPtIfSOhtBlWe4vxmUfFXbwwcez+KGWgNayJXpX7F+YOeiu8RsejnPY7PLmavfvTTelDs0JLTS8TwAEVLXUp6ZFEuFrfMxVGqrU8m3in3CG0SQRPxUxUOx2WX+CbsK+nn1YHTGWnOtIsg+Y1oZvCjaBxMdOGYDHjexxzSdyXIfk1hc2Md4vM41Ym1jK5Z4gC41KCQl+mOY2xGAWSMAfPZIWqccRcEm6un0fX60TkSziSN0kUEacxO/LxPQ6OGFlOUOytCMceT2J8hCIykaiHyo6x9p+k+/B05PgkyQth3QdxJXTavFwBm2y5TYKG6/Ob2/Qg1EX4qNXPOrJsI8SykVCWJJWeHzFdS5g49GUeW/lLbdwLS+ZrVU1We/FyOoXTtEC/5uCmMAsz3dPet0GsNpuIpXXfiDxhdeIWDN1r5mAi7KVXp3yvxeTlrSjb7RriUzjNla4u96le/jbhyd3BoHvJ/kaEAADAoVR/a5F+28wAAOYB9e5uyK9unOYr0zoNGKRLoTJQYFQYGQmz3MYWp7QvX7yE+YZjlgOa5ecRsHMwtZWl2bQCADqXkewlO3np1fJWIrG6D8JpPAcIVojBHOMQHmLCObakumMUCM9loGEqfbWGY5gDKZ+pbKXaiytDx6a313AYEAZfwPdyWaZwEm4gog8qVvelojTZqnEKL15+1QhVAQHClP14OuqfQL7dLFg6+E5HqftlaY9/c7gwihy0XhsAACj68ah00SajS09dQJslvrQqXY2IuvvxyM1BwrlaulWDa6NhrVopujZZ1tyqvK8w/BrgfD9AwVLE2K7W6V+xzBFk9Yn9Fce0xZ/CIpv/ek+DllD8hjD83Go3BOKr5PaWU6mYC5hdsp0L9ekJunK1Bzy9LnaAFQ38wtuU+k9kHkyjRhLChPO01G5nVqfsMfezLFJSMFBDtMJUNoanW9jVy5Ms+yRXZ1oLE1woWtuVUcYae7R7xWRzgdSoFayFnFaw1jTCTNez2WTjeHva7XVmhrs2uqnIqdZDfSubOVPHqqxZHE+KW4rz708pUZfHNVf+3CGGzMAmjUSnDTnVRldF376gSoXkmxQSsbQw66GA0/QDyNxwnFqAgi6ZIYmsqmSxKRY5wVnGT/cqLZn//yHMEw6ESxHRW1HKsMmIIce8J1QK5yDdMO2O6msap1xvDi3FW2YgCetSkZ6YVA04y3G4lJ77utI/XZjy+roEiOBNRUWR1mXVsXsYc5Yvy58rUlPIMfqsxY6U0QnM+GFjqZ7arbOHb2DuTQ8O00BbJOc93wDh0ulSKghimizV6pjaLG3RNdkhUPFTvizHMlaLOTaqGTEUWaRVdoT4YYUqz7QO+8hFRVprSf1ba0iIGPoZy6W5XJHlypwAQx6Kp2q64+hRfcikUJ8dFjVHH36QX7F/1amKjVx95SGPfB/7EOF4oo4mhQUJ/Zp5mbXYUSBoaSs6Rplcp5HeJwJSdra5LBGWFSFzisvhq5qzotZ9OpRP2XLGMJRPga0ogsNpGMqn7PjAAsBQPiX3M5RPGcqnDOVThvIp+3nTtjzrQ/mU/uswlE8ZyqcADOVT0p+hfMpQPmUonzKUTxnKp+iNk2qwjuo51SmnhlPbXDi5BW3byGlH8grDQ3PYY+GuAi8mL54/75Qrp9GFo4szR9et6lr336nm/85Sy+yyzn+/Gv/tGcS71/fXSSisUHvV9s8wb0/77FbXv7ZuvxV4a03/+pr9LUYPaz3/pnr9LUaf5lr+rbX67ZDr6/i31em3WxS71PDvatTZYe3+fdTt71Oz373Uvlu9/nqmowW0rVa/tRL/aGs2o1qFf9SJvdiRTa2jcWk/hqUeRiX3GUJvY9KeDEkdjEhdJN1OIpWzSUhO0xEouJmDtInHGWirKaijeaePaaejWcfNpOOYXbJmefuZc3rp0PqYcVxNOF0TzBZe8HrzTdEc0wlmremmtymm12J3N8Hsx/yyD9OLg9nFPXNz9XxaTC6dYbaZW1LzSSeoFlNLX9NJD7NJr3PZx0zQ3VTSzUzS2UTSUw3sOufOZpFtTCKdd3FPppC9mEH2aAJxNn8oo4Yj1A6mD8HmdbtAvZjBTme9Q+Mupo7uZg5HQt3BxGGMFw5Qd2XecKbJne6wOy3qZM7YlymjoxnDVXkBtQoMmwlD6QkcwLqbL/K6Asc8+VbTRVlbMHIhOA5mi4pBwrnQyTYmC+dT7a7/djVTfKx86W5V3bep6G4Liti2mruL+NteFbA78+JUNK1bwbQOxdIeaaG0LYqk7any3yOr+ud0p12q/Q0H9nEdWNeKfp2OrEs1v0K9PquNxrmSX22tvrZ0w/Yqfo/90rU2aa2l3r+OuqmV3jgDpxrqLjVw2u92S930bWqmt9RFb0+L6FIPvRtZdKBevWugZ3XOG4ffUf3z1rPrXPd8i5rnbUWsd1TvvHXH2uucb1HjXAknLWxm3/rmDnHwjnXNt6hpbk/dAQAZ1epZz3w3dCruJK50lFWseptutcsH6WSQTgbpZDiwg3QySCf9L11rE4fK4dtUDXeoDN5iJthXRfC9VAPvXwk8cqJ2LhXA91T9u0/lb/M2uZRKdav67VrT25F+t3oz9arjbSScVvuUew3vYoVuxwLYrfW7S9W5W8D2qt3tuBFuzlW96nW3JWuCzrW600rcrRvsWqc7V4W7BaZrje5CBe4WmG71uR13smNd7j41udtNg/upx72PWtw96nArwC1wu9bgbquoBQC7rr/tXnvblXd3qLndp962YQ4c6HmHWttZJW3nY9ejzrbTrXVmJJtsxd3LdzhVTK0k5dd1kIgqfLVCoS/jlrJqSKes1KNL/u2mQmmWNdyuOEmfUiOPvKZIa8ER2MOPHXruFGmVJoPT67MdQd8d7vv7+ZyLnKT5endc5OQ6lxm3tsqJbmArc1JQedeXOYmby5zEKQYudU7yijEZmncqgITpYACTqqycqWd8wu5AAhebxZK10lcjEHvKdOpYwUEa9aQug5ZCB/g7o+EV4qtjmCoiNDXFoqeccpTnHtQungTVuLZaWmrFXCFttDm6DkCwKUxD8mN6Jm4YC0NbBeG3rDOuM7FKWSkBU0svLY7FV7VlKauYydengtFNuXSVC04ny3KxzutKGugcAuZmTyu3srqjS1zBxc9Kj+2+Fo9GvFiMp67QhiIphe0DQAw8Sc/qygkOtXiGWjyFn6EWz8etxVO95jIRuikvKP6t7ABWQgYAOdtCB3bfWpBmy2I0onxKfRWcfoVoigVnaiH3KEJTKDYzapD4uxegyReaqYXaufiMS5GZtuwkdh2C4QtcCprkFVK6KKfpbrYtq3zeXOzAKpm3lCspUsBTxX2z1D+hWrBEsDu6ZihhGqe6zcEfkDB3sWOg4W90sfht+SuJ5P9NLn4Lfv3it+DXv89/+zXAT7vOSDNBxHetGTM7A8IgCckvSeoDxykQxXBt8pxVV1yUhWp25VifYXaVexSAMI8RDQJmV2kad6Wua8YIwEsk3VaGmNml9N4LKPKVblmqefTWaAWSoNcQkDsMLEQRWzVUBha9Va389JnpuiItdWYKi/KfWdttq8xYK8x0V7t0VSqZZ6dNq+Tdx9MOL4szMcmX09d9TLX33MIacKY+nc3Fq0e9Dym7OddEKstlFSnMsZRWu7jpfHYBQNK33nMwsuU4FS7HUrnGGJkHzQ4CJblTvYYahIasLAOKMGtrA7VHdhfIdyh4/4hgltvEQ5GWRkVN/5KIUDLpA0mZRTGuhYRs2gJjNWsUpT5+DBjGU4Dv6IOwHEhzhE8tWm8SekHi45qDYHjFfrvZVrqsrGzIfyuJ9a30AnTR9NsYhYwY4fd45FA0vdgFpLE6FcgEeyQr8MvfyrJ99qN4NWbhZ2yV1FurqFtXur1YfOXmiC5GZoEGpPaPuKXse1EP0ljnXblDiFtkavqjOU2a85DwdK+nXZGNsEzDdaMf8Foi7O70Vn0+DOC6cmGGa8iXDKsFC/kqU5nd2M52tD0sYIjhtbgaOPSaSXT5epX7lbzPSgTc6pDTRNrlgTAk3MxTZ7XQjS1wW8i7Axm3AG8i8I6E3Ia2oPAzDihg1NBvZiHgU1sxUQfjrYs7dluJtsoh6lOrrRifULqSWYYCk8VKX9r0XLDmyhMmm+IGc0D3iEi6pkp5EmZhg6wIN5oiWzjZK9EPiHyGVH1EnyzkunEFs/41z7z3u1I3NpC1gawNZO1RkDULPUuvaZqdnsStu6YtKkBDOH13vSOvD20e0WKuVVB/V2iqqrP6+lgatk0I7Em0jJHfRfWLEk7fql6tRHa2gJOseW31aKWJ0oYFwgxCfkPGBE4FyZK3VqbBoAkHFG5gjcIEBcA4jtioa5ijj1nTeSslUJXtjCZbb4cOQsTwgJRjop4CSI1hg+iitI4QBShMAXV+P2r1NdWjLZsJCqL3P9PNCCNW6JGAaL10TJZLLGb40JRWV50ftQySiGmbrZ5C+qKz+rqwrZWmFTgnbcSpalvejVza0L4KER9HWqWiT66beuSs0i236ObSPayw1FUii8egtyKBH2M5F5TORq6tuR7AaboP6cxlupzegb7tIi3UifJZF7MTqQOpPmgPiEngYKTflhhFm/ALLgKw0yZbBOHKPN+otkAYoEa5eKHyaeM4tmSKop6XxKx/LCpijjXYr2VTIAVTi+qvbRkaVblLvVdRdnZCSJCh9IikLh5FCrTzUukf38VQ/y2ZZ7Gy8D//+5l5Hkp4sJufJng5AayzR+Fe8Bt8BpVlc9fOgs1egjb3QJtfYLNb4L78AQ2vYSz52qrk5C5XcQQ7rX7YwltuhVHAV0Wfh0/nLPf5ecm5u8dlLnGj4qM8uMcN7nGDe9wjdY9r94vr4wBnc1OwJFVwSaXQmkChOFONSFnPa4TDvENc5llVO7D2lxs98kQNndMzCC0mYRyH3qY5g09xUcs9gLA0bUPmq1N012jw1xPFf36+/uboJfwRXvwMK0VZ59ik79FR91nsvzifwssw78g0rYX9CjGtClvTWOvTvnz+zzmTRONwcqhpZ1c/faquXXIi1anOuqVFsjnrMPeUSNNcQq8G0Zx5KMivd+eFIb+Ejg5xOrXV/01QIAP9Jac6zQeW61pUr+r9HlwcBRs0xNDgLZj5TRXcMOKEKboi2rTYi9oUe3chfQj1cOzTpJH7Po9CmVwWT1VLVjUZOS5WBziViiBoddhptEJYP8ed75n1gjVRLqsFQ9eZ0D2kIUEwoeJvrgnI7Fenu0Pp7Mq8aIU0cUI3Qzzcz6H1isa8FQM1nMIDRA8BWZPg29MrECkXGfzp5Z+f90cCBd3RQIFyoFP/hlKiCrqA2dWxwK0GMMCzpjkcfBK/0o/pUPpRObWe3u+/S37MKDVbGTGt5Dg1XeQByPQ9MmlAbskiHCvGjVs4XCAM5jLRp3L89jNLZDaOSSqqDZZPr2VT7f3ydDrqlIup0XE5HS61Ohd96oyefNTwEBU0X91fZWX0uYrp3GrzqbH6aCdN+SDM1Qpm+9ruItBm4nF63d18T60TKKCd81TEutAoDY0Vqy3VmYxA+EgTbzFdlWb7nbBXTZz9OC0TDeS6Wd05nedgN2WVpvBWBrIcasecNAMIUvrhQ1gjkTVME0/GUSinapmJQk0xe5mNNmceSw/FU+Y+bRDUiEg1j3xExwWiMVbD6Zw0RVLfdpEBAKBCt7bagGbvgTqaZZZDv86iM2ZATOEQ+Xu2aPY56GdwG+RlE0fUa8jt7SbSxlwZEpdVbK1rPBWt+yPb7s1kJTCKx+jj32TLOjO47TvPOWveOF8BVe+i1cXBVMsON3ZVw6OLAnB/PeBhtWl0HMrYL1OrV9KN7mEBXT18y669EGMmwm6FacTrFZkwKFp+j4oW9brVr4Ure1+Mi5T+GlW3O4vCOGrdEW+FvTtpG8L+7PIG/+L4EuYE0NllNfl8Kjz5ygPfmueYsLttzgsJFwFZrvg1Rq4O4Jc6t78Kj50dXWpTmTxB6nzYoyY7oHWzCb2OaLFN6O0Zqx+EUcARLVMJQRoS9rVca+q74vOG+jhPCvTo5lJIK8l30pNhcwhn2OID7IjbLwmNk7Ujdk//r2ydS/X7sMJISHSS4MBDzGXWB02xLUnaNQlnEFDGgcaAZQbDKYyfjxXrf6xApgklRMNnjRDXYuNhjgFHK7LGsdQYMXwAKPSVjkJY2hmPE48rwEpuFbg3AtULndFiEgJSR2Ui64yKjYUXBl+d8sJGe0ElCyeycHJoAD/dTkBUmQxc6YNKeaB7mdQHxPjMxNugkkQdlAuSLWTE5A8xCNWY3/YaIlF5JBpb5mlxayNBGVsbSULV2EocrsaP6to2fk4PRWMLtVe7lZeGgIwhIMOFWgwBGUNAxhCQMQRkAAwBGUNAxsePvrB1ltqwV8i7S6L6cIvdjPyPFTExl+u5w4gJtUF1ERPqS33EhEajOWJinoEthU2kX/YSNiGgFSMTlHejtBlJBbAaXxXLZ5yE2K+PIBALOlUgLoqcjNqOSvSBS/hCHj2NCI3TSqA+5JatHiHVoBYhtV9Hpv7OdrEeUuFdxNQW4tEruGOXkRVq8jWRFQr32sgKNMRWAMAQWzHEVnwOsRXqhttiK14Vr3qX5MKy5xlm7Q6Zr9KmBo0YrynH4qmKMVMqFQVPeXAsahVqVn42I/KO6FygTPQKuz4xTiiJ0gCBSFnSbsE2LQ1CiwAtgVNdPlxBypICCe1vZ21NFON7MYTTEl3lGmfFvBmX6XECzLE/UWvylNlTDYWoe2Zg5oplGcP8NhoNi0LThqQVl4yZacXmXdq0Dh8bY9WUQrqG2XJAulnrPIFaVsh8NOte86mWp4NduBNrEpW6E6cPjYCsdITl3Fj1jjhNfJdltQZ5dn/yrNzcU0MtGkXaQZ7tLs+mNHjngm1pv+ok3FKTelG3jGGzzFtqWSf8VpvsRQpW5FvTbOMxmQm+EY6FonWQfFMNNrBE5uNbJMKq1PzOlpESfMRNiOrReq0/fjwpuHSga8ThMs9Tzy1X0g8MYvEgFg9i8WMUi0tXfp/y8ZYC6Q6F0RzVdfKobhKuPp4YKAskv+6C902lSxl7BVRN4mn+AXva4HDQZZ77lyQ/nhQ5mAA/jch0jSWilmRrg8jUXWSK1aruUFTS+1QnIulP9aKRwaRZJIpzkEuiUPZp74ZAQ+Y0nZG8pLpCFtZeN7EIHB3ljHe6VlSY1p6SA3wqgaxk8CuVQM22rYrN47D46ZNZI+No7OttfkU5ZxBuBuFmEG4erXCj77hNqLkuXfYOUo20ROn+bmat9C4bF84cDZfbLkEeKYtgZ6vWGn2IMY83niis0IrQG/RB434tOp2KTmaRKnmtAHGO1xGvLx1gMk+kz5CK1im/AcVZNAUL5N5NhyDFtK0ugKJ79xKydN+b2HMd+Cb2wEMhzDGQSOarARLKcBftQywaqZ1t3FOANH+nknIyAPI0tC5k42ycj0LuAJTsS/mQOzUZfRAaIgHTA2D8Q/ueAtZQqfej5r+5UTU2ixY3nZ0QZVyh9bB/3olvtFR6GiDmEiWQNc4vmxHYC9+zI2XJe/PR9AqyYG62j8rdXpaloeBJWSOiMiXGzpC1WabrRYfS15vY+6jGaUPydmadrt/+fxTz9OemOliTpUr/wBG7YxatgV1f8MaAuUXsrqQwKHyraAyKCDSqDNbcAM70BYWujgoDI2qiIFqhbQXOwszytwSFGXKQYj6IkIMIOYiQn1yEVGKjiPMXTckirZysH7AqLXOTG6WW6YrS9rSZagR8eqM7ZEn38vGAGh0JFiJKg4bC3zDHmtrUGkBsKAMAxDhEjkF9s4UUr69OZYCt4gWQcShBDMQXFIj4t42OwW+ACiAjfB+wFLMUBrK3zvGaEc9EkHu4xg2CHzhFokXehesUb65ORdvijuSdHDWTITcEvACRdfMc27YGtopg0ydOcbydzpzq0n7qLFzx9ucuunfelKt39j2J7gExRj0iUJFn03buirU19rxJ2/DnN2k0dm+SZGy7TknGTvOts2E/UXlwM2WVqAD7tpRI9VxZuWfxAKVVNfXXppq6GMm0gjjaIhVTM0ol0UoxVFkJ4lrQAHQuxJ/s2ULFE5J6hjdmNXPNyKpCuP0T3iGhqe5jDpHBDd/Ur6H7gXI8Vo6vQtfspZ0z0DWCBZGbTilkza6Jtamko9tmbo2Z6erIq2ibSzlE8nwQNqg9ICLmaKsNLKPGsQ95h8KPl5s1i2tHMI8JXmScbSHNamoZtMwEhRtYIBIkMZbyC2EyB5GqiWGSvEYoltyJaMCJvwGfsChAGwtcorM7v55tmTE15h0yAd2Y9o/7Pgoiu0Xyvy2ykLrlcay5JwjWBcKrrm7MsX9ofIebbAP6svTR/xPMnJT/RCBZ1viLzVR/+zudG72/UpOmc2lM8GLJn9Ki+Lcd2ebD+uk4kI/vHPZRNZMRyzSRZzgK6GYtlAh5adv4FkXeRCWJpbH+q9SupSrBEUCA5tiknarvBjkFolAkRDTEIZ84NE51mi+nf54+T9WSDAc4K0SyRtxbvc5h0YxHJ0xMYrpjeCFXVhzGpa7goPb4Gis3khGAuElBmvQkv5QAxTWy49dxrezrBSWViK5NcuJJG6IUwUzvyRptUBm25plxXPCpsuECQNZoia+SILiiAfE2QnFwQflVJdu2bJcOf1QAd5yhDwAAgMP78qVTWFxenV+cv7p5f3Hy5vzm6uT0vNAKlF7um5jW5GBcEBz417jW8ii/Kd+mtGpkevitiNycX7+bnZ6fnJ5evr24lXjtHCXp+1Xdy1q8hMbm/eXV+fXJ7eX1+6vLs/2gVFilQtMn6dLMLt+/Ork5f382u87b5zwaLsgyiTHMEcPgk1je7Y2g8uZ4AA1hRRmHCPHVtAT/hxWOMVxGODx/dQMeCsG4f5h6WyTAbApneIGSgKthBKDUyeHoHsVHGUXLAy/vb24SpZZ6GY9hnAc3bl6M0xu1JZev399cnVwroMb3wKyOUlMqiUGsgMC7BJLTtINYMobujfqZrZCYv7jGMlYY8wesk+sx4mPwUFyer6lsonRcEfVFTR/C4PziHRAGKGA0xc1wQ0p6pV6VfXiSas4Ev4zBx+LMsvIO3kodlNodMUE1AmGFjTlSMIpda7andlmLveq3Sg9g2bHZm5Nvz99fvX39+v3N+en1+e1N/iDnt0pSN4iSIAAmHgnOUveJ4vK2Tqc6ZtNcxlbCJFfmavb+zbdvbhXQOiJwDOOMInMaTwSekzUK0RJrsuwwTLoB3caxwW8Y4Pz/XV1e355fO420nuAPwqHHPpPr85u/XZy+n13cnl+/O3ndAPNPz8dbcVb3vRir+w581f3e2Kr7TlzV/Udmqu478lT3j4elut8pR3XfhaEanmn7M317cv3t+W3fF1pWGWh8pj3ps56so+z1NYUOqf8R3spsbo/umdSZ4j/mQzmIEmvs9sTrc+P+yBPGl7zbK//u8vXbN+dduRZ1fVz4lvyIZqzLi5kYuh9LUWYKUn7gRq1yCzMwyeqHtjIFjq/ahN176RsvkC05RKGIjAAA1Mdj+PJfvvwX84eYcurR4FgU5dR/y1UqNU2LjEPtY8YwE+txspCubptjuKAh3oaDMo+s2nDkr4kcQK4fduKpUGQC4nLdH/B8Reldu9bKqVsDo9WJCQIQPn8kXCrbWurD18qttcyvG3PVAsxBdeXc9SNyXM341CioxAR0swo/5cqjoXjJypqsiRCqA7rklHEfx3H58/3XL0t/evnX//Pis9KOnZy9md3czC4v3v9w/uq7y8vvG1+zjLY23Oyx4wDfnMxev70+f391+Xp2+reGob5BJFAk+4lSc6xRSBaYcfAl7VG2EsOzSv8ZQKEPovJxMVl/et7Z4eiJcpHKuaWcXjP4P3D96uQU4kRyucXR0jos9ziW9dhHT2QWPAXIJ4sFljb9gITJB5E9754mMZOoqD/5hPGYstGT0RP4hsbAkkgQc2mh1P1DzOHyRhVlERyp0gOhOb3HAuUXB/At5kXvCKU+UpYMxZ7nuHUaA2EeI8hfg6jyHG+UhRPJYfQlno6ewMsDOBV1QHSMq4KjSuYrdzHtBaG6a85v4jEy8WNyj+PsBudbeozIAvWKR34CfzqAN4IgaFOVxlKenXCpByWhVHvpeqsK5nTUL2A83wlsP82dxCm6EFMQ+3im5np6fcasnXqM5PzzWXqx56qWIs6Rt1pLP9qdRcIrh7mTFHRdSHy5TX1sfAXJ5iD5e1QXH18G0NHvfRch1uWZFjzf5YkWcrtfjZ8ZXN8H1/fCz+D6/gmip8vX1xZGrdr2iaKWfEF7Gaib05vZLFzQ1BcfOFV/TQVPXW1bHAISBA0+upJTIoU8tFzXViYCWg8HZfKLWz2V2S9hSnGslbNbPZjkms0EDVkImdVp8EKXHeERJG4zf/o6CQu6vCAJjafR80N4MZ0CDdX668DMJm/AZ1qdeQzPD572RTvTSqDACf/bXAcdaC5mkSoc5d93saRW17R7Nwd+fRXTeyJoVY9TjaRTmqiCyZzW6CRtL19Mja3Sx4sl00WERCNbQT0oJoluaGXxp4YO9dgbvPyy6d9aqrbXzF40r84+NX0Th5ll2RQSplPOwDyg3p22hwONYS1kF1X0FkHFhNBhGTwUIU9o2JyKpenGThvE2wukidlIxYBbYTTRHER7c7LTqmjyizL+ZPmZeBKHlnqsadV3RXQCuiSh6Jilwes7sYX7ofnmpv7A5Cmlcl+Uw8KkcTr4A//CkMaDQ/iwYALq1bu+k5An7DLKSWItU3mT61DaIKr/+kDSHZLgGycTYuwzbQJL3U4/GSFwDnrLZwwTZ2q7+0EfQhwLgX925jT6Zdbe8GjiN1FkNr0V0iFHfJHA7QSYsGpasE4TEOqayzDYuJaak41zR6fIo+XPhCZ/jfiTMINneWnayxkyjpYkXOrX35VU3aheon2RVJbeQkmxTDY3axya7k5YnvJL5VlI/d4bxLtNK1uFnc9qTkJ/h1OzlxqeQGOI6CR/67pxZ7Z0HpJbb8zVses8HRWpzZT1l/8phZHp+K9RLf3PVXltfO8/frqOR5UD1UHdaml+YzIIaUUudFfmbqHGHT1KDS4ILCYoEuWmsT/N8hVNCT2GcU7lskrmU4+uj7Imwg5whD8o1drE5GfiOD4SXiNHX7z417GDjlidc9PbCxBjmE3N71N9GvXylDXFTc1qFMbqopozILMildTFNS0qyuJabEv64Jo2mTb4NEgYl9xAn5ypubyeygBTyet5Vv6zS3LRM8xxvC6kykPl5dCOyiY5J1/FNFmqZAM1C2cUtnMMPpYBh0oGIJzBnCahX+qkskVXIhPz8xVfCQ2VFbc676bPDfPPQU4NpZWjWhnlZFnN2+pngVA70ubXrmfKqUUoRmuxYUy93AiS0MdxsCHh0hBvYBvG8RpkLLNceDU3aXNU5zO7O3CSaS/qhjYOYnPDL8qRiEk1RsLKYUnV9zXgtIExpOEkzFtG2hQlH8cYsRvzw+/D4FC8cdZVLzYF/1HRE7hRFvd0reUBHF9jjkg4lnszluQDj6fZn9cYhfqG1eCksRd9BQrRasNIqVCe5VqK8e9wxKfpwHsfLl2Ma80rllW+OEzWdey0QrDmg1oo58Mk3yX7IZJN6irYmfnoFnKVVij0A8zysnPhxDTP9JNY2HZkU/v9WNGyZ6y85HlGqFlp3gi4tGfZOGrj7vBmonZtjSKlJSsdr/QxzHVd0Dh7Qs2tk75CmOE8WaER+iXBwCl8n/HS28UwN3I+8mM9v+cs5C1QwBqTMg6M6e+MMZ1jjgbudOBOB+504E4H7nTgTgfudOBOHzN3+gkzhg+q+jZVvSL7H0FXrwaya+tVmzZ9vUbZrrBPh9uJyr7oFlhO5sdyHIGKuMjFIucqJBi+eGor8jWVEG7pW1YtOnZd96neJj8pOwsUbZg6g2BamqM4jRDmG45ZC5pyUqLeRw2edd/qk5i1C4t1z68LtyLm1Myx7FyElKG+O5Yjrc4y+i1JEwhINld+7b4c1jntUBeQn0MdD85pvjBpiv4cBzRcsmlrrb4CNEvhvqoJcRdzMBxYcRb1R1c3Jfq4uU/tGi/KWaDqZva7sp7pNSt5YiCPJyiAMQ0nPmF349xx14vbcuoHncA/WIjMICKVFrs1qkU0SE90dknkgW3Rt8w3LW9Oo/hs87O3q4x6qI0eHXsxKJPyyiQRbe1vQrQWAIKNOBv3xNBUDY7pMCYZOK+cK1HC6Rpx3WmOdXyT4m1TFionRAEjPvZQnJXfGBe5tbEGry6Dn0ZT50ud1WuJxBSiGE9k2Y6CdH8ICcMxgzdvb25zLuzZVAp6dTGclZtovlHN6jerCs6ihoM2p9J6dVwHldzOeVyVFECu9RxnFVZQbmgTBJE/JN9ifhUkSxIKxu/ZAYgjpdMoIa4xtK+8dZ3U89S6TqpZzkiT0a+iBPqMxhn1OWi8OzQulZNR7MLfE8aNmogByqmYKm+n5Fz0oqrjShiQ9TrhMoU/WnAcp+oI+wK1BVaZ+X0nNbJOvtfFLqX4CrGtY9PiPfHH6j2rvaTttF6cBcXvt68XPKDKFukHs3Epp33d1ZXg0GHR8h3qlkx9NwuW80iX3JJZg3Tlco9g7hRydIdD2WPXM27JUk9D3FwINVWNwo/j4tkZ/+TQJb9yNR0apNPWWx+2yMu5dS9KzOaY6ci43CG7oFyXnlQ3NCUKogBF40CCO84xMzSGWKdQ8stJVBjmAmXFuyiqgmSU2OrQnAjNJ8dYdvGwDxFlPNVpZlSjXzXTnKjcusKVHqVzvzsJv9RsKmLDp7VdxdHQt2Kt6LFeJyXvNY34VJvLTYq/OVFZJKVyU0aMSC5C6ibvUUA0d1Wie7bJHKoBxJamlnW7KsS8tvKU+TqvTQ6VFYoi/JFekWZZvvZknFzNjPye1V9VZ7w3Rc5SOznFgM8WashYrxaCiGAPF9QFQELGMfL1H3HISYzT/VJUQSKUUydwREJA6hDAf9xcXhx9S/Xq63BjWSRnjUMua22sADGBmlh5ERyDpya10jRL//Xjy5/UgcIfkFAvHxr9ePoQmlNMmJpM2leSEIlSRH2N9INEVjwWQDWyCYaA3OFjGEu9WDb0/4jT+L9jePawwkJWEr+O1YCpjiRPSrOBlQQTk+USx1iFY2EhVB8AjQX+Ic01DnXpY0Mh/AoiP778aQzPivMCEvr4A7xMCxBF1D/QJ55tQo4+AGHgrSjDocq1IO/FPQZGRawYDoKJEToe0EaVgVZLqbxtIhTzkgbp9vLs8liNJrZtGQJhEFIOCyJogbjAvHDjWJISNm+FQll6SQXTJkLfMO2dOKBOnWNR65Qv2idUmjhP0Tnc9WledWydYsEweORTj4kJCgspO6L3OL4n+OHogcZ3JFxOxCGbqJ1nR5IuHz2R/9lqRiq3o/O0Cm/BPucmxmFHT/vH2CpJqstL8PTG2OtLvUt8QIHCrZGvSCAKN3s/xmIBZVyit5lom/QEhb74NyOMi7/3XrGEOF7gt7Ozj3O4E9L7tvaMR7U4SEC9k4T50FDTaVJlPHcY1WqNXJW7Ea8zw0Nm1e9S/jJnKWplsvONjaqHGxNTllhC6tknJJQ1pwpKDSUztmuUZ6ERNaEiTICHGK5qCvvpBg3Q9wLVcWqB0NojKZZJiflUtEt3eQzL66tTqUGq5b3NmDYsO+GQSu7CqcJgwXJoGB7NOCYp1TMDwqcwW0BIecbtHALhuRJt8kiZteV6Z5PwLqQPkqFPE3HQRX46hAGCtyH5ACEKKcMeDX2m+uPQo77O4BICCfmfv5jCZShbHxrHhTUKffjZRxzDH/+Z/fPFz3rKxTOu8AkLYzASehhe/Ou/PJ88fzF5/gKePz+W/4O3t6dTSx00iUnnsmyyCF3r1ZCtzJ0IEOO6ACn29SddtrlynLO35W1EQ5OFRQtMMebxRp8iBaZ4lrwAo55SVEtlz+KToBsXrHWqkKdRaIs9T0IuuW/7hMV807M4hYvL2/Njg43RTwR0uRQHVVke8uoGI/UwHDLCyT3Ok8H+7C0njrwft5M+NXWhtcktSJOY6VpAcht1WeaG1HqEs6blAo6dPaM+HgGX6Lzn9H3CPhX9bkdhC/J9qDIRP5CGJWOYA6cwvo0TPG6g9cbIh+XmkRAzBrS8r4biNx6wpnQxOQ8yhyOWtoW4jy/bRzxZAo/3ctBPdK7aENiKKfhhhUO9HYVYHw2ZsAKyxV3RTaVO8OJS6gXZGgWB0sOE+qRlO00WQHhB4ZI71bnW2rIi1kMUFnbgXBDPkLOf4TYeYE1Csk7Wx/C8e93WVquWzaJFrKasDlZRl8VqvjkrxAATsSly7ZVtQO8aV6n8IIrpMsaMTR9xmdjGGMpRSe7qFlM5eA4PnsOD53BpLoPn8OA5/DHDmwf34cF9eHAfHtyHB/fhwX14cB8e3IcH9+HBfbibsmVwH/5Hdx8ePHgHD97Bg3fw4B08eAcP3sGD16JZGTx4Bw/ewYN38OAdPHgHD97Bg3fw4B08eAcP3sGDt+FcDh68gwfv4ME7ePAOHryDB+/gwbuDUpeODrxD3ulPm3d6/wmnrZmmW1JM23NLZ0mlL/LVRf4h80rPFoAgxA8Zrvmq09q8qN88zb/X1F6QRx+u3p32sIZavF8V3GmEY6VB4+oQnAaIrGs9fG9kh6t3px39emcFel32i7BP3slqV3VOrDAWDgtx32SpbFiIutzt7otSChzQr2zKTShk0qdAnefVxo8Rr2NFpnsJKbDP4La0VXVWde3Cmbn8FBvtwu98Px7npQOWU4M3GYIFEQv13Sah2LEpXAUYMQxh6pFwj2OhZk9Vk8ZQrJytg03mHOStsCf0wzCnfFUeEoV+A76cAg5ZEmPVD8Va6SgH4YCRt1Ic5hRe6bGNBVvhhn2IYkJj4BQSqXkw2i1terOeNOkI8M79Im11hW6dFawPyFnDap1e/hWvTOa0/mPFk//zSi6uNFsJw/HT9DoLmcHwxLmyZg2Lr5y/0ldGk7ZDKPpJNAiE0yGKYIgiGKII7ItdCCOQeg3lyQLeCsXI4zgWt8or67YKj7O6453NmZp6HqlLnYo/7ElJZMj8mp52sMFt4b0rGf/sATBmAcUW2/0jd+qT1cheOynVG3uXnPjyfNhVXZ9GTtnRxbToRStkEXG5GUvWijmdp/400us7c55DFi/Dwg5kYzHMKzKeT7Hy78m7U9cIU/ty863hZjr4/Nb0btjADr6Kxb1EYSb0VOqLOi9zUTiTiywdttRKo/KTSMJ8kbVHmq648Q51yFxcs4E7TGP89F19x7q4jT5SFjTBN0ZFvOAQkgA4TfWimQ7ZxwuUBByayoEKfYsoYbsk9zgET1USk6Clj986CTiJggyOQqYIDrNjsaFpmwjHUvF7puM/ZtWAuIwkmNAeVIR5WOqgpPbsKRACliJeC7JMYgw04fBgZo0Yox6Rj0WGCBBNefLrUrcjud7abIF4Ho5BQq3dbAFrGsslD+Uy1IGUV1Jy3kgvdQ6e5CILIOTazzEOYY3iO+VzoDE+hKJxJjMsSDhLHGKpZkChchWdwvk64ht9U42jJQoC+qD9nTOjzfRp402uveg2n6Raz6JP4TUEpzQUL13MlLu6lJQ3qfQyx/xBLLSzkK6wZ8otVXo1LJIAns03ymHZxKMh3lH4VzxwQcw/gDle0FicNAW0VpBvY2TsYn07fbP3dyBzjdqYSqiATR3jajWrh7hCTMd14DC3byIAUkxPCbFWvDeYG7eOWwronhIZF8LIPMDAsJfEhG+AMCbjSrzf46F72jXg5Xfthpf1TQ1Wgwse12J+mQ8puuLRqhHIwchtcyiyHsOP5uuW46E9w2GtcBCJy8opJFGEYwiEwiF3aNgzMsXTQ0BRFBjtcvb1QHT0sUd8nI9r3eTjZUiYYJABlESeEhNilWJakLJgjhj2jeldLJzYDzXFGKvw+inc5vsXTngQwB3GkfLiE8PJq4lCDYJ64k1mZrWKNyWVyvNegYMn4OAJ+Ck9AXvZoz/OKzL4AT5yP8CWo+vqJuhgQVbU2+bS9pHO5OBB+HvyIIwQ5zgOj+G/n/3XH3+bHPz7s2c/Pp/8609/fPZfU/mPPxz8+8Fv5pc/Hhw8e/bj92++vb06/4kc/PZjmKzv1G+/PfsRn//kCOTg4N//qQaZD5Oca5Zg82k8UXer4ALX8jAMSTQbXUkGN6nBTWpwk3KfweAmNbhJDW5S8PjcpHafSXXwlRp8pQZfqcFXavCVGnylBl+pwVfqkfhKDW5Jg1vS4JbU6U4NbkmDW9LgljS4JQ1uSYNb0uCWNLglDW5Jg1tS14sLg1vS4JY0uCUNbkmDW9LglvSY3ZIea2awYk6rI2OxVaOc3sx0odR8JjBl2/VEz6nHyJRGOMTzQuasJ6YCHMsbWkkYkNCcc5nHTf3zNVlgb+MF+A31cZq8KjMbyD88gQsqxWhz4QQrmnBAvo910SdOzTdIomWMfGxIEEQBCjEwjNYBZizY6DHOoxVe4xgFEudbmlXXA8QhTkLJzyiFw5r6OfqZMCGnR9SXDJapEyfBjMWilNYVm4HGgEMebwDFWCqxFfsTUX8WLuhl+EYwRelpQZwjb2WsPca3Te6h3iClcb2mAdYuFIXNjefIm6KEr2hMflUsYLrPNVuqN3Iit3biMZLPtDbR2oeRLvIg92mij6OwhxIPn3iSqRsBNIPMpY1jKG2p6gSY5qOYBljX3alM1BHdWDVFEflWpXFrXIxRw4rubyklbnESYL2IBklxR8c65jkjEYXwan15dKN7HM9lgyXm40MYB4TJ/z4g7q3GP/UD7gn7XO8Rige/bjzdwlPK/13ORKqsKxCLoA61YgOLfymju/hX1GUchr0YcyvqdStTn0ewboCisXrLleo/rlLzVQbO1q8WhcK6qlpfe0HrSL2TZez02Lsc0W3ps3nvdOzO0/QojX0S5olU3TDSH7BpZumMzBT1Phb2Vp+DGhTq8o3W4eDJzKPmT36aedRy5CprnmGmcU9XRtL1J51+8s3rtQ1gaX6aCTyRrLdpb94FusPPaFT7GOdfMce3qvxG17zQcm33/GRmxT57PJmGRh/COEW/I8WG8RiqkGEcUZ+NoQiqgRbCT30f+EMYM7WRztQnuyR1tzX6SDyBlQy6j2Une7b5dOZADiXLHgrh47FzI/t5wnotooKvRJQ1Du0j7OCY7pyNKa7Ajt/RP9RN4Q+1b6CYT3UxDyH/hY0P8796NFyQZe2gH1M8zBPoz0A8rLwnHcRDPRGOOF4kwQ3mpfzqETvq/LjWv6wAAZrjQC6gIxSATAcjfIIjGuKQT7r3TbO2v5z+efo8VecwHGCPGyPoWtzQ1zkcQczfYSAAseo1DQtBAw5g+s9WP6nSdQXGWWMyHgHEWJpQ2TGIkAWO11GAuNbb53cWoLhHXVagbQ06rMJ2u27f+bKft4zDIXyTOTbmRijcWi8mnHgo0B1Z4eq73XjIqqUWYjCN4pFMYix009lEAMhaqlnFlV16sgKBcH833uC5Psf3L6Yvpvk6GyhesrKydzyZ3H/95bj6VwEJ+X6MGfv6n56dnJ1dn9/cHNQ0DDDycTyRN4fQMN8Ch/fl8XRQjQI3KrmWCiPFMRzdo/goIHMxmSNGvTvM2ZESM6KYftgcSbUj9e7Kq3KVBIEqOSjiHy8ov1K2o1HZe1UqH1k9amrAiU/KFUjWopOKH2rFsHYvc4qxTvuZ63d8/6fp8+mf2ve0ffeGvUmh6vWtIxxt+1PT13mP6u9d6Tp9LVXh487LmT94OXag08HL9Tu+f1kiJq672vuAWldpgRFPYjxZIo7Z17c0ogFdbr4W5oSa1vgDj9FEseMT88A1NV5jHhOPpdgdv3z5/MWLmobCZEIT/vXLL5+zms/aEXmyYMKC9jX+wL9wun9v/vb+4uTNed39+yam66p1XZqBG4r3ZxXU04d9GqI1rh155zf/U1JbJWJ0PPGmk7jDOz3ufd/Yj0elP91epVKLbFbdL81JHWUtlUPCccbBddujxkW9vDq/OH918/708uL2+vL16/Pr92fXs3fn1/XLXPte1EC7mb0/vzi7upxd3NYDSkLy4fjoqM+21Q93cjV7//b6df1oJrowZ84utnsClxEOz1/dZFKbDuzjK2y2Q22c+C1G4h8pX84q0FaIKYdyEjKOhJeKdQKC+t1cnZye16OfiY/NIGaX72cXN7cncgtv/3bVAEppGiZmDuM2mOcXJ69en78/uTh5/bfb2WnD7RuXHxZBE6CGKJjg2a//6VndWal7DZM4KLXWW13XWB2dfPvyuT4YfzIKoEarEXtqB8EiOOeMxNJtRCp+7CYC4dExgRNNzqHRCJI1kfEHcwwyDpVTEBXVlTPV1Tt2CFJpmosQOMk0WHu3qJtX6WOZ0zsrtrfSKFedSOqVh1JvLXxQ9mixb9BOdlCZ29YOyhjUGl0qOBgDQcUQExnLS72Nai+a0PQsfgZq0OK9eUQuMjp+dBLjJWE8Ri032/mGKL7IYlNOnRQ+pvK8OtvP4Ow0bFGXQ7QTU7yl4QX1cQ/zey/D+6hsXa/dLJfTIEip3di+79vneOvcLZ3t9r8dGaeVkZ/6nazmjSPu20jXZVkyA6lB92NSqJ1TpuyQ75Im9adFehS5T29Q1MdVhjCPEeSv6y+u6W1aHcNvcvJP/n9HcxIesZX8jSzgR5h8gKMVZfyIiS+mA/z0b8BX2Ij/3iqmlKuGUGo5/qf/T4ktOCgCTFh8FFAPBR1AN/VpHMQZclece6DcNATDdR2rLRckf8vOEF7TcBuLrzj2LrbeBhui7t4Kv6M9sK7Xju2/6RB9Lb85AH3mthMTbg6H/sbbApB+27RTg62kxJ1NtXn6DSBuzwXmQiNQiG9pM95KKIonz2h3J310LYSd22K2NACLWKQA8xRB8fpMIsRXX//TM6Vmen99/u37m8vT799fndx+V4ARmJCYIl5RjG84jYp/BMAfqhnZAERyOBTKAmv6tRGMxMQT/x+vYRIv4CiP3FFdSI9DEzFDqX8d/+SiTbar6BXjblfp1q1ei8Zfb4YGz2rnYR/0+7evzt9fXJ6d78cMJfPMinN90WSGkmPb9O7NVMlFialWpkWJWbFFZP0Lx9wOJd+0i+HDpEmR6Uc+8PLVIPckwMtSAJ768VCE5iQgdfH+yJdX5PTk6v2b7y8uz8T1EL/c/O3m/cnZm9mF+EP2y0+F7jIT0pUZ+px5KEA6Er6Iw76MNg20SyoF/ZyOW56e2dnB+BHo23Mn2c2qV5rEJ7h83Y1WLoSsZkHqgRaYgMG6lY1yHgrDxNEZYUgmyky4eI4ksQHtDHEIMv2EFtErEGLsUcFLyXQTaR6AicwyyHg+VXIe7evzN5dvL24dLV37p74+FqxbS38f39cPTn02kQ0dbVjmNa0spopoBUZVEDkKN6o/A4Z5EomzQHxcyLOPY0AxrkAS+VnQUiec8e5M0mIpsa2RtyJhJY2jwlT3kzR4/Ir4JFYeQyiot2MKiBMhDlrmLdq0D/YdZfyWnppp1Q+nRM+82qBp0ILgXWrHkrlqVfreaD2sPR9iWhJM8SGtPywq3v1MLieNN6MO7791nAYO7b2GtzmyYXEZq0wRI6f71AePek6xH05N16wTXp02pfm0eUbpVRxUe2eJQPxjeP7ll18Wvjoowdovl3W21tkJpUwhd4G3wn4icizlFJuFnClvmc5SqZK3gZF45V4oGiUN3HESmnTB2sJxCPOEg4dCmOMRwJreqzwqKJQpNuSLDGQBIfYwYyjeTLW66CoveFvVQ/Vu0/oN+dfn+mePs9aZx9XcTO4TEpusmAJl6uM+EyuqF/JTeiGm9P8fACR22lSkDAQA",
+	"openebs-operator-2.7.0.yaml":     "H4sIAAAAAAAC/+y9e3vbNpYw/r8+xfkpu2/iGUtO0nam653Ovo7tttomttd20ne2200hEpIwpgiWAO2o2/3uvwc33gmClJS4HfqZZxqbwMHB7eDczxM4jTHiGN6gDYIbHN8TD8OJ59Ek5CMUkXc4ZoSGx3D/YnRHQv/YNDJt1pgjH3F0PAII0RofA41wiOdsskYbNKERjhGnsf7KIuRlTUaTyWT0BM7wgoQYrmmAga8QBxQE9IGB6ktoyICG8N2XDCLqsyMfRwHdrHHImUbpNEgYx7EAUMA5niNvihK+ojH5RUKa3n3JpoQe3b+YY45eOGMfJwFmotEEUES+iWkSsWP4YfyH8Y8jAIAYM5rEHpZ/DKmP2fgQ1D+Ooph+2Oh29zie5zo6QTPLJkEytfry32I1zH+P8AfsiV9yq1P69WhBQhSQX3Asv8Q4CognV8WjIY9pEBS/MKxA4HsDDId+RIn+xaPhgizXKNJ4ebFu/3c6Vw1iGsp/954644jjRRIYTHyE1zSUv/WGaX75OaEcSagBWRMeo3CJK2ADwrho8oC4t3IegoTLGDOmNkmePRpyFETURwmnzENmoe9xzIlX98UTXxZiezAjy1BC/DnBjO8IRcZpjJbYC5DBMxKXhnEc8nsaJGvxiaxrv7gtvm4booitKJ/iDxzHIQomemR9EetwK/ZUC1X4k7iwFSSWmKvNzC+HPIWCvol/JZGv/xWZjz4OMMd16KOICJRDQUiYBVkvYZyuzZ98QcmIJFkl/KCMYB1eGp0Uwxq8NHmqxwbGfygNO/7DGGqgeGIXpjuCRWnskzBPXusWKsCI4aZdS3fLrE62Etnq6BWr2yx/TZjYqRgvCeNxGy73KCA+4iRcPuD5itI7RcsS1VGeuHXCLQ3qZ5FtqW0a+a0NaXitEXt7/VrCOlpjHhOvdgzX2x1R3ycsTiKB7Tzxl5jXwqs9jhnOhqSoV/oVCX3gK1zmEuCB8JX8IB/wq5jckwAvMZuOnsDt5dnlMZyusHcHZAE+XqAk4IB0VxQwCiHGPgNOYS6efxzj6qsuxibhck+PO0vmf8ce1+97LZcDYAdhvpf4m5gG+BovBOAqp9IG0eyzZapya/KLgqKIHaWs2ln6+NeshRwORURwFLiBPwMI0BwHcmUa+wFkhOTIo+uIhjjkE5fW9wbvl9M/T5+PWIQ9MRTDAfY4jdWwa3EMX+fwsGDSDRfD6BzDCzGqIBx4uVGD8E2Ej+Eaq4sh/yRYJBIu38qrfAxhEgQjAI7XUSD+oJDNrTNAcf1aMO+6jva1BAAw6yn/XTjQFy1nGUDwhIiEOM4hP7GjD0DWaImvkiC4ogHxNscwW1xQfhVjhvUlyrVLxz9aZ8COM+zVT0RjnkNBoZEid0Vjfgxf/OmLP+Va4PC+2OEJXF6dX5y/unk/u3z/3dtX5+9PLy++nn0DOETzADM5IXHfzCoBp2KMEHtc/PO7L1kJ3hwx7AMNga8IA/U6TOFW/CL+twxpjH0gC8DriG+mpd6mHUsiMT3sw4LGRSRwDHpD4fn0i+lLoOEDiv0SHmZD6qdXGhXuUZDgYxgfregaHyXzJOTJ0fQumeMjNYOxZdW+vHn/5uTm9vx6V4uGfD/GjD2SVUun17RoK86j46OjF39+OX355fTFyy+nnx1/+fzL501rdnHy5vzm6uT0HKKY3hMfM/lGpkQW6EKtQyahAWKAwhI8HN6TmIby+z2KiVj70m0oziYduNBKT+TrmK6PSx8AFgQHvn6nar9dIb46TmnbNJ1Ew9xvzq/fzU7P35+cnl6+vbgtroA5LYYHMOsQUR8Q23b2paF3vgaCpE6rtLRhId6c/O3k/dXlmdyU6kHY8dwLo+1390uozhb5y3R6fX5ye/7+7Pzrk7evb9/f3F5en3yT0lzCYIECJnm9EC4jHJ6/ujGcYQluXkwFFPrmDxGlATyQIICQcphjUK+0P7WuTxtqdUt2DGMeJ9hCGWcXN7cnr1+nME8F0Pc3VyfXN+fvry4vX4OPPbntDyss+FszV5BSGLAIxQyDnBJb0STwYV5eXyN+YF/SCIhQLC+OfkCBhIyjIFCsYXVv1BQAWUaWiznHuZEOgSxgLLdqDIQXlzttVUuk5coBR3eYAV4sxINAw2AjAPbaiyeS7GP5rIiptO1y65Y07LSabPtWn16f1d5OIAwShn3gVL+SRz5h8ovokt+l0iCLmK6VDvbkaqafsim82qQ7JkjG6fUZSzdKA8N+CZJlOU6vz0qNnQ/5q5Ob8/dns+v8DNNDIB928EksmfaNfJbNyaQhrCjjECG+Kp+V74Wwl1IAD4XyemMQqi4izvqCBJhN4UyvgRxGAEoX4egexUeZsNKyDmYSjYuQB2dZDHWcbk+uvzm/FfAk6nOcLox4I8hiI/dMTF6gXILGadpBrBZD9yRcyg5shcTUBYcrjtYc8weMQ/WJ+Bg8FJenShf6Qis1mXhNqtcSp0dJrqAcmrDCCh4pgtDKLJXn38hnVmG3rqq4neamPqallURSLKyicOcX74Awpb8wuAkdhVDYSoAB9eruuXzwsaG8Ppa6/I+wWaVl3c2O/fvs3YmCvJOdMlP+d3KP4EY99Fdi2QOKfOzDfGNoRZ/1+uTvVN3mFFbQZVMsu/H68vTk9dW7999e3txendx+u9tNMaoC06vImu1kh47EnQke5T7Vra3THZJTGrfxK/IYnF5e3F5fvn59fv1+9ubkm/MGHsVA/ju5R0pZ4gb++vzq9ez0ZK+wG0WuYxh/1gqq8Ky4oCkp84QwvuSO2OaIofsAgvR3h//mmze3HQeZrJfrbjN5d/n67ZvzrmMpNqHLaGacy4uZGNZlqPUEf5Bqmrj72p3/v6vL69vzPQ307fnrK0fYAQmTD5OEk4DVAS/Q3/OLk1evz9+fXJy8/tvt7PQGyCJPY4Dh0GeAQhpu1jRhkDC0LMt5ytQuunxD6TLAcBKiYMOJx9omVR7dWZZtlhfOr9/f/u2qZYlStfF41LAuKUrvr2YX37yfXdyeX787eW17mnxtaINnJIQVTWJ2UIAuHqYIx4T6xINIsHd64RgOed3qTQvdjTxBGLz8fFX89oaEZJ2sxbcXqyn8jSYSUY+GwlivtDWcwgPGd8FGPHgMc2EnhBd/+nI1sr8iDSsxqn1GXn6+yi9pQO5xiBm7iukcFzU1wvOj+BcAj67XKPTLfwaYwFHCYvUuHc1JeCT0px4PahpqTWrhi7Ruo+AMB2hzgz0a+uwYPnteaKN2Jv36p/zXGCOf/GbnUba2lX2iWq1sE605dLG2ORuDJuzeS01nOXOJOYEoInpeUdlaEsWUU48Gx3B7eqX/xlG8xLxgWCna4xpsQAxLE/zJQrpAbI7hgoZ4GwOloTBSbSp6d7FS1ne2rqpDl+3slU0D9MDqk1kum+fQa23hk9kwbRj1NmQaoHdfFgBXjJotFsqqrU0DhhzQT2Nuq0Nk3ya359LcJv7fanFrsO1uv3S7Me/ucOV2ZuI1wC8uz873YzWS9rKQ+rhiJXuEdktpQDMWxKrJrvjkiWNjKJKcymEJqnRlzu+2tu4saCz2WYLUGltFqo+U6xUYV88aS05IBUhJhYSTWi1zMU7V46kWJ6D0Lol2c04/m1yfvnA7q5X1bDqupYnof4m5lHq8Pj85O79+f/76/PR2dnmhxZ4zi+ElwMjHMcgXWljHckaVEuxSSwFTwfKn9dNsQKZpki0mFqULPLk9/fa9vI4nX389u5jd/s0yNyN7iPsFSDNeVX2eOGhCpWO4hpzLQc66Qhj8pOf701Rq7KSbpEKfAYrLtyZtLS2zP2mk/J+mjmrP6lSbVk4PVF68q5h6mDEJPzvrkv0Sq0IYSNdu7JtFePEFeCsUI4/jiqZf3yogyiAQLWMcAU14lPDShOCGwiIJAjmsh8KnPL1r0qAl/SFV/2d/1UOyg+kJxHiZBCgG/EEMxqrWAUkxJP6a4uCQkxgbKUhNdIVYJi+TyuGEk9Bb0Rh++u+f4DgFhsINMB4rSwjiwDiKOVOo/lRkhX4qb/P0Dz81AxLYlAHAgooADqUQ/gXHFMS2UDGRFYr3ItSyVc0fJ17NHzlmHH5S2zPxYPzfJUZw+ofxT/CVZKt3LS72kH2My3tLHI1NAqoD4cSrt3bcjTRUP0xvDDtJRttIQE14b7G2n04aSvHJQoGapZyaxhUJx1WAqkhCj4Qt3OHr4vy27Oll2cG70udVKZ2Sn0YdH5Vy/9/Wq1LCfoevyv7EFn1QDOqQXe860SVr1iK87FJw2a3Q0kFg6SasVMiqRctUQ1dtqqPfPmHdvbRdPrj5U1B3dL2Ahi5C925F7l0L3J3E7a7C9v5EbVdBu4OYbRGyh3d8Z+94VTzs9o7/JqXDEvY7lg4zkiB2U6hxJj5hd5M1CtESxxBjIZ34RgM9egIzXjacqwhk8ovyOxTdJSmZY+UxvyCBPMe1dstTCfcNiiwiaOivJ2r8La2WOUBmpCeQ+Ph+ItEFwgy1ABpDFJM1ijdqKvCwIt4qc4839AI4hTgJBWgJTU1WDcK0wBMYBT4DujDLARN9iUksyMEaFhRI6AWJjyUgsXL4g/xdHSxgOEKx3Ax1wtkIqhs2VSMdw68jUObVOdaDm3M5gTu8Oc7NuyReig9Q/CDTIBznPdU0EIaRuHe8FpD5WA9MurqXoK1R3ABKfGlBqrD0pclSplZJr+hEtS0NQpk8vOmylxpVFwFMU2HvODzC3DtaUcbZ4dGcUj4uonCPQ5/G9SOrby4D6iNyDONxDRKnry/fnr362+2hdoIsoSAcH+sREF+2H/7Ix/dHAaXRofzXwn+u/sFi/Y8YrdU//PVE/WPt6y9z/Y9f/HGb2krm4LjB3E4yuuupVKc2CrIfHZQB7DC6CuS/qdMr5Y3sWyqXMoScFqSb4uhJObCk+vAYdlYQVxoCCgIQRsQ4xBwz2Z6NcjzyhibwIGlzQO4wcKp4qtRdSnrrMrpWQ7FDYGiTjpxB0kzDPTbexYA4F1TMP5RDeGoIsXLAV5RhbfkIfUhSavZE/vFGHwDD/TxJv+LpcprCyEaSmCiWZwK5NBtH4sNSxOGPvzJpUybib+MUolgZjwcaqPgGfxH/Lzfpr92A5XHP9qsBxjHUAumqGBRU8wLzBxrfFQjOE/kFrmYFpt+TyRwEeUrki0puTm9maVKIh5WOqLg4e5OCMTFNokHG7JvPYpCr2VlhaJuusnJamyXqStPjF9PPCuI0ipeV6PLJ/Vef1ziwLzDiSYwnS8RrIr3UccYP8Pbt7AxQsKQx4at1OSJxMsmDYV+Nv7m6fYUY9kW38ahhTCiPKTl0OeTy+uo0v8DKdCf/WgnPf6L6MUAc/uXFiy+kQxjMU2KgXRzMoISBl8QxDrnwtQ/hJIhWSD1MBaA1szq5mt1UhUpX3QXDXhITvjmlIccfeHF/IpNgxD8uO+8ra/obcepZvfIjZWTzP2vRQakuJAdx1MTXlfqxZK56ObYXzo+XYbA5bg5iFNyfBbs4CY8qTUzfKKbeOs1WYpmeaNgdNR/ft0FvwmyOGK6EmhS75oMm8q9aHowKSOoAqBIiVeN3lXIpQEItZlycvQHCsgjL9DWMENP3TzQ5M9nIShARcw/d/oROL2IyksCLBhrpFFlImJDiffog1U6SwGj1iJh6Sp4fjTfRk6IDeRqL+iAVLrmYOxlTKnMO6VCn2tDxJ0CU5i9VoxyCjFcvQHGOQNeRd1/PXlfD7zpF392QX/Cz+YZjdlCKJRQY2WZUh8rN7D+b3PtfPP/zZ3/+/MWXLz+v+Nvd5BY6TNZzHAtUCgtTwKMVDVvY0PP9OMFLDU/N38ehvx7vzEij3qMaFqbyPHhGEVNE1qKJsb4bkqUSN6aIpuURUSLMmbk3o2y7JXEF+WjAM04BeVKdqv4sTx1dyLdH/PWF+EWMfgAkVEG1FVIhfZLEg6NgTGRKSdFP6a4ENx+hmKs0eiOXV8463cpr1zRVy0P3RB528b7lSAvRi4B9YFTJLg9YiS96jTTF1GG+skkBKIqx8UPFPiAuxC1OTF6QSiC3dZquG2p5k63wbQ90aazL+DRzPW99wJ1HVb134VUjLlJ/h5pS71ad537dZyojdMHnoznL1GDZbd3gk7nGZMx9BSGLvGnaVgROVxloh5FSQmKr+fMRX0dHBs8J8+8mYsxN++v3ue3xe1F8GheIBEmMb1cxZisa+MfwwsaPm0X/XjmHfiru2KDxSZIpNSbJwoVrIV6HetbqEaTAypmmr09EXG/TKh7DuHpp6oMUTl+fn1y8vXr/75evdh68u+fMPkJmMpPrldanW1Yf18xVYhHfX719/fr9zfnp9fntTevcpdAokJZkDKIkCECn/q73O/cCjMIkEocVApSEQpkL801hRdqnWkG0acaPzvTfbPj31j4QBn+BzAHgY4s6dPw4vJzT/M0T92S0KIqOIeuo8zS38WOtHRqYsp3EW7Zydo1z6sZ6WZam1/J8Ot6rGZ8K61U+Q70d52otAihgNKBLThn3cRxXDQYvS396+df/8+I34I2XIXJy9mZ2cyP8q74/f/Xt5eV3tie76eKOHUF/fTJ7/fZa5OJ7PTv9W8MgXyMS/G5dubr7YZXWutkPq94Nq9zd2Q1rB25nj8KTq7QAjyvOR+axiO63y3VQD8QpHsWh627UFU0DbYHlR1NiNOO+1RrDY8qBMKlJC9czGUIeqp77kAhhSIQwJEJYDwncm2f/yLRXjzK/2l7T2v1+Ij8+hqorVXStcBDhuKLnkg/fJLpP061CfShYZ7XXY1Z6/abjXUpsS+d4l3L/31a8Swn7nce7fJJanxbBS2VE/W3U+RxKe37y0p6//bqcv8nCljusazmUtfzNlrVMomWMfMwRu1OTIUuFufpLb7rxWMplbl320v2deyQlL0tYude8dGIksjoyNIb0sABdpJkC9spUpCM2chUGjWnXosjmfbAc0h93OZyqmFIZL7sF+xrZeUhxKPZ/f7ItfVQXKEOrww3a3Y+EBdv/2GGdipIwk0u9erBB66A3rJ547Wy9RjuGB7v5scKS6y/q8ejzKB2fesLqg9fufkrGuio/nBnvTiUXbJiZs5QLLlAKFIaUqzfneKREcyN0TZY4lFrneUICH8cFA8/98+nn0hoiiRqh4S1ZY8bROkpdRnS4gLjlEaWBp5aeTcuMbmqOW6q7X/muQGn89NxKGzpSSgjGv6v9/JowRdOiIIlRUIOV/MpWNOYX2VgAE/BYpJQPIqhIKHyqnUcAzKORUMEbkuiPwNgFNKyJMN7I9UfBVUxCjuNT8VyEBduWj5kXE8nMHcNtIUhlhVHAVxvwzNxmIeMo9HBe7/V3RkOlK56qKNep7lbXWG3Qt80NlAVS4JqPVLVimelg/K6Y5ro2Y3tlb9QDYx8zEnfHVndrxvSsuYETlidLLNCrPekVpFLrSOU6VvA6WeIKKn4W8aAa3RutGfNWeI2OC8bek6vZu89uSh+giH0Zbf1VZnapflQCe8pM5dWgUSyYDk5wybEpRwcLfy/h8VQgq9qBVARobay+nNjXM0wtQTHWmuOqQyyIRigEKtmnqeSZcMxMahdTZyXGHl2G5JcUdhpMI4z3rOzOLklBiAKlEj+UMURrtIEYi1EgCXPwZBM2hTc0xkDCBT0GYV9lx0dHS8LNCyC0q4lIp3wkiTmZJ5zGQh95j4MjRpYTFHsrwrEnop6PUEQmEvVQvgLTtf8kZWqflnBVp0WpjQufJFW27oMgzEAYIN1dzSVbblPo8Pr85jY9CHJLynsgVz/ryLKNEMtGwgWOZT/lvqxU70pBqL16Ca5EArNkviacpZnUgNMpnMrHUZoDpKrAn8IshFO0xsEpYnjv2yBWm03E0rpvRNkrpNhBrV3hU94/w+kmixBKk/VJdJYSKqptVgKbSUulD013XPyg5MN1KmNVvpZwPck1BqSDZ4VPS8JxOnhOuJ7LSNAaqABZaTNpFzNFNnM+KdOafoL1Eca4mlj09qkqHkacw/pvUGAh7HBk63BzuWj+DDBpeImaW9acuPxPhLggZsfw38/+64+/Tg7+7dmzH55P/uXHPz77r6n8xx8O/u3gV/PLHw8Onj374bs339xenf9IDn79IUzWd+q3X5/9gM9/dARycPBv/9SI0odJlvxlQkI+ofFEzaJxj6rE67XcldwbppI8fpDlttDauBJUTlrTNiqjWi0BybAVNMSnHhPkw8MRZ0cFleeRytcw0YNO0kEn6RE9etqAQCM1yC6qooPDQXxcB/Fa70v5KJKw/ig2AK2SwinMFpBCJwzomnDj9YQyqnfYAJFw4zTBgFPQF4YslH6TMGEmD4hHRDqWXF4Cylc4fiCsCVFOAYVA1lGA14Ynmyg+TnNDj/YSWT+Ld6X9ORPPKau8tbKv/Jdw/aAJM/mfpL8Cqb/QWfrB2gwLZhPxuuEaV/CqZQMEakBDiVCKjx67YRXtGLU/mCCEF3SNiK9Vtg2tSlM4K3QyE4kR8ZUaAgrHpBEmyGmLrkvhASEXYNrY2rK+NSim6Jnbx3REvkoMKWSRHMIWqBmSAj1Lw8iBloufeUC9uzOVGcDe0mHONpbTCM+vsgELi9EKFvLLJdEuZDQQwBgnPOGYFVZz2grZda0qKybdnh26lNbkVRGCObFCXNcRtk4wobAKU6c+Ti9u9uOhCHmiimL3SZ7qrmZ2BpSeocLdcZ56hjCTzw/bMI7XsMQhjiuJXSzXZo24ZEv+9HmHpWpnY3JIvibhXY+lOlM9zUr5+B4C8bu46oWDvvstNjyDC9aT8tEfuaFi4U3LTVEco81oW3QLiLJR26At+LVjVpcscVs+23EfC+foIoeHOUwqlESnhcknh+fUMraKpgEkp5axA6lal4TLKVzrzVBuzaMtFlkAVzmgHZ/8q7RDdml0WX+Bp3rw1aRRFAXEfgpo+qAahmc62u6taNN07Ebn0XKllEakp/ajgx6kz0Nq1430vzTOYmpVDHWn9M6i66MWYrcWZ7fTsLQCrZ77T65r6fWytelfhsP+Wz7sTlqcVph1CsdWfU4r1LK+p1Wz0wqxRfNj1fG0Av/EOqAel9uxocBGx5V0YAaenmbdslinPDC4jBS5sHPDk8Km08UCrihjRACTS87gGIJfDsWXpw7TbSUDBSXOrejjPumzcl/D3ymBXmAw3QWOUUyoTO0cIMbaxfgi81nuK25Qeh0Mw6nYytY7lfJlswUEeMFVBOuhKYdbMxbTI/htEv+zG4xrbXnTJuAHMGt7l+eUryRrqvFUZakUHJD+oM4YdmAsHXc17sFz75XhTqnOwGIPXMfAYg8s9nDYBxYbYGCxd81ixzRN7SqXvAuffX1Z7KtYTv2XZxGOPbHOSyyzkByMWt/cTF8qs4vLokNroVSUh6w02LN/tkNMibmCaMoESe9GWZJI1QOQH6V+l2Fu1+2qnL4CqylcXN6eH8OLWszEGAoYvHj+XA5jhaqD4gGKmBaUzlmNFyBKH20FOccGgy+/+OcpvJxWkZQnOh3kOfzlKyvESv+/fCVmN326W061nfbzFfHuUg/oDsf1ttCxUPiHeHcFFX3LLTTSY1lCzNfks01yTmmAke1QcBqYKLguM8x6HRZEq8NMTx9R/ynLw5+OtrZcVxzLI+pLES6HkRRudDEwKeGpLw6mORRugCPluVpKJ8FjEgUY/nKHN4fKcxgvFtjjf20FqsqjKOZPJ9ZI8zL/xfzrr22CYhf7t8Ls2MkemlvOc9kNRCCcDFpX85bLoSC20SzzI+c5hXMhfcIao5CpPwnOtQCQTeH7NpJlfnInTDPARjOCYgwXVDjI+0mAD+EqxgscZ39xAo9CHy7o+QfsJdzJTt+JGRRFHbtux3c4tcurNbvDG3Uu5Z/S4+42vVThYd+XO7xh03YNg6GPGO4UllrTkB7sdcI4zDGcfyCMs3910bKoH4+u5zqUXqPIaQ5Ls+WhL3+V6O56s8wkOu9YGnCYOcsDEjg+Zao8ryCCKxK5La6yeGpu8J2I108xU2dera1ci/OfExRMneCe5V4R1c0AEtv4c0LuUYBD+aA/kMD3UOzsvGFCKkyJEySps4fClARnJ83tNEinK1nhxZPJfwRdWtJ4s/M9z66TyUPTdfNvyxDyp0C+iTLNjeu0ZW2X4kWHZ6rmmLlbdKHJqBPMlLgVBBUZtyFcA3I5+g46vJiGDMg9zefyklIWYaowFuaHul6avNKO51/hkIEXRwzf4xie+VTCFb4j/GAK/4lj6kzkQ7xEnNynenXDlnLlFQmIwXN4JkE7wSTrNfYJ4jjYHBjtovJ+mo527/LUTW0hp9j5JL8TvYpPjwTU591JWSgq5RcBIX0jCNOU5zCjdE5Ac7W99bOTHui/i7uB0mRh6vbvmFo4y8WdRZI216qHmHB8KhjbPnab76u9zS5LwOCJb9KI47ACOzDxuHiKTaqmqm28mLIV7OzC/H1N16b1a/NqHTyT3WY+eCZX12TwTB48kwfP5D7P56fwTHbkAdom0IZ66Zlkjc3yftCjHrOyIerksFE6u00eFA3eGqhBG51XOrI0Ca34A5OBU7pIZn4cQDxtVQszEJHjqQxTlzPW6dLEzuHUejU6hlQ3rketj4PhwjNQiJdctUNaf5wLOl6VrV3wPRyHwCmc3lzNcoCGaO3fgj14iNbuQ9eHaO0hWnuI1t5ftHaLSdLVEGlez3pjZC3es0W+SfVp1Pqmfs+fa7h3V7OmoCBNu9DRmFljrGyA7GzCbBeP28yVnY2UBQNkI1hwMU02mx0tcNsMkq7GRgdibjUs9jInOgXjuRkRqwZCC1Cr6bDFLGgB28lg6LDg7cbBXiZBbe5rm4ijIbDGyGeB3Gb+azTtWWA2GP16GPQc9qSD8a6Pyc6Y46yeQk6GulojnBWs3TzXbHqzAG0wyjkY3CxA86a4HZrZ+hjX3HR1Lix1i/msj9HMPMMt1NXFVFYxg1lg9jaQtd6+Fj6vVeRv1ic1QlYZQbsl0ZNdytdb5YcEdI+IRBLonOH4voE/lKTr9Obq9CkDL4ljHMpqQNUH3MbxCDJD3Djb0/wYKjXp1ek2fGV5UU4NLiV5KR0QNRwLgQjIR8DDsTjrINM99uX/AsT4bYxCRkwmVcc79xoxnjlKpEsLPAWGfZWVkoZYHxvrxQMUypvSTlx8xPHE+ig4vFti4qrud4dJ38qDm02csNzMHxBLk2d+nDmsMWOilKcb8iewStYolN7N8sbp7oafF8KHsdOhOU1425ub7vRWDESMEaOh4xxuV1h3SK2p6QY8ZXpvdoNXPaVrwEvTOLooonQoDz9dwG0sGLivUcDwIbwN70L6sB123Op+UFyzTZSSsAy1LUZvt4dYr7tSlH3kBw2gnMTawSJQ7JAae9N02kLv8Iwd6OJ0zZnf5jifK3w6aiYLJOSfvew/8yaWqpwYvXXu5UTpLXNHMTZjfILZ1SVTb51hXXL1lIWszNSUWKzdXi3GW/mSvS9D49XR2b/PFGW3cm7vCk2L1WTNyyDIri7w1CmzMadvVa/WnZkt4CRrnouyEauionnyRjTRQCNUf/vE2GvEiYeCYCPLOtGEy3CDNQoTFADjuNZoa4/i0PTElYyYs6W3QyecwvCAlMCvpwDqVaudiC5XAVGAwhRQV4to87NW96CJTI8ZY6peuFjI6x4JCNIMH1kusZjhQ1M4gTo/ahmEfGpuilkLFGMlFtd7JrRxsRrcsYudwjD2pd2gi/Q8jXq+ij6OcOgL+UafXN8JobNKt9yim0v3sMKCNbaq27wVCfwYh9olTc9Grq25HsBpug/pzGOLdqE9jsmNja5IDlmXlOYaUUIfNMFNC+BgeOoG0K4MtZMvSOsmt3DchXm+Mew1A9TIei+A0TUGHMe02bhIPS+J2ag3u2ZjsEu5HkRTsyXI44I8lvhtiap65PpiJDs7ISTIUHpEUtm4SIH6YWFhNRs+1bO9E2mlGFl7C8UG9gsnT0d/Fv+WzDN/Ffif/x1ltBp5wkSI/YtycaDxuFDrR/6a03PADz+O1GDYf2dq9Ig/7ri0WFpfDHb10wKw4pzaUuaqFWB/DJ88GWpeURoQw0XvtuiV2d+mqlfme3PZK1KoStRQ94o01b0i2fh7KXz1LWVcTl/nEE8HhhRvYNp26TdUaRIpi1aU8ZJnp655Vf1QSxprCkdlThuaXIGct+Sh825+aVH1OcYhRKsNU8x2sYhRIGrs88ZJqFJTxtd4mrBCSzWXkxoYxq/wGF5sNcWEyZd5EWMzzUw3nZusG/YCSgX7r4t/dEKRchRUkVT4kbADRlxAqqBkXL07ojUTDKuwzLPsEBRzOViREk0vw2BTQee6+qGeBZ2U+ZbUthDSqeQQVP10I7c772BOl3CtYdjqstW0cSp4VkFYa0/6I64BNCL9beP3rghLbl3rFBVbpmiDBUVBoLIcxlNbVJHCtvTH/ne8dFKN5KlWy8yg/VSsEKsieVPWcrogVC51V3jfKuM/3lp3Bu9CsTv5eEHh9RoK3Q2F7oZCd4+t0F25og1ZSAug0oSl8Qt5/rf2HkOLdq69XM0WhWqMRqIGKrSUqHG12ncL/hQINSkjbLEu7VZ6t1DPboGtzuGdZroWwLDDwM4e4a8uwZwdwzgdgxzdAjid4/pcgza7h2s6Ygr9AjW7hGi6B2c6hmXuJSDTOaFu56jGlvDLnZRcabfQt4ZZbhGKaFQR7VZm3bB8/7IgiXx1NSsNdar5Zt3UtiI17oFF1mG2K0mjC8/UDrtlMZrWGn7NBWi2LD3TlNrMpehM22vhUmhmy7K6zbd0q+IyTgartumDU57r7kl/ndL9dkv02yHF7yNN7rtFWt9tgjpd8r0/jrzVTm+bS67q4cA+rgO7o+BP2EsAKOwvCBQeeSCo46VrbeJQoKVvaZZ8ct1R4wr3KsrS7rLjWohlVyVYWjFyLruyRcEVe/6pnqVWZDGVRqCdi6yUy6g0Qu5VXmU3vkBxJ76yI1NpDUjsUkBlYCMHNnJgI4cDO7CRAxv5qdlI1yIk25QfUe9Us7Nwl8IjpeIijYFibiVHasqKNEB0KzbSUFCkKfmqvcyIQykRW6B2ocBI5yIiHfgxG011KxnSt1hIezHJDmVC2h3rHUqD7K8oSIslcV+FQPqUAFFZc1pCVfsV/3CzRLoU/OibRccKFNyLfNRk0mkB7Vjeo0vpDkc2p7Vcx74y68C+suvAFhl2WsD2KsvhuBFupTj6Ztxp24iO5TfSrDutG+xaeCOXeacFplPJjXL2nRaYbsU2HHeyY4GNnnl62la+Q1GNXK6etnvlXE4jl6+nBaZbIY2anD3tXhKNJTQa8/a0wKzP6tO7MIa7I4eriOtQBmNPuXxgT/l8YJucPs631kHe6sA2N7uKdChr0begxTYqcbsni1uBCutSuhal2EM5isELcfBCHLwQAQYvxC237B/WC3GLhEDNyLWWc7AWctgmc54KKDKnJjL2btUTs0bf/MayB52y41luff1NL2rpvezPuXcpiwjq4bwnoiiPR426IpslxtUG42R9KToINgZjmucV0ILjGPAHL0j8ZqhZXIdk/uW7FKGYNKZNfnTmn16GHxmI+uh29SZZ57Y0PcskVCK8IOJio8QmLW1FXn4feySMBI/44pkIc7Unz8TtIZyl1+nA5IZvfrlo/qIK1eCGhMu8f9IhYO5Np4e/623+ZeFmW/jPr28M0T/hKugNs4w7R/69eIV8aZqM13UxltmPzCypXcFLL8Y2zil0KQ7EW2ZnglC7+b6L8d7ZdF/Mf5ThmmbaSY+2ekyMOXms5xVsxnaOnYYsWRvHIe3ilWqEjROIzjM1VWlMFWQrWP2ySVWaQlSr59I8l+l9kYN5NCKY5TbMrkZZkntx6xD8nKCQKwcuynBsImuzVZH3PAsZ8CxVJMwPw3gK39IHoXGTajyfYgYkFG+yebPzy5ZGWtuEwsd263vffBeZYZK/Vf1M/m3am1KajPyncr6K/LekCaGJIGldNUBbpoWeyXQohoZtlyP6apbmhZYKtabc0LIpoCbRbMgNPeSGHnJDD7mhHdbsd5Mb2hqQWsx945r92LQvkWH5MoFneBMNvBbndyL5F4YUkFYSWJUibVmCW3Ihi0w1rRN8qrx2RFvznmg1vM7mIf0QD2FFlquJKsXJkvUaxXXTzCXRMU+UmqYK2nzaNRC3JgVTl2zObrumdqYGLGS7VUrBtMddMwmxWudpEmQBUS6J2ulQ/a0xlavN28ymB23OKWVa2NNlmVY1+b5gPymrtTylcwsaQNMhU/WQqXrIVJ0NM2SqHjJVD5mqh0zV/3CZqiUk2PLHAZLkpxWPqXOh1Kan3gdO/8BpqO/lgquwpx2moc7vY10a6vz3+jTUBcSa01Dfe3VZqPOd95WFupQmVQ2ZGmjcMgxX4492mWNYo1SIyKmoEj5a5la15Vrk+S2lbs2f1ZrUrWbj1XdD401OrRxkAMTAk1c/bTatGYIwQCYWVz7MajXCZWHAUZELCMRgKVgGAbnD4OMooBsRG3kI7N6T9tkhpeyQUnZIKftRUspGyTwgbGXdvCvVJvMMEHNO7zOnOiBQ3GF5RfTtr4sGUsnspkCmeCqT5M388o1vE7hD2evYJQPf7CzDWU/Ujnu9+iabj8G/m85jy3y+hm6hakrfJvqu5IRRnX9Mqtkrdk3v/I5cC91zMFidN9zcNhwcNh6Zjb+Hdb/eWbMUtZVKzHo3jRY/CX0cS2+oegVW7ihMOxvaWY5zWrQb2/OM1gJWSGGeczJSpkidHvQ0g34aILKuxZ8ww8tIqt8ro2QjQ1E7i5OrWV53p/TBCyxUe72zRMkoN8lmOiVQmC3UkLF8OykgiAj2cIFLkfn6ZVKERaPfn0k4oHocqsdToZnjbQQVBSSebeLDv99cXhx90xTsKechNQaMKZ2JZu8SbwXKgiPIk1StTNcoJAvM+DRLnfnDyx+b1vBrKhxwkTJjEbXuKUcgl1/p4+VypBB1ZZumFYCI+nraD3K6HN1hoHq6CZaM6jGMZb2LDM3/EQz8/zZ5cD1Tx3csGo0VcikjmA8FSeHp8GajzLbE+mDBJRwAjYEsIKQ5EKGON0njwitI//Dyx0aMi+sFJPTxB3ip7GTS+cw/EE5mAv4m5OiDvHYryho9MmUmDk5hhe6xUjM+4CBI0588oI1SpqmNU05jIpTWeloN+317eXZ5rDATB2oZmojOBQmR8pIrxI42QGSJhAecgrdC4TJ1+V4kgjGb9s5CV8cTW3jjMhH5hNyl8xRD10x2Ty9yJ946RVviG3qP43uCH44eaHxHwuVEHNOJOg/sSCDDjp7I/2w1I6l+cZ+WbP4x5ibGYUdPt81r1+WVe3qjOc5ybxlRL4OmtdSsaW8DUCKEM1+RbBRu9n64xbJKO5u3mWiV5ASFvvg3I4yLv/dex4Q4Xuu3s7OPc+QT0vsOuzJ2N3Lzu/B2qkcx1lMdIc1nirvzf1mIopsV5WFT3JpIoYF0Do0pqNfHJCVakCDAOt8TWeiYfgReIKUbrZ6bdvZToQHxNg750EWzbH5qUqmIlgphCp5Ud6J4iRtyvId+WiKsB+8adUqOpAc6WUiV/qbWvyGVCsua0HqawiDESpyeY/AJU3cWCyYwpixXTa5YtLH7TMHE5t6QX/DxqEtEruiRpuJXHtAKFDD5JYR1EnCRjqgtFvmLFy9hvuGY5QAbjovlomIlXE0BmmWr7OfW9JQdvVT9pPgScdA9nAOWlma0AtUxM4fAKBCeS4gg9hxxA8tcF2kGl6EELdGzKs1Yhu4iFSAZEAafw3dyiaZwEm4gog+y6L4V5kspu+VWVyRFe/mlBgSEKeHDtoZt7lpFAtAWC1K6KM7HbYcXrOslq6nUZo+nEMn1fOPZp6orcRrRgC43retnc8twi0QorVTvBIQKjKOHgWwrGJu88qRYgiqnH7SQ5dL2bEHSHBJxQ51f9C+l7NsoWNKY8NU6/StmECdha3qFX3BMremlnCKifrmnwfc0vsOxe6aI2aXukd+NMFnPJcGQGSGRrzM+YJU8yD4bpeCH2SXbbjqup05luVhQx6yXxU4gkikW3sdMC0YXdWa0yvhM5LySTouqNRCW998EGmpOXTJnahgnuIBFOp2nO8glWJ50OdVUaeYj61F1pJwu105l2GxP8FEphHOBFAaVWeQqPlvXuLTWaqnZ9umF2okuwCSd9TZZitqyRChu1y3HpWzqTJHlG9eMugQGJnh4F9QZdWUArqifPf6FnJ0mSbzGUmbutEBNx7anOHSZhGon9cXYP0vEKdLZHkm4nKm0aurPKkVby1NU+xyZguaxEtHUcMBp+kFMmLVABdFBWNk00WeIE7ZQuc7MToiEzPrNyyk7WwHPNzmV4CHMEw6ES7Oxt6KUYW3eU+PeExqgtscGAFS8TwxrGqfcfg49yUvnwBIGa8p4K9R0r1Ln91BVaJe/LKW1g3ERvyEGfcBkueLssBWsNLwKXl2kNM6htcaYyzzwZgosPRytMDXRWctr+8zcOGNMP0yJUvnUtQKu224VwX9wmNZZQHIN5hsgXCaRc4DLVzFNlmolcaARp4vc4VBB1r5I8wFjtbhjl3MrVy5ZG9OEXF8hpolzb7IM5tLfejSOMYuEu6QD2lFGVm5xvP7XdIhn7CA7GiK4x5wMFGOHxcDyRGYnbtrSp+XpbyQP+pBKA1V20NR6+PC9/Ir9q+IsW4fR9iaVQBT5PvYhwvFEHW0KCxL6NXN0AKtX1uGmulHf2k106VLhpkzm+xMBLbslHMfrQ0CMUY845NUyP+nJKZxGvVtTJyDdlgAAVDFEW11Gh4U4UVDg5wTHGxCqUkDAMM8HUziQxOzHWLo8xNRTNXXu3H0FAECd/fOMuHXpW1qMMiggDJB0ae0EE4Au9LIyvTsF+j7V8bjZXzqCRzGGk4uzdirTi+Y4nJa6aXWEC2pxU0gmHaHWBEsvvcPOMGVGZeUAiMIsr6kErthf1hmmyWctgGrvwY4w+h1sAHDJ+e2wbXdZJnC1f3d40wMmZNnD023LCmhNe0B0zrtY9+OWhNtheWhdau4eQKE+nXdGUPudHgAAqE31PQsP4YJy8Z/zduG36Uec6zOK2QXlEspH30i1LFtvowIjyUqohOo2bXnTT969ltVlSe4FdRYCjc1+pemVmUZVJPruBXaOIaThROZm3hmu6jgBjQsnI492v6OWTjVNJ629YZqVIA7PiNAAiXAP8KV0pBykRZZ44sEax0vcC3DkUmdiZ+/sDu5Ru0Kp+cdF6VX/M+n1mkzSIzrqM0WrWm2XayNZwtey6ni3peleQG2Hp6HK1aopSOIIaxQBXcD/pAVj/rcrP4NILKySMhwrwHlIxvMrN2hH4AK7SuUJFBolg8C8zKd35RMfhMOdOLapYxuM7/BmfFignaPOdGg8C8eH2muuRN4NZ9sRqPTLGEtI46rY0FcQ6HWHenTKXK56SqcZgJxzgFIBZZ86TD3lgG+q/Cs8M1oltBQ7xQ/+tQNkEXEJNFZvmhQZdSmMsfH7fMoynMejvb0lvWhGd9poDNzf4U3Pvb3V65Jzp/DoJKCetFE8MxqZgw5zV2kQimBCTiYprFRP0wGoVDumVbKqJ0gTPHcNet39ONQGrxzq0idXOfmiLmc8TkIZAUpDo4pX5E4SEaMwlFPoAFSum6wfle17TguLVNBSuJEDdtkxY1gVfekiJ1tKry9fvlh6Rl1onCpUlUdWOzabKq2jvd2nrpzUJI/maA+UWCkheyhJVcecMlQdg/xdcJxjUStaUt8emisUS9fxFxNRD9EJsKurUlenpT5bOSlPy6mPWuLRDnfclZQ3GZR2YMbUMqihukWWJaWTMN842FUyY5JOL7RWLneZaU1625lKjr5LYSgA7RSogUgHDxNWYmqeNs6gFXh+hvkZeBgxrF3PzCzoGqtEpK1QtWgrpojNrsAzPF1OwU+wjl2ivqLsOtr24NDJcqVriQgbLo3lf8T68FgQUBUok8isW6p+lsutF4hIR0TCmV7S73XKrxjLrTR+oqnt0A3VQKklFhIoK9EVTpVFtutFRNKdnOOYyXfnUBl4ZXJ7HK9Zqq9QVnSyH+PeWRahinVZXZ/BM8EiBOIYUZbV6hwBOC1WgU9RWsl7bEysylExZUGesYODkbMG2I11s+elKj4QVpatyh45wCwwUE5skQNQuaYZO/SX3Nv9120YoowRkgubskLZUcgYoR1bU3uYEfdnQuxpPuw2Y9jKbOhoMkyNgM6AwdVc6OSTkP101BB0Fjn3YyJ0MA9qc18nsDbTYN7U1wlod8NOX5NgD3NgqylQrkEvs+gOzIC9dZx9zX/tpj9lxusIFlrNfsqE19vM2WDy63xWAWArc1/vDetn5ms38W1ndqwzmRVMdb0uRsW0VzTTdYbZWKi1YqLbHbo581xnoAqS3TTXGag05fUzy/U0yfU+633NTf3McN1NcL3Mb1uYDbqsQy+T27bmtl47vUcz295MbHs2r7mZ1rq5JTiY1YqGsg6wd2FS63wvOnboakbbmwltP+azfZjOOtH7zne/G03rbCrbp5nM3UTmFmeSI9lu5rG80cvVudpqGqtqdkZdSFXVLFZr7OqyEDUaIKuhyxF22Ry2IyNXp9PfzSLSZf12buJwAoiSD9dptm7X0LSTXKc0Gs2E0qQar+YUgvmf+SaLOZ9kEW3AiI/BQ3GWmIrtoiZ7QESOzuPRPpgphwqS6cHoZIbrUE0SAB5rMUIA6F+SsPYcPn0td7NUzWeNPpB1ss6ViNRHszWMNj3Smnx0TbRTiDY9WqMQLfFEDz5JwU/SE92Yh6kzTTA5Z4eD/fs42Nd6P8tHm4TFo90CtJkmS61POgphQNeEy9SXrRJxLieiKp6q0rgw4BT0hSSLNE4VfxDB6YQHbS9gLsBZ1nN8IMpYjEKQFcfWJp92mvpPci5tb/xv5Ao7NQuS/okxGNYpBhrSYrRZVHTuMFCZVe+xsHZZD3XON+VPn2+dRmdNQ9Ki6y6WrVHtAYeiUg0DGoNPmPp3LnYef4hozLH9yAtWxEPxlqlsVCyoi5mxO5HuU2r6IodNwSKiD4RJkJawFg6OU83Ia+kDZI2dHCennUzE6TFhp0o5su19iGJCY8I3pwFirC0DR6kmYKlnIcVCXqrnbdrf3ExnCwjwgitB/hBCmmIInhgICNOg/a3T5Mjrd4aj5kS/lUn/R9olowYSjEoyhjiQm9ObmS3HnPlRopxipnVigSUVGzy71HUUpfdLbZ78zlPV+U3EJL5GXhcqcF3umTdzGT4xJYgtHJYElVFLwyUCl8lzQ53/NSMuH402xp0FuL1Kb+nbOMhrA1s7yGsNuA/y2iCvVX4Gee13Ja9xGuA4X9nO4SjdZn0Ot837lRt/tJWpqJKFR3C7gjPOoQuE6To4iifSg7fa1lC4AbE3XOeQymU24rH0kP5Lavo9xIsF9vhfIWHt1Cc1EUux1Rhe/2L+9ddpS5ozV3qrcOro7XwuOwEJfcGfpkKpWAYFDzhV2E9HrtE+ymgoOynn7Rw4ph3Qs+PkAFa/q5nFGcMF1ZES+BCuZB6k7C+OnlgXVEVV4KmjTcTprXHyCyxswXeZJ6BaKeMJqP6UHmyXhcq8/6x7cYc3qb+VA1jtq6jFyfQIG/8k5QD1r9pfmK7nOsW5A2SFHqc5DM0mh778VaK6yw1yd1cs7NJls4NiweHQyQ6d+U9Ma/OJZB6FcP5zgoKpA9Sz3HOqOhkwFS+TBxL4HopdLonMhC1JHjCqZU5JdT0UpqQ1O1msnbcAAF0ZhXiigigIurOk8Wanu5xdmxssKvh2TvFW7l/JkIpjQl1WkC6yatwZVvBMqS7MHaILQ29TsuRCGXMcT748DBAVPHaQvX4ZfZk6wH21MezZoS5FICvKY35oEv/pi6ugO9IRObw4Uvgex/DMpxKqjGY6mMJ/4pjKIx/ipQqQcU5wYnSEXNfNQgyewzMJGMh6jX2COA42B0ZPoAKt2hfCVT+SP55uMoycWscz+U70KT4VEkz3dyJlbui02aM1l2RG+7w4QDbuqnlm/O/ijCOIsawYrO/vDm97J1bZ5q3QAsj62Vq2oqTz1S3LJEXmxkVBsQBiYtlUU0Q+K4hXrINYyIcf4ViVi0nLJGjhvxb0fAMJw/E0h23GiapAvfU64cKQ0aPGh63YInRWN7SqGdzVC45qhUeoTuipRmgtxGgOpdmyDlnLjc7AuJybcy1L2ih6I5gmxf4RKRirQFxT+LURcrnuqbciQaUYsyjHw1aUH6oRfT0+m26ZI/9U6FC6lGeQHWrXtFi01L6a0pwhXmNdM6hYzlfWY46BhtmlZx4KxPcjnz40PwrZPhwq311hi5X/yL6YjIvEV7/bV7D5ltmd1iZ1tdzzn/Pr3408Nw9sGXSiqyfVfTBE0bk4rizmbi+PK5uU3wRV+YvnKwocKWCG+tdXuio+IocQ0ShR18WYSlQlsaBqHhmq5D7uKrlupXF7F8AVMfxNmSYsKjp7Gf9TAzULFPAxRyRguj6uONNNbxRdFM50U9Uw65EDCBDjVzGd41tiM9AX5vEaMa6ktwcshphj31weNZ1mLtaIDoI6TwSI0RZ8rsD9NkYhk4P2mkABbeApMF1lGGiINZWysdAUUCgZ+4808TVmDC1dZ/ttskbhRDxggi81nY1yU1yKwqGzTDSQ65Yu0nSbOcQYMRo6TuFaNgbCAME8JniR1ejXoyglTGrfGdk12gtEgiTeag9kEzfsT/VrlV330t3V75GUOlvKeAMAQI3UQhjMpQ9PjIW7iK9KkInjnY3akntGF4zjVBjMBJAbjmKO/aeAudd/qezsDcDEnMjG7+qoWJ+8EUBHim6XfKMVYu21MK9EqybeRIIAuqiS/R71KpvrT1UKF5XLL6X4mOJFrFC9yF79rLFokeXNc6pHWrfsjfulq7CfKTJl5RffFZoqltTXej5D5gSLmETLGFVicWzvJUo4fat6te7DbAEnWfPa6oCqZqxiVEQDjVC9NkmMLdhcTyY6EgFANOGSkq1RKFgexnHERl1dH3WV9tbpnKl2Rsmmt0M7ImJ4QEqBrqcADRy45qMEfw1RgMIUUNfrUC82QIPoIBIJKbxVP5X3RZioSUB0QUxTkV3cjLD+UMvzo5YBhX56q/QU0vxfrL7mVqvqR4E7HnV4Tkq7kUtn07fusY8jHPqCauiT61YG+azSLbfo5tI9rLDgkABZ3iCpsIixnAtKZyPX1lwP4DTdh3TmtjRO9htguJq3Ul9gYyErDGTWJVU+G45SH7QHxCRwMOqI0Xa8oYhEFSycVXXVusktzGPRT1u1VYzXSvCRUOUjF0o9hePY4uxCPS+JWf/C5jZmsYFVLEiE8o9GMyxRtQpXrRjJzk4ICTKUHhHZrUqBdl7Zu+FTPS82kX4H5T9VpZ5J6SEeWcdjOL7HfuGsCpZXHL3C35J55iEL//O/o4y6I094EWFfluAXfwC4I6F/DGMVZR0FSYwC/WsqxbFj+OHHkRoM+5ofUH+cTCajJ1v+SADQ78cGIMclXply4p0AdMRgm58Riohe2GNAEcEfOA5VKTxd3v/+xUht1WnCOF0bj2aZRVDu0shos+VGhyHleU+sTA03WeJwKlRE84QEPo4lcDP0/fPp59PnI0hLWwtqzDhaR8eSWI5UcoJjJWwpftfUap/Kv01phEM8FziPxCUQwy9jmkTHUPmugBUOYnnTNiMAkDkCvqv//toUazJntwazEQAAW9GY5w4+wAS8+0h9I6G0GVZ7i/GZR8VdvDBx7P4oZaA1rIlelfsX5g56K7xGx6Oc9js8uZq9++ym9KFZoaWmlwnggIqWupT0SCJcre+ZCiPVWp5NvFPuENokgqdiJqqdDssv8E3Y19NPqwOmslMdaZZB85rQTeFG0LiYaUOwmPE9jrkk7suQ/JLCZsa7ReZxKxNrmdwzRIFxKUGhL9OcxliMAkmYg2eyQtU4Yi4JN9fPo+t1IvIlHMmbJIqI05gd+fgeB0eMLCco9laEY48nMT5CEZlI1ENlx1j7T9J9eDpyfJLkhbDug7gSOm1eroBNttwmQcP1+c1tehDqInzU6mcdWbYRYtlIKEuSSs+PmK4lTBz6Mo+t/KU27oUl87WqJqu9eDmdwmlaoF9zcFOYhZnuae/bIFabTcTSum9EnrA6cQuG7jVzMBH20qtTvtdi8vJWlO12DfEpnOZKV5f7VC9/m/Dk7mDQvWR/I0IAAOBQqr+1SL9tZgAA84B6dzfkFzdO85VpnQYM0qVQGSgwKoyMhFluY4tT2hcvXsJ8wzHLAc3y8wjYOZjaytJsWgEAnctI9pKdvPRqeSuRWN0H4TSeAwQrxGCOcQgPMeEcW1LdMQqE5zLQMJW+WsMxzIGUz1S2Uu3FlaFj09trOAwIg8/hO7ksUzgJNxDRBxWr+1JRmmzVOIUXL79shKqAAGHKfjwd9U8g324WLB18p6PU/bK0x785XBhFDlqvDQAAFP14VLpok9Glpy6gzRJfWpWuRkTd/Xjk5iDhXC3dqsG10bBWrRRdmyxrblXeVxh+CXC+H6BgKWJsV+v0r1jmCLL6xP6CY9riT2GRzX+5p0FLKH5DGH5utRsC8VVye8upVMwFzC7ZzoX69ARdudoDnl4XO8CKBn7hbUr9JzIPplEjCWHCeVpqtzOrU/aY+1kWKSkYqCFaYSobw9Mt7OrlSZZ9kqszrYUJLhSt7cooY4092r1isrlAatQK1kJOK1hrGmGm69lssnG8Pe32OjPDXRvdVORU66G+lc2cqWNV1iyOJ8Utxfn3p5Soy+OaK3/uEENmYJNGotOGnGqjq6JvX1ClQvJNColYWpj1UMBp+gFkbjhOLUBBl8yQRFZVstgUi5zgLOOne5WWzP//EOYJB8KliOitKGXYZMSQY94TKoVzkG6Ydkf1NY1TrjeHluItM5CEdalIT0yqBpzlOFxKz31d6Z8uTHl9XQJE8KaiokjrsurYPYw5y5flzxWpKeQYfdZiR8roBGb8sLFUT+3W2cM3MPemB4dpoC2S855vgHDpdCkVBDFNlmp1TG2Wtuia7BCo+ClflmMZq8UcG9WMGIos0io7QvywQpVnWod95KIirbWk/rU1JEQM/Yzl0lyuyHJlToAhD8VTNd1x9Kg+ZFKozw6LmqMP38uv2L/qVMVGrr7ykEe+j32IcDxRR5PCgoR+zbzMWuwoELS0FR2jTK7TSO8TASk721yWCMuKkDnF5fBVzVlR6z4dyqdsOWMYyqfAVhTB4TQM5VN2fGABYCifkvsZyqcM5VOG8ilD+ZT9vGlbnvWhfEr/dRjKpwzlUwCG8inpz1A+ZSifMpRPGcqnDOVT9MZJNVhH9ZzqlFPDqW0unNyCtm3ktCN5heGhOeyxcFeBF5MXz593ypXT6MLRxZmj61Z1rfvvVPN/Z6lldlnnv1+N//YM4t3r++skFFaovWr7Z5i3p312q+tfW7ffCry1pn99zf4Wo4e1nn9Tvf4Wo09zLf/WWv12yPV1/Nvq9Nstil1q+Hc16uywdv8+6vb3qdnvXmrfrV5/PdPRAtpWq99aiX+0NZtRrcI/6sRe7Mim1tG4tB/DUg+jkvsMobcxaU+GpA5GpC6SbieRytkkJKfpCBTczEHaxOMMtNUU1NG808e009Gs42bSccwuWbO8/cw5vXRofcw4riacrglmCy94vfmmaI7pBLPWdNPbFNNrsbubYPZjftmH6cXB7OKeubl6Pi0ml84w28wtqfmkE1SLqaWv6aSH2aTXuexjJuhuKulmJulsIumpBnadc2ezyDYmkc67uCdTyF7MIHs0gTibP5RRwxFqB9OHYPO6XaBezGCns96hcRdTR3czhyOh7mDiMMYLB6i7Mm840+ROd9idFnUyZ+zLlNHRjOGqvIBaBYbNhKH0BA5g3c0XeV2BY558q+mirC0YuRAcB7NFxSDhXOhkG5OF86l213+7mik+Vr50t6ru21R0twVFbFvN3UX8ba8K2J15cSqa1q1gWodiaY+0UNoWRdL2VPnvkVX9c7rTLtX+hgP7uA6sa0W/TkfWpZpfoV6f1UbjXMmvtlZfW7phexW/x37pWpu01lLvX0fd1EpvnIFTDXWXGjjtd7ulbvo2NdNb6qK3p0V0qYfejSw6UK/eNdCzOueNw++o/nnr2XWue75FzfO2ItY7qnfeumPtdc63qHGuhJMWNrNvfXOHOHjHuuZb1DS3p+4AgIxq9axnvhs6FXcSVzrKKla9Tbfa5YN0Mkgng3QyHNhBOhmkk/6XrrWJQ+XwbaqGO1QGbzET7Ksi+F6qgfevBB45UTuXCuB7qv7dp/K3eZtcSqW6Vf12rentSL9bvZl61fE2Ek6rfcq9hnexQrdjAezW+t2l6twtYHvV7nbcCDfnql71utuSNUHnWt1pJe7WDXat052rwt0C07VGd6ECdwtMt/rcjjvZsS53n5rc7abB/dTj3kct7h51uBXgFrhda3C3VdQCgF3X33avve3KuzvU3O5Tb9swBw70vEOt7ayStvOx61Fn2+nWOjOSTbbi7uU7nCqmVpLy6zpIRBW+WqHQl3FLWTWkU1bq0SX/dlOhNMsablecpE+pkUdeU6S14Ajs4ccOPXeKtEqTwen12Y6g7w73/f38loucpPl6d1zk5DqXGbe2yoluYCtzUlB515c5iZvLnMQpBi51TvKKMRmadyqAhOlgAJOqrJypZ3zC7kACF5vFkrXSVyMQe8p06ljBQRr1pC6DlkIH+Duj4RXiq2OYKiI0NcWip5xylOce1C6eBNW4tlpaasVcIW20OboOQLApTEPyY3ombhgLQ1sF4besM64zsUpZKQFTSy8tjsVXtWUpq5jJ16eC0U25dJULTifLcrHO60oa6BwC5mZPK7eyuqNLXMHFz0qP7b4Wj0a8WIynrtCGIimF7QNADDxJz+rKCQ61eIZaPIWfoRbPx63FU73mMhG6KS8o/q3sAFZCBgA520IHdt9akGbLYjSifEp9FZx+hWiKBWdqIfcoQlMoNjNqkPi7F6DJF5qphdq5+IxLkZm27CR2HYLhC1wKmuQVUroop+luti2rfN5c7MAqmbeUKylSwFPFfbPUP6FasESwO7pmKGEap7rNwR+QMHexY6Dhr3Sx+HX5C4nk/00ufg1++fzX4Je/z3/9JcBPu85IM0HEd60ZMzsDwiAJyc9J6gPHKRDFcG3ynFVXXJSFanblWJ9hdpV7FIAwjxENAmZXaRp3pa5rxgjASyTdVoaY2aX03gso8pVuWap59NZoBZKg1xCQOwwsRBFbNVQGFr1Vrfz0mem6Ii11ZgqL8p9Z222rzFgrzHRXu3RVKplnp02r5N3H0w4vizMxyZfT131Mtffcwhpwpj6dzcWrR70PKbs510Qqy2UVKcyxlFa7uOl8dgFA0rfeczCy5TgVLsdSucYYmQfNDgIluVO9hhqEhqwsA4owa2sDtUd2F8h3KHj/iGCW28RDkZZGRU3/nIhQMukDSZlFMa6FhGzaAmM1axSlPn4MGMZTgG/pg7AcSHOETy1abxJ6QeLjmoNgeMV+u9lWuqysbMh/K4n1rfQCdNH02xiFjBjh93jkUDS92AWksToVyAR7JCvwy9/Ksn32o3g1ZuFnbJXUW6uoW1e6vVh85eaILkZmgQak9o+4pex7UQ/SWOdduUOIW2Rq+qM5TZrzkPB0r6ddkY2wTMN1ox/wWiLs7vRWfT4M4LpyYYZryJcMqwUL+SpTmd3Yzna0PSxgiOG1uBo49JpJdPl6lfuVvM9KBNzqkNNE2uWBMCTczFNntdCNLXBbyLsDGbcAbyLwjoTchrag8DMOKGDU0G9mIeBTWzFRB+Otizt2W4m2yiHqU6utGJ9QupJZhgKTxUpf2vRcsObKEyab4gZzQPeISLqmSnkSZmGDrAg3miJbONkr0Q+IfIZUfUSfLOS6cQWz/jXPvPe7Ujc2kLWBrA1k7VGQNQs9S69pmp2exK27pi0qQEM4fXe9I68PbR7RYq5VUH9XaKqqs/r6WBq2TQjsSbSMkd9F9YsSTt+qXq1EdraAk6x5bfVopYnShgXCDEJ+Q8YETgXJkrdWpsGgCQcUbmCNwgQFwDiO2KhrmKOPWdN5KyVQle2MJltvhw5CxPCAlGOingJIjWGD6KK0jhAFKEwBdX4/avU11aMtmwkKovc/080II1bokYBovXRMlkssZvjQlFZXnR+1DJKIaZutnkL6orP6urCtlaYVOCdtxKlqW96NXNrQvgoRH0dapaJPrpt65KzSLbfo5tI9rLDUVSKLx6C3IoEfYzkXlM5Grq25HsBpug/pzGW6nN6Bvu0iLdSJ8lkXsxOpA6k+aA+ISeBgpN+WGEWb8AsuArDTJlsE4co836i2QBigRrl4ofJp4zi2ZIqinpfErH8sKmKONdivZVMgBVOL6q9tGRpVuUu9V1F2dkJIkKH0iKQuHkUKtPNS6R/fxVD/LZlnsbLwP//7G/M8lPBgNz9N8HICWGePwr3gN/gMKsvmrp0Fm70Ebe6BNr/AZrfAffkDGl7DWPK1VcnJXa7iCHZa/bCFt9wKo4Cvij4Pn85Z7rfnJefuHpe5xI2Kj/LgHje4xw3ucY/UPa7dL66PA5zNTcGSVMEllUJrAoXiTDUiZT2vEQ7zDnGZZ1XtwNpfbvTIEzV0Ts8gtJiEcRx6m+YMPsVFLfcAwtK0DZmvTtFdo8FfTxT/+en666OX8Ed48ROsFGWdY5O+R0fdZ7H/4nwKL8O8I9O0FvYrxLQqbE1jrU/74vk/50wSjcPJoaadXf30qbp2yYlUpzrrlhbJ5qzD3FMiTXMJvRpEc+ahIL/enReG/Bw6OsTp1Fb/kaBABvpLTnWaDyzXtahe1fs9uDgKNmiIocFbMPObKrhhxAlTdEW0abEXtSn27kL6EOrh2KdJI/ddHoUyuSyeqpasajJyXKwOcCoVQdDqsNNohbB+jjvfM+sFa6JcVguGrjOhe0hDgmBCxd9cE5DZr053h9LZlXnRCmnihG6GeLifQ+sVjXkrBmo4hQeIHgKyJsG3p1cgUi4y+Ozln573RwIF3dFAgXKgU/+GUqIKuoDZ1bHArQYwwLOmORx8Er/Sj+lQ+lE5tZ7e779LfswoNVsZMa3kODVd5AHI9D0yaUBuySIcK8aNWzhcIAzmMtGncvz2M0tkNo5JKqoNlk+vZVPt/fJ0OuqUi6nRcTkdLrU6F33qjJ581PAQFTRf3V9lZfS5iuncavOpsfpoJ035IMzVCmb72u4i0GbicXrd3XxPrRMooJ3zVMS60CgNjRWrLdWZjED4SBNvMV2VZvutsFdNnP04LRMN5LpZ3Tmd52A3ZZWm8FYGshxqx5w0AwhS+uFDWCORNUwTT8ZRKKdqmYlCTTF7mY02Zx5LD8VT5j5tENSISDWPfETHBaIxVsPpnDRFUt92kQEAoEK3ttqAZu+BOppllkO/zqIzZkBM4RD5e7Zo9jnoZ3Ab5GUTR9RryO3tJtLGXBkSl1VsrWs8Fa37I9vuzWQlMIrH6OPfZMs6M7jtO885a944XwFV76LVxcFUyw43dlXDo4sCcH894GG1aXQcytgvU6tX0o3uYQFdPXzLrr0QYybCboVpxOsVmTAoWn6Pihb1utWvhSt7X4yLlP4aVbc7i8I4at0Rb4W9O2kbwv7s8gb/7PgS5gTQ2WU1+XwqPPnKA9+a55iwu23OCwkXAVmu+DVGrg7glzq3vwqPnR1dalOZPEHqfNijJjugdbMJvY5osU3o7Rmr74VRwBEtUwlBGhL2tVxr6rvi84b6OE8K9OjmUkgrybfSk2FzCGfY4gPsiNvPCY2TtSN2T/9Dts6l+n1YYSQkOklw4CHmMuuDptiWJO2ahDMIKONAY8Ayg+EUxs/HivU/ViDThBKi4bNGiGux8TDHgKMVWeNYaowYPgAU+kpHISztjMeJxxVgJbcK3BuB6oXOaDEJAamjMpF1RsXGwguDr055YaO9oJKFE1k4OTSAn24nIKpMBq70QaU80L1M6gNifGbibVBJog7KBckWMmLyhxiEasxvew2RqDwSjS3ztLi1kaCMrY0koWpsJQ5X40d1bRs/p4eisYXaq93KS0NAxhCQ4UIthoCMISBjCMgYAjIAhoCMISDj40df2DpLbdgr5N0lUX24xW5G/seKmJjL9dxhxITaoLqICfWlPmJCo9EcMTHPwJbCJtIvewmbENCKkQnKu1HajKQCWI2viuUzTkLs10cQiAWdKhAXRU5GbUcl+sAlfCGPnkaExmklUB9yy1aPkGpQi5DaryNTf2e7WA+p8C5iagvx6BXcscvICjX5msgKhXttZAUaYisAYIitGGIrfguxFeqG22IrXhWvepfkwrLnGWbtDpmv0qYGjRivKcfiqYoxUyoVBU95cCxqFWpWfjYj8o7oXKBM9Aq7PjFOKInSAIFIWdJuwTYtDUKLAC2BU10+XEHKkgIJ7W9nbU0U43sxhNMSXeUaZ8W8GZfpcQLMsT9Ra/KU2VMNhah7ZmDmimUZw/w2Gg2LQtOGpBWXjJlpxeZd2rQOHxtj1ZRCuobZckC6Wes8gVpWyHw0617zqZang124E2sSlboTpw+NgKx0hOXcWPWOOE18l2W1Bnl2f/Ks3NxTQy0aRdpBnu0uz6Y0eOeCbWm/6iTcUpN6UbeMYbPMW2pZJ/xWm+xFClbkW9Ns4zGZCb4RjoWidZB8Uw02sETm41skwqrU/M6WkRJ8xE2I6tF6rT9+PCm4dKBrxOEyz1PPLVfSDwxi8SAWD2LxYxSLS1d+n/LxlgLpDoXRHNV18qhuEq4+nhgoCyS/7oL3TaVLGXsFVE3iaf4Be9rgcNBlnvuXJD+eFDmYAD+NyHSNJaKWZGuDyNRdZIrVqu5QVNL7VCci6U/1opHBpFkkinOQS6JQ9mnvhkBD5jSdkbykukIW1l43sQgcHeWMd7pWVJjWnpIDfCqBrGTwK5VAzbatis3jsPjpk1kj42js621+RTlnEG4G4WYQbh6tcKPvuE2ouS5d9g5SjbRE6f5uZq30LhsXzhwNl9suQR4pi2Bnq9YafYgxjzeeKKzQitAb9EHjfi06nYpOZpEqea0AcY7XEa8vHWAyT6TPkIrWKb8BxVk0BQvk3k2HIMW0rS6Aonv3ErJ035vYcx34JvbAQyHMMZBI5qsBEspwF+1DLBqpnW3cU4A0f6eScjIA8jS0LmTjbJyPQu4AlOxL+ZA7NRl9EBoiAdMDYPxD+54C1lCp96Pmv7lRNTaLFjednRBlXKH1sP+2E99oqfQ0QMwlSiBrnF82I7AXvmdHypL35qPpFWTB3Gwflbu9LEtDwZOyRkRlSoydIWuzTNeLDqWvN7H3UY3ThuTtzDpdv/3/KObp35rqYE2WKv0DR+yOWbQGdn3BGwPmFrG7ksKg8K2iMSgi0KgyWHMDONMXFLo6KgyMqImCaIW2FTgLM8vfEhRmyEGK+SBCDiLkIEJ+chFSiY0izl80JYu0crJ+wKq0zE1ulFqmK0rb02aqEfDpje6QJd3LxwNqdCRYiCgNGgp/wxxralNrALGhDAAQ4xA5BvXNFlK8vjqVAbaKF0DGoQQxEF9QIOLfNjoGvwEqgIzwfcBSzFIYyN46x2tGPBNB7uEaNwh+4BSJFnkXrlO8uToVbYs7kndy1EyG3BDwAkTWzXNs2xrYKoJNnzjF8XY6c6pL+6mzcMXbn7vo3nlTrt7Z9yS6B8QY9YhARZ5N27kr1tbY8yZtw5/fpNHYvUmSse06JRk7zbfOhv1E5cHNlFWiAuzbUiLVc2XlnsUDlFbV1F+baupiJNMK4miLVEzNKJVEK8VQZSWIa0ED0LkQf7JnCxVPSOoZ3pjVzDUjqwrh9k94h4Smuo85RAY3fFO/hu4HyvFYOb4KXbOXds5A1wgWRG46pZA1uybWppKObpu5NWamqyOvom0u5RDJ80HYoPaAiJijrTawjBrHPuQdCj9ebtYsrh3BPCZ4kXG2hTSrqWXQMhMUbmCBSJDEWMovhMkcRKomhknyGqFYcieiASf+BnzCogBtLHCJzu78erZlxtSYd8gEdGPaP+77KIjsFsn/tshC6pbHseaeIFgXCK+6ujHH/qHxHW6yDejL0kf/TzBzUv4TgWRZ4y82U/3t73Ru9P5KTZrOpTHBiyV/Sovi33Zkmw/rp+NAPr5z2EfVTEYs00Se4Sigm7VQIuSlbeNbFHkTlSSWxvqvUruWqgRHAAGaY5N2qr4b5BSIQpEQ0RCHfOLQONVpvpz+efo8VUsyHOCsEMkacW/1OodFMx6dMDGJ6Y7hhVxZcRiXuoKD2uNrrNxIRgDiJgVp0pP8UgIU18iOX8e1sq8XlFQiujbJiSdtiFIEM70na7RBZdiaZ8ZxwafKhgsAWaMlvkqC4IoGxNsIxcEF5VeVbNuyXTr8UQHccYY+AAAADu/Ll05hcXl1fnH+6ub9xcmb85urk9PzQitQermvY1qTg3FBcOBf41rLo/ymfJvSqpHp4bcicnN+/W52en5yenr59uJW4rVzlKTvV3Uva/ESGpv3l1fn1ye3l9fvry7P9oNSYZUKTZ+kSzO7fP/q5Ob8/dnsOm+f82i4IMskxjBHDINPYnm3N4LKm+MBNIQVZRwixFfTEvzvVzjGcBnh8PzVDXgoBOP+YeptkQCzKZzhBUoCroYRgFInh6N7FB9lFC0PvLy/uUmUWuplPIZxHty4eTFOb9SWXL5+f3N1cq2AGt8DszpKTakkBrECAu8SSE7TDmLJGLo36me2QmL+4hrLWGHMH7BOrseIj8FDcXm+prKJ0nFF1Bc1fQiD84t3QBiggNEUN8MNKemVelX24UmqORP8MgYfizPLyjt4K3VQanfEBNUIhBU25kjBKHat2Z7aZS32qt8qPYBlx2ZvTr45f3/19vXr9zfnp9fntzf5g5zfKkndIEqCAJh4JDhL3SeKy9s6neqYTXMZWwmTXJmr2fs337y5VUDriMAxjDOKzGk8EXhO1ihES6zJssMw6QZ0G8cGv2GA8/93dXl9e37tNNJ6gj8Ihx77TK7Pb/52cfp+dnF7fv3u5HUDzM+ej7firO57MVb3Hfiq+72xVfeduKr7j8xU3Xfkqe4fD0t1v1OO6r4LQzU80/Zn+vbk+pvz274vtKwy0PhMe9JnPVlH2etrCh1S/yO8ldncHt0zqTPFf8yHchAl1tjtidfnxv2RJ4wvebdX/t3l67dvzrtyLer6uPAt+RHNWJcXMzF0P5aizBSk/MCNWuUWZmCS1Q9tZQocX7UJu/fSN14gW3KIQhEZAQCoj8fwxZ+/+LP5Q0w59WhwLIpy6r/lKpWapkXGofYxY5iJ9ThZSFe3zTFc0BBvw0GZR1ZtOPLXRA4g1w878VQoMgFxue4PeL6i9K5da+XUrYHR6sQEAQifPxIulW0t9eFr5dZa5teNuWoB5qC6cu76ETmuZnxqFFRiArpZhZ9y5dFQvGRlTdZECNUBXXLKuI/juPz5/quXpT+9/Ov/efGb0o6dnL2Z3dzMLi/ef3/+6tvLy+8aX7OMtjbc7LHjAF+fzF6/vT5/f3X5enb6t4ahvkYkUCT7iVJzrFFIFphx8CXtUbYSw7NK/xlAoQ+i8nExWX963tnh6Ilykcq5pZxeM/g/cP3q5BTiRHK5xdHSOiz3OJb12EdPZBY8BcgniwWWNv2AhMkHkT3vniYxk6ioP/mE8Ziy0ZPRE/iaxsCSSBBzaaHU/UPM4fJGFWURHKnSA6E5vccC5RcH8A3mRe8IpT5SlgzFnue4dRoDYR4jyF+DqPIcb5SFE8lh9CWejp7AywM4FXVAdIyrgqNK5it3Me0Fobprzm/iMTLxY3KP4+wG51t6jMgC9YpHfgKfHcAbQRC0qUpjKc9OuNSDklCqvXS9VQVzOuoXMJ7vBLaf5k7iFF2IKYh9PFNzPb0+Y9ZOPUZy/vlNerHnqpYizpG3Wks/2p1FwiuHuZMUdF1IfLlNfWx8BcnmIPl7VBcfXwbQ0e99FyHW5ZkWPN/liRZyu1+Nnxlc3wfX98LP4Pr+CaKny9fXFkat2vaJopZ8QXsZqJvTm9ksXNDUFx84VX9NBU9dbVscAhIEDT66klMihTy0XNdWJgJaDwdl8rNbPZXZz2FKcayVs1s9mOSazQQNWQiZ1WnwQpcd4REkbjN/+joJC7q8IAmNp9HzQ3gxnQIN1frrwMwmb8BnWp15DM8PnvZFO9NKoMAJ/9tcBx1oLmaRKhzl33expFbXtHs3B359FdN7ImhVj1ONpFOaqILJnNboJG0vX0yNrdLHiyXTRYREI1tBPSgmiW5oZfGnhg712Bu8/LLp31qqttfMXjSvzj41fROHmWXZFBKmU87APKDenbaHA41hLWQXVfQWQcWE0GEZPBQhT2jYnIql6cZOG8TbC6SJ2UjFgFthNNEcRHtzstOqaPKLMv5k+Zl4EoeWeqxp1XdFdAK6JKHomKXB6zuxhfuh+fqm/sDkKaVyX5TDwqRxOvgD/9yQxoND+LBgAurVu76TkCfsMspJYi1TeZPrUNogqv/6QNIdkuAbJxNi7DNtAkvdTj8ZIXAOestnDBNnarv7QR9CHAuBf3bmNPpl1t7waOI3UWQ2vRXSIUd8kcDtBJiwalqwThMQ6prLMNi4lpqTjXNHp8ij5c+EJn+N+JMwg2d5adrLGTKOliRc6tfflVTdqF6ifZFUlt5CSbFMNjdrHJruTlie8kvlWUj93hvEu00rW4Wdz2pOQn+HU7OXGp5AY4joJH/runFntnQekltvzNWx6zwdFanNlPWX/ymFken4r1Et/c9VeW187z9+uo5HlQPVQd1qaX5jMghpRS50V+ZuocYdPUoNLggsJigS5aaxP83yFU0JPYZxTuWySuZTj66PsibCDnCEPyjV2sTkZ+I4PhJeI0efv/iXsYOOWJ1z09sLEGOYTc3vU30a9fKUNcVNzWoUxuqimjMgsyKV1MU1LSrK4lpsS/rgmjaZNvg0SBiX3ECfnKm5vJ7KAFPJ63lW/rNLctEzzHG8LqTKQ+Xl0I7KJjknX8U0WapkAzULZxS2cww+lgGHSgYgnMGcJqFf6qSyRVciE/PzFV8JDZUVtzrvps8N889BTg2llaNaGeVkWc3b6meBUDvS5teuZ8qpRShGa7FhTL3cCJLQx3GwIeHSEG9gG8bxGmQss1x4NTdpc1TnM7s7cJJpL+qGNg5ic8MvypGISTVGwsphSdX3NeC0gTGk4STMW0baFCUfxxixG/PD78PgULxx1lUvNgX/UdETuFEW93St5QEcX2OOSDiWezOW5AOPp9mf1xiF+obV4KSxF30FCtFqw0ipUJ7lWorx73DEp+nAex8uXYxrzSuWVb44TNZ17LRCsOaDWijnwyTfJfshkk3qKtiZ+egWcpVWKPQDzPKyc+HENM/0k1jYdmRT+/1Y0bJnrLzkeUaoWWneCLi0Z9k4auPu8Gaidm2NIqUlKx2v9DHMdV3QOHtCza2TvkKY4TxZoRH6OcHAKXyX8dLbxTA3cj7yYz2/5yzkLVDAGpMyDozp74wxnWOOBu504E4H7nTgTgfudOBOB+504E4fM3f6CTOGD6r6NlW9IvsfQVevBrJr61WbNn29RtmusE+H24nKvugWWE7mx3IcgYq4yMUi5yokGL54aivyNZUQbulbVi06dl33qd4mPyk7CxRtmDqDYFqaoziNEOYbjlkLmnJSot5HDZ513+qTmLULi3XPrwu3IubUzLHsXISUob47liOtzjL6LUkTCEg2V37tvhzWOe1QF5CfQx0Pzmm+MGmK/hwHNFyyaWutvgI0S+G+qglxF3MwHFhxFvVHVzcl+ri5T+0aL8pZoOpm9ruynuk1K3liII8nKIAxDSc+YXfj3HHXi9ty6gedwD9YiMwgIpUWuzWqRTRIT3R2SeSBbdG3zDctb06j+Gzzs7erjHqojR4dezEok/LKJBFt7W9CtBYAgo04G/fE0FQNjukwJhk4r5wrUcLpGnHdaY51fJPibVMWKidEASM+9lCcld8YF7m1sQavLoOfRlPnS53Va4nEFKIYT2TZjoJ0fwgJwzGDN29vbnMu7NlUCnp1MZyVm2i+Uc3qN6sKzqKGgzan0np1XAeV3M55XJUUQK71HGcVVlBuaBMEkT8k32B+FSRLEgrG79kBiCOl0yghrjG0r7x1ndTz1LpOqlnOSJPRr6IE+ozGGfU5aLw7NC6Vk1Hswt8Txo2aiAHKqZgqb6fkXPSiquNKGJD1OuEyhT9acByn6gj7ArUFVpn5fSs1sk6+18UupfgKsa1j0+I98cfqPau9pO20XpwFxe+3rxc8oMoW6QezcSmnfd3VleDQYdHyHeqWTH03C5bzSJfcklmDdOVyj2DuFHJ0h0PZY9czbslST0PcXAg1VY3CD+Pi2Rn/6NAlv3I1HRqk09ZbH7bIy7l1L0rM5pjpyLjcIbugXJeeVDc0JQqiAEXjQII7zjEzNIZYp1Dyy0lUGOYCZcW7KKqCZJTY6tCcCM0nx1h28bAPEWU81WlmVKNfNdOcqNy6wpUepXO/Owm/1GwqYsOntV3F0dC3Yq3osV4nJe81jfhUm8tNir85UVkkpXJTRoxILkLqJu9RQDR3VaJ7tskcqgHElqaWdbsqxLy28pT5Oq9NDpUViiL8kV6RZlm+9mScXM2M/J7VX1VnvDdFzlI7OcWAzxZqyFivFoKIYA8X1AVAQsYx8vUfcchJjNP9UlRBIpRTJ3BEQkDqEMC/31xeHH1D9errcGNZJGeNQy5rbawAMYGaWHkRHIOnJrXSNEv/9cPLH9WBwh+QUC8fGv14+hCaU0yYmkzaV5IQiVJEfY30g0RWPBZANbIJhoDc4WMYS71YNvT/iNP4v2N49rDCQlYSv47VgKmOJE9Ks4GVBBOT5RLHWIVjYSFUHwCNBf4hzTUOdeljQyH8CiI/vPxxDM+K8wIS+vgDvEwLEEXUP9Annm1Cjj4AYeCtKMOhyrUg78U9BkZFrBgOgokROh7QRpWBVkupvG0iFPOSBun28uzyWI0mtm0ZAmEQUg4LImiBuMC8cONYkhI2b4VCWXpJBdMmQt8w7Z04oE6dY1HrlC/aJ1SaOE/ROdz1aV51bJ1iwTB45FOPiQkKCyk7ovc4vif44eiBxnckXE7EIZuonWdHki4fPZH/2WpGKrej87QKb8E+5ybGYUdP+8fYKkmqy0vw9MbY60u9S3xAgcKtka9IIAo3ez/GYgFlXKK3mWib9ASFvvg3I4yLv/desYQ4XuC3s7OPc7gT0vu29oxHtThIQL2ThPnQUNNpUmU8dxjVao1clbsRrzPDQ2bV71L+MmcpamWy842NqocbE1OWWELq2ScklDWnCkoNJTO2a5RnoRE1oSJMgIcYrmoK++kGDdD3AtVxaoHQ2iMplkmJ+VS0S3d5DMvrq1OpQarlvc2YNiw74ZBK7sKpwmDBcmgYHs04JinVMwPCpzBbQEh5xu0cAuG5Em3ySJm15Xpnk/AupA+SoU8TcdBFfjqEAYK3IfkAIQopwx4Nfab649Cjvs7gEgIJ+Z8+n8JlKFsfGseFNQp9+MlHHMMf/5n988VPesrFM67wCQtjMBJ6GF78y5+fT56/mDx/Ac+fH8v/wdvb06mlDprEpHNZNlmErvVqyFbmTgSIcV2AFPv6ky7bXDnO2dvyNqKhycKiBaYY83ijT5ECUzxLXoBRTymqpbJn8UnQjQvWOlXI0yi0xZ4nIZfct33CYr7pWZzCxeXt+bHBxugnArpcioOqLA95dYORehgOGeHkHufJYH/2lhNH3o/bSZ+autDa5BakScx0LSC5jbosc0NqPcJZ03IBx86eUR+PgEt03nP6PmGfin63o7AF+T5UmYgfSMOSMcyBUxjfxgkeN9B6Y+TDcvNIiBkDWt5XQ/EbD1hTupicB5nDEUvbQtzHl+0jniyBx3s56Cc6V20IbMUUfL/Cod6OQqyPhkxYAdniruimUid4cSn1gmyNgkDpYUJ90rKdJgsgvKBwyZ3qXGttWRHrIQoLO3AuiGfI2c9wGw+wJiFZJ+tjeN69bmurVctm0SJWU1YHq6jLYjXfnBVigInYFLn2yjagd42rVH4QxXQZY8amj7hMbGMM5agkd3WLqRw8hwfP4cFzuDSXwXN48Bz+mOHNg/vw4D48uA8P7sOD+/DgPjy4Dw/uw4P78OA+3E3ZMrgP/6O7Dw8evIMH7+DBO3jwDh68gwfv4MFr0awMHryDB+/gwTt48A4evIMH7+DBO3jwDh68gwfv4MHbcC4HD97Bg3fw4B08eAcP3sGDd/Dg3UGpS0cH3iHv9KfNO73/hNPWTNMtKabtuaWzpNIX+eoi/5B5pWcLQBDihwzXfNVpbV7Ub57m32tqL8ijD1fvTntYQy3erwruNMKx0qBxdQhOA0TWtR6+N7LD1bvTjn69swK9LvtF2CfvZLWrOidWGAuHhbhvslQ2LERd7nb3RSkFDuhXNuUmFDLpU6DO82rjx4jXsSLTvYQU2GdwW9qqOqu6duHMXH6KjXbhd74fj/PSAcupwZsMwYKIhfpuk1Ds2BSuAowYhjD1SLjHsVCzp6pJYyhWztbBJnMO8lbYE/phmFO+Kg+JQr8BX04BhyyJseqHYq10lINwwMhbKQ5zCq/02MaCrXDDPkQxoTFwConUPBjtlja9WU+adAR4536RtrpCt84K1gfkrGG1Ti//ilcmc1r/seLJ/9tKLq40WwnD8dP0OguZwfDEubJmDYuvnL/SV0aTtkMo+kk0CITTIYpgiCIYogjsi10II5B6DeXJAt4KxcjjOBa3yivrtgqPs7rjnc2ZmnoeqUudij/sSUlkyPyannawwW3hvSsZ/+wBMGYBxRbb/SN36pPVyF47KdUbe5ec+PJ82FVdn0ZO2dHFtOhFK2QRwgAxlqwVczpP/Wmk13fmPIcsXoaFHcjGYphXZDyfYuXfk3enrhGm9uXmW8PNdPD5rendsIEdfBWLe4nCTOip1Bd1XuaicCYXWTpsqZVG5SeRhPkia480XXHjHeqQubhmA3eYxvjpu/qOdXEbfaQsaIJvjIp4wSEkAXCa6kUzHbKPFygJODSVAxX6FlHCdknucQieqiQmQUsfv3UScBIFGRyFTBEcZsdiQ9M2EY6l4vdMx3/MqgFxGUkwoT2oCPOw1EFJ7dlTIAQsRbwWZJnEGGjC4cHMGjFGPSIfiwwRIJry5NelbkdyvbXZAvE8HIOEWrvZAtY0lkseymWoAymvpOS8kV7qHDzJRRZAyLWfYxzCGsV3yudAY3wIReNMZliQcJY4xFLNgELlKjqF83XEN/qmGkdLFAT0Qfs7Z0ab6dPGm1x70W0+SbWeRZ/CawhOaSheupgpd3UpKW9S6WWO+YNYaGchXWHPlFuq9GpYJAE8m2+Uw7KJR0O8o/CveOCCmH8Ac7ygsThpCmitIN/GyNjF+nb6Zu/vQOYatTGVUAGbOsbValYPcYWYjuvAYW7fRACkmJ4SYq14bzA3bh23FNA9JTIuhBHBdDLsJTHhGyCMybgS7/d46J52DXj5XbvhZX1Tg9Xggse1mF/mQ4queLRqBHIwctsciqzH8KP5uuV4aM9wWCscROKycgpJFOEYAqFwyB0a9oxM8fQQUBQFRrucfT0QHX3sER/n41o3+XgZEiYYZAAlkafEhFilmBakLJgjhn1jehcLJ/ZDTTHGKrx+Crf5/oUTHgRwh3GkvPjEcPJqolCDoJ54k5lZreJNSaXyvFfg4Ak4eAJ+Sk/AXvboj/OKDH6Aj9wPsOXouroJOliQFfW2ubR9pDM5eBD+njwII8Q5jsNj+O9n//XHXycH//bs2Q/PJ//y4x+f/ddU/uMPB/928Kv55Y8HB8+e/fDdm29ur85/JAe//hAm6zv126/PfsDnPzoCOTj4t3+qQebDJOeaJdh8Gk/U3Sq4wLU8DEMSzUZXksFNanCTGtyk3GcwuEkNblKDmxQ8Pjep3WdSHXylBl+pwVdq8JUafKUGX6nBV2rwlXokvlKDW9LgljS4JXW6U4Nb0uCWNLglDW5Jg1vS4JY0uCUNbkmDW9LgltT14sLgljS4JQ1uSYNb0uCWNLglPWa3pMeaGayY0+rIWGzVKKc3M10oNZ8JTNl2PdFz6jEypREO8byQOeuJqQDH8oZWEgYkNOdc5nFT/3xNFtjbeAF+Q32cJq/KzAbyD0/ggkox2lw4wYomHJDvY130iVPzDZJoGSMfGxIEUYBCDAyjdYAZCzZ6jPNohdc4RoHE+ZZm1fUAcYiTUPIzSuGwpn6OfiZMyOkR9SWDZerESTBjsSildcVmoDHgkMcbQDGWSmzF/kTUn4ULehm+EUxReloQ58hbGWuP8W0Te1jYQm+F/USwmpXMblcxoUKxoBxSqpuot27iMTLJ5XPzYiLrzo0kfTyGf3muf0bLgM5RcKaUiQahwjM1llJMpAcGr0ZjnEoYpzecxvkqaRkO4OMooJu1VF+EwWY63te8Q+rj+hm/+AgzFqM3zNVcQqVVv6YB1m4yhVWI58ibooSvaEx+UWx+thq2mXMay/nnsulNtIZppAt5yLs40SRH2LyJh088ybiPAJpB5o4SQ2lLVQvCNB/FNMC6tlJloo7oxqopisg3KlVf42KMGlZ0f0spcYuTAOtFNEgKOjzWce3ZM1AIodcEUje6x/FcNlhiPj6EcUCY/O8D4t5q/GM/4J6wwfYeoUjc6sbTLTxl4NnlTKRZogKxCOpQK6+w+JdyrBD/irqMw7AXY25FvW5l6nNF1g1QdEjYcqX6j6tUuZWBs/WrRaGwrqqe217QOlK8UBk7PfYuR3Rb+mzeOx278zQ9SmOfhHkiVTeM9Plsmlk6IzNFvY+FvdXnoAaFupyydTh4Mrus+ZOfZpe1HLnKmmeYadzTlZF0/Umnn3zzeo0SWJqfZixKJGuq2pt3ge7wMxrVPsb5V8zxrSq/0TUvtFzbPT+ZWUHXHk+modGHME7R70ixYTyGKmQYR9RnYyiCaqCF8GPfB/4QxkxtpDP1yS5J3W2NPhJPYCWD7mPZyZ5tPp05kEMplglu+9FzI/t5wnotooKvxNA1Du0j7OCY7pyNKa7Ajt/RP9RN4Q+1b6CYT3UxDyH/hY0P8796NFyQZe2gH1M8zBPo34B4WHlPOoiHeiIccbxIghvMSzn0I3bU+XGtf1kBAjTHgVxARygAmZ5N+H1HNMQhn3Tvm2bmfzn98/R5qrJjOMAeN4butbihr3M4gpi/w0AAYtVrGhYCQxzA9J+tflKlexKMs8ZkPAKIsTSTs2MQYSkcr6MAcW2bye8sQHGPuqxA2xp0WIXtdt2+82Vf/iivNLtw0g9meu/s6rvdeMgq4hbibI1ymUxiLOwP2UQAyFqq0sWVXXqyyoQIcTAe/7k+x/cvpi+m+VoqKF6yskJ/PJncf/XFuPpXAQn5fowZ++qfnp2cnV2f39wc1DQMMPJxPJE3h9Aw3wKH9+XxdOCUAjcquQ8rtePRPYqPAjIXkzli1LvDnB0pMSOK6YfNkVQtU++uvCpXSRCospIixvWC8itlHxyVPZSlgpnVo6YGnPikXGVmLTqpGLFWDGv3MqcY67SfuX7H959Nn08/a9/T9t0b9iaFqte3jnC07U9NX+c9qr93pev0ldS1jzsvZ/7g5diBTgcv1+/4/mWJmLjuau8Dal2lBUY8ifFkiThmX93SiAZ0uflKmIxqWuMPPEYTxY5PzAPX1HiNeUw8lmJ3/PLl8xcvahpyssY04V+9/OI5q/msnc0nCyaspF/hD/xzp/v35m/vL07enNfdv69juj4eVVyEhKlfc4m139TFSONaQ7TGtSPv/OZ/SmqrRIyOJ950End4p8e97xv78aj0p9urVGqRzar7pTmpo6ylMhseZxxctz1qXNTLq/OL81c3708vL26vL1+/Pr9+f3Y9e3d+Xb/Mte9FDbSb2fvzi7Ory9nFbT2gJCQfjo+O+mxb/XAnV7P3b69f149mIkhzLgvFdk/gMsLh+aubTGrTwZt8hc12qI0Tv8VI/CPly1kF2goxFTRAQsaR8ESyTkBQv5urk9PzevQz8bEZxOzy/ezi5vZEbuHt364aQClNw8TMYdwG8/zi5NXr8/cnFyev/3Y7O224fePywyJoAtQQBRMg/dU/Pas7K3WvYRIHpdZ6q+saq6OTb18+1wfjT0YB1Gg1Yk/tIFgEYJ2RWLoGScWP3UQgvAsmcKLJOTQaQbImMsZkjkHGGnMKomq+cpi7escOpYsCy0WBnGQarL1b1M2r9LHM6Z0V21tplKuOQvXKQ6m3Fn5Ge7TYN2gnO6jMbWsHZQxqjS4VHIyBoGKIiYzlpd5GtRdNaHoWfwNq0OK9eUQuMjpGeBLjJWE8Ri032/mGKL7IYlNOnRQ+pvK8OtvfwNlp2KIuh2gnpnhLwwvq4x7m916G91HZul67WS6nQXoaWo3t+759jrfO3dLZbv/bkXFaGfmp38lq3jjivo10XZYlM5AadD8mhdo5ZcoO+S5pUn9apEeR+/QGRX1cZQjzGEH+uv7imt6m1TH8qhzW/7+jOQmP2Er+RhbwA0w+wNGKMn7ExBfTAX78V+ArbMR/bxVTylVDKLUc/9P/VWILDooAExYfBdRDQQfQTX0aB3GG3BXnHig3DcFwXcdqywUpXLMzhNc03MbkK869i7G3wYiou7fC72gQrOu1YwNwOkRf028OQJ+57cSGm8Ohv/W2AKTfNu3UYluMbHC11eYJOIC4PheYC5VAIYipzXoroSimPCPenRTStRB2bozZ0gIsAs4CzFMExfMziRBfffVPz5Se6f31+Tfvby5Pv3t/dXL7bQFGYOKeinhFMb7hNCr+EQB/qKbdAxAZAFEoq+jp50ZwEhNP/H+8hkm8gKM8ckd1cVsOTcQMpQJ2/KOLOtmuo1ecu12nW7d6LSp/vRkaPKudh33Q796+On9/cXl2vh87lEwmLM71RZMdSo5tU7w3UyUXLaZamRYtZsUYkfUvHHM7lHzTLpYPkwtH5pj5wMtXg9yTAC9LUZYAAAAeitCcBKQuqQPy5RU5Pbl6/+a7i8szcT3ELzd/u3l/cvZmdiH+kP3yY6G7THd1ZYY+Zx4KkE53UMRhX1abBtoltYJ+TsktT8/s7GD8CBTuuZPsZtYrTeITXL7uVisXQlazIPVAC0zAYN7KRjkPhWXi6IwwJLOhJlw8R5LYgPaGOASZY0TL6BUIIoex4KVkTpE02cNEBFvKwOv6db8+f3P59uLW0dS1f+rrY8G6tfT38X394NRnE9nQ0YhlXtPKYqqwZWBUZQpA4Ub1Z8AwTyJxFoiPC8UUcAwoxhVIIgkPWuqsQt6dyUwtRbY18lYkrOTqVJjqfir89hXxSaxchlBQb8gUECdCHrTMW7RpH+xbyvgtPTXTqh9OyZ55vUHToAXJu9SOJXPVqvS90XxYez7EtCSY4kNaf1hUUoMzuZw03ow6vP/WcRo4tPca3ubIhsVlrNKBjJzuUx886jnFfjg1XbNOeHXalObT5hmtV3FQ7Z4lsi0cw/Mvvvii8NVBC9Z+uayztc7u/x8AROp7D8AMBAA=",
+	"openebs-operator-2.8.0.yaml":     "H4sIAAAAAAAC/+z9e3sbN5IoDv+vT1EvvXtszYiU7SSzWe1k9idLSsKNLOmIsudks1kF7AZJjJoNpoGWzEzmu78PCkDfL+gmZSsZ6plnIquBQqEAFAp1fQYnESWSwluyJjCh0T3zKBx7Ho9DuUdW7D2NBOPhEdy/2rtjoX9kG9k2SyqJTyQ52gMIyZIeAV/RkE7FcEnWZMhXNCKSR+arWBEvbbI3HA73nsEpnbGQwjUPKMgFkUCCgD8I0H0ZDwXwEL77UsCK++LQp6uAr5c0lMKgdBLEQtJIAcjhHE2JNyKxXPCI/YKQRndfihHjh/evnBGP4oAK1WgIZMW+iXi8Ekfww+APgx/3AAAiKngceRT/GHKfisEB6F8OVxH/sDbt7mk0zXR0gmYphiCFJjz+rghh/3tIP1BP/SNDmMI/D2csJAH7hUb4JaKrgHlIEI+HMuJBkP8iqAZB7y0wGvorzsw/PB7O2HxJVgYvLzLt/8anukHEQ/y999SFJJLO4sBi4hO65CH+qzdM+4+fYy4JQg3YksmIhHNaAhswIVWTByK9hfMQLJxHVAi9SLjteChJsOI+iSUXHrGEvqeRZF7VF099manloYLNQ4T4c0yF3BKKQvKIzKkXEIvnSp0XIWko73kQL9Untqz84kZ80zYkK7HgckQ/SBqFJBiakc0ZrMIt31MTKvcndWBLSMyp1IuZJQfuQsXa1G/xyje/rexHnwZU0ir0yYoplEPFQ0QDsl4sJF/aP/mKiTHkVgX8oIhgFV4GnQTDCrwMe6rGBgZ/KAw7+MMAKqB4ahVGW4LFeeSzMMtZqwgVUCJo3aolq2Wpk1IipY6hWNVi+Usm1EpFdM6EjNpwuScB84lk4fyBThec32leFuuOuOOWsWxoUD2LdEmbppFd2pCH1waxd9fnCOtwSWXEvMoxXE/3ivs+E1G8UthOY39OZSW8yu2Y4mxZir6g37DQB7mgRQEBHphc4Ae8u68ids8COqditPcMbi5PL4/gZEG9O2Az8OmMxIEEYrqSQHAIKfUFSA5TqsBEtHyhq7FZON/+vS7i6d+oJ83VXinbADSDsN8LUk3EA3pNZwpwWT5pg2iXuGGWuCpZepDVShwmAtppcu9X0AKHIyumhAlaI5UBBGRKA6RMbT+AlIcceny54iEN5dCl9b3F+/Xoy9HLPbGinhpK0IB6kkd62KXagecZPBow6YaLlXGO4JUaVfEMOl/rQeR6RY/gmuozgX9S0hEL5+/wFB9BGAfBHoCky1Wg/qCRzdAZIE+/Fsy70rGZlgAAlp74e25DX7TsZQAlDhIW0iiD/LAZfQC2JHN6FQfBFQ+Ytz6C8eyCy6uICmoOUaZdMv7hMgV2lGKvf1Y8khkUNBoJclc8kkfwxZ+++FOmBQ3v8x2eweXV2cXZm8nt+PL2u3dvzm5PLi++Hn8DNCTTgAqckDpvlkoguRojpJ5Uv373pSjAmxJBfeAhyAUToC+GEdyof6j/zUMeUR/YDOhyJdejQm/bTsQrNT3qw4xHeSRoBGZB4eXoi9Fr4OEDifwCHnZBqqdXGBXuSRDTIxgcLviSHsbTOJTx4eguntJDPYNBA9W+nNy+PZ7cnF1vi2jE9yMqxBOhWjK9OqItpFwdHR6++rfXo9dfjl69/nL02dGXL798WUezi+O3Z5Or45MzWEX8nvlU4PWYMFngM02H9HEGRAAJC/BoeM8iHuL3exIxRfvCacjPJhk418pM5OuIL48KHwBmjAa+uacqv10RuThKeNsomUTN3Cdn1+/HJ2e3xycnl+8ubvIUsLvFXv+WDivuAxGbzr4w9NZpoFjqqMxLawjx9vj749ury1NclPJG2PLcc6M97uoXUB3Psofp5Prs+Obs9vTs6+N35ze3k5vL6+NvEp7LBMxIIFDMC+FyRcOzNxMrFBbgZl+oQELf/mHFeQAPLAgg5BKmFPQt7Y8a6dOGWhXJjmAgo5g2cMbxxeTm+Pw8gXmigN5Oro6vJ2e3V5eX5+BTD5f9YUGVaGvnCvgAA7EikaCAUxILHgc+TIv0tS8P6iOPgBWJ8OCYCxRYKCQJAi0altdGTwFIw8hIzCnNjHQAbAYDXKoBMJknd9Kqkkkj5UCSOyqAzmbUk8DDYK0A9lqLZ8j2KV4raiptq9y6JDUrrSfbvtQn16eVpxOYgFhQHyQ3t+ShzwR+UV2yq1QYZBbxpda8Hl+NzVU2gjfrZMXkAmGIZKEMMOoXIDWQ4+T6tNDYeZO/OZ6c3Z6Or7MzTDYBXuzgswiF9jVey3Zn8hAWXEhYEbko7pW/qndewgE8EuLxpqC0XEzt9RkLqBjBqaEBDqMAJUQ4vCfRYfpYaaGDnUQtEbLgGoiht9PN8fU3ZzcKHqI+pQlh1B3BZmtcMzV5hXIBmuRJB0UtQe5ZOMcOYkHU1JWES0N12OQDpaH+xHwKHomKU+Uzc6C1hkzdJuVjSZOthBTEoZnIUfBQM4RWYak4/1o5swy7larqdNqT+pRIi0xSEVZzuLOL98CEVl1Y3JR6wiMSLzYIuFd1zvHCp5bz+hTV+B9hsQpk3c6K/df4/bGGvJWVslP+L3ZPYKIv+itF9oATn/owXVte0Yden/yeqlqcHAVdFqVhNc4vT47Pr97ffns5ubk6vvl2u4tiVQW2V14028oKHaozEzzJdaqirdMZwikN2uQV3AYnlxc315fn52fXt+O3x9+c1cgoFvLf2D3RyhI38NdnV+fjk+NHhV375DqCwWetoHLXiguayJmHTMi5dMQ2wwzdB1Csvzv8t9+8vek4yHA5X3abyfvL83dvz7qOpcWELqPZcS4vxmpYl6GWQ/oB1TRRd9qd/b+ry+ubs0ca6Nuz8ytH2AEL4w/DWLJAVAHP8d+zi+M352e3xxfH59/fjE8mwGZZHgOChr4AEvJwveSxgFiQefGdp63sqss3nM8DCschCdaSeaJtUsXRnd+y9e+Fs+vbm++vWkiUqI0HezV0SVC6vRpffHM7vrg5u35/fN50NfnGxgYvmHo+xJHYz0FXF9OKRoz7zIOVEu8M4QQNZRX1Rrnu9j3BBLz+fJH/9paFbBkv1bdXixF8z2NE1OOhstNrbY3k8EDpXbBWF56gUpkI4dWfvlzsNd8iNZTYq7xGXn++yJI0YPc0pEJcRXxK85oa5fSR/wuAx5dLEvrFPwMM4TAWkb6XDqcsPFT6U08GFQ2NJjX3BQ3bJDilAVlPqMdDXxzBZy9zbfTKJF//lP0aUeKz3+w8ita2oidUq5VtaDSHLtY2Z2PQUNx7ieksYy6xO5CsmJnXqmgtWUVcco8HR3BzcmX+Jkk0pzJnWMnb42psQIKi9f14ht4P6yO44CHdxEBpOQyqTVXvLlbK6s6NVHXospm9sm6AHlh9Mstl/Rx60RY+mQ2zCaPehkwL9O7LHOCSUbPFQlm2tRnAkAH6acxtVYg8tsntJZrb1P83WtxqbLubk2475t0tUm5rJl4L/OLy9OxxrEZoL1MusCUr2RO0W6IBzVoQyya7/JWnto3lSDiVgwJUdGDOrrax7sx4pNYZQRqNrWbVh9rrCqyXZ4UlJ+QKJHIh5Z9WKVwMEvV4osUJOL+LV9vZp58Nr09eue3VEj3rtmthIuY3NZdCj/Oz49Oz69uz87OTm/HlhXn2nDYYXgJKfBoB3tDKOpYxqhRgF1oqmBqWP6qeZg0ydZNsMbFoXeDxzcm3t3gcj7/+enwxvvm+YW727aHOFxAjeJX1eWqjKZWOlRoyLgcZ6woT8JOZ708j1Nihh6RGXwCJiqcmaY2W2Z8MUv5PI0e1Z3mqdZQzAxWJdxVxjwqB8NO9juKXogoTgF7d1LdEePUFeAsSEU/SkqbfnCpg2iCwmkd0BTyWq1gWJgQTDrM4CHBYj4TPZXLW0KCFrpC6/4u/mCHF/ugYIjqPAxIB/aAGE2XrAHIMxN9wHBpKFlH7CtITXRCRvpdZaXPCcegteAQ//e9PcJQAI+EahIy0JYRIEJJEUmhUf8qLQj8Vl3n0h5/qASlsigBgxlXYhlYI/0IjDmpZuJrIgkSP8qgVi4o/Dr2KP0oqJPykl2foweB/C4Lg6A+Dn+ArFKu3/Vzs8fax3u4t0TNNL6AqEE6yemvH7byGqofpjWGnl9EmL6A6vDeg7ad7DSX4pFFA9a+cisalF47rA6r0EnoiYuEWbxfnu+WRbpYt3Ct9bpXCLvlpr+OlUuz/27pVCthv8VZ5vGeL2SgWdUiPd9XTJW3W8njZ5sNlu4+WDg+Wbo+VEltt0DJV8NUm1dFvn7Fu/7Vd3LjZXVC1db2Ahy6P7u0+ubf94O703O762H68p7brQ7vDM7vhkb27x7d2j5efh93u8d/k67CA/aO+DsvBxulr8QRDjG2c6GkSYpx7O5Iw5FJHpx7tWZFf3+DDOQ1RDzyNWeDTKPe+uH85+hwvGWSJjIc3bEmFJMtVYivSR3EacO/Oei2mL5Xk6TfX8YqZL7qnQUdP5Y0CckqNHVSts5DfFb+cM6GZxiqIIxLkh8YPYsEjeZECBxjC1NefWIjnKNdrD0B4fEWP4MJeS6q1IUJiMyW+j4QlwVXEQkmjE6WiDXNvpr8JHuqrbpTot4+ljNg0llRUqbuNmr38QT9J9aFpGKHgqKfBXeX/uIoYj9Dy+qr7AOhqvRaSLkczcbNelZH/elL480bjeWRFPCbXI+NHWBpuwn7pSCdJZCxGmLJgIq3lMwvypOqTM2BRCXOCHzvBS8Sj0mkrQT+el1H1UzR0o/tXJFgtiF0E4S3okhzlFArHV+P3n00KHwB8KryIYYB47vAB09eL7pFc9xG1FyDJtT65zu0KpXaQjBaCNjOsLvf3AhbPFaq6HWAiBXPTmWNKfTO/JHQqQarsbg2qEQmBY3j3CL00aCSsRGh9eiLq8XnIfklgCysJKNWQkAWYyBRCEmiR4wCV30uyhoiqUSAOM/CwiRjBWx5RYOGMH4Eyaoqjw8M5k5bJq5srVqr7Q+TXiovwSGW3uafBoWDzIYm8BZPUk3FED8mKDRH1EBn9aOk/S+L+nxdwrdyMCSduXAfFkYEJIKa7nktKbutUf302uUkSD+CSFNcAqZ92FOlCKLKxcEYj7KeDYLRYo1PMGGGd5V826kfE0yWTIpHaQfIRnOD9h6IWOjr4IxiHcEKWNDghgj76Mihqi6EirftCFHWO+Q6adrlPWQ1g5crpQzlZUS93gNJziTs2ikPJlhQ0c8ODUgAKpYu6/ZCrHzKfR3ROJNU9yw0K+B7n28MDEXjGQt84BmJMkJrCgtGIeAtW9BJPT+aMR0ui3xQheERQnNn5+7cj+JY/0Hu705gAL44iGspgjQ88tlwFVOniC/FU9sce8pBqpKYUIjpUbzzqK2w5PiqmVEqM71PpekZwg+18tfE9Ug25dl+oH3tHtpLwxDSsaNe0Uuon4HNFzwlq7NWdW92sMOB5sRcwkZW1LNihwBYg8pd5cdlgupZU1DTQa3qkqPzZ65o2moxq18xzCpb0Z7VYix4TvSp1K87UAh7qJrWzFNj3Y0zVSFRO8zNhNvbCz07M7r4OSP/p855I24i/apyHUBYSW/mk+kFZsEZflT9ApqGlQ0RnNKKhzmmg/vDm9AQeFsxb4JsW4dZwCuQvb057HMV6Camab16NEzURn2XRLhisHbkN5JR4Lhg8H8/0kPrFz4HAilGP5sQuDFSlxAc+q4FJQqvH0D0ONA01mhlhTRmvgBivhP+aXF4cfsPr9qSaBxAPFT8ouC9RfSdibwFEIIeOqI9vgdGShGxGhRylBrIfXv9YR8OvuVLPEHVfYIS0XFi8M7tGO4mT1OSGuhQW1lEAVtw3037A6apYJ+BmujGFgN3RIxjop1OC5t/VA+AfgxqoLx5Q2YS5AwcauUSyTbMP0AySqLSREZvPaVSzvQG7oLv9PvBIUSDkGRAImIlU/VRC+ofXP9ZinKcXsNCnH+C11rfpNAn7xg1PrENJPuBFvuCC1lEWw8QkhwW5pyD4ksIDDYKhFox8eCBrRQW7cGq/JcH19bvVvid0XiuTxENd+cAEShOYYxFlBvyodyOrY6Am/RRIDt5CZSC0+sVZrCTN0fO+p7lKyG8Q9otM5BOKy85TxBew0xQvMju+cYpKSRaFVClyGD/0uSfUBD26kuKQ3ytNOX04fODRHQvnQ7VNh3o/iEOFjDh8hv/ZaEbau8N5Wpl8Mo87NzWOOOw9NftK7HLLPZ9oRuIVe2MUDt7IRg1geG8NUCZgSXzNskm4fvTNrciKLwxvbd0khiT01e86j6a37k3HmDke63fj04+z5WPW+ww3CnA+lYQFolV+O9XtEilBXfnMA5LoZBUZ3pziNQpL7tPgQNCIkeCg9rUn8EknFz0EOeX0EzASOp7hk6Q5XpyShL6y9B2aK1TnERCJrNf2VFU/07XJLQAzFi0fSEQhkX0mVyfDVwf4n9cHQKXXdxvqEZRS2Gmap0nzjC4I96YaSS2QfTuqRdLJBw5qZ+gzcXeAVzVq6w8guF8egBetV7KmDw3j5VEtvCFCbPicDNXQpiIlQ75BwPmq6fP9suFr0+RwAk2dl6VQ/y4rHbH7DgttW9vXlF3fKfEUB9HgDuDb09PDyeS053J9e3ra8HUyafr6LrwL+UPTSg4G/Z9Q+sBdU23MdyLZ14VOlnJqTyYQITJf+6K2IJGvAHXUgXxb6mbRswAho+YBFjawpcfWfRi9E2ouu2qzkk52egaY1oo4KXAAImo8O6ZrOBRrcYgJMg4RxKHwyeHPMY3poQF9i3+/bVCPbYcseOU50eKtaonSEv7CZ018sXXHWeVYt/W4KvayC2LBPcaKWNgfa0m0/OGmpMOmOmcJ/hbGyymNNl2cexr61ku7BYP32BSYMJ02G7pF5LsPWHjnIvPdn6uGVugTIPhMAnZW+BGzS7JShRLgD6frIfMPR6NqDY9tEse1jZikS3G05yjxGDxhwQPferatMGlBkoBK3Y9K5kTca2iK+CPqur0yN6yH6lbX/1Z9q89gi7TarCcoTMlaBbPXuho5i16K2agWaPPtjq4cClZbiwahxuEAANTutKpbQrUFYmxR6V6r7d2wTZwRtI1IFJH1HkDHw9TcPfX5aD1sX7OATrBpetyWKuxA22nV5k2h4aA93k3a8cSJIVU9IzI42q2Z2DQalrjCvOm8QEiCK0UCx8vVNi/inqFlB7RHj8B/875MrRvjItdcOx9isi/9IDeTUUCBh0Zh86ZaNmcCiJTEW1C/x+axTldOC2EdsSwry6rDv0uUIRptq3eqXYtkXnri1oGmYS6brtGKRDSUjvb1wVWmdYN1/V1GW6QHqICMIpm52IAISz+PCKqz8thXspK3MtevGMH/hMp2UgHTWFOO9NUrfGKhaiwQrv30qmRUH3S1qmdQbKXdVdo2e2LZLOf/pDZ9ArX6PuARhFzCi++pOLzg+3CSeB+Q4IGsRZrnlcMFd3IdqL9ChzD4nopB3bcL3oNmcuFALLmwt4NadtUJXtDRfGSXb7rfbeB6s/AwMU9XfLIiZMWnPHeraFAhUdQeRe074+KMgw1z7jh8iu73PgJB3tPP2yZ1d3QzcmPT4u2DQFJMkuun/nZs2nwnDdZxpXvxGr9fUywS1NlZRjjRQE/fci19CCsmrucNL449ye7p4Tgk+hejONrvSBENpuajBV5LrzpdVd+DU+UFaz+Kir/X7P+KP+stfZTNqWmdUXJ/i6eZSkV//8deepCUyX4lqX9RdBofDHLe4PhP5Uuvee4R/PDjnh6M+u+tR7f642/dzR5Xayu+9ngs6x3u8XOT173GpMH13qv3vffM2I/lgD9N51HpeP+m9ruz+/dqQUQZ8FXhr78H72/cCQUXcCWDqX+Wmu18v3e+3zvf76fl+60FvaIDuCFX8jRXR5r0lDoL/LZV7Crw31o3S4Q7bNDJWBMi7hkvudE6yIlZ1LupOt7U9bTTybobhIneo1rVpba71hoQ5ZiQFH4x2VNMlGo6zRGMJXgkBBZ6QVwDVQ2HsdEwIyyIIwo+XxIWApXeqIdOZcGFdNapfGsaFx8XCki1fqUGKuT1LqDdGLUXboZQq1LIu/MegN76osLcGvRGDfaoR5xXm2nFcofTjo41Zo4aggmAqH/HtXo6qwDgRLfi5u2c65JdB1tVSXKNERCMva43QmetQsgDze/o/cpseaUmXw9N5SnnASXhXu0g7zG5n7Kourlrvcl3aplko+aahZpdoRlXzfLrVDuv/oQJ3gQFEmFcehor88YN8Vf7cMFl6uV7BGkf8LDSa7ZelC7awO4pvN6vgZj2RxSOModCAUEHXhKus2YGHmX05jVgP9vPQE4tBFnwGnQWMIp1CeQ6Lft4Zu7br9EgDEzYOxpJouuboR+v/m6Gw2D5GpBJFV0dbIvLeNrbT1CP62xTMdPIH3WrSTgAOj+iH+TnB/BhJh6NOdUh29WTTHkYaZvkZHJ6UOsspO7FKh8z9RpXCSmOyuVBHGbadG2WrhV9hxh5gEZ5BZTJ5ZAyW6UZrmY4WW3xCN4JWjDNjJL72QRkdJ1VqrJpm5ZVn5hiZQsarPTOXgUEfexxMigk2ci1g8pJjS+vJj2uF/swqP4KOQ1DMyRsHa4vZ8226nZvj3zLFpPvikj1zDyC/33xP3/8dbj/ny9e/PBy+O8//vHF/4zwlz/s/+f+r/Yff9zff/Hih+/efnNzdfYj2//1hzBe3ul//friB3r2oyOQ/f3//JdalD4MUx/hIQvlkEdDPYvaI1K+367Nupg/T22iH1NyIdlgCdOp47x0fgRsZnWLSUd18l+9xAqTZgtQP4Hu1cdIAoDtmoph5J4w5AEY5oHSeFoxvpkl1xrj28Lc7MbtyjvzGRcblmBiGmaT+MzUYz9nq7Msh4eC+bWRQIoieJCrt3LbIcVr8CzJcyOcrqhiJy2uKX0mOqKofI0JMSy1dZrb2p19s6C5ligSHV+c1u++Fr+OvNjagJRGPvmixM9aoJC6XWi90gEQuKNrrYIiIdhcjwgGIorqKzxed3TdAFZ1N5qq2lYrBy4JapymzwXC3NF14maJFFJ/QNzVnxKikNUqYFQ0wgWQfNTYwonrQkLDDtOwXbKCCC7Mc6EXQe3SBVuB5C1zICAo7mKzGvBecZtkAL0vx+GBkrvVf84+sGpOUVzdU07FBZfYfitk0gh2IJLugNs91K5Iap5Z1aIYKWlaLmjLfBJyMwHjEHhkqSEX1A6jB1jGAl8eIQ+HFUn6yz9jE8qZGUGTWI2SpWF2sDby51DRaOjwRf1Fq7ADZQgBP450+KFJWcu8FuBLGs0prBRHbJ5bqx9ah7Vv90drv+f0z7CFMQ2hlBq3423rgm1FduLNpEUHOpZvNI2Avg+WZKVOx98Vc8dN9g9YERaJERyjbS2oPyPZPiaENAteQWYC1Nrck8BUyiIhUB1OVAuWz0r37gE8LLjAuyWJboXBHV0PDnKnqBamaj4OBwcmSLZweJO7DsN3B/htMCpd1Xv1567lCndymqz8vLkzSOKG0cUj5KRC1d10LaO1st1jR7UqOYMYrwgEkcSId1e1N/kgFI2p8Ft3M1BeBml+T6v6Hyof+eGShGROIyOU+baczN4zq9LPVMHz0P9AxTXY8B/MCzo1WXtmLMCklJVFyE4Q7luyasgnH/rLoR5/wxJkGUB2pGcQ+/R+iOgCS13aeASriC1JtNZTMXaP9Kllkn+C5BDFoQKN0PRk9SDWyz+w1XhQWWnIAUPD7liEOi+Y8YyZ5BlSjn7Af+sskSDoikS4GHrrij0oL9hIj3QEv+6Z4zalZvDU7UFJvZl5F3LFqw+Q/6D9lLL71AARVLmLClkJyH6sBoZ16wvQliSqAaW+tCCVI31hslxoKhmKDnXbwiBc4OZNyF5oVCYC2KaqeNHBIZXeoVKjiYPDKedykEdBB6FUj6y/uQxotshRNtgvReLk/PLd6Zvvbw5MReMCCspPsBoB9WXz4dFjUoWLHuBvM/+l/kVE5peILPUv/nKof1n65svU/PKLP2irQUHokocTKptZRveiE7pTGwd5nIISFrDD6NpXYFJVJCJbMW/DShEpQk4EgU5VIJ5lsizXXDw2N7VirjxU1je4yxsrRQJsPIM1j00WGlSgS64TJCe1T1Eow1wq2PUABFknI6eQTAbge2ov58Qj/gCH8PQQinIgUZoMsYxR6EOccLNn+EertrLi3LPkK3oXWxjpSIiJ1jkPYZAhpvqAjnODrwam8VD9bZBAVJTxZGCAqm/wZySpWqS/dAOWxT1drxoYR1AJpGuVDzQ+UKmyNeQYzjP8AlfjXAZvbapLkwCyyclkbMuZKoOEfkZcnL5NwKi8UwapTOZu+1kNcjU+zQ3dVHiktFvr0+OXmh69Mv6U9odEc1FMej+8/+rzimr0M0pkHNHh3PgD51Oa6+1MH3RQBAnmPGJysRwVYQ+zYMRXg2+ubt4QQX3VbbBXMyYUx8R02zjk/PrqJEtg/ebBvyZ1VlOY2E8AkfDvr159gdVdYZowA6NpsIPmUh6yEI6V12DJ3/dZ1ayOr8aTcoZ410IEgnqxShN8wkNJPxQCpVYRu2cBnRfEefVzb8y2cShFdSWDRJDN/uiQLPS1RAnisE6uK3uV6V6O7SNK/MswWJfwTvFT0l8DdlEcHpaa2L6riHvYuG16qmF31Hx63wa9DrMpEbQiSiLb9Z5Eh8mp9ZeVYHSujA6ASsk1KoqoJlIKMBuUdXH6FphAoycJ1CvD3oYrIsz5U020JCRosSggEWocFvEQVfb3JGKKQVRO6RNWsFSTST2tNNIJshALTLvBH7CGBDIY43Cnpp6w5ydTGvRZvho8i/AGXWc8pvRm0D4b2aSsuqZHqSYe02U8Et8M1FKFeShZf5VKMJYik6vj68nZ7dfj87Pb0/F1TX38ir1brF+oUhu8wOQF+1bPksGoaUZVqEzG/11Xq//Vy3/77N8+f/Xl689LxXMnGUKnaQVyhMnh0YrGyeW7i5saPF4+TkV7LNdQ8fdB6C8HW6u4pO+jChGmdD14VhGTR7ZBE9N4b6BIVQrAWzVcIvoJc2rPzV663MhcAS8NeCG5TZGp/4y7js/w7lF/faX+oUbfB4am4ApW8UwnhifGYWqYxDBr3ZWS5itCI5tuucbplm67uqk2XHTPcLOr+y3DWpghAvVBcP12eaDGNUzTyHDMxEaeN9c+AxJRW1Sa+kBkkmLbxtRyf+Q6TdcFbbiTG+E3XdCFsS6jk7SOfOsF7jyq7r2NEpnqIPWvjlno3arzfNxamKURuuDz0SpfVmDZjW7wyepcpsJ9CaGG96ZtW3pwur6BFINk27nshKxwExnCoVyuDi2eQ+HfDdWY6/bb7/Omy+9V/mo0UQU3i4gKlc4mX/mltuLcX3Wl508lHVs0ri5PH0eQzY1fKulM7QYGondwkhQjcyzU7VAtWplybscntYLV5kJ4+YTVVQ68Pr65vK6l4hEMyodmUAnr5Pzs+OLd1e1/Xb65Hb89/qYOnj2MAQvjD8NYqqAFZBMNldTHF5Ob4/Pz25Pr08rHW0MJO9XFPBXLMYXPtJuuejPZyY2K2teT61ORPDGTR2djIcY8xjV0QBNP05wVDW+v3p2f307OTq7PbiatU8c3o8IZuRis4iAAoW4IKapryHsq3CFeqb0KAYlDpcuF6TpHkNba72VE66q+P7kyfvVF/LylD0zAnyEt5vexXzp88DQqlhN/ydBJZJhoLFtFMrJaHUHa8YFOF5zftYljrR1qZLJOchJAlLUEJYH6rYJd7Zy6SV4NpOlFnk8netXjU5K8inuodxHcSoMACQQP+FxyIX0aRWV7wevCn17/5f+8+g1U1k0ROT59O55MVK3Uv569+fby8rumG7vu4A4cQX99PD5/d312e3V5Pj75vmaQrwkLfrdlWbvXVC3Qur6manVJ1WJ355KqWygh+ySqshYI8KhVWTvfgAH3SLC6L9T87qiXqAbSyPA7dN2OtqJuoA2w/Gg6jHrcN6Lxp7tebb8MRkOljSso6FxvzsKNnIVq5l66lCvscRm5/7svVYHyyc3ZtXlzicrC55JbJar69bsvi08Rpen0dXoFJoD4vmLIo8Tzks1D5ZsJbAYVXv99K7C/rqi+Xv26SWdZ96pZSLk6Ojx89XL06vWXI/X/R1++/LLpOfvduzfKrHLx9fibLZLOeKE+Hcqls6wj3eGCL+lhPI1DGR9ilq1DPYvBk7FcPhlRMN1BBQ2SXirf3P6VqildaQtIcQM5G8Jrhv7Uyiu71c4ujt+cn90eXxyff38zPpnUyKwyiunAUXNzdn178/1Vm4jdrBLLQP327Pzq7HpzxdgzOD87Pj27vj07Pzu5UVK7nvtpgwosoMSnEaAowHiY1XDlIBfaZXzBckK3nV0NInuVJ71I+o+j6koUXSpinUYlPRdefMPVPdh7Faqu/x5qr6es9NrWI2njh0efd1ZBbKl/Z4nqh1axv/ND60m8kgrYb/mV9Ay0YRjekjVJXEYNG66OUJnkWHXDE8oTyorVbNXVOGCKTqrctU0CH8wtJIw+GmPEeaiEIXWaVUI3+4ATNmomiIWkkQKQQzqaEm9EYrngEfsFIWWShTpjHsWBdhkZAlmxb5SjrwoYGvxh8OMe5JJqwA9oOxGDA21EQZn7w9q0u6fRNNPRCZolGYI0tyT+riih/pshRuGfh1jBkv1CI/xiXmWKCGmq0+wXQTUIrAuqfzNp/PAfWkpbkpVBBRmv+vVvfKobRDzE33vPFh1ZZ3FgMfGtP98GMO0/fo65JAgVuW2kanSWwAZMSNXkQfET5yE8Gkld4w0LiLJwbnNAbAe+8SjHkjdm8WmkCxBK7dCkc7FUfXGjXFU+3cqZYmSb/ZOfZNYtjgKDOZWa1nrC+rmvftPRGnqrBlT/tqojR/oKr8LmD+W5QQUQPM2j7YDiPPJZmOUkVdAw+XWJ8nOaWfsMbVI6pLQx9KpaKqs1i+icoW6lGZc034nR2OtzHOuOuGeWsWxoUD2LdEGbppFd2JCHNqfQu+tzhHW4pDJiXuUYrocjXs0j4lNJxJ2eDJtrzPVfevONFfd9JqIYo2ynsT+nshLPyk2e0sKedH3RvWEmUrpw06Y5w/AOvLIO9WJUvuAUDBWou/17ztQwNldd5W0P0AIDoPKej3hAzVOwfGO3grSr1TBRd0nChjRrKdzuFnSYDclKLPjjShXJiLVihUVjZPh+0+HWPN50sBdEwy79cZvD8VCipFAYLz0GjzWy85BqUzz+AUqX9GmdoBSvDkdoez8ICzb/aYZ1MlFTvTTUgzVZBr1h9cRra/Ta2zI82M5PIyyk/xXngdmP6H3VE1YfvLb385urMIGnfMV54GnSi1FR1C3WmSh9r6g2UVzQQrWJ4udStYkSVrXVJjyxKpabKHbeYrmJfOm5XKTMgpJALtbg2bmNQyFJ6OVyQ5WrSZhuVY31An1b36A622QjlqkmyO+KaaZrPbZXzY16YOxTwaLu2Jpu9Zie1jdwwvJ4jgEdlTu9hNT2a31sWOWjiHYmEycpf9RP9kSaGu0qfewqfewqfTyNSh/Fw4rlPkzqKdXZlPeoalab2blDfjESf7h2zgd9nGmcFNBVnjWxpBVZf2G6rk0FqV8n6pZH65wOSiRRxjNm1Cedd1uGU9T77vJJP7F80ue4KsVs0uQD5nsmS+vQUNppdcuoTXuVDCTFVvEQn3tCsQ+PrqQ4zCk9D3XSiKEZdJgMOky26GHP7NG7xOa/0cTmpa1YA7QiATqMZ5BAZwL4kknre0VSrndQm6raum4IkBzMgWGzpNYJ/aDsd0wG62xyBC4XNHpgog5RnTeUqaq9SyuTDbUcZ6ShJ3uIGj+re6X9OlPXqSjdtdgXf1MOKDwWNgkVek2w6gOd5kCsTPPQmr+3hFelGKBQAx4iQgk+ZuwaKjZj1H5hAihx55ow3+hs3dKln+Y62YlEhPlaDQG5bVILE5J6hXPlh4EEGPWv058vbmHRS7PUFgqekwzCDVBTJBV6DQ3d0q/nKpu1tHTMCV0nctrHc7YGdpYYrWAhS65C6QHFmDweCslkLKnIUXPUCtmVViWKNZUCa6BJTWG7TF0wJ5j5qlQjpz7OGbv1j61/0WOStkhLWu3d/DtbMtlxnmaGMMbrx9RbmtOQRjVlziuPzZJIFEv+9HkHUrWLMRkkz1l414NUp7qnpZRP70GVIlc45zf69pfYJem6/RlCfcXcXtdquWlzhnhXdHOIir22QVvwa8esKmPjpnK24zqWKkNli7UkdTIMh7QR5sbBtWFsHdMDBKeWigOJWpeF8xFcm8XQztWbJNxXwHUiascr/yrpkB4alFg1nvrC15NuLwZiNHpZgWfTsiZtmo7t6DxajpTWiPTUfnTQg/S5SJt1I/0PjfMztfwMdef0zk/XJ/2I3fg5u5mGpRVoed9/cl1Lr5utTf+y2+y/5c3upMVphVmlcGzV57RCLep7WjU7rRBbND+NOp5W4J9YB9TjcDs2VNiY6JYOwsDzk7RbGnGVBQaXK80umqXhYW7R+WwGV1wIpoAhyQUcQfDLgfryfBulvnJKnKZCsm26nBusZqsPlH7QKwy2Uo5sFTGO+aUDIkT7Mz4vfBb7qhOUHAcrcGqxsvVMJXLZeAYBnUkdR3sAp3rJKsYSZgS/7cX/YkJppS1vVAd8H8Zt9/KUywWKpgbPkMuEmIAOoc4YdhAsHVc16iFzP6rAnXCdnYi9kzp2IvZOxN5t9p2IDbATsbctYkc8yS+LJO8iZ19f5vtqkdP85cWKRp6i85xiLpT9vdY7N9WXYopzrHy0VEpF3GSFwV78azPEhJlriCZyRReBxbpIuigBfkT9rqCyWberEwsrrEZwcXlzdgSvKjEDJgwwePXyJQ7TCNWE5gPkMc0pndNCM8C0PrrZOEktBl9+8a8jeD0qI4k7OhnkJfz5q0aIpf5//krNbvR8u5JqO++XC+bdJR7QHbbrTa5jrvoQ8+5yKvpGoJC8HosvxGxhwKZJTjkPKGnaFJIHNgyuywzTXge5p9VBqqdfcf+5yMLfQjXjApnVSD4+4TIYARNJRTKQ3GLgYJoj4Rok0Z6rhaQWMmKrgMKfk0q8B3Q2o578SytQXaMlqdmr/mHjuuDP9re/jNreoB1EIY3ZkZM9NEPOM+wGKhLOS4rMa3JoiG08y/7gPEdwpl6fsKQkFPpPSnLNARQj+Gsby7I/mR1mBOC0tDCFC64c5P04oAdwFdEZjdK/OIEnoQ8X/OwD9WLpZKfvJAy2ltCvWI7v0kL6mma5QvrpsXKbXqLwaF6XO7rGku1OQBUepty/0TQkG9uWRteV1v/DRcsCAPq5MzXB9AZFyTNY2iUPffwnorvtxbKT6LxiScBh6iwPROH4XOgawYoJLtjKjbg8LZ89gvcqYj/BTO95TVukxdnPMQlGTnBPM7eI7mYBlUqIP7DA90jk7LxhQypsnRWC3NkjYcKC053mthv4DIguM+OpWDBQfGnOo/XW1zw9TjYbTtfFvylCKJbf1sl2XKeNBWbyBx1e6MJn9mzxmWGjTjAT5pZ7qGDchq3zbjIF7ne4MS0bwDXNZhTDVxYTujoXlQemaBseacf9r3FIwastRu9pBC98jnCV74jcH8F/04g7M/mQzolk94le3YqlUntFAhHwEl4gaCeYbLmkPiOSBut9q13U3k+jve27PHVTW+AUO+/k96pX/upBQH3unUSE4upWQQjJHcGE4TwHKadzApopMG6unWRD/02dDZKkLNOnf8vcwvld3PlJ0uZa9RAxSU+UYNvHbvPXcm+7yggYPPUNjTgOFNiCicfFU2xYNlVt4sWUUrCzC/NfK7rW0a/Nq3Xnmew2851ncpkmO8/knWfyzjO5z/X5KTyTHWWAtgm0oV64JkVts6wf9F6PWTUh6uSwUdi7dR4UNd4apEYbnVU6iiQVrvqDwMApU6kzOw4QmbSqhBmoyPHkDVOVudbp0ETO4dSGGh1DqmvpUenjYKXwFBSRBVftkNfEtGV1vDpnvJJ7JA1BcjiZXI0zgHbR2r8Fe/AuWrsPX99Fa++itXfR2o8Xrd1iknQ1RNrbs9oYWYn3eJZtUr4ajb6p3/XnGu7d1aypOEjdKnQ0ZlYYK2sgO5sw25/HbebKzkbKnAGyFiy4mCbrzY4NcNsMkq7GRgdm3mhY7GVOdArGczMilg2EDUAbTYctZsEGsJ0Mhg4EbzcO9jIJGnNf20QcDYEVRr4GyG3mv1rTXgPMGqNfD4Oew5p0MN71MdlZc1yjp5CToa7SCNcIttk8V296awBaY5RzMLg1AM2a4rZoZutjXHPT1bmI1C3msz5GM3sNt3BXF1NZyQzWxFz7GshaT1+LnNf65K/XJ9VC1hlBuyXRwy7F463zQwK5JwyRBD5V1VRq5ENkXSeTq5PnArw4imiINYnKF3iTxKPYDHOTbE+yY+jUpFcnm8iVRaKcWFwK76VkQLJXl9756gTwEvBopPY6YLrHvvJfQIS8iUgomM2k6njmzomQqaNEQlqQCTDq66yUPKRm2zQePCAhnpR25uITSYeNl4Jsv7fUxHX18Q6TvsGNm06ciczMH4hIkmd+nDksqRCqoKgb8sewiJckRO9mPHGmu5Xn1ePD2unIlMey7c5NVnojASKiRPDQcQ43C2o6JNbUZAGeC7M228GrmtPV4GV4HJ/lUTrAzc9ncBMpAe5r5Th8AO/Cu5A/bIadbHQ/yNNsvUpYWIraBqO320Maj7tWlH3kCw2gmMTawSKQ75AYe5N02krv8ELsmxJ59ZnfpjSbK3y0V88WWCg/e91/5nUiVTExeuvci4nSW+ZOImrH+ASzq0qm3p5vsKJTIkKWZmoLPVZABWvLapRLHp0MtUfHZP8+1Zy9UXJ7n2uar2lrbwbFdk2Jp06ZjSV/p3u1rsx4Bsdp80yUjaIKYDRP1oimGhiEqk+fGntJJPNIEKyxsBOPJZBwDUsSxiQAIWml0bY5isPwE1c2YveWWQ6TcIrCA9EPfjMF0Lda5URMuQpYBSRMAHW1iNZfa1UXmsr0mAqm+oaL1HvdYwEjRuBj8zlVM3yoCyfQ+0eTgYR+clLMFIBEVD+Lqz0T2qRYA+7IxU5hBfvCavBZsp/2et6KPl3R0FfvG7NzfSeETkvdMkS3h+5hQZVo3Khu8xYs8CMaGpc0MxukrT0eIHmyDsnMowbtQvMJAGcxuvRySLskPNc+JcxGU9K0Ag5Wpq4B7SpQtzJXp0Vukbhz83yr2wITQGpF7xkIvqRAo4jXGxe558WR2OstrjUJ2IVcD6qpXRLiScUeC/I2oqovub4YYWcnhBQbSrZI8jbOc6B+WDSImjWfqsXeIVop9hp7K8UG9XM7z0R/5v8WT1N/Ffj7P/ZSXk08ZSKk/kWxONBgkKv1g//M6Dnghx/39GDUf29r9Kg/brm0WFJfDLb10wKw5JzaUuaqFWB/DJ8929W84jxgVorebtEru751Va/s9/qyVyxXlaim7hWrq3vF0vEfpfDVt1xInL7JIZ4MDAneIIzt0q+p0qRSFqnq+QXPTlPzqvyhkjVWFI5KnTYMuwKcN8rQWTe/pLT7lNIQVou10ML2XtG1yCOydhK61JT1NR7FItfS1HOqgGH9Co/g1UZTjAXezLOI2mmmuunMZN2wV1BK2H+d/6MTilySoIykxo+FHTCSClIJJevq3RGtsRJYlWVeJHQp5HJoREo1vQyDdQmd6/KHahF0WJRbEttCyEcoIegK6vbd7ryCGV3CtYHRVJetoo1TwbMSwkZ70h9xA6AW6W9rv3dFGKV1o1PUYpnmDQ0oKgaV5jAeNUUVaWwLf+x/xgs71b48NbXsDNp3xYKIMpKTopbTBaFiqbvc/VYa/+nWurN454rd4eUFudtrV+huV+huV+juqRW6K1a0YTO0AGpNWBK/kJV/K88xtGjn2svVbFCoxmokKqBCS4kaV6t9t+BPhVCdMqIp1qXdSu8W6tktsNU5vNNOtwEwbDGws0f4q0swZ8cwTscgR7cATue4Ptegze7hmo6YQr9AzS4hmu7BmY5hmY8SkOmcULdzVGNL+OVWSq60W+hbwyw3CEW0qoh2K7NpWDx/aZBEtrpaIw91qvnWuKhtRWrcA4sah9msJI0pPFM57IbFaFpr+NUXoNmw9ExdajOXojNtt4VLoZkNy+rWn9KNiss4Gazapg9Oea67J/11SvfbLdFvhxS/TzS57wZpfTcJ6nTJ9/408lY73W0uuap3G/ZpbdgtBX/CowSAwuMFgcITDwR1PHStTRwKtPQtzZJNrrtXS+FeRVnaXXZcC7FsqwRLK0bOZVc2KLjSnH+qZ6kVLKZSC7RzkZViGZVayL3Kq2zHFyjqJFd2FCobAxK7FFDZiZE7MXInRu427E6M3ImRn1qMdC1Cskn5EX1P1TsLdyk8UiguUhso5lZypKKsSA1Et2IjNQVF6pKvNpcZcSgl0hSonSsw0rmISAd5rImnupUM6VsspL2YZIcyIe2O9Q6lQR6vKEiLJfGxCoH0KQGis+a0hKr2K/7hZol0KfjRN4tOI1BwL/JRkUmnBbRjeY8upTscxZzWch2PlVkHHiu7DmyQYacFbK+yHI4L4VaKo2/GnbaF6Fh+I8m607rAroU3Mpl3WmA6ldwoZt9pgelWbMNxJTsW2OiZp6eN8h2KamRy9bSdK+dyGpl8PS0w3QppVOTsafeSqC2hUZu3pwVmdVaf3oUx3B05XJ+4DmUwHimXDzxSPh/YJKeP86l1eG91EJvrXUU6lLXoW9BiE5V4syeLW4GKRlK6FqV4hHIUOy/EnRfizgsRYOeFuOGS/dN6IW6QEKgeudZyDo2FHDbJnKcDiuyuWVl7t+5JRa1vfm3Zg07Z8RpOffVJz2vpvfTPmXspjQjq4bynoiiP9mp1RU2WGFcbjJP1Je8gWBuMaa9XIDNJI6AfvCD266GmcR0o/OO9tCIRq02b/OTMP70MPxiI+uRWdRIvM0ua7GUW6ie8YuJqodQizZuKvPw+1kgZCZ7wwbMR5npNXqjTw6RIjtO+zQ1ff3Px7EFVqsE1C+dZ/6QDoNIbjQ5+18v8y8zNtvDfX08s0z+WOuiNilQ6J/69uoV8NE1Gy6oYy/QHM0saV/DCjbGJcwqfqw3xTjQLQaTdfN/FeO9sus/nP0pxTTLtJFtbXybWnDww8wrWg2aJnYciXlrHIePilWiErROIyTM10mlMNeRGsOZmQ1WaRtSo55I8l8l5wcE8vmJUZBasWY0yZ/fq1BH4OSah1A5cXNDIRtamVMFznoYMeA1VJOyPoHQE3/IHpXFDNZ7PqQAWqjvZ3tlZsiWR1k2Pwqd26nuffJc3wzB7qvqZ/Nu0N4U0GdlPxXwV2W9xHUJDxdK6aoA2TAs9xnQolodtliP6apzkhUaFWl1uaGwKpO5ptssNvcsNvcsNvcsN7UCz301u6MaA1HzuG9fsx7Z9gQ3jzQSelU0M8Eqc36vkXxQSQEZJ0KgUacsS3JILeUFEe+Dtc+21o9ra+8So4U02D/RDPIAFmy+GuhSniJdLElVNM5NEx15Repo6aPN510DcihRMXbI5u62aXpkKsJCuViEF0yOumk2I1TpPmyALmHZJNE6H+m+1qVybvM2a9KD1OaVsi+Z0WbZVRb4veJyU1eY9ZXILWkCjXabqXabqXabqdJhdpupdpupdpupdpup/ukzVCAk2/HGAhPK0ljFNLpTK9NSPgdM/cRrqeyS4DnvaYhrq7DpWpaHOfq9OQ51DrD4N9b1XlYU62/mxslAX0qTqIRMDjVuG4XL80TZzDBuUchE5JVXCR8vcqpfcPHl+S6lbs3u1InWrXXj93fJ4m1MrAxmACPDw6CfNRhVDMAHExuLixaypEc5zA+7lpYBADZaAFRCwOwo+XQV8rWIjD0Dce2if3aWU3aWU3aWU/SgpZVfxNGBi0bh4V7pN6hmg5pycZ8lNQKA6w3hEzOmvigbSyexGwEZ0hEnyxn7xxLc9uEPsdeSSgW98muJsJtqMe7X6Jp2Pxb+bzmPDfL6Wb5FySt86/q7fCXtV/jGJZi/fNTnzW3ItdM/B0Oi84ea24eCw8cRs/D2s+9XOmoWoreTFbFbTavHj0KcRekNVK7AyW2HU2dAuMpLTrN3YnhW0ZrAgGvOMk5E2RZr0oCcp9JOAsGUl/kxYWQa5fq+MkrUCReUsjq/GWd2d1gfPqFLt9c4ShVFuKGY6JVAYz/SQEd6dHAisGPVoTkrBfP2YFGFW6/dnEw7oHgf68tRoZmQbxUWBqGub+fBfk8uLw2/qgj1xHqgxEELrTIx4F3sL0BYcxZ5QtTJakpDNqJCjNHXmD69/rKPh11w54BJtxmKa7olEgOTX+ngkRwLRVLapowCsuG+m/YDTleSOAjfTjSkKqkcwwHoXKZp/VwL8P+o8uF7o7TtQjQYauUQQzIaCJPBMeLNVZjfE+lAlJewDj4DNIOQZEKGJN0niwktI//D6x1qM8/QCFvr0A7zWdjJ0PvP3lZOZgr8OJfmAx27BRa1HJmbikBwW5J5qNeMDDYIk/ckDWWtlml447TSmQmkbd6sVv28uTy+PNGZqQ81DG9E5YyHRXnK52NEaiCJGeCA5eAsSzhOX71msBLNR7yx0VTJxg2xcZCKfULp0nmLomsnu+UVmxzdOsSnxDb+n0T2jD4cPPLpj4XyotulQ7wdxqJARh8/wPxvNCNUv7tPC5h9jbmoccfh807x2XW655xMjcRZ7Y0Q9Bk2bV7PhvTVAmXqc+Zplk3D96JtbkRXtbN56aFSSQxL66nfBhFR/703HmDke63fj04+z5WPW+wy7CnYTXPwusp3ukY/11FvIyJnq7Px/IiSryYLLsC5uTaXQICaHxgj07WOTEs1YEFCT74nNTEw/AS/A141Rz406+6nwgHlrh3zoqlk6Pz2p5ImWPMI0PFR3kmhOa3K8h35SIqyH7LrqlBzJDHQ8Q5X+utK/IXkVFjWh1TxFQEj1c3pKwWdCn1mqhMCIi0w1uXzRxu4zBRubO2G/0KO9LhG5qkeSil97QGtQIPBLCMs4kCodUVss8hevXsN0LanIALYSl8hExSJcwwHq31bpz43tiR29RP2k5RK10T2aAZaUZmwEamJmDkBwYDKTEEGtOZEWlj0uaAbHUIKW6FmdZixFd5Y8IAUwAZ/Dd0iiERyHa1jxByy63wjzNb7dMtRVSdFef2kAARP68dFEwzZ3rTwDaIsFKRwU5+22xQPW9ZBVVGprjqdQyfV869mnqytJvuIBn69b6dfkluEWiVCgVO8EhBqMo4cBtlWCTVZ5ki9BldEPNrDlwvJswNIcEnFDlV/0L4Xs2ySY84jJxTL5KxUQxWFreoVfaMQb00s5RUT9cs+Dv/LojkbumSLGl6ZHdjXCeDlFhoEZIYlvMj5QnTyoeTZawQ/jS7HZdFx3nc5yMeOOWS/znUAlU8zdj6kWjM+qzGil8YXKeYVOi7o1MJH13wQeGkkdhTM9jBNcoCqdzvMt5BIsTrqYaqow873GrerIOV2Onc6w2Z7go1QI54JoDEqzyFR8bqRxgdaa1GLz9ELtTBdgmMx6kyxFbVkitLTrluMSmzpzZLzj6lFHYGCDh7fBnUlXAeCK++nln8vZaZPEGywxc2cD1GTs5hSHLpPQ7VBfTP3TWO0ik+2RhfOxTqum/6xTtLVcRZXXkS1oHuknmh4OJE8+qAmLFqigOigrm2H6gkgmZjrXmV0JlZDZ3HkZZWcr4Ok6oxI8gGksgUk0G3sLzgU15j097j3jAWm7bABAx/tEsORRIu1n0ENZOgOWCVhyIVuhJmuVOL+HukI7/mOO1g4hVfyGGvSBsvlCioNWsGh4VbK6SmmcQWtJqcQ88HYKItkcrTAN01nisX1hT5w1ph8kTKm461oBVy23juDfP0jqLBCkwXQNTGISOQe4chHxeK4pSQODOJ9lNocOsvZVmg8YaOIOXPYtUi5eWtME0ndBUHcubJbBTPpbj0cRFSvlLumA9iplKzc0Wv5HMsQLsZ9uDRXcY3cGiagDMSjuyHTHjVr6tFz9tezBbFI0UKUbTdPDh7/iV+pf5WfZOoyxN+kEosT3qQ8rGg311uYwY6FfMUcHsIayDifVjftWLqJLl5I0ZTPfHyto6SmRNFoeABGCe8whr5b9SXZObjea1Ro5AelGAgDQxRCb6jI6EOJYQ4GfYxqtQalKgYCgMhtM4cAS0x9r6fKI0FfVyLlzdwoAgN77Zylz69K3QIwiKGACCLq0doIJwGeGrMKsTo6/j0w8bvqXjuBJROH44rSdy/TiOQ67pWpaHeGCJm4CyaYjNJpg9NI76AwTMyprB0ASpnlNEbgWf0VnmDaftQJqvAc7wui3sQHAJee3w7LdpZnA9frd0XUPmJBmD0+WLS2gNeoB0TnvYtWPWxJuB/LwqtTcPYBCdTrvlKH22z0AAFCZ6nscHsAFl+o/Z+2P37ofta9PORUXXCKUj76QmiwbL6MGg2wl1I/qNm153U/WvVZUZUnuBXUcAo/seiXplYVBVSX67gV2SiHk4RBzM28NV72dgEe5nZFFu99WS6aapJM23jD1ShCHa0RpgFS4B/j4OtIO0ipLPPNgSaM57QV45VJnYmv37BbOUbtCqf7HRelV/TPsdZsMky2612eKjWq1bdIGRcJzrDrejTTdC6htcTeUpVo9BWSOsCQr4DP4e1Iw5h9d5RnCImWVxHCsgGYhWc+vzKAdgSvsSpUnSGiVDArzopzeVU58UA53atsmjm0wuKPrwUGOd+515kODcTg4MF5zBfZuJduOQNEvY4CQBuVnQ9+HQK8z1KNT6nLV83WaAsg4B2gVUPqpw9QTCXhSll/hhdUqkblaKbn/Hx0gq4hL4JG+0/DJaEphDKzf53OR4jzYe7S7pBfP6M4brYH7O7ruubY3hi4ZdwqPDwPuoY3ihdXI7HeYu06DkAcTSjZMYCV6mg5AUe2YVMkq7yDD8Nw16FXn48AYvDKoo0+udvIlXfZ4FIcYAcpDq4rX7A6ZiFUY4hQ6AEW6Yf2odN0zWliig5bCNQ7YZcWsYVX15bPM2xK9vny8scyMuvA4Xagqi6xxbLZVWvce7Tx1laSGWTT3HoETayVkDyWp7phRhuptkD0LjnPMa0UL6tsDe4QidB1/NVT1EJ0Au7oqdXVa6rOUw+K0nPpoEu9tccVdWXmdQWkLZkzzBrVcNy+yJHwSpu27PWtMMumFltrlLjWtobedreTouxSGAjBOgQYIOnjYsBJb87R2Bq3AszPMzsCjRFDjemZnwZdUJyJthWqetmqK1K4KvKCj+Qj8mJrYJe5rzm6ibfcPnCxXppaIsuHyCP+j6CMjxUB1oEyMWbd0/SyXU68QQUdEJoUh6V9Nyq+I4lJaP9HEduiGaqDVEjMEKgp8RXJtke16EAm6k0saCbx3DrSBF5Pb02gpEn2FtqKzxzHunaYRqtSU1fUFvFAiQqC2ERdprc49ACdi5eQUrZW8p9bEqh0VExHkhdjf33PWALuJbs15qfIXRKPIVhaPHGDmBCgnscgBKNI0FYf+nLm7/7KJQJQKQkjYRBRKt0IqCG3ZmtrDjPh4JsSe5sNuM4aNzIaOJsPECOgMGFzNhU4+CelPRw1B5yfn45gIHcyDxtzXCWyTaTBr6usEtLthp69JsIc5sNUUiDToZRbdghmwt46zr/mv3fSnzXgdwUKr2U+b8HqbOWtMfp33KgBsZO7rvWD9zHztJr7NzI5VJrOcqa7XwSiZ9vJmus4wawu1lkx020M3Y57rDFRDajbNdQaKprx+ZrmeJrnee72vuamfGa67Ca6X+W0Ds0EXOvQyuW1qbuu10o9oZns0E9sjm9fcTGvd3BIczGp5Q1kH2NswqXU+Fx07dDWjPZoJ7XHMZ49hOuvE7zuf/W48rbOp7DHNZO4mMrc4kwzLdjOPZY1ers7VjaaxsmZnrwurKpvFKo1dXQhRoQFqNHQ5wi6aw7Zk5Oq0+7tZRLrQb+smDieAJP5wnWTrdg1NO850SqLRbChNovGqTyGY/Zmu05jzYRrRBoL5FDwSpYmpxDZqsgdM5eg82nsMYcqhgmSyMTqZ4TpUkwSAp1qMEAD6lySs3IfPz3E1C9V8luQDW8bLTIlIszVbw2iTLW3YR9dEO7lo08MlCcmcDs3gwwT8MNnRtXmYOvMEm3N2t7F/Hxv72qxncWuzML+1W4DW82TU+iSjMAF8ySSmvmx9EWdyIuriqTqNiwDJwRxINkviVOkHFZzOZNB2A2YCnLGe4wPTxmISAlYcW9p82knqP5Rc2u7438gRdmoWxP0TYwhqUgzUpMVos6iY3GGgM6veU2XtatzUGd+UP32+cRqdJQ9Zi647X7ZGtwcaqko1AngEPhP690zsPP2w4pGkzVteiSIeiTZMZaNjQV3MjN2ZdJ9S0xcZbHIWEbMhbIK0WLRIcJIbQd68PgBr7GQkOeNkonaPDTvVypFNz8MqYjxicn0SECHaMnAUagIWeuZSLGRf9bJN+5uZ6XgGAZ1J/ZA/gJAnGIKnBgImDGh/4zQ5ePxO6ao+0W9p0v836ZJyAwSjk4wRCWxyMhk35ZizP/opp4Vpk1hgztUCjy9NHUX0fqnMk995qia/iZrE18TrwgWuiz2zZi4rJyYMsUXCQlApt7RSIkhMnhua/K8pc/lovDHq/IB71Ndbcjfu3ms7sXb3XqvBffde273XSj+799rv6r0meUCjbGU7h610k/Y52DTvV2b8vY1MRaUsPEraVZJxBl1gwtTB0TKRGbzVtkbCNai1kSaHVCazkYzQQ/rPien3gM5m1JN/gVi0c5/ERIzPVmt4/bP97S+jljRnrvxW49TR2/kMOwELfSWfJo9SRQYNDyTX2I/2XKN9tNEQO2nn7Qw4YRzQ0+3kANbcq6nFmcIFN5ES9ACuMA9S+hdHT6wLrqMq6MjRJuJ01zj5BeaW4LvUE1BTynoC6j8lG9uFUKn3X+Na3NF14m/lANb4KprnZLKFrX+SdoD6D+MvzJdTk+LcAbJGT/IMhnaRQx//iahuc4Hc3RVzq3RZ76CYczh0skOn/hOjynwiqUchnP0ck2DkAPU0c53qThZMycvkgQW+RyKXQ4KZsJHlgeDmzYlc1yNhwlrTnSXaZQsAMJVRmKcqiILiO3Merbe6yumxmVBVwbdzirdi/1KGVBox7kJBPkurcadYwQuturBniM8sv03YkgtnzEg82fIwwHTw2H56+6X8ZeQA983aimcHphQBVpSn8sAm/jMHV0N35CM4vNpS9J5G8MLnCBWjmfZH8N804rjlQzrXATLOCU6sjlCaullEwEt4gYCBLZfUZ0TSYL1v9QQ60KqdEK76kez2dHvD4NQ67sn3qk/+qkAw3e+JRLjho3qP1kySGePz4gDZuqtmhfG/qT1OIKJYMdic3y2e9k6icpO3Qgugxs+NZSsKOl/TsshSMDcuCfIFEOOGRbVF5NOCePk6iLl8+Csa6XIxSZkE8/ivBD1dQyxoNMpgm0qiOlBvuYylMmT0qPHRVGwROqsbWtUM7uoFR7XCE1Qn9FQjtBZitJvSLlmHrOVWZ2Bdzu2+xpI2mt8ooUmLfwwfxjoQ1xZ+rYVcrHvqLVhQKsasyvGIBZcHekTfjC9GG+bIP1E6lC7lGbBDJU3zRUubqYnmDHUbm5pB+XK+WI85Ah6mh154JFDfD33+UH8ppOtwoH13lS0Wf0m/2IyLzNf/bqZg/SlrdlobVtVyz37O0r8be64fuGHQoameVPXBMkXn4rhYzL25PC42Kd4JuvKXzFYUONTALPevrnSVv0QOYMVXsT4u1lSiK4kFZfPIrkru066S61Yat3cBXBXDX5dpokFF11zG/8RCTQMFfCoJC4Spj6v2dN0dxWe5PV1XNaxxywEERMiriE/pDWsy0OfmcU6E1K+3B6qGmFLfHh49nXop1j4dFHceKhB7G8i5CvebiIQCB+01gRzaIBNgpsow8JAaLtUkQnMgIQr2H2niSyoEmbvO9tt4ScKhusCUXGo7W+WmOhS5Tdcw0QDplhBptMkcIkoEDx2ncI2NgQkgMI0YnaU1+s0oWgmT2Hf2mjXaM8KCONpoDbCJG/Yn5rZKj3vh7Jr7CF+dLWW8AQCg4tXCBEzRhyeiyl3E1yXI1PZOR23JPWMKxkmuDGYKyESSSFL/OVDp9SdVs3gDMLQ7sva73iqNV94eQEeO3vzyXS2IaK+FeaVa1ckmCAL4rMz2e9SrrK8/VSpcVCy/lOBjixeJXPWi5upntUWLGu48p3qkVWSvXS9Thf1Us6lGefF9rqkWSX2j57NsTomI8WoekVIsTtN9SWLJ3+lereswnsFx2ryyOqCuGasFFdXAIFStTVJjKzHXw0RHKgCIxxI52ZKESuQRkq7EXlfXR1OlvXU6p7qdVbKZ5TCOiBQeiFagmylAjQRu5CglX8MqIGECqOtxqH42QM3TQSUS0njrfjrvizJRs4CZgpi2Irs6GWH1psb9o8lAQj85VWYKSf4vUV1zq1X1o8Ed7XW4TgqrkUln07fusU9XNPQV1zA7160M8mmpW4bo9tA9LKiSkIA03EGosIgozoUks0Ha2uMBkifrkMy8KY1T8wmwUs071Bc0iZAlATLtkiifrURpNtoDEQgcrDpibzPZUEWiKhGuUXXVusgtwmPeT1u31YLXQsmRUJYjZ1o9RaOowdmFe14cif6FzZuExRpRMfcixD9azTCi2vi4asUIOzshpNhQskWwW5kDbb2yd82nallsiH4HxT+VXz3DwkW81zieoNE99XN7VYm8auvl/hZPUw9Z+Ps/9lLuTjzlRUR9LMGv/gBwx0L/CAY6ynoVxBEJzD+TV5w4gh9+3NODUd/IA/qPw+Fw79mGPwgA+v00AchIiVe2nHgnAB0x2ORnj6yYIewRkBWjHyQNdSk8U97//tWeXqqTWEi+tB7NmEUQV2nParNxocOQy6wnVqqGG85pOFIqomnMAp9GCNwOff9y9Pno5R4kpa0VNxaSLFdHyCz3dHKCI/3Y0vKurdU+wr+N+IqGdKpw3lOHQA0/j3i8OoLSdw0stxGLi7beAwDMEfBd9fdzW6zJ7t0KzPYAAMSCRzKz8QGG4N2v9DcWos2w3FuNLzyuzuKFjWP39xIB2sAaGqrcv7Jn0FvQJTnay2i/w+Or8fvPJoUP9QotPb30AQ4kb6lLWA8y4XJ9z+QxUq7lWSc7ZTZh04vguZqJbmfC8nNyE/XN9JPqgMnbqYo1Y9C8YXQjmCgeFwljCFYzvqeRROY+D9kvCWxhvVswj1uRWWNyz5AE1qWEhD6mOY2oGgXiMAPPZoWqcMScM2mPn8eXy1jlSzjEk6SKiPNIHPr0ngaHgs2HJPIWTFJPxhE9JCs2RNRDbcdY+s+SdXi+53gl4YFoXAd1JEzavEwBm5TcNkHD9dnkJtkIVRE+mvppR5EuhCIbC7EkKXp+RHyJMGnoYx5b/Edl3IuIp0tdTdZ48Uo+gpOkQL+R4EYwDlPd06Mvg6K2GCrSui9ElrE6SQuW79VLMCvqJUeneK7V5PFUFO12NfEpkmdKVxf7lA9/2+PJ3cGge8n+WoQAAMChVH9rkf6mmQEATAPu3U3YL26S5hvbOgkY5HOlMtBgdBgZC9Pcxg1OaV+8eg3TtaQiAzTNz6NgZ2AaK0u9aQUATC4j7IWdvORoeQuVWN0H5TSeAQQLImBKaQgPEZOSNqS6ExyYzGSgETp9tYFjhQN8n+lspcaLK0WnSW9v4AhgAj6H75AsIzgO17DiDzpW97XmNCnVJIdXr7+shaqBABPafjza659Avt0sWNj4Tlup+2Fpj39zODCaHbQeGwAAyPvx6HTRNqNLT11AmyW+QJWuRkTT/WjPzUHCuVp6owa3iYe1aqX40mZZc6vyvqDwS0Cz/YAEcxVju1gmf6WYI6jRJ/YXGvEWf4qGt/kv9zxoCcWvCcPPULsmEF8nt2/YlVq4gPGl2PqjPtlBV672gOfX+Q6w4IGfu5sS/4nUg2mvloUI5TyN2u3U6pRe5n6aRQofBnqIVpjaxvB8A7t6cZJFn+TyTCthggtHazsy2ljTHO1eMtlcED1qCWv1TstZa2phJvSsN9k4np52e52d4baNbjpyqnVT32AzZ+5Yfmvmx8Pnlpb8+3NK0uVyzZQ/d4ghs7BZLdNpQ063MVXRNy+oUmL5NoVEhBZmMxRInnwAzA0neQNQMCUzkMnqShbrfJETmmb8dK/Skvr/H8A0lsAkPhG9BeeC2owYOOY94/g4B3TDbHZUX/IokXozaGnZMgXJRJeK9MymaqBpjsM5eu6bSv98ZsvrmxIgSjZVFUVayWpi9yiVIluWP1OkJpdj9EWLHSnlE1TIg9pSPZVL1xy+QaU32j9IAm0Jznu6BibR6RIVBBGP55o6tjZLW3RNugl0/JSP5VgGmpgDq5pRQ7FZUmVHPT8aoeKeNmEfmajIxlpS/9EaEqKGfiEyaS4XbL6wO8Cyh/yuGm05etRsMnzUp5tFz9GHv+JX6l91qmKD1Nce8sT3qQ8rGg311uQwY6FfMS9Liy0FghaWomOUyXUS6X2sIKV7W2KJsLQImVNcjlxU7BVN99GufMqGM4Zd+RTYiCM47IZd+ZQtb1gA2JVPyfzsyqfsyqfsyqfsyqc8zp224V7flU/pT4dd+ZRd+RSAXfmU5GdXPmVXPmVXPmVXPmVXPsUsHKrBOqrndKeMGk4vc27n5rRte04rklUYHtjNHil3FXg1fPXyZadcObUuHF2cObouVde6/041/7eWWmabdf771fhvzyDevb6/SULRCLVXbf8U8/a0z251/Svr9jcCb63pX12zv8Xo0VjPv65ef4vRp76Wf2ut/mbI1XX82+r0N1sUu9Tw72rU2WLt/seo29+nZr97qX23ev3VQkcL6KZa/Y2V+Pc2FjPKVfj3OokXW7KpdTQuPY5hqYdRyX2G0NuY9EiGpA5GpC4v3U5PKmeTEE7TESi4mYOMiccZaKspqKN5p49pp6NZx82k45hdsoK8/cw5vXRofcw4riacrglmczd4tfkmb47pBLPSdNPbFNOL2N1NMI9jfnkM04uD2cU9c3N5fzaYXDrDbDO3JOaTTlAbTC19TSc9zCa99mUfM0F3U0k3M0lnE0lPNbDrnDubRTYxiXRexUcyhTyKGeQRTSDO5g9t1HCE2sH0ocS8bgeolzDYaa93aNzF1NHdzOHIqDuYOKzxwgHqtswbzjy50xl250WdzBmPZcroaMZwVV5ApQKjyYSh9QQOYN3NF1ldgWOe/EbTRVFbsOfCcBzMFiWDhHOhk01MFs672l3/7Wqm+Fj50t2qum9S0b0pKGLTau4uz9/2qoDdhRenomndCqZ1KJb2RAulbVAk7ZEq/z2xqn9OZ9ql2t9uwz6tDeta0a/TlnWp5per19doo3Gu5FdZq68t3XBzFb+nfuham7TWUu9fR93WSq+dgVMNdZcaOO1nu6Vu+iY101vqorenRXSph96NLTpwr9410NM657XDb6n+eeveda57vkHN87Yi1luqd966Yu11zjeoca4fJy1iZt/65g5x8I51zTeoad6cugMAUq7Vs575dvhU1Om50vGt0qi36Va7fPc62b1Odq+T3YbdvU52r5P+h661iUPl8E2qhjtUBm8xEzxWRfBHqQbevxL4yonbuVQAf6Tq330qf9u7yaVUqlvVb9ea3o78u9WbqVcdb/vCabVPudfwzlfodiyA3Vq/u1CduwVsr9rdjgvh5lzVq153W7Im6FyrO6nE3brArnW6M1W4W2C61ujOVeBugelWn9txJTvW5e5Tk7vdNPg49bgfoxZ3jzrcGnAL3K41uNsqagHAtutvu9fedpXdHWpu96m3bYUDB37eodZ2Wknbedv1qLPtdGqdBck6W3H38h1OFVNLSflNHSSmC18tSOhj3FJaDelEFHp0yb9dVyitgYabFSfpU2rkidcUaS04Ao/w0ww9s4uMSlPAyfXplqBvD/fH+/ktFzlJ8vVuucjJdSYzbmWVE9OgqcxJTuVdXeYkqi9zEiUYuNQ5ySrGMDTvRAEJk8EAhuW3cqqe8Zm4AwSuFkvES62vJqDWVJjUsUqCtOpJUwYtgQ7wN8HDKyIXRzDSTGhki0WPJJckKz3oVTwOynFtlby0EXONtNXmmDoAwTo3DZTHzEzcMFaGthLC70RnXMeKSmkpAVtLLymOJReVZSnLmOHtU8JoUixd5YLT8bxYrPO6lAY6g4A92aPSqSyv6JyWcPHT0mPbr8VjEM8X46kqtKFZSm75AIgAD/lZVTnBXS2eXS2e3M+uFs/HrcVTPuaYCN2WF1S/aztAIyMDgIxtoYO431iQZsNiNKp8SnUVnH6FaPIFZyoh9yhCkys2s1fz4u9egCZbaKYSaufiMy5FZtqykzTrEKxc4FLQJKuQMkU5bXe7bGnl8/piB40v85ZyJXkOeKKlb5H4J5QLlihxx9QMZcLgVLU49ANR5i5xBDz8lc9mv85/YSv8v+HFr8Evn/8a/PK36a+/BPR51xkZIYj5rjVjxqfABMQh+zlOfOAkB6YFrnVWsuqKi7ZQja8c6zOMrzKXAjDhCWZAwPgqSeOu1XX1GAF4MfJtbYgZX6L3XsCJr3XLqOYxS2MUSIpfQ8DuKIiQrMSipjKw6q1r5SfXTFeKtNSZyRHlv9O2m1aZaaww013t0lWpZK+dNq2Sdx+NOtwszswkW07f9LHV3jOEteBsfbomF68e9T7w7eZcE6n4Liu9whxLabU/N533LgAgf+s9B/u2HCSPywEq14Rg06DeQaDw7tS3oQFhIGvLgGbMxtrAmyO7c+w7VLL/ilGRWcQDlZZGR03/HKtQMvSB5KJBMW4eCem0FcZ61mSV+PgJEJSOAL7lD8pygOYInzdovVnoBbFPKzaClRX7rWZb6bKisiH7rfCsb+UXYIqm30QkFMw+fo/2HIqm57sAGquTB5kSj7ACP/6r+LZPf7SsJhrkmaZK6q1V1Bsp3V4svnRyVBf7ZoEapB4f8Yay73k9SG2dd+0OoU6RrelPpjyuz0Mik7UedUV2RTEN18Rc4JVM2N3prXx9WMBV5cKs1JAtGVYJFrJVplK7cbPY0XaxgGWG1+po0NCrZ9HF41XsV/A+KzDwRoecOtaOG8KycDtPk9XCNG6A28LeHdh4A/A6Bu/IyJvQVhx+LIEEglv+LRoY+KipmKiD8dbFHbutRFtpE/Wp1ZaPTygcyTRDgc1iZQ5tsi9EfeUJm01xTSWQe8KQr+lSnkw0iEGNCNeaIlsk2SvVDxheQ7o+os9mSDepYVbf5qn3flfuJnZsbcfWdmztSbC1Bn6WHNMkOz2LWlfNWFSAh3Dy/npLXh/GPGKeuY0P9fe5pro6q2+2pRXb1IM9Xs0j4ndR/ZJY8ne6VyuTHc/gOG1eWT1aa6KMYYEJi5BfkzFBcsWy8NRiGgweSyDhGpYkjEkAQtKV2Osa5uhTUbffCglUsZ3VZJvlMEGIFB6Idkw0UwDUGNY8XbTWEVYBCRNAne+PSn1NeWtjM8VBzPqnuhllxAo9FjCjl47YfE7VDB/q0urq/aPJgEzM2GzNFJIbXVTXhW2tNK3BOWkjTnTb4mpk0ob2VYj4dGVUKmbnuqlHTkvdMkS3h+5hQVFXSRo8Br0FC/yI4lxIMhukrT0eIHmyDsnMMV1O70Df9ictVD3l0y52JRIHUrPRHohA4GBfvy0xik2PX3B5ADstcsNDuDTPt7otMAGk9l080/m0aRQ1ZIrinhdHon8sKhGONdivsSmwnKlF9ze2DIMqrlJvKmJnJ4QUG0q2SOLikedAWy+V/vFdDM3f4mkaKwt//8dvzPMQ4cF2furgZR5gnT0KHwW/nc+gtmxu21mw3kuwyT2wyS+w3i3wsfwBraxhLfnGquTkLldyBDspf9jAW25BSSAXeZ+HT+cs99vzknN3j0td4vbyl/LOPW7nHrdzj3ui7nHtfnF9HOCa3BQakiq4pFJoTaCQn6lBpKjntY/DrENc6llVObDxl9t74okaOqdnUFpMJiQNvXV9Bp88UYs9gIkkbUPqq5N316jx11PFf366/vrwNfwRXv0EC81Zp9Sm7zFR92nsv9qfyssw68g0qoT9hgijClvyyOjTvnj5rxmTRO1wONSos6uf2VXXLjmRqlRn3dIiNTnrCPeUSKNMQq+ap7nwSJCld2fCsJ9DR4c4k9rq/8YkwEB/lFRH2cByU4vqTbXfg4ujYI2GGGq8BVO/qZwbRhQLzVdUmxZ7UZti7y7kD6EZTnyaNHLfZVEossv8rmrJqoaR44o6IDkqgqDVYafWCtH4Oep8zhoPWB3narRgmDoTpgcaEpQQqv7mmoCs+eh0dygdX9kbLZcmTulmmEf7ObRe8Ui2YqCH03iA6qEgGxZ8c3IFKuWigM9e/+llfyRI0B0NEmgHOv07FBJV8BmMr44UbhWAAV7UzWH/k/iVfkyH0o8qqfX0fv9dymNWqdkqiBklx4ntghsg1fdg0oAMyVY00oKbbJBwgQmYYqJP7fjtp5bIdBybVNQYLJ9fY1Pj/fJ8tNcpF1Ot43IyXGJ1zvvUWT35Xs1FlNN8db+VtdHnKuLTRptPhdXHOGnihTDVFEzXtd1FoM3E43S7u/meNk4gh3bGU5GaQqM8tFastlRnGIHwkSbeYroqzPZbZa8aOvtxNkw0QLo1unM6z6HZlFWYwjsMZDkwjjlJBhCi9cMHsCQqa5hhnkKSEKfaMBONmhb2UhttxjyWbIrnwn3aoLgRQzUPXqKDHNMY6OFMTpo8q287yAAAUOJbGy1AvfdAFc+y5DC3s+pMBTBbOAT/nRKteQ7mGtwEeWziiHoFu71Zr4wxF0Pi0oqtVY1HqnV/ZNu9mRoZjJYx+vg3NWWd2bntO885bV47XwXVrGKji4Otlh2um1UNTy4KwP32gIfFutZxKBW/bK1e5BvdwwK6evgWXXshoiIOdFFlr1dkwk7R8ntUtOjbrZoWruJ9Pi4S/TXKbncNCuNV64p4C+rdoW2I+uPLCf3Z8SbMPEDHl+Xk88njydce+I15jpm422S/sHAWsPlCXlPi6gB+aXL76/DY8eGlMZXhDtL7ozlqsgNak3XodURLrEPvkbH6qzIKOKJlKyGgIeGxyLXkvis+b7lPs6zAjG4PBVpJvkVPhvUBnNIGH2BH3H6OeRQvHbF7/n+xdSbV78OCEvWiQ4YDD5HErA+GYzckaTcsXEDAhQQeAcUMhiMYvBxo0f9Ig0wSSqiGL2ohLtXCw5QCXS3YkkaoMRJ0H0joax2FsrQLGcWe1ID1u1XhXgvUEDrlxSwEorfKEOuMqoWFVxZfk/KiifeCThbOsHByaAE/3+yBqDMZuPIHnfLA9LKpD5j1mYk2QSVedVAuoFgomM0fYhGqML89aohE6ZKobZnlxa2NFGdsbYSMqraV2ly1H/Wxrf2cbIraFnqttvte2gVk7AIyXLjFLiBjF5CxC8jYBWQA7AIydgEZHz/6oqkzasPeEO8uXlWHW2xn5H+uiIkp0nOLERN6gaoiJvSX6ogJg0Z9xMQ0BVsIm0i+PErYhIKWj0zQ3o1oM0IFsB5fF8sXkoXUr44gUAQdaRAXeUlGL0cp+sAlfCGLnkGER0klUB8yZKtGSDeoREiv16Gtv7NZrAcqvPOYNoV49Aru2GZkhZ58RWSFxr0ysoLsYisAYBdbsYut+C3EVugT3hRb8SZ/1LskF8aep1S0O2S+SZpaNCK65JKqqyqiQqtUNDztwTGrVKg1yrMpk3dE54KkT6+w6xXjhJIqDRColCXtFmzb0iI0C8gcJDflwzWkNCmQ0v521tasInqvhnAi0VWmcVrMW0hMjxNQSf2hpslz0ZxqKCTdMwMLVyyLGGaX0WpYNJpNSDbikgozrdi8T5pW4dMkWNWlkK4QthyQrtc6D6FSFLIfLd0rPlXKdLANd2LDohJ34uSiUZC1jrCYG6vaEadO7mqg1u49+3jvWVzcE8stap+0u/ds9/dswoO3/rAtrFfVC7fQpPqpW8Sw/s1baFn1+C03eZRXsGbfhmdbj8n04buikVK07l6+iQYbRIz5+GaxsirV37NFpJQcMQlJNVrn5uPHewUXNnTFc7go81RLy6X0A7tn8e5ZvHsWP8VnceHIP+b7eMMH6RYfoxmu6+RRXfe4+njPQCyQfN4F70mpSxF7DVRP4nn2Ante43DQZZ6P/5L8eK/InQnw0zyZriki2pBsbfdk6v5kijRVt/hUMutU9UQyn6qfRhaT+idRlIFceAqlnx7dEGjZnOEzKEvqI9Qg2psmDQ+Oju8M8yhL3mNmgE/1ICsY/AolUNNlK2PzNCx+lrmU3ziWsJU2v/w7Z/e42T1udo+bJ/u4MWe86VFzXTjsHV41aIky/d3MWslZti6cGR6Oy44gD7VFsLNVa0k+RFRGa4/HYbsh8i35YHC/Vp1OVCdLpFJeKyBS0uVKVpcOsJknkmtIR+sU74D8LOqCBTL3pkOQYtLWFEAxvXs9skzfSeS5DjyJPPBIqObOVpivBliI4S7Gh1g10itbu6YASf5O/cpJAeBuaCVk7Wyct0JmAxTsS9mQOz0ZsxFqIgGTDWD9Q/vuAlFTqfej5r+Z6BqbOYrY7IQklQobN/tvO/GNeZWeBES4RAmkjbNksw/23Pd0SzXkvfloegUsmJuuo3a3J5Kov3n41lhxTImxNWSbLNPVT4fC10nkfVTjtGV5W7NOVy//P4t5+remOliyuU7/IIm4Ew1ag2Z9wVsL5oaIu4LCIPetpDHII1CrMlhKCzjVF+S6OioM7FOTBKsF2fTBmZtZ9pSQMEUOEsx3T8jdE3L3hPzkT0j9bFRx/qopmyWVk80FVuZlbu9G1DJdcd6eNlOPQE8mpkOadC8bD2jQQbCw4jyoKfyt1lNzm0oDSFtgZURD4hjUN57h8/rqBANsETtBrEMJEaC+kEDFv61NDH59ZPmChvBA8ZmlMcDeJsdryjxjxe7hmtY8/MApEm3lXbhOcXJ1otrmVyTr5GiEDFwQ8ALClvVzbFsa2CiCzew4LfF22nO6S/uua5CKN993q3vnRbl637wmq3sgQnCPKVRwbzbtu3xtjUdepE3k80kSjd2bJVnbrlOSsZNs63TYT1Qe3E5ZJyqgflNKpGqprNgzv4GSqprma11NXUowrSBdbZCKqR6lwtNKC1RpCeKa/cin6vmTXlskv0MSz/DarGauGVl1CLd/LDskNDV97CayuNFJNQ3dN5TjtnK8FbpmL+2cga4WLKjcdFoha1dN0aaUjm6TudVmpqtir6ptJuUQy8pB1KL2QJiaY1NtYIwapz5kHQo/Xm7WNK6dwDRidJZKtrk0q4llsGEmJFzDjLAgjii+X5jAHES6JoZN8roiEUonqoFk/hp8JlYBWTfAZSa78/l4w4ypkeyQCWhi2z/t86iY7AbJ/zbIQuqWx7HinBBY5hivPrqRpP6B9R2usw2Yw9JH/8+ocFL+M4VkUeOvFlP/7W98avX+Wk2azKU2wUtD/pQWxX/Tlq3frJ9OAvn4zmEfVTO5Eqkm8pSuAr5e0lDmdI/Wt2jlDXWSWB6Zv6J2LVEJ7gEEZEpt2qnqbpBRICpFwoqHNJRDh8aJTvP16MvRy0QtKWhA00IkSyK9xXkGi3o8OmFiE9MdwSukrNqMc1PBQa/xNdVuJHsA6iQFSdKTouIiyGHXhF9HWjXTCwoqEVOb5NhDGyI+wWzvIT6MisCN0EyjnFNVEzIAbEnm9CoOgiseMG+tNAcXXF6V0m1ju2T8wxy4oxR//UPD++Kp01hcXp1dnL2Z3F4cvz2bXB2fnOVagVbMfR3xiiSMM0YD/5pWmh7xm3ZuSspGJru/EZHJ2fX78cnZ8cnJ5buLG8Rr6yih81d5MSvxUiqb28urs+vjm8vr26vL08dBKUelXNNnCWnGl7dvjidnt6fj66yBzuPhjM3jiMKUCAo+i/Bwr1HeMtsDeAgLLiSsiFyMCvD/uqARhcsVDc/eTFCrZP0/bMEtFlAxglM6I3Eg9TAKUOLlcHhPosOUpWWBF9c3M4lCS0PGIxhkwQ3qiXEy0UtyeX47uTq+1kCt84GljtZT6ieDooDCuwBS8qSDIpkg91b/LBZEzV8dYwwWpvKBmux6gvkUPBIV52tLm2gl14r7qqgPE3B28R7F60DwBDcrDunnK/fK8sOzRHWmBGYKPlV7VhRX8AaVUHp1cGFwBCZyC3OoYeS7VixPJVkLA1YulRmgYcXGb4+/Obu9end+fjs5O7k+u5lkN3J2qZC7wSoOAhDqlpAi8Z/Ik7d1OuUx6+YyaGRMSJmr8e3bb97eaKBVTOAIBilHVjeCwnO4JCGZU8OWHYZJFqDbOE3wawY4+39Xl9c3Z9dOIy2H9MOKR7J5Jtdnk+8vTm7HFzdn1++Pz2tgfvZysJFodd9LsrrvIFjdP5pcdd9JrLr/yFLVfUeh6v4JyVT3WxWp7rtIVLt7uvmevjm+/ubspu8VjXUGau9pD73W4+UqvX5tqUPuf4TLMp3bk7snTa74j3lT7t4SS+p2x5t9437LMyHnsts1//7y/N3bs65iiz4+LoJLdkQ71uXFWA3dT6YoSgWJQDDRVG6RBoZpBdFWqcDxWhuKey+55BWyBZcosmJmlurjEXzxb1/8m/1DxCX3eHCkynKav2Vqldqmecmh8jITVCh6HM/Q2W19BBc8pJuIUPlblvhLhgMg/aiTUEVWNiQu0/2BThec37XS161bjaTVSQoCUF5/LJxr61rixdcqrrXMr5t01UqsDQj2CUWueoQqVFQKtmlWEqhchTQSzUVRlzVUz+qAzyUX0qdRVPx8/9Xrwp9e/+X/vPpN6ceOT9+OJ5Px5cXtX8/efHt5+V3tdZYy15qjPXAc4Ovj8fm767Pbq8vz8cn3NUN9TVigefYzrehYkpDNqJDgI/PR5hIrtKILDRr9VPHjfL7+ZMOLg71n2ksq45lyci3g/8D1m+MTiGIUc/OjJaVY7mmEJdn3nmEiPA3IZ7MZRbN+wML4A8wCcs/jSCAq+k8+EzLiYu/Z3jP4mkcg4pXi5mikNP1DKuFyouuygFwQrQkiU35PFcqv9uEbKvMOElqBZNwUUT7PiOs8AiY8wYi/BFXoOVprIyfBYcwpHu09g9f7cKJKgZgwVw1HV83XHmPGEUJ3N6Lf0BNs6EfsnkbpCc629ATDGvVaSH4Gn+3DW8URjLXKYIl7J5ybQVmIii9TclXDHO31ixnPdoKmn/pOahddqCmodTzVcz25PhWNnXqM5Pzzm3RkzxQuJVISb7FEV9qtBcNrn7njBHRVVHyxTXV4fAnJ+jj5e1IVIl8E0NH1fRtR1sWZ5pzfcUerh7tfDqHZeb/vvN9zPzvv908QQF08vk2R1Lptn0BqlAvaK0FNTibjcTjjiTs+SK7/mrw8TcFttQlYENS46aKkxHKpaKUpr8wUtB4+yuxnt5Iq45/DhOM0Fs9udWJCmo0VD5mpR6vT4LkuW8IjiN1m/vw8DnPKvCAOrbPRywN4NRoBDzX9TWxmnUPgC6PPPIKX+8/7op2qJUjghP9NpoOJNVezSDSO+PdtkLTRO+3ezYffHMXknChe1WNXE/RLU4UwhRONjpP2eGMabLVCXpHM1BFSjZpq6kE+T3RNqwaXauhQkr3G0S+d/k1D4faK2avm5dknxm/mMLM0oUIsTNYZmAbcuzMWceARLNXbRde9JVCyIXQgg0dWxFMqNqd6aaax0wI51EhTs0HFgFttNNUcVHu7s5PCaPhFW3/SFE0yjsKGkqxJ4XfNdAI+Z6HqmGbC6zuxmfum+XpSvWGynFJ7MOKwMKydDv0gP7escf8APsyEgnr1vu8kcIddrjIvsZapvM10KCwQN399YMkKIfjayYSU+sLYwBLP00/GCJzj3rJJw9Se2ux88IeQRurBPz51Gv0ybW9lNPUvVWc2ORXokqO+IPBmBsxEOTNYpwkodc1lGKxdq81h48zWycto2T1h2F8t/ixM4TXcNO0VDYUkcxbOze3vyqomupdqn2eVhbsQOZZN6NYYima6M5Hl/Kg8C7nfe4Fkt2mlVNj6rKbqmbi9qTVXGx5CbZToMHvquklnTRk9UFqvTdex7VQdpVebreyP/ylEkpkQsL1K/p8p9Fp733/8jB1PKg2qg7q1ofnEJhEyilzorszdQI279yQ1uKjkG5KVqjhN/VGasmjE+BEMMiqXRTwdeXx5mDZRdoBD+kGr1oY2RZOk0aFyGzn8/NW/Dxx0xHqf295eQISgYmT/PTK70ZCnqCmua1ahMNYH1e4BTIxUUBdXtCgpiyuxLeiDK9qk2uCTIBYSpYE+aVMzqT21AaaU2vO0+GeX/KKnVNJomcuWR4rkMK7KNj+nXEQ8nut8AxWEswrbKQWfYsyhfgMwKWDK49AvdNIJo0vBidn5qq+Mh9qKW5533eea+WcgJ4bS0lYtjXI8L6du9dNYqC1p8yvpmUhqKxKRpVowoW9uAnHo0yhYK/WuOQcg1kLSJWA4MxJezw1tjnp/pmcHjlPtRdXQ1kNsauVFHInZbGMsLG2WRH1fAc4YGEMeDsOsZaRNUfJxjBHbMT/8PgwO+RPXSPV8U/CfFD+Biba4J7TGDTi4psrKPMC1GSD7oINR+uclJaE5YRU4GexVX4XCarEWrFArr+FYqvHv6EqOkoEffbiEGNdGViyqfGkYL6vEaY1gxQdNKOfNhPdS8ybCJlVF7Ox8TAuk0oKEfkBF9u2c2zH1M/0kFrYt2dR+P1a09BorkjwrCNUrzWsBF9YsHUcv3B1dD/WqLclKa8kK2yu5DDNdZzxKr1B76tBXiAqaZSt8RX6OKUgO36Wy9GZhzLWSD36slvecH3kzEojavIw7wfR3JphOqSQ76XQnne6k0510upNOd9LpTjrdSadPWTr9hEnDd6r6NlW9ZvsfQVevB2rW1us2bfp6g3Kzwj4Zbisq+7xbYDGfn8hIBDriIhOMnCmSYOXiUVOdrxFCuOHvRLnu2HXVp2qb/LDoLJC3YZokgkl1jvw0QpiuJRUtaOKkVMmPCjyrvlXnMWt/LFZdvy7SippTvcSy9Sckxvpu+R3Z6Cxj7hK7y7SYi1+7k6NxTlvUBWTnUCWDS56tTZqgP6UBD+di1FquLwetoXZf2YS4jTlYCSw/i+qta5oys93cp3ZNZ8U8UFUz+11ZzwzNCp4YxJMxCWDAw6HPxN0gs90NcVt2/U4n8E8WIrN7IhWI3RrVohokOzo9JLhhW/Qt03XLnVP7fG7ys29WGfVQGz058WKnTMoqk1S0tb8OyVIBCNZqb9wzy1MNOGHCmDBwXjtXkljyJZGm05Sa+CYt2yYiVOYRBYL51CNRWoFjkJfWBga8Pgx+Ek2drXZWrSVSU1hFdIiVO3Kv+wOIBY0EvH03ucm4sKdTyenV1XCN0kT9iapXvzWq4BrUcNDmVFqtjuugktu6jKuTAiCtpzQtskIyQ9sgiOwm+YbKqyCes1AJfi/2QW0pk0eJSINhM+Ub6aSvp1Y66WYZI03Kv/Iv0Bc8SrnPfu3Z4VGhoowWF/4WC2nVRAJIRsVUujtRcjFE1duVCWDLZSwxiz+ZSRol6ohmArUFVtn5fYsaWSff63yXQnyFWtaBbXHL/IG+zyoPaTuvV3tBy/vt9IIHUloic2HWknLU111dPxw6EC3boYpk+rslWMYjHaUlS4OEcplLMLMLJbmjIfbY9oxbEtXzkNbXQk1Uo/DDIL93Bj86dMlSrqJDzeu09dSHLe/lDN3zL2a7zUxkXGaTXXBpqk/qE5owBVWDonYgJR1nhBkeQWRyKPnFJCqCSoWyll00VyEYJbY4sDvCyMkRxS4e9WHFhUx0minX6FfQNPNUbqVwqUdh32/vhV9oNlKx4aPKrmprmFOx1PzY0Em/9+pGfG7M5TbH35TpNJKo3MSIEZQiUDd5TwJmpKsC32uazIEeQC1pYllvVoXY2xZ3mW/y2mRQWZDVin6kW6T+LV+5M46vxvb9npZg1Xu8N0dOUzs5xYCPZ3rIyFCLwIpRj+bUBcBCISnxzR9pKFlEk/XSXAERyqgTJGEhEL0J4L8mlxeH33BDfRNujHVyljSUWG5jAUQo1BTlVXAMHdnUSqM0/dcPr3/UG4p+IEq9fGD148lFaHcxE3oySV9kIYjSivsG6QdEVl0WwA2yMYWA3dEjGKBeLB3672o3/mMALx4WVL2V1D8HesBER5JlpenA+gUTsfmcRlSHY1H1qN4HHin8Q55pHJrqx5ZD+CVEfnj94wBe5OcFLPTpB3id1CBacX/f7HixDiX5AEyAt+CChjrXAp6LewqCq1gxGgRD++h4IGtdCVqTUnvbrEgkCxqkm8vTyyM9mlq2eQhMQMglzJjiBeoAy9yJE3HC2LwFCbH6kg6mjZW+YdQ7cUCVOqdBrVM8aJ9QaeI8Redw1+dZ1XHjFHOGwUOfe0JNUFlIxSG/p9E9ow+HDzy6Y+F8qDbZUK+8OES+fPgM/7PRjHRyR+dp5e6Cx5ybGkccPu8fY6tfUl1ugucTa68v9C7IATkOtyS+ZoEkXD/6NlYExLhEbz00NukhCX31u2BCqr/3pljMHA/wu/Hpx9ncMet9WnvGozY4SEC1k4T9UFPWaVgWPLcY1doYuYqrES1Tw0Nq1e9SATNjKWoVsrONrapHWhNTmlgC9exDFmLZqZxSQ78Z2zXK49A+NaH0mACPCFrWFPbTDVqgtwrVQWKBMNojfJbhi/lEtUtWeQDz66sT1CBVyt52zCYsO+GQvNyVU4XFQmTQsDKadUzSqmcBTI5gPIOQy1TaOQAmM1XacEtZ2kqzsnF4F/IHFOiTRBx8lp0OE0DgXcg+QEhCLqjHQ1/o/jT0uG8yuITAQvmnz0dwGWLrA+u4sCShDz/5RFL447+Kf734yUw5v8c1PmFuDMFCj8Krf/+3l8OXr4YvX8HLl0f4P3h3czJqKIWGmHSuzIZ16FqPBrayZyIgQpoapNQ3n0zl5tJ2Tu+Wdyse2iws5sEUURmtzS7SYPJ7yQso6fmKainumb8STOOctU7X8rQKbbXmcShR+m6esJpvshdHcHF5c3ZksbH6iYDP52qjastDVt1gXz2ChoJJdk+zbLC/eCuZo+wnm1mfnrrS2mQIUvfMdK0huYm6LHVDat3CadNiDcfOnlEfj4EjOreS38biU/HvdhQ2YN8HOhPxA6shmaASJIfBTRTTQQ2vt0Y+iovHQioE8OK6Wo5fu8Hq0sVkPMgctljSFqI+vmwfcWcpPG5x0E+0r9oQ2Ego+OuChmY5crE+BjITOWTzq2Kaok7w4hL1gmJJgkDrYUKz09KVZjNgMqdwyezqTGtjWVH0ULWFHSQXIlPkmvdwmwywZCFbxssjeNm9dGurVavJosUaTVkdrKIuxKo/OQsigDK1KEh7bRswqyZ1Kj9YRXweUSFGT7hSbG0M5V7h3dUtpnLnObzzHN55DhfmsvMc3nkOf8zw5p378M59eOc+vHMf3rkP79yHd+7DO/fhnfvwzn24m7Jl5z78z+4+vPPg3Xnw7jx4dx68Ow/enQfvzoO3QbOy8+DdefDuPHh3Hrw7D96dB+/Og3fnwbvz4N158O48eGv25c6Dd+fBu/Pg3Xnw7jx4dx68Ow/eLZS6dHTg3eWd/rR5px8/4XRjpumWFNPNuaXTpNIX2eoi/5R5pcczIBDShxTXbNVpY140d56R3ytqL+DWh6v3Jz2soQ3erxruaEUjrUGTehOcBIQtKz18J9jh6v1JR7/ecY5fF/0imifvZLUrOyeWBAsHQtzXWSprCFGVu92dKIXAAXPLJtKERia5CvR+Xqz9iMgqUWT0KCEFzTO4KSxVlVXduHCmLj/5RtvwO38cj/PCBsuowesMwUx1MGebhWrFRnAVUCIohIlHwj2NlJo9UU1aQ7F2tg7WqXOQt6Ce0g/DlMtFcUgS+jX4Sg40FHFEdT8SGaUjDiKBEm+hJcwRvDFjWwu2xo36sIoYj0ByiFHzYLVbxvTWuNPQEeC9+0Ha6AjdOCtYH4izhrVxetlbvDSZk+qPJU/+31Zyca3ZigWNnifHGWY8sjJxpqxZDfG181dyyxjWdgB5P4maB+FoF0WwiyLYRRE0EzsXRoB6De3JAt6CRMSTNFKnyivqtnKXsz7jnc2Zhnse6kOdPH/Es8KTIfVret7BBreB9y4K/ukFYM0CWixu9o/cqk9WrXjtpFSv7V1w4svKYVdVfWolZUcX07wXrXqLMAFEiHiphdNp4k+DXt+p8xxp8DLMrUA6lqCy9MbzOdX+PVl36orH1GO5+VZIMx18fit61yxgB1/F/FqSMH30lOqLOpM5/zhDIqPDlqY0KV6JLMwWWXui6Yprz1CHzMUVC7jFNMbP31d3rIrb6PPKgjr41qhIZxJCFoDkiV401SH7dEbiQEJdOVClb1ElbOfsnobg6UpiCBp9/JZxINkqSOFoZPLgqDgCHqZtVjRCxe+pif8YlwPiUpZgQ3tIHuZBoYN+tadXgXpgaeY1Y/M4osBjCQ921kQI7jG8LFJEgBnOk6VL1YpkehuzBZFZOBYJTbvxDJY8QpKHSIYqkHgkUfImhtQZeChF5kAg7aeUhrAk0Z32OTAYH0DeOJMaFhDOnIYU1Qwk1K6iIzhbruTanFTraEmCgD8Yf+fUaDN6XnuSKw96k09SpWfRp/AaghMeqpsuEtpdHV/K6+T1MqXygdLQ/ZGusRfaLRW9GmZxAC+ma+2wbOPRiOz4+NcycO6Zvw9TOuOR2mkaaOVDvk2QaX7Wt/O35v4ObK5WG1MKFWhSx7hazaohLogwcR00zKybCoBU09OP2Ea811Rat44bDuSeM4wLEUwJnYJ6ccTkGpgQGFfi/R433fOuAS+/aze8tG9isNq54EnzzC/KIXlXPF42AjkYuZscihq34UfzdcvI0J6VsBY0WKnDKjnEqxWNIFAKh8ymES/YiI4OgKxWgdUup1/3VUefesyn2bjWdTZehoUxBQygZLhLbIhVgmnulQVTIqhvTe+KcMBnZooR1eH1I7jJ9s/t8CCAO0pX2otPDYdHk4QGBPfUnSwstfInJXmVZ70Cd56AO0/AT+kJ2Mse/XFukZ0f4BP3A2zZuq5ugg4WZM29m1zaPtKe3HkQ/p48CFdEShqFR/C/L/7nj78O9//zxYsfXg7//cc/vvifEf7yh/3/3P/V/uOP+/svXvzw3dtvbq7OfmT7v/4Qxss7/a9fX/xAz350BLK//5//UoHMh2HGNUuJ+Twa6rOVc4FruRh2STRrXUl2blI7N6mdm5T7DHZuUjs3qZ2bFDw9N6ntZ1Ld+UrtfKV2vlI7X6mdr9TOV2rnK7XzlXoivlI7t6SdW9LOLanTmdq5Je3cknZuSTu3pJ1b0s4taeeWtHNL2rkl7dySuh5c2Lkl7dySdm5JO7eknVvSzi3pKbslPdXMYPmcVpmcYJOxqZGaTQKmzbqe6jTyBBvxFQ3pNJc065kt/iayNlYWBiy0W1yonaR/PWcz6q29gL7lPk3yVqUWA/zDM7jg+IK2Z01JobEE4vvU1HuS3H6DeDWPiE8t94FVQEIKgpJlQIUI1maMs9WCLmlEAsT5hqeF9YBIiOIQRRmta1hyP8M6Y6Ge6Cvuo2xlS8QhmIEiSoGk1A40ABrKaA0koqi/1pLPivvjcMYvw7dKHko2CpGSeAtr6LFubWr5cqvnLagfKymztIBXEeNKp6B9UcqLaJZu6Ak2zKRy8yKGJef2kDUewb+/ND9784BPSXCq9YgWodwNNcAHzMoMDF6Fsjh5XJxMJI+yBdJSHMCnq4Cvl6i5CIP1aPBY8w65T6tn/OojzFiNXjNXewi1Qv2aB9R4yOSoEE2JNyKxXPCI/aIl/JQaTTOXPML5ZxLpDY1yac/U8MCzODTcRpm7mUePPZTZ9wDqQWa2kiBJS10Gwjbfi3hATVml0kQd0Y10U7Ji3+gsfbXE2Kuh6OOREnGL4oAaIlokFQsemJD29AbIRc8bBmka3dNoig3mVA4OYBAwgf99INJbDH7sB9xT5tfeI+SZW9V4poWnbTvbnAlaJEoQ86AOjN6Kqt+0T4X6bdVlHEG9iMpG1KsoU50msmqAvC/ChpTqP67W4pYGTulXiUKOrrqU26OgdajFoCJ2ZuxtjuhG+nTeWx278zQ9ziOfhVkmVTUMunvWzSyZkZ2iWcfc2pp9UIFCVTrZKhw8TCxr/+QniWUbtlyJ5ilmBveEMsjXn3X6yTavViZBQ/OTVERZYTnV5uZdoDv87O1VXsbZW8zxrire0RU3NNL2ka/MtJZrjyvT8uiDQYJ9V4ZdeVly/1EvX6EgCL2CzmwnPR1Vx3T1kYSBRv7nPlYzv2uaT2fR4wDfY0rMfvJiyOPcXb2IqOHr9+eShs0jbGGbbl1+aaHARvfnH6og/qHy7lPTKdPyALJfFP/K/NPj4YzNKwf9mM/CLGP+DTwLS/dIh2ehmYgkks7iYEJlIW3+Shx2vlSrb1SAgExpgAR0hAKQ6teUq/eKhzSUw+59k2T8r0dfjl4mqjpBA+pJa9teqgN6nsER1PwdBgJQVK9omIsFcQDTf7bmRkWPJBikjdlgDyCiaBkXR/BqD0DS5Sog0phjsisLkF+jLhRoo0EHKmy26s0rX3TfX2WVZRdOesFU1Z0efbcTD2kR3FxorVUqs2FElckhnQgAW6L2XB3ZuYeFJVRUg3Xyz/Q5un81ejXKlk8h0VwUdfiD4fD+qy8G5b8qSMT3IyrEV//y4vj09PpsMtmvaBhQ4tNoiCeH8TDbgob3xfFMrJQGt1fwGNbqxsN7Eh0GbKomcyi4d0elONTPi1XEP6wPUaXMvbsiVa7iINCVJFVY6wWXV9okuFd0SkbFsqhGTQ849FmxsMxSddJhYa0YVq5lRiHWaT0z/Y7uPxu9HH3Wvqbtq7dbmwSqoW8V42hbn4q+zmtUfe4Kx+kr1LEPOpMzu/Ey4kCnjZfpd3T/usBMXFe19wZtpNKMEhlHdDgnkoqvbviKB3y+/kqZiipa0w8yIkMtjQ/tBVfXeEllxDyRYHf0+vXLV68qGipzGI/lV6+/eCkqPhv/8uFMKMPoV/SD/Nzp/L39/vbi+O1Z1fn7OuLLo72SV5Cy7hspsfKbPhhJKGtIlrRy5K2f/E/JbfUTo+OOt53UGd7qdu97x348Lv3p1ip5tWCz8noZSeowbanNhUepBNdtjWqJenl1dnH2ZnJ7cnlxc315fn52fXt6PX5/dl1N5sr7ogLaZHx7dnF6dTm+uKkGFIfsw9HhYZ9lqx7u+Gp8++76vHo0GzSacVXIt3sGlysanr2ZpK82E68pF9Quh1449a+IqF8SuVyUoC2I0HECLBSSKOejxgko7je5Oj45q0Y/fT7Wgxhf3o4vJjfHuIQ331/VgNKahqGdw6AN5tnF8Zvzs9vji+Pz72/GJzWnb1C8WBRPgAqmYGOiv/qXF1V7peo2jKOg0NosdVVjvXWy7Yv7en/wyTiAHq3i2VM5CFUxV6csQm8gVPw0mwaUV8EQjg07h1rjR9oEw0qmFDC8WHJQhfK1j9zVe3GArgkiE/hxnGqwHt2Sbm+lj2VG76zX3kihXHYQqlYeotpa+Rc9oqW+RjvZQWPeSLseCNQYB6zuuNoq9Sg60GQX/gYUoPkT84ScYkxA8DCicyZkRFrOtPPZ0BJRgxU5cUv4mGrz8mx/A3unZom6bKKtGN8bGl5wn/YwuPcyte8V7emVi+WyG9C3sNG8/tinz/HUuZs42w1/W7JK40WkbyF3c3ntiI9tnutCltQymrvTPhKH2jpnSjf5NnlSf15kRsF1ektWfZxjmPAEI/6y+uDa3rbVEfy6BwDw7P93OGXhoVjgv9gMfoDhBzhccCEPhfpiO8CP/wFyQe3D31tEnEvdEAotB//y/+kHCw3yAGMRHQbcI0EH0HV9agdxhtwV5x4o1w0haFXHcssZy56yU0KXPNzE1qu2vYuVt8Z6aLq3wu9oCazqtWXLbzJEX5tvBkCfuW3FeJvBob/ZNgek3zJt1VSbD2VwNdJm+TeAOj0XVCpdQC5gqc1si1C0TJ7y7k6a6EoIW7fCbGj6VcFlAZUJgur2Ga6IXHz1Ly+0gun2+uyb28nlyXe3V8c33+ZgBDbQKY/XKqITyVf5PwLQD+UUewAq2x8JsWKeuW2UIDH01P9HSxhGMzjMIndYFajl0ETNEDWvgx9d9MjNynktuDcrc6uo16LrN4thwIvKeTQP+t27N2e3F5enZ49jgMLEwWpfX9QZoHDsJo17PVdyUV9qyrSoL0tWiLR/bps3Q8k27WLysHlvMJ/MB1k8GuyeBXReiKjUPx5ZkSkLWFUCB+LjETk5vrp9+93F5ak6Huofk+8nt8enb8cX6g/pP37MdcfUVld26DPhkYCY1AZ5HB7LXFPDu1Ad6Ge027h7xqf7gyegac/sZDd7XmESn+DwdTdXuTCyCoJUA80JATu7VjrKWahMEoenTBDMfBpLdR0hswHjBnEAmE/EPNFLECLqcSVLYf6QJLHDENNGCpnNfZ1F+/rs7eW7ixtHG9fjc1+fKtGtpb9P76sH574YYkNH65W9TUvE1HHKILjOCkDCte4vQFAZr9ReYD7NFU6gEZCIliCtIr4ic5NByLuzWajxxbYk3oKFpbycGlPTT8fbvmE+i7SvEAmqLZgK4jDiXDbMW7VpH+xbLuQNP7HTqh5OPz2zaoO6QXMP70I7EU91q8L3Wrth5f5Q00Iw+Yu0erPoBAanSE4erfc63P+N49RIaLcG3vqwCYvLSKf+2HM6T33wqJYU++FUd8w64dVpUep3m2eVXvlBjV+WSq9wBC+/+OKL3FcHJVj74WqcbePs/v8DAFTe9Vz3XAQA",
+	"openebs-operator-2.9.0.yaml":     "H4sIAAAAAAAC/+z9e3sbN5IoDv+vT1EvvXtszYqU7SSzGe1k9idLSsKNLOmIsudks1kF7AZJjJoNpoGWzEzmu78PCkDfL+gmZSsZ6plnIquBQqEAFAp1fQYnESWSwluyJjCh0T3zKBx7Ho9DuUdW7D2NBOPhEdy/2rtjoX9kG9k2SyqJTyQ52gMIyZIeAV/RkE7FcEnWZMhXNCKSR+arWBEvbbI3HA73nsEpnbGQwjUPKMgFkUCCgD8I0H0ZDwXwEL77UsCK++LQp6uAr5c0lMKgdBLEQtJIAcjhHE2JNyKxXPCI/YKQRndfihHjh/evnBGP4oAK1WgIZMW+iXi8Ekfww+APgx/3AAAiKngceRT/GHKfisEB6F8OVxH/sDbt7mk0zXR0gmYphiCFJjz+rghh/3tIP1BP/SNDmMI/D2csJAH7hUb4JaKrgHlIEI+HMuJBkP8iqAZB7y0wGvorzsw/PB7O2HxJVgYvLzLt/8anukHEQ/y999SFJJLO4sBi4hO65CH+qzdM+4+fYy4JQg3YksmIhHNaAhswIVWTByK9hfMQLJxHVAi9SLjteChJsOI+iSUXHrGEvqeRZF7VF099manloYLNQ4T4c0yF3BKKQvKIzKkXEIvnSp0XIWko73kQL9Untqz84kZ80zYkK7HgckQ/SBqFJBiakc0ZrMIt31MTKvcndWBLSMyp1IuZJQfuQsXa1G/xyje/rexHnwZU0ir0yYoplEPFQ0QDsl4sJF/aP/mKiTHkVgX8oIhgFV4GnQTDCrwMe6rGBgZ/KAw7+MMAKqB4ahVGW4LFeeSzMMtZqwgVUCJo3aolq2Wpk1IipY6hWNVi+Usm1EpFdM6EjNpwuScB84lk4fyBThec32leFuuOuOOWsWxoUD2LdEmbppFd2pCH1waxd9fnCOtwSWXEvMoxXE/3ivs+E1G8UthOY39OZSW8yu2Y4mxZir6g37DQB7mgRQEBHphc4Ae8u68ids8COqditPcMbi5PL4/gZEG9O2Az8OmMxIEEYrqSQHAIKfUFSA5TqsBEtHyhq7FZON/+vS7i6d+oJ83VXinbADSDsN8LUk3EA3pNZwpwWT5pg2iXuGGWuCpZepDVShwmAtppcu9X0AKHIyumhAlaI5UBBGRKA6RMbT+AlIcceny54iEN5dCl9b3F+/XoT6OXe2JFPTWUoAH1JI/0sEu1A88zeDRg0g0XK+McwSs1quIZdL7Wg8j1ih7BNdVnAv+kpCMWzt/hKT6CMA6CPQBJl6tA/UEjm6EzQJ5+LZh3pWMzLQEALD3x99yGvmjZywBKHCQspFEG+WEz+gBsSeb0Kg6CKx4wb30E49kFl1cRFdQcoky7ZPzDZQrsKMVe/6x4JDMoaDQS5K54JI/giz9+8cdMCxre5zs8g8urs4uzN5Pb8eXtd+/enN2eXF58Pf4GaEimARU4IXXeLJVAcjVGSD2pfv3uS1GANyWC+sBDkAsmQF8MI7hR/1D/m4c8oj6wGdDlSq5Hhd62nYhXanrUhxmP8kjQCMyCwsvRF6PXwMMHEvkFPOyCVE+vMCrckyCmRzA4XPAlPYyncSjjw9FdPKWHegaDBqp9Obl9ezy5ObveFtGI70dUiCdCtWR6dURbSLk6Ojx89e+vR6+/HL16/eXos6MvX375so5mF8dvzyZXxydnsIr4PfOpwOsxYbLAZ5oO6eMMiAASFuDR8J5FPMTv9yRiivaF05CfTTJwrpWZyNcRXx4VPgDMGA18c09VfrsicnGU8LZRMomauU/Ort+PT85uj09OLt9d3OQpYHeLvf4tHVbcByI2nX1h6K3TQLHUUZmX1hDi7fH3x7dXl6e4KOWNsOW550Z73NUvoDqeZQ/TyfXZ8c3Z7enZ18fvzm9uJzeX18ffJDyXCZiRQKCYF8LlioZnbyZWKCzAzb5QgYS+/cOK8wAeWBBAyCVMKehb2h810qcNtSqSHcFARjFt4Izji8nN8fl5AvNEAb2dXB1fT85ury4vz8GnHi77w4Iq0dbOFfABBmJFIkEBpyQWPA58mBbpa18e1EceASsS4cExFyiwUEgSBFo0LK+NngKQhpGRmFOaGekA2AwGuFQDYDJP7qRVJZNGyoEkd1QAnc2oJ4GHwVoB7LUWz5DtU7xW1FTaVrl1SWpWWk+2falPrk8rTycwAbGgPkhubslDnwn8orpkV6kwyCziS615Pb4am6tsBG/WyYrJBcIQyUIZYNQvQGogx8n1aaGx8yZ/czw5uz0dX2dnmGwCvNjBZxEK7Wu8lu3O5CEsuJCwInJR3Ct/Ve+8hAN4JMTjTUFpuZja6zMWUDGCU0MDHEYBSohweE+iw/Sx0kIHO4laImTBNRBDb6eb4+tvzm4UPER9ShPCqDuCzda4ZmryCuUCNMmTDopagtyzcI4dxIKoqSsJl4bqsMkHSkP9ifkUPBIVp8pn5kBrDZm6TcrHkiZbCSmIQzORo+ChZgitwlJx/rVyZhl2K1XV6bQn9SmRFpmkIqzmcGcX74EJrbqwuCn1hEckXmwQcK/qnOOFTy3n9Smq8T/CYhXIup0V+6/x+2MNeSsrZaf8X+yewERf9FeK7AEnPvVhura8og+9Pvk9VbU4OQq6LErDapxfnhyfX72//fZycnN1fPPtdhfFqgpsr7xotpUVOlRnJniS61RFW6czhFMatMkruA1OLi9uri/Pz8+ub8dvj785q5FRLOS/sXuilSVu4K/Prs7HJ8ePCrv2yXUEg89aQeWuFRc0kTMPmZBz6Yhthhm6D6BYf3f4b795e9NxkOFyvuw2k/eX5+/ennUdS4sJXUaz41xejNWwLkMth/QDqmmi7rQ7+39Xl9c3Z4800Ldn51eOsAMWxh+GsWSBqAKe479nF8dvzs9ujy+Oz7+/GZ9MgM2yPAYEDX0BJOThesljAbEg8+I7T1vZVZdvOJ8HFI5DEqwl80TbpIqjO79l698LZ9e3N99ftZAoURsP9mrokqB0ezW++OZ2fHFzdv3++LzpavKNjQ1eMPV8iCOxn4OuLqYVjRj3mQcrJd4ZwgkayirqjXLd7XuCCXj9+SL/7S0L2TJeqm+vFiP4nseIqMdDZafX2hrJ4YHSu2CtLjxBpTIRwqs/frnYa75FaiixV3mNvP58kSVpwO5pSIW4iviU5jU1yukj/xcAjy+XJPSLfwYYwmEsIn0vHU5ZeKj0p54MKhoaTWruCxq2SXBKA7KeUI+HvjiCz17m2uiVSb7+Mfs1osRnv9l5FK1tRU+oVivb0GgOXaxtzsagobj3EtNZxlxidyBZMTOvVdFasoq45B4PjuDm5Mr8TZJoTmXOsJK3x9XYgARF6/vxDL0f1kdwwUO6iYHSchhUm6reXayU1Z0bqerQZTN7Zd0APbD6ZJbL+jn0oi18MhtmE0a9DZkW6N2XOcAlo2aLhbJsazOAIQP005jbqhB5bJPbSzS3qf9vtLjV2HY3J912zLtbpNzWTLwW+MXl6dnjWI3QXqZcYEtWsidot0QDmrUglk12+StPbRvLkXAqBwWo6MCcXW1j3ZnxSK0zgjQaW82qD7XXFVgvzwpLTsgVSORCyj+tUrgYJOrxRIsTcH4Xr7azTz8bXp+8cturJXrWbdfCRMxvai6FHudnx6dn17dn52cnN+PLC/PsOW0wvASU+DQCvKGVdSxjVCnALrRUMDUsf1Q9zRpk6ibZYmLRusDjm5Nvb/E4Hn/99fhifPN9w9zs20OdLyBG8Crr89RGUyodKzVkXA4y1hUm4Ccz359GqLFDD0mNvgASFU9N0hotsz8ZpPyfRo5qz/JU6yhnBioS7yriHhUC4ad7HcUvRRUmAL26qW+J8OoL8BYkIp6kJU2/OVXAtEFgNY/oCngsV7EsTAgmHGZxEOCwHgmfy+SsoUELXSF1/xd/MUOK/dExRHQeByQC+kENJsrWAeQYiL/hODSULKL2FaQnuiAifS+z0uaE49Bb8Ah++t+f4CgBRsI1CBlpSwiRICSJpNCo/pQXhX4qLvPoDz/VA1LYFAHAjKuwDa0Q/oVGHNSycDWRBYke5VErFhV/HHoVf5RUSPhJL8/Qg8H/FgTB0R8GP8FXKFZv+7nY4+1jvd1bomeaXkBVIJxk9daO23kNVQ/TG8NOL6NNXkB1eG9A20/3GkrwSaOA6l85FY1LLxzXB1TpJfRExMIt3i7Od8sj3SxbuFf63CqFXfLTXsdLpdj/t3WrFLDf4q3yeM8Ws1Es6pAe76qnS9qs5fGyzYfLdh8tHR4s3R4rJbbaoGWq4KtNqqPfPmPd/mu7uHGzu6Bq63oBD10e3dt9cm/7wd3pud31sf14T23Xh3aHZ3bDI3t3j2/tHi8/D7vd47/J12EB+0d9HZaDjdPX4gmGGNs40dMkxDj3diRhyKWOTj3asyK/vsGHcxqiHngas8CnUe59cf9y9DleMsgSGQ9v2JIKSZarxFakj+I04N6d9VpMXyrJ02+u4xUzX3RPg46eyhsF5JQaO6haZyG/K345Z0IzjVUQRyTID40fxIJH8iIFDjCEqa8/sRDPUa7XHoDw+IoewYW9llRrQ4TEZkp8HwlLgquIhZJGJ0pFG+beTH8TPNRX3SjRbx9LGbFpLKmoUncbNXv5g36S6kPTMELBUU+Du8r/cRUxHqHl9VX3AdDVei0kXY5m4ma9KiP/9aTw543G88iKeEyuR8aPsDTchP3SkU6SyFiMMGXBRFrLZxbkSdUnZ8CiEuYEP3aCl4hHpdNWgn48L6Pqp2joRvevSLBaELsIwlvQJTnKKRSOr8bvP5sUPgD4VHgRwwDx3OEDpq8X3SO57iNqL0CSa31yndsVSu0gGS0EbWZYXe7vBSyeK1R1O8BECuamM8eU+mZ+SehUglTZ3RpUIxICx/DuEXpp0EhYidD69ETU4/OQ/ZLAFlYSUKohIQswkSmEJNAixwEqv5dkDRFVo0AcZuBhEzGCtzyiwMIZPwJl1BRHh4dzJi2TVzdXrFT3h8ivFRfhkcpuc0+DQ8HmQxJ5CyapJ+OIHpIVGyLqITL60dJ/lsT9Py/gWrkZE07cuA6KIwMTQEx3PZeU3Nap/vpscpMkHsAlKa4BUj/tKNKFUGRj4YxG2E8HwWixRqeYMcI6y79s1I+Ip0smRSK1g+QjOMH7D0UtdHTwRzAO4YQsaXBCBH30ZVDUFkNFWveFKOoc8x007XKfshrAypXTh3Kyol7uAKXnEndsFIeSLSlo5oYHpQAUShd1+yFXP2Q+j+icSKp7lhsU8D3Ot4cHIvCMhb5xDMSYIDWFBaMR8Ras6CWenswZj5ZEvylC8IigOLPz929H8C1/oPd2pzEBXhxFNJTBGh94bLkKqNLFF+Kp7I895CHVSE0pRHSo3njUV9hyfFRMqZQY36fS9YzgBtv5auN7pBpy7b5QP/aObCXhiWlY0a5ppdRPwOeKnhPU2Ks7t7pZYcDzYi9gIitrWbBDgS1A5C/z4rLBdC2pqGmg1/RIUfmz1zVtNBnVrpnnFCzpz2qxFj0melXqVpypBTzUTWpnKbDvx5iqkaic5mfCbOyFn52Y3X0dkP7j5z2RthF/1TgPoSwktvJJ9YOyYI2+Kn+ATENLh4jOaERDndNA/eHN6Qk8LJi3wDctwq3hFMhf3pz2OIr1ElI137waJ2oiPsuiXTBYO3IbyCnxXDB4Pp7pIfWLnwOBFaMezYldGKhKiQ98VgOThFaPoXscaBpqNDPCmjJeATFeCf81ubw4/IbX7Uk1DyAeKn5QcF+i+k7E3gKIQA4dUR/fAqMlCdmMCjlKDWQ/vP6xjoZfc6WeIeq+wAhpubB4Z3aNdhInqckNdSksrKMArLhvpv2A01WxTsDNdGMKAbujRzDQT6cEzb+rB8A/BjVQXzygsglzBw40colkm2YfoBkkUWkjIzaf06hmewN2QXf7feCRokDIMyAQMBOp+qmE9A+vf6zFOE8vYKFPP8BrrW/TaRL2jRueWIeSfMCLfMEFraMsholJDgtyT0HwJYUHGgRDLRj58EDWigp24dR+S4Lr63erfU/ovFYmiYe68oEJlCYwxyLKDPhR70ZWx0BN+imQHLyFykBo9YuzWEmao+d9T3OVkN8g7BeZyCcUl52niC9gpyleZHZ84xSVkiwKqVLkMH7oc0+oCXp0JcUhv1eacvpw+MCjOxbOh2qbDvV+EIcKGXH4DP+z0Yy0d4fztDL5ZB53bmoccdh7avaV2OWWez7RjMQr9sYoHLyRjRrA8N4aoEzAkviaZZNw/eibW5EVXxje2rpJDEnoq991Hk1v3ZuOMXM81u/Gpx9ny8es9xluFOB8KgkLRKv8dqrbJVKCuvKZByTRySoyvDnFaxSW3KfBgaARI8FB7WtP4JNOLnoIcsrpJ2AkdDzDJ0lzvDglCX1l6Ts0V6jOIyASWa/tqap+pmuTWwBmLFo+kIhCIvtMrk6Grw7wP68PgEqv7zbUIyilsNM0T5PmGV0Q7k01klog+3ZUi6STDxzUztBn4u4Ar2rU1h9AcL88AC9ar2RNHxrGy6NaeEOE2PA5GaqhTUVKhnyDgPNV0+f7ZcPXpsnhBJo6L0uh/l1WOmL3HRbatravKbu+U+IpDqLBHcC3p6eHk8lpz+X69vS04etk0vT1XXgX8oemlRwM+j+h9IG7ptqY70SyrwudLOXUnkwgQmS+9kVtQSJfAeqoA/m21M2iZwFCRs0DLGxgS4+t+zB6J9RcdtVmJZ3s9AwwrRVxUuAARNR4dkzXcCjW4hATZBwiiEPhk8OfYxrTQwP6Fv9+26Ae2w5Z8MpzosVb1RKlJfyFz5r4YuuOs8qxbutxVexlF8SCe4wVsbA/1pJo+cNNSYdNdc4S/C2Ml1Mabbo49zT0rZd2CwbvsSkwYTptNnSLyHcfsPDORea7P1cNrdAnQPCZBOys8CNml2SlCiXAH07XQ+YfjkbVGh7bJI5rGzFJl+Joz1HiMXjCgge+9WxbYdKCJAGVuh+VzIm419AU8UfUdXtlblgP1a2u/636Vp/BFmm1WU9QmJK1CmavdTVyFr0Us1Et0ObbHV05FKy2Fg1CjcMBAKjdaVW3hGoLxNii0r1W27thmzgjaBuRKCLrPYCOh6m5e+rz0XrYvmYBnWDT9LgtVdiBttOqzZtCw0F7vJu044kTQ6p6RmRwtFszsWk0LHGFedN5gZAEV4oEjperbV7EPUPLDmiPHoH/5n2ZWjfGRa65dj7EZF/6QW4mo4ACD43C5k21bM4EECmJt6B+j81jna6cFsI6YllWllWHf5coQzTaVu9UuxbJvPTErQNNw1w2XaMViWgoHe3rg6tM6wbr+ruMtkgPUAEZRTJzsQERln4eEVRn5bGvZCVvZa5fMYL/CZXtpAKmsaYc6atX+MRC1VggXPvpVcmoPuhqVc+g2Eq7q7Rt9sSyWc7/SW36BGr1fcAjCLmEF99TcXjB9+Ek8T4gwQNZizTPK4cL7uQ6UH+FDmHwPRWDum8XvAfN5MKBWHJhbwe17KoTvKCj+cgu33S/28D1ZuFhYp6u+GRFyIpPee5W0aBCoqg9itp3xsUZBxvm3HH4FN3vfQSCvKeft03q7uhm5MamxdsHgaSYJNdP/e3YtPlOGqzjSvfiNX6/plgkqLOzjHCigZ6+5Vr6EFZMXM8bXhx7kt3Tw3FI9C9GcbTfkSIaTM1HC7yWXnW6qr4Hp8oL1n4UFX+v2f8Vf9Zb+iibU9M6o+T+Fk8zlYr+/o+99CApk/1KUv+i6DQ+GOS8wfGfypde89wj+OHHPT0Y9d9bj271x9+6mz2u1lZ87fFY1jvc4+cmr3uNSYPrvVfve++ZsR/LAX+azqPS8f5N7Xdn9+/Vgogy4KvCX38P3t+4Ewou4EoGU/8sNdv5fu98v3e+30/L91sLekUHcEOu5GmujjTpKXUW+G2r2FXgv7Vulgh32KCTsSZE3DNecqN1kBOzqHdTdbyp62mnk3U3CBO9R7WqS213rTUgyjEhKfxisqeYKNV0miMYS/BICCz0grgGqhoOY6NhRlgQRxR8viQsBCq9UQ+dyoIL6axT+dY0Lj4uFJBq/UoNVMjrXUC7MWov3AyhVqWQd+c9AL31RYW5NeiNGuxRjzivNtOK5Q6nHR1rzBw1BBMAUf+Oa/V0VgHAiW7Fzds51yW7DraqkuQaIyAYe11vhM5ahZAHmt/R+5XZ8kpNvh6aylPOA0rCvdpB3mNyP2VRdXPXepPv1DLJRs01CzW7QjOumuXXqXZe/QkTvAkKJMK49DRW5o0b4q/24YLL1Mv3CNI+4GGl12y9KF20gd1TeL1fAzHtjygcZQ6FAoIOvCRcZ80MPMrozWvAfrafgZxaCLLgNegsYBTrEsh1WvbxzNy3X6NBGJiwdzSSRNc3Qz9e/d0Mh8HyNSCTKro62BaX8bS3n6Ae19mmYqaRP+pWk3AAdH5EP8jPD+DDTDwac6pDtqsnmfIw0jbJyeT0oNZZSN2LVT5m6jWuElIclcuDOMy06dosXSv6DjHyAI3yCiiTyyFltkozXM1wstriEbwTtGCaGSX3swnI6DqrVGXTNi2rPjHFyhY0WOmdvQoI+tjjZFBIspFrB5WTGl9eTXpcL/ZhUP0VchqGZkjYOlxfzppt1e3eHvmWLSbfFZHqmXkE//vif/7t1+H+f7548cPL4Z9+/LcX/zPCX/6w/5/7v9p//Nv+/osXP3z39pubq7Mf2f6vP4Tx8k7/69cXP9CzHx2B7O//57/UovRhmPoID1kohzwa6lnUHpHy/XZt1sX8eWoT/ZiSC8kGS5hOHeel8yNgM6tbTDqqk//qJVaYNFuA+gl0rz5GEgBs11QMI/eEIQ/AMA+UxtOK8c0sudYY3xbmZjduV96Zz7jYsAQT0zCbxGemHvs5W51lOTwUzK+NBFIUwYNcvZXbDileg2dJnhvhdEUVO2lxTekz0RFF5WtMiGGprdPc1u7smwXNtUSR6PjitH73tfh15MXWBqQ08skXJX7WAoXU7ULrlQ6AwB1daxUUCcHmekQwEFFUX+HxuqPrBrCqu9FU1bZaOXBJUOM0fS4Q5o6uEzdLpJD6A+Ku/pQQhaxWAaOiES6A5KPGFk5cFxIadpiG7ZIVRHBhngu9CGqXLtgKJG+ZAwFBcReb1YD3itskA+h9OQ4PlNyt/nP2gVVziuLqnnIqLrjE9lshk0awA5F0B9zuoXZFUvPMqhbFSEnTckFb5pOQmwkYh8AjSw25oHYYPcAyFvjyCHk4rEjSX/4Zm1DOzAiaxGqULA2zg7WRP4eKRkOHL+ovWoUdKEMI+HGkww9NylrmtQBf0mhOYaU4YvPcWv3QOqx9uz9a+z2nf4YtjGkIpdS4HW9bF2wrshNvJi060LF8o2kE9H2wJCt1Ov6umDtusn/AirBIjOAYbWtB/RnJ9jEhpFnwCjIToNbmngSmUhYJgepwolqwfFa6dw/gYcEF3i1JdCsM7uh6cJA7RbUwVfNxODgwQbKFw5vcdRi+O8Bvg1Hpqt6rP3ctV7iT02Tl582dQRI3jC4eIScVqu6maxmtle0eO6pVyRnEeEUgiCRGvLuqvckHoWhMhd+6m4HyMkjze1rV/1D5yA+XJCRzGhmhzLflZPaeWZV+pgqeh/4HKq7Bhv9gXtCpydozYwEmpawsQnaCcN+SVUM++dBfDvX4G5YgywCyIz2D2Kf3Q0QXWOrSxiNYRWxJorWeirF7pE8tk/wTJIcoDhVohKYnqwexXv6BrcaDykpDDhgadsci1HnBjGfMJM+QcvQD/ltniQRBVyTCxdBbV+xBecFGeqQj+HXPHLcpNYOnbg9K6s3Mu5ArXn2A/Aftp5TdpwaIoMpdVMhKQPZjNTCsW1+AtiRRDSj1pQWpHOkLk+VCU8lQdKjbFgbhAjdvQvZCozIRwDZVxYsODqn0DpUaTRwcTjmXgzwKOgilemT9zWVAs0WOssF+KRIn55fvTt98f3NgKhoXUFB+gtUIqC+bD48ekypc9AB/m/kv9S8iMr9EZKl/8ZdD/cvSN1+m5pdf/EFbDQpClzycUNnMMroXndCd2jjI4xSUsIAdRte+ApOqIhHZinkbVopIEXIiCHSqAvEsk2W55uKxuakVc+Whsr7BXd5YKRJg4xmseWyy0KACXXKdIDmpfYpCGeZSwa4HIMg6GTmFZDIA31N7OSce8Qc4hKeHUJQDidJkiGWMQh/ihJs9wz9atZUV554lX9G72MJIR0JMtM55CIMMMdUHdJwbfDUwjYfqb4MEoqKMJwMDVH2DPyNJ1SL9pRuwLO7petXAOIJKIF2rfKDxgUqVrSHHcJ7hF7ga5zJ4a1NdmgSQTU4mY1vOVBkk9DPi4vRtAkblnTJIZTJ3289qkKvxaW7opsIjpd1anx6/1PTo1ejzUTb3LInmopj0fnj/1ecV1ehnlMg4osO58QfOpzTX25k+6KAIEsx5xORiOSrCHmbBiK8G31zdvCGC+qrbYK9mTCiOiem2ccj59dVJlsD6zYN/TeqspjCxnwAi4U+vXn2B1V1hmjADo2mwg+ZSHrIQjpXXYMnf91nVrI6vxpNyhnjXQgSCerFKE3zCQ0k/FAKlVhG7ZwGdF8R59XNvzLZxKEV1JYNEkM3+6JAs9LVECeKwTq4re5XpXo7tI0r8yzBYl/BO8VPSXwN2URwelprYvquIe9i4bXqqYXfUfHrfBr0OsykRtCJKItv1nkSHyan1l5VgdK6MDoBKyTUqiqgmUgowG5R1cfoWmECjJwnUK8PehisizPlTTbQkJGixKCARahwW8RBV9vckYopBVE7pE1awVJNJPa000gmyEAtMu8EfsIYEMhjjcKemnrDnJ1Ma9Fm+GjyL8AZdZzym9GbQPhvZpKy6pkepJh7TZTwS3wzUUoV5KFl/lUowliKTq+Prydnt1+Pzs9vT8XVNffyKvVusX6hSG7zA5AX7Vs+SwahpRlWoTMb/XVer/9XLf//s3z9/9eXrz0vFcycZQqdpBXKEyeHRisbJ5buLmxo8Xj5ORXss11Dx90HoLwdbq7ik76MKEaZ0PXhWEZNHtkET03hvoEhVCsBbNVwi+glzas/NXrrcyFwBLw14IblNkan/jLuOz/DuUX99pf6hRt8HhqbgClbxTCeGJ8ZhapjEMGvdlZLmK0Ijm265xumWbru6qTZcdM9ws6v7LcNamCEC9UFw/XZ5oMY1TNPIcMzERp431z4DElFbVJr6QGSSYtvG1HJ/5DpN1wVtuJMb4Tdd0IWxLqOTtI586wXuPKruvY0Smeog9a+OWejdqvN83FqYpRG64PPRKl9WYNmNbvDJ6lymwn0JoYb3pm1benC6voEUg2TbueyErHATGcKhXK4OLZ5D4d8N1Zjr9tvv86bL71X+ajRRBTeLiAqVziZf+aW24txfdaXnTyUdWzSuLk8fR5DNjV8q6UztBgaid3CSFCNzLNTtUC1amXJuxye1gtXmQnj5hNVVDrw+vrm8rqXiEQzKh2ZQCevk/Oz44t3V7X9dvrkdvz3+pg6ePYwBC+MPw1iqoAVkEw2V1McXk5vj8/Pbk+vTysdbQwk71cU8Fcsxhc+0m656M9nJjYra15PrU5E8MZNHZ2MhxjzGNXRAE0/TnBUNb6/enZ/fTs5Ors9uJq1Txzejwhm5GKziIAChbggpqmvIeyrcIV6pvQoBiUOly4XpOkeQ1trvZUTrqr4/uTJ+9UX8vKUPTMCfIS3m97FfOnzwNCqWE3/J0ElkmGgsW0UyslodQdrxgU4XnN+1iWOtHWpksk5yEkCUtQQlgfqtgl3tnLpJXg2k6UWeTyd61eNTkryKe6h3EdxKgwAJBA/4XHIhfRpFZXvB68KfXv/l/7z6DVTWTRE5Pn07nkxUrdS/nr359vLyu6Ybu+7gDhxBf308Pn93fXZ7dXk+Pvm+ZpCvCQt+t2VZu9dULdC6vqZqdUnVYnfnkqpbKCH7JKqyFgjwqFVZO9+AAfdIsLov1PzuqJeoBtLI8Dt03Y62om6gDbD8aDqMetw3ovGnu15tvwxGQ6WNKyjoXG/Owo2chWrmXrqUK+xxGbn/uy9VgfLJzdm1eXOJysLnklslqvr1uy+LTxGl6fR1egUmgPi+YsijxPOSzUPlmwlsBhVe/30rsL+uqL5e/bpJZ1n3qllIuTo6PHz1cvTq9Zcj9f9HX778suk5+927N8qscvH1+Jstks54oT4dyqWzrCPd4YIv6WE8jUMZH2KWrUM9i8GTsVw+GVEw3UEFDZJeKt/c/pWqKV1pC0hxAzkbwmuG/tTKK7vVzi6O35yf3R5fHJ9/fzM+mdTIrDKK6cBRc3N2fXvz/VWbiN2sEstA/fbs/OrsenPF2DM4Pzs+Pbu+PTs/O7lRUrue+2mDCiygxKcRoCjAeJjVcOUgF9plfMFyQredXQ0ie5UnvUj6j6PqShRdKmKdRiU9F158w9U92HsVqq7/Hmqvp6z02tYjaeOHR593VkFsqX9nieqHVrG/80PrSbySCthv+ZX0DLRhGN6SNUlcRg0bro5QmeRYdcMTyhPKitVs1dU4YIpOqty1TQIfzC0kjD4aY8R5qIQhdZpVQjf7gBM2aiaIhaSRApBDOpoSb0RiueAR+wUhZZKFOmMexYF2GRkCWbFvlKOvChga/GHw4x7kkmrAD2g7EYMDbURBmfvD2rS7p9E009EJmiUZgjS3JP6uKKH+myFG4Z+HWMGS/UIj/GJeZYoIaarT7BdBNQisC6p/M2n88B9aSluSlUEFGa/69W98qhtEPMTfe88WHVlncWAx8a0/3wYw7T9+jrkkCBW5baRqdJbABkxI1eRB8RPnITwaSV3jDQuIsnBuc0BsB77xKMeSN2bxaaQLEErt0KRzsVR9caNcVT7dypliZJv9k59k1i2OAoM5lZrWesL6ua9+09EaeqsGVP+2qiNH+gqvwuYP5blBBRA8zaPtgOI88lmY5SRV0DD5dYnyc5pZ+wxtUjqktDH0qloqqzWL6JyhbqUZlzTfidHY63Mc6464Z5axbGhQPYt0QZumkV3YkIc2p9C763OEdbikMmJe5RiuhyNezSPiU0nEnZ4Mm2vM9V96840V930mohijbKexP6eyEs/KTZ7Swp50fdG9YSZSunDTpjnD8A68sg71YlS+4BQMFai7/XvO1DA2V13lbQ/QAgOg8p6PeEDNU7B8Y7eCtKvVMFF3ScKGNGsp3O4WdJgNyUos+ONKFcmItWKFRWNk+H7T4dY83nSwF0TDLv1xm8PxUKKkUBgvPQaPNbLzkGpTPP4BSpf0aZ2gFK8OR2h7PwgLNv9phnUyUVO9NNSDNVkGvWH1xGtr9NrbMjzYzk8jLKT/FeeB2Y/ofdUTVh+8tvfzm6swgad8xXngadKLUVHULdaZKH2vqDZRXNBCtYni51K1iRJWtdUmPLEqlpsodt5iuYl86blcpMyCkkAu1uDZuY1DIUno5XJDlatJmG5VjfUCfVvfoDrbZCOWqSbI74pppms9tlfNjXpg7FPBou7Ymm71mJ7WN3DC8niOAR2VO72E1PZrfWxY5aOIdiYTJyl/1E/2RJoa7Sp97Cp97Cp9PI1KH8XDiuU+TOop1dmU96hqVpvZuUN+MRJ/uHbOB32caZwU0FWeNbGkFVl/YbquTQWpXyfqlkfrnA5KJFHGM2bUJ513W4ZT1Pvu8kk/sXzS57gqxWzS5APmeyZL69BQ2ml1y6hNe5UMJMVW8RCfe0KxD4+upDjMKT0PddKIoRl0mAw6TLboYc/s0bvE5r/RxOalrVgDtCIBOoxnkEBnAviSSet7RVKud1Cbqtq6bgiQHMyBYbOk1gn9oOx3TAbrbHIELhc0emCiDlGdN5Spqr1LK5MNtRxnpKEne4gaP6t7pf06U9epKN212Bd/Uw4oPBY2CRV6TbDqA53mQKxM89Cav7eEV6UYoFADHiJCCT5m7BoqNmPUfmECKHHnmjDf6Gzd0qWf5jrZiUSE+VoNAbltUgsTknqFc+WHgQQY9a/Tny9uYdFLs9QWCp6TDMINUFMkFXoNDd3Sr+cqm7W0dMwJXSdy2sdztgZ2lhitYCFLrkLpAcWYPB4KyWQsqchRc9QK2ZVWJYo1lQJroElNYbtMXTAnmPmqVCOnPs4Zu/WPrX/RY5K2SEta7d38O1sy2XGeZoYwxuvH1Fua05BGNWXOK4/NkkgUS/74eQdStYsxGSTPWXjXg1SnuqellE/vQZUiVzjnN/r2l9gl6br9GUJ9xdxe12q5aXOGeFd0c4iKvbZBW/Brx6wqY+OmcrbjOpYqQ2WLtSR1MgyHtBHmxsG1YWwd0wMEp5aKA4lal4XzEVybxdDO1Zsk3FfAdSJqxyv/KumQHhqUWDWe+sLXk24vBmI0elmBZ9OyJm2aju3oPFqOlNaI9NR+dNCD9LlIm3Uj/Q+N8zO1/Ax15/TOT9cn/Yjd+Dm7mYalFWh5339yXUuvm61N/7Lb7L/lze6kxWmFWaVwbNXntEIt6ntaNTutEFs0P406nlbgn1gH1ONwOzZU2Jjolg7CwPOTtFsacZUFBpcrzS6apeFhbtH5bAZXXAimgCHJBRxB8MuB+vJ8G6W+ckqcpkKybbqcG6xmqw+UftArDLZSjmwVMY75pQMiRPszPi98FvuqE5QcBytwarGy9Uwlctl4BgGdSR1HewCneskqxhJmBL/txf9iQmmlLW9UB3wfxm338pTLBYqmBs+Qy4SYgA6hzhh2ECwdVzXqIXM/qsCdcJ2diL2TOnYi9k7E3m32nYgNsBOxty1iRzzJL4sk7yJnX1/m+2qR0/zlxYpGnqLznGIulP291js31ZdiinOsfLRUSkXcZIXBXvxrM8SEmWuIJnJFF4HFuki6KAF+RP2uoLJZt6sTCyusRnBxeXN2BK8qMQMmDDB49fIlDtMI1YTmA+QxzSmd00IzwLQ+utk4SS0GX37xryN4PSojiTs6GeQl/PmrRoil/n/+Ss1u9Hy7kmo775cL5t0lHtAdtutNrmOu+hDz7nIq+kagkLweiy/EbGHApklOOQ8oadoUkgc2DK7LDNNeB7mn1UGqp19x/7nIwt9CNeMCmdVIPj7hMhgBE0lFMpDcYuBgmiPhGiTRnquFpBYyYquAwp+TSrwHdDajnvxLK1BdoyWp2av+YeO64M/2t7+M2t6gHUQhjdmRkz00Q84z7AYqEs5LisxrcmiIbTzL/uA8R3CmXp+wpCQU+k9Kcs0BFCP4axvLsj+ZHWYE4LS0MIULrhzk/TigB3AV0RmN0r84gSehDxf87AP1Yulkp+8kDLaW0K9Yju/SQvqaZrlC+umxcpteovBoXpc7usaS7U5AFR6m3L/RNCQb25ZG15XW/8NFywIA+rkzNcH0BkXJM1jaJQ99/Ceiu+3FspPovGJJwGHqLA9E4fhc6BrBigku2MqNuDwtnz2C9ypiP8FM73lNW6TF2c8xCUZOcE8zt4juZgGVSog/sMD3SOTsvGFDKmydFYLc2SNhwoLTnea2G/gMiC4z46lYMFB8ac6j9dbXPD1ONhtO18W/KUIolt/WyXZcp40FZvIHHV7owmf2bPGZYaNOMBPmlnuoYNyGrfNuMgXud7gxLRvANc1mFMNXFhO6OheVB6ZoGx5px/2vcUjBqy1G72kEL3yOcJXviNwfwX/TiDsz+ZDOiWT3iV7diqVSe0UCEfASXiBoJ5hsuaQ+I5IG632rXdTeT6O97bs8dVNb4BQ77+T3qlf+6kFAfe6dRITi6lZBCMkdwYThPAcpp3MCmikwbq6dZEP/TZ0NkqQs06d/y9zC+V3c+UnS5lr1EDFJT5Rg28du89dyb7vKCBg89Q2NOA4U2IKJx8VTbFg2VW3ixZRSsLML818rutbRr82rdeeZ7DbznWdymSY7z+SdZ/LOM7nP9fkpPJMdZYC2CbShXrgmRW2zrB/0Xo9ZNSHq5LBR2Lt1HhQ13hqkRhudVTqKJBWu+oPAwClTqTM7DhCZtKqEGajI8eQNU5W51unQRM7h1IYaHUOqa+lR6eNgpfAUFJEFV+2Q18S0ZXW8Ome8knskDUFyOJlcjTOAdtHavwV78C5auw9f30Vr76K1d9Hajxet3WKSdDVE2tuz2hhZifd4lm1SvhqNvqnf9eca7t3VrKk4SN0qdDRmVhgrayA7mzDbn8dt5srORsqcAbIWLLiYJuvNjg1w2wySrsZGB2beaFjsZU50CsZzMyKWDYQNQBtNhy1mwQawnQyGDgRvNw72Mgkac1/bRBwNgRVGvgbIbea/WtNeA8wao18Pg57DmnQw3vUx2VlzXKOnkJOhrtII1wi22TxXb3prAFpjlHMwuDUAzZritmhm62Ncc9PVuYjULeazPkYzew23cFcXU1nJDNbEXPsayFpPX4uc1/rkr9cn1ULWGUG7JdHDLsXjrfNDArknDJEEPlXVVGrkQ2RdJ5Ork+cCvDiKaIg1icoXeJPEo9gMc5NsT7Jj6NSkVyebyJVFopxYXArvpWRAsleX3vnqBPAS8Gik9jpguse+8l9AhLyJSCiYzaTqeObOiZCpo0RCWpAJMOrrrJQ8pGbbNB48ICGelHbm4hNJh42Xgmy/t9TEdfXxDpO+wY2bTpyJzMwfiEiSZ36cOSypEKqgqBvyx7CIlyRE72Y8caa7lefV48Pa6ciUx7Ltzk1WeiMBIqJE8NBxDjcLajok1tRkAZ4Lszbbwaua09XgZXgcn+VROsDNz2dwEykB7mvlOHwA78K7kD9shp1sdD/I02y9SlhYitoGo7fbQxqPu1aUfeQLDaCYxNrBIpDvkBh7k3TaSu/wQuybEnn1md+mNJsrfLRXzxZYKD973X/mdSJVMTF669yLidJb5k4iasf4BLOrSqbenm+wolMiQpZmags9VkAFa8tqlEsenQy1R8dk/z7VnL1Rcnufa5qvaWtvBsV2TYmnTpmNJX+ne7WuzHgGx2nzTJSNogpgNE/WiKYaGISqT58ae0kk80gQrLGwE48lkHANSxLGJAAhaaXRtjmKw/ATVzZi95ZZDpNwisID0Q9+MwXQt1rlREy5ClgFJEwAdbWI1l9rVReayvSYCqb6hovUe91jASNG4GPzOVUzfKgLJ9D7R5OBhH5yUswUgERUP4urPRPapFgD7sjFTmEF+8Jq8Fmyn/Z63oo+XdHQV+8bs3N9J4ROS90yRLeH7mFBlWjcqG7zFizwIxoalzQzG6StPR4gebIOycyjBu1C8wkAZzG69HJIuyQ81z4lzEZT0rQCDlamrgHtKlC3MlenRW6RuHPzfKvbAhNAakXvGQi+pECjiNcbF7nnxZHY6y2uNQnYhVwPqqldEuJJxR4L8jaiqi+5vhhhZyeEFBtKtkjyNs5zoH5YNIiaNZ+qxd4hWin2GnsrxQb1czvPRH/m/xZPU38V+Ps/9lJeTTxlIqT+RbE40GCQq/WD/8zoOeCHH/f0YNR/b2v0qD9uubRYUl8MtvXTArDknNpS5qoVYH8Mnz3b1bziPGBWit5u0Su7vnVVr+z3+rJXLFeVqKbuFaure8XS8R+l8NW3XEicvskhngwMCd4gjO3Sr6nSpFIWqer5Bc9OU/Oq/KGSNVYUjkqdNgy7Apw3ytBZN7+ktPuU0hBWi7XQwvZe0bXII7J2ErrUlPU1HsUi19LUc6qAYf0Kj+DVRlOMBd7Ms4jaaaa66cxk3bBXUErYf53/oxOKXJKgjKTGj4UdMJIKUgkl6+rdEa2xEliVZV4kdCnkcmhESjW9DIN1CZ3r8odqEXRYlFsS20LIRygh6Arq9t3uvIIZXcK1gdFUl62ijVPBsxLCRnvSH3EDoBbpb2u/d0UYpXWjU9RimeYNDSgqBpXmMB41RRVpbAt/7H/GCzvVvjw1tewM2nfFgogykpOiltMFoWKpu9z9Vhr/6da6s3jnit3h5QW522tX6G5X6G5X6O6pFborVrRhM7QAak1YEr+QlX8rzzG0aOfay9VsUKjGaiQqoEJLiRpXq3234E+FUJ0yoinWpd1K7xbq2S2w1Tm80063ATBsMbCzR/irSzBnxzBOxyBHtwBO57g+16DN7uGajphCv0DNLiGa7sGZjmGZjxKQ6ZxQt3NUY0v45VZKrrRb6FvDLDcIRbSqiHYrs2lYPH9pkES2ulojD3Wq+da4qG1FatwDixqH2awkjSk8UznshsVoWmv41Reg2bD0TF1qM5eiM223hUuhmQ3L6taf0o2KyzgZrNqmD055rrsn/XVK99st0W+HFL9PNLnvBml9NwnqdMn3/jTyVjvdbS65qncb9mlt2C0Ff8KjBIDC4wWBwhMPBHU8dK1NHAq09C3Nkk2uu1dL4V5FWdpddlwLsWyrBEsrRs5lVzYouNKcf6pnqRUsplILtHORlWIZlVrIvcqrbMcXKOokV3YUKhsDErsUUNmJkTsxcidG7jbsTozciZGfWox0LUKySfkRfU/VOwt3KTxSKC5SGyjmVnKkoqxIDUS3YiM1BUXqkq82lxlxKCXSFKidKzDSuYhIB3msiae6lQzpWyykvZhkhzIh7Y71DqVBHq8oSIsl8bEKgfQpAaKz5rSEqvYr/uFmiXQp+NE3i04jUHAv8lGRSacFtGN5jy6lOxzFnNZyHY+VWQceK7sObJBhpwVsr7IcjgvhVoqjb8adtoXoWH4jybrTusCuhTcymXdaYDqV3Chm32mB6VZsw3ElOxbY6Jmnp43yHYpqZHL1tJ0r53IamXw9LTDdCmlU5Oxp95KoLaFRm7enBWZ1Vp/ehTHcHTlcn7gOZTAeKZcPPFI+H9gkp4/zqXV4b3UQm+tdRTqUtehb0GITlXizJ4tbgYpGUroWpXiEchQ7L8SdF+LOCxFg54W44ZL903ohbpAQqB651nIOjYUcNsmcpwOK7K5ZWXu37klFrW9+bdmDTtnxGk599UnPa+m99M+ZeymNCOrhvKeiKI/2anVFTZYYVxuMk/Ul7yBYG4xpr1cgM0kjoB+8IPbroaZxHSj84720IhGrTZv85Mw/vQw/GIj65FZ1Ei8zS5rsZRbqJ7xi4mqh1CLNm4q8/D7WSBkJnvDBsxHmek1eqNPDpEiO077NDV9/c/HsQVWqwTUL51n/pAOg0huNDn7Xy/zLzM228N9fTyzTP5Y66I2KVDon/r26hXw0TUbLqhjL9AczSxpX8MKNsYlzCp+rDfFONAtBpN1838V472y6z+c/SnFNMu0kW1tfJtacPDDzCtaDZomdhyJeWsch4+KVaIStE4jJMzXSaUw15Eaw5mZDVZpG1KjnkjyXyXnBwTy+YlRkFqxZjTJn9+rUEfg5JqHUDlxc0MhG1qZUwXOehgx4DVUk7I+gdATf8gelcUM1ns+pABaqO9ne2VmyJZHWTY/Cp3bqe598lzfDMHuq+pn827Q3hTQZ2U/FfBXZb3EdQkPF0rpqgDZMCz3GdCiWh22WI/pqnOSFRoVaXW5obAqk7mm2yw29yw29yw29yw3tQLPfTW7oxoDUfO4b1+zHtn2BDePNBJ6VTQzwSpzfq+RfFBJARknQqBRpyxLckgt5QUR74O1z7bWj2tr7xKjhTTYP9EM8gAWbL4a6FKeIl0sSVU0zk0THXlF6mjpo83nXQNyKFExdsjm7rZpemQqwkK5WIQXTI66aTYjVOk+bIAuYdkk0Tof6b7WpXJu8zZr0oPU5pWyL5nRZtlVFvi94nJTV5j1lcgtaQKNdpupdpupdpup0mF2m6l2m6l2m6l2m6n+6TNUICTb8cYCE8rSWMU0ulMr01I+B0z9xGup7JLgOe9piGursOlaloc5+r05DnUOsPg31vVeVhTrb+bGyUBfSpOohEwONW4bhcvzRNnMMG5RyETklVcJHy9yql9w8eX5LqVuze7UidatdeP3d8nibUysDGYAI8PDoJ81GFUMwAcTG4uLFrKkRznMD7uWlgEANloAVELA7Cj5dBXytYiMPQNx7aJ/dpZTdpZTdpZT9KCllV/E0YGLRuHhXuk3qGaDmnJxnyU1AoDrDeETM6a+KBtLJ7EbARnSESfLGfvHEtz24Q+x15JKBb3ya4mwm2ox7tfomnY/Fv5vOY8N8vpZvkXJK3zr+rt8Je1X+MYlmL981OfNbci10z8HQ6Lzh5rbh4LDxxGz8Paz71c6ahait5MVsVtNq8ePQpxF6Q1UrsDJbYdTZ0C4yktOs3dieFbRmsCAa84yTkTZFmvSgJyn0k4CwZSX+TFhZBrl+r4yStQJF5SyOr8ZZ3Z3WB8+oUu31zhKFUW4oZjolUBjP9JAR3p0cCKwY9WhOSsF8/ZgUYVbr92cTDugeB/ry1GhmZBvFRYGoa5v58F+Ty4vDb+qCPXEeqDEQQutMjHgXewvQFhzFnlC1MlqSkM2okKM0deYPr3+so+HXXDngEm3GYpruiUSA5Nf6eCRHAtFUtqmjAKy4b6b9gNOV5I4CN9ONKQqqRzDAehcpmn9XAvw/6jy4XujtO1CNBhq5RBDMhoIk8Ex4s1VmN8T6UCUl7AOPgM0g5BkQoYk3SeLCS0j/8PrHWozz9AIW+vQDvNZ2MnQ+8/eVk5mCvw4l+YDHbsFFrUcmZuKQHBbknmo14wMNgiT9yQNZa2WaXjjtNKZCaRt3qxW/by5PL480ZmpDzUMb0TljIdFecrnY0RqIIkZ4IDl4CxLOE5fvWawEs1HvLHRVMnGDbFxkIp9QunSeYuiaye75RWbHN06xKfENv6fRPaMPhw88umPhfKi26VDvB3GokBGHz/A/G80I1S/u08LmH2Nuahxx+HzTvHZdbrnnEyNxFntjRD0GTZtXs+G9NUCZepz5mmWTcP3om1uRFe1s3npoVJJDEvrqd8GEVH/vTceYOR7rd+PTj7PlY9b7DLsKdhNc/C6yne6Rj/XUW8jImers/H8iJKvJgsuwLm5NpdAgJofGCPTtY5MSzVgQUJPvic1MTD8BL8DXjVHPjTr7qfCAeWuHfOiqWTo/PankiZY8wjQ8VHeSaE5rcryHflIirIfsuuqUHMkMdDxDlf660r8heRUWNaHVPEVASPVzekrBZ0KfWaqEwIiLTDW5fNHG7jMFG5s7Yb/Qo70uEbmqR5KKX3tAa1Ag8EsIyziQKh1RWyzyF69ew3QtqcgAthKXyETFIlzDAerfVunPje2JHb1E/aTlErXRPZoBlpRmbARqYmYOQHBgMpMQQa05kRaWPS5oBsdQgpboWZ1mLEV3ljwgBTABn8N3SKIRHIdrWPEHLLrfCPM1vt0y1FVJ0V5/aQABE/rx0UTDNnetPANoiwUpHBTn7bbFA9b1kFVUamuOp1DJ9Xzr2aerK0m+4gGfr1vp1+SW4RaJUKBU7wSEGoyjhwG2VYJNVnmSL0GV0Q82sOXC8mzA0hwScUOVX/QvhezbJJjziMnFMvkrFRDFYWt6hV9oxBvTSzlFRP1yz4O/8uiORu6ZIsaXpkd2NcJ4OUWGgRkhiW8yPlCdPKh5NlrBD+NLsdl0XHedznIx445ZL/OdQCVTzN2PqRaMz6rMaKXxhcp5hU6LujUwkfXfBB4aSR2FMz2ME1ygKp3O8y3kEixOuphqqjDzvcat6sg5XY6dzrDZnuCjVAjngmgMSrPIVHxupHGB1prUYvP0Qu1MF2CYzHqTLEVtWSK0tOuW4xKbOnNkvOPqUUdgYIOHt8GdSVcB4Ir76eWfy9lpk8QbLDFzZwPUZOzmFIcuk9DtUF9M/dNY7SKT7ZGF87FOq6b/rFO0tVxFldeRLWge6SeaHg4kTz6oCYsWqKA6KCubYfqCSCZmOteZXQmVkNnceRllZyvg6TqjEjyAaSyBSTQbewvOBTXmPT3uPeMBabtsAEDH+0Sw5FEi7WfQQ1k6A5YJWHIhW6Ema5U4v4e6Qjv+Y47WDiFV/IYa9IGy+UKKg1awaHhVsrpKaZxBa0mpxDzwdgoi2RytMA3TWeKxfWFPnDWmHyRMqbjrWgFXLbeO4N8/SOosEKTBdA1MYhI5B7hyEfF4rilJA4M4n2U2hw6y9lWaDxho4g5c9i1SLl5a0wTSd0FQdy5slsFM+luPRxEVK+Uu6YD2KmUrNzRa/kcyxAuxn24NFdxjdwaJqAMxKO7IdMeNWvq0XP217MFsUjRQpRtN08OHv+JX6l/lZ9k6jLE36QSixPepDysaDfXW5jBjoV8xRwewhrIOJ9WN+1YuokuXkjRlM98fK2jpKZE0Wh4AEYJ7zCGvlv1Jdk5uN5rVGjkB6UYCANDFEJvqMjoQ4lhDgZ9jGq1BqUqBgKAyG0zhwBLTH2vp8ojQV9XIuXN3CgCA3vtnKXPr0rdAjCIoYAIIurR2ggnAZ4aswqxOjr+PTDxu+peO4ElE4fjitJ3L9OI5Drulalod4YImbgLJpiM0mmD00jvoDBMzKmsHQBKmeU0RuBZ/RWeYNp+1Amq8BzvC6LexAcAl57fDst2lmcD1+t3RdQ+YkGYPT5YtLaA16gHROe9i1Y9bEm4H8vCq1Nw9gEJ1Ou+UofbbPQAAUJnqexwewAWX6j9n7Y/fuh+1r085FRdcIpSPvpCaLBsvowaDbCXUj+o2bXndT9a9VlRlSe4FdRwCj+x6JemVhUFVJfruBXZKIeThEHMzbw1XvZ2AR7mdkUW731ZLppqkkzbeMPVKEIdrRGmAVLgH+Pg60g7SKks882BJozntBXjlUmdia/fsFs5Ru0Kp/sdF6VX9M+x1mwyTLbrXZ4qNarVt0gZFwnOsOt6NNN0LqG1xN5SlWj0FZI6wJCvgM/h7UjDmH13lGcIiZZXEcKyAZiFZz6/MoB2BK+xKlSdIaJUMCvOinN5VTnxQDndq2yaObTC4o+vBQY537nXmQ4NxODgwXnMF9m4l245A0S9jgJAG5WdD34dArzPUo1PqctXzdZoCyDgHaBVQ+qnD1BMJeFKWX+GF1SqRuVopuf8fHSCriEvgkb7T8MloSmEMrN/nc5HiPNh7tLukF8/ozhutgfs7uu65tjeGLhl3Co8PA+6hjeKF1cjsd5i7ToOQBxNKNkxgJXqaDkBR7ZhUySrvIMPw3DXoVefjwBi8MqijT6528iVd9ngUhxgBykOritfsDpmIVRjiFDoARbph/ah03TNaWKKDlsI1DthlxaxhVfXls8zbEr2+fLyxzIy68DhdqCqLrHFstlVa9x7tPHWVpIZZNPcegRNrJWQPJanumFGG6m2QPQuOc8xrRQvq2wN7hCJ0HX81VPUQnQC7uip1dVrqs5TD4rSc+mgS721xxV1ZeZ1BaQtmTPMGtVw3L7IkfBKm7bs9a0wy6YWW2uUuNa2ht52t5Oi7FIYCME6BBgg6eNiwElvztHYGrcCzM8zOwKNEUON6ZmfBl1QnIm2Fap62aorUrgq8oKP5CPyYmtgl7mvObqJt9w+cLFemloiy4fII/6PoIyPFQHWgTIxZt3T9LJdTrxBBR0QmhSHpX03Kr4jiUlo/0cR26IZqoNUSMwQqCnxFcm2R7XoQCbqTSxoJvHcOtIEXk9vTaCkSfYW2orPHMe6dphGq1JTV9QW8UCJCoLYRF2mtzj0AJ2Ll5BStlbyn1sSqHRUTEeSF2N/fc9YAu4luzXmp8hdEo8hWFo8cYOYEKCexyAEo0jQVh/6cubv/solAlApCSNhEFEq3QioIbdma2sOM+HgmxJ7mw24zho3Mho4mw8QI6AwYXM2FTj4J6U9HDUHnJ+fjmAgdzIPG3NcJbJNpMGvq6wS0u2Gnr0mwhzmw1RSINOhlFt2CGbC3jrOv+a/d9KfNeB3BQqvZT5vweps5a0x+nfcqAGxk7uu9YP3MfO0mvs3MjlUms5yprtfBKJn28ma6zjBrC7WWTHTbQzdjnusMVENqNs11BoqmvH5muZ4mud57va+5qZ8ZrrsJrpf5bQOzQRc69DK5bWpu67XSj2hmezQT2yOb19xMa93cEhzManlDWQfY2zCpdT4XHTt0NaM9mgntccxnj2E668TvO5/9bjyts6nsMc1k7iYytziTDMt2M49ljV6uztWNprGyZmevC6sqm8UqjV1dCFGhAWo0dDnCLprDtmTk6rT7u1lEutBv6yYOJ4Ak/nCdZOt2DU07znRKotFsKE2i8apPIZj9ma7TmPNhGtEGgvkUPBKlianENmqyB0zl6DzaewxhyqGCZLIxOpnhOlSTBICnWowQAPqXJKzch8/PcTUL1XyW5ANbxstMiUizNVvDaJMtbdhH10Q7uWjTwyUJyZwOzeDDBPww2dG1eZg68wSbc3a3sX8fG/varGdxa7Mwv7VbgNbzZNT6JKMwAXzJJKa+bH0RZ3Ii6uKpOo2LAMnBHEg2S+JU6QcVnM5k0HYDZgKcsZ7jA9PGYhICVhxb2nzaSeo/lFza7vjfyBF2ahbE/RNjCGpSDNSkxWizqJjcYaAzq95TZe1q3NQZ35Q/fr5xGp0lD1mLrjtftka3BxqqSjUCeAQ+E/r3TOw8/bDikaTNW16JIh6JNkxlo2NBXcyM3Zl0n1LTFxlschYRsyFsgrRYtEhwkhtB3rw+AGvsZCQ542Sido8NO9XKkU3PwypiPGJyfRIQIdoycBRqAhZ65lIsZF/1sk37m5npeAYBnUn9kD+AkCcYgqcGAiYMaH/jNDl4/E7pqj7Rb2nS/zfpknIDBKOTjBEJbHIyGTflmLM/+imnhWmTWGDO1QKPL00dRfR+qcyT33mqJr+JmsTXxOvCBa6LPbNmLisnJgyxRcJCUCm3tFIiSEyeG5r8rylz+Wi8Mer8gHvU11tyN+7eazuxdvdeq8F9917bvddKP7v32u/qvSZ5QKNsZTuHrXST9jnYNO9XZvy9jUxFpSw8StpVknEGXWDC1MHRMpEZvNW2RsI1qLWRJodUJrORjNBD+s+J6feAzmbUk3+BWLRzn8REjM9Wa3j9s/3tL6OWNGeu/Fbj1NHb+Qw7AQt9JZ8mj1JFBg0PJNfYj/Zco3200RA7aeftDDhhHNDT7eQA1tyrqcWZwgU3kRL0AK4wD1L6F0dPrAuuoyroyNEm4nTXOPkF5pbgu9QTUFPKegLqPyUb24VQqfdf41rc0XXib+UA1vgqmudksoWtf5J2gPoP4y/Ml1OT4twBskZP8gyGdpFDH/+JqG5zgdzdFXOrdFnvoJhzOHSyQ6f+E6PKfCKpRyGc/RyTYOQA9TRznepOFkzJy+SBBb5HIpdDgpmwkeWB4ObNiVzXI2HCWtOdJdplCwAwlVGYpyqIguI7cx6tt7rK6bGZUFXBt3OKt2L/UoZUGjHuQkE+S6txp1jBC626sGeIzyy/TdiSC2fMSDzZ8jDAdPDYfnr7pfxl5AD3zdqKZwemFAFWlKfywCb+MwdXQ3fkIzi82lL0nkbwwucIFaOZ9kfw3zTiuOVDOtcBMs4JTqyOUJq6WUTAS3iBgIEtl9RnRNJgvW/1BDrQqp0QrvqR7PZ0e8Pg1DruyfeqT/6qQDDd74lEuOGjeo/WTJIZ4/PiANm6q2aF8b+pPU4golgx2JzfLZ72TqJyk7dCC6DGz41lKwo6X9OyyFIwNy4J8gUQ44ZFtUXk04J4+TqIuXz4KxrpcjFJmQTz+K8EPV1DLGg0ymCbSqI6UG+5jKUyZPSo8dFUbBE6qxta1Qzu6gVHtcITVCf0VCO0FmK0m9IuWYes5VZnYF3O7b7Gkjaa3yihSYt/DB/GOhDXFn6thVyse+otWFAqxqzK8YgFlwd6RN+ML0Yb5sg/UTqULuUZsEMlTfNFS5upieYMdRubmkH5cr5YjzkCHqaHXngkUN8Pff5Qfymk63CgfXeVLRZ/Sb/YjIvM1/9upmD9KWt2WhtW1XLPfs7Svxt7rh+4YdChqZ5U9cEyRefiuFjMvbk8LjYp3gm68pfMVhQ41MAs96+udJW/RA5gxVexPi7WVKIriQVl88iuSu7TrpLrVhq3dwFcFcNfl2miQUXXXMb/xEJNAwV8KgkLhKmPq/Z03R3FZ7k9XVc1rHHLAQREyKuIT+kNazLQ5+ZxToTUr7cHqoaYUt8eHj2deinWPh0Udx4qEHsbyLkK95uIhAIH7TWBHNogE2CmyjDwkBou1SRCcyAhCvYfaeJLKgSZu87223hJwqG6wJRcajtb5aY6FLlN1zDRAOmWEGm0yRwiSgQPHadwjY2BCSAwjRidpTX6zShaCZPYd/aaNdozwoI42mgNsIkb9ifmtkqPe+HsmvsIX50tZbwBAKDi1cIETNGHJ6LKXcTXJcjU9k5Hbck9YwrGSa4MZgrIRJJIUv85UOn1J1WzeAMwtDuy9rveKo1X3h5AR47e/PJdLYhor4V5pVrVySYIAviszPZ71Kusrz9VKlxULL+U4GOLF4lc9aLm6me1RYsa7jyneqRVZK9dL1OF/VSzqUZ58X2uqRZJfaPns2xOiYjxah6RUixO031JYsnf6V6t6zCewXHavLI6oK4ZqwUV1cAgVK1NUmMrMdfDREcqAIjHEjnZkoRK5BGSrsReV9dHU6W9dTqnup1VspnlMI6IFB6IVqCbKUCNBG7kKCVfwyogYQKo63GofjZAzdNBJRLSeOt+Ou+LMlGzgJmCmLYiuzoZYfWmxv2jyUBCPzlVZgpJ/i9RXXOrVfWjwR3tdbhOCquRSWfTt+6xT1c09BXXMDvXrQzyaalbhuj20D0sqJKQgDTcQaiwiCjOhSSzQdra4wGSJ+uQzLwpjVPzCbBSzTvUFzSJkCUBMu2SKJ+tRGk22gMRCBysOmJvM9lQRaIqEa5RddW6yC3CY95PW7fVgtdCyZFQliNnWj1Fo6jB2YV7XhyJ/oXNm4TFGlEx9yLEP1rNMKLa+LhqxQg7OyGk2FCyRbBbmQNtvbJ3zadqWWyIfgfFP5VfPcPCRbzXOJ6g0T31c3tVibxq6+X+Fk9TD1n4+z/2Uu5OPOVFRH0swa/+AHDHQv8IBjrKehXEEQnMP5NXnDiCH37c04NR38gD+o/D4XDv2YY/CAD6/TQByEiJV7aceCcAHTHY5GePrJgh7BGQFaMfJA11KTxT3v/+1Z5eqpNYSL60Hs2YRRBXac9qs3Ghw5DLrCdWqoYbzmk4UiqiacwCn0YI3A59/3L0+ejlHiSlrRU3FpIsV0fILPd0coIj/djS8q6t1T7Cv434ioZ0qnDeU4dADT+PeLw6gtJ3DSy3EYuLtt4DAMwR8F3193NbrMnu3QrM9gAAxIJHMrPxAYbg3a/0NxaizbDcW40vPK7O4oWNY/f3EgHawBoaqty/smfQW9AlOdrLaL/D46vx+88mhQ/1Ci09vfQBDiRvqUtYDzLhcn3P5DFSruVZJztlNmHTi+C5moluZ8Lyc3IT9c30k+qAydupijVj0LxhdCOYKB4XCWMIVjO+p5FE5j4P2S8JbGG9WzCPW5FZY3LPkATWpYSEPqY5jagaBeIwA89mhapwxJwzaY+fx5fLWOVLOMSTpIqI80gc+vSeBoeCzYck8hZMUk/GET0kKzZE1ENtx1j6z5J1eL7neCXhgWhcB3UkTNq8TAGblNw2QcP12eQm2QhVET6a+mlHkS6EIhsLsSQpen5EfIkwaehjHlv8R2Xci4inS11N1njxSj6Ck6RAv5HgRjAOU93Toy+DorYYKtK6L0SWsTpJC5bv1UswK+olR6d4rtXk8VQU7XY18SmSZ0pXF/uUD3/b48ndwaB7yf5ahAAAwKFUf2uR/qaZAQBMA+7dTdgvbpLmG9s6CRjkc6Uy0GB0GBkL09zGDU5pX7x6DdO1pCIDNM3Po2BnYBorS71pBQBMLiPshZ285Gh5C5VY3QflNJ4BBAsiYEppCA8Rk5I2pLoTHJjMZKAROn21gWOFA3yf6WylxosrRadJb2/gCGACPofvkCwjOA7XsOIPOlb3teY0KdUkh1evv6yFqoEAE9p+PNrrn0C+3SxY2PhOW6n7YWmPf3M4MJodtB4bAADI+/HodNE2o0tPXUCbJb5Ala5GRNP9aM/NQcK5WnqjBreJh7VqpfjSZllzq/K+oPBLQLP9gARzFWO7WCZ/pZgjqNEn9hca8RZ/ioa3+S/3PGgJxa8Jw89QuyYQXye3b9iVWriA8aXY+qM+2UFXrvaA59f5DrDggZ+7mxL/idSDaa+WhQjlPI3a7dTqlF7mfppFCh8GeohWmNrG8HwDu3pxkkWf5PJMK2GCC0drOzLaWNMc7V4y2VwQPWoJa/VOy1lramEm9Kw32TiennZ7nZ3hto1uOnKqdVPfYDNn7lh+a+bHw+eWlvz7c0rS5XLNlD93iCGzsFkt02lDTrcxVdE3L6hSYvk2hUSEFmYzFEiefADMDSd5A1AwJTOQyepKFut8kROaZvx0r9KS+v8fwDSWwCQ+Eb0F54LajBg45j3j+DgHdMNsdlRf8iiRejNoadkyBclEl4r0zKZqoGmOwzl67ptK/3xmy+ubEiBKNlUVRVrJamL3KJUiW5Y/U6Qml2P0RYsdKeUTVMiD2lI9lUvXHL5BpTfaP0gCbQnOe7oGJtHpEhUEEY/nmjq2NktbdE26CXT8lI/lWAaamAOrmlFDsVlSZUc9Pxqh4p42YR+ZqMjGWlL/0RoSooZ+ITJpLhdsvrA7wLKH/K4abTl61GwyfNSnm0XP0Ye/4lfqX3WqYoPU1x7yxPepDysaDfXW5DBjoV8xL0uLLQWCFpaiY5TJdRLpfawgpXtbYomwtAiZU1yOXFTsFU330a58yoYzhl35FNiIIzjshl35lC1vWADYlU/J/OzKp+zKp+zKp+zKpzzOnbbhXt+VT+lPh135lF35FIBd+ZTkZ1c+ZVc+ZVc+ZVc+ZVc+xSwcqsE6qud0p4waTi9zbufmtG17TiuSVRge2M0eKXcVeDV89fJlp1w5tS4cXZw5ui5V17r/TjX/t5ZaZpt1/vvV+G/PIN69vr9JQtEItVdt/xTz9rTPbnX9K+v2NwJvrelfXbO/xejRWM+/rl5/i9GnvpZ/a63+ZsjVdfzb6vQ3WxS71PDvatTZYu3+x6jb36dmv3upfbd6/dVCRwvoplr9jZX49zYWM8pV+Pc6iRdbsql1NC49jmGph1HJfYbQ25j0SIakDkakLi/dTk8qZ5MQTtMRKLiZg4yJxxloqymoo3mnj2mno1nHzaTjmF2ygrz9zDm9dGh9zDiuJpyuCWZzN3i1+SZvjukEs9J009sU04vY3U0wj2N+eQzTi4PZxT1zc3l/NphcOsNsM7ck5pNOUBtMLX1NJz3MJr32ZR8zQXdTSTczSWcTSU81sOucO5tFNjGJdF7FRzKFPIoZ5BFNIM7mD23UcITawfShxLxuB6iXMNhpr3do3MXU0d3M4cioO5g4rPHCAeq2zBvOPLnTGXbnRZ3MGY9lyuhoxnBVXkClAqPJhKH1BA5g3c0XWV2BY578RtNFUVuw58JwHMwWJYOEc6GTTUwWzrvaXf/taqb4WPnS3aq6b1LRvSkoYtNq7i7P3/aqgN2FF6eiad0KpnUolvZEC6VtUCTtkSr/PbGqf05n2qXa327DPq0N61rRr9OWdanml6vX12ijca7kV1mrry3dcHMVv6d+6FqbtNZS719H3dZKr52BUw11lxo47We7pW76JjXTW+qit6dFdKmH3o0tOnCv3jXQ0zrntcNvqf556951rnu+Qc3ztiLWW6p33rpi7XXON6hxrh8nLWJm3/rmDnHwjnXNN6hp3py6AwBSrtWznvl2+FTU6bnS8a3SqLfpVrt89zrZvU52r5Pdht29Tnavk/6HrrWJQ+XwTaqGO1QGbzETPFZF8EepBt6/EvjKidu5VAB/pOrffSp/27vJpVSqW9Vv15rejvy71ZupVx1v+8JptU+51/DOV+h2LIDdWr+7UJ27BWyv2t2OC+HmXNWrXndbsiboXKs7qcTdusCudbozVbhbYLrW6M5V4G6B6Vaf23ElO9bl7lOTu900+Dj1uB+jFnePOtwacAvcrjW42ypqAcC262+71952ld0dam73qbdthQMHft6h1nZaSdt52/Wos+10ap0FyTpbcffyHU4VU0tJ+U0dJKYLXy1I6GPcUloN6UQUenTJv11XKK2BhpsVJ+lTauSJ1xRpLTgCj/DTDD2zi4xKU8DJ9emWoG8P98f7+S0XOUny9W65yMl1JjNuZZUT06CpzElO5V1d5iSqL3MSJRi41DnJKsYwNO9EAQmTwQCG5bdyqp7xmbgDBK4WS8RLra8moNZUmNSxSoK06klTBi2BDvA3wcMrIhdHMNJMaGSLRY8klyQrPehVPA7KcW2VvLQRc4201eaYOgDBOjcNlMfMTNwwVoa2EsLvRGdcx4pKaSkBW0svKY4lF5VlKcuY4e1TwmhSLF3lgtPxvFis87qUBjqDgD3Zo9KpLK/onJZw8dPSY9uvxWMQzxfjqSq0oVlKbvkAiAAP+VlVOcFdLZ5dLZ7cz64Wz8etxVM+5pgI3ZYXVL9rO0AjIwOAjG2hg7jfWJBmw2I0qnxKdRWcfoVo8gVnKiH3KEKTKzazV/Pi716AJltophJq5+IzLkVm2rKTNOsQrFzgUtAkq5AyRTltd7tsaeXz+mIHjS/zlnIleQ54oqVvkfgnlAuWKHHH1AxlwuBUtTj0A1HmLnEEPPyVz2a/zn9hK/y/4cWvwS+f/xr88rfpr78E9HnXGRkhiPmuNWPGp8AExCH7OU584CQHpgWudVay6oqLtlCNrxzrM4yvMpcCMOEJZkDA+CpJ467VdfUYAXgx8m1tiBlfovdewImvdcuo5jFLYxRIil9DwO4oiJCsxKKmMrDqrWvlJ9dMV4q01JnJEeW/07abVplprDDTXe3SValkr502rZJ3H4063CzOzCRbTt/0sdXeM4S14Gx9uiYXrx71PvDt5lwTqfguK73CHEtptT83nfcuACB/6z0H+7YcJI/LASrXhGDToN5BoPDu1LehAWEga8uAZszG2sCbI7tz7DtUsv+KUZFZxAOVlkZHTf8cq1Ay9IHkokExbh4J6bQVxnrWZJX4+AkQlI4AvuUPynKA5gifN2i9WegFsU8rNoKVFfutZlvpsqKyIfut8Kxv5RdgiqbfRCQUzD5+j/YciqbnuwAaq5MHmRKPsAI//qv4tk9/tKwmGuSZpkrqrVXUGyndXiy+dHJUF/tmgRqkHh/xhrLveT1IbZ137Q6hTpGt6U+mPK7PQyKTtR51RXZFMQ3XxFzglUzY3emtfH1YwFXlwqzUkC0ZVgkWslWmUrtxs9jRdrGAZYbX6mjQ0Ktn0cXjVexX8D4rMPBGh5w61o4bwrJwO0+T1cI0boDbwt4d2HgD8DoG78jIm9BWHH4sgQSCW/4tGhj4qKmYqIPx1sUdu61EW2kT9anVlo9PKBzJNEOBzWJlDm2yL0R95QmbTXFNJZB7wpCv6VKeTDSIQY0I15oiWyTZK9UPGF5Duj6iz2ZIN6lhVt/mqfd+V+4mdmxtx9Z2bO1JsLUGfpYc0yQ7PYtaV81YVICHcPL+ekteH8Y8Yp65jQ/197mmujqrb7alFdvUgz1ezSPid1H9kljyd7pXK5Mdz+A4bV5ZPVprooxhgQmLkF+TMUFyxbLw1GIaDB5LIOEaliSMSQBC0pXY6xrm6FNRt98KCVSxndVkm+UwQYgUHoh2TDRTANQY1jxdtNYRVgEJE0Cd749KfU15a2MzxUHM+qe6GWXECj0WMKOXjth8TtUMH+rS6ur9o8mATMzYbM0UkhtdVNeFba00rcE5aSNOdNviamTShvZViPh0ZVQqZue6qUdOS90yRLeH7mFBUVdJGjwGvQUL/IjiXEgyG6StPR4gebIOycwxXU7vQN/2Jy1UPeXTLnYlEgdSs9EeiEDgYF+/LTGKTY9fcHkAOy1yw0O4NM+3ui0wAaT2XTzT+bRpFDVkiuKeF0eifywqEY412K+xKbCcqUX3N7YMgyquUm8qYmcnhBQbSrZI4uKR50BbL5X+8V0Mzd/iaRorC3//x2/M8xDhwXZ+6uBlHmCdPQofBb+dz6C2bG7bWbDeS7DJPbDJL7DeLfCx/AGtrGEt+caq5OQuV3IEOyl/2MBbbkFJIBd5n4dP5yz32/OSc3ePS13i9vKX8s49bucet3OPe6Luce1+cX0c4JrcFBqSKrikUmhNoJCfqUGkqOe1j8OsQ1zqWVU5sPGX23viiRo6p2dQWkwmJA29dX0GnzxRiz2AiSRtQ+qrk3fXqPHXU8V/frr++vA1/Bu8+gkWmrNOqU3fY6Lu09h/tT+Vl2HWkWlUCfsNEUYVtuSR0ad98fJfMyaJ2uFwqFFnVz+zq65dciJVqc66pUVqctYR7imRRpmEXjVPc+GRIEvvzoRhP4eODnEmtdX/jUmAgf4oqY6ygeWmFtWbar8HF0fBGg0x1HgLpn5TOTeMKBaar6g2LfaiNsXeXcgfQjOc+DRp5L7LolBkl/ld1ZJVDSPHFXVAclQEQavDTq0VovFz1PmcNR6wOs7VaMEwdSZMDzQkKCFU/c01AVnz0enuUDq+sjdaLk2c0s0wj/ZzaL3ikWzFQA+n8QDVQ0E2LPjm5ApUykUBn73+48v+SJCgOxok0A50+ncoJKrgMxhfHSncKgADvKibw/4n8Sv9mA6lH1VS6+n9/ruUx6xSs1UQM0qOE9sFN0Cq78GkARmSrWikBTfZIOECEzDFRJ/a8dtPLZHpODapqDFYPr/Gpsb75flor1MuplrH5WS4xOqc96mzevK9mosop/nqfitro89VxKeNNp8Kq49x0sQLYaopmK5ru4tAm4nH6XZ38z1tnEAO7YynIjWFRnlorVhtqc4wAuEjTbzFdFWY7bfKXjV09uNsmGiAdGt053SeQ7MpqzCFdxjIcmAcc5IMIETrhw9gSVTWMMM8hSQhTrVhJho1LeylNtqMeSzZFM+F+7RBcSOGah68RAc5pjHQw5mcNHlW33aQAQCgxLc2WoB674EqnmXJYW5n1ZkKYLZwCP47JVrzHMw1uAny2MQR9Qp2e7NeGWMuhsSlFVurGo9U6/7ItnszNTIYLWP08W9qyjqzc9t3nnPavHa+CqpZxUYXB1stO1w3qxqeXBSA++0BD4t1reNQKn7ZWr3IN7qHBXT18C269kJERRzoosper8iEnaLl96ho0bdbNS1cxft8XCT6a5Td7hoUxqvWFfEW1LtD2xD1x5cT+rPjTZh5gI4vy8nnk8eTrz3wG/McM3G3yX5h4Sxg84W8psTVAfzS5PbX4bHjw0tjKsMdpPdHc9RkB7Qm69DriJZYh94jY/VXZRRwRMtWQkBDwmORa8l9V3zecp9mWYEZ3R4KtJJ8i54M6wM4pQ0+wI64/RzzKF46Yvf8/2LrTKrfhwUl6kWHDAceIolZHwzHbkjSbli4gIALCTwCihkMRzB4OdCi/5EGmSSUUA1f1EJcqoWHKQW6WrAljVBjJOg+kNDXOgplaRcyij2pAet3q8K9FqghdMqLWQhEb5Uh1hlVCwuvLL4m5UUT7wWdLJxh4eTQAn6+2QNRZzJw5Q865YHpZVMfMOszE22CSrzqoFxAsVAwmz/EIlRhfnvUEInSJVHbMsuLWxspztjaCBlVbSu1uWo/6mNb+znZFLUt9Fpt9720C8jYBWS4cItdQMYuIGMXkLELyADYBWTsAjI+fvRFU2fUhr0h3l28qg632M7I/1wRE1Ok5xYjJvQCVUVM6C/VERMGjfqIiWkKthA2kXx5lLAJBS0fmaC9G9FmhApgPb4uli8kC6lfHUGgCDrSIC7ykoxejlL0gUv4QhY9gwiPkkqgPmTIVo2QblCJkF6vQ1t/Z7NYD1R45zFtCvHoFdyxzcgKPfmKyAqNe2VkBdnFVgDALrZiF1vxW4it0Ce8KbbiTf6od0kujD1PqWh3yHyTNLVoRHTJJVVXVUSFVqloeNqDY1apUGuUZ1Mm74jOBUmfXmHXK8YJJVUaIFApS9ot2LalRWgWkDlIbsqHa0hpUiCl/e2srVlF9F4N4USiq0zjtJi3kJgeJ6CS+kNNk+eiOdVQSLpnBhauWBYxzC6j1bBoNJuQbMQlFWZasXmfNK3Cp0mwqkshXSFsOSBdr3UeQqUoZD9auld8qpTpYBvuxIZFJe7EyUWjIGsdYTE3VrUjTp3c1UCt3Xv28d6zuLgnllvUPml379nu79mEB2/9YVtYr6oXbqFJ9VO3iGH9m7fQsurxW27yKK9gzb4Nz7Yek+nDd0UjpWjdvXwTDTaIGPPxzWJlVaq/Z4tIKTliEpJqtM7Nx4/3Ci5s6IrncFHmqZaWS+kHds/i3bN49yx+is/iwpF/zPfxhg/SLT5GM1zXyaO67nH18Z6BWCD5vAvek1KXIvYaqJ7E8+wF9rzG4aDLPB//JfnxXpE7E+CneTJdU0S0Idna7snU/ckUaapu8alk1qnqiWQ+VT+NLCb1T6IoA7nwFEo/Pboh0LI5w2dQltRHqEG0N00aHhwd3xnmUZa8x8wAn+pBVjD4FUqgpstWxuZpWPwscym/cSxhK21++XfO7nGze9zsHjdP9nFjznjTo+a6cNg7vGrQEmX6u5m1krNsXTgzPByXHUEeaotgZ6vWknyIqIzWHo/DdkPkW/LB4H6tOp2oTpZIpbxWQKSky5WsLh1gM08k15CO1ineAflZ1AULZO5NhyDFpK0pgGJ693pkmb6TyHMdeBJ54JFQzZ2tMF8NsBDDXYwPsWqkV7Z2TQGS/J36lZMCwN3QSsja2ThvhcwGKNiXsiF3ejJmI9REAiYbwPqH9t0FoqZS70fNfzPRNTZzFLHZCUkqFTZu9t924hvzKj0JiHCJEkgbZ8lmH+y57+mWash789H0ClgwN11H7W5PJFF/8/CtseKYEmNryDZZpqufDoWvk8j7qMZpy/K2Zp2uXv5/FvP0b011sGRznf5BEnEnGrQGzfqCtxbMDRF3BYVB7ltJY5BHoFZlsJQWcKovyHV1VBjYpyYJVguy6YMzN7PsKSFhihwkmO+ekLsn5O4J+cmfkPrZqOL8VVM2SyonmwuszMvc3o2oZbrivD1tph6BnkxMhzTpXjYe0KCDYGHFeVBT+Futp+Y2lQaQtsDKiIbEMahvPMPn9dUJBtgidoJYhxIiQH0hgYp/W5sY/PrI8gUN4YHiM0tjgL1NjteUecaK3cM1rXn4gVMk2sq7cJ3i5OpEtc2vSNbJ0QgZuCDgBYQt6+fYtjSwUQSb2XFa4u2053SX9l3XIBVvvu9W986LcvW+eU1W90CE4B5TqODebNp3+doaj7xIm8jnkyQauzdLsrZdpyRjJ9nW6bCfqDy4nbJOVED9ppRI1VJZsWd+AyVVNc3Xupq6lGBaQbraIBVTPUqFp5UWqNISxDX7kU/V8ye9tkh+hySe4bVZzVwzsuoQbv9YdkhoavrYTWRxo5NqGrpvKMdt5XgrdM1e2jkDXS1YULnptELWrpqiTSkd3SZzq81MV8VeVdtMyiGWlYOoRe2BMDXHptrAGDVOfcg6FH683KxpXDuBacToLJVsc2lWE8tgw0xIuIYZYUEcUXy/MIE5iHRNDJvkdUUilE5UA8n8NfhMrAKyboDLTHbn8/GGGVMj2SET0MS2f9rnUTHZDZL/bZCF1C2PY8U5IbDMMV59dCNJ/QPrO1xnGzCHpY/+n1HhpPxnCsmixl8tpv7b3/jU6v21mjSZS22Cl4b8KS2K/6YtW79ZP50E8vGdwz6qZnIlUk3kKV0FfL2koczpHq1v0cob6iSxPDJ/Re1aohLcAwjIlNq0U9XdIKNAVIqEFQ9pKIcOjROd5uvRn0YvE7WkoAFNC5EsifQW5xks6vHohIlNTHcEr5CyajPOTQUHvcbXVLuR7AGokxQkSU+Kiosgh10Tfh1p1UwvKKhETG2SYw9tiPgEs72H+DAqAjdCM41yTlVNyACwJZnTqzgIrnjAvLXSHFxweVVKt43tkvEPc+COUvz1Dw3vi6dOY3F5dXZx9mZye3H89mxydXxylmsFWjH3dcQrkjDOGA38a1ppesRv2rkpKRuZ7P5GRCZn1+/HJ2fHJyeX7y5uEK+to4TOX+XFrMRLqWxuL6/Oro9vLq9vry5PHwelHJVyTZ8lpBlf3r45npzdno6vswY6j4czNo8jClMiKPgswsO9RnnLbA/gISy4kLAicjEqwP/rgkYULlc0PHszQa2S9f+wBbdYQMUITumMxIHUwyhAiZfD4T2JDlOWlgVeXN/MJAotDRmPYJAFN6gnxslEL8nl+e3k6vhaA7XOB5Y6Wk+pnwyKAgrvAkjJkw6KZILcW/2zWBA1f3WMMViYygdqsusJ5lPwSFScry1topVcK+6roj5MwNnFexSvA8ET3Kw4pJ+v3CvLD88S1ZkSmCn4VO1ZUVzBG1RC6dXBhcERmMgtzKGGke9asTyVZC0MWLlUZoCGFRu/Pf7m7Pbq3fn57eTs5PrsZpLdyNmlQu4GqzgIQKhbQorEfyJP3tbplMesm8ugkTEhZa7Gt2+/eXujgVYxgSMYpBxZ3QgKz+GShGRODVt2GCZZgG7jNMGvGeDs/11dXt+cXTuNtBzSDyseyeaZXJ9Nvr84uR1f3Jxdvz8+r4H52cvBRqLVfS/J6r6DYHX/aHLVfSex6v4jS1X3HYWq+yckU91vVaS67yJR7e7p5nv65vj6m7Obvlc01hmovac99FqPl6v0+rWlDrn/ES7LdG5P7p40ueI/5k25e0ssqdsdb/aN+y3PhJzLbtf8+8vzd2/Puoot+vi4CC7ZEe1YlxdjNXQ/maIoFSQCwURTuUUaGKYVRFulAsdrbSjuveSSV8gWXKLIiplZqo9H8MW/f/Hv9g8Rl9zjwZEqy2n+lqlVapvmJYfKy0xQoehxPENnt/URXPCQbiJC5W9Z4i8ZDoD0o05CFVnZkLhM9wc6XXB+10pft241klYnKQhAef2xcK6ta4kXX6u41jK/btJVK7E2INgnFLnqEapQUSnYpllJoHIV0kg0F0Vd1lA9qwM+l1xIn0ZR8fP9V68Lf3r9l//z6jelHzs+fTueTMaXF7d/PXvz7eXld7XXWcpca472wHGAr4/H5++uz26vLs/HJ9/XDPU1YYHm2c+0omNJQjajQoKPzEebS6zQii40aPRTxY/z+fqTDS8O9p5pL6mMZ8rJtYD/A9dvjk8gilHMzY+WlGK5pxGWZN97honwNCCfzWYUzfoBC+MPMAvIPY8jgajoP/lMyIiLvWd7z+BrHoGIV4qbo5HS9A+phMuJrssCckG0JohM+T1VKL/ah2+ozDtIaAWScVNE+TwjrvMImPAEI/4SVKHnaK2NnASHMad4tPcMXu/DiSoFYsJcNRxdNV97jBlHCN3diH5DT7ChH7F7GqUnONvSEwxr1Gsh+Rl8tg9vFUcw1iqDJe6dcG4GZSEqvkzJVQ1ztNcvZjzbCZp+6jupXXShpqDW8VTP9eT6VDR26jGS889v0pE9U7iUSEm8xRJdabcWDK995o4T0FVR8cU21eHxJSTr4+TvSVWIfBFAR9f3bURZF2eac37HHa0e7n45hGbn/b7zfs/97LzfP0EAdfH4NkVS67Z9AqlRLmivBDU5mYzH4Ywn7vgguf5r8vI0BbfVJmBBUOOmi5ISy6Wilaa8MlPQevgos5/dSqqMfw4TjtNYPLvViQlpNlY8ZKYerU6D57psCY8gdpv58/M4zCnzgji0zkYvD+DVaAQ81PQ3sZl1DoEvjD7zCF7uP++LdqqWIIET/jeZDibWXM0i0Tji37dB0kbvtHs3H35zFJNzonhVj11N0C9NFcIUTjQ6TtrjjWmw1Qp5RTJTR0g1aqqpB/k80TWtGlyqoUNJ9hpHv3T6Nw2F2ytmr5qXZ58Yv5nDzNKECrEwWWdgGnDvzljEgUewVG8XXfeWQMmG0IEMHlkRT6nYnOqlmcZOC+RQI03NBhUDbrXRVHNQ7e3OTgqj4Rdt/UlTNMk4ChtKsiaF3zXTCfichapjmgmv78Rm7pvm60n1hslySu3BiMPCsHY69IP83LLG/QP4MBMK6tX7vpPAHXa5yrzEWqbyNtOhsEDc/PWBJSuE4GsnE1LqC2MDSzxPPxkjcI57yyYNU3tqs/PBH0IaqQf/+NRp9Mu0vZXR1L9UndnkVKBLjvqCwJsZMBPlzGCdJqDUNZdhsHatNoeNM1snL6Nl94Rhf7X4szCF13DTtFc0FJLMWTg3t78rq5roXqp9nlUW7kLkWDahW2MomunORJbzo/Is5H7vBZLdppVSYeuzmqpn4vam1lxteAi1UaLD7KnrJp01ZfRAab02Xce2U3WUXm22sj/+pxBJZkLA9ir5f6bQa+19//EzdjypNKgO6taG5hObRMgocqG7MncDNe7ek9TgopJvSFaq4jT1R2nKohHjRzDIqFwW8XTk8eVh2kTZAQ7pB61aG9oUTZJGh8pt5PDzV38aOOiI9T63vb2ACEHFyP57ZHajIU9RU1zXrEJhrA+q3QOYGKmgLq5oUVIWV2Jb0AdXtEm1wSdBLCRKA33SpmZSe2oDTCm152nxzy75RU+ppNEyly2PFMlhXJVtfk65iHg81/kGKghnFbZTCj7FmEP9BmBSwJTHoV/opBNGl4ITs/NVXxkPtRW3PO+6zzXzz0BODKWlrVoa5XheTt3qp7FQW9LmV9IzkdRWJCJLtWBC39wE4tCnUbBW6l1zDkCshaRLwHBmJLyeG9oc9f5Mzw4cp9qLqqGth9jUyos4ErPZxlhY2iyJ+r4CnDEwhjwchlnLSJui5OMYI7Zjfvh9GBzyJ66R6vmm4D8pfgITbXFPaI0bcHBNlZV5gGszQPZBB6P0z0tKQnPCKnAy2Ku+CoXVYi1YoVZew7FU49/RlRwlAz/6cAkxro2sWFT50jBeVonTGsGKD5pQzpsJ76XmTYRNqorY2fmYFkilBQn9gIrs2zm3Y+pn+kksbFuyqf1+rGjpNVYkeVYQqlea1wIurFk6jl64O7oe6lVbkpXWkhW2V3IZZrrOeJReofbUoa8QFTTLVviK/BxTkBy+S2XpzcKYayUf/Fgt7zk/8mYkELV5GXeC6e9MMJ1SSXbS6U463UmnO+l0J53upNOddLqTTp+ydPoJk4bvVPVtqnrN9j+Crl4P1Kyt123a9PUG5WaFfTLcVlT2ebfAYj4/kZEIdMRFJhg5UyTBysWjpjpfI4Rww9+Jct2x66pP1Tb5YdFZIG/DNEkEk+oc+WmEMF1LKlrQxEmpkh8VeFZ9q85j1v5YrLp+XaQVNad6iWXrT0iM9d3yO7LRWcbcJXaXaTEXv3YnR+OctqgLyM6hSgaXPFubNEF/SgMezsWotVxfDlpD7b6yCXEbc7ASWH4W1VvXNGVmu7lP7ZrOinmgqmb2u7KeGZoVPDGIJ2MSwICHQ5+Ju0Fmuxvituz6nU7gnyxEZvdEKhC7NapFNUh2dHpIcMO26Fum65Y7p/b53ORn36wy6qE2enLixU6ZlFUmqWhrfx2SpQIQrNXeuGeWpxpwwoQxYeC8dq4kseRLIk2nKTXxTVq2TUSozCMKBPOpR6K0AscgL60NDHh9GPwkmjpb7axaS6SmsIroECt35F73BxALGgl4+25yk3FhT6eS06ur4RqlifoTVa9+a1TBNajhoM2ptFod10Elt3UZVycFQFpPaVpkhWSGtkEQ2U3yDZVXQTxnoRL8XuyD2lImjxKRBsNmyjfSSV9PrXTSzTJGmpR/5V+gL3iUcp/92rPDo0JFGS0u/C0W0qqJBJCMiql0d6LkYoiqtysTwJbLWGIWfzKTNErUEc0EagussvP7FjWyTr7X+S6F+Aq1rAPb4pb5A32fVR7Sdl6v9oKW99vpBQ+ktETmwqwl5aivu7p+OHQgWrZDFcn0d0uwjEc6SkuWBgnlMpdgZhdKckdD7LHtGbckquchra+FmqhG4YdBfu8MfnTokqVcRYea12nrqQ9b3ssZuudfzHabmci4zCa74NJUn9QnNGEKqgZF7UBKOs4IMzyCyORQ8otJVASVCmUtu2iuQjBKbHFgd4SRkyOKXTzqw4oLmeg0U67Rr6Bp5qncSuFSj8K+394Lv9BspGLDR5Vd1dYwp2Kp+bGhk37v1Y343JjLbY6/KdNpJFG5iREjKEWgbvKeBMxIVwW+1zSZAz2AWtLEst6sCrG3Le4y3+S1yaCyIKsV/Ui3SP1bvnJnHF+N7fs9LcGq93hvjpymdnKKAR/P9JCRoRaBFaMezakLgIVCUuKbP9JQsogm66W5AiKUUSdIwkIgehPAf00uLw6/4Yb6JtwY6+QsaSix3MYCiFCoKcqr4Bg6sqmVRmn6rx9e/6g3FP1AlHr5wOrHk4vQ7mIm9GSSvshCEKUV9w3SD4isuiyAG2RjCgG7o0cwQL1YOvTf1W78xwBePCyoeiupfw70gImOJMtK04H1CyZi8zmNqA7HoupRvQ88UviHPNM4NNWPLYfwS4j88PrHAbzIzwtY6NMP8DqpQbTi/r7Z8WIdSvIBmABvwQUNda4FPBf3FARXsWI0CIb20fFA1roStCal9rZZkUgWNEg3l6eXR3o0tWzzEJiAkEuYMcUL1AGWuRMn4oSxeQsSYvUlHUwbK33DqHfigCp1ToNap3jQPqHSxHmKzuGuz7Oq48Yp5gyDhz73hJqgspCKQ35Po3tGHw4feHTHwvlQbbKhXnlxiHz58Bn+Z6MZ6eSOztPK3QWPOTc1jjh83j/GVr+kutwEzyfWXl/oXZADchxuSXzNAkm4fvRtrAiIcYneemhs0kMS+up3wYRUf+9NsZg5HuB349OPs7lj1vu09oxHbXCQgGonCfuhpqzTsCx4bjGqtTFyFVcjWqaGh9Sq36UCZsZS1CpkZxtbVY+0JqY0sQTq2YcsxLJTOaWGfjO2a5THoX1qQukxAR4RtKwp7KcbtEBvFaqDxAJhtEf4LMMX84lql6zyAObXVyeoQaqUve2YTVh2wiF5uSunCouFyKBhZTTrmKRVzwKYHMF4BiGXqbRzAExmqrThlrK0lWZl4/Au5A8o0CeJOPgsOx0mgMC7kH2AkIRcUI+HvtD9aehx32RwCYGF8o+fj+AyxNYH1nFhSUIffvKJpPBv/yr+9eInM+X8Htf4hLkxBAs9Cq/+9O8vhy9fDV++gpcvj/B/8O7mZNRQCg0x6VyZDevQtR4NbGXPRECENDVIqW8+mcrNpe2c3i3vVjy0WVjMgymiMlqbXaTB5PeSF1DS8xXVUtwzfyWYxjlrna7laRXaas3jUKL03TxhNd9kL47g4vLm7MhiY/UTAZ/P1UbVloesusG+egQNBZPsnmbZYH/xVjJH2U82sz49daW1yRCk7pnpWkNyE3VZ6obUuoXTpsUajp09oz4eA0d0biW/jcWn4t/tKGzAvg90JuIHVkMyQSVIDoObKKaDGl5vjXwUF4+FVAjgxXW1HL92g9Wli8l4kDlssaQtRH182T7izlJ43OKgn2hftSGwkVDw1wUNzXLkYn0MZCZyyOZXxTRFneDFJeoFxZIEgdbDhGanpSvNZsBkTuGS2dWZ1sayouihags7SC5Epsg17+E2GWDJQraMl0fwsnvp1larVpNFizWasjpYRV2IVX9yFkQAZWpRkPbaNmBWTepUfrCK+DyiQoyecKXY2hjKvcK7q1tM5c5zeOc5vPMcLsxl5zm88xz+mOHNO/fhnfvwzn145z68cx/euQ/v3Id37sM79+Gd+3A3ZcvOffif3X1458G78+DdefDuPHh3Hrw7D96dB2+DZmXnwbvz4N158O48eHcevDsP3p0H786Dd+fBu/Pg3Xnw1uzLnQfvzoN358G78+DdefDuPHh3HrxbKHXp6MC7yzv9afNOP37C6cZM0y0ppptzS6dJpS+y1UX+KfNKj2dAIKQPKa7ZqtPGvGjuPCO/V9RewK0PV+9PelhDG7xfNdzRikZagyb1JjgJCFtWevhOsMPV+5OOfr3jHL8u+kU0T97Jald2TiwJFg6EuK+zVNYQoip3uztRCoED5pZNpAmNTHIV6P28WPsRkVWiyOhRQgqaZ3BTWKoqq7px4UxdfvKNtuF3/jge54UNllGD1xmCmepgzjYL1YqN4CqgRFAIE4+EexopNXuimrSGYu1sHaxT5yBvQT2lH4Ypl4vikCT0a/CVHGgo4ojqfiQySkccRAIl3kJLmCN4Y8a2FmyNG/VhFTEegeQQo+bBareM6a1xp6EjwHv3g7TREbpxVrA+EGcNa+P0srd4aTIn1R9Lnvy/reTiWrMVCxo9T44zzHhkZeJMWbMa4mvnr+SWMaztAPJ+EjUPwtEuimAXRbCLImgmdi6MAPUa2pMFvAWJiCdppE6VV9Rt5S5nfcY7mzMN9zzUhzp5/ohnhSdD6tf0vIMNbgPvXRT80wvAmgW0WNzsH7lVn6xa8dpJqV7bu+DEl5XDrqr61ErKji6meS9a9RZhAogQ8VILp9PEnwa9vlPnOdLgZZhbgXQsQWXpjedzqv17su7UFY+px3LzrZBmOvj8VvSuWcAOvor5tSRh+ugp1Rd1JnP+cYZERoctTWlSvBJZmC2y9kTTFdeeoQ6ZiysWcItpjJ+/r+5YFbfR55UFdfCtUZHOJIQsAMkTvWiqQ/bpjMSBhLpyoErfokrYztk9DcHTlcQQNPr4LeNAslWQwtHI5MFRcQQ8TNusaISK31MT/zEuB8SlLMGG9pA8zINCB/1qT68C9cDSzGvG5nFEgccSHuysiRDcY3hZpIgAM5wnS5eqFcn0NmYLIrNwLBKaduMZLHmEJA+RDFUg8Uii5E0MqTPwUIrMgUDaTykNYUmiO+1zYDA+gLxxJjUsIJw5DSmqGUioXUVHcLZcybU5qdbRkgQBfzD+zqnRZvS89iRXHvQmn6RKz6JP4TUEJzxUN10ktLs6vpTXyetlSuUDpaH7I11jL7RbKno1zOIAXkzX2mHZxqMR2fHxr2Xg3DN/H6Z0xiO10zTQyod8myDT/Kxv52/N/R3YXK02phQq0KSOcbWaVUNcEGHiOmiYWTcVAKmmpx+xjXivqbRuHTccyD1nGBcimBI6BfXiiMk1MCEwrsT7PW66510DXn7Xbnhp38RgtXPBk+aZX5RD8q54vGwEcjByNzkUNW7Dj+brlpGhPSthLWiwUodVcohXKxpBoBQOmU0jXrARHR0AWa0Cq11Ov+6rjj71mE+zca3rbLwMC2MKGEDJcJfYEKsE09wrC6ZEUN+a3hXhgM/MFCOqw+tHcJPtn9vhQQB3lK60F58aDo8mCQ0I7qk7WVhq5U9K8irPegXuPAF3noCf0hOwlz3649wiOz/AJ+4H2LJ1Xd0EHSzImns3ubR9pD258yD8PXkQroiUNAqP4H9f/M+//Trc/88XL354OfzTj//24n9G+Msf9v9z/1f7j3/b33/x4ofv3n5zc3X2I9v/9YcwXt7pf/364gd69qMjkP39//yXCmQ+DDOuWUrM59FQn62cC1zLxbBLolnrSrJzk9q5Se3cpNxnsHOT2rlJ7dyk4Om5SW0/k+rOV2rnK7Xzldr5Su18pXa+UjtfqZ2v1BPxldq5Je3cknZuSZ3O1M4taeeWtHNL2rkl7dySdm5JO7eknVvSzi1p55bU9eDCzi1p55a0c0vauSXt3JJ2bklP2S3pqWYGy+e0yuQEm4xNjdRsEjBt1vVUp5En2IivaEinuaRZz2zxN5G1sbIwYKHd4kLtJP3rOZtRb+0F9C33aZK3KrUY4B+ewQXHF7Q9a0oKjSUQ36em3pPk9hvEq3lEfGq5D6wCElIQlCwDKkSwNmOcrRZ0SSMSIM43PC2sB0RCFIcoymhdw5L7GdYZC/VEX3EfZStbIg7BDBRRCiSldqAB0FBGayARRf21lnxW3B+HM34ZvlXyULJRiJTEW1hDj3VrU8uXWz1vQf1YSZmlBbyKGFc6Be2LUl5Es3RDT7BhJpWbFzEsObeHrPEI/vTS/OzNAz4lwanWI1qEcjfUAB8wKzMweBXK4uRxcTKRPMoWSEtxAJ+uAr5eouYiDNajwWPNO+Q+rZ7xq48wYzV6zVztIdQK9WseUOMhk6NCNCXeiMRywSP2i5bwU2o0zVzyCOefSaQ3NMqlPVPDA8/i0HAbZe5mHj32UGbfA6gHmdlKgiQtdRkI23wv4gE1ZZVKE3VEN9JNyYp9o7P01RJjr4aij0dKxC2KA2qIaJFULHhgQtrTGyAXPW8YpGl0T6MpNphTOTiAQcAE/veBSG8x+LEfcE+ZX3uPkGduVeOZFp627WxzJmiRKEHMgzoweiuqftM+Feq3VZdxBPUiKhtRr6JMdZrIqgHyvggbUqr/uFqLWxo4pV8lCjm66lJuj4LWoRaDitiZsbc5ohvp03lvdezO0/Q4j3wWZplU1TDo7lk3s2RGdopmHXNra/ZBBQpV6WSrcPAwsaz9k58klm3YciWap5gZ3BPKIF9/1ukn27xamQQNzU9SEWWF5VSbm3eB7vCzt1d5GWdvMce7qnhHV9zQSNtHvjLTWq49rkzLow8GCfZdGXblZcn9R718hYIg9Ao6s530dFQd09VHEgYa+Z/7WM38rmk+nUWPA3yPKTH7yYshj3N39SKihq/fn0saNo+whW26dfmlhQIb3Z9/qIL4h8q7T02nTMsDyH5R/CvzT4+HMzavHPRjPguzjPk38Cws3SMdnoVmIpJIOouDCZWFtPkrcdj5Uq2+UQECMqUBEtARCkCqX1Ou3ise0lAOu/dNkvG/Hv1p9DJR1QkaUE9a2/ZSHdDzDI6g5u8wEICiekXDXCyIA5j+szU3KnokwSBtzAZ7ABFFy7g4gld7AJIuVwGRxhyTXVmA/Bp1oUAbDTpQYbNVb175ovv+Kqssu3DSC6aq7vTou514SIvg5kJrrVKZDSOqTA7pRADYErXn6sjOPSwsoaIarJN/ps/R/avRq1G2fAqJ5qKowx8Mh/dffTEo/1VBIr4fUSG++pcXx6en12eTyX5Fw4ASn0ZDPDmMh9kWNLwvjmdipTS4vYLHsFY3Ht6T6DBgUzWZQ8G9OyrFoX5erCL+YX2IKmXu3RWpchUHga4kqcJaL7i80ibBvaJTMiqWRTVqesChz4qFZZaqkw4La8Wwci0zCrFO65npd3T/2ejl6LP2NW1fvd3aJFANfasYR9v6VPR1XqPqc1c4Tl+hjn3QmZzZjZcRBzptvEy/o/vXBWbiuqq9N2gjlWaUyDiiwzmRVHx1w1c84PP1V8pUVNGafpARGWppfGgvuLrGSyoj5okEu6PXr1++elXRUJnDeCy/ev3FS1Hx2fiXD2dCGUa/oh/k507n7+33txfHb8+qzt/XEV8e7ZW8gpR130iJld/0wUhCWUOypJUjb/3kf0puq58YHXe87aTO8Fa3e9879uNx6U+3VsmrBZuV18tIUodpS20uPEoluG5rVEvUy6uzi7M3k9uTy4ub68vz87Pr29Pr8fuz62oyV94XFdAm49uzi9Ory/HFTTWgOGQfjg4P+yxb9XDHV+Pbd9fn1aPZoNGMq0K+3TO4XNHw7M0kfbWZeE25oHY59MKpf0VE/ZLI5aIEbUGEjhNgoZBEOR81TkBxv8nV8ckjs0CcVyMi48vb8cXk5hg3ws33V2fV9NT6iqGlxKAN5tnF8Zvzs9vji+Pz72/GJzVneFC8nhRngQrWYiOrv/qXF1U7rupOjaOg0NpsmKrGegNm2xdPx/7gk/ERPVrF46lyEKoit05ZhD5FqD5qNjAo34QhHJtLAWpNKGkTDE6ZUsAgZclBldvXnnZX78UBOjiITPjIcaoHe3R7vL3bPpYxvrN2fCO1dNnNqFoFicpv5aX0iPb+Gh1nB717I+16IFBjYrAa6Grb1qNoUpNd+BtQo+ZPzBNyrTFhxcOIzpmQEWk5085nQ8tVDbboxLnhYyrfy7P9DeydmiXqsom2YsJvaHjBfdrDbN/LYL9XtMpXLpbLbkAPxUYj/WOfPsdT524obTcfbsm2jReRvoXcje61Iz62ka8LWVL7au5O+0gcauucKd3k2+RJ/XmRGQXX6S1Z9XGxYcITjPjL6oNre9tWR/DrHgDAs//f4ZSFh2KB/2Iz+AGGH+BwwYU8FOqL7QA//gfIBbXqA28RcS51Qyi0HPzL/6cfLDTIA4xFdBhwjwQdQNf1qR3EGXJXnHugXDeEoFUdyy1nLHfMTgld8nATk7Ha9y7G4hojpOneCr+jQbGq15YNyMkQfU3HGQB95rYVG3AGh/7W3xyQfsu0VYtvPiLC1dabZeAA6vhcUKmUAbm4pzbrL0LRQnnKvDsptCshbN2Ys6EFWcWoBVQmCKrrZ7gicvHVv7zQGqbb67NvbieXJ9/dXh3ffJuDEdh4qTxeq4hOJF/l/whAP5Qz9QGopIEkxMJ75rpRksTQU/8fLWEYzeAwi9xhVbyXQxM1Q1TgDn50UUc36/i15N6sE66iXovJwCyGAS8q59E86Hfv3pzdXlyenj2OHQvzD6t9fVFnx8KxmxT39VzJRX+pKdOivywZM9L+uW3eDCXbtIvlxKbPwbQ0H2TxaLB7FtB5ITBT/3hkRaYsYFV5IIiPR+Tk+Or27XcXl6fqeKh/TL6f3B6fvh1fqD+k//gx1x0zZF3Zoc+ERwJiMiTkcXgsq08N70J9oJ9Rb+PuGZ/uD56Aqj2zk93MgoVJfILD193q5cLIKghSDTQnBOzMY9s0jwE8g7NQWTYOT5kgmIY1lupSQ5YFxifjQCc3MS/9EoSIelxJZJjMJMkyMcQclkJmE3FnJ3999vby3cWNo6ns8Xm4T5UA2NLfp/fVg3NfDLGhoxHM3sklYuqgaRBcpygg4Vr3FyCojFdqRzGf5qo40AhIVF7YVcRXZG7SGXl3NiU2PvyWxFuwsJQkVGNq+ung3zfMZ5F2XCJBtSFUQRxGnMuGeas27YN9y4W84Sd2WtXD6RdsVvtQN2ju/V5oJ+KpblX4Xmt+rNwfaloIJn8dV28WnU3hFMnJo/VeBymicZwaOe/WwFsfNmFxGek8JHtO56kPHtXyZj+c6o5ZJ7w6LUr9bvOs7iw/qHESU7kejuDlF198kfvqoEtrP1yNs22c3f9/AHvoASSEXQQA",
+	"releases/release-1.10.0-ee.yaml": "H4sIAAAAAAAC/4SQT2vjMBDF7/oUAzlbSMvm4lvWuwuBzbbEUOhxIk8SFVtjRopp+umL/yVuofSmee+H3ptZwZ5qwkhQUXTi28QCfISHlsKfXyVYbY02GZHqSKLnkC8kbkkwsTxvdv9y4JYCHWI2q9kN1FdsarUCq19BxrgIDgMcCC7tSbCiChIDhivUmEiWpJqIv8JNrgAaH57uVdbaKMdNy4FCir3/4jtclgRo8IoxseRgtJ3FUDX9vB5nF33pK3Iowx9uwBVA//SblNCdSXLofmijzawX9SUmkt/iO5I9nXxMgj1ltdF2pv5zRd8gj8Kd7zei0VrfM/YU/dsgG/3zLpcB23jmlG6txs/ipBccknBdf7BvdxjQeSrK7VcV7RTYMLqi3C7u0Ht26X1eobePhOkiFHOVwW5KU+8DAD7boftxAgAA",
+	"releases/release-1.10.0.yaml":    "H4sIAAAAAAAC/4SPTUvDQBCG7/kVAz13yYq95FarguAXDQgep5uxXcnuLLPTYP31kqZtIgjeJu/7ZOeZGaypJcwEDWUnPikL8Ae8JIp3NzVYY0tTFh1J9hyr8zcnElSW9+XTYwWcKNImz8/pfKDMAUNbzMCaL5BhSwaHETYE+7QVbKgBZcB4gBaVZEoWJ+JeOFQFQPDxbZRYmLJwHBJHipr7/tN3eNEDiE2ooBy47GvfkEM5gi4rSz/0o1+qotuRVNBdmeHXY75q91lJbsV3JGva+qyCPWVNaeyZeuaG/kFehTvfa9NQLcYda8r++xiX5nqM64gp71j1YjU8lk/5iqMKt+2vOuABx8MCo1vVD5PjrLHGTru/vH4GAEdCH/kNAgAA",
+	"releases/release-1.11.0-ee.yaml": "H4sIAAAAAAAC/4SQzWrrMBCF93qKgawt7Nx7s/Au120h0LQlhkKXE3mSqNgaMVJM06cv/ssPtHSnOedD58zMYEM1YSCoKBixPrIA7+DZk7v/X0Kms0ynCZFqSYJll19J7Ekwsrwt1485sCdH25BManIG9QmbWs0g0x8gQ1wAgw62BEe/F6yogsiA7gQ1RpJrUo3Eg3CTK4DGutdLlX86VYYbz45cDJ3/blu8LgnQ4AlDZMkh1fNJdFXTzYthNsGWtiKD0v9helwBdE+7jBHNgSSHdq5TnU56UR9DJLkT25JsaG9DFOyoTKc6m6gnrugX5EW4td1GNFiLS8aGgv3s5VT/vcilQx8OHOO51fBZGPWCXRSu6xv7fIcenaaiXP1U8c8Y2DCaolzd3GF+6323wo4wHoVCrhJYj2nqawAGczV7cQIAAA==",
+	"releases/release-1.11.0.yaml":    "H4sIAAAAAAAC/4SQT2vjQAzF7/MpBDlnsLO7OfiW9W4h0LQlhkKPiq0kU+yR0Sim6acv4z9JAy29We/9xu9JM9hSTRgIKgqluFZZgPfw2JL//7eA1KapTUxHEhz7bJq5JUFleVlt7jPgljztwnxS5wNlz9jUZgapfQMZUgKU6GFHcGoPghVVoAzoz1CjknwmzUjcCTeZAWicf76W+GMTU3LTsievIfqvrsNLPYAGzxiUJYPELnrFV00clvFlcIWrqETpn5Y9aADip1upYnkkyaBb2MQmk57Xp6Ak/8R1JFs6uKCCkYqbphP1wBX9gDwJdy4uQoO1vGZsKbj3Xk7s76tceGzDkVUvrYafhVHP2atwXd/Ylwv06DTlxfq7ir/GwIaxzIv1zR0Wt95XK+wJ9SQUMjOHzZhmPgYAzUTGyF8CAAA=",
+	"releases/release-1.12.0-ee.yaml": "H4sIAAAAAAAC/4SQT0vDQBDF7/spBnrOktR/kFuNCgWr0oDgcbqZtivJTpjdBuunl/xtC4q3nfd+7HszM1hTSegJCvJGbB1YgLfwWpN7vM8h0clcxxGRaki8ZZeeSVyTYGD5WKyeU+CaHG18NKrRBOojVqWaQaK/QPo4DwYdbAgO9U6woAICA7ojlBhIzkk1EE/CVaoAKuveT1VudKwMVzU7csG3/qdt8LwkQIVH9IElhVhPoiuqdr7rZ+NtbgsyKN0fpsMVQPu0ixDQ7ElSaOY61vGoZ+XBB5IHsQ3JmnbWB8GWSnSsk5F64YL+Qd6EG9tuRL11e8pYk7ffnRzr65OcO6z9nkOYWvWf+UHP2AXhsrywpzt06Dhl+fKvildDYMVosnx5cYf5pffbClvCcBDyqYpgNaSpnwEAwuLlhnECAAA=",
+	"releases/release-1.12.0.yaml":    "H4sIAAAAAAAC/4SQTWvzQAyE7/srBDlnsfN+gW953RYCTVtiKPSo2EqyxV4ZrWKa/vqy/kgaaOnNmnnWM9IMNlQTBoKKQimuVRbgHTy25G//F5DadGET05EExz6bZm5JUFleluv7DLglT9swn9T5QNkTNrWZQWrfQIaUACV62BIc271gRRUoA/oT1Kgkn0kzEnfCTWYAGuefLyX+2MSU3LTsyWuI/qvr8FwPoMETBmXJILGD4qsmDv/iy+AKV1GJ0j8te9AAxE+3VMXyQJJBF3dIJj2vj0FJbsR1JBvau6CCkUptYtOJeuCKfkCehDsXF6HB+nvJ2FBw772c2N8XufDYhgOrnlsNPwujnrNX4bq+ss8X6NFpyovVdxV/jYENY5kXq6s7LK69r1bYEepRKGRmDusxzXwMADHeJwNfAgAA",
+	"releases/release-1.5.0.yaml":     "H4sIAAAAAAAC/zSMsaoCMRQF+3zFgVdv2Aduk07BThG0soy7R4hs7g25IeDfC+KWMwzzhytXRiMW2lxTaVqhT1wK5Xi44d9PfnSd1ZJK+KEW1ti03vfnU4AWCh82bHb4Rv4d8+pmzUWF0iw44JV63B6ALDmgj37nJ/cZAK4CXYWHAAAA",
+	"releases/release-1.6.0.yaml":     "H4sIAAAAAAAC/0yOwUrGMBCE73mKgf/c0Ir2kJuCnhRBQfC4bVaJNLthE4t9e9FW/I8z88F8JzzxwlQZketsqTQ16BseC8vtzTMGP/rerWw1qYQjamGjpvZ6/XAfoIWFp9r9td0v5DfKizth8F+w/aJiJsHE+CzvRpEjmoJkw0KN7Zx0B3FnmoMDcpKXf4Ur37tZc1FhafVn/0gr7XIXDpCYA9beX/rRfQ8Adqpa/OAAAAA=",
+	"releases/release-1.7.0.yaml":     "H4sIAAAAAAAC/0yOwUrAMBBE7/mKgZ4bWlAKuSnoSREUBI/bZpVIsxs2sdi/F21FjzPzYF6HR16ZKiNyXSyVpgZ9xUNhubl+wugnP7iNrSaVcEYtbNTUXq7u7wK0sPBc+9+2/4H8Tnl1HUb/CTsuKhYSzIyP8mYUOaIpSHas1Nj+k+4kbk1zcEBO8vyncOkHt2guKiytfu/vaaNDbnSAxBywDf7CT+5rALFmqA3gAAAA",
+	"releases/release-1.8.0.yaml":     "H4sIAAAAAAAC/0yOwUrAMBBE7/mKgZ4bWlAouSnoSREUBI/bZpVIsxs2sdi/F7VFjzPzYF6HR16ZKiNyXSyVpgZ9xUNhubl+wugnP7iNrSaVcEQtbNTUXq7u7wK0sPBc+7PtfyC/U15dh9F/wn4vKhYSzIyP8mYUOaIpSHas1Nj+k+4gbk1zcEBO8vyncOkHt2guKiytfu/vaaNTDpCYA7bBX/jJfQ0AhUuSseAAAAA=",
+	"releases/release-1.9.0.yaml":     "H4sIAAAAAAAC/4SOT08CMRDF7/spJuFM0zVyYG+IevJfIDHxOHRHqNntNDNDI356s8AKN2/T3/ul701gRR2hErSkQWI2FuBPeM2UHu7WULu581Uh0cipOT85k6CxfCyenxrgTIk2Oh3p9Ci5A/ZdNYHafYOcKhQCJtgQ7PNWsKUWjAHTATo0kmuzOhuPwn1TAfQxvV8mzJyvAveZEyXTIf+KBcdxAKntGyje3bp5FTSuY0sB5SgGNZbhGM64MMOwI2mg3Djv/MiX3V6N5F5iIVnRNqoJDlbtvKtH64Vb+kd5Ey5xmE2naHbpWJHGnyP2rr7gdcKsOzb7W3X6TM98ycmEu+4q/h0Awk601MMBAAA=",
+	"releases/release-2.0.0-ee.yaml":  "H4sIAAAAAAAC/4SQT4vbQAzF7/4Ugpw92E4pxbfUbSHQ3Za4FHqcHSvxFHtkJNls+unLOPHmDy25zfz0pCe9FeywQysIDYpjPygx0B6+DRg+f6yhMJnJUsRkQhZPobwQGpCtEv/aPH0tgQYM+CLpQtNFZ46275IVFOYV+OQl4FobDgjaInT2SKNGz/hzdVzAjaLUA6PQyA4FWgwOk1WUCIKzAV4QxuHAtsEGlIBCd4Q9U3+eKQr5xTA5S78w9WUC0Pvwc7knN3lhsgjt6x2MVzrqBwoYVGLjbz/Zqwhi09GKEpcwZWZ9T6t6e1sIViX256ntBh9wbfI84qYvITMfTDFbiq99g87y7Onm+QlAfPqNqnUtRsN5jYVX3SiK/In9hLzDgxdlG1W5yUy+qJ6pwQeS70yTjyngqfT+4rFD8X/wdOu7C66DHaQl1betTsPkzCsKytR1N+W34GbpVWBVvf3flmuT3asfSsm6qt7epFbc1v518B6tjoxSJik8nd2SFJ43P+orUtXb5O8AEfXpf0EDAAA=",
+	"releases/release-2.0.0.yaml":     "H4sIAAAAAAAC/4SQQYvbMBCF7/oVAzlb2E4pxbfUbSHQ3ZaoFHrUypNYxdaYGdls+uuLHHuTLC17k755M2/ebOCAHVpBaFAc+yESAx3h24Dh80cDpc51riZk8RSq5UsDso3Ev3YPXyugAQM+SbbSbBbps+07tYFSPwNfLARca8MJIbYInT3TGJNV+jmTfN0okXpgFBrZoUCLwaHaJIkgOBvgCWEcTmwbbCASUOjOcGTql5kSobgaqkX6hamvFEDvw881SaGLUucJ2udXMENUjvqBAoYoqfG3n+waPnWcrUTiCqZcb+9QbfY3NNgoqa3IbDf4gFtdFAk3fQW5/qBL5cQb36CzPPu4eawCSE+/i9G6FpPPaj3zuhslIn9iPyEf8OQlsk2qQue6WFWP1OAbku9Mk0/J8VJ6f/U4oPg/eIn47opNsIO0FOPLVpdhsvCaQmTqurvyy71m6c2parP/35Zbnb9Wvykl62qzv7taeV/7V+Aj2jgySqUyeFjcVAaPux/mhtRmr/4OAN2W3+AsAwAA",
+	"releases/release-2.1.0-ee.yaml":  "H4sIAAAAAAAC/4SQwWrbQBCG7/sUAzlrkZRSim6u2oKhSYtVCj1OpEm8RdpZZsai7tMXKZZjhQbfxPd/mn92bmBHPaESdKSthGQswI/wLVH8/LGB0hc+z4jcSKKBY/VCOJGgsfza3H2tgBNFetBsodni+SMOvbsB2xP0qAaF/wNyKmUBjEcglD6QQHkRtRjhgeCQngQ76sB4HhJ0Mdwp+iI8VA5gCPHnsmThi9LnruUhcaRoOgm/w4gX+wMMeEQ1lgrG3L97Tetmuw4ims7/Z9inEOnWF8WEu6GC3H/w5aS1GprQUYsyd7bzfAcwfYaNGbZ7mgpLn/t84XV/UCP5JGEk2dFTUBOcrMLnvlise+7oivJdeAzTCeg5ev/SsSMNf+n81gU3EZPu2ey81fMwPfGaown3/So+H25WLw5WN9u3trz1+Wv7qsrY1s12dbVynf3vwY+EdhDSymVwd2pzGdxvfjQXpG627t8AbKhMBv4CAAA=",
+	"releases/release-2.1.0.yaml":     "H4sIAAAAAAAC/4SQQYvUQBCF7/0rCvacJsmKSG5jVBhwV5mI4LE2qd1pSbqaqprg+Osl2WTNiMvckve+rlf1buBAPaESdKSthGQswI/wJVH8+L6B0hc+dyOJBo7V8suJBI3lx+7ucwWcKNKDZquazZA/49C7G7AjQY9qUPhfIEsWC2A8A6H0gQTKjdVihAeCU3oS7KgD43lI0JVwi/VJeKgcwBDi93W9whelz13LQ+JI0XQCfoYR180BBjyjGksFY+7fXEh1s9+oEU3nZxn2KUS69UUxyd1QQe7f+dK1GprQUYsy57TzWAcwfYadGbZHmnJKn/t81ev+pEbyQcJIcqCnoCY4UVNnxUrdc0dXkK/CY5jOpmfr7d+MA2n4TdsTZ7mJmPTIZi9bPQ/TRa85mnDfX9gvfc3opqq62b+25a3P/6Wvooxt3ewvWisvvf8d/EhoJyGtXAZ3S5rL4H73rdkodbN3fwYAAEdEVukCAAA=",
+	"releases/release-2.2.0-ee.yaml":  "H4sIAAAAAAAC/4SPwUrDQBCG7/sUAz1nSYII5largmCrNCJ4nCbTdiXZCTPTYH16SdvY3LzNfv/HzL8zWFNDqAQ1aSWhMxbgLbx2FB/vS8h97tOEyPUkGjgWV8IdCRrL53z5UgB3FGmjyUiT0fNHbBs3A9sTNKgGmf8GuRxlAYxHIJQmkEA+iSqMsCE4dDvBmmowPi0JOhruEj0Jt4UDaEP8GEtmPst96ipuO44UTQfhK/Q46Q8Q67aA1N/5bHhXGspQU4Vysis1lmEYxjA3w2pPUkCf+9SnI180BzWSBwk9yZp2QU1wsDKf+my0VlzTP8qbcB+G8nSObq831qTh54RTf3PFZcRO92z21+q8TC98wdGEm2YSbwntIKSFS2CJRxy+6BJYzd/LCVmUz+53ADrn40EYAgAA",
+	"releases/release-2.2.0.yaml":     "H4sIAAAAAAAC/4SPwWrjQAyG7/MUgpw92GFZWN+y2V1YaNISl0KPiq0kU+yRkRTT9OnLOHaTW28z3/8h/VrAjlpCJWhIawm9sQAf4LGn+Pd3BUu/9LkbSDRwLKcv9yRoLK+rzUMJ3FOkvWYzzUbJX7Br3QLsRNCiGhT+HWTaxQIYL0AobSCB5V1UY4Q9wbk/CjbUgPE4JOhsuCn6J9yVDqAL8WWuV/gi9au56zlSNE3CWxhwbg4Qm66E3P/yhas1VKGhGmX0ajWW9EjPsDLD+kRSwpCuyWe+bs9qJH8kDCQ7OgY1wWQVPvfFbG25oW+UJ+EhpNp0jX7eduxIw8eIc//jhquIvZ7Y7KvVdZhOfM3RhNv2Lj4Q2llIS5fBBi+YTnQZbFfP1R1ZV//d5wBufcLrCQIAAA==",
+	"releases/release-2.4.0.yaml":     "H4sIAAAAAAAC/4SPwWrjQAyG7/MUgpw92CbswbdsdguFJi1xKfSo2EoyxR4ZSTFNn77YsZvcetN8/4f0zwJ21BAqQU1aSeiMBfgAzx3F/39LyP3Sp64n0cCxmJ7ckaCxvK82TwVwR5H2msw0GSV/wbZxC7ATQYNqkPlPkOkWC2C8AKE0gQTyu6jCCHuCc3cUrKkG43FJ0NlwU/Qg3BYOoA3xba6X+Sz3qau47ThSNB2Ej9Dj3Bwg1u3gpT5zlYYy1FShjF6lxjIMwxhWZlidSAroc5/6dObr5qxG8k9CT7KjY1ATHKzrzsnack2/KC/CfRhq0zX6c7uxIw1fI0798obLiJ2e2Oyn1XWZTnzN0YSb5i4+ENpZSAuXwAYvOHzRJbBdvZZ3ZF0+uu8BAJQHLawJAgAA",
+	"releases/release-2.5.0.yaml":     "H4sIAAAAAAAC/2SOwWrrQAxF9/MVgqxtYoe38S4vbaHQpCUuhS4VW0mm2CMjKabp15cZbDB0pzn3MPeu4EgdoRK0pI34wViAz/A6UHj8X0OZ/8vXbiRRz6GanjyQoLF8bvcvFfBAgU6azTRLUn7HvnMrsCtBh2pQ5N8gUxcLYLgDoXSeBMpF1GCAE8FtuAi21IJx+sTrbLgpehLuKwfQ+/AxzyvyoszXruF+4EDBNApffsR5OUBo++RFTX3tW2pQkteoscQjnn5rhs2VpIJxk+yJH7ilB/EjyZEuXk0wKuVSeRMefRxEf6Ijqf9JuFjiOuCgVzab+tb5JkU68R0HE+66RXwmtJuQVi6DPd4xjncZHLbv9YLs6mf3OwDlFx6k4wEAAA==",
+	"releases/release-2.6.0.yaml":     "H4sIAAAAAAAC/2SOQWvjQAyF7/MrBDl7sB3Yg2/Z7C4sNGmJS6FHxVaSKfbISIpp+uvLGBsMvWm+9zHvbeBEHaEStKSNhMFYgC/wPFD8+7uG0v/yuRtJNHCs5icPJGgs77vDUwU8UKSzZgvNJsk/sO/cBuxG0KEaFP4TZO5iAYwPIJQukEC5ihqMcCa4D1fBllownj4Juhhujv4J95UD6EN8W+YVvih97hruB44UTZPwEUZclgPEtk/epGmoQ0sNyuQ1aizpSGfYmWFzI6lg3PrC5ws/ckt/JIwkJ7oGNcGklGvlRXgMaRD9iE6k4WvCxRrXEQe9sdncl/vtFOnM9xxNuOtW8YXQ7kJauQwO+MA03mVw3L3WK7Kv/7vvAQDNzvK24wEAAA==",
+	"releases/release-2.7.0.yaml":     "H4sIAAAAAAAC/2SOQWvjQAyF7/MrBDl7sJ3Dgm/Z7C4sNGmJS6FHxVaSKfbISIpp+uvLGBsMvWm+9zHvbeBEHaEStKSNhMFYgC/wPFD8+7uG0v/yuRtJNHCs5icPJGgs77vDUwU8UKSzZgvNJsk/sO/cBuxG0KEaFP4TZO5iAYwPIJQukEC5ihqMcCa4D1fBllownj4Juhhujv4J95UD6EN8W+YVvih97hruB44UTZPwEUZclgPEtk/eNmka6tBSgzJ5jRpLOtIZdmbY3EgqGLe+8PnCj9zSHwkjyYmuQU0wKeVaeREeQxpEP6ITafiacLHGdcRBb2w29+V+O0U68z1HE+66VXwhtLuQVi6DAz4wjXcZHHev9Yrs6//uewDVeam44wEAAA==",
+	"releases/release-2.8.0.yaml":     "H4sIAAAAAAAC/2SOQUvDQBCF7/srBnrOkqQeJLdaFQRbpRHB4zSZtivJzjI7DdZfLxsSCHib/d7HvreCA3WEkaCl2IgLygJ8grdA/umhhtLe29wMJNGxr6YnBxJUlq/N7rUCDuTpGLOZZqNkb9h3ZgV6IegwKhT2B2TqYgH0NyCUzpFAuYga9HAkuIazYEstKI+fuDgbZoqehfvKAPTOf87zCluUNjcN94E9eY1J+HYDzssBfNsn7y5p0dWupQZl9JqoLOlIp9uoYnMhqWBY28LmM99zS4/iBpIDnV1UwaSUS+VdeHBpEP2LDhTd74iLJa49hnhh1akvt+sxihPfslfhrlvEJ0K9CsXKZLDDG6bxJoP95qNekG39Yv4GAFLYFU/jAQAA",
+	"releases/release-2.9.0.yaml":     "H4sIAAAAAAAC/2SOQUvDQBCF7/srBnrOkqRezK1WBcFWaUTwOE2m7UqyE2amwfrrJSGBgLfZ733seys4UEOoBDVpJaEzFuATvHUUnx5KyP29T11PooFjMT25I0Fj+drsXgvgjiIdNZlpMkr+hm3jVmAXggbVIPM/IFMXC2C8AaE0gQTyRVRhhCPBtTsL1lSD8fhJ0NlwU/Qs3BYOoA3xc56X+Sz3qau47ThSNB2E79DjvBwg1u3g3fnMVRrKUFOFMnqVGstwDGfYmGF1ISmgX/vMpzPfc02PEnqSA52DmuCg5EvlXbgPwyD6Fx1Iw++IsyUuI3Z6YbOpL/XrMdKJbzmacNMs4hOhXYW0cAns8IbDeJfAfvNRLsi2fHF/AwD2TOUM4wEAAA==",
+}
@@ -6,4 +6,15 @@ const (
 	AnnotationPrefix string = "openebs-upgrade.dao.mayadata.io"
 	// AnnKeyOpenEBSUID is the annotation that refers to OpenEBS UID of openebs-upgrade
 	AnnKeyOpenEBSUID string = AnnotationPrefix + "/openebs-uid"
+	// AnnKeyForceUninstall is the annotation that can be set on OpenEBS to
+	// uninstall OpenEBS even if there are volumes provisioned by OpenEBS.
+	AnnKeyForceUninstall string = AnnotationPrefix + "/force-uninstall"
+	// AnnKeyJivaReplicaOriginalStrategy is the annotation set on the replica
+	// deployment of a Jiva volume while it is being upgraded, it stores the
+	// strategy of the deployment which is restored once the upgrade completes.
+	AnnKeyJivaReplicaOriginalStrategy string = AnnotationPrefix + "/jiva-replica-original-strategy"
+	// AnnKeyDesiredAnnotations is the annotation set on the components which
+	// stores the comma separated keys of the annotations desired by
+	// openebs-upgrade, the other annotations of the components are retained.
+	AnnKeyDesiredAnnotations string = AnnotationPrefix + "/desired-annotations"
 )
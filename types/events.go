@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+// Reasons of the events recorded against OpenEBS and AdoptOpenEBS.
+const (
+	// EventReasonReconcileFailed is used when reconciliation fails.
+	EventReasonReconcileFailed string = "ReconcileFailed"
+	// EventReasonComponentCreated is used when a component is created.
+	EventReasonComponentCreated string = "ComponentCreated"
+	// EventReasonComponentUpdated is used when a component is updated.
+	EventReasonComponentUpdated string = "ComponentUpdated"
+	// EventReasonComponentDeleted is used when a component is deleted.
+	EventReasonComponentDeleted string = "ComponentDeleted"
+	// EventReasonUpgradeStarted is used when OpenEBS starts getting
+	// upgraded to a new version.
+	EventReasonUpgradeStarted string = "UpgradeStarted"
+	// EventReasonUpgradeSucceeded is used when all the components are
+	// ready after an upgrade.
+	EventReasonUpgradeSucceeded string = "UpgradeSucceeded"
+	// EventReasonUpgradeRolledBack is used when a failed upgrade is rolled
+	// back to the last known good version.
+	EventReasonUpgradeRolledBack string = "UpgradeRolledBack"
+	// EventReasonRollbackRefused is used when a failed upgrade can't be
+	// rolled back.
+	EventReasonRollbackRefused string = "RollbackRefused"
+	// EventReasonISCSISetupCompleted is used when the ISCSI client has been
+	// set up on the nodes.
+	EventReasonISCSISetupCompleted string = "ISCSISetupCompleted"
+	// EventReasonCSIComponentsMoved is used when the CSI components are
+	// moved out of the kube-system namespace.
+	EventReasonCSIComponentsMoved string = "CSIComponentsMoved"
+	// EventReasonOpenEBSAdopted is used when an existing OpenEBS
+	// installation is adopted.
+	EventReasonOpenEBSAdopted string = "OpenEBSAdopted"
+	// EventReasonOverlayFailed is used when an overlay can't be applied to
+	// the manifest of a component.
+	EventReasonOverlayFailed string = "OverlayFailed"
+	// EventReasonSecurityContextIgnored is used when the settings of a
+	// security context are ignored since these prevent a component which
+	// requires privileges from working.
+	EventReasonSecurityContextIgnored string = "SecurityContextIgnored"
+)
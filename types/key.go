@@ -37,6 +37,11 @@ const (
 	// AdmissionServerSVCNameKey is the name of admission server service
 	AdmissionServerSVCNameKey string = "admission-server-svc"
 
+	// ImagePullSecretsEnvKey is the env of the control plane components which
+	// is used to pass the comma separated imagePullSecrets to the data plane
+	// pods launched by them.
+	ImagePullSecretsEnvKey string = "OPENEBS_IO_IMAGE_PULL_SECRETS"
+
 	// APIServerContainerKey is the name of the container of maya-apiserver.
 	APIServerContainerKey string = "maya-apiserver"
 	// NodeDiskOperatorContainerKey is the name of the container of ndm-operator.
@@ -188,6 +193,10 @@ const (
 	KindCSIDriver string = "CSIDriver"
 	// KindPriorityClass is the k8s kind of PriorityClass.
 	KindPriorityClass string = "PriorityClass"
+	// KindJob is the k8s kind of job.
+	KindJob string = "Job"
+	// KindPodDisruptionBudget is the k8s kind of PodDisruptionBudget.
+	KindPodDisruptionBudget string = "PodDisruptionBudget"
 	// MayaAPIServerManifestKey is used to get the manifest of maya-apiserver
 	MayaAPIServerManifestKey string = MayaAPIServerNameKey + "_" + KindDeployment
 	// MayaAPIServerServiceManifestKey is used to get the manifest of maya-apiserver-service
@@ -254,6 +263,8 @@ const (
 	CStorAdmissionServerManifestKey string = CStorAdmissionServerNameKey + "_" + KindDeployment
 	// CVCOperatorManifestKey is used to get the manifest of CVC operator
 	CVCOperatorManifestKey string = CVCOperatorNameKey + "_" + KindDeployment
+	// CVCOperatorServiceManifestKey is used to get the manifest of CVC operator service
+	CVCOperatorServiceManifestKey string = CVCOperatorServiceNameKey + "_" + KindService
 	// CSPCOperatorManifestKey is used to get the manifest of CSPC operator
 	CSPCOperatorManifestKey string = CSPCOperatorNameKey + "_" + KindDeployment
 	// CSPCCRDV1ManifestKey is used to get the manifest of CSPC CRD
@@ -286,13 +297,6 @@ const (
 	// MayastorPoolsCRDManifestKey is used to get the manifest of mayastorpools CRD.
 	MayastorPoolsCRDManifestKey string = MayastorPoolsCRDV1alpha1NameKey + "_" + KindCustomResourceDefinition
 
-	// MayastorSupportedVersion is the openebs version from where mayastor is supported.
-	MayastorSupportedVersion string = "1.10.0-ee" // MayastorSupportedVersion is the openebs version from where mayastor is supported.
-	// NATSSupportedVersion is the openebs version from where NATS is supported.
-	NATSSupportedVersion string = "2.0.0"
-	// MayastorCSISupportedVersion is the openebs version from where mayastor-csi is supported.
-	MayastorCSISupportedVersion string = "2.0.0"
-
 	// OpenEBSVersion150 is the OpenEBS version 1.5.0
 	OpenEBSVersion150 string = "1.5.0"
 	// OpenEBSVersion160 is the OpenEBS version 1.6.0
@@ -407,6 +411,10 @@ const (
 	// identifying a particular OpenEBS component i.e., openebs-ndm will be the label value
 	// for ndm daemonset while openebs-ndm-operator will be the value for NDM operator.
 	OpenEBSComponentNameLabelKey string = "openebs-upgrade.dao.mayadata.io/component-name"
+	// OpenEBSDataPlaneUpgradeLabelKey is the label key which is set on the jobs
+	// launched to upgrade the OpenEBS data plane i.e., pools and volumes. Its value
+	// is the type of resource being upgraded by the job such as cstor-pool.
+	OpenEBSDataPlaneUpgradeLabelKey string = "openebs-upgrade.dao.mayadata.io/data-plane-upgrade"
 
 	// OpenEBSSAComponentNameLabelValue is the value of the component-name label
 	// of OpenEBS service account.
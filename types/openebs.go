@@ -15,6 +15,7 @@ package types
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // OpenEBS defines the intent to get
@@ -81,6 +82,17 @@ type OpenEBSSpec struct {
 	// for OpenEBS components.
 	ImagePullPolicy string `json:"imagePullPolicy"`
 
+	// ImagePullSecrets are the secrets used to pull the images of the
+	// OpenEBS components from a private registry.
+	//
+	// imagePullSecrets provided at this level i.e., .spec.imagePullSecrets
+	// will be applicable to all the components, the ServiceAccounts and the
+	// data plane pods i.e., pools and volumes.
+	//
+	// This can be overrided by providing it for a particular component in the
+	// component's specified section, for example, inside apiServer.
+	ImagePullSecrets []ImagePullSecret `json:"imagePullSecrets,omitempty"`
+
 	// Resources can be used to specify the resource requests of the containers
 	// of the OpenEBS components in terms of CPU and Memory
 	//
@@ -97,6 +109,124 @@ type OpenEBSSpec struct {
 	// PreInstallation specifies the components or the tools or the dependencies that needs
 	// to be installed prior to OpenEBS installation.
 	PreInstallation PreInstallation `json:"preInstallation,omitempty"`
+
+	// Uninstall specifies the configuration to be used while uninstalling
+	// OpenEBS i.e., when OpenEBS resource gets deleted.
+	Uninstall Uninstall `json:"uninstall,omitempty"`
+
+	// DataPlaneUpgrade specifies if the OpenEBS data plane i.e., pools and
+	// volumes should be upgraded once the control plane is upgraded.
+	DataPlaneUpgrade *DataPlaneUpgrade `json:"dataPlaneUpgrade,omitempty"`
+
+	// Rollback specifies if OpenEBS should be rolled back to the last known
+	// good version if the components fail to become ready after an upgrade.
+	Rollback *Rollback `json:"rollback,omitempty"`
+
+	// DryRun if set to true plans the changes as usual but instead of
+	// applying them, reports these in status.dryRun.
+	//
+	// Defaults to false
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Overlays are the patches applied to the manifests of the components
+	// once these are rendered so that any field of any component can be
+	// customized.
+	Overlays []Overlay `json:"overlays,omitempty"`
+}
+
+// OverlayPatchType is the type of the patch of an overlay.
+type OverlayPatchType string
+
+const (
+	// OverlayPatchTypeJSON is a JSON patch i.e., a list of operations as
+	// per RFC 6902.
+	OverlayPatchTypeJSON OverlayPatchType = "json"
+	// OverlayPatchTypeStrategicMerge is a strategic merge patch as used by
+	// kubectl patch. A JSON merge patch is applied instead for the kinds
+	// which do not support strategic merge such as CustomResourceDefinition.
+	OverlayPatchTypeStrategicMerge OverlayPatchType = "strategic-merge"
+)
+
+// Overlay is a patch applied to the rendered manifest of a component.
+type Overlay struct {
+	// Target is the key of the manifest to be patched i.e., name_kind of the
+	// component such as openebs-ndm_DaemonSet.
+	Target string `json:"target"`
+
+	// Type is the type of the patch.
+	//
+	// Defaults to strategic-merge
+	Type OverlayPatchType `json:"type,omitempty"`
+
+	// Patch is a list of JSON patch operations or a strategic merge patch
+	// object as per the given type.
+	Patch interface{} `json:"patch"`
+}
+
+// Rollback stores the configuration for rolling back a failed upgrade.
+//
+// The spec with which all the OpenEBS components were last found ready is
+// snapshotted in the status. If an upgrade started by changing spec.version
+// does not make any progress within the given timeout, the snapshot is
+// rendered and applied again. The rollback is kept in place till spec.version
+// is changed.
+type Rollback struct {
+	// Defaults to false
+	Enabled *bool `json:"enabled"`
+
+	// TimeoutSeconds is the time given to an upgrade to make progress i.e.,
+	// to move to the next stage or to roll out any further component.
+	//
+	// Defaults to 600
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// DataPlaneUpgrade stores the configuration for upgrading the OpenEBS
+// data plane.
+//
+// cStor pools are upgraded one pool cluster i.e., CStorPoolCluster or
+// StoragePoolClaim at a time since the upgrade job upgrades the pools of a
+// pool cluster one after the other. The cStor volumes are then upgraded in
+// batches of the given size. Jiva volumes are upgraded replica by replica
+// followed by their controller.
+type DataPlaneUpgrade struct {
+	// Defaults to false
+	Enabled *bool `json:"enabled"`
+
+	// VolumeBatchSize is the number of volumes that are upgraded at a time.
+	//
+	// Defaults to 1
+	VolumeBatchSize *int32 `json:"volumeBatchSize,omitempty"`
+
+	// JivaVolumeConcurrency is the number of Jiva volumes that are upgraded
+	// at a time.
+	//
+	// Defaults to 1
+	JivaVolumeConcurrency *int32 `json:"jivaVolumeConcurrency,omitempty"`
+
+	// JivaReplicaRebuildSeconds is the minimum time for which an upgraded
+	// Jiva replica must stay ready before the next replica is upgraded. The
+	// next replica is upgraded only once all the replicas are in RW mode,
+	// this time is used to pause the rollout before that and hence should
+	// be more than the resync period of the operator. Values less than the
+	// resync period of 10 seconds are raised to it.
+	//
+	// Defaults to 60
+	JivaReplicaRebuildSeconds *int32 `json:"jivaReplicaRebuildSeconds,omitempty"`
+}
+
+// Uninstall stores the configuration used for uninstalling OpenEBS.
+type Uninstall struct {
+	// If retainCRDs is true then the OpenEBS CRDs will not be deleted
+	// while uninstalling OpenEBS.
+	//
+	// Defaults to false
+	RetainCRDs *bool `json:"retainCRDs,omitempty"`
+	// Provisioners are the names of the provisioners or CSI drivers whose
+	// persistent volumes block the uninstallation of OpenEBS, in addition
+	// to the ones installed by OpenEBS and the provisioners of the OpenEBS
+	// storage classes.
+	Provisioners []string `json:"provisioners,omitempty"`
 }
 
 // PreInstallation stores the components or the tools or the dependencies that needs
@@ -175,6 +305,87 @@ type Component struct {
 	Affinity          map[string]interface{} `json:"affinity,omitempty"`
 	MatchLabels       map[string]string      `json:"matchLabels,omitempty"`
 	PodTemplateLabels map[string]string      `json:"podTemplateLabels,omitempty"`
+	// Annotations are merged with the annotations of the workload i.e., the
+	// deployment, daemonset or statefulset of the component.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// PodAnnotations are merged with the annotations of the pod template of
+	// the workload of the component.
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+	// ContainerResources are the resources of the particular containers of
+	// the component, including the sidecars, keyed by the container names.
+	// The resources of the component and then the global resources are used
+	// for the containers which are not present here.
+	ContainerResources map[string]map[string]interface{} `json:"containerResources,omitempty"`
+	// PodSecurityContext is set as the security context of the pods of the
+	// component.
+	PodSecurityContext map[string]interface{} `json:"podSecurityContext,omitempty"`
+	// ContainerSecurityContext is merged with the security context of each
+	// container of the component unless the container has its own.
+	//
+	// NOTE: The settings which prevent the components requiring privileges
+	// such as NDM, CSI node, mayastor and ISCSI setup from working are
+	// ignored with a warning.
+	ContainerSecurityContext map[string]interface{} `json:"containerSecurityContext,omitempty"`
+	// ImagePullSecrets if set are used instead of the global
+	// imagePullSecrets for the pods of the component.
+	ImagePullSecrets []ImagePullSecret `json:"imagePullSecrets,omitempty"`
+	// PodDisruptionBudget if set is created for the component as long as it
+	// runs more than one replica so that its pods are not evicted all at
+	// once, for example while draining the nodes. It is applicable to the
+	// components which are deployed as deployments.
+	PodDisruptionBudget *PodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+	// TopologySpreadConstraints are set as the topologySpreadConstraints of
+	// the pods of the component so that the replicas can be spread across
+	// the nodes or zones. It is applicable to the components which are
+	// deployed as deployments.
+	TopologySpreadConstraints []interface{} `json:"topologySpreadConstraints,omitempty"`
+	// UpdateStrategy if set is used instead of the update strategy of the
+	// template so that the pods of the component are not restarted on all
+	// the nodes at once during an upgrade. It is applicable to the
+	// components which are deployed as daemonsets.
+	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
+	// Strategy if set is used instead of the strategy of the template. It
+	// is applicable to the components which are deployed as deployments.
+	Strategy *DeploymentStrategy `json:"strategy,omitempty"`
+}
+
+// UpdateStrategy stores the update strategy of the daemonset of a component.
+type UpdateStrategy struct {
+	// Type can be either RollingUpdate or OnDelete.
+	Type string `json:"type,omitempty"`
+	// MaxUnavailable is the maximum number or percentage of the pods that
+	// can be unavailable during a rolling update.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// MinReadySeconds is the time for which a new pod should be ready for
+	// it to be considered available.
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+}
+
+// DeploymentStrategy stores the strategy of the deployment of a component.
+type DeploymentStrategy struct {
+	// Type can be either RollingUpdate or Recreate.
+	Type string `json:"type,omitempty"`
+	// MaxUnavailable and MaxSurge are the maximum number or percentage of
+	// the pods that can be unavailable or created above the replica count
+	// during a rolling update.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	MaxSurge       *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// MinReadySeconds is the time for which a new pod should be ready for
+	// it to be considered available.
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+}
+
+// PodDisruptionBudget stores the configuration of the PodDisruptionBudget
+// of a component, only one of minAvailable and maxUnavailable can be set.
+type PodDisruptionBudget struct {
+	MinAvailable   *intstr.IntOrString `json:"minAvailable,omitempty"`
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// ImagePullSecret refers to a secret, in the namespace of the OpenEBS
+// components, which is used to pull the images from a private registry.
+type ImagePullSecret struct {
+	Name string `json:"name"`
 }
 
 // APIServer store the configuration for maya-apiserver
@@ -472,15 +683,39 @@ type Container struct {
 	Image                string        `json:"image,omitempty"`
 	EnableLeaderElection *bool         `json:"enableLeaderElection,omitempty"`
 	ENV                  []interface{} `json:"env,omitempty"`
+	// ImagePullPolicy if set is used instead of the global imagePullPolicy
+	// for this container.
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+	// ImagePrefix if set is used instead of the global imagePrefix to form
+	// the image of this container.
+	ImagePrefix string `json:"imagePrefix,omitempty"`
+	// SecurityContext if set is merged with the security context of this
+	// container instead of the containerSecurityContext of the component.
+	//
+	// NOTE: This is not named containerSecurityContext since the component
+	// and the container are embedded together for most of the components.
+	SecurityContext map[string]interface{} `json:"securityContext,omitempty"`
 }
 
 // OpenEBSStatus defines the current status of
 // OpenEBS
 type OpenEBSStatus struct {
-	// Phase is the current state of OpenEBS, it can be either Online
-	// or Error.
+	// Phase is the current state of OpenEBS, it can be one of Pending,
+	// Installing, Upgrading, Online, Degraded, Uninstalling or Failed.
 	Phase OpenEBSStatusPhase `json:"phase"`
 
+	// ObservedGeneration is the generation of OpenEBS that was last
+	// reconciled.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// CurrentVersion is the lowest version the OpenEBS components are
+	// running at.
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// TargetVersion is the version the OpenEBS components are being
+	// installed or upgraded to.
+	TargetVersion string `json:"targetVersion,omitempty"`
+
 	// Reason is a brief CamelCase string that describes any failure and is meant
 	// for machine parsing and tidy display in the CLI.
 	Reason string `json:"reason,omitempty"`
@@ -488,12 +723,214 @@ type OpenEBSStatus struct {
 	// Conditions are various states that OpenEBS
 	// is currently passing through.
 	Conditions []OpenEBSStatusCondition `json:"conditions"`
+
+	// RemovedComponents are the components which were installed earlier but
+	// have been removed from the cluster since these got disabled.
+	RemovedComponents []ComponentReference `json:"removedComponents,omitempty"`
+
+	// Uninstall reports the progress of OpenEBS uninstallation.
+	Uninstall *UninstallStatus `json:"uninstall,omitempty"`
+
+	// Upgrade reports the progress of OpenEBS upgrade.
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+
+	// DataPlaneUpgrade reports the progress of the pools and volumes
+	// upgrade.
+	DataPlaneUpgrade *DataPlaneUpgradeStatus `json:"dataPlaneUpgrade,omitempty"`
+
+	// LastKnownGood is the snapshot of the spec with which all the OpenEBS
+	// components were last found ready.
+	LastKnownGood *LastKnownGood `json:"lastKnownGood,omitempty"`
+
+	// UpgradeHistory reports the recent upgrades of OpenEBS along with
+	// their results.
+	UpgradeHistory []UpgradeHistoryEntry `json:"upgradeHistory,omitempty"`
+
+	// DryRun reports the changes that will be applied once spec.dryRun
+	// is unset.
+	DryRun *DryRunPlan `json:"dryRun,omitempty"`
+
+	// Overlays report the result of every overlay given in spec.overlays.
+	Overlays []OverlayStatus `json:"overlays,omitempty"`
+}
+
+// OverlayStatus reports the result of applying an overlay.
+type OverlayStatus struct {
+	// Index is the index of the overlay in spec.overlays.
+	Index  int    `json:"index"`
+	Target string `json:"target"`
+	// Applied is true if the overlay got applied, the manifest is left as
+	// it is if the overlay fails.
+	Applied bool `json:"applied"`
+	// Error is the reason due to which the overlay failed.
+	Error string `json:"error,omitempty"`
+}
+
+// DryRunPlan reports the components that will be added, changed or deleted
+// if OpenEBS is reconciled with the current spec.
+type DryRunPlan struct {
+	// Summary is the count of the components to be added, changed and
+	// deleted for example, 1 to add, 2 to change, 0 to delete.
+	Summary string          `json:"summary"`
+	Add     []PlannedChange `json:"add,omitempty"`
+	Change  []PlannedChange `json:"change,omitempty"`
+	Delete  []PlannedChange `json:"delete,omitempty"`
+}
+
+// PlannedChange reports the change planned for a particular component.
+type PlannedChange struct {
+	ComponentReference `json:",inline"`
+	// FieldChanges are reported only for the components to be changed.
+	FieldChanges []FieldChange `json:"fieldChanges,omitempty"`
+	// RestartsPods is true if the change updates the pod template of a
+	// workload and hence its pods will be restarted.
+	RestartsPods bool `json:"restartsPods,omitempty"`
+	// RolloutStrategy is the strategy with which the pods are restarted
+	// for example, RollingUpdate maxUnavailable=1 minReadySeconds=30. It
+	// is reported only if the pods will be restarted.
+	RolloutStrategy string `json:"rolloutStrategy,omitempty"`
+}
+
+// FieldChange reports the change of a particular field of a component, the
+// values are JSON encoded.
+type FieldChange struct {
+	Path     string `json:"path"`
+	Observed string `json:"observed,omitempty"`
+	Desired  string `json:"desired,omitempty"`
+}
+
+// LastKnownGood is the snapshot of the OpenEBS spec that is rendered and
+// applied again if an upgrade fails.
+type LastKnownGood struct {
+	Version string       `json:"version"`
+	Spec    *OpenEBSSpec `json:"spec"`
+	// SnapshotTime is the time at which the snapshot was taken in RFC3339
+	// format.
+	SnapshotTime string `json:"snapshotTime"`
+}
+
+// UpgradeHistoryEntry reports the result of upgrading OpenEBS from one
+// version to another.
+type UpgradeHistoryEntry struct {
+	FromVersion string              `json:"fromVersion"`
+	ToVersion   string              `json:"toVersion"`
+	Phase       UpgradeHistoryPhase `json:"phase"`
+	Reason      string              `json:"reason,omitempty"`
+	// Progress is the stage being upgraded along with the component
+	// blocking it, LastProgressTime is the time at which it last changed.
+	Progress string `json:"progress,omitempty"`
+	// StartTime, LastProgressTime and CompletionTime are in RFC3339 format.
+	StartTime        string `json:"startTime"`
+	LastProgressTime string `json:"lastProgressTime,omitempty"`
+	CompletionTime   string `json:"completionTime,omitempty"`
+}
+
+// UpgradeHistoryPhase reports the result of an upgrade
+type UpgradeHistoryPhase string
+
+const (
+	// UpgradeHistoryPhaseUpgrading indicates the upgrade is in progress
+	UpgradeHistoryPhaseUpgrading UpgradeHistoryPhase = "Upgrading"
+
+	// UpgradeHistoryPhaseSucceeded indicates all the components became
+	// ready after the upgrade
+	UpgradeHistoryPhaseSucceeded UpgradeHistoryPhase = "Succeeded"
+
+	// UpgradeHistoryPhaseRolledBack indicates the components did not become
+	// ready in time and the last known good spec has been applied again
+	UpgradeHistoryPhaseRolledBack UpgradeHistoryPhase = "RolledBack"
+
+	// UpgradeHistoryPhaseRollbackRefused indicates the components did not
+	// become ready in time but the upgrade can't be rolled back since the
+	// CRD changes of the upgraded version can't be undone
+	UpgradeHistoryPhaseRollbackRefused UpgradeHistoryPhase = "RollbackRefused"
+)
+
+// DataPlaneUpgradeStatus reports the progress of the OpenEBS data plane
+// upgrade to a particular version.
+type DataPlaneUpgradeStatus struct {
+	TargetVersion string                 `json:"targetVersion"`
+	Phase         DataPlaneUpgradePhase  `json:"phase"`
+	Reason        string                 `json:"reason,omitempty"`
+	Pools         []DataPlaneUpgradeItem `json:"pools,omitempty"`
+	Volumes       []DataPlaneUpgradeItem `json:"volumes,omitempty"`
+	JivaVolumes   []DataPlaneUpgradeItem `json:"jivaVolumes,omitempty"`
+}
+
+// DataPlaneUpgradeItem reports the result of upgrading a particular pool
+// cluster or volume.
+type DataPlaneUpgradeItem struct {
+	Kind        string                `json:"kind"`
+	Name        string                `json:"name"`
+	FromVersion string                `json:"fromVersion,omitempty"`
+	Job         string                `json:"job,omitempty"`
+	Phase       DataPlaneUpgradePhase `json:"phase"`
+	// Progress reports the progress of an item which is upgraded in steps
+	// such as the pools of a pool cluster or the replicas of a Jiva volume.
+	Progress string `json:"progress,omitempty"`
+}
+
+// DataPlaneUpgradePhase reports the phase of the data plane upgrade
+type DataPlaneUpgradePhase string
+
+const (
+	// DataPlaneUpgradePhaseUpgrading indicates the upgrade is in progress
+	DataPlaneUpgradePhaseUpgrading DataPlaneUpgradePhase = "Upgrading"
+
+	// DataPlaneUpgradePhaseCompleted indicates the upgrade is completed
+	DataPlaneUpgradePhaseCompleted DataPlaneUpgradePhase = "Completed"
+
+	// DataPlaneUpgradePhaseFailed indicates the upgrade has failed, no
+	// further pools or volumes are upgraded once it fails.
+	DataPlaneUpgradePhaseFailed DataPlaneUpgradePhase = "Failed"
+)
+
+// UpgradeStatus reports the progress of a staged OpenEBS upgrade i.e.,
+// the stage being upgraded, the number of stages completed and the
+// component which is blocking the upgrade from moving to the next stage.
+type UpgradeStatus struct {
+	Stage     string `json:"stage"`
+	Progress  string `json:"progress"`
+	BlockedBy string `json:"blockedBy,omitempty"`
+	// PendingPodDeletions lists the daemonsets having the OnDelete update
+	// strategy whose pods are updated only once these are deleted.
+	PendingPodDeletions []string `json:"pendingPodDeletions,omitempty"`
+}
+
+// UninstallStatus reports the progress of OpenEBS uninstallation
+// i.e., the stage being uninstalled, the components left to be deleted
+// and the reason if uninstallation is blocked.
+type UninstallStatus struct {
+	Stage               string `json:"stage,omitempty"`
+	RemainingComponents int64  `json:"remainingComponents"`
+	BlockedBy           string `json:"blockedBy,omitempty"`
+}
+
+// ComponentReference refers to a particular kubernetes resource which
+// is managed as a part of OpenEBS installation.
+type ComponentReference struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
 }
 
 // OpenEBSStatusPhase reports the current phase of OpenEBS
 type OpenEBSStatusPhase string
 
 const (
+	// OpenEBSStatusPhasePending indicates the OpenEBS
+	// components are yet to be created
+	OpenEBSStatusPhasePending OpenEBSStatusPhase = "Pending"
+
+	// OpenEBSStatusPhaseInstalling indicates the OpenEBS
+	// components have been created but are not yet ready
+	// for the first time
+	OpenEBSStatusPhaseInstalling OpenEBSStatusPhase = "Installing"
+
+	// OpenEBSStatusPhaseUpgrading indicates the OpenEBS
+	// components are being upgraded to the desired version
+	OpenEBSStatusPhaseUpgrading OpenEBSStatusPhase = "Upgrading"
+
 	// OpenEBSStatusPhaseFailed indicates error in
 	// OpenEBS
 	OpenEBSStatusPhaseFailed OpenEBSStatusPhase = "Failed"
@@ -501,6 +938,15 @@ const (
 	// OpenEBSStatusPhaseOnline indicates
 	// OpenEBS in Online state i.e. no error or warning
 	OpenEBSStatusPhaseOnline OpenEBSStatusPhase = "Online"
+
+	// OpenEBSStatusPhaseDegraded indicates some of the OpenEBS
+	// components are not ready but all of them have at least one
+	// ready replica
+	OpenEBSStatusPhaseDegraded OpenEBSStatusPhase = "Degraded"
+
+	// OpenEBSStatusPhaseUninstalling indicates OpenEBS
+	// components are being deleted since OpenEBS got deleted
+	OpenEBSStatusPhaseUninstalling OpenEBSStatusPhase = "Uninstalling"
 )
 
 // OpenEBSStatusCondition defines the current state of OpenEBS
@@ -509,4 +955,16 @@ type OpenEBSStatusCondition struct {
 	Status           ConditionState `json:"status"`
 	Reason           string         `json:"reason,omitempty"`
 	LastObservedTime string         `json:"lastObservedTime"`
+
+	// Below fields are set only for the ComponentReady conditions i.e.,
+	// one condition per OpenEBS Deployment, DaemonSet and StatefulSet.
+	Kind            string `json:"kind,omitempty"`
+	Component       string `json:"component,omitempty"`
+	ReadyReplicas   *int64 `json:"readyReplicas,omitempty"`
+	DesiredReplicas *int64 `json:"desiredReplicas,omitempty"`
+	// ImageVersion is the tag of the image the component is running.
+	ImageVersion string `json:"imageVersion,omitempty"`
+	// LastTransitionTime is the time at which the status of the condition
+	// last changed.
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
 }
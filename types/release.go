@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The MayaData Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    https://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+// ReleaseFeature is a feature which is available only in some of the
+// OpenEBS releases.
+type ReleaseFeature string
+
+const (
+	// ReleaseFeatureMayastor states that Mayastor i.e., moac and the
+	// mayastor daemonset can be installed.
+	ReleaseFeatureMayastor ReleaseFeature = "Mayastor"
+	// ReleaseFeatureNATS states that NATS can be installed along with
+	// Mayastor.
+	ReleaseFeatureNATS ReleaseFeature = "NATS"
+	// ReleaseFeatureMayastorCSI states that mayastor-csi can be installed
+	// along with Mayastor.
+	ReleaseFeatureMayastorCSI ReleaseFeature = "MayastorCSI"
+)
+
+// ReleaseDescriptor describes an OpenEBS release that can be installed,
+// the releases are read from the release catalog so that a new release can
+// be supported without changing the code.
+type ReleaseDescriptor struct {
+	// Version is the OpenEBS version of this release such as 2.5.0 or
+	// 2.0.0-ee.
+	Version string `json:"version"`
+	// OperatorYAML is the operator YAML of this release from which the
+	// OpenEBS components are formed. A relative path is resolved against
+	// the templates directory. It can also be a remote template i.e., an
+	// http(s):// URL or an oci:// artifact reference such as
+	// oci://quay.io/mayadata/openebs-operator:2.5.0-hotfix1.
+	OperatorYAML string `json:"operatorYAML"`
+	// OperatorYAMLDigest is the SHA-256 digest of the operator YAML such as
+	// sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae.
+	// It is required for the remote templates, the operator YAML is not
+	// used if its digest doesn't match.
+	OperatorYAMLDigest string `json:"operatorYAMLDigest,omitempty"`
+	// Kubernetes is the range of kubernetes versions this release can be
+	// installed on.
+	Kubernetes KubernetesVersionRange `json:"kubernetes,omitempty"`
+	// UpgradeFrom is the range of OpenEBS versions which can be upgraded
+	// directly to this release. The release can only be installed afresh
+	// if it is not set.
+	UpgradeFrom VersionRange `json:"upgradeFrom,omitempty"`
+	// Components are the image versions of the components which are not
+	// versioned along with OpenEBS.
+	Components ReleaseComponentVersions `json:"components,omitempty"`
+	// CSISidecars are the image versions of the CSI sidecars.
+	CSISidecars ReleaseCSISidecarVersions `json:"csiSidecars,omitempty"`
+	// Features are the features available in this release.
+	Features []ReleaseFeature `json:"features,omitempty"`
+}
+
+// KubernetesVersionRange is an inclusive range of kubernetes versions, an
+// empty bound is not checked.
+type KubernetesVersionRange struct {
+	MinVersion string `json:"minVersion,omitempty"`
+	MaxVersion string `json:"maxVersion,omitempty"`
+}
+
+// VersionRange is an inclusive range of OpenEBS versions, an empty bound is
+// not checked.
+type VersionRange struct {
+	MinVersion string `json:"minVersion,omitempty"`
+	MaxVersion string `json:"maxVersion,omitempty"`
+}
+
+// ReleaseComponentVersions stores the image versions of the components of
+// a release. An empty version implies the component is not available in
+// the release.
+type ReleaseComponentVersions struct {
+	NDM         string `json:"ndm,omitempty"`
+	Jiva        string `json:"jiva,omitempty"`
+	Mayastor    string `json:"mayastor,omitempty"`
+	MayastorCSI string `json:"mayastorCSI,omitempty"`
+	NATS        string `json:"nats,omitempty"`
+}
+
+// ReleaseCSISidecarVersions stores the image versions of the CSI sidecars
+// of a release.
+type ReleaseCSISidecarVersions struct {
+	CStor    CStorCSISidecarVersions    `json:"cstor,omitempty"`
+	Mayastor MayastorCSISidecarVersions `json:"mayastor,omitempty"`
+}
+
+// CStorCSISidecarVersions stores the image versions of the sidecars of the
+// cStor CSI controller and node.
+type CStorCSISidecarVersions struct {
+	Resizer                string `json:"csiResizer,omitempty"`
+	Snapshotter            string `json:"csiSnapshotter,omitempty"`
+	SnapshotController     string `json:"snapshotController,omitempty"`
+	Provisioner            string `json:"csiProvisioner,omitempty"`
+	Attacher               string `json:"csiAttacher,omitempty"`
+	ClusterDriverRegistrar string `json:"csiClusterDriverRegistrar,omitempty"`
+	NodeDriverRegistrar    string `json:"csiNodeDriverRegistrar,omitempty"`
+}
+
+// MayastorCSISidecarVersions stores the image versions of the sidecars of
+// moac, mayastor and mayastor-csi.
+type MayastorCSISidecarVersions struct {
+	MOACProvisioner                string `json:"moacCSIProvisioner,omitempty"`
+	MOACAttacher                   string `json:"moacCSIAttacher,omitempty"`
+	MayastorNodeDriverRegistrar    string `json:"mayastorCSINodeDriverRegistrar,omitempty"`
+	MayastorCSINodeDriverRegistrar string `json:"mayastorCSICSINodeDriverRegistrar,omitempty"`
+}
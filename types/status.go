@@ -26,6 +26,10 @@ const (
 	// presence or absence of error while reconciling
 	// OpenEBS.
 	OpenEBSReconcileErrorCondition ConditionType = "OpenEBSReconcileError"
+
+	// ComponentReadyCondition is used to indicate if all
+	// the replicas of an OpenEBS component are ready.
+	ComponentReadyCondition ConditionType = "ComponentReady"
 )
 
 // ConditionState is a custom datatype that
@@ -55,6 +59,12 @@ func now() string {
 	return metav1.Now().Format("2006-01-02 15:04:05.000000")
 }
 
+// Now returns the current time in the format used
+// by the status conditions.
+func Now() string {
+	return now()
+}
+
 // MakeOpenEBSReconcileErrCond builds a new
 // OpenEBSReconcileError condition
 // suitable to be used in API status.conditions